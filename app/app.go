@@ -87,6 +87,7 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/cast"
 
 	"github.com/ignite/modules/cmd"
@@ -108,6 +109,11 @@ const (
 	MissionIDStaking = 1
 	// MissionIDVoting is the mission ID for voting mission to claim airdrop
 	MissionIDVoting = 2
+
+	// FlagMintStreamDistributions enables the gRPC streaming of the mint distributions
+	FlagMintStreamDistributions = "mint.stream-distributions"
+	// FlagMintStreamBufferSize is the number of mint distributions buffered for each stream client
+	FlagMintStreamBufferSize = "mint.stream-buffer-size"
 )
 
 // this line is used by starport scaffolding # stargate/wasm/app/enabledProposals
@@ -126,6 +132,21 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 	return govProposalHandlers
 }
 
+// getMintModuleOptions returns the options of the mint module setting the app-specific
+// default genesis, the app has no funded addresses so their share is given to staking
+func getMintModuleOptions() []mint.ModuleOption {
+	params := minttypes.DefaultParams()
+	params.DistributionProportions = minttypes.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(7, 1),
+		FundedAddresses: sdk.ZeroDec(),
+		CommunityPool:   sdk.NewDecWithPrec(3, 1),
+	}
+
+	return []mint.ModuleOption{
+		mint.WithDefaultGenesisParams(params),
+	}
+}
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -151,7 +172,7 @@ var (
 		evidence.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		consensus.AppModuleBasic{},
-		mint.AppModuleBasic{},
+		mint.NewAppModuleBasic(getMintModuleOptions()...),
 		claim.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
@@ -160,7 +181,7 @@ var (
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
+		minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
@@ -219,6 +240,9 @@ type App struct {
 
 	ClaimKeeper claimkeeper.Keeper
 	MintKeeper  mintkeeper.Keeper
+
+	// MintStream streams the mint distributions, nil if not enabled
+	MintStream *mintkeeper.DistributionStream
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// mm is the module manager
@@ -411,7 +435,19 @@ func New(
 		app.BankKeeper,
 		app.DistrKeeper,
 		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		mintkeeper.WithStateProver(mintkeeper.NewABCIStateProver(app)),
 	)
+	if cast.ToBool(appOpts.Get(FlagMintStreamDistributions)) {
+		app.MintStream = mintkeeper.NewDistributionStream(
+			app.MintKeeper,
+			func() (sdk.Context, error) {
+				return app.CreateQueryContext(0, false)
+			},
+			cast.ToInt(appOpts.Get(FlagMintStreamBufferSize)),
+		)
+		app.SetStreamingService(app.MintStream)
+	}
 
 	govConfig := govtypes.DefaultConfig()
 	govKeeper := govkeeper.NewKeeper(
@@ -478,7 +514,7 @@ func New(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		params.NewAppModule(app.ParamsKeeper),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, getMintModuleOptions()...),
 		claim.NewAppModule(appCodec, app.ClaimKeeper, app.AccountKeeper, app.BankKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 		// this line is used by starport scaffolding # stargate/app/appModule
@@ -563,6 +599,10 @@ func New(
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
 
+	if err := mint.ValidateModuleOrder(app.mm.OrderInitGenesis, app.mm.OrderBeginBlockers); err != nil {
+		panic(err)
+	}
+
 	// Uncomment if you want to set a custom migration order here.
 	// app.mm.SetOrderMigrations(custom order)
 
@@ -654,6 +694,11 @@ func (app *App) Configurator() module.Configurator {
 	return app.configurator
 }
 
+// ModuleManager get app module manager
+func (app *App) ModuleManager() *module.Manager {
+	return app.mm
+}
+
 // LoadHeight loads a particular height
 func (app *App) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
@@ -752,6 +797,15 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	// apiSvr.Router.Handle("/static/openapi.yml", http.FileServer(http.FS(docs.Docs)))
 }
 
+// RegisterGRPCServer registers the gRPC services of the app, the mint stream service is
+// registered if enabled.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	if app.MintStream != nil {
+		minttypes.RegisterStreamServer(server, app.MintStream)
+	}
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"encoding/json"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	minttypes "github.com/ignite/modules/x/mint/types"
+)
+
+// InfoData is the data of the ABCI Info response of the app: the name of the app and the health
+// report of the minting at the last committed block
+type InfoData struct {
+	Name string                `json:"name"`
+	Mint *minttypes.MintHealth `json:"mint,omitempty"`
+}
+
+// Info implements the ABCI Info method, the health report of the minting is included in the data
+// of the response so that the health checks of the node, querying the abci_info RPC endpoint,
+// can tell whether coins are minted. The data is the name of the app until a block is committed.
+func (app *App) Info(req abci.RequestInfo) abci.ResponseInfo {
+	res := app.BaseApp.Info(req)
+	if res.LastBlockHeight == 0 {
+		return res
+	}
+
+	ctx, err := app.CreateQueryContext(res.LastBlockHeight, false)
+	if err != nil {
+		return res
+	}
+	health := app.MintKeeper.Health(ctx)
+	data, err := json.Marshal(InfoData{Name: res.Data, Mint: &health})
+	if err != nil {
+		return res
+	}
+	res.Data = string(data)
+	return res
+}
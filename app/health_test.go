@@ -0,0 +1,46 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/app"
+	"github.com/ignite/modules/testutil"
+	minttypes "github.com/ignite/modules/x/mint/types"
+)
+
+func TestInfoMintHealth(t *testing.T) {
+	chainID := "health-chain-id"
+	testApp, genesisState := testutil.GenApp(chainID, true, 5)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	testApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+		ChainId:         chainID,
+	})
+
+	// the data is the name of the app until a block is committed
+	res := testApp.Info(abci.RequestInfo{})
+	require.Equal(t, testApp.Name(), res.Data)
+
+	header := tmproto.Header{ChainID: chainID, Height: 1, Time: time.Now().UTC()}
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	testApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	testApp.Commit()
+
+	res = testApp.Info(abci.RequestInfo{})
+	var data app.InfoData
+	require.NoError(t, json.Unmarshal([]byte(res.Data), &data))
+	require.Equal(t, testApp.Name(), data.Name)
+	require.NotNil(t, data.Mint)
+	require.Equal(t, minttypes.HealthStatusActive, data.Mint.Status)
+	require.EqualValues(t, 1, data.Mint.Height)
+	require.EqualValues(t, 1, data.Mint.LastMintHeight)
+}
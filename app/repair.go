@@ -0,0 +1,31 @@
+package app
+
+import (
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/ignite/modules/cmd"
+	mintkeeper "github.com/ignite/modules/x/mint/keeper"
+	minttypes "github.com/ignite/modules/x/mint/types"
+)
+
+// RepairMintStore loads the latest state of the application database and runs the repair of the
+// mint store on it. The repair runs on a branch of the state that is never committed, the
+// database is left unchanged.
+func RepairMintStore(db dbm.DB, homePath string, appOpts servertypes.AppOptions) (minttypes.RepairReport, error) {
+	app := New(
+		log.NewNopLogger(),
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		homePath,
+		0,
+		cmd.MakeEncodingConfig(ModuleBasics),
+		appOpts,
+	).(*App)
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	return mintkeeper.NewMigrator(app.MintKeeper).RepairStore(ctx)
+}
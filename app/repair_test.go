@@ -0,0 +1,44 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/app"
+	"github.com/ignite/modules/testutil"
+	minttypes "github.com/ignite/modules/x/mint/types"
+)
+
+func TestRepairMintStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	chainID := "repair-chain-id"
+	testApp, genesisState := testutil.GenAppWithDB(db, chainID, true, 5)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	testApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+		ChainId:         chainID,
+	})
+
+	// the summary of the committed state is deleted
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ctx.KVStore(testApp.GetKey(minttypes.StoreKey)).Delete(minttypes.SummaryKey)
+	testApp.Commit()
+
+	// the repair is reported but not committed, the repairs are reported again
+	for i := 0; i < 2; i++ {
+		report, err := app.RepairMintStore(db, t.TempDir(), simtestutil.EmptyAppOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []minttypes.StoreRepair{{
+			Record: minttypes.RepairedRecordSummary,
+			Detail: "rebuilt from the minter and the params",
+		}}, report.Repairs)
+	}
+}
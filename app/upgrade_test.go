@@ -0,0 +1,179 @@
+package app_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/app"
+	"github.com/ignite/modules/testutil"
+	"github.com/ignite/modules/testutil/upgrade"
+	mintkeeper "github.com/ignite/modules/x/mint/keeper"
+	minttypes "github.com/ignite/modules/x/mint/types"
+)
+
+var exportUpgradeFixture = flag.String(
+	"export-upgrade-fixture",
+	"",
+	"release whose mint state is exported to the upgrade fixture of its consensus version",
+)
+
+const (
+	upgradeChainID  = "upgrade-chain-id"
+	upgradeFixtures = "testdata/upgrades"
+)
+
+var upgradeStart = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// initUpgradeChain initializes a chain with the default genesis of the app
+func initUpgradeChain(t *testing.T) *app.App {
+	testApp, genesisState := testutil.GenApp(upgradeChainID, true, 5)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	testApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+		ChainId:         upgradeChainID,
+	})
+	return testApp
+}
+
+// runUpgradeBlock runs and commits the block at the height and returns the events of the begin
+// blocker
+func runUpgradeBlock(testApp *app.App, height int64) []abci.Event {
+	header := tmproto.Header{
+		ChainID: upgradeChainID,
+		Height:  height,
+		Time:    upgradeStart.Add(time.Duration(height) * 5 * time.Second),
+	}
+	res := testApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+	return res.Events
+}
+
+// TestExportUpgradeFixture exports the mint state of the chain of the release after 10 blocks to
+// the upgrade fixture of the consensus version of the module, see testdata/upgrades/README.md
+func TestExportUpgradeFixture(t *testing.T) {
+	if *exportUpgradeFixture == "" {
+		t.Skip("the release of the fixture to export is not set")
+	}
+	testApp := initUpgradeChain(t)
+	for height := int64(1); height <= 10; height++ {
+		runUpgradeBlock(testApp, height)
+	}
+
+	ctx := testApp.BaseApp.NewContext(true, tmproto.Header{Height: testApp.LastBlockHeight()})
+	version := testApp.ModuleManager().GetVersionMap()[minttypes.ModuleName]
+	f := upgrade.ExportFixture(
+		ctx,
+		*exportUpgradeFixture,
+		version,
+		testApp.GetKey(minttypes.StoreKey),
+		testApp.GetKey(paramstypes.StoreKey),
+		minttypes.ModuleName,
+	)
+	path := filepath.Join(upgradeFixtures, fmt.Sprintf("mint_v%d.json", version))
+	require.NoError(t, upgrade.WriteFixture(path, f))
+}
+
+// TestUpgradeFromFixtures upgrades a chain with the mint state written by each previous release to
+// the current release: the state of the fixture is imported at genesis, the registered migrations
+// are run by the upgrade handler of the first block and the current begin blocker is run for 100
+// blocks
+func TestUpgradeFromFixtures(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join(upgradeFixtures, "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		f, err := upgrade.ReadFixture(path)
+		require.NoError(t, err)
+
+		t.Run(f.Release, func(t *testing.T) {
+			testApp := initUpgradeChain(t)
+
+			// the chain runs the release of the fixture, an upgrade is planned at the first block
+			ctx := testApp.BaseApp.NewContext(false, tmproto.Header{ChainID: upgradeChainID})
+			require.NoError(t, f.Import(
+				ctx,
+				testApp.GetKey(minttypes.StoreKey),
+				testApp.GetKey(paramstypes.StoreKey),
+				minttypes.ModuleName,
+			))
+			fromVM := testApp.ModuleManager().GetVersionMap()
+			fromVM[minttypes.ModuleName] = f.ConsensusVersion
+			testApp.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+			plan := upgradetypes.Plan{Name: "simulated-upgrade", Height: 1}
+			require.NoError(t, testApp.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+			testApp.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+				return testApp.ModuleManager().RunMigrations(ctx, testApp.Configurator(), vm)
+			})
+
+			// the counters of the minter before the upgrade, zero for the releases before they were tracked
+			initial := testApp.MintKeeper.GetMinter(ctx)
+			minted := sdkmath.ZeroInt()
+			for height := int64(1); height <= 100; height++ {
+				// the state of the genesis is only committed by the first block
+				ctx := testApp.BaseApp.NewContext(height > 1, tmproto.Header{Height: testApp.LastBlockHeight()})
+				before := testApp.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+
+				var events []abci.Event
+				require.NotPanics(t, func() { events = runUpgradeBlock(testApp, height) }, "height %d", height)
+
+				// the supply increases by the minted coins of the block
+				ctx = testApp.BaseApp.NewContext(true, tmproto.Header{Height: testApp.LastBlockHeight()})
+				mint := mintEvent(t, events)
+				require.NotNil(t, mint, "height %d", height)
+				require.True(t, mint.Amount.IsPositive(), "height %d", height)
+				after := testApp.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+				require.Equal(t, before.Add(mint.Amount), after, "height %d", height)
+				minted = minted.Add(mint.Amount)
+			}
+
+			ctx = testApp.BaseApp.NewContext(true, tmproto.Header{Height: testApp.LastBlockHeight()})
+			version, found := testApp.MintKeeper.GetSchemaVersion(ctx)
+			require.True(t, found)
+			require.Equal(t, minttypes.SchemaVersion, version)
+			require.Equal(t, minttypes.SchemaVersion, testApp.UpgradeKeeper.GetModuleVersionMap(ctx)[minttypes.ModuleName])
+
+			// the counters track the coins minted by the current release
+			minter := testApp.MintKeeper.GetMinter(ctx)
+			require.Equal(t, initial.CumulativeMinted.Add(minted), minter.CumulativeMinted)
+			require.Equal(
+				t,
+				initial.CumulativeDistributed.Total().Add(minted),
+				minter.CumulativeDistributed.Total(),
+			)
+			msg, broken := mintkeeper.AllInvariants(testApp.MintKeeper)(ctx)
+			require.False(t, broken, msg)
+			require.NoError(t, testApp.MintKeeper.GetParams(ctx).Validate())
+		})
+	}
+}
+
+// mintEvent returns the EventMint of the events of a block
+func mintEvent(t *testing.T, events []abci.Event) *minttypes.EventMint {
+	for _, event := range events {
+		if event.Type != "modules.mint.EventMint" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		return parsed.(*minttypes.EventMint)
+	}
+	return nil
+}
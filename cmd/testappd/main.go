@@ -7,6 +7,7 @@ import (
 
 	"github.com/ignite/modules/app"
 	"github.com/ignite/modules/cmd"
+	mintcli "github.com/ignite/modules/x/mint/client/cli"
 )
 
 func main() {
@@ -17,6 +18,11 @@ func main() {
 		app.DefaultChainID,
 		app.ModuleBasics,
 		app.New,
+		cmd.AddSubCmd(
+			mintcli.GetCmdSetGenesisProfile(app.DefaultNodeHome),
+			mintcli.GetCmdSetGenesisFromSpec(app.DefaultNodeHome),
+			mintcli.GetCmdMint(app.DefaultNodeHome, app.RepairMintStore),
+		),
 	)
 	if err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome); err != nil {
 		os.Exit(1)
@@ -8,18 +8,19 @@ import (
 
 // Type Aliases to sdk errors module
 var (
-	SuccessABCICode    = sdkerrors.SuccessABCICode
-	ABCIInfo           = sdkerrors.ABCIInfo
-	UndefinedCodespace = sdkerrors.UndefinedCodespace
-	Register           = sdkerrors.Register
-	ABCIError          = sdkerrors.ABCIError
-	New                = sdkerrors.New
-	Wrap               = sdkerrors.Wrap
-	Wrapf              = sdkerrors.Wrapf
-	Recover            = sdkerrors.Recover
-	WithType           = sdkerrors.WithType
-	IsOf               = sdkerrors.IsOf
-	AssertNil          = sdkerrors.AssertNil
+	SuccessABCICode      = sdkerrors.SuccessABCICode
+	ABCIInfo             = sdkerrors.ABCIInfo
+	UndefinedCodespace   = sdkerrors.UndefinedCodespace
+	Register             = sdkerrors.Register
+	RegisterWithGRPCCode = sdkerrors.RegisterWithGRPCCode
+	ABCIError            = sdkerrors.ABCIError
+	New                  = sdkerrors.New
+	Wrap                 = sdkerrors.Wrap
+	Wrapf                = sdkerrors.Wrapf
+	Recover              = sdkerrors.Recover
+	WithType             = sdkerrors.WithType
+	IsOf                 = sdkerrors.IsOf
+	AssertNil            = sdkerrors.AssertNil
 )
 
 // Error type alias for errorsmod.Error
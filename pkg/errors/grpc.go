@@ -0,0 +1,32 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// paginationRequestErrors are the messages of the errors of query.Paginate rejecting the page
+// request, they are not typed errors
+var paginationRequestErrors = []string{
+	"either offset or key is expected",
+}
+
+// IterationError returns the gRPC error of an iteration over the store failed with the error.
+// An iteration stopped by a cancelled or expired request context fails with the Canceled or
+// DeadlineExceeded code, a page request rejected by the pagination with the InvalidArgument code,
+// any other failure with the Internal code.
+func IterationError(err error) error {
+	if stderrors.Is(err, context.Canceled) || stderrors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	for _, msg := range paginationRequestErrors {
+		if strings.Contains(err.Error(), msg) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,22 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+func TestIterationError(t *testing.T) {
+	require.Equal(t, codes.Canceled, status.Code(errors.IterationError(context.Canceled)))
+	require.Equal(t, codes.DeadlineExceeded, status.Code(errors.IterationError(fmt.Errorf("iterate: %w", context.DeadlineExceeded))))
+	require.Equal(t, codes.InvalidArgument, status.Code(errors.IterationError(
+		fmt.Errorf("invalid request, either offset or key is expected, got both"),
+	)))
+	require.Equal(t, codes.Internal, status.Code(errors.IterationError(errors.ErrIO)))
+}
@@ -23,9 +23,24 @@ import (
 	"github.com/ignite/modules/cmd"
 )
 
-func GenApp(chainID string, withGenesis bool, invCheckPeriod uint) (*testapp.App, testapp.GenesisState) {
+func GenApp(
+	chainID string,
+	withGenesis bool,
+	invCheckPeriod uint,
+	baseAppOptions ...func(*baseapp.BaseApp),
+) (*testapp.App, testapp.GenesisState) {
+	return GenAppWithDB(dbm.NewMemDB(), chainID, withGenesis, invCheckPeriod, baseAppOptions...)
+}
+
+// GenAppWithDB generates an app like GenApp storing its state in the database
+func GenAppWithDB(
+	db dbm.DB,
+	chainID string,
+	withGenesis bool,
+	invCheckPeriod uint,
+	baseAppOptions ...func(*baseapp.BaseApp),
+) (*testapp.App, testapp.GenesisState) {
 	var (
-		db     = dbm.NewMemDB()
 		encCdc = cmd.MakeEncodingConfig(testapp.ModuleBasics)
 		app    = testapp.New(
 			log.NewNopLogger(),
@@ -37,7 +52,7 @@ func GenApp(chainID string, withGenesis bool, invCheckPeriod uint) (*testapp.App
 			invCheckPeriod,
 			encCdc,
 			simtestutil.EmptyAppOptions{},
-			baseapp.SetChainID(chainID),
+			append([]func(*baseapp.BaseApp){baseapp.SetChainID(chainID)}, baseAppOptions...)...,
 		)
 	)
 	originalApp := app.(*testapp.App)
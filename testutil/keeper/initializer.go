@@ -25,6 +25,7 @@ import (
 	"github.com/ignite/modules/testutil/sample"
 	claimkeeper "github.com/ignite/modules/x/claim/keeper"
 	claimtypes "github.com/ignite/modules/x/claim/types"
+	mintkeeper "github.com/ignite/modules/x/mint/keeper"
 	minttypes "github.com/ignite/modules/x/mint/types"
 )
 
@@ -33,7 +34,7 @@ var moduleAccountPerms = map[string][]string{
 	distrtypes.ModuleName:          nil,
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-	minttypes.ModuleName:           {authtypes.Minter},
+	minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 	claimtypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
 }
 
@@ -202,3 +203,48 @@ func (i initializer) Claim(
 		bankKeeper,
 	)
 }
+
+func (i initializer) Mint(
+	paramKeeper paramskeeper.Keeper,
+	stakingKeeper minttypes.StakingKeeper,
+	accountKeeper authkeeper.AccountKeeper,
+	bankKeeper minttypes.BankKeeper,
+	distrKeeper minttypes.DistrKeeper,
+	useStoreService bool,
+	opts ...mintkeeper.KeeperOption,
+) mintkeeper.Keeper {
+	storeKey := sdk.NewKVStoreKey(minttypes.StoreKey)
+	i.StateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, i.DB)
+
+	paramKeeper.Subspace(minttypes.ModuleName)
+	subspace, _ := paramKeeper.GetSubspace(minttypes.ModuleName)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	if useStoreService {
+		return mintkeeper.NewKeeperWithStoreService(
+			i.Codec,
+			mintkeeper.NewKVStoreService(storeKey),
+			subspace,
+			stakingKeeper,
+			accountKeeper,
+			bankKeeper,
+			distrKeeper,
+			authtypes.FeeCollectorName,
+			authority,
+			opts...,
+		)
+	}
+
+	return mintkeeper.NewKeeper(
+		i.Codec,
+		storeKey,
+		subspace,
+		stakingKeeper,
+		accountKeeper,
+		bankKeeper,
+		distrKeeper,
+		authtypes.FeeCollectorName,
+		authority,
+		opts...,
+	)
+}
@@ -12,12 +12,15 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	claimkeeper "github.com/ignite/modules/x/claim/keeper"
 	claimtypes "github.com/ignite/modules/x/claim/types"
+	mintkeeper "github.com/ignite/modules/x/mint/keeper"
+	minttypes "github.com/ignite/modules/x/mint/types"
 )
 
 var (
@@ -31,21 +34,77 @@ var (
 // TestKeepers holds all keepers used during keeper tests for all modules
 type TestKeepers struct {
 	T             testing.TB
+	ParamsKeeper  paramskeeper.Keeper
 	AccountKeeper authkeeper.AccountKeeper
 	BankKeeper    bankkeeper.Keeper
 	DistrKeeper   distrkeeper.Keeper
 	StakingKeeper *stakingkeeper.Keeper
 	ClaimKeeper   *claimkeeper.Keeper
+	MintKeeper    mintkeeper.Keeper
 }
 
 // TestMsgServers holds all message servers used during keeper tests for all modules
 type TestMsgServers struct {
 	T        testing.TB
 	ClaimSrv claimtypes.MsgServer
+	MintSrv  minttypes.MsgServer
 }
 
 // NewTestSetup returns initialized instances of all the keepers and message servers of the modules
 func NewTestSetup(t testing.TB) (sdk.Context, TestKeepers, TestMsgServers) {
+	return newTestSetup(t, false, nil, nil, nil)
+}
+
+// NewTestSetupWithStoreService returns initialized instances of all the keepers and message servers of the modules
+// where the keepers supporting it access their store through a store service instead of a store key
+func NewTestSetupWithStoreService(t testing.TB) (sdk.Context, TestKeepers, TestMsgServers) {
+	return newTestSetup(t, true, nil, nil, nil)
+}
+
+// NewTestSetupWithMintKeeperOptions returns initialized instances of all the keepers and message servers of the modules
+// where the mint keeper is initialized with the provided options
+func NewTestSetupWithMintKeeperOptions(t testing.TB, opts ...mintkeeper.KeeperOption) (sdk.Context, TestKeepers, TestMsgServers) {
+	return newTestSetup(t, false, nil, nil, nil, opts...)
+}
+
+// NewTestSetupWithMintDistrKeeper returns initialized instances of all the keepers and message servers of the modules
+// where the distribution keeper used by the mint keeper is wrapped with the provided function, it allows to observe
+// the calls of the mint keeper to the distribution keeper
+func NewTestSetupWithMintDistrKeeper(
+	t testing.TB,
+	wrap func(minttypes.DistrKeeper) minttypes.DistrKeeper,
+) (sdk.Context, TestKeepers, TestMsgServers) {
+	return newTestSetup(t, false, wrap, nil, nil)
+}
+
+// NewTestSetupWithMintStakingKeeper returns initialized instances of all the keepers and message servers of the modules
+// where the staking keeper used by the mint keeper is wrapped with the provided function, it allows to alter the
+// values the mint keeper reads from the staking keeper
+func NewTestSetupWithMintStakingKeeper(
+	t testing.TB,
+	wrap func(minttypes.StakingKeeper) minttypes.StakingKeeper,
+) (sdk.Context, TestKeepers, TestMsgServers) {
+	return newTestSetup(t, false, nil, wrap, nil)
+}
+
+// NewTestSetupWithMintBankKeeper returns initialized instances of all the keepers and message servers of the modules
+// where the bank keeper used by the mint keeper is wrapped with the provided function, it allows to make the transfers
+// of the mint keeper fail
+func NewTestSetupWithMintBankKeeper(
+	t testing.TB,
+	wrap func(minttypes.BankKeeper) minttypes.BankKeeper,
+) (sdk.Context, TestKeepers, TestMsgServers) {
+	return newTestSetup(t, false, nil, nil, wrap)
+}
+
+func newTestSetup(
+	t testing.TB,
+	useStoreService bool,
+	wrapMintDistrKeeper func(minttypes.DistrKeeper) minttypes.DistrKeeper,
+	wrapMintStakingKeeper func(minttypes.StakingKeeper) minttypes.StakingKeeper,
+	wrapMintBankKeeper func(minttypes.BankKeeper) minttypes.BankKeeper,
+	mintKeeperOpts ...mintkeeper.KeeperOption,
+) (sdk.Context, TestKeepers, TestMsgServers) {
 	initializer := newInitializer()
 
 	paramKeeper := initializer.Param()
@@ -54,6 +113,19 @@ func NewTestSetup(t testing.TB) (sdk.Context, TestKeepers, TestMsgServers) {
 	stakingKeeper := initializer.Staking(authKeeper, bankKeeper)
 	distrKeeper := initializer.Distribution(authKeeper, bankKeeper, stakingKeeper)
 	claimKeeper := initializer.Claim(paramKeeper, authKeeper, distrKeeper, bankKeeper)
+	var mintDistrKeeper minttypes.DistrKeeper = distrKeeper
+	if wrapMintDistrKeeper != nil {
+		mintDistrKeeper = wrapMintDistrKeeper(distrKeeper)
+	}
+	var mintStakingKeeper minttypes.StakingKeeper = stakingKeeper
+	if wrapMintStakingKeeper != nil {
+		mintStakingKeeper = wrapMintStakingKeeper(stakingKeeper)
+	}
+	var mintBankKeeper minttypes.BankKeeper = bankKeeper
+	if wrapMintBankKeeper != nil {
+		mintBankKeeper = wrapMintBankKeeper(bankKeeper)
+	}
+	mintKeeper := initializer.Mint(paramKeeper, mintStakingKeeper, authKeeper, mintBankKeeper, mintDistrKeeper, useStoreService, mintKeeperOpts...)
 	require.NoError(t, initializer.StateStore.LoadLatestVersion())
 
 	// Create a context using a custom timestamp
@@ -71,18 +143,24 @@ func NewTestSetup(t testing.TB) (sdk.Context, TestKeepers, TestMsgServers) {
 	err = stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
 	require.NoError(t, err)
 	claimKeeper.SetParams(ctx, claimtypes.DefaultParams())
+	mintKeeper.SetParams(ctx, minttypes.DefaultParams())
+	mintKeeper.SetMinter(ctx, minttypes.DefaultInitialMinter())
 
 	claimSrv := claimkeeper.NewMsgServerImpl(*claimKeeper)
+	mintSrv := mintkeeper.NewMsgServerImpl(mintKeeper)
 
 	return ctx, TestKeepers{
 			T:             t,
+			ParamsKeeper:  paramKeeper,
 			AccountKeeper: authKeeper,
 			BankKeeper:    bankKeeper,
 			DistrKeeper:   distrKeeper,
 			StakingKeeper: stakingKeeper,
 			ClaimKeeper:   claimKeeper,
+			MintKeeper:    mintKeeper,
 		}, TestMsgServers{
 			T:        t,
 			ClaimSrv: claimSrv,
+			MintSrv:  mintSrv,
 		}
 }
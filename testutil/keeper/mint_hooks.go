@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	minttypes "github.com/ignite/modules/x/mint/types"
+)
+
+var _ minttypes.MintHooks = &MintHooksMock{}
+
+// MintHooksMock is a mint hooks implementation recording the coins minted for each block
+type MintHooksMock struct {
+	// MintedCoins are the minted coins received by the hooks by block height
+	MintedCoins map[int64]sdk.Coin
+
+	// Err is returned by the hooks if set
+	Err error
+}
+
+// NewMintHooksMock returns a new mint hooks mock
+func NewMintHooksMock() *MintHooksMock {
+	return &MintHooksMock{MintedCoins: make(map[int64]sdk.Coin)}
+}
+
+// AfterDistributeMintedCoin implements MintHooks
+func (h *MintHooksMock) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	h.MintedCoins[ctx.BlockHeight()] = mintedCoin
+	return h.Err
+}
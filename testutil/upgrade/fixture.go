@@ -0,0 +1,119 @@
+// Package upgrade provides fixtures of the state of a module written by a release to simulate
+// the upgrade of a chain to the current release.
+package upgrade
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pair is a key-value pair of a store, hex encoded
+type Pair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Fixture is the state of a module written by a release: the pairs of the store of the module
+// and of the subspace of the module in the params store
+type Fixture struct {
+	// Release is the release that wrote the state
+	Release string `json:"release"`
+	// ConsensusVersion is the consensus version of the module in the release
+	ConsensusVersion uint64 `json:"consensus_version"`
+	// Height is the height of the block the state was exported at
+	Height int64  `json:"height"`
+	Store  []Pair `json:"store"`
+	Params []Pair `json:"params"`
+}
+
+// ExportFixture exports the state of the module with the store key and the params subspace
+func ExportFixture(
+	ctx sdk.Context,
+	release string,
+	consensusVersion uint64,
+	storeKey, paramsKey storetypes.StoreKey,
+	subspace string,
+) Fixture {
+	return Fixture{
+		Release:          release,
+		ConsensusVersion: consensusVersion,
+		Height:           ctx.BlockHeight(),
+		Store:            exportPairs(ctx.KVStore(storeKey)),
+		Params:           exportPairs(prefix.NewStore(ctx.KVStore(paramsKey), subspacePrefix(subspace))),
+	}
+}
+
+// Import writes the state of the fixture in the store of the module and in the params subspace,
+// the existing state of the module is deleted
+func (f Fixture) Import(ctx sdk.Context, storeKey, paramsKey storetypes.StoreKey, subspace string) error {
+	if err := importPairs(ctx.KVStore(storeKey), f.Store); err != nil {
+		return err
+	}
+	return importPairs(prefix.NewStore(ctx.KVStore(paramsKey), subspacePrefix(subspace)), f.Params)
+}
+
+// WriteFixture writes the fixture to the JSON file
+func WriteFixture(path string, f Fixture) error {
+	bz, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bz, '\n'), 0o600)
+}
+
+// ReadFixture reads the fixture from the JSON file
+func ReadFixture(path string) (f Fixture, err error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Fixture{}, err
+	}
+	err = json.Unmarshal(bz, &f)
+	return f, err
+}
+
+// subspacePrefix returns the prefix of the params of a subspace in the params store
+func subspacePrefix(subspace string) []byte {
+	return []byte(subspace + "/")
+}
+
+func exportPairs(store storetypes.KVStore) (pairs []Pair) {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		pairs = append(pairs, Pair{
+			Key:   hex.EncodeToString(it.Key()),
+			Value: hex.EncodeToString(it.Value()),
+		})
+	}
+	return pairs
+}
+
+func importPairs(store storetypes.KVStore, pairs []Pair) error {
+	var keys [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, pair := range pairs {
+		key, err := hex.DecodeString(pair.Key)
+		if err != nil {
+			return err
+		}
+		value, err := hex.DecodeString(pair.Value)
+		if err != nil {
+			return err
+		}
+		store.Set(key, value)
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const flagNotary = "notary"
+
+// emissionReportFile is the file an emission report is written to, with the hash of the canonical
+// encoding of the report and the context to verify its records with store proofs. The report is
+// notarized with the signature of its canonical encoding by a key of the keyring.
+type emissionReportFile struct {
+	Report       json.RawMessage `json:"report"`
+	Hash         string          `json:"hash"`
+	ProofContext json.RawMessage `json:"proof_context"`
+	Notary       *reportNotary   `json:"notary,omitempty"`
+}
+
+// reportNotary is the signature of the canonical encoding of a report
+type reportNotary struct {
+	Address   string          `json:"address"`
+	PubKey    json.RawMessage `json:"pub_key"`
+	Signature []byte          `json:"signature"`
+}
+
+// newEmissionReportFile returns the file of the emission report of the query response, the
+// report is notarized if the name of the notary key is not empty
+func newEmissionReportFile(
+	cdc codec.Codec,
+	kr keyring.Keyring,
+	notary string,
+	res *types.QueryEmissionReportResponse,
+) (emissionReportFile, error) {
+	canonical, err := res.Report.CanonicalBytes()
+	if err != nil {
+		return emissionReportFile{}, err
+	}
+	if hash, err := res.Report.Hash(); err != nil {
+		return emissionReportFile{}, err
+	} else if !bytes.Equal(hash, res.Hash) {
+		return emissionReportFile{}, fmt.Errorf("report hash %X returned by the node is not the hash of the report %X", res.Hash, hash)
+	}
+	proofContext, err := cdc.MarshalJSON(&res.ProofContext)
+	if err != nil {
+		return emissionReportFile{}, err
+	}
+	file := emissionReportFile{
+		Report:       canonical,
+		Hash:         hex.EncodeToString(res.Hash),
+		ProofContext: proofContext,
+	}
+	if notary == "" {
+		return file, nil
+	}
+
+	if kr == nil {
+		return emissionReportFile{}, errors.New("no keyring to sign the report")
+	}
+	signature, pubKey, err := kr.Sign(notary, canonical)
+	if err != nil {
+		return emissionReportFile{}, err
+	}
+	pubKeyJSON, err := cdc.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return emissionReportFile{}, err
+	}
+	file.Notary = &reportNotary{
+		Address:   sdk.AccAddress(pubKey.Address()).String(),
+		PubKey:    pubKeyJSON,
+		Signature: signature,
+	}
+	return file, nil
+}
+
+// verifyEmissionReportFile checks the hash and the notary signature of the report of the file and
+// that the node assembles the same report from its state
+func verifyEmissionReportFile(
+	ctx context.Context,
+	cdc codec.Codec,
+	queryClient types.QueryClient,
+	file emissionReportFile,
+) (types.EmissionReport, error) {
+	var report types.EmissionReport
+	if err := cdc.UnmarshalJSON(file.Report, &report); err != nil {
+		return report, fmt.Errorf("invalid report: %w", err)
+	}
+	canonical, err := report.CanonicalBytes()
+	if err != nil {
+		return report, err
+	}
+	hash, err := report.Hash()
+	if err != nil {
+		return report, err
+	}
+	if file.Hash != hex.EncodeToString(hash) {
+		return report, fmt.Errorf("report hash %s is not the hash of the report %X", file.Hash, hash)
+	}
+
+	if file.Notary != nil {
+		var pubKey cryptotypes.PubKey
+		if err := cdc.UnmarshalInterfaceJSON(file.Notary.PubKey, &pubKey); err != nil {
+			return report, fmt.Errorf("invalid notary public key: %w", err)
+		}
+		if address := sdk.AccAddress(pubKey.Address()).String(); address != file.Notary.Address {
+			return report, fmt.Errorf("notary public key is the key of %s, not %s", address, file.Notary.Address)
+		}
+		if !pubKey.VerifySignature(canonical, file.Notary.Signature) {
+			return report, fmt.Errorf("invalid notary signature of %s", file.Notary.Address)
+		}
+	}
+
+	res, err := queryClient.EmissionReport(ctx, &types.QueryEmissionReportRequest{
+		FromHeight: report.FromHeight,
+		ToHeight:   report.ToHeight,
+	})
+	if err != nil {
+		return report, err
+	}
+	if !bytes.Equal(res.Hash, hash) {
+		return report, fmt.Errorf(
+			"report differs from the report of the node at height %d with hash %X",
+			res.ProofContext.Height, res.Hash,
+		)
+	}
+	return report, nil
+}
+
+// GetCmdQueryEmissionReport implements a command to write the emission report of a range of
+// heights.
+func GetCmdQueryEmissionReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-report [from-height] [to-height]",
+		Short: "Write the emission report of a range of heights",
+		Long: `Write the emissions of a range of heights assembled from the allocations recorded by the node,
+with the hash of the canonical encoding of the report and the context to verify the records with
+store proofs. The range must end at most at the current height. With --notary, the canonical
+encoding of the report is signed with the key of the keyring.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			notary, err := cmd.Flags().GetString(flagNotary)
+			if err != nil {
+				return err
+			}
+			path, err := cmd.Flags().GetString(flagFile)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EmissionReport(cmd.Context(), &types.QueryEmissionReportRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
+			if err != nil {
+				return err
+			}
+			file, err := newEmissionReportFile(clientCtx.Codec, clientCtx.Keyring, notary, res)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(file, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if path == "" {
+				return clientCtx.PrintString(string(bz) + "\n")
+			}
+			if err := os.WriteFile(path, bz, 0o600); err != nil {
+				return err
+			}
+			cmd.PrintErrf("emission report of heights %d to %d written to %s\n", fromHeight, toHeight, path)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagFile, "", "File the report is written to, standard output if empty")
+	cmd.Flags().String(flagNotary, "", "Name or address of the key of the keyring signing the report")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+
+	return cmd
+}
+
+// GetCmdQueryVerifyEmissionReport implements a command to verify an emission report written by
+// the emission-report command against the state of a node.
+func GetCmdQueryVerifyEmissionReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-emission-report [report-file]",
+		Short: "Verify an emission report against the state of a node",
+		Long: `Verify the hash and the notary signature of an emission report written by the emission-report
+command, and that the node assembles the same report from its state. The records of the range must
+not have been pruned by the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var file emissionReportFile
+			if err := json.Unmarshal(bz, &file); err != nil {
+				return fmt.Errorf("invalid report file: %w", err)
+			}
+
+			report, err := verifyEmissionReportFile(cmd.Context(), clientCtx.Codec, queryClient, file)
+			if err != nil {
+				return err
+			}
+			notarized := ""
+			if file.Notary != nil {
+				notarized = fmt.Sprintf(", notarized by %s", file.Notary.Address)
+			}
+			return clientCtx.PrintString(fmt.Sprintf(
+				"emission report of heights %d to %d verified%s\n",
+				report.FromHeight, report.ToHeight, notarized,
+			))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// reportQueryClient serves the emission report of the node
+type reportQueryClient struct {
+	types.QueryClient
+	report types.EmissionReport
+}
+
+func (c reportQueryClient) EmissionReport(
+	_ context.Context,
+	req *types.QueryEmissionReportRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryEmissionReportResponse, error) {
+	report := c.report
+	report.FromHeight, report.ToHeight = req.FromHeight, req.ToHeight
+	hash, err := report.Hash()
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEmissionReportResponse{
+		Report: report,
+		Hash:   hash,
+		ProofContext: types.EmissionReportProofContext{
+			Height:    200,
+			StoreName: types.StoreKey,
+			KeyPrefix: types.DistributionHistoryKeyPrefix,
+		},
+	}, nil
+}
+
+func TestEmissionReportFile(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	kr := keyring.NewInMemory(cdc)
+	_, _, err := kr.NewMnemonic("notary", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	report := types.NewEmissionReport(100, 150)
+	for height := int64(100); height <= 150; height++ {
+		report.Add(types.BlockDistribution{
+			Height: height,
+			Time:   time.Date(2023, 6, 1, 12, 0, int(height-100)*5, 0, time.UTC),
+			Minted: sdkmath.NewInt(1000),
+			Distributed: types.CategoryTotals{
+				Staking:         sdkmath.NewInt(700),
+				FundedAddresses: sdkmath.NewInt(200),
+				CommunityPool:   sdkmath.NewInt(100),
+			},
+		})
+	}
+	node := reportQueryClient{report: report}
+	res, err := node.EmissionReport(context.Background(), &types.QueryEmissionReportRequest{FromHeight: 100, ToHeight: 150})
+	require.NoError(t, err)
+
+	// writeFile writes the file of the report and reads it back
+	writeFile := func(t *testing.T, notary string) emissionReportFile {
+		file, err := newEmissionReportFile(cdc, kr, notary, res)
+		require.NoError(t, err)
+		bz, err := json.MarshalIndent(file, "", "  ")
+		require.NoError(t, err)
+		var read emissionReportFile
+		require.NoError(t, json.Unmarshal(bz, &read))
+		return read
+	}
+
+	t.Run("should verify a report against the node", func(t *testing.T) {
+		file := writeFile(t, "")
+		require.Nil(t, file.Notary)
+		verified, err := verifyEmissionReportFile(context.Background(), cdc, node, file)
+		require.NoError(t, err)
+		require.Equal(t, report, verified)
+	})
+
+	t.Run("should verify a notarized report", func(t *testing.T) {
+		file := writeFile(t, "notary")
+		require.NotNil(t, file.Notary)
+		key, err := kr.Key("notary")
+		require.NoError(t, err)
+		address, err := key.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, address.String(), file.Notary.Address)
+
+		_, err = verifyEmissionReportFile(context.Background(), cdc, node, file)
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a report changed after it was written", func(t *testing.T) {
+		file := writeFile(t, "")
+		changed := report
+		changed.Minted = changed.Minted.AddRaw(1)
+		file.Report, err = changed.CanonicalBytes()
+		require.NoError(t, err)
+
+		_, err = verifyEmissionReportFile(context.Background(), cdc, node, file)
+		require.ErrorContains(t, err, "is not the hash of the report")
+	})
+
+	t.Run("should reject an invalid notary signature", func(t *testing.T) {
+		file := writeFile(t, "notary")
+		file.Notary.Signature[0]++
+
+		_, err = verifyEmissionReportFile(context.Background(), cdc, node, file)
+		require.ErrorContains(t, err, "invalid notary signature")
+	})
+
+	t.Run("should reject a report differing from the state of the node", func(t *testing.T) {
+		file := writeFile(t, "notary")
+		changed := report
+		changed.Distributed.Dust = sdkmath.OneInt()
+
+		_, err = verifyEmissionReportFile(context.Background(), cdc, reportQueryClient{report: changed}, file)
+		require.ErrorContains(t, err, "report differs from the report of the node at height 200")
+	})
+
+	t.Run("should reject a hash not matching the report returned by the node", func(t *testing.T) {
+		tampered := *res
+		tampered.Hash = append([]byte{}, res.Hash...)
+		tampered.Hash[0]++
+
+		_, err := newEmissionReportFile(cdc, kr, "", &tampered)
+		require.ErrorContains(t, err, "is not the hash of the report")
+	})
+}
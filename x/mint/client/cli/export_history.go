@@ -0,0 +1,327 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+	flagFormat     = "format"
+	flagFile       = "file"
+	flagPageDelay  = "page-delay"
+	flagMaxRetries = "max-retries"
+
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+
+	// exportRetryBackoff is the delay before the first retry of a rate limited page request, the
+	// delay doubles with each retry
+	exportRetryBackoff = time.Second
+)
+
+// exportHistoryColumns are the columns of the exported distribution history
+var exportHistoryColumns = []string{
+	"height",
+	"time",
+	"inflation",
+	"minted",
+	types.CategoryStaking,
+	types.CategoryFundedAddresses,
+	types.CategoryCommunityPool,
+	types.CounterDust,
+}
+
+// historyWriter writes the allocations of the minted coins as flat records
+type historyWriter interface {
+	Write(distribution types.BlockDistribution) error
+	// Flush writes the buffered records to the underlying writer
+	Flush() error
+}
+
+// historyRecord returns the values of the columns of an allocation, the time and the inflation
+// of the allocations recorded before they were tracked are empty
+func historyRecord(distribution types.BlockDistribution) []string {
+	var blockTime, inflation string
+	if !distribution.Time.IsZero() {
+		blockTime = distribution.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if !distribution.Inflation.IsNil() {
+		inflation = distribution.Inflation.String()
+	}
+	return []string{
+		strconv.FormatInt(distribution.Height, 10),
+		blockTime,
+		inflation,
+		distribution.Minted.String(),
+		distribution.Distributed.Staking.String(),
+		distribution.Distributed.FundedAddresses.String(),
+		distribution.Distributed.CommunityPool.String(),
+		distribution.Distributed.Dust.String(),
+	}
+}
+
+// csvHistoryWriter writes the allocations as CSV rows after a header row
+type csvHistoryWriter struct {
+	w *csv.Writer
+}
+
+func newCSVHistoryWriter(w io.Writer) (*csvHistoryWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportHistoryColumns); err != nil {
+		return nil, err
+	}
+	return &csvHistoryWriter{w: cw}, nil
+}
+
+func (w *csvHistoryWriter) Write(distribution types.BlockDistribution) error {
+	return w.w.Write(historyRecord(distribution))
+}
+
+func (w *csvHistoryWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// jsonHistoryWriter writes the allocations as JSON lines, one object per allocation with the
+// columns as keys
+type jsonHistoryWriter struct {
+	w io.Writer
+}
+
+func newJSONHistoryWriter(w io.Writer) *jsonHistoryWriter {
+	return &jsonHistoryWriter{w: w}
+}
+
+func (w *jsonHistoryWriter) Write(distribution types.BlockDistribution) error {
+	// the fields follow the order of the columns
+	record := historyRecord(distribution)
+	fields := make([]string, len(record))
+	for i, column := range exportHistoryColumns {
+		key, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(record[i])
+		if err != nil {
+			return err
+		}
+		fields[i] = string(key) + ":" + string(value)
+	}
+	_, err := fmt.Fprintf(w.w, "{%s}\n", strings.Join(fields, ","))
+	return err
+}
+
+func (w *jsonHistoryWriter) Flush() error {
+	return nil
+}
+
+// validateExportFormat checks the format of the export is supported
+func validateExportFormat(format string) error {
+	if format != exportFormatCSV && format != exportFormatJSON {
+		return fmt.Errorf("unsupported format %q, expected %s or %s", format, exportFormatCSV, exportFormatJSON)
+	}
+	return nil
+}
+
+// newHistoryWriter returns the history writer of the format
+func newHistoryWriter(format string, w io.Writer) (historyWriter, error) {
+	if err := validateExportFormat(format); err != nil {
+		return nil, err
+	}
+	if format == exportFormatJSON {
+		return newJSONHistoryWriter(w), nil
+	}
+	return newCSVHistoryWriter(w)
+}
+
+// historyExporter pages through the distribution history and writes each page once received
+type historyExporter struct {
+	queryClient types.QueryClient
+	pageDelay   time.Duration
+	maxRetries  int
+	backoff     time.Duration
+}
+
+// export writes the allocations between the heights of the request and returns the number of
+// written allocations, the writer is flushed after each page so the history is never fully
+// buffered
+func (e historyExporter) export(ctx context.Context, req types.QueryDistributionHistoryRequest, w historyWriter) (int, error) {
+	var written int
+	for {
+		res, err := e.queryPage(ctx, &req)
+		if err != nil {
+			return written, err
+		}
+		for _, distribution := range res.Distributions {
+			if err := w.Write(distribution); err != nil {
+				return written, err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return written, err
+		}
+		written += len(res.Distributions)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return written, nil
+		}
+		var limit uint64
+		if req.Pagination != nil {
+			limit = req.Pagination.Limit
+		}
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: limit}
+		if err := sleep(ctx, e.pageDelay); err != nil {
+			return written, err
+		}
+	}
+}
+
+// queryPage queries a page of the distribution history, the requests rejected because of the rate
+// limits or the availability of the endpoint are retried with an exponential backoff
+func (e historyExporter) queryPage(
+	ctx context.Context,
+	req *types.QueryDistributionHistoryRequest,
+) (*types.QueryDistributionHistoryResponse, error) {
+	backoff := e.backoff
+	for retry := 0; ; retry++ {
+		res, err := e.queryClient.DistributionHistory(ctx, req)
+		if err == nil {
+			return res, nil
+		}
+		if code := status.Code(err); retry >= e.maxRetries || (code != codes.ResourceExhausted && code != codes.Unavailable) {
+			return nil, err
+		}
+		if err := sleep(ctx, backoff); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+// sleep waits for the duration unless the context is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// GetCmdQueryExportHistory implements a command to export the distribution history to a flat file.
+func GetCmdQueryExportHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-history",
+		Short: "Export the allocations of the minted coins of each block to a CSV or JSON lines file",
+		Long: `Export the allocations of the minted coins of each block recorded by the node, one row per block
+with the height, the time, the inflation rate, the minted amount and the amount distributed to each
+category. The history is queried page by page and each page is written to the file once received.
+The pages rejected because of the rate limits of the endpoint are retried with an exponential backoff.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if err := validateExportFormat(format); err != nil {
+				return err
+			}
+			file, err := cmd.Flags().GetString(flagFile)
+			if err != nil {
+				return err
+			}
+			pageDelay, err := cmd.Flags().GetDuration(flagPageDelay)
+			if err != nil {
+				return err
+			}
+			maxRetries, err := cmd.Flags().GetInt(flagMaxRetries)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if file != "" {
+				f, err := os.Create(file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			w, err := newHistoryWriter(format, out)
+			if err != nil {
+				return err
+			}
+
+			exporter := historyExporter{
+				queryClient: types.NewQueryClient(clientCtx),
+				pageDelay:   pageDelay,
+				maxRetries:  maxRetries,
+				backoff:     exportRetryBackoff,
+			}
+			written, err := exporter.export(cmd.Context(), types.QueryDistributionHistoryRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			}, w)
+			if err != nil {
+				return err
+			}
+			if file != "" {
+				cmd.PrintErrf("exported %d blocks to %s\n", written, file)
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	cmd.Flags().Int64(flagFromHeight, 0, "Lowest height of the exported blocks")
+	cmd.Flags().Int64(flagToHeight, 0, "Highest height of the exported blocks, no upper bound if zero")
+	cmd.Flags().String(flagFormat, exportFormatCSV, "Format of the export, csv or json (JSON lines)")
+	cmd.Flags().String(flagFile, "", "File the history is written to, standard output if empty")
+	cmd.Flags().Duration(flagPageDelay, 0, "Delay between the page requests")
+	cmd.Flags().Int(flagMaxRetries, 5, "Maximum number of retries of a rate limited page request")
+
+	return cmd
+}
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+// requireGolden compares the output with the golden file, the golden file is rewritten when the
+// tests are run with -update
+func requireGolden(t *testing.T, name string, output []byte) {
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(path, output, 0o600))
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(output))
+}
+
+// historyQueryClient serves the distribution history by pages of two allocations, the first
+// request of each page is rejected while failures remain
+type historyQueryClient struct {
+	types.QueryClient
+	distributions []types.BlockDistribution
+	failures      int
+	requests      []*types.QueryDistributionHistoryRequest
+}
+
+func (c *historyQueryClient) DistributionHistory(
+	_ context.Context,
+	req *types.QueryDistributionHistoryRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryDistributionHistoryResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.failures > 0 {
+		c.failures--
+		return nil, status.Error(codes.ResourceExhausted, "rate limited")
+	}
+
+	start := 0
+	if req.Pagination != nil && len(req.Pagination.Key) > 0 {
+		start = int(sdk.BigEndianToUint64(req.Pagination.Key))
+	}
+	end := start + 2
+	if end >= len(c.distributions) {
+		return &types.QueryDistributionHistoryResponse{
+			Distributions: c.distributions[start:],
+			Pagination:    &query.PageResponse{},
+		}, nil
+	}
+	return &types.QueryDistributionHistoryResponse{
+		Distributions: c.distributions[start:end],
+		Pagination:    &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(uint64(end))},
+	}, nil
+}
+
+// goldenDistributions returns the allocations of the golden files, the first allocation has
+// been recorded before the time and the inflation were tracked
+func goldenDistributions() []types.BlockDistribution {
+	distributions := []types.BlockDistribution{{
+		Height: 100,
+		Minted: sdkmath.NewInt(1000),
+		Distributed: types.CategoryTotals{
+			Staking:         sdkmath.NewInt(700),
+			FundedAddresses: sdkmath.NewInt(200),
+			CommunityPool:   sdkmath.NewInt(99),
+			Dust:            sdkmath.NewInt(1),
+		},
+	}}
+	for height := int64(101); height <= 104; height++ {
+		distributions = append(distributions, types.NewBlockDistribution(
+			height,
+			time.Date(2023, 6, 1, 12, 0, int(height-100)*5, 0, time.UTC),
+			sdk.NewDecWithPrec(13, 2),
+			sdkmath.NewInt(1000+height),
+			types.NewCategoryTotals(),
+			types.CategoryTotals{
+				Staking:         sdkmath.NewInt(700 + height),
+				FundedAddresses: sdkmath.NewInt(200),
+				CommunityPool:   sdkmath.NewInt(100),
+				Dust:            sdkmath.ZeroInt(),
+			},
+		))
+	}
+	return distributions
+}
+
+func TestExportHistory(t *testing.T) {
+	for _, tc := range []struct {
+		format string
+		golden string
+	}{
+		{format: exportFormatCSV, golden: "export_history.csv"},
+		{format: exportFormatJSON, golden: "export_history.jsonl"},
+	} {
+		tc := tc
+		t.Run("should export the history as "+tc.format, func(t *testing.T) {
+			queryClient := &historyQueryClient{distributions: goldenDistributions()}
+			var buf bytes.Buffer
+			w, err := newHistoryWriter(tc.format, &buf)
+			require.NoError(t, err)
+
+			exporter := historyExporter{queryClient: queryClient, maxRetries: 1}
+			written, err := exporter.export(context.Background(), types.QueryDistributionHistoryRequest{
+				FromHeight: 100,
+				ToHeight:   104,
+				Pagination: &query.PageRequest{Limit: 2},
+			}, w)
+			require.NoError(t, err)
+			require.Equal(t, 5, written)
+			require.Len(t, queryClient.requests, 3)
+			for _, req := range queryClient.requests {
+				require.EqualValues(t, 100, req.FromHeight)
+				require.EqualValues(t, 104, req.ToHeight)
+				require.EqualValues(t, 2, req.Pagination.Limit)
+			}
+			requireGolden(t, tc.golden, buf.Bytes())
+		})
+	}
+
+	t.Run("should write the header of an empty history", func(t *testing.T) {
+		var buf bytes.Buffer
+		w, err := newHistoryWriter(exportFormatCSV, &buf)
+		require.NoError(t, err)
+
+		exporter := historyExporter{queryClient: &historyQueryClient{}}
+		written, err := exporter.export(context.Background(), types.QueryDistributionHistoryRequest{}, w)
+		require.NoError(t, err)
+		require.Zero(t, written)
+		require.Equal(t, "height,time,inflation,minted,staking,funded_addresses,community_pool,dust\n", buf.String())
+	})
+
+	t.Run("should retry the rate limited pages", func(t *testing.T) {
+		queryClient := &historyQueryClient{distributions: goldenDistributions(), failures: 2}
+		var buf bytes.Buffer
+		w, err := newHistoryWriter(exportFormatCSV, &buf)
+		require.NoError(t, err)
+
+		exporter := historyExporter{queryClient: queryClient, maxRetries: 2, backoff: time.Millisecond}
+		written, err := exporter.export(context.Background(), types.QueryDistributionHistoryRequest{}, w)
+		require.NoError(t, err)
+		require.Equal(t, 5, written)
+		require.Len(t, queryClient.requests, 5)
+	})
+
+	t.Run("should fail once the retries are exhausted", func(t *testing.T) {
+		queryClient := &historyQueryClient{distributions: goldenDistributions(), failures: 3}
+		var buf bytes.Buffer
+		w, err := newHistoryWriter(exportFormatCSV, &buf)
+		require.NoError(t, err)
+
+		exporter := historyExporter{queryClient: queryClient, maxRetries: 2, backoff: time.Millisecond}
+		_, err = exporter.export(context.Background(), types.QueryDistributionHistoryRequest{}, w)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Len(t, queryClient.requests, 3)
+	})
+
+	t.Run("should prevent unsupported formats", func(t *testing.T) {
+		_, err := newHistoryWriter("parquet", &bytes.Buffer{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const flagProfile = "profile"
+
+// GetCmdSetGenesisProfile implements a command to set the params and the minter of the mint
+// genesis state from a registered profile.
+func GetCmdSetGenesisProfile(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-genesis",
+		Short: "Set the mint params and minter of genesis.json from a profile",
+		Long: fmt.Sprintf(`Set the params and the initial minter of the mint genesis state of genesis.json
+from the defaults of a registered profile. The built-in profiles are %s.`,
+			strings.Join(types.Profiles(), ", ")),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString(flagProfile)
+			if err != nil {
+				return err
+			}
+			mintGenState, err := types.DefaultGenesisForProfile(profile)
+			if err != nil {
+				return err
+			}
+			return writeMintGenesis(cmd, mintGenState)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagProfile, types.ProfileMainnet, "Profile of the mint genesis state")
+
+	return cmd
+}
+
+// GetCmdSetGenesisFromSpec implements a command to set the params and the minter of the mint
+// genesis state from a tokenomics spec.
+func GetCmdSetGenesisFromSpec(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-from-spec [spec-file]",
+		Short: "Set the mint params and minter of genesis.json from a tokenomics spec",
+		Long: `Set the params and the initial minter of the mint genesis state of genesis.json from a
+tokenomics spec: a JSON file with the rates and the shares as percentages and the block time as a
+duration. The lossy conversions of the spec into params are printed as warnings.`,
+		Example: `{
+  "mint_denom": "stake",
+  "initial_inflation": "10",
+  "max_inflation": "15",
+  "min_inflation": "5",
+  "block_time": "6s",
+  "staking_share": "70",
+  "funded_addresses_share": "0",
+  "community_pool_share": "30"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var spec types.TokenomicsSpec
+			if err := json.Unmarshal(bz, &spec); err != nil {
+				return fmt.Errorf("invalid tokenomics spec: %w", err)
+			}
+
+			params, warnings, err := types.ParamsFromTokenomics(spec)
+			for _, warning := range warnings {
+				cmd.PrintErrf("warning: %s\n", warning)
+			}
+			if err != nil {
+				return err
+			}
+			return writeMintGenesis(cmd, &types.GenesisState{
+				Minter: spec.InitialMinter(),
+				Params: params,
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// writeMintGenesis sets the mint genesis state of the genesis.json of the home of the command
+func writeMintGenesis(cmd *cobra.Command, mintGenState *types.GenesisState) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	cdc := clientCtx.Codec
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	config.SetRoot(clientCtx.HomeDir)
+
+	genFile := config.GenesisFile()
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	mintGenStateBz, err := cdc.MarshalJSON(mintGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal mint genesis state: %w", err)
+	}
+	appState[types.ModuleName] = mintGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
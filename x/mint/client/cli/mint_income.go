@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const flagClaim = "claim"
+
+// MintIncomeClaim is the amount of minted coins an address claims to have received from the
+// distributions of a range of heights
+type MintIncomeClaim struct {
+	Address    string
+	FromHeight int64
+	ToHeight   int64
+	Amount     sdk.Coins
+}
+
+// VerifyMintIncomeClaim checks the amount of a claim against the mint income of the address of
+// the claim returned by the AddressMintIncome query for the range of the claim. If the
+// distributions streamed by StreamDistributions or an indexer are not nil, the income is also
+// summed from the streamed distributions of the range, which must match the response.
+func VerifyMintIncomeClaim(
+	claim MintIncomeClaim,
+	res types.QueryAddressMintIncomeResponse,
+	streamed []types.BlockDistribution,
+) error {
+	if streamed != nil {
+		income, recordedBlocks, err := types.SumAddressIncome(claim.Address, claim.FromHeight, claim.ToHeight, streamed)
+		if err != nil {
+			return fmt.Errorf("invalid streamed distributions: %w", err)
+		}
+		if recordedBlocks != res.RecordedBlocks {
+			return fmt.Errorf(
+				"%d distributions streamed for heights %d to %d, the node recorded %d",
+				recordedBlocks, claim.FromHeight, claim.ToHeight, res.RecordedBlocks,
+			)
+		}
+		if !income.IsEqual(res.Amount) {
+			return fmt.Errorf("streamed distributions sum to %s, the node returned %s", income, res.Amount)
+		}
+	}
+	if !claim.Amount.IsEqual(res.Amount) {
+		return fmt.Errorf(
+			"%s claims %s from heights %d to %d, received %s",
+			claim.Address, claim.Amount, claim.FromHeight, claim.ToHeight, res.Amount,
+		)
+	}
+	return nil
+}
+
+// GetCmdQueryAddressMintIncome implements a command to return the minted coins distributed to a
+// funded address in a range of heights.
+func GetCmdQueryAddressMintIncome() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-mint-income [address] [from-height] [to-height]",
+		Short: "Query the minted coins distributed to a funded address in a range of heights",
+		Long: `Query the shares of the minted coins distributed to a funded address in a range of heights from
+the allocations recorded by the node, and the income of the address since it is tracked. The range
+must end at most at the current height and start in the retention of the distribution history.
+With --claim, the amount claimed by the address is verified against the response.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			claimed, err := cmd.Flags().GetString(flagClaim)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AddressMintIncome(cmd.Context(), &types.QueryAddressMintIncomeRequest{
+				Address:    args[0],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
+			if err != nil {
+				return err
+			}
+			if claimed == "" {
+				return clientCtx.PrintProto(res)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(claimed)
+			if err != nil {
+				return err
+			}
+			claim := MintIncomeClaim{Address: args[0], FromHeight: fromHeight, ToHeight: toHeight, Amount: amount}
+			if err := VerifyMintIncomeClaim(claim, *res, nil); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf(
+				"claim of %s from heights %d to %d verified\n",
+				amount, fromHeight, toHeight,
+			))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagClaim, "", "Amount claimed by the address, verified against the response")
+
+	return cmd
+}
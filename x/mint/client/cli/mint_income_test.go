@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestVerifyMintIncomeClaim(t *testing.T) {
+	const address = "cosmos1ezptsm3npn54qx9vvpah4nymre59ykr9967vj9"
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	streamed := func(amounts ...int64) []types.BlockDistribution {
+		var distributions []types.BlockDistribution
+		for i, amount := range amounts {
+			distributed := types.NewCategoryTotals()
+			distributed.FundedAddresses = sdkmath.NewInt(amount)
+			distributions = append(distributions, types.BlockDistribution{
+				Height:          int64(10 + i),
+				Distributed:     distributed,
+				FundedAddresses: []types.FundedAddressDistribution{{Address: address, Amount: stake(amount)}},
+			})
+		}
+		return distributions
+	}
+	claim := MintIncomeClaim{Address: address, FromHeight: 10, ToHeight: 12, Amount: stake(30)}
+	res := types.QueryAddressMintIncomeResponse{Amount: stake(30), RecordedBlocks: 3}
+
+	tests := []struct {
+		name     string
+		claim    MintIncomeClaim
+		streamed []types.BlockDistribution
+		err      string
+	}{
+		{
+			name:  "should verify a claim against the response",
+			claim: claim,
+		},
+		{
+			name:     "should verify a claim against the response and the streamed distributions",
+			claim:    claim,
+			streamed: streamed(10, 10, 10, 10),
+		},
+		{
+			name:  "should reject a claim above the response",
+			claim: MintIncomeClaim{Address: address, FromHeight: 10, ToHeight: 12, Amount: stake(31)},
+			err:   "claims 31stake from heights 10 to 12, received 30stake",
+		},
+		{
+			name:     "should reject a response differing from the streamed distributions",
+			claim:    claim,
+			streamed: streamed(10, 10, 11),
+			err:      "streamed distributions sum to 31stake, the node returned 30stake",
+		},
+		{
+			name:     "should reject a response missing streamed distributions",
+			claim:    claim,
+			streamed: streamed(10, 10, 10)[:2],
+			err:      "2 distributions streamed for heights 10 to 12, the node recorded 3",
+		},
+		{
+			name:     "should reject invalid streamed distributions",
+			claim:    claim,
+			streamed: append(streamed(10, 10, 10), streamed(10)...),
+			err:      "invalid streamed distributions",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyMintIncomeClaim(tc.claim, res, tc.streamed)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
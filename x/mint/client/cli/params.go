@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const outputFormatJSON = "json"
+
+// verboseParams are the params of the module followed by the values derived from them
+type verboseParams struct {
+	Params  json.RawMessage `json:"params"`
+	Derived json.RawMessage `json:"derived"`
+}
+
+// verboseParamsOutput returns the canonical params and the values derived from them in the
+// output format, JSON for the json output format and YAML otherwise
+func verboseParamsOutput(res *types.QueryParamsResponse, outputFormat string) ([]byte, error) {
+	params, err := res.Params.MarshalCanonicalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if res.Derived == nil {
+		return nil, errors.New("the node doesn't return the values derived from the params")
+	}
+	derivedBz, err := res.Derived.MarshalCanonicalJSON()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(verboseParams{Params: params, Derived: derivedBz})
+	if err != nil {
+		return nil, err
+	}
+
+	if outputFormat != outputFormatJSON {
+		return yaml.JSONToYAML(bz)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, bz, "", "  "); err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
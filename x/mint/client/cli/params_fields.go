@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// printProtoJSON prints the response in the proto-JSON encoding of the client codec whatever the
+// output format, so the output of the params fields queries is machine-readable
+func printProtoJSON(clientCtx client.Context, res proto.Message) error {
+	bz, err := clientCtx.Codec.MarshalJSON(res)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(append(bz, '\n'))
+}
+
+// GetCmdQueryMintDenom implements a command to return the mint_denom param.
+func GetCmdQueryMintDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-denom",
+		Short: "Query the mint_denom param, in proto-JSON",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintDenom(cmd.Context(), &types.QueryMintDenomRequest{})
+			if err != nil {
+				return err
+			}
+
+			return printProtoJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDistributionProportions implements a command to return the
+// distribution_proportions param.
+func GetCmdQueryDistributionProportions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-proportions",
+		Short: "Query the distribution_proportions param, in proto-JSON",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionProportions(cmd.Context(), &types.QueryDistributionProportionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return printProtoJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFundedAddresses implements a command to return a page of the funded_addresses param.
+func GetCmdQueryFundedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funded-addresses",
+		Short: "Query the funded_addresses param, in proto-JSON",
+		Long: `Query the funded_addresses param in the order of the params, in proto-JSON. At most 100 funded
+addresses are returned per page. The next_key of a page is the base64 encoding of the address of
+the first funded address of the next page, pass the decoded address with --page-key.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.FundedAddresses(cmd.Context(), &types.QueryFundedAddressesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return printProtoJSON(clientCtx, res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// derivedParamsFixture returns the params response of a chain with a phase active, a pending goal
+// bonded transition, a funded address out of its funding window, the staking share paused, the
+// max supply set and minting auto paused
+func derivedParamsFixture() *types.QueryParamsResponse {
+	const height = 200
+	params := types.DefaultParams()
+	params.Phases = []types.Phase{
+		{
+			Name:         "growth",
+			StartHeight:  100,
+			InflationMin: sdk.NewDecWithPrec(5, 2),
+			InflationMax: sdk.NewDecWithPrec(15, 2),
+			GoalBonded:   sdk.NewDecWithPrec(60, 2),
+		},
+		{
+			Name:         "maturity",
+			StartHeight:  500,
+			InflationMin: sdk.NewDecWithPrec(2, 2),
+			InflationMax: sdk.NewDecWithPrec(8, 2),
+			GoalBonded:   sdk.NewDecWithPrec(67, 2),
+		},
+	}
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(5, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(4, 1),
+		CommunityPool:    sdk.NewDecWithPrec(1, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: "cosmos1ezptsm3npn54qx9vvpah4nymre59ykr9967vj9", Weight: sdk.NewDecWithPrec(3, 1)},
+		{Address: "cosmos1aqn8ynvr3jmq67879qulzrwhchq5dtrvh6h4er", Weight: sdk.NewDecWithPrec(7, 1), EndHeight: 150},
+	}
+	params.PauseStakingShare = true
+	params.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+	params.MaxSupply = sdkmath.NewInt(2_000_000)
+	params.MinBondedRatio = sdk.NewDecWithPrec(5, 1)
+
+	minter := types.DefaultInitialMinter()
+	minter.AutoPaused = true
+	minter.GoalBondedTransition = &types.GoalBondedTransition{
+		From:        sdk.NewDecWithPrec(50, 2),
+		To:          sdk.NewDecWithPrec(60, 2),
+		StartHeight: 150,
+		EndHeight:   250,
+	}
+
+	derived := types.NewDerivedParams(minter, params, height, sdkmath.NewInt(1_500_000), sdk.NewDecWithPrec(4, 1))
+	active, _ := params.ActivePhase(height)
+	return &types.QueryParamsResponse{Params: params, ActivePhase: &active, Derived: &derived}
+}
+
+func TestVerboseParamsOutput(t *testing.T) {
+	res := derivedParamsFixture()
+
+	t.Run("should append the derived values to the params in JSON", func(t *testing.T) {
+		bz, err := verboseParamsOutput(res, "json")
+		require.NoError(t, err)
+		requireGolden(t, "params_verbose.json", bz)
+
+		var output struct {
+			Params  map[string]json.RawMessage `json:"params"`
+			Derived map[string]json.RawMessage `json:"derived"`
+		}
+		require.NoError(t, json.Unmarshal(bz, &output))
+		require.JSONEq(t, `{
+			"staking":"0.000000000000000000",
+			"funded_addresses":"0.120000000000000000",
+			"community_pool":"0.380000000000000000",
+			"strategic_reserve":"0.000000000000000000"
+		}`, string(output.Derived["effective_proportions"]))
+		require.JSONEq(t, `"0.500000000000000000"`, string(output.Derived["buffered_proportion"]))
+		require.JSONEq(t, `"0.550000000000000000"`, string(output.Derived["goal_bonded"]))
+		require.JSONEq(t, `"0.750000000000000000"`, string(output.Derived["max_supply_utilization"]))
+		require.JSONEq(t, `["cosmos1aqn8ynvr3jmq67879qulzrwhchq5dtrvh6h4er"]`, string(output.Derived["funded_addresses_out_of_window"]))
+	})
+
+	t.Run("should print the params and the derived values in YAML", func(t *testing.T) {
+		jsonBz, err := verboseParamsOutput(res, "json")
+		require.NoError(t, err)
+		expected, err := yaml.JSONToYAML(jsonBz)
+		require.NoError(t, err)
+
+		for _, format := range []string{"text", "yaml"} {
+			bz, err := verboseParamsOutput(res, format)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(bz))
+		}
+		bz, err := verboseParamsOutput(res, "text")
+		require.NoError(t, err)
+		requireGolden(t, "params_verbose.yaml", bz)
+	})
+
+	t.Run("should fail without derived values", func(t *testing.T) {
+		_, err := verboseParamsOutput(&types.QueryParamsResponse{Params: res.Params}, "json")
+		require.Error(t, err)
+	})
+}
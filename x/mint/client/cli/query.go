@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/modules/x/mint/types"
 )
 
+const (
+	flagDescribe = "describe"
+	flagVerbose  = "verbose"
+)
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -24,18 +34,54 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryMinter(),
+		GetCmdQueryAdminCapabilities(),
+		GetCmdQueryFundedAddressHistory(),
+		GetCmdQueryParamsImpact(),
+		GetCmdQueryStatus(),
+		GetCmdQueryDelegatorAPR(),
+		GetCmdQueryModuleAccount(),
+		GetCmdQueryEmissionDrift(),
+		GetCmdQueryFeeAdvisory(),
+		GetCmdQueryExportHistory(),
+		GetCmdQueryAverageInflation(),
+		GetCmdQueryEmissionReport(),
+		GetCmdQueryVerifyEmissionReport(),
+		GetCmdQueryEstimatedDistribution(),
+		GetCmdQueryAddressMintIncome(),
+		GetCmdQueryTotalBurned(),
+		GetCmdQueryTotalMinted(),
+		GetCmdQueryInflationHistory(),
+		GetCmdQueryStrategicReserve(),
+		GetCmdQueryUpcomingProvisions(),
+		GetCmdQueryAnnualFundedProvisions(),
+		GetCmdQueryModuleVersion(),
+		GetCmdQueryStateProof(),
+		GetCmdQueryMintDenom(),
+		GetCmdQueryDistributionProportions(),
+		GetCmdQueryFundedAddresses(),
 	)
 
 	return mintingQueryCmd
 }
 
 // GetCmdQueryParams implements a command to return the current minting
-// parameters.
+// parameters, or their descriptors with the describe flag.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
 		Short: "Query the current minting parameters",
-		Args:  cobra.NoArgs,
+		Long: `Query the current minting parameters.
+
+With --describe, the name, param key, type, current value, default value and bounds of every
+parameter are shown instead.
+
+With --verbose, the values derived from the parameters at the current height are appended: the
+effective distribution proportions, the inflation bounds and goal bonded of the active phase, the
+next phase, the pending goal bonded transition, the bootstrap override, the funded addresses out of
+their funding window, the utilization of the max supply and the pause flags. The output is YAML
+with --output text or yaml, and JSON with --output json.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -43,16 +89,49 @@ func GetCmdQueryParams() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryParamsRequest{}
+			verbose, err := cmd.Flags().GetBool(flagVerbose)
+			if err != nil {
+				return err
+			}
+			params := &types.QueryParamsRequest{Verbose: verbose}
 			res, err := queryClient.Params(cmd.Context(), params)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			describe, err := cmd.Flags().GetBool(flagDescribe)
+			if err != nil {
+				return err
+			}
+			if describe {
+				descriptors, err := res.Params.Describe()
+				if err != nil {
+					return err
+				}
+				bz, err := json.Marshal(descriptors)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(bz)
+			}
+			if verbose {
+				bz, err := verboseParamsOutput(res, clientCtx.OutputFormat)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(bz)
+			}
+
+			bz, err := res.Params.MarshalCanonicalJSON()
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
 		},
 	}
 
+	cmd.Flags().Bool(flagDescribe, false, "Show the descriptors of the parameters")
+	cmd.Flags().Bool(flagVerbose, false, "Append the values derived from the parameters at the current height")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -115,3 +194,613 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryMinter implements a command to return the current minter state.
+func GetCmdQueryMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter",
+		Short: "Query the current minter state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMinterRequest{}
+			res, err := queryClient.Minter(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			bz, err := res.Minter.MarshalCanonicalJSON()
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAdminCapabilities implements a command to return the messages executable by
+// the module authority and whether they are currently available.
+func GetCmdQueryAdminCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-capabilities",
+		Short: "Query the messages executable by the module authority and their availability",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAdminCapabilitiesRequest{}
+			res, err := queryClient.AdminCapabilities(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFundedAddressHistory implements a command to return the recorded weight
+// changes of a funded address.
+func GetCmdQueryFundedAddressHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funded-address-history [address]",
+		Short: "Query the recorded weight changes of a funded address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryFundedAddressHistoryRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.FundedAddressHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetCmdQueryParamsImpact implements a command to return the emissions of the first year
+// under the current params and under the params proposed in a file.
+func GetCmdQueryParamsImpact() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-impact [params-file]",
+		Short: "Query the first year emissions impact of proposed minting parameters",
+		Long: `Query the emissions of the first year under the current minting parameters and under the
+parameters proposed in a JSON file, with the current bonded ratio. All the parameters must be provided.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := types.ValidateJSON(bz); err != nil {
+				return err
+			}
+			var proposedParams types.Params
+			if err := types.ModuleCdc.UnmarshalJSON(bz, &proposedParams); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryParamsImpactRequest{ProposedParams: proposedParams}
+			res, err := queryClient.ParamsImpact(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStatus implements a command to return the pause state of the module and the
+// consistency of the supplies of the mint denom.
+func GetCmdQueryStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Query the pause state of the module and the consistency of the mint denom supplies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryStatusRequest{}
+			res, err := queryClient.Status(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegatorAPR implements a command to return the estimated annual rate of the
+// staking rewards minted for the delegators of a validator.
+func GetCmdQueryDelegatorAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-apr [validator-address]",
+		Short: "Query the estimated APR of the delegators of a validator, net of the community tax and the commission",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDelegatorAPRRequest{ValidatorAddress: args[0]}
+			res, err := queryClient.DelegatorAPR(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryModuleAccount implements a command to return the balance of the module account
+// broken down by ledger entry.
+func GetCmdQueryModuleAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-account",
+		Short: "Query the balance of the module account broken down by ledger entry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryModuleAccountRequest{}
+			res, err := queryClient.ModuleAccount(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEmissionDrift implements a command to return the drift of the realized emissions
+// from the target emissions.
+func GetCmdQueryEmissionDrift() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-drift",
+		Short: "Query the drift of the realized emissions from the target emissions of the schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEmissionDriftRequest{}
+			res, err := queryClient.EmissionDrift(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEstimatedDistribution implements a command to return the amounts the block provision
+// is distributed in.
+func GetCmdQueryEstimatedDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimated-distribution",
+		Short: "Query the amounts the block provision is distributed in to the fee collector, the community pool and each funded address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEstimatedDistributionRequest{}
+			res, err := queryClient.EstimatedDistribution(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFeeAdvisory implements a command to return the advisory minimum gas price for the
+// fees to cover a target ratio of the staking share of the block provision.
+func GetCmdQueryFeeAdvisory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-advisory [target-fee-coverage-ratio] [average-block-gas]",
+		Short: "Query an advisory minimum gas price for the fees to cover a ratio of the staking provision",
+		Long: `Query an advisory minimum gas price, in the mint denom, for the fees of a block using the average
+block gas to cover the target ratio of the staking share of the block provision. The price is advisory,
+to inform the configuration of the validators, and is not used by consensus.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ratio, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			blockGas, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryFeeAdvisoryRequest{
+				TargetFeeCoverageRatio: ratio,
+				AverageBlockGas:        blockGas,
+			}
+			res, err := queryClient.FeeAdvisory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAverageInflation implements a command to return the time-weighted mean of the
+// recorded inflation rates over a period.
+func GetCmdQueryAverageInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "average-inflation [from-time] [to-time]",
+		Short: "Query the time-weighted average inflation rate over a period",
+		Long: `Query the mean of the recorded inflation rates weighted by the time each rate applied between
+two RFC 3339 times, and the fraction of the period covered by the recorded blocks. The pruned and
+missing records leave the rest of the period uncovered.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromTime, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+			toTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAverageInflationRequest{FromTime: fromTime, ToTime: toTime}
+			res, err := queryClient.AverageInflation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalBurned implements a command to return the total amount of each denom burned with
+// MsgBurn.
+func GetCmdQueryTotalBurned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-burned",
+		Short: "Query the total amount of each denom burned with the burn message",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTotalBurnedRequest{}
+			res, err := queryClient.TotalBurned(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalMinted implements a command to return the total amount of each denom minted by
+// the module.
+func GetCmdQueryTotalMinted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-minted",
+		Short: "Query the total amount of each denom minted by the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTotalMintedRequest{}
+			res, err := queryClient.TotalMinted(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInflationHistory implements a command to return the snapshots of the inflation
+// recorded in a range of heights.
+func GetCmdQueryInflationHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-history [from-height] [to-height]",
+		Short: "Query the snapshots of the inflation recorded in a range of heights",
+		Long: `Query the snapshots of the inflation, the annual provisions and the bonded ratio recorded every
+inflation_snapshot_interval blocks between two heights, included. The range has no upper bound if
+to-height is zero. The snapshots older than inflation_snapshot_retention blocks are pruned.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryInflationHistoryRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.InflationHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetCmdQueryStrategicReserve implements a command to return the balance of the strategic reserve.
+func GetCmdQueryStrategicReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "strategic-reserve",
+		Short: "Query the balance of the strategic reserve and the coins accrued in and released from it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryStrategicReserveRequest{}
+			res, err := queryClient.StrategicReserve(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpcomingProvisions implements a command to return the provisions of the next blocks
+// simulated from the current state.
+func GetCmdQueryUpcomingProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-provisions [count]",
+		Short: "Query the provisions of the next blocks simulated from the current state",
+		Long: fmt.Sprintf(`Query the provisions of the next count blocks, at most %d, simulated from the minter, the
+current bonded ratio and the schedule of the params: the phases, the drift correction, the carry
+buffer and the max supply. The height of the first block where the inflation bounds or the max
+supply bind is returned, zero if they don't bind.`, types.MaxUpcomingProvisions),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			count, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid count: %w", err)
+			}
+
+			params := &types.QueryUpcomingProvisionsRequest{Count: count}
+			res, err := queryClient.UpcomingProvisions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAnnualFundedProvisions implements a command to return the coins projected to be
+// distributed to the funded addresses over a year.
+func GetCmdQueryAnnualFundedProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-funded-provisions [address]",
+		Short: "Query the coins projected to be distributed to the funded addresses over a year",
+		Long: `Query the coins projected to be distributed over a year to a funded address, or to all the
+funded addresses if the address is omitted, at the current annual provisions and params: the annual
+provisions times the funded addresses proportion times the weight of the address.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAnnualFundedProvisionsRequest{}
+			if len(args) > 0 {
+				params.Address = args[0]
+			}
+			res, err := queryClient.AnnualFundedProvisions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryModuleVersion implements a command to return the consensus version and the behavior
+// revision of the module, and the migrations completed on the chain.
+func GetCmdQueryModuleVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-version",
+		Short: "Query the module version and the migrations completed on the chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryModuleVersionRequest{}
+			res, err := queryClient.ModuleVersion(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
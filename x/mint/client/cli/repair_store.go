@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// applicationDBName is the name of the application database in the data directory of the node
+const applicationDBName = "application"
+
+// StoreRepairer runs the repair of the mint store on the latest state of an application database
+type StoreRepairer func(db dbm.DB, homePath string, appOpts servertypes.AppOptions) (types.RepairReport, error)
+
+// openApplicationDB opens the application database of the node home, the database is locked by
+// a running node so the database of a live node cannot be opened
+func openApplicationDB(homePath string, backend dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(homePath, "data")
+	if _, err := os.Stat(filepath.Join(dataDir, applicationDBName+".db")); err != nil {
+		return nil, fmt.Errorf("no application database found in %s: %w", dataDir, err)
+	}
+	db, err := dbm.NewDB(applicationDBName, backend, dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open the application database, the node must be stopped: %w", err)
+	}
+	return db, nil
+}
+
+// GetCmdMint implements the root command of the offline mint commands operating on the node data.
+func GetCmdMint(defaultNodeHome string, repair StoreRepairer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Offline commands of the mint module operating on the node data",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(GetCmdRepairStore(defaultNodeHome, repair))
+
+	return cmd
+}
+
+// GetCmdRepairStore implements a command to check the derived records of the mint store of a
+// stopped node.
+func GetCmdRepairStore(defaultNodeHome string, repair StoreRepairer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair-store",
+		Short: "Re-derive the derived records of the mint store of a stopped node and report the repairs",
+		Long: `Load the latest state of the application database of a stopped node and re-derive the derived
+records of the mint store from its authoritative records, the params, the minter and the balance
+of the module account: the schema version, the unset fields of the minter, the dust entry of the
+ledger and the summary. The repaired records are reported, the repair runs on a branch of the
+state that is never committed as writing a state outside of a block would break the app hash of
+the node. A store with repairs is fixed on chain by running the same repair, the RepairStore
+method of the mint migrator, from an upgrade handler. The repair is idempotent.
+
+The command refuses to run while the node is running.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			homePath, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+
+			db, err := openApplicationDB(homePath, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			report, err := repair(db, homePath, serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			cmd.Print(report.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
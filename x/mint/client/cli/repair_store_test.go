@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenApplicationDB(t *testing.T) {
+	t.Run("should prevent opening a missing database", func(t *testing.T) {
+		_, err := openApplicationDB(t.TempDir(), dbm.GoLevelDBBackend)
+		require.ErrorContains(t, err, "no application database found")
+	})
+
+	t.Run("should prevent opening the database of a running node", func(t *testing.T) {
+		home := t.TempDir()
+		// the database held open by the node is locked
+		db, err := dbm.NewDB(applicationDBName, dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+		require.NoError(t, err)
+
+		_, err = openApplicationDB(home, dbm.GoLevelDBBackend)
+		require.ErrorContains(t, err, "the node must be stopped")
+
+		require.NoError(t, db.Close())
+		db, err = openApplicationDB(home, dbm.GoLevelDBBackend)
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+	})
+}
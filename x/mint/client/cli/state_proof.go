@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const flagVerify = "verify"
+
+// VerifyStateProof checks the commitment proof of a state proof against the app hash of the
+// header of the block following the height of the proof, which commits the state of the height.
+// A proof with an empty value proves the absence of the key.
+func VerifyStateProof(proof types.StateProof, appHash []byte) error {
+	if proof.Proof == nil {
+		return errors.New("the state proof has no proof")
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(proof.StoreName), merkle.KeyEncodingURL).
+		AppendKey(proof.Key, merkle.KeyEncodingHex)
+
+	runtime := rootmulti.DefaultProofRuntime()
+	var err error
+	if len(proof.Value) == 0 {
+		err = runtime.VerifyAbsence(proof.Proof, appHash, keyPath.String())
+	} else {
+		err = runtime.VerifyValue(proof.Proof, appHash, keyPath.String(), proof.Value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid proof of the key %X of the %s store at height %d: %w", proof.Key, proof.StoreName, proof.Height, err)
+	}
+	return nil
+}
+
+// GetCmdQueryStateProof implements a command to return a part of the mint state at a height with
+// its commitment proofs, optionally verified against the app hash of the block headers.
+func GetCmdQueryStateProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-proof [key-name] [height]",
+		Short: "Query a part of the mint state at a height with its commitment proofs",
+		Long: fmt.Sprintf(`Query the values of the store keys holding a part of the mint state at a height, the current
+height if omitted, with the commitment proofs of the values or of their absence. The key name is
+one of %v.
+
+With --verify, the proofs are verified against the app hash of the header of the next block
+returned by the node instead of being printed, the state of the last height can't be verified
+before the next block is committed.`, types.StateKeyNames()),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryStateProofRequest{KeyName: args[0]}
+			if len(args) > 1 {
+				params.Height, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid height %s: %w", args[1], err)
+				}
+			}
+			res, err := queryClient.StateProof(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			verify, err := cmd.Flags().GetBool(flagVerify)
+			if err != nil {
+				return err
+			}
+			if !verify {
+				return clientCtx.PrintProto(res)
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			if len(res.Proofs) == 0 {
+				return errors.New("no proof returned by the node")
+			}
+			// the proofs of a response are proofs of the state of the same height
+			height := res.Proofs[0].Height
+			next := height + 1
+			commit, err := node.Commit(cmd.Context(), &next)
+			if err != nil {
+				return fmt.Errorf("no header of height %d committing the state of height %d: %w", next, height, err)
+			}
+			for _, proof := range res.Proofs {
+				if err := VerifyStateProof(proof, commit.Header.AppHash); err != nil {
+					return err
+				}
+			}
+			return clientCtx.PrintString(fmt.Sprintf(
+				"%d proofs of %s at height %d verified against the app hash %X\n",
+				len(res.Proofs), args[0], height, commit.Header.AppHash,
+			))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagVerify, false, "Verify the proofs against the app hash of the block headers instead of printing them")
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdSetPaused(),
+		CmdUpdateParams(),
+		CmdSetGoalBonded(),
+		CmdClaimDistribution(),
+		CmdBurn(),
+		CmdReleaseReserve(),
+		CmdFundMinter(),
+		CmdResumeMinting(),
+		CmdRunMaintenance(),
+		CmdAddFundedAddress(),
+		CmdRemoveFundedAddress(),
+		CmdSetFundedAddressWeight(),
+		CmdSetMinCommunityPoolShare(),
+	)
+
+	return cmd
+}
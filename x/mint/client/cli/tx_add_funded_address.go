@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const (
+	flagPayoutMode  = "payout-mode"
+	flagWeightMode  = "weight-mode"
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+)
+
+func CmdAddFundedAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-funded-address [address] [weight]",
+		Short: "add a funded address, scaling down the weights of the other funded addresses",
+		Long: `Add a funded address receiving the weight of the funded addresses share, the weights of the
+other funded addresses are scaled down so the weights still sum to 1. The other params are kept
+unchanged. The signer must be the module authority, the transaction is usually generated with
+--generate-only to be submitted in a governance proposal. The message expects the chain-id of the
+client, it is rejected if executed on another chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			weight, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			fundedAddress := types.WeightedAddress{
+				Address: args[0],
+				Weight:  weight,
+			}
+			payoutMode, err := cmd.Flags().GetString(flagPayoutMode)
+			if err != nil {
+				return err
+			}
+			mode, ok := types.PayoutMode_value["PAYOUT_MODE_"+strings.ToUpper(payoutMode)]
+			if !ok {
+				return fmt.Errorf("invalid payout mode %s, expected push or pull", payoutMode)
+			}
+			fundedAddress.PayoutMode = types.PayoutMode(mode)
+			weightMode, err := cmd.Flags().GetString(flagWeightMode)
+			if err != nil {
+				return err
+			}
+			mode, ok = types.WeightMode_value["WEIGHT_MODE_"+strings.ToUpper(strings.ReplaceAll(weightMode, "-", "_"))]
+			if !ok {
+				return fmt.Errorf("invalid weight mode %s, expected fixed or stake-weighted", weightMode)
+			}
+			fundedAddress.WeightMode = types.WeightMode(mode)
+			if fundedAddress.StartHeight, err = cmd.Flags().GetInt64(flagStartHeight); err != nil {
+				return err
+			}
+			if fundedAddress.EndHeight, err = cmd.Flags().GetInt64(flagEndHeight); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddFundedAddress(clientCtx.GetFromAddress().String(), fundedAddress)
+			msg.ExpectedChainId = clientCtx.ChainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagPayoutMode, "push", "payout mode of the funded address, push or pull")
+	cmd.Flags().String(flagWeightMode, "fixed", "weight mode of the funded address, fixed or stake-weighted")
+	cmd.Flags().Int64(flagStartHeight, 0, "first height the address is funded at, zero for no lower bound")
+	cmd.Flags().Int64(flagEndHeight, 0, "first height the address is no longer funded at, zero for no upper bound")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func CmdReleaseReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-reserve [recipient] [amount]",
+		Short: "release coins of the strategic reserve to a recipient",
+		Long: `Release coins of the strategic reserve locked in the mint module account to a recipient. The
+release fails if it exceeds the balance of the reserve. The signer must be the module authority, the
+transaction is usually generated with --generate-only to be submitted in a governance proposal. The
+message expects the chain-id of the client, it is rejected if executed on another chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseReserve(clientCtx.GetFromAddress().String(), args[0], amount)
+			msg.ExpectedChainId = clientCtx.ChainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
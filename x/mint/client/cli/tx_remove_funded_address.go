@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func CmdRemoveFundedAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-funded-address [address]",
+		Short: "remove a funded address, scaling up the weights of the remaining funded addresses",
+		Long: `Remove a funded address, the weights of the remaining funded addresses are scaled up so the
+weights still sum to 1. Removing the last funded address sends the funded addresses share to the
+community pool. The other params are kept unchanged. The signer must be the module authority, the
+transaction is usually generated with --generate-only to be submitted in a governance proposal. The
+message expects the chain-id of the client, it is rejected if executed on another chain.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFundedAddress(clientCtx.GetFromAddress().String(), args[0])
+			msg.ExpectedChainId = clientCtx.ChainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
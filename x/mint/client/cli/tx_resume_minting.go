@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func CmdResumeMinting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-minting",
+		Short: "resume minting and reset the consecutive distribution failures",
+		Long: `Resume minting and reset the consecutive failures of the distribution of the minted coins.
+Minting paused by the auto pause threshold can only be resumed with this message. The signer must be
+the module authority, the transaction is usually generated with --generate-only to be submitted in a
+governance proposal. The message expects the chain-id of the client, it is rejected if executed on
+another chain.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResumeMinting(clientCtx.GetFromAddress().String())
+			msg.ExpectedChainId = clientCtx.ChainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func CmdRunMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run-maintenance [limit]",
+		Short: "run the maintenance of the mint store",
+		Long: `Run the maintenance of the mint store: flush the shares buffered for the categories no
+longer paused, prune the expired distribution history and inflation snapshots, and delete the
+weight changes of the expired funded addresses. At most limit records are cleaned, the maximum when
+the limit is omitted or zero. Any account can run the maintenance once every maintenance_interval
+blocks, the signer pays gas proportional to the records cleaned.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var limit uint64
+			if len(args) > 0 {
+				limit, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRunMaintenance(clientCtx.GetFromAddress().String(), limit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
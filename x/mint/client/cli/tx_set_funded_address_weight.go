@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func CmdSetFundedAddressWeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-funded-address-weight [address] [weight]",
+		Short: "set the weight of a funded address, scaling the weights of the other funded addresses",
+		Long: `Set the weight of a funded address, the weights of the other funded addresses are scaled so the
+weights still sum to 1. The other params are kept unchanged. The signer must be the module
+authority, the transaction is usually generated with --generate-only to be submitted in a
+governance proposal. The message expects the chain-id of the client, it is rejected if executed on
+another chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			weight, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFundedAddressWeight(clientCtx.GetFromAddress().String(), args[0], weight)
+			msg.ExpectedChainId = clientCtx.ChainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func CmdSetGoalBonded() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-goal-bonded [goal-bonded] [transition-blocks]",
+		Short: "set the goal bonded ratio, optionally with a linear transition",
+		Long: `Set the goal bonded ratio, in (0, 1]. With a positive number of transition blocks, the goal
+used to compute the inflation rate moves linearly from the current goal to the new goal over these blocks.
+The signer must be the module authority, the transaction is usually generated with --generate-only
+to be submitted in a governance proposal. The message expects the chain-id of the client, it is
+rejected if executed on another chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			goalBonded, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			transitionBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetGoalBonded(
+				clientCtx.GetFromAddress().String(),
+				goalBonded,
+				transitionBlocks,
+			)
+			msg.ExpectedChainId = clientCtx.ChainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
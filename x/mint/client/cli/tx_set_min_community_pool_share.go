@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const flagAcknowledgeMinCommunityPoolShare = "acknowledge-min-community-pool-share"
+
+func CmdSetMinCommunityPoolShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-min-community-pool-share [share]",
+		Short: "set the minimum share of the minted coins sent to the community pool",
+		Long: `Set the minimum share of the minted coins sent to the community pool, in [0, 1]. Every later change
+of the distribution proportions is rejected if the community pool share, including the funded
+addresses share when there is no funded address, is lower than the minimum. The current proportions
+must meet the new minimum. The minimum cannot be changed by update-params, the message is rejected
+unless --acknowledge-min-community-pool-share is set.
+The signer must be the module authority, the transaction is usually generated with --generate-only
+to be submitted in a governance proposal. The message expects the chain-id of the client, it is
+rejected if executed on another chain.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			share, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMinCommunityPoolShare(clientCtx.GetFromAddress().String(), share)
+			msg.ExpectedChainId = clientCtx.ChainID
+			msg.AcknowledgeMinCommunityPoolShare, err = cmd.Flags().GetBool(flagAcknowledgeMinCommunityPoolShare)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flagAcknowledgeMinCommunityPoolShare, false, "Acknowledge the min community pool share binds every later change of the distribution proportions")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
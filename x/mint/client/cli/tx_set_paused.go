@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// pauseTargets maps the CLI argument to the pause target
+var pauseTargets = map[string]types.PauseTarget{
+	"minting":   types.PAUSE_TARGET_MINTING,
+	"staking":   types.PAUSE_TARGET_STAKING_SHARE,
+	"funded":    types.PAUSE_TARGET_FUNDED_SHARE,
+	"community": types.PAUSE_TARGET_COMMUNITY_SHARE,
+}
+
+func CmdSetPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-paused [minting|staking|funded|community] [paused]",
+		Short: "pause or resume minting or the distribution of a category of the minted coins",
+		Long: `Pause or resume minting or the distribution of a category of the minted coins.
+The signer must be the module authority, the transaction is usually generated with --generate-only
+to be submitted in a governance proposal. The message expects the chain-id of the client, it is
+rejected if executed on another chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			target, ok := pauseTargets[args[0]]
+			if !ok {
+				return fmt.Errorf("invalid pause target %s", args[0])
+			}
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(
+				clientCtx.GetFromAddress().String(),
+				target,
+				paused,
+			)
+			msg.ExpectedChainId = clientCtx.ChainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
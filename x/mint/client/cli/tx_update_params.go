@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const (
+	flagAcknowledgeLargeChange = "acknowledge-large-change"
+	flagInflationMinBps        = "inflation-min-bps"
+	flagInflationMaxBps        = "inflation-max-bps"
+)
+
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-file]",
+		Short: "update all the parameters of the module",
+		Long: `Update all the parameters of the module from a JSON file, usually created from the output
+of the params query. All the parameters must be provided.
+The signer must be the module authority, the transaction is usually generated with --generate-only
+to be submitted in a governance proposal. The message expects the chain-id of the client, it is
+rejected if executed on another chain.
+With --dry-run, the params are validated by the node and the resulting effective values are
+shown, the transaction is not generated nor broadcast.
+A change moving the max or min inflation by more than the large change threshold param is
+rejected unless --acknowledge-large-change is set.
+The --inflation-min-bps and --inflation-max-bps flags set the min and max inflation in basis points,
+a basis point is 0.0001, in place of the values of the file.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := types.ValidateJSON(bz); err != nil {
+				return err
+			}
+			var params types.Params
+			if err := types.ModuleCdc.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+			// the inflation bounds in basis points replace the bounds of the file
+			inflationMinBps, err := getBasisPointsFlag(cmd, flagInflationMinBps)
+			if err != nil {
+				return err
+			}
+			if inflationMinBps != nil {
+				params.InflationMin = sdk.Dec{}
+			}
+			inflationMaxBps, err := getBasisPointsFlag(cmd, flagInflationMaxBps)
+			if err != nil {
+				return err
+			}
+			if inflationMaxBps != nil {
+				params.InflationMax = sdk.Dec{}
+			}
+
+			dryRun, err := cmd.Flags().GetBool(flags.FlagDryRun)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				clientCtx, err := client.GetClientQueryContext(cmd)
+				if err != nil {
+					return err
+				}
+				queryClient := types.NewQueryClient(clientCtx)
+
+				msg := types.MsgUpdateParams{
+					Params:          params,
+					InflationMinBps: inflationMinBps,
+					InflationMaxBps: inflationMaxBps,
+				}
+				if err := msg.ResolveInflationBasisPoints(); err != nil {
+					return err
+				}
+				res, err := queryClient.ValidateParams(cmd.Context(), &types.QueryValidateParamsRequest{Params: msg.Params})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(
+				clientCtx.GetFromAddress().String(),
+				params,
+			)
+			msg.ExpectedChainId = clientCtx.ChainID
+			msg.InflationMinBps = inflationMinBps
+			msg.InflationMaxBps = inflationMaxBps
+			msg.AcknowledgeLargeChange, err = cmd.Flags().GetBool(flagAcknowledgeLargeChange)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagAcknowledgeLargeChange, false, "Acknowledge a move of the inflation bounds larger than the large change threshold")
+	cmd.Flags().Int64(flagInflationMinBps, 0, "Min inflation in basis points, replacing the min inflation of the file")
+	cmd.Flags().Int64(flagInflationMaxBps, 0, "Max inflation in basis points, replacing the max inflation of the file")
+
+	return cmd
+}
+
+// getBasisPointsFlag returns the basis points of the flag, nil if the flag is not set
+func getBasisPointsFlag(cmd *cobra.Command, flag string) (*types.BasisPoints, error) {
+	if !cmd.Flags().Changed(flag) {
+		return nil, nil
+	}
+	value, err := cmd.Flags().GetInt64(flag)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewBasisPoints(value), nil
+}
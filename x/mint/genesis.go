@@ -1,6 +1,8 @@
 package mint
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ignite/modules/x/mint/keeper"
@@ -9,8 +11,24 @@ import (
 
 // InitGenesis new mint genesis
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, data *types.GenesisState) {
+	data.Normalize()
+	if override := data.Params.BootstrapOverride; override.IsModuleAccount() && ak.GetModuleAddress(override.Recipient) == nil {
+		panic(fmt.Sprintf("bootstrap override recipient module account %q not found", override.Recipient))
+	}
+	// the imported minter is set after the params, a blocks per year transition started by the
+	// params of a previously initialized store doesn't override the transition of the genesis
+	keeper.SetParamsWithChange(ctx, data.Params, types.GenesisParamsChange())
 	keeper.SetMinter(ctx, data.Minter)
-	keeper.SetParams(ctx, data.Params)
+	keeper.SetTotalMinted(ctx, data.TotalMinted)
+	if data.DustAccumulator != nil {
+		keeper.SetDustAccumulator(ctx, *data.DustAccumulator)
+	}
+	if err := keeper.InitSchemaVersion(ctx); err != nil {
+		panic(err)
+	}
+	if err := keeper.RecordModuleInitialized(ctx); err != nil {
+		panic(err)
+	}
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -20,6 +38,10 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 
 	genesis.Minter = keeper.GetMinter(ctx)
 	genesis.Params = keeper.GetParams(ctx)
+	genesis.TotalMinted = keeper.GetTotalMinted(ctx)
+	if accumulator, found := keeper.GetDustAccumulator(ctx); found {
+		genesis.DustAccumulator = &accumulator
+	}
 
 	return genesis
 }
@@ -0,0 +1,80 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	testapp "github.com/ignite/modules/app"
+	"github.com/ignite/modules/testutil"
+)
+
+const chainID = "simapp-chain-id"
+
+// setup builds the app with the default genesis state of its modules and a single validator
+func setup(t *testing.T) *testapp.App {
+	app, genesisState := testutil.GenApp(chainID, true, 5)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+			ChainId:         chainID,
+		},
+	)
+	return app
+}
+
+// beginBlock begins the block following the last committed block at the time and returns the
+// context of the block
+func beginBlock(app *testapp.App, blockTime time.Time) (sdk.Context, abci.ResponseBeginBlock) {
+	header := tmproto.Header{
+		ChainID: chainID,
+		Height:  app.LastBlockHeight() + 1,
+		Time:    blockTime,
+	}
+	res := app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	return app.NewContext(false, header), res
+}
+
+// endBlock ends and commits the block of the context
+func endBlock(app *testapp.App, ctx sdk.Context) abci.ResponseEndBlock {
+	res := app.EndBlock(abci.RequestEndBlock{Height: ctx.BlockHeight()})
+	app.Commit()
+	return res
+}
+
+// commitBlocks begins, ends and commits n blocks
+func commitBlocks(app *testapp.App, n int) {
+	for i := 0; i < n; i++ {
+		ctx, _ := beginBlock(app, time.Now().UTC())
+		endBlock(app, ctx)
+	}
+}
+
+// deliverMsg executes the message with the handler the app routes it to, like a transaction
+func deliverMsg(t *testing.T, app *testapp.App, ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler, "no handler for %s", sdk.MsgTypeURL(msg))
+	return handler(ctx, msg)
+}
+
+// hasTypedEvent returns true if the events contain a typed event of the message
+func hasTypedEvent(events []abci.Event, msg proto.Message) bool {
+	for _, event := range events {
+		if event.Type == proto.MessageName(msg) {
+			return true
+		}
+	}
+	return false
+}
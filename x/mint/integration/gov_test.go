@@ -0,0 +1,99 @@
+package integration_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestGovAuthority(t *testing.T) {
+	app := setup(t)
+	commitBlocks(app, 2)
+	blockTime := time.Now().UTC()
+
+	ctx, _ := beginBlock(app, blockTime)
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	// the genesis account holds the only delegation and therefore all the voting power
+	voter := sdk.MustAccAddressFromBech32(app.StakingKeeper.GetAllDelegations(ctx)[0].DelegatorAddress)
+	fundedAddress := sample.Address(rand.New(rand.NewSource(1)))
+
+	genesisParams := app.MintKeeper.GetParams(ctx)
+	params := genesisParams
+	params.BlocksPerYear *= 2
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(5, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(3, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	params.FundedAddresses = []types.WeightedAddress{{Address: fundedAddress, Weight: sdk.OneDec()}}
+	params.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+	msgs := []sdk.Msg{
+		types.NewMsgUpdateParams(govAddress, params),
+		types.NewMsgSetPaused(govAddress, types.PAUSE_TARGET_COMMUNITY_SHARE, true),
+	}
+
+	t.Run("should prevent a non-gov account from executing the messages", func(t *testing.T) {
+		for _, msg := range []sdk.Msg{
+			types.NewMsgUpdateParams(voter.String(), params),
+			types.NewMsgSetPaused(voter.String(), types.PAUSE_TARGET_COMMUNITY_SHARE, true),
+		} {
+			_, err := deliverMsg(t, app, ctx, msg)
+			require.ErrorIs(t, err, types.ErrUnauthorized)
+		}
+		require.Equal(t, genesisParams, app.MintKeeper.GetParams(ctx))
+	})
+
+	// submit the proposal to x/gov with the minimum deposit and vote for it
+	govParams := app.GovKeeper.GetParams(ctx)
+	proposal, err := govv1.NewMsgSubmitProposal(msgs, govParams.MinDeposit, voter.String(), "", "mint", "update mint")
+	require.NoError(t, err)
+	res, err := deliverMsg(t, app, ctx, proposal)
+	require.NoError(t, err)
+	var submitted govv1.MsgSubmitProposalResponse
+	require.NoError(t, proto.Unmarshal(res.MsgResponses[0].Value, &submitted))
+	_, err = deliverMsg(t, app, ctx, govv1.NewMsgVote(voter, submitted.ProposalId, govv1.OptionYes, ""))
+	require.NoError(t, err)
+	endBlock(app, ctx)
+
+	// the proposal is executed at the end of the first block after the voting period
+	ctx, _ = beginBlock(app, blockTime.Add(*govParams.VotingPeriod).Add(time.Second))
+	endRes := endBlock(app, ctx)
+	blockTime = ctx.BlockTime()
+
+	ctx = app.NewContext(true, tmproto.Header{})
+	passed, found := app.GovKeeper.GetProposal(ctx, submitted.ProposalId)
+	require.True(t, found)
+	require.Equal(t, govv1.StatusPassed, passed.Status)
+	require.True(t, hasTypedEvent(endRes.Events, &types.EventBlocksPerYearTransition{}))
+
+	params.PauseCommunityShare = true
+	require.Equal(t, params, app.MintKeeper.GetParams(ctx))
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.NotNil(t, minter.BlocksPerYearTransition)
+	require.Equal(t, params.BlocksPerYear, minter.BlocksPerYearTransition.To)
+	change, found := app.MintKeeper.GetLastParamsChange(ctx)
+	require.True(t, found)
+	require.Equal(t, govAddress, change.Authority)
+
+	// the next blocks mint with the new params, the community share is buffered while paused
+	for i := 0; i < 3; i++ {
+		blockCtx, beginRes := beginBlock(app, blockTime.Add(time.Duration(i+1)*5*time.Second))
+		require.True(t, hasTypedEvent(beginRes.Events, &types.EventMint{}))
+		require.True(t, hasTypedEvent(beginRes.Events, &types.EventPausedShare{}))
+		endBlock(app, blockCtx)
+	}
+	ctx = app.NewContext(true, tmproto.Header{})
+	require.True(t, app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(fundedAddress), params.MintDenom).IsPositive())
+}
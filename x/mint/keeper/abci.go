@@ -3,48 +3,252 @@ package keeper
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ignite/modules/x/mint/types"
 )
 
-// BeginBlocker mints new coins for the previous block.
+// BeginBlocker mints new coins for the previous block: the coins of the mint denom, then the
+// coins of the denom of each mint configuration, minted and distributed independently.
 func (k Keeper) BeginBlocker(ctx sdk.Context) error {
-	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	if !isDryRun(ctx) {
+		defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricKeyBeginBlocker)
+	}
+
+	ctx, logProfile := k.profileBlock(ctx)
+	defer logProfile()
+
+	// the writes of the block are flushed at the end of the begin blocker, the block failing
+	// included, like the unbatched writes
+	ctx, flush := k.batchWrites(ctx)
+	defer k.profileFlush(ctx, flush)
+
+	endStage := k.profileStage(ctx, types.ProfileStageMintDenom)
+	err := k.mintDenom(ctx)
+	endStage()
+	if err != nil {
+		return err
+	}
+
+	endStage = k.profileStage(ctx, types.ProfileStageMintConfigs)
+	defer endStage()
+	return k.mintConfiguredDenoms(ctx)
+}
+
+// profileFlush flushes the batched writes of the block as a profiled stage
+func (k Keeper) profileFlush(ctx sdk.Context, flush func()) {
+	defer k.profileStage(ctx, types.ProfileStageFlush)()
+	flush()
+}
 
-	// fetch stored minter & params
+// mintDenom mints the coins of the mint denom for the previous block.
+func (k Keeper) mintDenom(ctx sdk.Context) error {
+	// fetch stored minter & params, the inflation bounds and the goal bonded of the active phase
+	// replace the params so the inflation of the previous block is clamped into the bounds of a
+	// new phase
 	minter := k.GetMinter(ctx)
-	params := k.GetParams(ctx)
+	params := k.GetParams(ctx).AtHeight(ctx.BlockHeight())
 
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
+
+	// the provisions can't be computed against the supply of another denom, the minter is
+	// left untouched until the supplies are consistent again
+	if consistency := k.GetDenomConsistency(ctx, params.MintDenom, totalStakingSupply); consistency.Mismatch {
+		k.Logger(ctx).Error(
+			"minting skipped, the supplies of the mint denom mismatch",
+			"mint_denom", consistency.MintDenom,
+			"staking_supply", consistency.StakingSupply.String(),
+			"bank_supply", consistency.BankSupply.String(),
+		)
+		return ctx.EventManager().EmitTypedEvent(&types.EventDenomMismatch{
+			MintDenom:     consistency.MintDenom,
+			BondDenom:     consistency.BondDenom,
+			StakingSupply: consistency.StakingSupply,
+			BankSupply:    consistency.BankSupply,
+		})
+	}
+
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
-	k.SetMinter(ctx, minter)
+	stakingSupply, supplySource := k.EffectiveStakingSupply(ctx, params, totalStakingSupply)
 
-	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
-	err := k.MintCoin(ctx, mintedCoin)
-	if err != nil {
-		return err
+	// the inflation, the provision of the block and the carry buffer are computed with the mint
+	// schedule shared with the projections of the queries
+	step := minter.NextMintStep(params, ctx.BlockHeight(), 1, bondedRatio, stakingSupply)
+	minter.LastBlockInputs = types.BlockInputs{
+		Height:        ctx.BlockHeight(),
+		BondedRatio:   bondedRatio,
+		StakingSupply: stakingSupply,
+		SupplySource:  supplySource,
 	}
 
-	// distribute minted coins according to the defined proportions
-	err = k.DistributeMintedCoin(ctx, mintedCoin)
-	if err != nil {
-		return err
+	// the inflation is snapshotted while minting is paused
+	k.recordInflationSnapshot(ctx, params, minter)
+
+	// the community pool funding is tracked from the start of each budget year
+	if budgetYear := types.BudgetYear(ctx.BlockHeight(), params.BlocksPerYear); budgetYear != minter.CommunityFunding.BudgetYear {
+		minter.CommunityFunding = types.NewCommunityFunding(budgetYear, minter.CumulativeDistributed.CommunityPool)
+	}
+
+	// the minting is skipped while the bonded ratio is below the min bonded ratio
+	if step.Skipped {
+		k.SetMinter(ctx, minter)
+		return ctx.EventManager().EmitTypedEvent(&types.EventMintSkipped{
+			BondedRatio:    bondedRatio,
+			MinBondedRatio: params.MinBondedRatio,
+		})
 	}
 
-	if mintedCoin.Amount.IsInt64() {
-		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+	// the minter keeps tracking the inflation while minting is paused, and the provisions accrue
+	// in the carry buffer between the minting heights of a minting interval
+	if !step.Minting {
+		k.SetMinter(ctx, minter)
+		return nil
 	}
+	mintedCoin := sdk.NewCoin(params.MintDenom, step.Minted)
+	driftCorrection := step.DriftCorrection
+
+	// the minted coins are reduced to the headroom below the max supply, the minted top-up of the
+	// community pool funding included
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	headroom, capped := params.MaxSupplyHeadroom(supply)
+	provisionCoin := mintedCoin
+	uncapped := mintedCoin.Amount
+	if capped {
+		mintedCoin.Amount = sdkmath.MinInt(mintedCoin.Amount, headroom)
+	}
+
+	// the shortfall of the minted coins from the provision is allocated to the categories by the
+	// shortfall policy
+	shares, shortfall := k.cappedShares(ctx, params, provisionCoin, mintedCoin)
+
+	// in the final blocks of the budget year, the community pool funding is topped up to reach
+	// the minimum annual community funding. While the bootstrap override is active, all the minted
+	// coins are sent to its recipient as a funded address and the community pool is not topped up.
+	topUp := types.ZeroCommunityFundingTopUp()
+	if params.BootstrapOverride.IsActiveAt(ctx.BlockHeight()) {
+		shares = types.NewCategoryTotals()
+		shares.FundedAddresses = mintedCoin.Amount
+	} else {
+		topUp = types.NewCommunityFundingTopUp(params, minter, ctx.BlockHeight(), stakingSupply, mintedCoin.Amount)
+	}
+	if capped {
+		uncapped = uncapped.Add(topUp.Minted)
+		topUp.Minted = sdkmath.MinInt(topUp.Minted, headroom.Sub(mintedCoin.Amount))
+		topUp.Reallocated = sdkmath.MinInt(topUp.Reallocated, shares.Staking)
+	}
+	minter.TargetCumulativeEmission = minter.TargetCumulativeEmission.Add(sdk.NewDecFromInt(topUp.Minted))
+
+	// the drift is reset when the max supply cuts the minted coins, so the drift correction doesn't
+	// compensate the amount not minted
+	if cut := uncapped.Sub(mintedCoin.Amount).Sub(topUp.Minted); capped && cut.IsPositive() {
+		minter.TargetCumulativeEmission = sdk.NewDecFromInt(
+			minter.CumulativeMinted.Add(mintedCoin.Amount).Add(topUp.Minted),
+		).Add(minter.CarryBuffer)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMintCapped{
+			MaxSupply: params.MaxSupply,
+			Supply:    supply,
+			Minted:    mintedCoin.Amount.Add(topUp.Minted),
+			Capped:    cut,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if mintedCoin.Amount.LT(provisionCoin.Amount) {
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMintShortfall{
+			Policy:    params.ShortfallPolicy,
+			Allocated: shares,
+			Shortfall: shortfall,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// the planned emission is announced before any state change of the block
+	if params.EmitMintPlanned {
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMintPlanned{
+			Amount:      mintedCoin.Amount,
+			Allocations: types.ProjectCategoryShares(params, shares),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// the minter, the minted coins and their distribution are set on a branch of the context
+	// written once the distribution succeeded, so a failed block mints no coin, transfers nothing
+	// and keeps the minter of the previous block
+	mintCtx, write := ctx.CacheContext()
+	k.SetMinter(mintCtx, minter)
+
+	totalMinted := mintedCoin.AddAmount(topUp.Minted)
+	if totalMinted.IsPositive() {
+		// mint coins, update supply
+		endStage := k.profileStage(ctx, types.ProfileStageMintCoins)
+		err := k.MintCoin(mintCtx, totalMinted)
+		endStage()
+		if err != nil {
+			return err
+		}
+
+		// distribute minted coins according to the defined proportions, the consecutive failures
+		// of the distribution are recorded on the context and pause minting at the auto pause
+		// threshold
+		endStage = k.profileStage(ctx, types.ProfileStageDistribution)
+		err = k.distributeMintedCoin(mintCtx, mintedCoin, shares, topUp)
+		endStage()
+		if err != nil {
+			if pauseErr := k.recordDistributionFailure(ctx, err); pauseErr != nil {
+				return pauseErr
+			}
+			return err
+		}
+	}
+	write()
+
+	if totalMinted.IsPositive() {
+		k.resetDistributionFailures(ctx)
+
+		// the hooks receive the coins minted for the block, the minted top-up included
+		if k.hooks != nil && !isDryRun(ctx) {
+			endStage := k.profileStage(ctx, types.ProfileStageHooks)
+			err := k.hooks.AfterDistributeMintedCoin(ctx, totalMinted)
+			endStage()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if topUp.Shortfall.IsPositive() {
+		k.Logger(ctx).Info(
+			"community pool funding below the annual minimum",
+			"shortfall", topUp.Shortfall.String(),
+			"minted", topUp.Minted.String(),
+			"reallocated", topUp.Reallocated.String(),
+		)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventCommunityFundingFloor{
+			BudgetYear:  minter.CommunityFunding.BudgetYear,
+			Shortfall:   topUp.Shortfall,
+			Minted:      topUp.Minted,
+			Reallocated: topUp.Reallocated,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	k.setMintMetrics(ctx, minter, bondedRatio, mintedCoin)
 
 	return ctx.EventManager().EmitTypedEvent(&types.EventMint{
 		BondedRatio:      bondedRatio,
 		Inflation:        minter.Inflation,
 		AnnualProvisions: minter.AnnualProvisions,
 		Amount:           mintedCoin.Amount,
+		DriftCorrection:  driftCorrection,
 	})
 }
@@ -1,7 +1,337 @@
 package keeper_test
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// lowInflationParams returns params pinning the inflation to 10% with 300 blocks per year so
+// that a supply of 1000 tokens produces a block provision of 1/3 token.
+func lowInflationParams() types.Params {
+	params := types.DefaultParams()
+	params.InflationMin = sdk.NewDecWithPrec(1, 1)
+	params.InflationMax = sdk.NewDecWithPrec(1, 1)
+	params.BlocksPerYear = 300
+	return params
+}
+
+// fundSupply mints the provided coins to a random account to initialize the token supply
+func fundSupply(t testing.TB, ctx sdk.Context, tk testkeeper.TestKeepers, coins sdk.Coins) {
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, tk.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sample.AccAddress(r), coins))
+}
 
-// TODO add test
 func TestBeginBlocker(t *testing.T) {
+	for _, ts := range testSetups {
+		ts := ts
+		t.Run(ts.name, func(t *testing.T) {
+			t.Run("should mint the block provision", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				params.BlocksPerYear = 10
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				require.Equal(t, sdkmath.NewInt(1010), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+				require.Equal(t, sdk.ZeroDec(), tk.MintKeeper.GetMinter(ctx).CarryBuffer)
+			})
+
+			t.Run("should accumulate provisions below the minimum distributable provision", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				params.MinDistributableProvision = sdkmath.OneInt()
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+				third := sdk.MustNewDecFromStr("0.333333333333333333")
+				for i := int64(1); i <= 3; i++ {
+					require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+					require.Equal(t, sdkmath.NewInt(1000), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+					require.Equal(t, third.MulInt64(i), tk.MintKeeper.GetMinter(ctx).CarryBuffer)
+				}
+
+				// the buffer crosses the threshold, the integral part is minted and distributed
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				require.Equal(t, sdkmath.NewInt(1001), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+				require.Equal(t, third.MulInt64(4).Sub(sdk.OneDec()), tk.MintKeeper.GetMinter(ctx).CarryBuffer)
+				mintAddr := tk.AccountKeeper.GetModuleAddress(types.ModuleName)
+				require.True(t, tk.BankKeeper.GetAllBalances(ctx, mintAddr).IsZero())
+			})
+
+			t.Run("should distribute buffered provisions once above a larger minimum", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				params.MinDistributableProvision = sdkmath.NewInt(5)
+				params.BlocksPerYear = 30
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(3000))))
+
+				// the block provision is 10 tokens so the buffer is minted on each block
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(1)))
+				require.Equal(t, sdkmath.NewInt(3010), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+
+				// the block provision is reduced to 2 tokens after the one block transition of the blocks
+				// per year, minted on the third block
+				params.BlocksPerYear = 150
+				tk.MintKeeper.SetParams(ctx.WithBlockHeight(1), params)
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(2)))
+				require.Equal(t, sdkmath.NewInt(3020), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+				for height := int64(3); height <= 4; height++ {
+					require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+					require.Equal(t, sdkmath.NewInt(3020), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+				}
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(5)))
+				require.Equal(t, sdkmath.NewInt(3026), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+			})
+
+			t.Run("should flush the carry buffer when the minimum is disabled", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				tk.MintKeeper.SetParams(ctx, params)
+				minter := types.InitialMinter(params.InflationMax)
+				minter.CarryBuffer = sdk.MustNewDecFromStr("2.5")
+				tk.MintKeeper.SetMinter(ctx, minter)
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(3000))))
+
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				require.Equal(t, sdkmath.NewInt(3003), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+				require.Equal(t, sdk.ZeroDec(), tk.MintKeeper.GetMinter(ctx).CarryBuffer)
+			})
+
+			t.Run("should record the inputs of the inflation decision of the block", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				params.BlocksPerYear = 10
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(500))))
+				bonded := sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(500)))
+				require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, bonded))
+				require.NoError(t, tk.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.BondedPoolName, bonded))
+
+				bondedRatio := tk.MintKeeper.BondedRatio(ctx)
+				require.Equal(t, sdk.NewDecWithPrec(5, 1), bondedRatio)
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+				// the recorded bonded ratio is the one used before minting the block provision
+				inputs := tk.MintKeeper.GetMinter(ctx).LastBlockInputs
+				require.Equal(t, ctx.BlockHeight(), inputs.Height)
+				require.True(t, bondedRatio.Equal(inputs.BondedRatio))
+				require.Equal(t, sdkmath.NewInt(1000), inputs.StakingSupply)
+				require.False(t, tk.MintKeeper.BondedRatio(ctx).Equal(inputs.BondedRatio))
+			})
+
+			t.Run("should not mint when minting is paused", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				params.BlocksPerYear = 10
+				params.PauseMinting = true
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				require.Equal(t, sdkmath.NewInt(1000), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+				require.Equal(t, sdk.NewDec(100), tk.MintKeeper.GetMinter(ctx).AnnualProvisions)
+
+				// minting resumes once unpaused
+				params.PauseMinting = false
+				tk.MintKeeper.SetParams(ctx, params)
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				require.Equal(t, sdkmath.NewInt(1010), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+			})
+		})
+	}
+}
+
+// failingDistrKeeper fails to fund the community pool
+type failingDistrKeeper struct {
+	types.DistrKeeper
+}
+
+func (failingDistrKeeper) FundCommunityPool(sdk.Context, sdk.Coins, sdk.AccAddress) error {
+	return errors.New("community pool unavailable")
+}
+
+func TestBeginBlockerMintPlanned(t *testing.T) {
+	// plannedAndMinted returns the planned and the minted events of the block
+	plannedAndMinted := func(t *testing.T, ctx sdk.Context) (planned []*types.EventMintPlanned, minted []*types.EventMint) {
+		for _, event := range ctx.EventManager().Events() {
+			parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			switch e := parsed.(type) {
+			case *types.EventMintPlanned:
+				planned = append(planned, e)
+			case *types.EventMint:
+				minted = append(minted, e)
+			}
+		}
+		return planned, minted
+	}
+	third := sdk.MustNewDecFromStr("0.333333333333333333")
+
+	tests := []struct {
+		name             string
+		distrKeeper      func(types.DistrKeeper) types.DistrKeeper
+		params           func(*types.Params)
+		planned          bool
+		err              error
+		fundedDivergence int64
+	}{
+		{
+			name:   "should not plan the mint when the flag is not set",
+			params: func(*types.Params) {},
+		},
+		{
+			name: "should plan the executed mint",
+			params: func(p *types.Params) {
+				p.EmitMintPlanned = true
+			},
+			planned: true,
+		},
+		{
+			name: "should plan the funded addresses share before the truncation of the weights",
+			params: func(p *types.Params) {
+				p.EmitMintPlanned = true
+				p.FundedAddresses = []types.WeightedAddress{
+					{Address: sample.Address(r), Weight: third},
+					{Address: sample.Address(r), Weight: third},
+					{Address: sample.Address(r), Weight: sdk.OneDec().Sub(third.MulInt64(2))},
+				}
+			},
+			planned: true,
+			// 4 coins are planned for the funded addresses, 1 coin each is sent and 1 is dust
+			fundedDivergence: 1,
+		},
+		{
+			name: "should plan the mint of a failing distribution",
+			distrKeeper: func(dk types.DistrKeeper) types.DistrKeeper {
+				return failingDistrKeeper{dk}
+			},
+			params: func(p *types.Params) {
+				p.EmitMintPlanned = true
+			},
+			planned: true,
+			err:     types.ErrDistributionFailed,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, tk, _ := testkeeper.NewTestSetupWithMintDistrKeeper(t, tc.distrKeeper)
+			params := lowInflationParams()
+			params.BlocksPerYear = 10
+			tc.params(&params)
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+			fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := tk.MintKeeper.BeginBlocker(ctx)
+			planned, minted := plannedAndMinted(t, ctx)
+			if !tc.planned {
+				require.NoError(t, err)
+				require.Empty(t, planned)
+				require.Len(t, minted, 1)
+				return
+			}
+			// the planned mint is the first event of the block
+			require.Len(t, planned, 1)
+			require.Equal(t, "modules.mint.EventMintPlanned", ctx.EventManager().Events()[0].Type)
+			require.Equal(t, sdkmath.NewInt(10), planned[0].Amount)
+			require.Equal(t, planned[0].Amount, planned[0].Allocations.Total())
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Empty(t, minted)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, minted, 1)
+			require.Equal(t, planned[0].Amount, minted[0].Amount)
+
+			distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, ctx.BlockHeight())
+			require.True(t, found)
+			require.Equal(t, planned[0].Amount, distribution.Distributed.Total())
+			require.Equal(t, planned[0].Allocations.Staking, distribution.Distributed.Staking)
+			require.Equal(t, planned[0].Allocations.CommunityPool, distribution.Distributed.CommunityPool)
+			require.Equal(t,
+				planned[0].Allocations.FundedAddresses.SubRaw(tc.fundedDivergence),
+				distribution.Distributed.FundedAddresses,
+			)
+			require.Equal(t, sdkmath.NewInt(tc.fundedDivergence), distribution.Distributed.Dust)
+		})
+	}
+}
+
+func TestBeginBlockerHooks(t *testing.T) {
+	t.Run("should invoke the hooks with the minted coin of the block", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		hooks := testkeeper.NewMintHooksMock()
+		tk.MintKeeper.SetHooks(types.NewMultiMintHooks(hooks))
+		params := lowInflationParams()
+		params.MinDistributableProvision = sdkmath.OneInt()
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		// the provisions of the first blocks are buffered, no coin is minted
+		for height := int64(1); height <= 3; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+		}
+		require.Empty(t, hooks.MintedCoins)
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(4)))
+		require.Equal(t, map[int64]sdk.Coin{4: sdk.NewCoin(params.MintDenom, sdkmath.OneInt())}, hooks.MintedCoins)
+		require.Equal(t, sdkmath.NewInt(1001), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	})
+
+	t.Run("should not invoke the hooks while minting is paused", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		hooks := testkeeper.NewMintHooksMock()
+		tk.MintKeeper.SetHooks(hooks)
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		params.PauseMinting = true
+		tk.MintKeeper.SetParams(ctx, params)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Empty(t, hooks.MintedCoins)
+	})
+
+	t.Run("should return the error of the hooks", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		hooks := testkeeper.NewMintHooksMock()
+		hooks.Err = errors.New("hook failed")
+		tk.MintKeeper.SetHooks(hooks)
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		tk.MintKeeper.SetParams(ctx, params)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		require.ErrorIs(t, tk.MintKeeper.BeginBlocker(ctx), hooks.Err)
+	})
+
+	t.Run("should panic if the hooks are set twice", func(t *testing.T) {
+		_, tk, _ := testSetups[0].setup(t)
+		tk.MintKeeper.SetHooks(testkeeper.NewMintHooksMock())
+		require.Panics(t, func() {
+			tk.MintKeeper.SetHooks(testkeeper.NewMintHooksMock())
+		})
+	})
 }
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// allocationIndex numbers the allocations of the distribution of a block: the staking share, the
+// share of each funded address, the dust and the community pool funding, in this order
+type allocationIndex uint32
+
+// next returns the index of the next allocation of the block
+func (i *allocationIndex) next() uint32 {
+	index := uint32(*i)
+	*i++
+	return index
+}
+
+// transferWithAllocationIndex runs the transfers of an allocation with an event manager of its
+// own and emits their events with the allocation_index attribute of the allocation, so the
+// events of the bank transfers of the allocations with the same sender and amount are distinct
+func transferWithAllocationIndex(ctx sdk.Context, index uint32, transfer func(sdk.Context) error) error {
+	em := sdk.NewEventManager()
+	if err := transfer(ctx.WithEventManager(em)); err != nil {
+		return err
+	}
+
+	attribute := sdk.NewAttribute(types.AttributeKeyAllocationIndex, strconv.FormatUint(uint64(index), 10))
+	for _, event := range em.Events() {
+		ctx.EventManager().EmitEvent(event.AppendAttributes(attribute))
+	}
+	return nil
+}
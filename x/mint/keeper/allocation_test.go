@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// requireDistinctEvents checks that the events of a block are distinct by type and attributes,
+// that the attributes of the typed events of the module are ordered by key and that the
+// allocation indexes of the events don't decrease
+func requireDistinctEvents(t *testing.T, events sdk.Events) {
+	seen := make(map[string]bool)
+	lastIndex := -1
+	for _, event := range events {
+		attributes := make([]string, len(event.Attributes))
+		keys := make([]string, len(event.Attributes))
+		for i, attribute := range event.Attributes {
+			attributes[i] = attribute.Key + "=" + attribute.Value
+			keys[i] = attribute.Key
+			if attribute.Key != types.AttributeKeyAllocationIndex {
+				continue
+			}
+			index, err := strconv.Atoi(attribute.Value)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, index, lastIndex, "allocation index of %s", event.Type)
+			lastIndex = index
+		}
+		if strings.HasPrefix(event.Type, "modules.mint.") {
+			require.True(t, sort.StringsAreSorted(keys), "attributes of %s: %v", event.Type, keys)
+		}
+
+		tuple := event.Type + "|" + strings.Join(attributes, "|")
+		require.False(t, seen[tuple], "duplicate event %s", tuple)
+		seen[tuple] = true
+	}
+}
+
+func TestDistributionEventsDistinct(t *testing.T) {
+	addrs := []sdk.AccAddress{sample.AccAddress(r), sample.AccAddress(r), sample.AccAddress(r), sample.AccAddress(r)}
+
+	// the restriction blocks the third address and redirects the coins of the fourth address to the
+	// first address
+	restriction := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		switch {
+		case toAddr.Equals(addrs[2]):
+			return nil, errors.New("sanctioned address")
+		case toAddr.Equals(addrs[3]):
+			return addrs[0], nil
+		}
+		return toAddr, nil
+	}
+
+	// the funded addresses share of 40 coins is split into 4 shares of 9 coins with 4 coins of dust
+	weight := sdk.NewDecWithPrec(225, 3)
+	fundedAddresses := []types.WeightedAddress{
+		{Address: addrs[0].String(), Weight: weight},
+		{Address: addrs[1].String(), Weight: weight},
+		{Address: addrs[2].String(), Weight: weight},
+		{Address: addrs[3].String(), Weight: sdk.OneDec().Sub(weight.MulInt64(3))},
+	}
+
+	tests := []struct {
+		name   string
+		update func(*types.Params)
+	}{
+		{
+			name: "should emit distinct events for the funded addresses and the dust",
+		},
+		{
+			name: "should emit distinct events for the redirected shares",
+			update: func(params *types.Params) {
+				params.PauseStakingShare = true
+				params.PausedShareMode = types.PAUSED_SHARE_MODE_COMMUNITY_POOL
+			},
+		},
+		{
+			name: "should emit distinct events for the pull payouts",
+			update: func(params *types.Params) {
+				params.FundedAddresses[1].PayoutMode = types.PAYOUT_MODE_PULL
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sdkCtx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, keeper.EnforceSendRestrictions(restriction))
+			params := types.DefaultParams()
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:         sdk.NewDecWithPrec(3, 1),
+				FundedAddresses: sdk.NewDecWithPrec(4, 1),
+				CommunityPool:   sdk.NewDecWithPrec(3, 1),
+			}
+			params.FundedAddresses = append([]types.WeightedAddress(nil), fundedAddresses...)
+			params.DustAssignment = types.DUST_ASSIGNMENT_ROUND_ROBIN
+			if tc.update != nil {
+				tc.update(&params)
+			}
+			tk.MintKeeper.SetParams(sdkCtx, params)
+			mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+			// the dust is assigned to each category in round robin
+			for height := int64(1); height <= int64(len(types.DustCategories)); height++ {
+				ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+				require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+				require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+				requireDistinctEvents(t, ctx.EventManager().Events())
+			}
+		})
+	}
+}
+
+func TestDistributionEventsAllocationIndex(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	addrs := []string{sample.Address(r), sample.Address(r)}
+	params := types.DefaultParams()
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: addrs[0], Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: addrs[1], Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	tk.MintKeeper.SetParams(ctx, params)
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+	require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+	// the allocations are the staking share, the share of each funded address and the community
+	// pool funding
+	var indexes []string
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			for _, attribute := range event.Attributes {
+				if attribute.Key == types.AttributeKeyAllocationIndex {
+					indexes = append(indexes, attribute.Value)
+				}
+			}
+		case "modules.mint.EventCommunityPoolFunded":
+			parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			require.EqualValues(t, 3, parsed.(*types.EventCommunityPoolFunded).AllocationIndex)
+		}
+	}
+	require.Equal(t, []string{"0", "1", "2", "3"}, indexes)
+}
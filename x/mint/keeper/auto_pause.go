@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// recordDistributionFailure counts a failed distribution of the minted coins of the mint denom.
+// Minting is paused once the distribution failed for auto_pause_threshold consecutive blocks, so
+// the minted coins don't pile up in the module account while the distribution keeps failing.
+func (k Keeper) recordDistributionFailure(ctx sdk.Context, distributionErr error) error {
+	minter := k.GetMinter(ctx)
+	minter.ConsecutiveDistributionFailures++
+	params := k.GetParams(ctx)
+	threshold := params.AutoPauseThreshold
+	if threshold == 0 || minter.ConsecutiveDistributionFailures < threshold || params.PauseMinting {
+		k.SetMinter(ctx, minter)
+		return nil
+	}
+
+	minter.AutoPaused = true
+	k.SetMinter(ctx, minter)
+	params.PauseMinting = true
+	k.SetParamsWithChange(ctx, params, types.NewParamsChange(ctx, "", types.ParamsChangeSourceAutoPause))
+
+	k.Logger(ctx).Error(
+		"minting auto paused, the distribution of the minted coins keeps failing",
+		"consecutive_failures", minter.ConsecutiveDistributionFailures,
+		"threshold", threshold,
+		"error", distributionErr.Error(),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintingAutoPaused{
+		ConsecutiveFailures: minter.ConsecutiveDistributionFailures,
+		Threshold:           threshold,
+		Error:               distributionErr.Error(),
+	})
+}
+
+// resetDistributionFailures resets the consecutive distribution failures after a successful
+// distribution of the minted coins of the mint denom
+func (k Keeper) resetDistributionFailures(ctx sdk.Context) {
+	minter := k.GetMinter(ctx)
+	if minter.ConsecutiveDistributionFailures == 0 {
+		return
+	}
+	minter.ConsecutiveDistributionFailures = 0
+	k.SetMinter(ctx, minter)
+}
+
+// checkAutoPausedResume rejects params resuming minting while minting is auto paused, the
+// authority resumes it with MsgResumeMinting
+func (k Keeper) checkAutoPausedResume(ctx sdk.Context, params types.Params) error {
+	if !params.PauseMinting && k.GetMinter(ctx).AutoPaused {
+		return errors.Wrap(types.ErrAutoPaused, "minting must be resumed with MsgResumeMinting")
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// failingBankKeeper fails the transfers of the mint module to the accounts while fail is set
+type failingBankKeeper struct {
+	types.BankKeeper
+	fail *bool
+}
+
+func (k failingBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if *k.fail {
+		return errors.New("transfer failed")
+	}
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// mintingAutoPausedEvents returns the EventMintingAutoPaused events emitted in the context
+func mintingAutoPausedEvents(t *testing.T, ctx sdk.Context) []types.EventMintingAutoPaused {
+	var events []types.EventMintingAutoPaused
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventMintingAutoPaused); ok {
+			events = append(events, *e)
+		}
+	}
+	return events
+}
+
+func TestBeginBlockerAutoPause(t *testing.T) {
+	// setup runs the blocks of a test with a funded address the transfers to fail, the block
+	// provision is about 10 tokens
+	setup := func(t *testing.T, threshold uint64) (sdk.Context, testkeeper.TestKeepers, testkeeper.TestMsgServers, *bool) {
+		fail := false
+		ctx, tk, ts := testkeeper.NewTestSetupWithMintBankKeeper(t, func(bk types.BankKeeper) types.BankKeeper {
+			return failingBankKeeper{BankKeeper: bk, fail: &fail}
+		})
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		params.FundedAddresses = []types.WeightedAddress{{Address: sample.Address(r), Weight: sdk.OneDec()}}
+		params.AutoPauseThreshold = threshold
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+		return ctx, tk, ts, &fail
+	}
+	nextBlock := func(ctx sdk.Context) sdk.Context {
+		return ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	}
+
+	t.Run("should pause minting once the distribution failed for the threshold consecutive blocks", func(t *testing.T) {
+		ctx, tk, ts, fail := setup(t, 3)
+		authority := tk.MintKeeper.GetAuthority()
+
+		// a successful distribution resets the count
+		*fail = true
+		ctx = nextBlock(ctx)
+		require.ErrorIs(t, tk.MintKeeper.BeginBlocker(ctx), types.ErrDistributionFailed)
+		require.EqualValues(t, 1, tk.MintKeeper.GetMinter(ctx).ConsecutiveDistributionFailures)
+		*fail = false
+		ctx = nextBlock(ctx)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Zero(t, tk.MintKeeper.GetMinter(ctx).ConsecutiveDistributionFailures)
+
+		*fail = true
+		for i := uint64(1); i <= 3; i++ {
+			ctx = nextBlock(ctx)
+			require.ErrorIs(t, tk.MintKeeper.BeginBlocker(ctx), types.ErrDistributionFailed)
+			minter := tk.MintKeeper.GetMinter(ctx)
+			require.Equal(t, i, minter.ConsecutiveDistributionFailures)
+			require.Equal(t, i == 3, minter.AutoPaused)
+			require.Equal(t, i == 3, tk.MintKeeper.GetParams(ctx).PauseMinting)
+			require.Equal(t, i == 3, len(mintingAutoPausedEvents(t, ctx)) == 1)
+		}
+		event := mintingAutoPausedEvents(t, ctx)[0]
+		require.EqualValues(t, 3, event.ConsecutiveFailures)
+		require.EqualValues(t, 3, event.Threshold)
+		require.Contains(t, event.Error, "transfer failed")
+		change, found := tk.MintKeeper.GetLastParamsChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ParamsChangeSourceAutoPause, change.MsgType)
+
+		// no coin is minted while paused
+		ctx = nextBlock(ctx)
+		supply := tk.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, supply, tk.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+		require.EqualValues(t, 3, tk.MintKeeper.GetMinter(ctx).ConsecutiveDistributionFailures)
+
+		// minting auto paused is only resumed with MsgResumeMinting
+		_, err := ts.MintSrv.SetPaused(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgSetPaused(authority, types.PAUSE_TARGET_MINTING, false),
+		)
+		require.ErrorIs(t, err, types.ErrAutoPaused)
+		params := tk.MintKeeper.GetParams(ctx)
+		params.PauseMinting = false
+		_, err = ts.MintSrv.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.ErrorIs(t, err, types.ErrAutoPaused)
+		_, err = ts.MintSrv.ResumeMinting(sdk.WrapSDKContext(ctx), types.NewMsgResumeMinting(sample.Address(r)))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+
+		_, err = ts.MintSrv.ResumeMinting(sdk.WrapSDKContext(ctx), types.NewMsgResumeMinting(authority))
+		require.NoError(t, err)
+		minter := tk.MintKeeper.GetMinter(ctx)
+		require.Zero(t, minter.ConsecutiveDistributionFailures)
+		require.False(t, minter.AutoPaused)
+		require.False(t, tk.MintKeeper.GetParams(ctx).PauseMinting)
+
+		*fail = false
+		ctx = nextBlock(ctx)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.True(t, tk.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).IsGTE(supply.AddAmount(sdk.OneInt())))
+	})
+
+	t.Run("should roll back the minting and the transfers of a failed distribution", func(t *testing.T) {
+		ctx, tk, _, fail := setup(t, 2)
+		ctx = nextBlock(ctx)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		minter := tk.MintKeeper.GetMinter(ctx)
+		supply := tk.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+		feeCollector := tk.BankKeeper.GetAllBalances(ctx, tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+		totalMinted := tk.MintKeeper.GetTotalMinted(ctx)
+
+		// the staking share is sent before the transfer to the funded address fails
+		*fail = true
+		for i := 0; i < 2; i++ {
+			ctx = nextBlock(ctx)
+			require.ErrorIs(t, tk.MintKeeper.BeginBlocker(ctx), types.ErrDistributionFailed)
+			msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.False(t, broken, msg)
+		}
+		require.Equal(t, supply, tk.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+		require.Equal(t, feeCollector, tk.BankKeeper.GetAllBalances(ctx, tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)))
+		require.Equal(t, totalMinted, tk.MintKeeper.GetTotalMinted(ctx))
+		failed := tk.MintKeeper.GetMinter(ctx)
+		require.Equal(t, minter.CumulativeMinted, failed.CumulativeMinted)
+		require.Equal(t, minter.CumulativeDistributed, failed.CumulativeDistributed)
+		require.True(t, failed.AutoPaused)
+		require.True(t, tk.MintKeeper.GetParams(ctx).PauseMinting)
+	})
+
+	t.Run("should never pause minting with a zero threshold", func(t *testing.T) {
+		ctx, tk, _, fail := setup(t, 0)
+
+		*fail = true
+		for i := 0; i < 5; i++ {
+			ctx = nextBlock(ctx)
+			require.ErrorIs(t, tk.MintKeeper.BeginBlocker(ctx), types.ErrDistributionFailed)
+			require.Empty(t, mintingAutoPausedEvents(t, ctx))
+		}
+		minter := tk.MintKeeper.GetMinter(ctx)
+		require.EqualValues(t, 5, minter.ConsecutiveDistributionFailures)
+		require.False(t, minter.AutoPaused)
+		require.False(t, tk.MintKeeper.GetParams(ctx).PauseMinting)
+	})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// AverageInflationBetween returns the mean of the inflation rates of the recorded blocks weighted
+// by the time each rate applied between from, included, and to, excluded, and the fraction of the
+// period covered by the records. The rate of a block applies until the time of the next block:
+// the time between two records of non-consecutive heights, pruned or not recorded, the time
+// around the records without time and inflation rate, and the time after the last record are not
+// covered. The average and the fraction are truncated, the average is
+// zero if no part of the period is covered.
+func (k Keeper) AverageInflationBetween(ctx sdk.Context, from, to time.Time) (average, coveredFraction sdk.Dec, err error) {
+	if !to.After(from) {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("to time %s must be after from time %s", to, from)
+	}
+
+	var (
+		previous types.BlockDistribution
+		started  bool
+		covered  time.Duration
+		weighted = sdk.ZeroDec()
+	)
+	err = k.IterateBlockDistributions(ctx, 0, func(distribution types.BlockDistribution) bool {
+		if started && distribution.Height == previous.Height+1 && previous.IsTimed() && distribution.IsTimed() {
+			start, end := previous.Time, distribution.Time
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				covered += end.Sub(start)
+				weighted = weighted.Add(previous.Inflation.MulInt64(int64(end.Sub(start))))
+			}
+		}
+		previous, started = distribution, true
+		return !distribution.Time.Before(to)
+	})
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if covered == 0 {
+		return sdk.ZeroDec(), sdk.ZeroDec(), nil
+	}
+	return weighted.QuoInt64(int64(covered)), sdk.NewDec(int64(covered)).QuoInt64(int64(to.Sub(from))), nil
+}
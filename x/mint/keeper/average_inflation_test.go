@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestAverageInflationBetween(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	genesis := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int64) time.Time {
+		return genesis.Add(time.Duration(seconds) * time.Second)
+	}
+
+	// the record of the height 0 was recorded before the time and the inflation rate were tracked
+	tk.MintKeeper.SetBlockDistribution(ctx, types.BlockDistribution{
+		Minted:      sdkmath.NewInt(1),
+		Distributed: types.NewCategoryTotals(),
+	})
+
+	// the block times are irregular and the records of the heights 5 and 6 are missing
+	for _, record := range []struct {
+		height    int64
+		seconds   int64
+		inflation int64
+	}{
+		{height: 1, seconds: 0, inflation: 10},
+		{height: 2, seconds: 2, inflation: 20},
+		{height: 3, seconds: 10, inflation: 30},
+		{height: 4, seconds: 11, inflation: 40},
+		{height: 7, seconds: 30, inflation: 50},
+		{height: 8, seconds: 36, inflation: 60},
+	} {
+		tk.MintKeeper.SetBlockDistribution(ctx, types.BlockDistribution{
+			Height:      record.height,
+			Time:        at(record.seconds),
+			Inflation:   sdk.NewDecWithPrec(record.inflation, 2),
+			Minted:      sdkmath.NewInt(record.height),
+			Distributed: types.NewCategoryTotals(),
+		})
+	}
+
+	tests := []struct {
+		name            string
+		from, to        int64
+		average         string
+		coveredFraction string
+	}{
+		{
+			name:            "should weight the rates by the time to the next block",
+			from:            1,
+			to:              10,
+			average:         "0.188888888888888888",
+			coveredFraction: "1.000000000000000000",
+		},
+		{
+			name:            "should exclude the gap between non-consecutive records and the time after the last record",
+			from:            0,
+			to:              40,
+			average:         "0.300000000000000000",
+			coveredFraction: "0.425000000000000000",
+		},
+		{
+			name:            "should clip the records covering the bounds of the period",
+			from:            5,
+			to:              33,
+			average:         "0.311111111111111111",
+			coveredFraction: "0.321428571428571428",
+		},
+		{
+			name:            "should return zero for a period within a gap",
+			from:            12,
+			to:              29,
+			average:         "0.000000000000000000",
+			coveredFraction: "0.000000000000000000",
+		},
+		{
+			name:            "should return zero for a period before the records",
+			from:            -10,
+			to:              0,
+			average:         "0.000000000000000000",
+			coveredFraction: "0.000000000000000000",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			average, coveredFraction, err := tk.MintKeeper.AverageInflationBetween(ctx, at(tc.from), at(tc.to))
+			require.NoError(t, err)
+			require.Equal(t, tc.average, average.String())
+			require.Equal(t, tc.coveredFraction, coveredFraction.String())
+
+			res, err := querier.NewQuerier(tk.MintKeeper).AverageInflation(sdk.WrapSDKContext(ctx), &types.QueryAverageInflationRequest{
+				FromTime: at(tc.from),
+				ToTime:   at(tc.to),
+			})
+			require.NoError(t, err)
+			require.Equal(t, average, res.AverageInflation)
+			require.Equal(t, coveredFraction, res.CoveredFraction)
+		})
+	}
+
+	t.Run("should reject an empty period", func(t *testing.T) {
+		_, _, err := tk.MintKeeper.AverageInflationBetween(ctx, at(10), at(10))
+		require.Error(t, err)
+
+		_, err = querier.NewQuerier(tk.MintKeeper).AverageInflation(sdk.WrapSDKContext(ctx), &types.QueryAverageInflationRequest{
+			FromTime: at(10),
+			ToTime:   at(5),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// distributeBootstrap sends all the coins minted for the block to the recipient of the bootstrap
+// override instead of distributing them by the distribution proportions. The coins are accounted
+// in the funded addresses category, with the recipient as the only funded address of the block,
+// so the cumulative totals, the distribution history and the income of the recipient stay
+// consistent with the normal distribution.
+func (k Keeper) distributeBootstrap(ctx sdk.Context, override types.BootstrapOverride, mintedCoin sdk.Coin) error {
+	minter := k.GetMinter(ctx)
+	totalsBefore := minter.CumulativeDistributed
+	minter.CumulativeMinted = minter.CumulativeMinted.Add(mintedCoin.Amount)
+	var allocations allocationIndex
+
+	index := allocations.next()
+	coins := sdk.NewCoins(mintedCoin)
+	recipient, err := k.sendBootstrapShare(ctx, override, coins, index)
+	if err != nil {
+		return errorsignite.Wrapf(types.ErrDistributionFailed, "bootstrap recipient %s: %s", override.Recipient, err)
+	}
+	minter.CumulativeDistributed.FundedAddresses = minter.CumulativeDistributed.FundedAddresses.Add(mintedCoin.Amount)
+	k.SetMinter(ctx, minter)
+
+	fundedAddresses := []types.FundedAddressDistribution{{
+		Address:   recipient.String(),
+		Amount:    coins,
+		Recipient: recipient.String(),
+	}}
+	distribution := types.NewBlockDistribution(
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		minter.Inflation,
+		mintedCoin.Amount,
+		totalsBefore,
+		minter.CumulativeDistributed,
+	)
+	distribution.FundedAddresses = fundedAddresses
+	k.SetBlockDistribution(ctx, distribution)
+	k.addFundedAddressIncome(ctx, fundedAddresses)
+
+	distributed := distribution.Distributed
+	k.incrDistributionMetrics(ctx, mintedCoin.Denom, distributed.Staking, distributed.CommunityPool, fundedAddresses)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBootstrapDistribution{
+		Recipient:       override.Recipient,
+		Address:         recipient.String(),
+		Amount:          coins,
+		EndHeight:       override.EndHeight,
+		AllocationIndex: index,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintDistribution{
+		Minted:           mintedCoin,
+		Staking:          sdk.NewCoin(mintedCoin.Denom, distributed.Staking),
+		CommunityPool:    sdk.NewCoin(mintedCoin.Denom, distributed.CommunityPool),
+		FundedAddresses:  fundedAddresses,
+		Dust:             sdk.NewCoin(mintedCoin.Denom, distributed.Dust),
+		StrategicReserve: sdk.NewCoin(mintedCoin.Denom, distributed.StrategicReserve),
+	})
+}
+
+// sendBootstrapShare sends the coins to the recipient of the bootstrap override, a module account
+// or an address, and returns the address of the recipient
+func (k Keeper) sendBootstrapShare(
+	ctx sdk.Context,
+	override types.BootstrapOverride,
+	coins sdk.Coins,
+	index uint32,
+) (sdk.AccAddress, error) {
+	if override.IsModuleAccount() {
+		recipient := k.accountKeeper.GetModuleAddress(override.Recipient)
+		if recipient == nil {
+			return nil, fmt.Errorf("module account %q not found", override.Recipient)
+		}
+		return recipient, transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+			return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, override.Recipient, coins)
+		})
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(override.Recipient)
+	if err != nil {
+		return nil, errorsignite.Critical(err.Error())
+	}
+	return recipient, transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+	})
+}
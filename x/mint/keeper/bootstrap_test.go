@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	claimtypes "github.com/ignite/modules/x/claim/types"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// bootstrapDistributionEvents returns the EventBootstrapDistribution events emitted in the context
+func bootstrapDistributionEvents(t *testing.T, ctx sdk.Context) []types.EventBootstrapDistribution {
+	var events []types.EventBootstrapDistribution
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventBootstrapDistribution" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, *parsed.(*types.EventBootstrapDistribution))
+	}
+	return events
+}
+
+func TestBeginBlockerBootstrapOverride(t *testing.T) {
+	const endHeight = 5
+	recipient := sample.AccAddress(r)
+
+	tests := []struct {
+		name      string
+		recipient string
+		address   sdk.AccAddress
+	}{
+		{
+			name:      "should send the provisions to the bootstrap address",
+			recipient: recipient.String(),
+			address:   recipient,
+		},
+		{
+			name:      "should send the provisions to the bootstrap module account",
+			recipient: claimtypes.ModuleName,
+			address:   authtypes.NewModuleAddress(claimtypes.ModuleName),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, tk, _ := testSetups[0].setup(t)
+			// the block provision is 10 tokens
+			params := lowInflationParams()
+			params.BlocksPerYear = 10
+			params.BootstrapOverride = types.BootstrapOverride{
+				Recipient: tc.recipient,
+				EndHeight: endHeight,
+			}
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+			fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+			feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+			for height := int64(1); height < endHeight; height++ {
+				ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+				coins := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 10))
+				require.Equal(t, []types.EventBootstrapDistribution{{
+					Recipient: tc.recipient,
+					Address:   tc.address.String(),
+					Amount:    coins,
+					EndHeight: endHeight,
+				}}, bootstrapDistributionEvents(t, ctx))
+				distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, height)
+				require.True(t, found)
+				require.Equal(t, []types.FundedAddressDistribution{{
+					Address:   tc.address.String(),
+					Amount:    coins,
+					Recipient: tc.address.String(),
+				}}, distribution.FundedAddresses)
+			}
+			balance := tk.BankKeeper.GetBalance(ctx, tc.address, params.MintDenom).Amount
+			require.Equal(t, sdkmath.NewInt(40), balance)
+			require.True(t, tk.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).IsZero())
+
+			// the override reverts to the distribution proportions at the end height
+			ctx = ctx.WithBlockHeight(endHeight).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+			require.Empty(t, bootstrapDistributionEvents(t, ctx))
+			require.Equal(t, balance, tk.BankKeeper.GetBalance(ctx, tc.address, params.MintDenom).Amount)
+			require.Equal(t, sdkmath.NewInt(3), tk.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount)
+
+			minter := tk.MintKeeper.GetMinter(ctx)
+			require.Equal(t, sdkmath.NewInt(50), minter.CumulativeMinted)
+			require.Equal(t, sdkmath.NewInt(40), minter.CumulativeDistributed.FundedAddresses)
+			require.Equal(t, sdkmath.NewInt(3), minter.CumulativeDistributed.Staking)
+			require.Equal(t, sdkmath.NewInt(7), minter.CumulativeDistributed.CommunityPool)
+			_, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestMsgUpdateParamsBootstrapOverride(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+
+	updateOverride := func(endHeight int64) error {
+		params := tk.MintKeeper.GetParams(sdkCtx)
+		params.BootstrapOverride = types.BootstrapOverride{
+			Recipient: claimtypes.ModuleName,
+			EndHeight: endHeight,
+		}
+		msg := types.NewMsgUpdateParams(authority, params)
+		msg.AcknowledgeLargeChange = true
+		_, err := ts.MintSrv.UpdateParams(ctx, msg)
+		return err
+	}
+
+	require.ErrorIs(t, updateOverride(10), types.ErrBootstrapEnded)
+	require.ErrorIs(t, updateOverride(5), types.ErrBootstrapEnded)
+	require.NoError(t, updateOverride(11))
+
+	// the params can still be updated once the chain has passed the end height of the override
+	sdkCtx = sdkCtx.WithBlockHeight(20)
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	params := tk.MintKeeper.GetParams(sdkCtx)
+	params.BlocksPerYear++
+	msg := types.NewMsgUpdateParams(authority, params)
+	msg.AcknowledgeLargeChange = true
+	_, err := ts.MintSrv.UpdateParams(ctx, msg)
+	require.NoError(t, err)
+	require.ErrorIs(t, updateOverride(15), types.ErrBootstrapEnded)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// BurnCoin burns a coin of the mint denom from an account: the coin is sent to the module account
+// and burned, reducing the supply, and added to the total burned amount of the denom. The coin
+// isn't sent to the module account if it can't be burned. It returns the total burned amount
+// of the denom and emits EventBurn.
+func (k Keeper) BurnCoin(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	if mintDenom := k.GetParams(ctx).MintDenom; coin.Denom != mintDenom {
+		return sdk.Coin{}, errors.Wrapf(types.ErrInvalidBurn, "denom %s is not the mint denom %s", coin.Denom, mintDenom)
+	}
+	if !coin.IsPositive() {
+		return sdk.Coin{}, errors.Wrapf(types.ErrInvalidBurn, "burned amount must be positive: %s", coin)
+	}
+
+	// the coins are burned in a branch of the state so they stay in the account if the burn fails
+	burnCtx, write := ctx.CacheContext()
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(burnCtx, from, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(burnCtx, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+	write()
+
+	total := k.GetTotalBurnedOf(ctx, coin.Denom).Add(coin)
+	k.collections.totalBurned.Set(ctx, total.Denom, total.Amount)
+
+	return total, ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		Address:     from.String(),
+		Amount:      coin,
+		TotalBurned: total,
+	})
+}
+
+// GetTotalBurnedOf returns the total amount of a denom burned with MsgBurn
+func (k Keeper) GetTotalBurnedOf(ctx sdk.Context, denom string) sdk.Coin {
+	amount, found := k.collections.totalBurned.Get(ctx, denom)
+	if !found {
+		amount = sdkmath.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// GetTotalBurned returns the total amount of each denom burned with MsgBurn
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	return iterateTotals(ctx, k.collections.totalBurned)
+}
+
+// iterateTotals returns the total burned or minted amount of each denom of a map of totals
+func iterateTotals(ctx sdk.Context, totals storeMap[string, sdkmath.Int]) sdk.Coins {
+	total := sdk.NewCoins()
+	totals.Iterate(ctx, storeRange{}, func(denom string, amount sdkmath.Int) bool {
+		total = total.Add(sdk.NewCoin(denom, amount))
+		return false
+	})
+	return total
+}
@@ -0,0 +1,492 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkminttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// The state of the module store is declared in a schema of typed items, stored under a single
+// key, and maps, stored under a prefix and iterable. The layout mirrors cosmossdk.io/collections,
+// which requires a newer core API than the cosmos-sdk version of the module, and the keys are the
+// keys of the types package so the state written before the schema is read as is. The keeper
+// accesses the module store only through the items and the maps of the schema.
+
+// storeSchema is the layout of the module store, a key or prefix overlapping a key or prefix
+// already registered is rejected so new state cannot shadow existing state
+type storeSchema struct {
+	storeService corestore.KVStoreService
+	names        []string
+	prefixes     [][]byte
+}
+
+func newStoreSchema(storeService corestore.KVStoreService) *storeSchema {
+	return &storeSchema{storeService: storeService}
+}
+
+// register adds the key or prefix to the schema, it panics if it overlaps a registered key or
+// prefix
+func (s *storeSchema) register(name string, keyPrefix []byte) {
+	for i, registered := range s.prefixes {
+		if bytes.HasPrefix(keyPrefix, registered) || bytes.HasPrefix(registered, keyPrefix) {
+			panic(fmt.Sprintf("store prefix %X of %s overlaps the prefix %X of %s", keyPrefix, name, registered, s.names[i]))
+		}
+	}
+	s.names = append(s.names, name)
+	s.prefixes = append(s.prefixes, keyPrefix)
+}
+
+// valueCodec encodes the values of an item or a map
+type valueCodec[V any] interface {
+	Encode(value V) ([]byte, error)
+	Decode(bz []byte) (V, error)
+}
+
+// protoMessage is a pointer to a proto message of type T
+type protoMessage[T any] interface {
+	*T
+	codec.ProtoMarshaler
+}
+
+// protoValue encodes proto messages with the binary codec of the keeper
+type protoValue[T any, PT protoMessage[T]] struct {
+	cdc codec.BinaryCodec
+}
+
+func (c protoValue[T, PT]) Encode(value T) ([]byte, error) {
+	return c.cdc.Marshal(PT(&value))
+}
+
+func (c protoValue[T, PT]) Decode(bz []byte) (T, error) {
+	var value T
+	err := c.cdc.Unmarshal(bz, PT(&value))
+	return value, err
+}
+
+// intValue encodes integers with their binary encoding
+type intValue struct{}
+
+func (intValue) Encode(value sdkmath.Int) ([]byte, error) {
+	return value.Marshal()
+}
+
+func (intValue) Decode(bz []byte) (sdkmath.Int, error) {
+	value := sdkmath.ZeroInt()
+	err := value.Unmarshal(bz)
+	return value, err
+}
+
+// uint64Value encodes unsigned integers with their big endian encoding
+type uint64Value struct{}
+
+func (uint64Value) Encode(value uint64) ([]byte, error) {
+	return sdk.Uint64ToBigEndian(value), nil
+}
+
+func (uint64Value) Decode(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid uint64 length %d", len(bz))
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// stringValue encodes strings with their bytes
+type stringValue struct{}
+
+func (stringValue) Encode(value string) ([]byte, error) {
+	return []byte(value), nil
+}
+
+func (stringValue) Decode(bz []byte) (string, error) {
+	return string(bz), nil
+}
+
+// heightValue encodes block heights with their big endian encoding
+type heightValue struct{}
+
+func (heightValue) Encode(value int64) ([]byte, error) {
+	return sdk.Uint64ToBigEndian(uint64(value)), nil
+}
+
+func (heightValue) Decode(bz []byte) (int64, error) {
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid height length %d", len(bz))
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// keyCodec encodes the keys of a map, the order of the encoded keys is the order of the iteration
+type keyCodec[K any] interface {
+	Encode(key K) []byte
+	Decode(bz []byte) (K, error)
+}
+
+// stringKey encodes the keys with their bytes, the keys must not be prefixes of each other
+type stringKey struct{}
+
+func (stringKey) Encode(key string) []byte {
+	return []byte(key)
+}
+
+func (stringKey) Decode(bz []byte) (string, error) {
+	return string(bz), nil
+}
+
+// heightKey encodes block heights with their big endian encoding, ordered by height
+type heightKey struct{}
+
+func (heightKey) Encode(key int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(key))
+}
+
+func (heightKey) Decode(bz []byte) (int64, error) {
+	return heightValue{}.Decode(bz)
+}
+
+// addressKey encodes addresses with their length prefixed bytes
+type addressKey struct{}
+
+func (addressKey) Encode(key string) []byte {
+	return address.MustLengthPrefix([]byte(key))
+}
+
+func (addressKey) Decode(bz []byte) (string, error) {
+	if len(bz) == 0 || len(bz) != 1+int(bz[0]) {
+		return "", fmt.Errorf("invalid length prefixed address %X", bz)
+	}
+	return string(bz[1:]), nil
+}
+
+// addressSequence is the key of a record of an address, the records of an address are ordered by
+// sequence
+type addressSequence struct {
+	Address  string
+	Sequence uint64
+}
+
+// addressSequenceKey encodes the key of a record of an address with the length prefixed address
+// followed by the big endian sequence
+type addressSequenceKey struct{}
+
+func (addressSequenceKey) Encode(key addressSequence) []byte {
+	return append(addressSequenceKey{}.Prefix(key.Address), sdk.Uint64ToBigEndian(key.Sequence)...)
+}
+
+func (addressSequenceKey) Decode(bz []byte) (addressSequence, error) {
+	if len(bz) == 0 || len(bz) != 1+int(bz[0])+8 {
+		return addressSequence{}, fmt.Errorf("invalid address sequence key %X", bz)
+	}
+	return addressSequence{
+		Address:  string(bz[1 : 1+int(bz[0])]),
+		Sequence: sdk.BigEndianToUint64(bz[1+int(bz[0]):]),
+	}, nil
+}
+
+// Prefix returns the encoded prefix of the keys of the records of an address
+func (addressSequenceKey) Prefix(addr string) []byte {
+	return address.MustLengthPrefix([]byte(addr))
+}
+
+// storeRange bounds the iteration of a map with encoded keys, the start is included and the end
+// excluded, a nil bound is unbounded
+type storeRange struct {
+	start   []byte
+	end     []byte
+	reverse bool
+}
+
+// prefixRange returns the range of the encoded keys with a prefix
+func prefixRange(keyPrefix []byte) storeRange {
+	return storeRange{start: keyPrefix, end: sdk.PrefixEndBytes(keyPrefix)}
+}
+
+// storeItem is a value stored under a single key
+type storeItem[V any] struct {
+	storeService corestore.KVStoreService
+	key          []byte
+	codec        valueCodec[V]
+}
+
+func newStoreItem[V any](schema *storeSchema, name string, key []byte, codec valueCodec[V]) storeItem[V] {
+	schema.register(name, key)
+	return storeItem[V]{storeService: schema.storeService, key: key, codec: codec}
+}
+
+// Encoded returns the encoded value of the item as stored, nil if the item is not set
+func (i storeItem[V]) Encoded(ctx sdk.Context) []byte {
+	bz, err := i.storeService.OpenKVStore(ctx).Get(i.key)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Load returns the value of the item, found is false if the item is not set. The error of the
+// decoding of the value is returned.
+func (i storeItem[V]) Load(ctx sdk.Context) (value V, found bool, err error) {
+	bz := i.Encoded(ctx)
+	if bz == nil {
+		return value, false, nil
+	}
+	value, err = i.codec.Decode(bz)
+	return value, true, err
+}
+
+// Get returns the value of the item, found is false if the item is not set
+func (i storeItem[V]) Get(ctx sdk.Context) (value V, found bool) {
+	value, found, err := i.Load(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return value, found
+}
+
+// Has returns true if the item is set
+func (i storeItem[V]) Has(ctx sdk.Context) bool {
+	found, err := i.storeService.OpenKVStore(ctx).Has(i.key)
+	if err != nil {
+		panic(err)
+	}
+	return found
+}
+
+// Set sets the value of the item
+func (i storeItem[V]) Set(ctx sdk.Context, value V) {
+	bz, err := i.codec.Encode(value)
+	if err != nil {
+		panic(err)
+	}
+	if err := i.storeService.OpenKVStore(ctx).Set(i.key, bz); err != nil {
+		panic(err)
+	}
+}
+
+// Remove removes the item
+func (i storeItem[V]) Remove(ctx sdk.Context) {
+	if err := i.storeService.OpenKVStore(ctx).Delete(i.key); err != nil {
+		panic(err)
+	}
+}
+
+// storeMap is a set of values stored under a prefix, keyed by encoded keys
+type storeMap[K, V any] struct {
+	storeService corestore.KVStoreService
+	prefix       []byte
+	keys         keyCodec[K]
+	codec        valueCodec[V]
+}
+
+func newStoreMap[K, V any](
+	schema *storeSchema,
+	name string,
+	keyPrefix []byte,
+	keys keyCodec[K],
+	codec valueCodec[V],
+) storeMap[K, V] {
+	schema.register(name, keyPrefix)
+	return storeMap[K, V]{storeService: schema.storeService, prefix: keyPrefix, keys: keys, codec: codec}
+}
+
+func (m storeMap[K, V]) key(key K) []byte {
+	return append(append([]byte{}, m.prefix...), m.keys.Encode(key)...)
+}
+
+// store returns the store of the encoded keys of the map with a prefix, the store of the
+// paginated queries
+func (m storeMap[K, V]) store(ctx sdk.Context, keyPrefix []byte) storetypes.KVStore {
+	return prefix.NewStore(
+		newKVStoreAdapter(m.storeService.OpenKVStore(ctx)),
+		append(append([]byte{}, m.prefix...), keyPrefix...),
+	)
+}
+
+// Get returns the value of the key, found is false if the key is not set
+func (m storeMap[K, V]) Get(ctx sdk.Context, key K) (value V, found bool) {
+	bz, err := m.storeService.OpenKVStore(ctx).Get(m.key(key))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return value, false
+	}
+	value, err = m.codec.Decode(bz)
+	if err != nil {
+		panic(err)
+	}
+	return value, true
+}
+
+// Set sets the value of the key
+func (m storeMap[K, V]) Set(ctx sdk.Context, key K, value V) {
+	bz, err := m.codec.Encode(value)
+	if err != nil {
+		panic(err)
+	}
+	if err := m.storeService.OpenKVStore(ctx).Set(m.key(key), bz); err != nil {
+		panic(err)
+	}
+}
+
+// Remove removes the key
+func (m storeMap[K, V]) Remove(ctx sdk.Context, key K) {
+	if err := m.storeService.OpenKVStore(ctx).Delete(m.key(key)); err != nil {
+		panic(err)
+	}
+}
+
+// Iterate calls cb with the keys and values of the range of the map in the order of the keys, or
+// in the reverse order for a reverse range, until cb returns true
+func (m storeMap[K, V]) Iterate(ctx sdk.Context, r storeRange, cb func(key K, value V) (stop bool)) {
+	it := m.iterator(ctx, r)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key, err := m.keys.Decode(it.Key())
+		if err != nil {
+			panic(err)
+		}
+		value, err := m.codec.Decode(it.Value())
+		if err != nil {
+			panic(err)
+		}
+		if cb(key, value) {
+			return
+		}
+	}
+}
+
+// RemoveRange removes up to limit keys of the range of the map, more is true if keys are left
+// behind. The number of removed keys is returned.
+func (m storeMap[K, V]) RemoveRange(ctx sdk.Context, r storeRange, limit uint64) (removed uint64, more bool) {
+	keys, more := collectKeys(m.iterator(ctx, r), limit)
+	store := m.store(ctx, nil)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return uint64(len(keys)), more
+}
+
+// Clear removes all the keys of the map
+func (m storeMap[K, V]) Clear(ctx sdk.Context) {
+	m.RemoveRange(ctx, storeRange{}, ^uint64(0))
+}
+
+func (m storeMap[K, V]) iterator(ctx sdk.Context, r storeRange) storetypes.Iterator {
+	store := m.store(ctx, nil)
+	if r.reverse {
+		return store.ReverseIterator(r.start, r.end)
+	}
+	return store.Iterator(r.start, r.end)
+}
+
+// storeCollections are the items and maps of the module store declared in its schema
+type storeCollections struct {
+	minter                storeItem[types.Minter]
+	summary               storeItem[types.Summary]
+	fundedAddressHistory  storeMap[addressSequence, types.FundedAddressWeightChange]
+	distributionHistory   storeMap[int64, types.BlockDistribution]
+	paramsChange          storeItem[types.ParamsChange]
+	schemaVersion         storeItem[uint64]
+	feeCollectorName      storeItem[string]
+	fundedAddressIncome   storeMap[string, types.FundedAddressIncome]
+	totalBurned           storeMap[string, sdkmath.Int]
+	inflationSnapshots    storeMap[int64, types.InflationSnapshot]
+	moduleVersion         storeItem[types.ModuleVersion]
+	totalMinted           storeMap[string, sdkmath.Int]
+	dustAccumulator       storeItem[sdk.Coin]
+	lastMaintenanceHeight storeItem[int64]
+	initializedHeight     storeItem[int64]
+	sdkMint               sdkMintCollections
+}
+
+// newStoreCollections declares the schema of the module store
+func newStoreCollections(cdc codec.BinaryCodec, storeService corestore.KVStoreService) storeCollections {
+	schema := newStoreSchema(storeService)
+	return storeCollections{
+		minter:  newStoreItem[types.Minter](schema, "minter", types.MinterKey, protoValue[types.Minter, *types.Minter]{cdc: cdc}),
+		summary: newStoreItem[types.Summary](schema, "summary", types.SummaryKey, protoValue[types.Summary, *types.Summary]{cdc: cdc}),
+		fundedAddressHistory: newStoreMap[addressSequence, types.FundedAddressWeightChange](
+			schema,
+			"funded_address_history",
+			types.FundedAddressHistoryKeyPrefix,
+			addressSequenceKey{},
+			protoValue[types.FundedAddressWeightChange, *types.FundedAddressWeightChange]{cdc: cdc},
+		),
+		distributionHistory: newStoreMap[int64, types.BlockDistribution](
+			schema,
+			"distribution_history",
+			types.DistributionHistoryKeyPrefix,
+			heightKey{},
+			protoValue[types.BlockDistribution, *types.BlockDistribution]{cdc: cdc},
+		),
+		paramsChange: newStoreItem[types.ParamsChange](
+			schema,
+			"params_change",
+			types.ParamsChangeKey,
+			protoValue[types.ParamsChange, *types.ParamsChange]{cdc: cdc},
+		),
+		schemaVersion:    newStoreItem[uint64](schema, "schema_version", types.SchemaVersionKey, uint64Value{}),
+		feeCollectorName: newStoreItem[string](schema, "fee_collector_name", types.FeeCollectorNameKey, stringValue{}),
+		fundedAddressIncome: newStoreMap[string, types.FundedAddressIncome](
+			schema,
+			"funded_address_income",
+			types.FundedAddressIncomeKeyPrefix,
+			addressKey{},
+			protoValue[types.FundedAddressIncome, *types.FundedAddressIncome]{cdc: cdc},
+		),
+		totalBurned: newStoreMap[string, sdkmath.Int](schema, "total_burned", types.TotalBurnedKeyPrefix, stringKey{}, intValue{}),
+		inflationSnapshots: newStoreMap[int64, types.InflationSnapshot](
+			schema,
+			"inflation_snapshot",
+			types.InflationSnapshotKeyPrefix,
+			heightKey{},
+			protoValue[types.InflationSnapshot, *types.InflationSnapshot]{cdc: cdc},
+		),
+		moduleVersion: newStoreItem[types.ModuleVersion](
+			schema,
+			"module_version",
+			types.ModuleVersionKey,
+			protoValue[types.ModuleVersion, *types.ModuleVersion]{cdc: cdc},
+		),
+		totalMinted:           newStoreMap[string, sdkmath.Int](schema, "total_minted", types.TotalMintedKeyPrefix, stringKey{}, intValue{}),
+		dustAccumulator:       newStoreItem[sdk.Coin](schema, "dust_accumulator", types.DustAccumulatorKey, protoValue[sdk.Coin, *sdk.Coin]{cdc: cdc}),
+		lastMaintenanceHeight: newStoreItem[int64](schema, "last_maintenance_height", types.LastMaintenanceHeightKey, heightValue{}),
+		initializedHeight:     newStoreItem[int64](schema, "initialized_height", types.InitializedHeightKey, heightValue{}),
+		sdkMint:               newSDKMintCollections(cdc, storeService),
+	}
+}
+
+// sdkMintCollections are the items of the store written by the cosmos-sdk x/mint module, read
+// once by the migration of the store. The keys overlap the keys of the module so they are
+// declared in a schema of their own.
+type sdkMintCollections struct {
+	minter storeItem[sdkminttypes.Minter]
+	params storeItem[sdkminttypes.Params]
+}
+
+func newSDKMintCollections(cdc codec.BinaryCodec, storeService corestore.KVStoreService) sdkMintCollections {
+	schema := newStoreSchema(storeService)
+	return sdkMintCollections{
+		minter: newStoreItem[sdkminttypes.Minter](
+			schema,
+			"sdk_mint_minter",
+			sdkminttypes.MinterKey,
+			protoValue[sdkminttypes.Minter, *sdkminttypes.Minter]{cdc: cdc},
+		),
+		params: newStoreItem[sdkminttypes.Params](
+			schema,
+			"sdk_mint_params",
+			sdkminttypes.ParamsKey,
+			protoValue[sdkminttypes.Params, *sdkminttypes.Params]{cdc: cdc},
+		),
+	}
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// the raw store code path of the keeper before the store collections, kept to check the layout
+// of the store is unchanged
+
+func rawSetMinter(ctx sdk.Context, cdc codec.BinaryCodec, minter types.Minter) {
+	ctx.KVStore(mintStoreKey(ctx)).Set(types.MinterKey, cdc.MustMarshal(&minter))
+}
+
+func rawGetMinter(ctx sdk.Context, cdc codec.BinaryCodec) (minter types.Minter) {
+	cdc.MustUnmarshal(ctx.KVStore(mintStoreKey(ctx)).Get(types.MinterKey), &minter)
+	return minter
+}
+
+func rawSetTotalMinted(ctx sdk.Context, total sdk.Coin) {
+	bz, err := total.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(mintStoreKey(ctx)).Set(types.TotalMintedKey(total.Denom), bz)
+}
+
+func TestStoreCollectionsCompatibility(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
+	minter := types.DefaultInitialMinter()
+	minter.Inflation = sdk.NewDecWithPrec(9, 2)
+	minter.AnnualProvisions = sdk.NewDec(1_000_000)
+	minter.CumulativeMinted = sdkmath.NewInt(5000)
+	minter.TargetCumulativeEmission = sdk.NewDec(5000)
+
+	t.Run("should read the minter written with the raw store", func(t *testing.T) {
+		rawSetMinter(ctx, cdc, minter)
+		require.Equal(t, minter, tk.MintKeeper.GetMinter(ctx))
+	})
+
+	t.Run("should write the minter with the layout of the raw store", func(t *testing.T) {
+		minter.Inflation = sdk.NewDecWithPrec(11, 2)
+		minter.CumulativeMinted = sdkmath.NewInt(6000)
+		tk.MintKeeper.SetMinter(ctx, minter)
+		require.Equal(t, cdc.MustMarshal(&minter), ctx.KVStore(mintStoreKey(ctx)).Get(types.MinterKey))
+		require.Equal(t, minter, rawGetMinter(ctx, cdc))
+	})
+
+	t.Run("should read the totals written with the raw store", func(t *testing.T) {
+		rawSetTotalMinted(ctx, sdk.NewCoin("bar", sdkmath.NewInt(10)))
+		rawSetTotalMinted(ctx, sdk.NewCoin("foo", sdkmath.NewInt(20)))
+		require.Equal(t, sdk.NewCoin("foo", sdkmath.NewInt(20)), tk.MintKeeper.GetTotalMintedOf(ctx, "foo"))
+		require.Equal(t, sdk.NewCoin("baz", sdkmath.ZeroInt()), tk.MintKeeper.GetTotalMintedOf(ctx, "baz"))
+		require.Equal(t, sdk.NewCoins(
+			sdk.NewCoin("bar", sdkmath.NewInt(10)),
+			sdk.NewCoin("foo", sdkmath.NewInt(20)),
+		), tk.MintKeeper.GetTotalMinted(ctx))
+	})
+
+	t.Run("should write the totals with the layout of the raw store", func(t *testing.T) {
+		tk.MintKeeper.SetTotalMinted(ctx, sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(30))))
+		store := ctx.KVStore(mintStoreKey(ctx))
+		require.Nil(t, store.Get(types.TotalMintedKey("bar")))
+		bz, err := sdkmath.NewInt(30).Marshal()
+		require.NoError(t, err)
+		require.Equal(t, bz, store.Get(types.TotalMintedKey("foo")))
+	})
+
+	t.Run("should write the histories with the layout of the raw store", func(t *testing.T) {
+		store := ctx.KVStore(mintStoreKey(ctx))
+		distribution := types.BlockDistribution{Height: 42, Minted: sdkmath.NewInt(10), Inflation: sdk.NewDecWithPrec(8, 2)}
+		tk.MintKeeper.SetBlockDistribution(ctx, distribution)
+		require.Equal(t, cdc.MustMarshal(&distribution), store.Get(types.DistributionHistoryKey(42)))
+
+		addr := sample.Address(sample.Rand())
+		for i := int64(1); i <= 2; i++ {
+			change := types.FundedAddressWeightChange{
+				Address:   addr,
+				Height:    i,
+				OldWeight: sdk.ZeroDec(),
+				NewWeight: sdk.OneDec(),
+				Action:    types.WeightChangeAdded,
+			}
+			tk.MintKeeper.AppendFundedAddressWeightChange(ctx, change)
+			require.Equal(t, cdc.MustMarshal(&change), store.Get(types.FundedAddressHistoryKey(addr, uint64(i-1))))
+		}
+	})
+
+	t.Run("should read the histories written with the raw store", func(t *testing.T) {
+		store := ctx.KVStore(mintStoreKey(ctx))
+		snapshot := types.InflationSnapshot{
+			Height:           7,
+			Inflation:        sdk.NewDecWithPrec(8, 2),
+			AnnualProvisions: sdk.NewDec(100),
+			BondedRatio:      sdk.NewDecWithPrec(5, 1),
+		}
+		store.Set(types.InflationSnapshotKey(7), cdc.MustMarshal(&snapshot))
+		got, found := tk.MintKeeper.GetInflationSnapshot(ctx, 7)
+		require.True(t, found)
+		require.Equal(t, snapshot, got)
+
+		addr := sample.Address(sample.Rand())
+		change := types.FundedAddressWeightChange{
+			Address:   addr,
+			Height:    3,
+			OldWeight: sdk.ZeroDec(),
+			NewWeight: sdk.OneDec(),
+			Action:    types.WeightChangeAdded,
+		}
+		store.Set(types.FundedAddressHistoryKey(addr, 4), cdc.MustMarshal(&change))
+		tk.MintKeeper.AppendFundedAddressWeightChange(ctx, change)
+		require.NotNil(t, store.Get(types.FundedAddressHistoryKey(addr, 5)))
+	})
+}
+
+func BenchmarkMinterStore(b *testing.B) {
+	ctx, tk, _ := testSetups[0].setup(b)
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	minter := tk.MintKeeper.GetMinter(ctx)
+
+	b.Run("raw/get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rawGetMinter(ctx, cdc)
+		}
+	})
+	b.Run("raw/set", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rawSetMinter(ctx, cdc, minter)
+		}
+	})
+	// the keeper also checks the cumulative counters on read and refreshes the summary on write
+	b.Run("collections/get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tk.MintKeeper.GetMinter(ctx)
+		}
+	})
+	b.Run("collections/set", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tk.MintKeeper.SetMinter(ctx, minter)
+		}
+	})
+}
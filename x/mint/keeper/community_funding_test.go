@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// communityFundingFloorEvents returns the EventCommunityFundingFloor events emitted in the context
+func communityFundingFloorEvents(ctx sdk.Context) (events []*types.EventCommunityFundingFloor) {
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventCommunityFundingFloor); ok {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func TestBeginBlockerCommunityFundingFloor(t *testing.T) {
+	t.Run("should reallocate the staking share in the final blocks of the year", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		// the block provision is 10 tokens, 3 for staking and 7 for the community pool
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		params.CommunityFundingWindow = 2
+		params.MinAnnualCommunityFunding = sdk.NewInt64Coin(params.MintDenom, 100)
+		params.CommunityFundingPriority = []types.CommunityFundingSource{types.COMMUNITY_FUNDING_SOURCE_STAKING}
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+
+		var floorEvents []*types.EventCommunityFundingFloor
+		for height := int64(1); height <= 10; height++ {
+			ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+			floorEvents = append(floorEvents, communityFundingFloorEvents(ctx)...)
+		}
+
+		// the shortfall is spread over the window within the staking share of the blocks
+		require.Equal(t, []*types.EventCommunityFundingFloor{
+			{
+				BudgetYear:  0,
+				Shortfall:   sdkmath.NewInt(37),
+				Minted:      sdkmath.ZeroInt(),
+				Reallocated: sdkmath.NewInt(3),
+			},
+			{
+				BudgetYear:  0,
+				Shortfall:   sdkmath.NewInt(27),
+				Minted:      sdkmath.ZeroInt(),
+				Reallocated: sdkmath.NewInt(3),
+			},
+		}, floorEvents)
+		minter := tk.MintKeeper.GetMinter(ctx)
+		require.Equal(t, sdkmath.NewInt(76), minter.CumulativeDistributed.CommunityPool)
+		require.Equal(t, sdkmath.NewInt(24), minter.CumulativeDistributed.Staking)
+		feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		require.Equal(t, sdkmath.NewInt(24), tk.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount)
+
+		// the funding of the next budget year starts from the community pool total
+		ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Empty(t, communityFundingFloorEvents(ctx))
+		require.Equal(t, types.NewCommunityFunding(1, sdkmath.NewInt(76)), tk.MintKeeper.GetMinter(ctx).CommunityFunding)
+
+		_, broken := keeper.CumulativeCountersInvariant(tk.MintKeeper)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should mint the top-up within the maximum inflation rate", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		// the block provision is 10 tokens at the 10% inflation rate and up to 50 tokens at the
+		// maximum inflation rate
+		params := lowInflationParams()
+		// the smallest rate change is rounded off the inflation of each block, the inflation is constant
+		params.InflationRateChange = sdk.SmallestDec()
+		params.InflationMax = sdk.NewDecWithPrec(5, 1)
+		params.BlocksPerYear = 10
+		params.CommunityFundingWindow = 1
+		params.MinAnnualCommunityFunding = sdk.NewInt64Coin(params.MintDenom, 100)
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMin))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+
+		ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+		require.Equal(t, []*types.EventCommunityFundingFloor{
+			{
+				BudgetYear:  0,
+				Shortfall:   sdkmath.NewInt(93),
+				Minted:      sdkmath.NewInt(40),
+				Reallocated: sdkmath.NewInt(3),
+			},
+		}, communityFundingFloorEvents(ctx))
+		require.Equal(t, sdkmath.NewInt(1050), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+		communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+		require.Equal(t, sdkmath.NewInt(50), communityPool.AmountOf(params.MintDenom))
+
+		minter := tk.MintKeeper.GetMinter(ctx)
+		require.Equal(t, sdkmath.NewInt(50), minter.CumulativeMinted)
+		require.Equal(t, sdkmath.NewInt(50), minter.CumulativeDistributed.CommunityPool)
+		distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, 10)
+		require.True(t, found)
+		require.Equal(t, sdkmath.NewInt(50), distribution.Minted)
+		_, broken := keeper.CumulativeCountersInvariant(tk.MintKeeper)(ctx)
+		require.False(t, broken)
+	})
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// TestConcurrentReads reads the params and the minter from goroutines on branched query contexts
+// while blocks are committed, it detects the shared mutable state of the keeper when the tests are
+// run with the race detector. The query contexts are created by the writer between the blocks since
+// the creation of a query context is not synchronized with the commit of a block, and the IAVL fast
+// nodes are disabled since their reads are not synchronized with the commit either.
+func TestConcurrentReads(t *testing.T) {
+	app := setup(
+		false,
+		baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager()),
+		baseapp.SetIAVLDisableFastNode(true),
+	)
+	commitBlocks(app, 1)
+
+	const (
+		readers = 4
+		blocks  = 20
+	)
+	var (
+		mu       sync.RWMutex
+		queryCtx sdk.Context
+	)
+	snapshot := func() {
+		ctx, err := app.CreateQueryContext(0, false)
+		require.NoError(t, err)
+		mu.Lock()
+		queryCtx = ctx
+		mu.Unlock()
+	}
+	snapshot()
+
+	done := make(chan struct{})
+	errs := make(chan error, readers)
+	var wg sync.WaitGroup
+	for i := 0; i < readers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q := querier.NewQuerier(app.MintKeeper)
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				// the branch has its own gas meter, the meter of the query context is not
+				// synchronized
+				mu.RLock()
+				ctx, _ := queryCtx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+				mu.RUnlock()
+				params := app.MintKeeper.GetParams(ctx)
+				if err := params.Validate(); err != nil {
+					errs <- err
+					return
+				}
+				minter := app.MintKeeper.GetMinter(ctx)
+				if err := minter.Validate(); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := q.Minter(sdk.WrapSDKContext(ctx), &types.QueryMinterRequest{}); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := q.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{}); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	// the writer commits blocks minting and distributing coins
+	blockTime := time.Now().UTC()
+	for i := 0; i < blocks; i++ {
+		blockTime = blockTime.Add(5 * time.Second)
+		ctx, _ := beginBlock(app, blockTime)
+		endBlock(app, ctx)
+		snapshot()
+	}
+	close(done)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, app.LastBlockHeight(), queryCtx.BlockHeight())
+	require.True(t, app.MintKeeper.GetMinter(queryCtx).CumulativeMinted.IsPositive())
+}
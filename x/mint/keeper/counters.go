@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// checkCounters checks the cumulative minted amount is consistent with the category totals, the
+// buffered paused shares and the dust accumulator. The category totals are authoritative, the cumulative minted amount is
+// recomputed from them when self-healing is enabled.
+func (k Keeper) checkCounters(ctx sdk.Context, minter *types.Minter) {
+	expected := k.expectedCumulativeMinted(ctx, *minter)
+	if minter.CumulativeMinted.Equal(expected) {
+		return
+	}
+
+	k.reportInconsistency(ctx, types.CounterCumulativeMinted, minter.CumulativeMinted, expected)
+	if k.selfHeal {
+		minter.CumulativeMinted = expected
+	}
+}
+
+// expectedCumulativeMinted returns the cumulative minted amount derived from the totals of the
+// minter and the dust carried over to the next block, which is minted but not yet distributed
+func (k Keeper) expectedCumulativeMinted(ctx sdk.Context, minter types.Minter) sdkmath.Int {
+	return minter.ExpectedCumulativeMinted().Add(types.TotalAmount(k.carriedDust(ctx)))
+}
+
+// enforceMonotonicCounters checks the cumulative counters of the minter to set don't decrease compared
+// to the stored minter. The decreased counters are set back to their stored value when self-healing
+// is enabled.
+func (k Keeper) enforceMonotonicCounters(ctx sdk.Context, stored types.Minter, minter *types.Minter) {
+	minter.CumulativeDistributed.Normalize()
+	if minter.CumulativeMinted.IsNil() {
+		minter.CumulativeMinted = sdkmath.ZeroInt()
+	}
+	if minter.CumulativeFunded.IsNil() {
+		minter.CumulativeFunded = sdkmath.ZeroInt()
+	}
+
+	for _, counter := range []struct {
+		name   string
+		stored sdkmath.Int
+		value  *sdkmath.Int
+	}{
+		{name: types.CounterCumulativeMinted, stored: stored.CumulativeMinted, value: &minter.CumulativeMinted},
+		{name: types.CounterCumulativeFunded, stored: stored.CumulativeFunded, value: &minter.CumulativeFunded},
+		{name: types.CategoryStaking, stored: stored.CumulativeDistributed.Staking, value: &minter.CumulativeDistributed.Staking},
+		{name: types.CategoryFundedAddresses, stored: stored.CumulativeDistributed.FundedAddresses, value: &minter.CumulativeDistributed.FundedAddresses},
+		{name: types.CategoryCommunityPool, stored: stored.CumulativeDistributed.CommunityPool, value: &minter.CumulativeDistributed.CommunityPool},
+		{name: types.CounterDust, stored: stored.CumulativeDistributed.Dust, value: &minter.CumulativeDistributed.Dust},
+		{name: types.CategoryStrategicReserve, stored: stored.CumulativeDistributed.StrategicReserve, value: &minter.CumulativeDistributed.StrategicReserve},
+	} {
+		if counter.value.GTE(counter.stored) {
+			continue
+		}
+
+		k.reportInconsistency(ctx, counter.name, *counter.value, counter.stored)
+		if k.selfHeal {
+			*counter.value = counter.stored
+		}
+	}
+}
+
+// reportInconsistency logs a critical error and emits an event for an inconsistent counter
+func (k Keeper) reportInconsistency(ctx sdk.Context, counter string, found, expected sdkmath.Int) {
+	k.Logger(ctx).Error(errorsignite.Critical(fmt.Sprintf(
+		"inconsistent %s counter: found %s, expected %s, self-healing enabled: %t",
+		counter,
+		found,
+		expected,
+		k.selfHeal,
+	)).Error())
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCounterInconsistency{
+		Counter:  counter,
+		Found:    found,
+		Expected: expected,
+		Healed:   k.selfHeal,
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to emit counter inconsistency event: %s", err))
+	}
+}
@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// counterInconsistencies returns the counters reported as inconsistent in the events
+func counterInconsistencies(ctx sdk.Context) (counters []string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventCounterInconsistency" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "counter" {
+				counters = append(counters, strings.Trim(attr.Value, `"`))
+			}
+		}
+	}
+	return counters
+}
+
+// consistentMinter returns a minter with consistent non-zero cumulative counters
+func consistentMinter() types.Minter {
+	minter := types.DefaultInitialMinter()
+	minter.CumulativeDistributed = types.CategoryTotals{
+		Staking:         sdkmath.NewInt(100),
+		FundedAddresses: sdkmath.NewInt(100),
+		CommunityPool:   sdkmath.NewInt(100),
+		Dust:            sdkmath.NewInt(100),
+	}
+	minter.PausedShares.Staking = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	minter.CumulativeMinted = sdkmath.NewInt(500)
+	return minter
+}
+
+func TestCheckCounters(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(*types.Minter)
+	}{
+		{
+			name: "cumulative minted",
+			corrupt: func(m *types.Minter) {
+				m.CumulativeMinted = sdkmath.NewInt(600)
+			},
+		},
+		{
+			name: "staking total",
+			corrupt: func(m *types.Minter) {
+				m.CumulativeDistributed.Staking = sdkmath.NewInt(200)
+			},
+		},
+		{
+			name: "funded addresses total",
+			corrupt: func(m *types.Minter) {
+				m.CumulativeDistributed.FundedAddresses = sdkmath.NewInt(200)
+			},
+		},
+		{
+			name: "community pool total",
+			corrupt: func(m *types.Minter) {
+				m.CumulativeDistributed.CommunityPool = sdkmath.NewInt(200)
+			},
+		},
+		{
+			name: "dust total",
+			corrupt: func(m *types.Minter) {
+				m.CumulativeDistributed.Dust = sdkmath.NewInt(200)
+			},
+		},
+		{
+			name: "paused shares",
+			corrupt: func(m *types.Minter) {
+				m.PausedShares.CommunityPool = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			},
+		},
+	}
+	for _, selfHeal := range []bool{false, true} {
+		var opts []keeper.KeeperOption
+		name := "without self-healing"
+		if selfHeal {
+			opts = append(opts, keeper.WithSelfHeal())
+			name = "with self-healing"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Run("should not detect inconsistencies with consistent counters", func(t *testing.T) {
+				ctx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, opts...)
+				tk.MintKeeper.SetMinter(ctx, consistentMinter())
+
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+				require.Equal(t, sdkmath.NewInt(500), tk.MintKeeper.GetMinter(ctx).CumulativeMinted)
+				require.Empty(t, counterInconsistencies(ctx))
+				msg, broken := keeper.CumulativeCountersInvariant(tk.MintKeeper)(ctx)
+				require.False(t, broken, msg)
+			})
+
+			for _, tc := range tests {
+				tc := tc
+				t.Run("should detect corrupted "+tc.name, func(t *testing.T) {
+					ctx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, opts...)
+					minter := consistentMinter()
+					tc.corrupt(&minter)
+					tk.MintKeeper.SetMinter(ctx, minter)
+
+					_, broken := keeper.CumulativeCountersInvariant(tk.MintKeeper)(ctx)
+					require.True(t, broken)
+
+					ctx = ctx.WithEventManager(sdk.NewEventManager())
+					got := tk.MintKeeper.GetMinter(ctx)
+					require.Equal(t, []string{types.CounterCumulativeMinted}, counterInconsistencies(ctx))
+					if selfHeal {
+						require.Equal(t, got.ExpectedCumulativeMinted(), got.CumulativeMinted)
+					} else {
+						require.Equal(t, minter.CumulativeMinted, got.CumulativeMinted)
+					}
+				})
+
+				t.Run("should read corrupted "+tc.name+" as stored", func(t *testing.T) {
+					ctx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, opts...)
+					minter := consistentMinter()
+					tc.corrupt(&minter)
+					tk.MintKeeper.SetMinter(ctx, minter)
+
+					ctx = ctx.WithEventManager(sdk.NewEventManager())
+					require.Equal(t, minter.CumulativeMinted, tk.MintKeeper.ReadMinter(ctx).CumulativeMinted)
+					res, err := querier.NewQuerier(tk.MintKeeper).Minter(sdk.WrapSDKContext(ctx), &types.QueryMinterRequest{})
+					require.NoError(t, err)
+					require.Equal(t, minter.CumulativeMinted, res.Minter.CumulativeMinted)
+					require.Empty(t, counterInconsistencies(ctx))
+				})
+			}
+		})
+	}
+}
+
+func TestEnforceMonotonicCounters(t *testing.T) {
+	tests := []struct {
+		name     string
+		counter  string
+		decrease func(*types.Minter)
+		value    func(types.Minter) sdkmath.Int
+	}{
+		{
+			name:    "cumulative minted",
+			counter: types.CounterCumulativeMinted,
+			decrease: func(m *types.Minter) {
+				m.CumulativeMinted = sdkmath.NewInt(400)
+			},
+			value: func(m types.Minter) sdkmath.Int { return m.CumulativeMinted },
+		},
+		{
+			name:    "staking total",
+			counter: types.CategoryStaking,
+			decrease: func(m *types.Minter) {
+				m.CumulativeDistributed.Staking = sdkmath.NewInt(50)
+			},
+			value: func(m types.Minter) sdkmath.Int { return m.CumulativeDistributed.Staking },
+		},
+		{
+			name:    "funded addresses total",
+			counter: types.CategoryFundedAddresses,
+			decrease: func(m *types.Minter) {
+				m.CumulativeDistributed.FundedAddresses = sdkmath.NewInt(50)
+			},
+			value: func(m types.Minter) sdkmath.Int { return m.CumulativeDistributed.FundedAddresses },
+		},
+		{
+			name:    "community pool total",
+			counter: types.CategoryCommunityPool,
+			decrease: func(m *types.Minter) {
+				m.CumulativeDistributed.CommunityPool = sdkmath.NewInt(50)
+			},
+			value: func(m types.Minter) sdkmath.Int { return m.CumulativeDistributed.CommunityPool },
+		},
+		{
+			name:    "dust total",
+			counter: types.CounterDust,
+			decrease: func(m *types.Minter) {
+				m.CumulativeDistributed.Dust = sdkmath.NewInt(50)
+			},
+			value: func(m types.Minter) sdkmath.Int { return m.CumulativeDistributed.Dust },
+		},
+	}
+	for _, selfHeal := range []bool{false, true} {
+		var opts []keeper.KeeperOption
+		name := "without self-healing"
+		if selfHeal {
+			opts = append(opts, keeper.WithSelfHeal())
+			name = "with self-healing"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			for _, tc := range tests {
+				tc := tc
+				t.Run("should detect decreased "+tc.name, func(t *testing.T) {
+					ctx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, opts...)
+					tk.MintKeeper.SetMinter(ctx, consistentMinter())
+
+					ctx = ctx.WithEventManager(sdk.NewEventManager())
+					minter := consistentMinter()
+					tc.decrease(&minter)
+					tk.MintKeeper.SetMinter(ctx, minter)
+					require.Equal(t, []string{tc.counter}, counterInconsistencies(ctx))
+
+					stored := tk.MintKeeper.GetMinter(ctx)
+					if selfHeal {
+						require.Equal(t, tc.value(consistentMinter()), tc.value(stored))
+					} else {
+						require.Equal(t, tc.value(minter), tc.value(stored))
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestDistributionCounters(t *testing.T) {
+	for _, ts := range testSetups {
+		ts := ts
+		t.Run(ts.name, func(t *testing.T) {
+			t.Run("should keep the counters consistent when distributing", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				params.BlocksPerYear = 10
+				params.FundedAddresses = []types.WeightedAddress{
+					{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(3, 1)},
+					{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(7, 1)},
+				}
+				params.PauseCommunityShare = true
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+				for i := 0; i < 3; i++ {
+					require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				}
+				require.Empty(t, counterInconsistencies(ctx))
+				msg, broken := keeper.CumulativeCountersInvariant(tk.MintKeeper)(ctx)
+				require.False(t, broken, msg)
+
+				// 10 tokens per block: 3 for staking, 1 and 2 for the funded addresses with 1 of dust,
+				// and 3 buffered for the paused community pool
+				minter := tk.MintKeeper.GetMinter(ctx)
+				require.Equal(t, sdkmath.NewInt(30), minter.CumulativeMinted)
+				require.Equal(t, sdkmath.NewInt(9), minter.CumulativeDistributed.Staking)
+				require.Equal(t, sdkmath.NewInt(9), minter.CumulativeDistributed.FundedAddresses)
+				require.Equal(t, sdkmath.NewInt(3), minter.CumulativeDistributed.Dust)
+				require.Equal(t, sdkmath.ZeroInt(), minter.CumulativeDistributed.CommunityPool)
+				require.Equal(t, sdkmath.NewInt(9), types.TotalAmount(minter.PausedShares.Total()))
+			})
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetDenomConsistency compares the staking token supply with the bank supply of the mint denom.
+// The supplies mismatch if the mint denom is the bond denom and only one of them is zero, this
+// happens when the bond denom changes during a chain upgrade while the mint denom is not updated.
+func (k Keeper) GetDenomConsistency(ctx sdk.Context, mintDenom string, stakingSupply sdkmath.Int) types.DenomConsistency {
+	consistency := types.DenomConsistency{
+		MintDenom:     mintDenom,
+		BondDenom:     k.stakingKeeper.BondDenom(ctx),
+		StakingSupply: stakingSupply,
+		BankSupply:    k.bankKeeper.GetSupply(ctx, mintDenom).Amount,
+	}
+	if consistency.MintDenom == consistency.BondDenom {
+		consistency.Mismatch = consistency.StakingSupply.IsZero() != consistency.BankSupply.IsZero()
+	}
+	return consistency
+}
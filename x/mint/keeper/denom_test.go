@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// mockStakingKeeper overrides the staking token supply and the bond denom of the staking keeper
+type mockStakingKeeper struct {
+	types.StakingKeeper
+	stakingSupply *sdkmath.Int
+	bondDenom     string
+}
+
+func (k *mockStakingKeeper) StakingTokenSupply(ctx sdk.Context) sdkmath.Int {
+	if k.stakingSupply != nil {
+		return *k.stakingSupply
+	}
+	return k.StakingKeeper.StakingTokenSupply(ctx)
+}
+
+func (k *mockStakingKeeper) BondDenom(ctx sdk.Context) string {
+	if k.bondDenom != "" {
+		return k.bondDenom
+	}
+	return k.StakingKeeper.BondDenom(ctx)
+}
+
+func TestBeginBlockerDenomMismatch(t *testing.T) {
+	supply := func(amount int64) *sdkmath.Int {
+		i := sdkmath.NewInt(amount)
+		return &i
+	}
+
+	tests := []struct {
+		name          string
+		stakingSupply *sdkmath.Int
+		bondDenom     string
+		bankSupply    int64
+		mismatch      bool
+	}{
+		{
+			name:          "should skip minting when the staking supply is zero",
+			stakingSupply: supply(0),
+			bankSupply:    1000,
+			mismatch:      true,
+		},
+		{
+			name:          "should skip minting when the bank supply is zero",
+			stakingSupply: supply(1000),
+			mismatch:      true,
+		},
+		{
+			name:       "should mint when the supplies are consistent",
+			bankSupply: 1000,
+		},
+		{
+			name:          "should mint when the mint denom is not the bond denom",
+			stakingSupply: supply(1000),
+			bondDenom:     "newbond",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stakingKeeper := &mockStakingKeeper{stakingSupply: tc.stakingSupply, bondDenom: tc.bondDenom}
+			ctx, tk, _ := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+				stakingKeeper.StakingKeeper = sk
+				return stakingKeeper
+			})
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			params := lowInflationParams()
+			params.BlocksPerYear = 10
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+			if tc.bankSupply > 0 {
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, tc.bankSupply)))
+			}
+			minter := tk.MintKeeper.GetMinter(ctx)
+
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+			var mismatchEvents []*types.EventDenomMismatch
+			for _, event := range ctx.EventManager().Events() {
+				msg, err := sdk.ParseTypedEvent(abci.Event(event))
+				if err != nil {
+					continue
+				}
+				if e, ok := msg.(*types.EventDenomMismatch); ok {
+					mismatchEvents = append(mismatchEvents, e)
+				}
+			}
+
+			res, err := querier.NewQuerier(tk.MintKeeper).Status(sdk.WrapSDKContext(ctx), &types.QueryStatusRequest{})
+			require.NoError(t, err)
+			require.Equal(t, types.NewPauseState(params), res.PauseState)
+
+			if !tc.mismatch {
+				require.Empty(t, mismatchEvents)
+				require.True(t, tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.GT(sdkmath.NewInt(tc.bankSupply)))
+				require.False(t, res.DenomConsistency.Mismatch)
+				return
+			}
+
+			require.Equal(t, sdkmath.NewInt(tc.bankSupply), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+			require.Equal(t, minter, tk.MintKeeper.GetMinter(ctx))
+			require.Len(t, mismatchEvents, 1)
+			require.Equal(t, params.MintDenom, mismatchEvents[0].MintDenom)
+			require.Equal(t, params.MintDenom, mismatchEvents[0].BondDenom)
+			require.Equal(t, *tc.stakingSupply, mismatchEvents[0].StakingSupply)
+			require.Equal(t, sdkmath.NewInt(tc.bankSupply), mismatchEvents[0].BankSupply)
+			require.Equal(t, types.DenomConsistency{
+				MintDenom:     params.MintDenom,
+				BondDenom:     params.MintDenom,
+				StakingSupply: *tc.stakingSupply,
+				BankSupply:    sdkmath.NewInt(tc.bankSupply),
+				Mismatch:      true,
+			}, res.DenomConsistency)
+		})
+	}
+}
@@ -0,0 +1,501 @@
+package keeper
+
+import (
+	"math/big"
+	"math/bits"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// ratioPrecision is the denominator of the integer representation of a sdk.Dec ratio
+const ratioPrecision uint64 = 1_000_000_000_000_000_000
+
+// bigRatioPrecision is ratioPrecision as a big integer
+var bigRatioPrecision = new(big.Int).SetUint64(ratioPrecision)
+
+// GetProportion gets the balance of the `MintedDenom` from minted coins and returns coins according to the `AllocationRatio`.
+// The amount is computed as amount * ratioNumerator / 10^18 truncated toward zero, which is the
+// truncation of the product of the amount and the ratio as decimals without their intermediate
+// decimal values. Unlike the decimal product, it does not panic when the intermediate value
+// exceeds the bit length of a sdk.Dec, only when the resulting amount exceeds the bit length of a
+// sdkmath.Int, which cannot happen for a ratio between 0 and 1.
+func (k Keeper) GetProportion(_ sdk.Context, mintedCoin sdk.Coin, ratio sdk.Dec) sdk.Coin {
+	return sdk.NewCoin(mintedCoin.Denom, mulRatio(mintedCoin.Amount, ratio))
+}
+
+// mulRatio returns the amount multiplied by the ratio truncated toward zero, the product of the
+// amounts and the ratios fitting in 128 bits is computed without big integer arithmetic
+func mulRatio(amount sdkmath.Int, ratio sdk.Dec) sdkmath.Int {
+	numerator := ratio.BigInt()
+	if amount.IsUint64() && numerator.IsUint64() {
+		hi, lo := bits.Mul64(amount.Uint64(), numerator.Uint64())
+		if hi < ratioPrecision {
+			quo, _ := bits.Div64(hi, lo, ratioPrecision)
+			return sdkmath.NewIntFromBigInt(numerator.SetUint64(quo))
+		}
+	}
+	numerator.Mul(numerator, amount.BigInt())
+	return sdkmath.NewIntFromBigInt(numerator.Quo(numerator, bigRatioPrecision))
+}
+
+// DistributeMintedCoin implements distribution of minted coins from mint
+// to be used in BeginBlocker. The cumulative minted amount and the category totals
+// of the minter are updated with the distributed amounts. All the amounts sent to the
+// community pool are accumulated by source and funded in a single transfer. The allocation
+// of the block is recorded in the distribution history. The events of each allocation of the
+// block carry the index of the allocation. The coins of the denom of a mint configuration are
+// distributed by the distribution proportions of the configuration.
+func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if config, ok := params.GetMintConfig(mintedCoin.Denom); ok {
+		return k.distributeConfiguredDenom(ctx, params.WithMintConfig(config), mintedCoin)
+	}
+	return k.distributeMintedCoin(ctx, mintedCoin, k.categoryShares(ctx, params, mintedCoin), types.ZeroCommunityFundingTopUp())
+}
+
+// distributeMintedCoin distributes the minted coins split in the shares of the categories with
+// the top-up of the community pool funding, the minted top-up has been minted with the minted
+// coins and the reallocated top-up is taken from the staking share.
+func (k Keeper) distributeMintedCoin(
+	ctx sdk.Context,
+	mintedCoin sdk.Coin,
+	shares types.CategoryTotals,
+	topUp types.CommunityFundingTopUp,
+) error {
+	params := k.GetParams(ctx)
+	if params.BootstrapOverride.IsActiveAt(ctx.BlockHeight()) {
+		return k.distributeBootstrap(ctx, params.BootstrapOverride, mintedCoin.AddAmount(topUp.Minted))
+	}
+	return k.distributeCoin(ctx, params, mintedCoin, shares, topUp, false)
+}
+
+// distributeCoin distributes the coins split in the shares of the categories with the top-up of
+// the community pool funding. The coins funded with MsgFundMinter are added to the cumulative
+// funded amount instead of the cumulative minted amount and are not recorded in the distribution
+// history, which records the allocation of the minted coins of the block. The distribution runs
+// on a branch of the context written once every transfer succeeded, so a failed transfer reverts
+// the transfers and the bookkeeping of the shares allocated before it.
+func (k Keeper) distributeCoin(
+	ctx sdk.Context,
+	params types.Params,
+	mintedCoin sdk.Coin,
+	shares types.CategoryTotals,
+	topUp types.CommunityFundingTopUp,
+	funded bool,
+) error {
+	distributionCtx, write := ctx.CacheContext()
+	if err := k.distributeShares(distributionCtx, params, mintedCoin, shares, topUp, funded); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// distributeShares transfers the shares of the categories of the coins and books the
+// distribution in the minter, see distributeCoin.
+func (k Keeper) distributeShares(
+	ctx sdk.Context,
+	params types.Params,
+	mintedCoin sdk.Coin,
+	shares types.CategoryTotals,
+	topUp types.CommunityFundingTopUp,
+	funded bool,
+) error {
+	minter := k.GetMinter(ctx)
+	totals := &minter.CumulativeDistributed
+	totalsBefore := minter.CumulativeDistributed
+	minted := mintedCoin.Amount.Add(topUp.Minted)
+	if funded {
+		minter.CumulativeFunded = minter.CumulativeFunded.Add(minted)
+	} else {
+		minter.CumulativeMinted = minter.CumulativeMinted.Add(minted)
+	}
+	var allocations allocationIndex
+
+	// the minted coins are split with the dust carried over from the previous blocks, and the
+	// truncation remainder of the split is assigned by the dust policy
+	splitCoin := mintedCoin
+	var firstRecipientDust, carriedDust sdk.Coins
+	if !funded {
+		splitCoin, shares, firstRecipientDust, carriedDust = k.applyDustPolicy(ctx, params, mintedCoin, shares)
+	}
+
+	stakingRewardsCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, shares.Staking))
+	fundedAddrsCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, shares.FundedAddresses))
+	reserveCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, shares.StrategicReserve))
+
+	// the strategic reserve share is kept in the module account, booked in the ledger
+	minter.Book(types.LedgerEntryStrategicReserve, reserveCoins)
+	totals.StrategicReserve = totals.StrategicReserve.Add(types.TotalAmount(reserveCoins))
+
+	// subtract from original provision to ensure no coins left over after the allocations
+	var communityPoolSources types.CommunityPoolSources
+	communityPoolSources = communityPoolSources.Add(
+		types.CommunityPoolSourceShare,
+		sdk.NewCoins(splitCoin).
+			Sub(stakingRewardsCoins...).
+			Sub(fundedAddrsCoins...).
+			Sub(reserveCoins...).
+			Sub(firstRecipientDust...).
+			Sub(carriedDust...),
+	)
+
+	// the top-up of the community pool funding is taken from the staking share or minted
+	reallocatedCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, topUp.Reallocated))
+	stakingRewardsCoins = stakingRewardsCoins.Sub(reallocatedCoins...)
+	communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceFloorReallocated, reallocatedCoins)
+	communityPoolSources = communityPoolSources.Add(
+		types.CommunityPoolSourceFloorMinted,
+		sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, topUp.Minted)),
+	)
+
+	// allocate staking rewards into fee collector account to be moved to on next begin blocker by
+	// distribution module, or to the fallback recipient if the fee collector doesn't exist
+	stakingRewardsCoins, redirectedCoins, err := k.applyPause(
+		ctx,
+		&minter,
+		types.CategoryStaking,
+		params.PauseStakingShare,
+		params.PausedShareMode,
+		stakingRewardsCoins,
+	)
+	if err != nil {
+		return err
+	}
+	communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceRedirectedStaking, redirectedCoins)
+
+	fundedAddrsCoins, redirectedCoins, err = k.applyPause(
+		ctx,
+		&minter,
+		types.CategoryFundedAddresses,
+		params.PauseFundedShare,
+		params.PausedShareMode,
+		fundedAddrsCoins,
+	)
+	if err != nil {
+		return err
+	}
+	communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceRedirectedFunded, redirectedCoins)
+
+	// the shares of the funded addresses are computed and their addresses validated before any
+	// transfer, so an invalid address fails the distribution without partial transfers
+	fundedShares, dustCoins, err := k.fundedAddressShares(ctx, params.FundedAddresses, fundedAddrsCoins)
+	if err != nil {
+		return err
+	}
+
+	if !stakingRewardsCoins.IsZero() {
+		endStage := k.profileStage(ctx, types.ProfileStageDistributionStaking)
+		_, communityPoolCoins, err := k.sendStakingShare(ctx, params, stakingRewardsCoins, allocations.next())
+		endStage()
+		if err != nil {
+			return errorsignite.Wrapf(types.ErrDistributionFailed, "staking share: %s", err)
+		}
+		communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceMissingFeeCollector, communityPoolCoins)
+		totals.Staking = totals.Staking.Add(types.TotalAmount(stakingRewardsCoins.Sub(communityPoolCoins...)))
+	}
+
+	var fundedAddresses []types.FundedAddressDistribution
+	if len(params.FundedAddresses) == 0 {
+		// fund community pool when rewards address is empty
+		communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceUnallocatedFunded, fundedAddrsCoins)
+	} else if !fundedAddrsCoins.IsZero() {
+		// allocate developer rewards to developer addresses by weight, the truncation remainder is kept
+		// in the module account or assigned to a category in round robin. The share of an address
+		// outside of its funding window is sent to the community pool.
+		endStage := k.profileStage(ctx, types.ProfileStageDistributionFundedAddresses)
+		fundedAddresses = make([]types.FundedAddressDistribution, len(params.FundedAddresses))
+		for i, w := range params.FundedAddresses {
+			index := allocations.next()
+			fundedAddrCoins := fundedShares[i].coins
+			if !w.IsFundedAt(ctx.BlockHeight()) {
+				fundedAddresses[i] = types.FundedAddressDistribution{Address: w.Address, Amount: sdk.NewCoins()}
+				communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceOutOfWindowFunded, fundedAddrCoins)
+				continue
+			}
+			if !firstRecipientDust.IsZero() {
+				// the truncation remainder of the split is added to the share of the first funded address
+				fundedAddrCoins = fundedAddrCoins.Add(firstRecipientDust...)
+				firstRecipientDust = nil
+			}
+			fundedAddresses[i] = types.FundedAddressDistribution{Address: w.Address, Amount: fundedAddrCoins}
+			if w.PayoutMode == types.PAYOUT_MODE_PULL {
+				// the share is kept in the module account until claimed by the address
+				minter.BookPayout(w.Address, ctx.BlockHeight(), fundedAddrCoins)
+			} else {
+				recipient, blocked, err := k.restrictPayout(ctx, &minter, w.Address, fundedShares[i].account, fundedAddrCoins, index)
+				if err != nil {
+					return err
+				}
+				if !blocked {
+					err = transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+						return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, fundedAddrCoins)
+					})
+					if err != nil {
+						return errorsignite.Wrapf(types.ErrDistributionFailed, "funded address %s: %s", w.Address, err)
+					}
+					fundedAddresses[i].Recipient = recipient.String()
+				}
+			}
+			totals.FundedAddresses = totals.FundedAddresses.Add(types.TotalAmount(fundedAddrCoins))
+		}
+
+		switch {
+		case dustCoins.IsZero():
+		case params.DustAssignment == types.DUST_ASSIGNMENT_ROUND_ROBIN:
+			communityPoolDust, err := k.assignDust(ctx, params, &minter, fundedAddresses, dustCoins, allocations.next())
+			if err != nil {
+				return err
+			}
+			communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceDust, communityPoolDust)
+		default:
+			totals.Dust = totals.Dust.Add(types.TotalAmount(dustCoins))
+			minter.Book(types.LedgerEntryDust, dustCoins)
+		}
+		endStage()
+	}
+
+	// the truncation remainder of the split is sent to the community pool without funded address
+	// to receive it
+	communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceDust, firstRecipientDust)
+	recordTruncationRemainder(ctx, firstRecipientDust)
+
+	// the community pool share is always buffered when paused, including the shares redirected
+	// from the other paused categories
+	releasedCoins := minter.PausedShares.CommunityPool
+	communityPoolCoins, _, err := k.applyPause(
+		ctx,
+		&minter,
+		types.CategoryCommunityPool,
+		params.PauseCommunityShare,
+		types.PAUSED_SHARE_MODE_BUFFER,
+		communityPoolSources.Total(),
+	)
+	if err != nil {
+		return err
+	}
+	if !params.PauseCommunityShare {
+		communityPoolSources = communityPoolSources.Add(types.CommunityPoolSourceReleasedCommunityPool, releasedCoins)
+	}
+	if !communityPoolCoins.IsZero() {
+		index := allocations.next()
+		endStage := k.profileStage(ctx, types.ProfileStageDistributionCommunityPool)
+		err = transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+			return k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		})
+		endStage()
+		if err != nil {
+			return errorsignite.Wrapf(types.ErrDistributionFailed, "community pool share: %s", err)
+		}
+		totals.CommunityPool = totals.CommunityPool.Add(types.TotalAmount(communityPoolCoins))
+		minter.AddCommunityPoolFunding(communityPoolSources)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCommunityPoolFunded{
+			Amount:          communityPoolCoins,
+			Sources:         communityPoolSources,
+			AllocationIndex: index,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetMinter(ctx, minter)
+	distribution := types.NewBlockDistribution(
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		minter.Inflation,
+		minted,
+		totalsBefore,
+		*totals,
+	)
+	distribution.FundedAddresses = fundedAddresses
+	if !funded {
+		k.SetBlockDistribution(ctx, distribution)
+		k.addFundedAddressIncome(ctx, fundedAddresses)
+	}
+
+	// the amounts actually distributed are reported for the indexers
+	distributed := distribution.Distributed
+	k.incrDistributionMetrics(ctx, mintedCoin.Denom, distributed.Staking, distributed.CommunityPool, fundedAddresses)
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintDistribution{
+		Minted:           sdk.NewCoin(mintedCoin.Denom, minted),
+		Staking:          sdk.NewCoin(mintedCoin.Denom, distributed.Staking),
+		CommunityPool:    sdk.NewCoin(mintedCoin.Denom, distributed.CommunityPool),
+		FundedAddresses:  fundedAddresses,
+		Dust:             sdk.NewCoin(mintedCoin.Denom, distributed.Dust),
+		StrategicReserve: sdk.NewCoin(mintedCoin.Denom, distributed.StrategicReserve),
+	})
+}
+
+// fundedAddressShare is the share of the minted coins of a funded address and the account of the
+// address
+type fundedAddressShare struct {
+	account sdk.AccAddress
+	coins   sdk.Coins
+}
+
+// fundedAddressShares returns the shares of the funded addresses coins of each funded address by
+// weight and the truncation remainder, the weights of the stake weighted addresses are split by
+// their bonded delegations of the block. All the addresses are validated, an invalid address fails
+// with a critical error before any coin of the block is transferred so the distribution of the
+// block is all-or-nothing.
+func (k Keeper) fundedAddressShares(
+	ctx sdk.Context,
+	fundedAddresses []types.WeightedAddress,
+	coins sdk.Coins,
+) (shares []fundedAddressShare, dust sdk.Coins, err error) {
+	fundedAddresses = k.StakeWeightedAddresses(ctx, fundedAddresses)
+	dust = coins
+	shares = make([]fundedAddressShare, len(fundedAddresses))
+	for i, w := range fundedAddresses {
+		account, err := sdk.AccAddressFromBech32(w.Address)
+		if err != nil {
+			return nil, nil, errorsignite.Criticalf("invalid funded address %s at index %d: %s", w.Address, i, err)
+		}
+		share := sdk.NewCoins()
+		for _, coin := range coins {
+			share = share.Add(k.GetProportion(ctx, coin, w.Weight))
+		}
+		dust = dust.Sub(share...)
+		shares[i] = fundedAddressShare{account: account, coins: share}
+	}
+	return shares, dust, nil
+}
+
+// StakeWeightedAddresses returns the funded addresses with the weights of the stake weighted
+// addresses split by their bonded delegations at the current block. The number of stake weighted
+// addresses is bounded by the validation of the params.
+func (k Keeper) StakeWeightedAddresses(ctx sdk.Context, fundedAddresses []types.WeightedAddress) []types.WeightedAddress {
+	bonded := make([]sdkmath.Int, len(fundedAddresses))
+	for i, w := range fundedAddresses {
+		bonded[i] = sdkmath.ZeroInt()
+		if !w.IsStakeWeighted() {
+			continue
+		}
+		// an invalid address is reported by the validation of the funded addresses shares
+		if account, err := sdk.AccAddressFromBech32(w.Address); err == nil {
+			bonded[i] = k.stakingKeeper.GetDelegatorBonded(ctx, account)
+		}
+	}
+	return types.ApplyStakeWeights(fundedAddresses, bonded)
+}
+
+// assignDust assigns the dust of the block to the distribution category rotating with the block
+// height. The dust assigned to the funded addresses is sent to the funded address rotating with
+// the rounds of the categories, or added to its pending payout in pull payout mode or when the
+// send restriction blocks the payout, and added to the share of the funded address in the
+// distribution of the block. The dust assigned to the community pool, or to a funded address
+// outside of its funding window, is returned to be funded with the community pool share.
+func (k Keeper) assignDust(
+	ctx sdk.Context,
+	params types.Params,
+	minter *types.Minter,
+	fundedAddresses []types.FundedAddressDistribution,
+	dust sdk.Coins,
+	index uint32,
+) (communityPoolDust sdk.Coins, err error) {
+	totals := &minter.CumulativeDistributed
+	height := ctx.BlockHeight()
+	category := types.DustCategory(height)
+	round := height / int64(len(types.DustCategories))
+	i := round % int64(len(params.FundedAddresses))
+	if category == types.CategoryFundedAddresses && !params.FundedAddresses[i].IsFundedAt(height) {
+		category = types.CategoryCommunityPool
+	}
+
+	var recipient sdk.AccAddress
+	switch category {
+	case types.CategoryStaking:
+		recipient, communityPoolDust, err = k.sendStakingShare(ctx, params, dust, index)
+		if err != nil {
+			return nil, errorsignite.Wrapf(types.ErrDistributionFailed, "staking dust: %s", err)
+		}
+		totals.Staking = totals.Staking.Add(types.TotalAmount(dust.Sub(communityPoolDust...)))
+	case types.CategoryFundedAddresses:
+		fundedAddr := params.FundedAddresses[i]
+		fundedAddresses[i].Amount = fundedAddresses[i].Amount.Add(dust...)
+		recipient, err = sdk.AccAddressFromBech32(fundedAddr.Address)
+		if err != nil {
+			return nil, errorsignite.Critical(err.Error())
+		}
+		if fundedAddr.PayoutMode == types.PAYOUT_MODE_PULL {
+			minter.BookPayout(fundedAddr.Address, height, dust)
+		} else {
+			to, blocked, err := k.restrictPayout(ctx, minter, fundedAddr.Address, recipient, dust, index)
+			if err != nil {
+				return nil, err
+			}
+			if !blocked {
+				err := transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+					return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, dust)
+				})
+				if err != nil {
+					return nil, errorsignite.Wrapf(types.ErrDistributionFailed, "funded address %s dust: %s", fundedAddr.Address, err)
+				}
+				fundedAddresses[i].Recipient = to.String()
+			}
+		}
+		totals.FundedAddresses = totals.FundedAddresses.Add(types.TotalAmount(dust))
+	default:
+		recipient = k.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+		communityPoolDust = dust
+	}
+
+	return communityPoolDust, ctx.EventManager().EmitTypedEvent(&types.EventDustAssigned{
+		Category:        category,
+		Recipient:       recipient.String(),
+		Amount:          dust,
+		AllocationIndex: index,
+	})
+}
+
+// applyPause returns the coins to distribute to a category depending on its pause status.
+// When the category is paused, its share is either booked in the ledger entry of the category
+// or returned as coins to redirect to the community pool depending on the paused share mode.
+// When the category is not paused, its ledger entry is released and added to the coins to
+// distribute.
+func (k Keeper) applyPause(
+	ctx sdk.Context,
+	minter *types.Minter,
+	category string,
+	paused bool,
+	mode types.PausedShareMode,
+	share sdk.Coins,
+) (distributed sdk.Coins, redirected sdk.Coins, err error) {
+	entry := types.PausedShareLedgerEntry(category)
+	if !paused {
+		released := minter.Release(entry)
+		if released.IsZero() {
+			return share, nil, nil
+		}
+		return share.Add(released...), nil, ctx.EventManager().EmitTypedEvent(&types.EventPausedShareReleased{
+			Category: category,
+			Amount:   released,
+		})
+	}
+
+	if share.IsZero() {
+		return nil, nil, nil
+	}
+
+	if mode == types.PAUSED_SHARE_MODE_BUFFER {
+		minter.Book(entry, share)
+		return nil, nil, ctx.EventManager().EmitTypedEvent(&types.EventPausedShare{
+			Category: category,
+			Action:   types.PausedShareActionBuffered,
+			Amount:   share,
+		})
+	}
+
+	return nil, share, ctx.EventManager().EmitTypedEvent(&types.EventPausedShare{
+		Category: category,
+		Action:   types.PausedShareActionRedirected,
+		Amount:   share,
+	})
+}
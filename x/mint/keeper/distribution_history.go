@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// SetBlockDistribution records the allocation of the coins minted in a block, the allocations
+// older than DistributionHistoryRetention blocks are pruned
+func (k Keeper) SetBlockDistribution(ctx sdk.Context, distribution types.BlockDistribution) {
+	k.collections.distributionHistory.Set(ctx, distribution.Height, distribution)
+	if pruned := distribution.Height - types.DistributionHistoryRetention; pruned >= 0 {
+		k.collections.distributionHistory.Remove(ctx, pruned)
+	}
+}
+
+// GetBlockDistribution returns the allocation of the coins minted in a block
+func (k Keeper) GetBlockDistribution(ctx sdk.Context, height int64) (distribution types.BlockDistribution, found bool) {
+	return k.collections.distributionHistory.Get(ctx, height)
+}
+
+// IterateBlockDistributions iterates over the recorded allocations of the minted coins from the
+// provided height, the iteration stops when the callback returns true. The iteration also stops
+// once the context is done, its error is returned.
+func (k Keeper) IterateBlockDistributions(ctx sdk.Context, fromHeight int64, cb func(types.BlockDistribution) (stop bool)) error {
+	if fromHeight < 0 {
+		fromHeight = 0
+	}
+	var err error
+	r := storeRange{start: heightKey{}.Encode(fromHeight)}
+	k.collections.distributionHistory.Iterate(ctx, r, func(_ int64, distribution types.BlockDistribution) bool {
+		if err = ctx.Context().Err(); err != nil {
+			return true
+		}
+		return cb(distribution)
+	})
+	return err
+}
+
+// GetBlockDistributions returns the recorded allocations of the minted coins from the oldest with
+// a height between fromHeight and toHeight, toHeight is not bounding if zero. The first page starts
+// at fromHeight unless an offset is requested. The iteration stops with the error of the context
+// once it is done.
+func (k Keeper) GetBlockDistributions(
+	ctx sdk.Context,
+	fromHeight, toHeight int64,
+	pagination *query.PageRequest,
+) ([]types.BlockDistribution, *query.PageResponse, error) {
+	store := k.collections.distributionHistory.store(ctx, nil)
+	if fromHeight > 0 && (pagination == nil || (len(pagination.Key) == 0 && pagination.Offset == 0)) {
+		start := query.PageRequest{}
+		if pagination != nil {
+			start = *pagination
+		}
+		start.Key = heightKey{}.Encode(fromHeight)
+		pagination = &start
+	}
+
+	var distributions []types.BlockDistribution
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if err := ctx.Context().Err(); err != nil {
+			return false, err
+		}
+		var distribution types.BlockDistribution
+		if err := k.cdc.Unmarshal(value, &distribution); err != nil {
+			return false, err
+		}
+		if distribution.Height < fromHeight || (toHeight > 0 && distribution.Height > toHeight) {
+			return false, nil
+		}
+		if accumulate {
+			distributions = append(distributions, distribution)
+		}
+		return true, nil
+	})
+	return distributions, pageRes, err
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// blockDistribution returns the allocation of a block minting the height
+func blockDistribution(height int64) types.BlockDistribution {
+	return types.BlockDistribution{
+		Height:      height,
+		Time:        time.Unix(height*5, 0).UTC(),
+		Inflation:   sdk.NewDecWithPrec(height, 2),
+		Minted:      sdkmath.NewInt(height),
+		Distributed: types.NewCategoryTotals(),
+	}
+}
+
+func TestDistributionHistory(t *testing.T) {
+	for _, ts := range testSetups {
+		ts := ts
+		t.Run(ts.name, func(t *testing.T) {
+			ctx, tk, _ := ts.setup(t)
+			distribution := blockDistribution
+
+			for height := int64(1); height <= 5; height++ {
+				tk.MintKeeper.SetBlockDistribution(ctx, distribution(height))
+			}
+			got, found := tk.MintKeeper.GetBlockDistribution(ctx, 3)
+			require.True(t, found)
+			require.Equal(t, distribution(3), got)
+
+			var heights []int64
+			tk.MintKeeper.IterateBlockDistributions(ctx, 2, func(d types.BlockDistribution) bool {
+				heights = append(heights, d.Height)
+				return d.Height == 4
+			})
+			require.Equal(t, []int64{2, 3, 4}, heights)
+
+			// the distributions older than the retention are pruned
+			tk.MintKeeper.SetBlockDistribution(ctx, distribution(types.DistributionHistoryRetention+2))
+			_, found = tk.MintKeeper.GetBlockDistribution(ctx, 2)
+			require.False(t, found)
+			_, found = tk.MintKeeper.GetBlockDistribution(ctx, 1)
+			require.True(t, found)
+		})
+	}
+}
+
+func TestDistributionHistoryQuery(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	q := querier.NewQuerier(tk.MintKeeper)
+	for height := int64(1); height <= 10; height++ {
+		tk.MintKeeper.SetBlockDistribution(sdkCtx, blockDistribution(height))
+	}
+	heights := func(distributions []types.BlockDistribution) (heights []int64) {
+		for _, d := range distributions {
+			heights = append(heights, d.Height)
+		}
+		return heights
+	}
+
+	t.Run("should return the distributions between the heights", func(t *testing.T) {
+		res, err := q.DistributionHistory(ctx, &types.QueryDistributionHistoryRequest{FromHeight: 3, ToHeight: 6})
+		require.NoError(t, err)
+		require.Equal(t, []int64{3, 4, 5, 6}, heights(res.Distributions))
+		require.Equal(t, blockDistribution(3), res.Distributions[0])
+	})
+
+	t.Run("should page through the distributions from the height", func(t *testing.T) {
+		var got []int64
+		req := &types.QueryDistributionHistoryRequest{FromHeight: 4, Pagination: &query.PageRequest{Limit: 3}}
+		for {
+			res, err := q.DistributionHistory(ctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Distributions), 3)
+			got = append(got, heights(res.Distributions)...)
+			if len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}
+		}
+		require.Equal(t, []int64{4, 5, 6, 7, 8, 9, 10}, got)
+	})
+
+	t.Run("should bound the page size", func(t *testing.T) {
+		res, err := q.DistributionHistory(ctx, &types.QueryDistributionHistoryRequest{
+			Pagination: &query.PageRequest{Limit: types.DistributionHistoryMaxLimit + 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Distributions, 10)
+	})
+
+	t.Run("should prevent invalid heights and page requests", func(t *testing.T) {
+		for _, req := range []*types.QueryDistributionHistoryRequest{
+			nil,
+			{FromHeight: -1},
+			{FromHeight: 5, ToHeight: 4},
+			{Pagination: &query.PageRequest{Offset: 1, Key: sdk.Uint64ToBigEndian(2)}},
+		} {
+			_, err := q.DistributionHistory(ctx, req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
@@ -0,0 +1,888 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestDistributeMintedCoin(t *testing.T) {
+	fundedAddr := sample.Address(r)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	type pause struct {
+		staking   bool
+		funded    bool
+		community bool
+		mode      types.PausedShareMode
+	}
+	tests := []struct {
+		name                 string
+		pause                pause
+		noFundedAddresses    bool
+		pausedShares         types.PausedShares
+		expectedStaking      sdk.Coins
+		expectedFunded       sdk.Coins
+		expectedCommunity    sdk.Coins
+		expectedPausedShares types.PausedShares
+		expectedEvents       []string
+	}{
+		{
+			name:              "should distribute the minted coins when no category is paused",
+			expectedStaking:   stake(30),
+			expectedFunded:    stake(40),
+			expectedCommunity: stake(30),
+		},
+		{
+			name:              "should redirect the paused staking share to the community pool",
+			pause:             pause{staking: true},
+			expectedFunded:    stake(40),
+			expectedCommunity: stake(60),
+			expectedEvents:    []string{"modules.mint.EventPausedShare"},
+		},
+		{
+			name:              "should redirect the paused funded share to the community pool",
+			pause:             pause{funded: true},
+			expectedStaking:   stake(30),
+			expectedCommunity: stake(70),
+			expectedEvents:    []string{"modules.mint.EventPausedShare"},
+		},
+		{
+			name:                 "should buffer the paused community share",
+			pause:                pause{community: true},
+			expectedStaking:      stake(30),
+			expectedFunded:       stake(40),
+			expectedPausedShares: types.PausedShares{CommunityPool: stake(30)},
+			expectedEvents:       []string{"modules.mint.EventPausedShare"},
+		},
+		{
+			name:                 "should buffer the shares redirected to a paused community share",
+			pause:                pause{staking: true, community: true},
+			expectedFunded:       stake(40),
+			expectedPausedShares: types.PausedShares{CommunityPool: stake(60)},
+			expectedEvents:       []string{"modules.mint.EventPausedShare", "modules.mint.EventPausedShare"},
+		},
+		{
+			name:                 "should buffer all shares when all categories are paused in community pool mode",
+			pause:                pause{staking: true, funded: true, community: true},
+			expectedPausedShares: types.PausedShares{CommunityPool: stake(100)},
+			expectedEvents: []string{
+				"modules.mint.EventPausedShare",
+				"modules.mint.EventPausedShare",
+				"modules.mint.EventPausedShare",
+			},
+		},
+		{
+			name:                 "should buffer the paused staking share in buffer mode",
+			pause:                pause{staking: true, mode: types.PAUSED_SHARE_MODE_BUFFER},
+			expectedFunded:       stake(40),
+			expectedCommunity:    stake(30),
+			expectedPausedShares: types.PausedShares{Staking: stake(30)},
+			expectedEvents:       []string{"modules.mint.EventPausedShare"},
+		},
+		{
+			name:  "should buffer all shares when all categories are paused in buffer mode",
+			pause: pause{staking: true, funded: true, community: true, mode: types.PAUSED_SHARE_MODE_BUFFER},
+			expectedPausedShares: types.PausedShares{
+				Staking:         stake(30),
+				FundedAddresses: stake(40),
+				CommunityPool:   stake(30),
+			},
+			expectedEvents: []string{
+				"modules.mint.EventPausedShare",
+				"modules.mint.EventPausedShare",
+				"modules.mint.EventPausedShare",
+			},
+		},
+		{
+			name:              "should release the buffered staking share when resumed",
+			pausedShares:      types.PausedShares{Staking: stake(20)},
+			expectedStaking:   stake(50),
+			expectedFunded:    stake(40),
+			expectedCommunity: stake(30),
+			expectedEvents:    []string{"modules.mint.EventPausedShareReleased"},
+		},
+		{
+			name:              "should release the buffered funded share to the community pool without funded addresses",
+			noFundedAddresses: true,
+			pausedShares:      types.PausedShares{FundedAddresses: stake(10)},
+			expectedStaking:   stake(30),
+			expectedCommunity: stake(80),
+			expectedEvents:    []string{"modules.mint.EventPausedShareReleased"},
+		},
+		{
+			name:              "should release the buffered community share when resumed",
+			pausedShares:      types.PausedShares{CommunityPool: stake(50)},
+			expectedStaking:   stake(30),
+			expectedFunded:    stake(40),
+			expectedCommunity: stake(80),
+			expectedEvents:    []string{"modules.mint.EventPausedShareReleased"},
+		},
+		{
+			name:                 "should keep the buffered share of a category still paused",
+			pause:                pause{staking: true, mode: types.PAUSED_SHARE_MODE_BUFFER},
+			pausedShares:         types.PausedShares{Staking: stake(20)},
+			expectedFunded:       stake(40),
+			expectedCommunity:    stake(30),
+			expectedPausedShares: types.PausedShares{Staking: stake(50)},
+			expectedEvents:       []string{"modules.mint.EventPausedShare"},
+		},
+	}
+	for _, ts := range testSetups {
+		ts := ts
+		t.Run(ts.name, func(t *testing.T) {
+			for _, tc := range tests {
+				tc := tc
+				t.Run(tc.name, func(t *testing.T) {
+					ctx, tk, _ := ts.setup(t)
+					params := types.DefaultParams()
+					params.DistributionProportions = types.DistributionProportions{
+						Staking:         sdk.NewDecWithPrec(3, 1),
+						FundedAddresses: sdk.NewDecWithPrec(4, 1),
+						CommunityPool:   sdk.NewDecWithPrec(3, 1),
+					}
+					if !tc.noFundedAddresses {
+						params.FundedAddresses = []types.WeightedAddress{{Address: fundedAddr, Weight: sdk.OneDec()}}
+					}
+					params.PauseStakingShare = tc.pause.staking
+					params.PauseFundedShare = tc.pause.funded
+					params.PauseCommunityShare = tc.pause.community
+					params.PausedShareMode = tc.pause.mode
+					tk.MintKeeper.SetParams(ctx, params)
+
+					// the module account holds the minted coin and the buffered paused shares
+					minter := types.DefaultInitialMinter()
+					minter.PausedShares = tc.pausedShares
+					tk.MintKeeper.SetMinter(ctx, minter)
+					mintedCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+					moduleCoins := sdk.NewCoins(mintedCoin).Add(tc.pausedShares.Total()...)
+					require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, moduleCoins))
+
+					ctx = ctx.WithEventManager(sdk.NewEventManager())
+					require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+					feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+					require.True(t, tc.expectedStaking.IsEqual(tk.BankKeeper.GetAllBalances(ctx, feeCollector)))
+					if !tc.noFundedAddresses {
+						fundedBalance := tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(fundedAddr))
+						require.True(t, tc.expectedFunded.IsEqual(fundedBalance))
+					}
+					communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+					require.True(t, tc.expectedCommunity.IsEqual(communityPool))
+
+					pausedShares := tk.MintKeeper.GetMinter(ctx).PausedShares
+					require.True(t, tc.expectedPausedShares.Staking.IsEqual(pausedShares.Staking))
+					require.True(t, tc.expectedPausedShares.FundedAddresses.IsEqual(pausedShares.FundedAddresses))
+					require.True(t, tc.expectedPausedShares.CommunityPool.IsEqual(pausedShares.CommunityPool))
+
+					// the module account only holds the buffered paused shares
+					mintAddr := tk.AccountKeeper.GetModuleAddress(types.ModuleName)
+					require.True(t, pausedShares.Total().IsEqual(tk.BankKeeper.GetAllBalances(ctx, mintAddr)))
+
+					var events []string
+					for _, event := range ctx.EventManager().Events() {
+						if event.Type == "modules.mint.EventPausedShare" || event.Type == "modules.mint.EventPausedShareReleased" {
+							events = append(events, event.Type)
+						}
+					}
+					require.Equal(t, tc.expectedEvents, events)
+				})
+			}
+		})
+	}
+}
+
+// countingDistrKeeper counts the calls to the distribution keeper
+type countingDistrKeeper struct {
+	types.DistrKeeper
+	fundCommunityPoolCalls int
+}
+
+func (k *countingDistrKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	k.fundCommunityPoolCalls++
+	return k.DistrKeeper.FundCommunityPool(ctx, amount, sender)
+}
+
+func TestDistributeMintedCoinFundsCommunityPoolOnce(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	distrKeeper := &countingDistrKeeper{}
+	ctx, tk, _ := testkeeper.NewTestSetupWithMintDistrKeeper(t, func(dk types.DistrKeeper) types.DistrKeeper {
+		distrKeeper.DistrKeeper = dk
+		return distrKeeper
+	})
+
+	// every path to the community pool is active: the community share, the redirected staking share,
+	// the funded share without funded addresses including its released buffer, and the released
+	// community pool buffer
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(3, 1),
+		FundedAddresses: sdk.NewDecWithPrec(4, 1),
+		CommunityPool:   sdk.NewDecWithPrec(3, 1),
+	}
+	params.FundedAddresses = nil
+	params.PauseStakingShare = true
+	tk.MintKeeper.SetParams(ctx, params)
+
+	minter := types.DefaultInitialMinter()
+	minter.PausedShares = types.PausedShares{
+		FundedAddresses: stake(10),
+		CommunityPool:   stake(20),
+	}
+	tk.MintKeeper.SetMinter(ctx, minter)
+	mintedCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	moduleCoins := sdk.NewCoins(mintedCoin).Add(minter.PausedShares.Total()...)
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, moduleCoins))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+	require.Equal(t, 1, distrKeeper.fundCommunityPoolCalls)
+
+	communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+	require.True(t, stake(130).IsEqual(communityPool))
+
+	// the allocation of the block is recorded
+	distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, ctx.BlockHeight())
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(100), distribution.Minted)
+	require.Equal(t, sdkmath.NewInt(130), distribution.Distributed.CommunityPool)
+
+	var fundedEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "modules.mint.EventCommunityPoolFunded" {
+			fundedEvents = append(fundedEvents, event)
+		}
+	}
+	require.Len(t, fundedEvents, 1)
+	parsed, err := sdk.ParseTypedEvent(abci.Event(fundedEvents[0]))
+	require.NoError(t, err)
+	event, ok := parsed.(*types.EventCommunityPoolFunded)
+	require.True(t, ok)
+	require.True(t, stake(130).IsEqual(event.Amount))
+	require.Equal(t, []types.CommunityPoolSource{
+		{Source: types.CommunityPoolSourceShare, Amount: stake(30), Label: types.CommunityPoolLabelConfiguredShare},
+		{Source: types.CommunityPoolSourceRedirectedStaking, Amount: stake(30), Label: types.CommunityPoolLabelPausedCategory},
+		{Source: types.CommunityPoolSourceUnallocatedFunded, Amount: stake(50), Label: types.CommunityPoolLabelEmptyFundedList},
+		{Source: types.CommunityPoolSourceReleasedCommunityPool, Amount: stake(20), Label: types.CommunityPoolLabelPausedCategory},
+	}, event.Sources)
+}
+
+func TestDistributeMintedCoinRoundRobinDust(t *testing.T) {
+	fundedAddrs := []string{sample.Address(r), sample.Address(r)}
+
+	// distributeBlocks distributes the minted coins of consecutive blocks and returns the dust
+	// assignment events
+	distributeBlocks := func(t *testing.T, fromHeight int64, blocks int) []types.EventDustAssigned {
+		ctx, tk, _ := testkeeper.NewTestSetup(t)
+		params := types.DefaultParams()
+		params.DistributionProportions = types.DistributionProportions{
+			Staking:         sdk.NewDecWithPrec(3, 1),
+			FundedAddresses: sdk.NewDecWithPrec(4, 1),
+			CommunityPool:   sdk.NewDecWithPrec(3, 1),
+		}
+		// 40 coins are distributed to the funded addresses, 13 and 26 with 1 of dust
+		params.FundedAddresses = []types.WeightedAddress{
+			{Address: fundedAddrs[0], Weight: sdk.MustNewDecFromStr("0.333333333333333333")},
+			{Address: fundedAddrs[1], Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+		}
+		params.DustAssignment = types.DUST_ASSIGNMENT_ROUND_ROBIN
+		tk.MintKeeper.SetParams(ctx, params)
+
+		feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		mintAddr := tk.AccountKeeper.GetModuleAddress(types.ModuleName)
+		mintedCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+		var events []types.EventDustAssigned
+		for height := fromHeight; height < fromHeight+int64(blocks); height++ {
+			ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+			require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != "modules.mint.EventDustAssigned" {
+					continue
+				}
+				parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				events = append(events, *parsed.(*types.EventDustAssigned))
+			}
+
+			// the minted coins are conserved and no dust is kept in the module account
+			distributed := tk.BankKeeper.GetAllBalances(ctx, feeCollector)
+			for _, addr := range fundedAddrs {
+				distributed = distributed.Add(tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(addr))...)
+			}
+			communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+			distributed = distributed.Add(communityPool...)
+			blockCount := height - fromHeight + 1
+			require.True(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100*blockCount)).IsEqual(distributed))
+			require.True(t, tk.BankKeeper.GetAllBalances(ctx, mintAddr).IsZero())
+
+			minter := tk.MintKeeper.GetMinter(ctx)
+			require.True(t, minter.CumulativeDistributed.Dust.IsZero())
+			require.Equal(t, minter.CumulativeMinted, minter.ExpectedCumulativeMinted())
+		}
+		return events
+	}
+
+	fromHeight := int64(10)
+	events := distributeBlocks(t, fromHeight, 6)
+	require.Len(t, events, 6)
+	for i, event := range events {
+		height := fromHeight + int64(i)
+		require.Equal(t, types.DustCategory(height), event.Category)
+		require.True(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)).IsEqual(event.Amount))
+	}
+	// the dust of the funded addresses category rotates across the funded addresses
+	var fundedRecipients []string
+	for _, event := range events {
+		if event.Category == types.CategoryFundedAddresses {
+			fundedRecipients = append(fundedRecipients, event.Recipient)
+		}
+	}
+	require.Len(t, fundedRecipients, 2)
+	require.ElementsMatch(t, fundedAddrs, fundedRecipients)
+
+	// the assignment only depends on the height
+	require.Equal(t, events, distributeBlocks(t, fromHeight, 6))
+}
+
+func TestDistributeMintedCoinEvent(t *testing.T) {
+	fundedAddrs := []sdk.AccAddress{sample.AccAddress(r), sample.AccAddress(r)}
+	stake := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
+	}
+	stakes := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(stake(amount))
+	}
+	// blocked blocks the payouts to the first funded address
+	blocked := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(fundedAddrs[0]) {
+			return nil, errors.New("sanctioned address")
+		}
+		return toAddr, nil
+	}
+
+	tests := []struct {
+		name     string
+		params   func(*types.Params)
+		opts     []keeper.KeeperOption
+		height   int64
+		expected types.EventMintDistribution
+	}{
+		{
+			name:   "should report the amounts sent to each recipient",
+			params: func(*types.Params) {},
+			expected: types.EventMintDistribution{
+				Minted:        stake(100),
+				Staking:       stake(30),
+				CommunityPool: stake(30),
+				FundedAddresses: []types.FundedAddressDistribution{
+					{Address: fundedAddrs[0].String(), Amount: stakes(13), Recipient: fundedAddrs[0].String()},
+					{Address: fundedAddrs[1].String(), Amount: stakes(26), Recipient: fundedAddrs[1].String()},
+				},
+				Dust:             stake(1),
+				StrategicReserve: stake(0),
+			},
+		},
+		{
+			name: "should report the funded addresses share sent to the community pool without funded address",
+			params: func(p *types.Params) {
+				p.FundedAddresses = nil
+			},
+			expected: types.EventMintDistribution{
+				Minted:           stake(100),
+				Staking:          stake(30),
+				CommunityPool:    stake(70),
+				FundedAddresses:  []types.FundedAddressDistribution{},
+				Dust:             stake(0),
+				StrategicReserve: stake(0),
+			},
+		},
+		{
+			name: "should report the pull payouts and the dust assigned to a funded address",
+			params: func(p *types.Params) {
+				p.FundedAddresses[0].PayoutMode = types.PAYOUT_MODE_PULL
+				p.DustAssignment = types.DUST_ASSIGNMENT_ROUND_ROBIN
+			},
+			// the dust of the fourth block is assigned to the second funded address
+			height: 4,
+			expected: types.EventMintDistribution{
+				Minted:        stake(100),
+				Staking:       stake(30),
+				CommunityPool: stake(30),
+				FundedAddresses: []types.FundedAddressDistribution{
+					{Address: fundedAddrs[0].String(), Amount: stakes(13)},
+					{Address: fundedAddrs[1].String(), Amount: stakes(27), Recipient: fundedAddrs[1].String()},
+				},
+				Dust:             stake(0),
+				StrategicReserve: stake(0),
+			},
+		},
+		{
+			name:   "should report the blocked payouts",
+			params: func(*types.Params) {},
+			opts:   []keeper.KeeperOption{keeper.EnforceSendRestrictions(blocked)},
+			expected: types.EventMintDistribution{
+				Minted:        stake(100),
+				Staking:       stake(30),
+				CommunityPool: stake(30),
+				FundedAddresses: []types.FundedAddressDistribution{
+					{Address: fundedAddrs[0].String(), Amount: stakes(13)},
+					{Address: fundedAddrs[1].String(), Amount: stakes(26), Recipient: fundedAddrs[1].String()},
+				},
+				Dust:             stake(1),
+				StrategicReserve: stake(0),
+			},
+		},
+		{
+			name: "should report the strategic reserve share",
+			params: func(p *types.Params) {
+				p.DistributionProportions.CommunityPool = sdk.NewDecWithPrec(2, 1)
+				p.DistributionProportions.StrategicReserve = sdk.NewDecWithPrec(1, 1)
+			},
+			expected: types.EventMintDistribution{
+				Minted:        stake(100),
+				Staking:       stake(30),
+				CommunityPool: stake(20),
+				FundedAddresses: []types.FundedAddressDistribution{
+					{Address: fundedAddrs[0].String(), Amount: stakes(13), Recipient: fundedAddrs[0].String()},
+					{Address: fundedAddrs[1].String(), Amount: stakes(26), Recipient: fundedAddrs[1].String()},
+				},
+				Dust:             stake(1),
+				StrategicReserve: stake(10),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sdkCtx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, tc.opts...)
+			ctx := sdkCtx.WithBlockHeight(tc.height).WithEventManager(sdk.NewEventManager())
+			params := types.DefaultParams()
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:         sdk.NewDecWithPrec(3, 1),
+				FundedAddresses: sdk.NewDecWithPrec(4, 1),
+				CommunityPool:   sdk.NewDecWithPrec(3, 1),
+			}
+			// 40 coins are distributed to the funded addresses, 13 and 26 with 1 of dust
+			params.FundedAddresses = []types.WeightedAddress{
+				{Address: fundedAddrs[0].String(), Weight: sdk.MustNewDecFromStr("0.333333333333333333")},
+				{Address: fundedAddrs[1].String(), Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+			}
+			tc.params(&params)
+			tk.MintKeeper.SetParams(ctx, params)
+
+			mintedCoin := stake(100)
+			require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+			require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+			var events []types.EventMintDistribution
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != "modules.mint.EventMintDistribution" {
+					continue
+				}
+				parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				events = append(events, *parsed.(*types.EventMintDistribution))
+			}
+			require.Equal(t, []types.EventMintDistribution{tc.expected}, events)
+
+			// the reported amounts are the transferred amounts
+			feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			require.Equal(t, tc.expected.Staking, tk.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom))
+			communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+			require.True(t, sdk.NewCoins(tc.expected.CommunityPool).IsEqual(communityPool))
+			for _, funded := range tc.expected.FundedAddresses {
+				balance := tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(funded.Address))
+				if funded.Recipient == "" {
+					require.True(t, balance.IsZero())
+					continue
+				}
+				require.True(t, funded.Amount.IsEqual(balance))
+			}
+		})
+	}
+}
+
+func TestDistributeMintedCoinErrors(t *testing.T) {
+	proportions := func(staking, funded, community int64) types.DistributionProportions {
+		return types.DistributionProportions{
+			Staking:         sdk.NewDecWithPrec(staking, 1),
+			FundedAddresses: sdk.NewDecWithPrec(funded, 1),
+			CommunityPool:   sdk.NewDecWithPrec(community, 1),
+		}
+	}
+
+	// the minted coins are not minted to the module account, every transfer fails
+	tests := []struct {
+		name        string
+		proportions types.DistributionProportions
+	}{
+		{
+			name:        "should fail to send the staking share",
+			proportions: proportions(10, 0, 0),
+		},
+		{
+			name:        "should fail to send the funded addresses share",
+			proportions: proportions(0, 10, 0),
+		},
+		{
+			name:        "should fail to fund the community pool",
+			proportions: proportions(0, 0, 10),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, tk, _ := testkeeper.NewTestSetup(t)
+			params := types.DefaultParams()
+			params.DistributionProportions = tc.proportions
+			params.FundedAddresses = []types.WeightedAddress{{Address: sample.Address(r), Weight: sdk.OneDec()}}
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+			err := tk.MintKeeper.DistributeMintedCoin(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			require.ErrorIs(t, err, types.ErrDistributionFailed)
+		})
+	}
+}
+
+func TestDistributeMintedCoinInvalidFundedAddress(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	addr1, addr3 := sample.AccAddress(r), sample.AccAddress(r)
+	params := types.DefaultParams()
+	// the staking share and the share of the first address are allocated before the invalid
+	// address is reached
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: addr1.String(), Weight: sdk.NewDecWithPrec(3, 1)},
+		{Address: "cosmos1invalid", Weight: sdk.NewDecWithPrec(3, 1)},
+		{Address: addr3.String(), Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+	require.Error(t, params.Validate())
+	require.Error(t, (&types.GenesisState{Minter: types.DefaultInitialMinter(), Params: params}).Validate())
+	tk.MintKeeper.SetParams(ctx, types.DefaultParams())
+	tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+	// the subspace setter skips the validation of the params
+	subspace, found := tk.ParamsKeeper.GetSubspace(types.ModuleName)
+	require.True(t, found)
+	subspace.Set(ctx, types.KeyFundedAddresses, params.FundedAddresses)
+
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+	err := tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin)
+	require.ErrorIs(t, err, errorsignite.ErrCritical)
+
+	// no coin left the module account
+	moduleAddr := tk.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.Equal(t, mintedCoin, tk.BankKeeper.GetBalance(ctx, moduleAddr, mintedCoin.Denom))
+	for _, addr := range []sdk.AccAddress{
+		addr1,
+		addr3,
+		tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName),
+	} {
+		require.True(t, tk.BankKeeper.GetBalance(ctx, addr, mintedCoin.Denom).IsZero())
+	}
+}
+
+func TestDistributeMintedCoinFailedTransfer(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	addr := sample.AccAddress(r)
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(5, 1),
+		FundedAddresses: sdk.NewDecWithPrec(5, 1),
+		CommunityPool:   sdk.ZeroDec(),
+	}
+	params.FundedAddresses = []types.WeightedAddress{{Address: addr.String(), Weight: sdk.OneDec()}}
+	tk.MintKeeper.SetParams(ctx, params)
+	minter := types.DefaultInitialMinter()
+	tk.MintKeeper.SetMinter(ctx, minter)
+
+	// only the staking share is in the module account, the transfer of the funded addresses share
+	// fails after the staking share is sent
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	stakingShare := sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(stakingShare)))
+	err := tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin)
+	require.ErrorIs(t, err, types.ErrDistributionFailed)
+
+	// the staking share sent before the failed transfer is reverted with the bookkeeping
+	moduleAddr := tk.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.Equal(t, stakingShare, tk.BankKeeper.GetBalance(ctx, moduleAddr, mintedCoin.Denom))
+	feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, tk.BankKeeper.GetBalance(ctx, feeCollector, mintedCoin.Denom).IsZero())
+	require.True(t, tk.BankKeeper.GetBalance(ctx, addr, mintedCoin.Denom).IsZero())
+	require.Equal(t, minter, tk.MintKeeper.GetMinter(ctx))
+	_, found := tk.MintKeeper.GetBlockDistribution(ctx, ctx.BlockHeight())
+	require.False(t, found)
+}
+
+// decProportion is the proportion of the amount computed with the decimal product
+func decProportion(amount sdkmath.Int, ratio sdk.Dec) sdkmath.Int {
+	return sdk.NewDecFromInt(amount).Mul(ratio).TruncateInt()
+}
+
+// randomProportionInput returns an amount of a random bit length and a random ratio, the ratios
+// are mostly between 0 and 1 and sometimes negative or greater than 1
+func randomProportionInput(r *rand.Rand) (sdkmath.Int, sdk.Dec) {
+	amount := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(1+r.Intn(250))))
+	numerator := new(big.Int).Rand(r, big.NewInt(1_000_000_000_000_000_001))
+	switch r.Intn(10) {
+	case 0:
+		numerator.Neg(numerator)
+	case 1:
+		// keep the decimal product within the bit length of a decimal
+		numerator.Mul(numerator, big.NewInt(int64(2+r.Intn(1000))))
+		amount.Rsh(amount, 16)
+	case 2:
+		amount.Neg(amount)
+	}
+	return sdkmath.NewIntFromBigInt(amount), sdk.NewDecFromBigIntWithPrec(numerator, sdk.Precision)
+}
+
+func TestGetProportion(t *testing.T) {
+	k := keeper.Keeper{}
+
+	t.Run("should match the truncated decimal product", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		inputs := [][2]string{
+			{"0", "0.5"},
+			{"1", "0.999999999999999999"},
+			{"1", "1"},
+			{"3", "0.333333333333333333"},
+			{"18446744073709551615", "1"},
+			{"18446744073709551615", "0.999999999999999999"},
+			{"18446744073709551616", "0.000000000000000001"},
+			{"-7", "0.5"},
+			{"7", "-0.5"},
+		}
+		for i := 0; i < 10000; i++ {
+			amount, ratio := randomProportionInput(r)
+			inputs = append(inputs, [2]string{amount.String(), ratio.String()})
+		}
+		for _, input := range inputs {
+			amount, ok := sdkmath.NewIntFromString(input[0])
+			require.True(t, ok)
+			ratio := sdk.MustNewDecFromStr(input[1])
+			expected := decProportion(amount, ratio)
+			coin := sdk.Coin{Denom: "foo", Amount: amount}
+			if expected.IsNegative() {
+				// a coin cannot hold a negative amount
+				require.Panics(t, func() { k.GetProportion(sdk.Context{}, coin, ratio) })
+				continue
+			}
+			proportion := k.GetProportion(sdk.Context{}, coin, ratio)
+			require.Equal(t, "foo", proportion.Denom)
+			require.True(t, expected.Equal(proportion.Amount), "%s * %s: expected %s, got %s", amount, ratio, expected, proportion.Amount)
+		}
+	})
+
+	t.Run("should not overflow when the decimal product does", func(t *testing.T) {
+		for _, ratio := range []sdk.Dec{sdk.OneDec(), sdk.MustNewDecFromStr("0.999999999999999999"), sdk.NewDecWithPrec(5, 1)} {
+			coin := sdk.NewCoin("foo", maxInt)
+			expected := new(big.Int).Quo(new(big.Int).Mul(maxInt.BigInt(), ratio.BigInt()), big.NewInt(1_000_000_000_000_000_000))
+			if ratio.Equal(sdk.OneDec()) {
+				require.Panics(t, func() { decProportion(maxInt, ratio) })
+			}
+			require.Equal(t, expected, k.GetProportion(sdk.Context{}, coin, ratio).Amount.BigInt())
+		}
+	})
+
+	t.Run("should panic when the proportion exceeds the bit length of an integer", func(t *testing.T) {
+		require.Panics(t, func() { k.GetProportion(sdk.Context{}, sdk.NewCoin("foo", maxInt), sdk.NewDec(2)) })
+	})
+}
+
+func BenchmarkGetProportion(b *testing.B) {
+	k := keeper.Keeper{}
+	ratio := sdk.NewDecWithPrec(3, 1)
+	for _, bench := range []struct {
+		name   string
+		amount sdkmath.Int
+	}{
+		{name: "block provision", amount: sdkmath.NewInt(123_456_789)},
+		{name: "large amount", amount: maxInt.QuoRaw(3)},
+	} {
+		coin := sdk.NewCoin(sdk.DefaultBondDenom, bench.amount)
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				k.GetProportion(sdk.Context{}, coin, ratio)
+			}
+		})
+		b.Run(bench.name+" decimal product", func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				sdk.NewCoin(coin.Denom, decProportion(coin.Amount, ratio))
+			}
+		})
+	}
+}
+
+func TestDistributeMintedCoinFundingWindow(t *testing.T) {
+	windowed, funded := sample.AccAddress(r), sample.AccAddress(r)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	tests := []struct {
+		name          string
+		height        int64
+		windowed      sdk.Coins
+		communityPool sdk.Coins
+	}{
+		{
+			name:          "should redirect the share before the window to the community pool",
+			height:        9,
+			windowed:      stake(0),
+			communityPool: stake(50),
+		},
+		{
+			name:          "should fund the address at the start height",
+			height:        10,
+			windowed:      stake(20),
+			communityPool: stake(30),
+		},
+		{
+			name:          "should fund the address inside the window",
+			height:        19,
+			windowed:      stake(20),
+			communityPool: stake(30),
+		},
+		{
+			name:          "should redirect the share from the end height to the community pool",
+			height:        20,
+			windowed:      stake(0),
+			communityPool: stake(50),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sdkCtx, tk, _ := testSetups[0].setup(t)
+			ctx := sdkCtx.WithBlockHeight(tc.height)
+			params := types.DefaultParams()
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:         sdk.NewDecWithPrec(3, 1),
+				FundedAddresses: sdk.NewDecWithPrec(4, 1),
+				CommunityPool:   sdk.NewDecWithPrec(3, 1),
+			}
+			params.FundedAddresses = []types.WeightedAddress{
+				{Address: windowed.String(), Weight: sdk.NewDecWithPrec(5, 1), StartHeight: 10, EndHeight: 20},
+				{Address: funded.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+			}
+			require.NoError(t, params.Validate())
+			tk.MintKeeper.SetParams(ctx, params)
+
+			mintedCoins := stake(100)
+			require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, mintedCoins))
+			require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoins[0]))
+
+			// the share of the windowed address is not re-split among the other addresses
+			require.True(t, tc.windowed.IsEqual(tk.BankKeeper.GetAllBalances(ctx, windowed)))
+			require.True(t, stake(20).IsEqual(tk.BankKeeper.GetAllBalances(ctx, funded)))
+			communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+			require.True(t, tc.communityPool.IsEqual(communityPool))
+
+			distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, tc.height)
+			require.True(t, found)
+			require.Equal(t, tc.windowed.AmountOf(sdk.DefaultBondDenom).AddRaw(20), distribution.Distributed.FundedAddresses)
+			require.Equal(t, tc.communityPool.AmountOf(sdk.DefaultBondDenom), distribution.Distributed.CommunityPool)
+		})
+	}
+}
+
+func TestDistributeMintedCoinCommunityPoolLabels(t *testing.T) {
+	app := setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	receiptsBefore, _ := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+	minterBefore := app.MintKeeper.GetMinter(ctx)
+
+	// 40 coins are distributed to the funded addresses, 13 and 26 with 1 of dust
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(3, 1),
+		FundedAddresses: sdk.NewDecWithPrec(4, 1),
+		CommunityPool:   sdk.NewDecWithPrec(3, 1),
+	}
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: sample.Address(r), Weight: sdk.MustNewDecFromStr("0.333333333333333333")},
+		{Address: sample.Address(r), Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+	}
+	params.DustAssignment = types.DUST_ASSIGNMENT_ROUND_ROBIN
+
+	// the labeled amounts of the community pool funded events
+	eventLabels := make(map[string]sdk.Coins)
+	distribute := func(height int64, params types.Params) {
+		ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		app.MintKeeper.SetParams(ctx, params)
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+		require.NoError(t, app.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != "modules.mint.EventCommunityPoolFunded" {
+				continue
+			}
+			parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			for _, source := range parsed.(*types.EventCommunityPoolFunded).Sources {
+				require.Equal(t, types.CommunityPoolSourceLabel(source.Source), source.Label)
+				eventLabels[source.Label] = eventLabels[source.Label].Add(source.Amount...)
+			}
+		}
+	}
+
+	// the dust of the height 11 is assigned to the community pool
+	distribute(11, params)
+
+	noFundedAddresses := params
+	noFundedAddresses.FundedAddresses = nil
+	distribute(12, noFundedAddresses)
+
+	pausedStaking := params
+	pausedStaking.PauseStakingShare = true
+	distribute(13, pausedStaking)
+
+	// the fee collector module was renamed without updating the fee collector of the keeper
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(types.FeeCollectorNameKey, []byte("removed_fee_collector"))
+	distribute(14, params)
+
+	receipts, _ := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+	receipts = receipts.Sub(receiptsBefore...)
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.Equal(t,
+		minter.CumulativeDistributed.CommunityPool.Sub(minterBefore.CumulativeDistributed.CommunityPool),
+		receipts.AmountOf(sdk.DefaultBondDenom),
+	)
+
+	// each label is funded and the labeled amounts sum to the receipts of the community pool
+	labeled, eventsLabeled := sdk.NewCoins(), sdk.NewCoins()
+	for _, label := range types.CommunityPoolLabels {
+		funding := minter.CommunityPoolFundingOf(label).Sub(minterBefore.CommunityPoolFundingOf(label)...)
+		require.True(t, funding.IsAllPositive(), "no community pool funding labeled %s", label)
+		require.True(t, funding.IsEqual(eventLabels[label]), "label %s", label)
+		labeled = labeled.Add(funding...)
+		eventsLabeled = eventsLabeled.Add(eventLabels[label]...)
+	}
+	require.True(t, receipts.IsEqual(labeled))
+	require.True(t, receipts.IsEqual(eventsLabeled))
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// driftParams returns params with a constant inflation of 10% below the max inflation so the
+// provisions can be corrected, the block provisions are about 333.33 tokens
+func driftParams() types.Params {
+	params := lowInflationParams()
+	// the smallest rate change is rounded off the inflation of each block, the inflation is constant
+	params.InflationRateChange = sdk.SmallestDec()
+	params.InflationMax = sdk.NewDecWithPrec(2, 1)
+	return params
+}
+
+// emissionDrift returns the drift of the realized emissions queried from the keeper
+func emissionDrift(t *testing.T, ctx sdk.Context, k keeper.Keeper) *types.QueryEmissionDriftResponse {
+	res, err := querier.NewQuerier(k).EmissionDrift(sdk.WrapSDKContext(ctx), &types.QueryEmissionDriftRequest{})
+	require.NoError(t, err)
+	return res
+}
+
+// mintEvent returns the EventMint emitted in the context
+func mintEvent(t *testing.T, ctx sdk.Context) *types.EventMint {
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventMint); ok {
+			return e
+		}
+	}
+	require.FailNow(t, "no mint event")
+	return nil
+}
+
+func TestBeginBlockerEmissionDrift(t *testing.T) {
+	t.Run("should track the truncation drift without correction", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := driftParams()
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(sdk.NewDecWithPrec(1, 1)))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000_000)))
+
+		for height := int64(1); height <= 3000; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+		}
+
+		// each block truncates a fraction of token of the block provision
+		res := emissionDrift(t, ctx, tk.MintKeeper)
+		require.True(t, res.Drift.LT(sdk.NewDec(-500)), res.Drift.String())
+		require.True(t, res.RelativeDrift.IsNegative())
+		require.Equal(t, sdk.NewDecFromInt(res.RealizedEmission).Sub(res.TargetEmission), res.Drift)
+	})
+
+	t.Run("should converge to the target emissions over a long horizon", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := driftParams()
+		params.DriftCorrection = types.NewDriftCorrection(sdk.NewDecWithPrec(5, 1), 100)
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(sdk.NewDecWithPrec(1, 1)))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000_000)))
+
+		// minting is paused for 100 blocks, a drift of about 33,333 tokens
+		height := int64(1)
+		params.PauseMinting = true
+		tk.MintKeeper.SetParams(ctx, params)
+		for ; height <= 100; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+		}
+		initialDrift := emissionDrift(t, ctx, tk.MintKeeper).Drift
+		require.True(t, initialDrift.LT(sdk.NewDec(-33000)), initialDrift.String())
+
+		params.PauseMinting = false
+		tk.MintKeeper.SetParams(ctx, params)
+		maxInflationProvision := func() sdk.Dec {
+			return params.InflationMax.MulInt(tk.MintKeeper.StakingTokenSupply(ctx)).QuoInt64(int64(params.BlocksPerYear))
+		}
+		previousDrift := initialDrift
+		for ; height <= 3000; height++ {
+			ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			maxProvision := maxInflationProvision()
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+			// the corrected provision is bounded and never exceeds the max inflation
+			event := mintEvent(t, ctx)
+			provision := event.AnnualProvisions.QuoInt64(int64(params.BlocksPerYear))
+			require.True(t, event.DriftCorrection.Abs().LTE(provision.Mul(params.DriftCorrection.MaxFactor)))
+			require.True(t, sdk.NewDecFromInt(event.Amount).LTE(maxProvision))
+
+			// the drift never overshoots and only increases in absolute value from truncation
+			drift := emissionDrift(t, ctx, tk.MintKeeper).Drift
+			require.True(t, drift.LT(sdk.OneDec()), "height %d: drift %s", height, drift)
+			if previousDrift.LT(sdk.NewDec(-1)) {
+				require.True(t, drift.GT(previousDrift), "height %d: drift %s", height, drift)
+			}
+			previousDrift = drift
+		}
+
+		// the drift is closed to less than a token
+		res := emissionDrift(t, ctx, tk.MintKeeper)
+		require.True(t, res.Drift.Abs().LT(sdk.OneDec()), res.Drift.String())
+		require.True(t, res.RelativeDrift.Abs().LT(sdk.NewDecWithPrec(1, 6)), res.RelativeDrift.String())
+
+		_, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should converge with the carry buffer", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := driftParams()
+		params.MinDistributableProvision = sdk.NewInt(1000)
+		params.DriftCorrection = types.NewDriftCorrection(sdk.NewDecWithPrec(1, 1), 1000)
+		tk.MintKeeper.SetParams(ctx, params)
+		minter := types.InitialMinter(sdk.NewDecWithPrec(1, 1))
+		minter.TargetCumulativeEmission = sdk.NewDec(5000)
+		tk.MintKeeper.SetMinter(ctx, minter)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000_000)))
+
+		for height := int64(1); height <= 3000; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+		}
+
+		// the carry buffer is minted later, the drift is closed up to it
+		res := emissionDrift(t, ctx, tk.MintKeeper)
+		pending := res.Drift.Add(res.CarryBuffer)
+		require.True(t, pending.Abs().LT(sdk.OneDec()), pending.String())
+	})
+}
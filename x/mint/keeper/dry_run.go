@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// dryRunKey is the context key marking the begin blocker run by DryRunBeginBlocker
+type dryRunKey struct{}
+
+// isDryRun returns true if the context is the branch of DryRunBeginBlocker
+func isDryRun(ctx sdk.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// truncationRemainderKey is the context key of the truncation remainder of the split of the minted
+// coins sent to the community pool, recorded by the distribution of the estimate
+type truncationRemainderKey struct{}
+
+// recordTruncationRemainder adds the truncation remainder sent to the community pool to the
+// remainder recorded in the context, if any
+func recordTruncationRemainder(ctx sdk.Context, remainder sdk.Coins) {
+	if recorded, ok := ctx.Value(truncationRemainderKey{}).(*sdk.Coins); ok {
+		*recorded = recorded.Add(remainder...)
+	}
+}
+
+// dryRunContext returns a branch of the context for the next block that is discarded, the begin
+// blocker run on the branch skips its hooks, telemetry, profiling and logs. The branch keeps the
+// gas meter of the context, so the work of a query is bounded by its gas limit.
+func dryRunContext(ctx sdk.Context) sdk.Context {
+	branchCtx, _ := ctx.CacheContext()
+	return branchCtx.
+		WithBlockHeight(ctx.BlockHeight()+1).
+		WithLogger(log.NewNopLogger()).
+		WithValue(dryRunKey{}, true)
+}
+
+// DryRunBeginBlocker sets the params with the change and runs the begin blocker of the next block
+// on a branch of the context that is discarded. It returns the error of the begin blocker, or of
+// its panic, so that params breaking the minting are rejected before they are set instead of
+// halting the chain. The dry run only computes the minting and the distribution of the block, the
+// hooks, the telemetry, the profiling and the logs of the begin blocker are skipped. The gas of the
+// begin blocker is not charged to the message, like the begin blocker of the block.
+func (k Keeper) DryRunBeginBlocker(ctx sdk.Context, params types.Params, change types.ParamsChange) (err error) {
+	branchCtx := dryRunContext(ctx).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Wrap(types.ErrBeginBlockerDryRun, fmt.Sprintf("panic: %v", r))
+		}
+	}()
+	k.SetParamsWithChange(branchCtx, params, change)
+
+	// the block is minted at the new blocks per year, the provision reached at the end of a
+	// transition of the blocks per year
+	if minter := k.GetMinter(branchCtx); minter.BlocksPerYearTransition != nil {
+		minter.BlocksPerYearTransition = nil
+		k.SetMinter(branchCtx, minter)
+	}
+	if err := k.BeginBlocker(branchCtx); err != nil {
+		return errors.Wrap(types.ErrBeginBlockerDryRun, err.Error())
+	}
+	return nil
+}
+
+// EstimateDistribution returns the distribution of the coins of the mint denom minted by the next
+// block. The coins are minted and distributed on a dry run branch of the state by the path of the
+// begin blocker, so the estimate accounts for the carry buffer, the minting interval, the drift
+// correction, the max supply, the community funding top-up, the pauses, the dust policy and the
+// bootstrap override like the next block. The writes of the branch are discarded and their gas is
+// consumed from the gas meter of the context, so a query can't run the begin blocker unmetered.
+func (k Keeper) EstimateDistribution(ctx sdk.Context) (types.QueryEstimatedDistributionResponse, error) {
+	denom := k.GetParams(ctx).MintDenom
+	zero := sdk.NewInt64Coin(denom, 0)
+	res := types.QueryEstimatedDistributionResponse{
+		BlockProvision:      zero,
+		Staking:             zero,
+		CommunityPool:       zero,
+		TruncationRemainder: zero,
+		FundedAddressesDust: zero,
+	}
+
+	truncationRemainder := sdk.NewCoins()
+	branchCtx := dryRunContext(ctx).WithValue(truncationRemainderKey{}, &truncationRemainder)
+	if err := k.mintDenom(branchCtx); err != nil {
+		return res, err
+	}
+	res.TruncationRemainder = sdk.NewCoin(denom, truncationRemainder.AmountOf(denom))
+	for _, event := range branchCtx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventMintDistribution); ok && e.Minted.Denom == denom {
+			res.BlockProvision = e.Minted
+			res.Staking = e.Staking
+			res.CommunityPool = e.CommunityPool
+			res.FundedAddressesDust = e.Dust
+			res.FundedAddresses = make([]types.FundedAddressAllocation, len(e.FundedAddresses))
+			for i, fundedAddress := range e.FundedAddresses {
+				res.FundedAddresses[i] = types.FundedAddressAllocation{
+					Address: fundedAddress.Address,
+					Amount:  sdk.NewCoin(denom, fundedAddress.Amount.AmountOf(denom)),
+				}
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetDustAccumulator returns the truncation remainder of the category split carried over to the
+// next block with the carry over dust policy, the coins are held by the module account
+func (k Keeper) GetDustAccumulator(ctx sdk.Context) (sdk.Coin, bool) {
+	return k.collections.dustAccumulator.Get(ctx)
+}
+
+// SetDustAccumulator sets the truncation remainder carried over to the next block, a zero
+// accumulator is removed from the store
+func (k Keeper) SetDustAccumulator(ctx sdk.Context, accumulator sdk.Coin) {
+	if accumulator.IsNil() || accumulator.IsZero() {
+		k.collections.dustAccumulator.Remove(ctx)
+		return
+	}
+	k.collections.dustAccumulator.Set(ctx, accumulator)
+}
+
+// carriedDust returns the coins of the dust accumulator
+func (k Keeper) carriedDust(ctx sdk.Context) sdk.Coins {
+	accumulator, found := k.GetDustAccumulator(ctx)
+	if !found {
+		return nil
+	}
+	return sdk.NewCoins(accumulator)
+}
+
+// applyDustPolicy returns the coin split in the shares of the categories, the shares and the
+// truncation remainder of the split taken from the community pool share by the dust policy: the
+// dust to add to the share of the first funded address with the first recipient policy, or the
+// dust carried over to the next block with the carry over policy. The dust carried over from the
+// previous blocks is split with the minted coins. The dust policy only applies to the shares split
+// by the distribution proportions, the shares of the minted coins capped by the max supply are
+// distributed unchanged.
+func (k Keeper) applyDustPolicy(
+	ctx sdk.Context,
+	params types.Params,
+	mintedCoin sdk.Coin,
+	shares types.CategoryTotals,
+) (split sdk.Coin, _ types.CategoryTotals, firstRecipientDust, carriedDust sdk.Coins) {
+	if !equalShares(shares, k.categoryShares(ctx, params, mintedCoin)) {
+		return mintedCoin, shares, nil, nil
+	}
+
+	// the carried dust is released whatever the current dust policy
+	split = mintedCoin
+	accumulator, found := k.GetDustAccumulator(ctx)
+	if found && accumulator.Denom == mintedCoin.Denom {
+		split = split.Add(accumulator)
+		shares = k.categoryShares(ctx, params, split)
+		k.SetDustAccumulator(ctx, sdk.NewCoin(accumulator.Denom, sdkmath.ZeroInt()))
+		found = false
+	}
+
+	// the community pool share is the remainder of the split, it holds the truncation remainders
+	// of the shares of the other categories
+	remainder := shares.CommunityPool.Sub(mulRatio(split.Amount, params.DistributionProportions.CommunityPool))
+	if !remainder.IsPositive() {
+		return split, shares, nil, nil
+	}
+	dust := sdk.NewCoins(sdk.NewCoin(split.Denom, remainder))
+	switch params.DustPolicy {
+	case types.DUST_POLICY_FIRST_RECIPIENT:
+		firstRecipientDust = dust
+	case types.DUST_POLICY_CARRY_OVER:
+		// the dust of another mint denom is kept in the accumulator until the denom is minted again
+		if found {
+			return split, shares, nil, nil
+		}
+		k.SetDustAccumulator(ctx, sdk.NewCoin(split.Denom, remainder))
+		carriedDust = dust
+	default:
+		recordTruncationRemainder(ctx, dust)
+		return split, shares, nil, nil
+	}
+	shares.CommunityPool = shares.CommunityPool.Sub(remainder)
+	return split, shares, firstRecipientDust, carriedDust
+}
+
+// equalShares returns true if the shares of the categories are equal
+func equalShares(shares, other types.CategoryTotals) bool {
+	shares.Normalize()
+	other.Normalize()
+	return shares.Staking.Equal(other.Staking) &&
+		shares.FundedAddresses.Equal(other.FundedAddresses) &&
+		shares.CommunityPool.Equal(other.CommunityPool) &&
+		shares.StrategicReserve.Equal(other.StrategicReserve)
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestDustPolicy(t *testing.T) {
+	const blocks = 50
+	third := sdk.MustNewDecFromStr("0.333333333333333333")
+
+	for _, policy := range []types.DustPolicy{
+		types.DUST_POLICY_COMMUNITY_POOL,
+		types.DUST_POLICY_FIRST_RECIPIENT,
+		types.DUST_POLICY_CARRY_OVER,
+	} {
+		policy := policy
+		t.Run(policy.String(), func(t *testing.T) {
+			ctx, tk, _ := testSetups[0].setup(t)
+			fundedAddrs := []string{sample.Address(r), sample.Address(r)}
+			params := types.DefaultParams()
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:          third,
+				FundedAddresses:  third,
+				CommunityPool:    sdk.MustNewDecFromStr("0.233333333333333334"),
+				StrategicReserve: sdk.NewDecWithPrec(1, 1),
+			}
+			params.FundedAddresses = []types.WeightedAddress{
+				{Address: fundedAddrs[0], Weight: third},
+				{Address: fundedAddrs[1], Weight: sdk.OneDec().Sub(third)},
+			}
+			params.DustPolicy = policy
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+			totalMinted := sdkmath.ZeroInt()
+			communityPoolShares := sdkmath.ZeroInt()
+			for height := int64(1); height <= blocks; height++ {
+				ctx := ctx.WithBlockHeight(height)
+				mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100+height*7)
+				require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+				require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+				totalMinted = totalMinted.Add(mintedCoin.Amount)
+				communityPoolShares = communityPoolShares.Add(
+					tk.MintKeeper.GetProportion(ctx, mintedCoin, params.DistributionProportions.CommunityPool).Amount,
+				)
+
+				msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+				require.False(t, broken, msg)
+			}
+
+			// the minted coins are all received by a recipient or held by the module account
+			feeCollector := tk.BankKeeper.GetBalance(ctx, tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), sdk.DefaultBondDenom)
+			communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+			moduleAccount := tk.MintKeeper.ModuleAccountBalance(ctx)
+			received := feeCollector.Amount.
+				Add(communityPool.AmountOf(sdk.DefaultBondDenom)).
+				Add(moduleAccount.AmountOf(sdk.DefaultBondDenom))
+			fundedAmounts := make([]sdkmath.Int, len(fundedAddrs))
+			for i, addr := range fundedAddrs {
+				fundedAmounts[i] = tk.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(addr), sdk.DefaultBondDenom).Amount
+				received = received.Add(fundedAmounts[i])
+			}
+			require.True(t, totalMinted.Equal(received), "minted %s, received %s", totalMinted, received)
+
+			minter := tk.MintKeeper.GetMinter(ctx)
+			require.True(t, totalMinted.Equal(minter.CumulativeMinted))
+			accumulator, found := tk.MintKeeper.GetDustAccumulator(ctx)
+			switch policy {
+			case types.DUST_POLICY_COMMUNITY_POOL:
+				// the community pool receives the truncation remainders of the split
+				require.True(t, communityPool.AmountOf(sdk.DefaultBondDenom).GT(communityPoolShares))
+				require.False(t, found)
+			case types.DUST_POLICY_FIRST_RECIPIENT:
+				// the community pool receives its exact share, the remainders go to the first funded address
+				require.True(t, communityPool.AmountOf(sdk.DefaultBondDenom).Equal(communityPoolShares))
+				require.True(t, fundedAmounts[0].GT(fundedAmounts[1].QuoRaw(2)))
+				require.False(t, found)
+			case types.DUST_POLICY_CARRY_OVER:
+				// the remainder of the last block is carried over, the previous ones are distributed
+				// with the minted coins of the next block
+				require.True(t, found)
+				require.True(t, accumulator.Amount.IsPositive())
+				require.True(t, accumulator.Amount.LTE(sdkmath.NewInt(3)))
+				require.True(t, tk.MintKeeper.TotalBuffered(ctx).IsEqual(moduleAccount))
+				require.True(t, communityPool.AmountOf(sdk.DefaultBondDenom).LT(communityPoolShares.Add(sdkmath.NewInt(blocks))))
+			}
+		})
+	}
+}
+
+func TestDustPolicyReleaseCarriedDust(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(5, 1),
+		FundedAddresses:  sdk.ZeroDec(),
+		CommunityPool:    sdk.NewDecWithPrec(5, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	params.FundedAddresses = nil
+	params.DustPolicy = types.DUST_POLICY_CARRY_OVER
+	tk.MintKeeper.SetParams(ctx, params)
+	tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+	tk.MintKeeper.SetDustAccumulator(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))))
+	minter := tk.MintKeeper.GetMinter(ctx)
+	minter.CumulativeMinted = sdkmath.OneInt()
+	tk.MintKeeper.SetMinter(ctx, minter)
+
+	// the carried dust is distributed with the minted coins of the next block
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+	require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+	_, found := tk.MintKeeper.GetDustAccumulator(ctx)
+	require.False(t, found)
+	distributed := tk.MintKeeper.GetMinter(ctx).CumulativeDistributed
+	require.EqualValues(t, 2, distributed.Staking.Int64())
+	require.EqualValues(t, 2, distributed.CommunityPool.Int64())
+	require.True(t, tk.MintKeeper.ModuleAccountBalance(ctx).IsZero())
+	msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GenerateEmissionReport assembles the emission report of a range of heights from the recorded
+// allocations of the minted coins. The range must end at most at the current height so that the
+// report of a range is deterministic, the heights without record are not covered by the report.
+func (k Keeper) GenerateEmissionReport(ctx sdk.Context, fromHeight, toHeight int64) (types.EmissionReport, error) {
+	if err := types.ValidateEmissionReportRange(fromHeight, toHeight, ctx.BlockHeight()); err != nil {
+		return types.EmissionReport{}, err
+	}
+
+	report := types.NewEmissionReport(fromHeight, toHeight)
+	err := k.IterateBlockDistributions(ctx, fromHeight, func(distribution types.BlockDistribution) bool {
+		if distribution.Height > toHeight {
+			return true
+		}
+		report.Add(distribution)
+		return false
+	})
+	return report, err
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestGenerateEmissionReport(t *testing.T) {
+	app := setup(false)
+	sdkCtx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	q := querier.NewQuerier(app.MintKeeper)
+
+	// the allocation of the height 3 is not recorded
+	store := sdkCtx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(types.DistributionHistoryKey(3))
+	for _, height := range []int64{1, 2, 4, 5, 6} {
+		app.MintKeeper.SetBlockDistribution(sdkCtx, blockDistribution(height))
+	}
+
+	t.Run("should assemble the report from the recorded allocations of the range", func(t *testing.T) {
+		report, err := app.MintKeeper.GenerateEmissionReport(sdkCtx, 2, 5)
+		require.NoError(t, err)
+		require.Equal(t, types.EmissionReport{
+			FromHeight:     2,
+			ToHeight:       5,
+			RecordedBlocks: 3,
+			FirstTime:      time.Unix(10, 0).UTC(),
+			LastTime:       time.Unix(25, 0).UTC(),
+			Minted:         sdkmath.NewInt(11),
+			Distributed:    types.NewCategoryTotals(),
+		}, report)
+	})
+
+	t.Run("should return the report with its hash and proof context", func(t *testing.T) {
+		res, err := q.EmissionReport(ctx, &types.QueryEmissionReportRequest{FromHeight: 2, ToHeight: 5})
+		require.NoError(t, err)
+		report, err := app.MintKeeper.GenerateEmissionReport(sdkCtx, 2, 5)
+		require.NoError(t, err)
+		require.Equal(t, report, res.Report)
+		hash, err := report.Hash()
+		require.NoError(t, err)
+		require.Equal(t, hash, res.Hash)
+		require.Equal(t, types.EmissionReportProofContext{
+			Height:    10,
+			StoreName: types.StoreKey,
+			KeyPrefix: types.DistributionHistoryKeyPrefix,
+		}, res.ProofContext)
+
+		// the records are stored at the keys located by the proof context
+		for _, height := range []int64{2, 4, 5} {
+			key := append(append([]byte{}, res.ProofContext.KeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+			var distribution types.BlockDistribution
+			require.NoError(t, app.AppCodec().Unmarshal(store.Get(key), &distribution))
+			require.Equal(t, blockDistribution(height), distribution)
+		}
+	})
+
+	t.Run("should return the same report once new allocations are recorded", func(t *testing.T) {
+		before, err := q.EmissionReport(ctx, &types.QueryEmissionReportRequest{FromHeight: 2, ToHeight: 5})
+		require.NoError(t, err)
+		app.MintKeeper.SetBlockDistribution(sdkCtx, blockDistribution(7))
+		after, err := q.EmissionReport(sdk.WrapSDKContext(sdkCtx.WithBlockHeight(11)), &types.QueryEmissionReportRequest{
+			FromHeight: 2,
+			ToHeight:   5,
+		})
+		require.NoError(t, err)
+		require.Equal(t, before.Hash, after.Hash)
+	})
+
+	t.Run("should reject an invalid range", func(t *testing.T) {
+		for _, req := range []*types.QueryEmissionReportRequest{
+			{FromHeight: -1, ToHeight: 5},
+			{FromHeight: 5, ToHeight: 4},
+			{FromHeight: 5, ToHeight: 11},
+		} {
+			_, err := q.EmissionReport(ctx, req)
+			require.ErrorIs(t, err, types.ErrInvalidHeightRange)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetFeeCollectorName returns the name of the module account receiving the staking share: the
+// name set at an upgrade, or the name the keeper is created with
+func (k Keeper) GetFeeCollectorName(ctx sdk.Context) string {
+	name, found := k.collections.feeCollectorName.Get(ctx)
+	if !found {
+		return k.feeCollectorName
+	}
+	return name
+}
+
+// SetFeeCollectorName sets the name of the module account receiving the staking share in place of
+// the name the keeper is created with, typically in the upgrade handler renaming the fee collector
+// module. The module account must exist.
+func (k Keeper) SetFeeCollectorName(ctx sdk.Context, name string) error {
+	if k.accountKeeper.GetModuleAddress(name) == nil {
+		return errorsignite.Wrapf(types.ErrFeeCollectorNotFound, "module account %q", name)
+	}
+	k.collections.feeCollectorName.Set(ctx, name)
+	return nil
+}
+
+// sendStakingShare sends the coins of the staking share to the staking rewards recipient of the
+// params, or to the fee collector if the param is empty, and returns the recipient of the coins.
+// When the fee collector module account doesn't exist, the coins are returned as community pool
+// coins to be funded with the community pool share, and an EventFeeCollectorMissing is emitted
+// instead of failing the block. The events of the allocation carry its index.
+func (k Keeper) sendStakingShare(
+	ctx sdk.Context,
+	params types.Params,
+	coins sdk.Coins,
+	index uint32,
+) (recipient sdk.AccAddress, communityPoolCoins sdk.Coins, err error) {
+	if params.StakingRewardsRecipient != "" {
+		recipient, err = k.sendStakingRewards(ctx, params, coins, index)
+		return recipient, nil, err
+	}
+
+	feeCollector := k.GetFeeCollectorName(ctx)
+	if recipient = k.accountKeeper.GetModuleAddress(feeCollector); recipient != nil {
+		return recipient, nil, transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+			return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, feeCollector, coins)
+		})
+	}
+
+	recipient = k.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	k.Logger(ctx).Error(
+		"fee collector module account not found, staking share sent to the community pool",
+		"fee_collector", feeCollector,
+		"amount", coins.String(),
+	)
+	return recipient, coins, ctx.EventManager().EmitTypedEvent(&types.EventFeeCollectorMissing{
+		FeeCollector:    feeCollector,
+		Recipient:       recipient.String(),
+		Amount:          coins,
+		AllocationIndex: index,
+	})
+}
+
+// sendStakingRewards sends the coins of the staking share to the staking rewards recipient of the
+// params, a module account or an address, and returns the address of the recipient. A recipient
+// that is neither a known module account nor a valid address is a critical error.
+func (k Keeper) sendStakingRewards(
+	ctx sdk.Context,
+	params types.Params,
+	coins sdk.Coins,
+	index uint32,
+) (sdk.AccAddress, error) {
+	if params.StakingRewardsRecipientIsModuleAccount() {
+		recipient := k.accountKeeper.GetModuleAddress(params.StakingRewardsRecipient)
+		if recipient == nil {
+			return nil, errorsignite.Criticalf("staking rewards recipient module account %q not found", params.StakingRewardsRecipient)
+		}
+		return recipient, transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+			return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, params.StakingRewardsRecipient, coins)
+		})
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(params.StakingRewardsRecipient)
+	if err != nil {
+		return nil, errorsignite.Criticalf("invalid staking rewards recipient %q: %s", params.StakingRewardsRecipient, err)
+	}
+	return recipient, transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+	})
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	claimtypes "github.com/ignite/modules/x/claim/types"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// feeCollectorMissingEvents returns the fee collector missing events of the context
+func feeCollectorMissingEvents(t *testing.T, ctx sdk.Context) []types.EventFeeCollectorMissing {
+	var events []types.EventFeeCollectorMissing
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventFeeCollectorMissing" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, *parsed.(*types.EventFeeCollectorMissing))
+	}
+	return events
+}
+
+func TestSetFeeCollectorName(t *testing.T) {
+	app := setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	require.Equal(t, authtypes.FeeCollectorName, app.MintKeeper.GetFeeCollectorName(ctx))
+
+	err := app.MintKeeper.SetFeeCollectorName(ctx, "renamed_fee_collector")
+	require.ErrorIs(t, err, types.ErrFeeCollectorNotFound)
+	require.Equal(t, authtypes.FeeCollectorName, app.MintKeeper.GetFeeCollectorName(ctx))
+
+	require.NoError(t, app.MintKeeper.SetFeeCollectorName(ctx, distrtypes.ModuleName))
+	require.Equal(t, distrtypes.ModuleName, app.MintKeeper.GetFeeCollectorName(ctx))
+}
+
+func TestMissingFeeCollector(t *testing.T) {
+	app := setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	// the fee collector module was renamed without updating the fee collector of the keeper
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(types.FeeCollectorNameKey, []byte("removed_fee_collector"))
+
+	app.MintKeeper.SetParams(ctx, types.DefaultParams())
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	minter := app.MintKeeper.GetMinter(ctx)
+
+	// the staking share of 100 coins is 30 coins
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+	require.NoError(t, app.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+	require.Equal(t, []types.EventFeeCollectorMissing{{
+		FeeCollector: "removed_fee_collector",
+		Recipient:    authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+		Amount:       sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)),
+	}}, feeCollectorMissingEvents(t, ctx))
+	require.Equal(t, feeCollectorBalance, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t,
+		communityPool.Add(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+	require.Equal(t, minter.CumulativeDistributed.Staking, app.MintKeeper.GetMinter(ctx).CumulativeDistributed.Staking)
+}
+
+func TestStakingRewardsRecipient(t *testing.T) {
+	// the staking share of 100 coins is 30 coins
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	stakingShare := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))
+	address := sample.AccAddress(r)
+
+	tests := []struct {
+		name         string
+		recipient    string
+		feeCollector string
+		expected     sdk.AccAddress
+		err          string
+	}{
+		{
+			name:     "should send the staking share to the fee collector without recipient",
+			expected: authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+		},
+		{
+			name:      "should send the staking share to a module account",
+			recipient: claimtypes.ModuleName,
+			expected:  authtypes.NewModuleAddress(claimtypes.ModuleName),
+		},
+		{
+			name:      "should send the staking share to an address",
+			recipient: address.String(),
+			expected:  address,
+		},
+		{
+			name:         "should send the staking share to the recipient when the fee collector doesn't exist",
+			recipient:    address.String(),
+			feeCollector: "removed_fee_collector",
+			expected:     address,
+		},
+		{
+			name:      "should fail with an unknown module account",
+			recipient: "fee_abstraction",
+			err:       errorsignite.ErrCritical.Error(),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app := setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+			if tc.feeCollector != "" {
+				store := ctx.KVStore(app.GetKey(types.StoreKey))
+				store.Set(types.FeeCollectorNameKey, []byte(tc.feeCollector))
+			}
+
+			params := types.DefaultParams()
+			params.StakingRewardsRecipient = tc.recipient
+			app.MintKeeper.SetParams(ctx, params)
+			minter := app.MintKeeper.GetMinter(ctx)
+			communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+			var balance sdk.Coins
+			if tc.expected != nil {
+				balance = app.BankKeeper.GetAllBalances(ctx, tc.expected)
+			}
+
+			require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+			err := app.MintKeeper.DistributeMintedCoin(ctx, mintedCoin)
+			if tc.err != "" {
+				require.ErrorIs(t, err, types.ErrDistributionFailed)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Empty(t, feeCollectorMissingEvents(t, ctx))
+			require.Equal(t, balance.Add(stakingShare...), app.BankKeeper.GetAllBalances(ctx, tc.expected))
+			require.Equal(t,
+				communityPool.Add(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(70))),
+				app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+			)
+			require.Equal(t,
+				minter.CumulativeDistributed.Staking.AddRaw(30),
+				app.MintKeeper.GetMinter(ctx).CumulativeDistributed.Staking,
+			)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// FundMinterCoin distributes a coin of the mint denom of an account by the distribution proportions
+// like the minted coins, with the same distribution events. The coin is added to the cumulative
+// funded amount of the minter rather than to the cumulative minted amount, and the bootstrap
+// override doesn't apply to it. The coin is sent to the module account and distributed in a branch
+// of the state so it stays in the account if the distribution fails. It returns the cumulative
+// funded amount and emits EventMinterFunded before the events of the distribution.
+func (k Keeper) FundMinterCoin(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	params := k.GetParams(ctx)
+	if coin.Denom != params.MintDenom {
+		return sdk.Coin{}, errors.Wrapf(types.ErrInvalidFunding, "denom %s is not the mint denom %s", coin.Denom, params.MintDenom)
+	}
+	if !coin.IsPositive() {
+		return sdk.Coin{}, errors.Wrapf(types.ErrInvalidFunding, "funded amount must be positive: %s", coin)
+	}
+
+	fundCtx, write := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(fundCtx, from, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	total := sdk.NewCoin(coin.Denom, k.GetMinter(fundCtx).CumulativeFunded.Add(coin.Amount))
+	err := fundCtx.EventManager().EmitTypedEvent(&types.EventMinterFunded{
+		Address:          from.String(),
+		Amount:           coin,
+		CumulativeFunded: total,
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	shares := k.categoryShares(fundCtx, params, coin)
+	if err := k.distributeCoin(fundCtx, params, coin, shares, types.ZeroCommunityFundingTopUp(), true); err != nil {
+		return sdk.Coin{}, err
+	}
+	write()
+
+	return total, nil
+}
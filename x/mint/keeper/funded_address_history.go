@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// AppendFundedAddressWeightChange records a weight change of a funded address, the oldest
+// changes of the address are pruned to keep at most FundedAddressHistoryRetention changes
+func (k Keeper) AppendFundedAddressWeightChange(ctx sdk.Context, change types.FundedAddressWeightChange) {
+	// the sequence of the change follows the sequence of the last recorded change
+	var sequence uint64
+	r := prefixRange(addressSequenceKey{}.Prefix(change.Address))
+	r.reverse = true
+	k.collections.fundedAddressHistory.Iterate(ctx, r, func(key addressSequence, _ types.FundedAddressWeightChange) bool {
+		sequence = key.Sequence + 1
+		return true
+	})
+
+	k.collections.fundedAddressHistory.Set(ctx, addressSequence{Address: change.Address, Sequence: sequence}, change)
+	if sequence >= types.FundedAddressHistoryRetention {
+		k.collections.fundedAddressHistory.Remove(ctx, addressSequence{
+			Address:  change.Address,
+			Sequence: sequence - types.FundedAddressHistoryRetention,
+		})
+	}
+}
+
+// GetFundedAddressHistory returns the recorded weight changes of a funded address from the oldest,
+// the iteration stops with the error of the context once it is done
+func (k Keeper) GetFundedAddressHistory(
+	ctx sdk.Context,
+	address string,
+	pagination *query.PageRequest,
+) ([]types.FundedAddressWeightChange, *query.PageResponse, error) {
+	store := k.collections.fundedAddressHistory.store(ctx, addressSequenceKey{}.Prefix(address))
+
+	var changes []types.FundedAddressWeightChange
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		if err := ctx.Context().Err(); err != nil {
+			return err
+		}
+		var change types.FundedAddressWeightChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	return changes, pageRes, err
+}
+
+// recordFundedAddressChanges records the weight changes between two lists of funded addresses
+func (k Keeper) recordFundedAddressChanges(ctx sdk.Context, old, new []types.WeightedAddress) {
+	for _, change := range types.DiffFundedAddresses(ctx.BlockHeight(), old, new) {
+		k.AppendFundedAddressWeightChange(ctx, change)
+	}
+}
+
+// resetFundingWindows sets the funding windows of the funded addresses to zero heights, funding
+// the addresses at every height
+func (k Keeper) resetFundingWindows(ctx sdk.Context) {
+	var fundedAddresses []types.WeightedAddress
+	k.paramSpace.Get(ctx, types.KeyFundedAddresses, &fundedAddresses)
+	for i := range fundedAddresses {
+		fundedAddresses[i].StartHeight = 0
+		fundedAddresses[i].EndHeight = 0
+	}
+	k.paramSpace.Set(ctx, types.KeyFundedAddresses, fundedAddresses)
+}
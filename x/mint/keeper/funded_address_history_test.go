@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestFundedAddressHistory(t *testing.T) {
+	for _, ts := range testSetups {
+		ts := ts
+		t.Run(ts.name, func(t *testing.T) {
+			t.Run("should record the weight changes of the params updates", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				addr1, addr2 := sample.Address(r), sample.Address(r)
+
+				params := types.DefaultParams()
+				params.FundedAddresses = []types.WeightedAddress{
+					{Address: addr1, Weight: sdk.NewDecWithPrec(4, 1)},
+					{Address: addr2, Weight: sdk.NewDecWithPrec(6, 1)},
+				}
+				tk.MintKeeper.SetParams(ctx.WithBlockHeight(10), params)
+
+				// reordering the funded addresses doesn't record any change
+				params.FundedAddresses = []types.WeightedAddress{params.FundedAddresses[1], params.FundedAddresses[0]}
+				tk.MintKeeper.SetParams(ctx.WithBlockHeight(11), params)
+
+				params.FundedAddresses = []types.WeightedAddress{{Address: addr2, Weight: sdk.OneDec()}}
+				tk.MintKeeper.SetParams(ctx.WithBlockHeight(12), params)
+
+				changes, _, err := tk.MintKeeper.GetFundedAddressHistory(ctx, addr1, nil)
+				require.NoError(t, err)
+				require.Equal(t, []types.FundedAddressWeightChange{
+					{Address: addr1, Height: 10, OldWeight: sdk.ZeroDec(), NewWeight: sdk.NewDecWithPrec(4, 1), Action: types.WeightChangeAdded},
+					{Address: addr1, Height: 12, OldWeight: sdk.NewDecWithPrec(4, 1), NewWeight: sdk.ZeroDec(), Action: types.WeightChangeRemoved},
+				}, changes)
+
+				changes, _, err = tk.MintKeeper.GetFundedAddressHistory(ctx, addr2, nil)
+				require.NoError(t, err)
+				require.Equal(t, []types.FundedAddressWeightChange{
+					{Address: addr2, Height: 10, OldWeight: sdk.ZeroDec(), NewWeight: sdk.NewDecWithPrec(6, 1), Action: types.WeightChangeAdded},
+					{Address: addr2, Height: 12, OldWeight: sdk.NewDecWithPrec(6, 1), NewWeight: sdk.OneDec(), Action: types.WeightChangeUpdated},
+				}, changes)
+			})
+
+			t.Run("should prune the oldest changes beyond the retention", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				addr := sample.Address(r)
+
+				total := types.FundedAddressHistoryRetention + 5
+				for i := 0; i < total; i++ {
+					tk.MintKeeper.AppendFundedAddressWeightChange(ctx, types.FundedAddressWeightChange{
+						Address:   addr,
+						Height:    int64(i),
+						OldWeight: sdk.ZeroDec(),
+						NewWeight: sdk.OneDec(),
+						Action:    types.WeightChangeUpdated,
+					})
+				}
+
+				changes, _, err := tk.MintKeeper.GetFundedAddressHistory(ctx, addr, &query.PageRequest{Limit: uint64(total)})
+				require.NoError(t, err)
+				require.Len(t, changes, types.FundedAddressHistoryRetention)
+				require.EqualValues(t, 5, changes[0].Height)
+				require.EqualValues(t, total-1, changes[len(changes)-1].Height)
+			})
+		})
+	}
+}
+
+func TestFundedAddressHistoryQuery(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	addr := sample.Address(r)
+
+	for i := 0; i < 5; i++ {
+		tk.MintKeeper.AppendFundedAddressWeightChange(sdkCtx, types.FundedAddressWeightChange{
+			Address:   addr,
+			Height:    int64(i),
+			OldWeight: sdk.ZeroDec(),
+			NewWeight: sdk.OneDec(),
+			Action:    types.WeightChangeUpdated,
+		})
+	}
+
+	t.Run("should paginate the changes", func(t *testing.T) {
+		var heights []int64
+		var next []byte
+		for {
+			res, err := querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(ctx, &types.QueryFundedAddressHistoryRequest{
+				Address:    addr,
+				Pagination: &query.PageRequest{Key: next, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Changes), 2)
+			for _, change := range res.Changes {
+				heights = append(heights, change.Height)
+			}
+			next = res.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, []int64{0, 1, 2, 3, 4}, heights)
+	})
+
+	t.Run("should return no change for an address without history", func(t *testing.T) {
+		res, err := querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(ctx, &types.QueryFundedAddressHistoryRequest{
+			Address: sample.Address(r),
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.Changes)
+	})
+
+	t.Run("should prevent querying an invalid address", func(t *testing.T) {
+		_, err := querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(ctx, &types.QueryFundedAddressHistoryRequest{
+			Address: "invalid",
+		})
+		require.Error(t, err)
+	})
+}
+
+// countdownContext is a context cancelled after its error has been checked a number of times
+type countdownContext struct {
+	context.Context
+	checks *int
+}
+
+func (c countdownContext) Err() error {
+	if *c.checks <= 0 {
+		return context.Canceled
+	}
+	*c.checks--
+	return nil
+}
+
+func TestFundedAddressHistoryQueryBounds(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	addr := sample.Address(r)
+
+	total := types.FundedAddressHistoryMaxLimit + 10
+	for i := 0; i < total; i++ {
+		tk.MintKeeper.AppendFundedAddressWeightChange(sdkCtx, types.FundedAddressWeightChange{
+			Address:   addr,
+			Height:    int64(i),
+			OldWeight: sdk.ZeroDec(),
+			NewWeight: sdk.OneDec(),
+			Action:    types.WeightChangeUpdated,
+		})
+	}
+
+	t.Run("should bound the changes returned per request", func(t *testing.T) {
+		res, err := querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(ctx, &types.QueryFundedAddressHistoryRequest{
+			Address:    addr,
+			Pagination: &query.PageRequest{Limit: 1000},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Changes, types.FundedAddressHistoryMaxLimit)
+		require.NotNil(t, res.Pagination.NextKey)
+
+		res, err = querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(ctx, &types.QueryFundedAddressHistoryRequest{
+			Address:    addr,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Changes, 10)
+		require.EqualValues(t, total-1, res.Changes[9].Height)
+		require.Nil(t, res.Pagination.NextKey)
+	})
+
+	t.Run("should reject a page request with an offset and a key", func(t *testing.T) {
+		_, err := querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(ctx, &types.QueryFundedAddressHistoryRequest{
+			Address:    addr,
+			Pagination: &query.PageRequest{Offset: 1, Key: sdk.Uint64ToBigEndian(2)},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should stop the iteration once the request is cancelled", func(t *testing.T) {
+		checks := 3
+		_, err := querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(countdownContext{Context: ctx, checks: &checks}, &types.QueryFundedAddressHistoryRequest{
+			Address: addr,
+		})
+		require.Equal(t, codes.Canceled, status.Code(err))
+	})
+
+	t.Run("should stop the iteration once the request deadline is exceeded", func(t *testing.T) {
+		expired, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+		defer cancel()
+		_, err := querier.NewQuerier(tk.MintKeeper).FundedAddressHistory(expired, &types.QueryFundedAddressHistoryRequest{
+			Address: addr,
+		})
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+}
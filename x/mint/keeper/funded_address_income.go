@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetFundedAddressIncome returns the income of a funded address since the income of the funded
+// addresses is tracked, the income of an address without share has no height
+func (k Keeper) GetFundedAddressIncome(ctx sdk.Context, address string) types.FundedAddressIncome {
+	income := types.FundedAddressIncome{Address: address, Total: sdk.NewCoins()}
+	if stored, found := k.collections.fundedAddressIncome.Get(ctx, address); found {
+		income = stored
+	}
+	return income
+}
+
+// addFundedAddressIncome adds the shares of the funded addresses of a block to their income
+func (k Keeper) addFundedAddressIncome(ctx sdk.Context, shares []types.FundedAddressDistribution) {
+	for _, share := range shares {
+		if share.Amount.IsZero() {
+			continue
+		}
+		income := k.GetFundedAddressIncome(ctx, share.Address)
+		income.Add(ctx.BlockHeight(), share.Amount)
+		k.collections.fundedAddressIncome.Set(ctx, share.Address, income)
+	}
+}
+
+// GetAddressMintIncome returns the total of the shares of a funded address in the recorded
+// allocations of a range of heights and the number of recorded allocations of the range. The
+// range must end at most at the current height and start in the retention of the distribution
+// history, ErrInsufficientHistory is returned if the allocations of the range are pruned or
+// don't record the shares of the funded addresses.
+func (k Keeper) GetAddressMintIncome(
+	ctx sdk.Context,
+	address string,
+	fromHeight,
+	toHeight int64,
+) (sdk.Coins, uint64, error) {
+	if err := types.ValidateEmissionReportRange(fromHeight, toHeight, ctx.BlockHeight()); err != nil {
+		return nil, 0, err
+	}
+	if retainedFrom := types.DistributionHistoryRetainedFrom(ctx.BlockHeight()); fromHeight < retainedFrom {
+		return nil, 0, errors.Wrapf(
+			types.ErrInsufficientHistory,
+			"from height %d predates the distribution history retained from height %d",
+			fromHeight, retainedFrom,
+		)
+	}
+
+	var distributions []types.BlockDistribution
+	err := k.IterateBlockDistributions(ctx, fromHeight, func(distribution types.BlockDistribution) bool {
+		if distribution.Height > toHeight {
+			return true
+		}
+		distributions = append(distributions, distribution)
+		return false
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return types.SumAddressIncome(address, fromHeight, toHeight, distributions)
+}
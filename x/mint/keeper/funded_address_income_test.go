@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestAddressMintIncome(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	q := querier.NewQuerier(tk.MintKeeper)
+	funded, other := sample.AccAddress(r), sample.AccAddress(r)
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(3, 1),
+		FundedAddresses: sdk.NewDecWithPrec(4, 1),
+		CommunityPool:   sdk.NewDecWithPrec(3, 1),
+	}
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: funded.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: other.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, params.Validate())
+	tk.MintKeeper.SetParams(sdkCtx, params)
+
+	// each address receives 20stake at the heights 2 to 6
+	for height := int64(2); height <= 6; height++ {
+		ctx := sdkCtx.WithBlockHeight(height)
+		require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, stake(100)))
+		require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	}
+	ctx := sdkCtx.WithBlockHeight(6)
+
+	t.Run("should count the lifetime income of the funded addresses", func(t *testing.T) {
+		require.Equal(t, types.FundedAddressIncome{
+			Address:     funded.String(),
+			Total:       stake(100),
+			FirstHeight: 2,
+			LastHeight:  6,
+		}, tk.MintKeeper.GetFundedAddressIncome(ctx, funded.String()))
+		unfunded := sample.Address(r)
+		require.Equal(t, types.FundedAddressIncome{
+			Address: unfunded,
+			Total:   sdk.NewCoins(),
+		}, tk.MintKeeper.GetFundedAddressIncome(ctx, unfunded))
+	})
+
+	t.Run("should return the income of a range of heights with the lifetime income", func(t *testing.T) {
+		res, err := q.AddressMintIncome(sdk.WrapSDKContext(ctx), &types.QueryAddressMintIncomeRequest{
+			Address:    funded.String(),
+			FromHeight: 3,
+			ToHeight:   5,
+		})
+		require.NoError(t, err)
+		require.True(t, stake(60).IsEqual(res.Amount))
+		require.EqualValues(t, 3, res.RecordedBlocks)
+		require.True(t, stake(100).IsEqual(res.Lifetime.Total))
+	})
+
+	t.Run("should return no income for an address without share", func(t *testing.T) {
+		res, err := q.AddressMintIncome(sdk.WrapSDKContext(ctx), &types.QueryAddressMintIncomeRequest{
+			Address:    sample.Address(r),
+			FromHeight: 1,
+			ToHeight:   6,
+		})
+		require.NoError(t, err)
+		require.True(t, res.Amount.IsZero())
+		require.EqualValues(t, 5, res.RecordedBlocks)
+		require.True(t, res.Lifetime.Total.IsZero())
+	})
+
+	t.Run("should reject an invalid request", func(t *testing.T) {
+		_, err := q.AddressMintIncome(sdk.WrapSDKContext(ctx), nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = q.AddressMintIncome(sdk.WrapSDKContext(ctx), &types.QueryAddressMintIncomeRequest{
+			Address:  "invalid",
+			ToHeight: 6,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = q.AddressMintIncome(sdk.WrapSDKContext(ctx), &types.QueryAddressMintIncomeRequest{
+			Address:    funded.String(),
+			FromHeight: 2,
+			ToHeight:   7,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidHeightRange)
+	})
+
+	t.Run("should reject a range predating the retention of the history", func(t *testing.T) {
+		_, err := q.AddressMintIncome(
+			sdk.WrapSDKContext(ctx.WithBlockHeight(types.DistributionHistoryRetention+3)),
+			&types.QueryAddressMintIncomeRequest{Address: funded.String(), FromHeight: 3, ToHeight: 6},
+		)
+		require.ErrorIs(t, err, types.ErrInsufficientHistory)
+	})
+
+	t.Run("should reject a range with allocations not recording the shares of the addresses", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(7)
+		distributed := types.NewCategoryTotals()
+		distributed.FundedAddresses = sdkmath.NewInt(40)
+		tk.MintKeeper.SetBlockDistribution(ctx, types.BlockDistribution{
+			Height:      7,
+			Minted:      sdkmath.NewInt(100),
+			Distributed: distributed,
+			Inflation:   sdk.ZeroDec(),
+		})
+		_, err := q.AddressMintIncome(sdk.WrapSDKContext(ctx), &types.QueryAddressMintIncomeRequest{
+			Address:    funded.String(),
+			FromHeight: 2,
+			ToHeight:   7,
+		})
+		require.ErrorIs(t, err, types.ErrInsufficientHistory)
+	})
+}
@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// maxInt is the largest sdkmath.Int, 2^256 - 1
+var maxInt = sdkmath.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+
+// fuzzFundedAddress is the funded address of the seed corpora, a fixed address keeps the corpora
+// deterministic
+const fuzzFundedAddress = "cosmos1ezptsm3npn54qx9vvpah4nymre59ykr9967vj9"
+
+// fuzzOtherFundedAddress is the other funded address of the state of the funded addresses targets
+const fuzzOtherFundedAddress = "cosmos1ve6h57jlda6xsetjtan82mnyv4j97cty5y5peh"
+
+// requireMintConsistency checks the invariants of the module hold and the cumulative counters of
+// the minter did not decrease
+func requireMintConsistency(t *testing.T, ctx sdk.Context, k keeper.Keeper, before types.Minter) {
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	after := k.GetMinter(ctx)
+	require.True(t, after.CumulativeMinted.GTE(before.CumulativeMinted))
+	require.True(t, after.CumulativeDistributed.Staking.GTE(before.CumulativeDistributed.Staking))
+	require.True(t, after.CumulativeDistributed.FundedAddresses.GTE(before.CumulativeDistributed.FundedAddresses))
+	require.True(t, after.CumulativeDistributed.CommunityPool.GTE(before.CumulativeDistributed.CommunityPool))
+	require.True(t, after.CumulativeDistributed.Dust.GTE(before.CumulativeDistributed.Dust))
+}
+
+// mustMarshal returns the encoded message for the seed corpora
+func mustMarshal(f *testing.F, msg interface{ Marshal() ([]byte, error) }) []byte {
+	bz, err := msg.Marshal()
+	require.NoError(f, err)
+	return bz
+}
+
+// The fuzz targets build the keepers once and run each input on a branch of the context that is
+// discarded, so an input starts from the state of the setup and the targets run fast enough to be
+// fuzzed continuously.
+
+// fuzzParams returns the seed params with the funded addresses and the minimum provision
+func fuzzParams(fundedAddresses []types.WeightedAddress, minProvision sdkmath.Int) types.Params {
+	params := types.DefaultParams()
+	params.FundedAddresses = fundedAddresses
+	params.MinDistributableProvision = minProvision
+	return params
+}
+
+func FuzzMsgUpdateParams(f *testing.F) {
+	authority := sample.Address(sample.Rand())
+	seeds := []types.Params{
+		types.DefaultParams(),
+		// weights summing to just over 1
+		fuzzParams([]types.WeightedAddress{
+			{Address: fuzzFundedAddress, Weight: sdk.MustNewDecFromStr("0.500000000000000001")},
+			{Address: authority, Weight: sdk.NewDecWithPrec(5, 1)},
+		}, sdkmath.ZeroInt()),
+		fuzzParams([]types.WeightedAddress{
+			{Address: fuzzFundedAddress, Weight: sdk.OneDec(), PayoutMode: types.PAYOUT_MODE_PULL},
+		}, maxInt),
+		fuzzParams(nil, sdkmath.OneInt()),
+	}
+	for _, params := range seeds {
+		f.Add(mustMarshal(f, types.NewMsgUpdateParams(authority, params)))
+	}
+	emptyDenom := types.DefaultParams()
+	emptyDenom.MintDenom = ""
+	f.Add(mustMarshal(f, types.NewMsgUpdateParams(authority, emptyDenom)))
+	emptyDenom = types.DefaultParams()
+	emptyDenom.MinAnnualCommunityFunding = sdk.Coin{Amount: sdkmath.OneInt()}
+	f.Add(mustMarshal(f, types.NewMsgUpdateParams(authority, emptyDenom)))
+	f.Add([]byte{})
+	f.Add([]byte{0x0a, 0xff, 0xff})
+
+	setupCtx, tk, ts := testSetups[0].setup(f)
+	fundSupply(f, setupCtx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var msg types.MsgUpdateParams
+		if err := msg.Unmarshal(bz); err != nil {
+			return
+		}
+
+		msg.Authority = tk.MintKeeper.GetAuthority()
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+		sdkCtx, _ := setupCtx.CacheContext()
+		before := tk.MintKeeper.GetMinter(sdkCtx)
+
+		_, err := ts.MintSrv.UpdateParams(sdk.WrapSDKContext(sdkCtx), &msg)
+		if err != nil && !errors.Is(err, types.ErrLargeChange) {
+			require.ErrorIs(t, err, types.ErrNoParamChange)
+		}
+		require.NoError(t, tk.MintKeeper.GetParams(sdkCtx).Validate())
+
+		// the accepted params must not break the minting of the next blocks
+		for height := int64(1); height <= 3; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(sdkCtx.WithBlockHeight(sdkCtx.BlockHeight()+height)))
+		}
+		requireMintConsistency(t, sdkCtx, tk.MintKeeper, before)
+	})
+}
+
+func FuzzMsgUpdateParamsFundedAddress(f *testing.F) {
+	f.Add(fuzzFundedAddress, "0.5", "0.5", int32(types.PAYOUT_MODE_PUSH))
+	f.Add(fuzzFundedAddress, "1", "0", int32(types.PAYOUT_MODE_PULL))
+	// weights summing to just over 1
+	f.Add(fuzzFundedAddress, "0.500000000000000001", "0.5", int32(types.PAYOUT_MODE_PUSH))
+	f.Add(fuzzFundedAddress, "0.000000000000000001", "0.999999999999999999", int32(types.PAYOUT_MODE_PULL))
+	f.Add(fuzzFundedAddress, maxInt.String(), "0", int32(types.PAYOUT_MODE_PUSH))
+	f.Add("", "1", "0", int32(types.PAYOUT_MODE_PUSH))
+	f.Add(fuzzFundedAddress, "1", "0", int32(100))
+
+	setupCtx, tk, ts := testSetups[0].setup(f)
+
+	f.Fuzz(func(t *testing.T, address, weight, otherWeight string, payoutMode int32) {
+		w, err := sdk.NewDecFromStr(weight)
+		if err != nil {
+			return
+		}
+		other, err := sdk.NewDecFromStr(otherWeight)
+		if err != nil {
+			return
+		}
+
+		params := types.DefaultParams()
+		params.FundedAddresses = []types.WeightedAddress{
+			{Address: address, Weight: w, PayoutMode: types.PayoutMode(payoutMode)},
+		}
+		if other.IsPositive() {
+			params.FundedAddresses = append(params.FundedAddresses, types.WeightedAddress{
+				Address: sample.Address(r),
+				Weight:  other,
+			})
+		}
+		msg := types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), params)
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+		sdkCtx, _ := setupCtx.CacheContext()
+		before := tk.MintKeeper.GetMinter(sdkCtx)
+
+		_, err = ts.MintSrv.UpdateParams(sdk.WrapSDKContext(sdkCtx), msg)
+		require.NoError(t, err)
+
+		// the funded address receives its share or accumulates it until claimed
+		mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+		require.NoError(t, tk.BankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+		require.NoError(t, tk.MintKeeper.DistributeMintedCoin(sdkCtx, mintedCoin))
+		requireMintConsistency(t, sdkCtx, tk.MintKeeper, before)
+
+		addr := sdk.MustAccAddressFromBech32(address)
+		received := tk.BankKeeper.GetAllBalances(sdkCtx, addr)
+		if payout, found := tk.MintKeeper.GetMinter(sdkCtx).GetPendingPayout(address); found {
+			require.True(t, received.IsZero())
+			received = payout.Amount
+		}
+		expected := tk.MintKeeper.GetProportion(
+			sdkCtx,
+			tk.MintKeeper.GetProportion(sdkCtx, mintedCoin, params.DistributionProportions.FundedAddresses),
+			w,
+		)
+		require.True(t, expected.Amount.Equal(received.AmountOf(sdk.DefaultBondDenom)))
+	})
+}
+
+func FuzzDistributeMintedCoin(f *testing.F) {
+	pull := fuzzParams([]types.WeightedAddress{
+		{Address: fuzzFundedAddress, Weight: sdk.MustNewDecFromStr("0.333333333333333333"), PayoutMode: types.PAYOUT_MODE_PULL},
+		{Address: sample.Address(sample.Rand()), Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+	}, sdkmath.ZeroInt())
+	paused := pull
+	paused.PauseStakingShare = true
+	paused.PauseCommunityShare = true
+	paused.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+	roundRobin := pull
+	roundRobin.DustAssignment = types.DUST_ASSIGNMENT_ROUND_ROBIN
+
+	for _, params := range []types.Params{types.DefaultParams(), pull, paused, roundRobin} {
+		f.Add(mustMarshal(f, &params), sdk.DefaultBondDenom, "100", int64(1))
+		f.Add(mustMarshal(f, &params), "foo", "1", int64(2))
+	}
+	f.Add(mustMarshal(f, &pull), "foo", maxInt.String(), int64(3))
+	f.Add(mustMarshal(f, &pull), "", "100", int64(4))
+	f.Add([]byte{}, sdk.DefaultBondDenom, "0", int64(0))
+
+	setupCtx, tk, _ := testSetups[0].setup(f)
+
+	f.Fuzz(func(t *testing.T, bz []byte, denom, amount string, height int64) {
+		var params types.Params
+		if err := params.Unmarshal(bz); err != nil || params.Validate() != nil {
+			return
+		}
+		amt, ok := sdkmath.NewIntFromString(amount)
+		if !ok || !amt.IsPositive() || height < 0 {
+			return
+		}
+		mintedCoin := sdk.Coin{Denom: denom, Amount: amt}
+		if err := mintedCoin.Validate(); err != nil {
+			return
+		}
+
+		branchCtx, _ := setupCtx.CacheContext()
+		ctx := branchCtx.WithBlockHeight(height)
+		tk.MintKeeper.SetParams(ctx, params)
+		if err := tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)); err != nil {
+			return
+		}
+		before := tk.MintKeeper.GetMinter(ctx)
+
+		require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+		requireMintConsistency(t, ctx, tk.MintKeeper, before)
+		require.True(t, before.CumulativeMinted.Add(amt).Equal(tk.MintKeeper.GetMinter(ctx).CumulativeMinted))
+	})
+}
+
+// fundedAddressesFuzzSetup returns the context and the keepers of the funded addresses targets, the
+// funded addresses share is split between two funded addresses
+func fundedAddressesFuzzSetup(f *testing.F) (sdk.Context, testkeeper.TestKeepers, testkeeper.TestMsgServers) {
+	ctx, tk, ts := testSetups[0].setup(f)
+	tk.MintKeeper.SetParams(ctx, fuzzParams([]types.WeightedAddress{
+		{Address: fuzzFundedAddress, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Address: fuzzOtherFundedAddress, Weight: sdk.NewDecWithPrec(4, 1), PayoutMode: types.PAYOUT_MODE_PULL},
+	}, sdkmath.ZeroInt()))
+	fundSupply(f, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	return ctx, tk, ts
+}
+
+// requireFundedAddressesUpdate checks a rejected update of the funded addresses left the params
+// unchanged, and an accepted update set valid params minting the next blocks
+func requireFundedAddressesUpdate(
+	t *testing.T,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	before types.Params,
+	fundedAddresses []types.WeightedAddress,
+	err error,
+) {
+	params := k.GetParams(ctx)
+	if err != nil {
+		require.True(t, errors.Is(err, types.ErrInvalidFundedAddress) ||
+			errors.Is(err, types.ErrInvalidWeightSum) ||
+			errors.Is(err, types.ErrFundedAddressNotFound) ||
+			errors.Is(err, types.ErrChainIDMismatch), "unexpected error: %v", err)
+		require.Equal(t, before, params)
+		return
+	}
+	require.NoError(t, params.Validate())
+	require.Equal(t, fundedAddresses, params.FundedAddresses)
+
+	minter := k.GetMinter(ctx)
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, k.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+height)))
+	}
+	requireMintConsistency(t, ctx, k, minter)
+}
+
+func FuzzMsgAddFundedAddress(f *testing.F) {
+	authority := sample.Address(sample.Rand())
+	for _, fundedAddress := range []types.WeightedAddress{
+		{Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", Weight: sdk.SmallestDec(), PayoutMode: types.PAYOUT_MODE_PULL},
+		// the added weight leaves no weight to the other funded addresses
+		{Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", Weight: sdk.OneDec()},
+		// the other weights are scaled down to zero
+		{Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", Weight: sdk.MustNewDecFromStr("0.999999999999999999")},
+		{Address: fuzzFundedAddress, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: "", Weight: sdk.NewDecWithPrec(5, 1)},
+	} {
+		f.Add(mustMarshal(f, types.NewMsgAddFundedAddress(authority, fundedAddress)))
+	}
+	otherChain := types.NewMsgAddFundedAddress(authority, types.WeightedAddress{
+		Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+		Weight:  sdk.NewDecWithPrec(5, 1),
+	})
+	otherChain.ExpectedChainId = "other-chain"
+	f.Add(mustMarshal(f, otherChain))
+	f.Add([]byte{})
+
+	setupCtx, tk, ts := fundedAddressesFuzzSetup(f)
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var msg types.MsgAddFundedAddress
+		if err := msg.Unmarshal(bz); err != nil {
+			return
+		}
+
+		msg.Authority = tk.MintKeeper.GetAuthority()
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+		sdkCtx, _ := setupCtx.CacheContext()
+		before := tk.MintKeeper.GetParams(sdkCtx)
+
+		res, err := ts.MintSrv.AddFundedAddress(sdk.WrapSDKContext(sdkCtx), &msg)
+		var fundedAddresses []types.WeightedAddress
+		if err == nil {
+			fundedAddresses = res.FundedAddresses
+			require.Len(t, fundedAddresses, len(before.FundedAddresses)+1)
+		}
+		requireFundedAddressesUpdate(t, sdkCtx, tk.MintKeeper, before, fundedAddresses, err)
+	})
+}
+
+func FuzzMsgSetFundedAddressWeight(f *testing.F) {
+	authority := sample.Address(sample.Rand())
+	for _, seed := range []struct {
+		address string
+		weight  sdk.Dec
+	}{
+		{address: fuzzFundedAddress, weight: sdk.NewDecWithPrec(25, 2)},
+		{address: fuzzOtherFundedAddress, weight: sdk.MustNewDecFromStr("0.999999999999999999")},
+		{address: fuzzFundedAddress, weight: sdk.SmallestDec()},
+		// the weight leaves no weight to the other funded address
+		{address: fuzzFundedAddress, weight: sdk.OneDec()},
+		{address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", weight: sdk.NewDecWithPrec(5, 1)},
+	} {
+		f.Add(mustMarshal(f, types.NewMsgSetFundedAddressWeight(authority, seed.address, seed.weight)))
+	}
+	otherChain := types.NewMsgSetFundedAddressWeight(authority, fuzzFundedAddress, sdk.NewDecWithPrec(5, 1))
+	otherChain.ExpectedChainId = "other-chain"
+	f.Add(mustMarshal(f, otherChain))
+	f.Add([]byte{})
+
+	setupCtx, tk, ts := fundedAddressesFuzzSetup(f)
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var msg types.MsgSetFundedAddressWeight
+		if err := msg.Unmarshal(bz); err != nil {
+			return
+		}
+
+		msg.Authority = tk.MintKeeper.GetAuthority()
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+		sdkCtx, _ := setupCtx.CacheContext()
+		before := tk.MintKeeper.GetParams(sdkCtx)
+
+		res, err := ts.MintSrv.SetFundedAddressWeight(sdk.WrapSDKContext(sdkCtx), &msg)
+		var fundedAddresses []types.WeightedAddress
+		if err == nil {
+			fundedAddresses = res.FundedAddresses
+			require.Len(t, fundedAddresses, len(before.FundedAddresses))
+			addr := sdk.MustAccAddressFromBech32(msg.Address)
+			found := false
+			for _, fundedAddress := range fundedAddresses {
+				if sdk.MustAccAddressFromBech32(fundedAddress.Address).Equals(addr) {
+					require.True(t, msg.Weight.Equal(fundedAddress.Weight))
+					found = true
+				}
+			}
+			require.True(t, found)
+		}
+		requireFundedAddressesUpdate(t, sdkCtx, tk.MintKeeper, before, fundedAddresses, err)
+	})
+}
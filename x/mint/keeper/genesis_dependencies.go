@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// CheckGenesisDependencies checks that the genesis of the modules the mint module depends on is
+// initialized: the auth params, the staking params and the bank supply of the bond denom must be
+// set. It returns ErrModuleOrder naming the first module not initialized, usually ordered after
+// the mint module in the genesis initialization order of the app.
+func (k Keeper) CheckGenesisDependencies(ctx sdk.Context) error {
+	if err := k.accountKeeper.GetParams(ctx).Validate(); err != nil {
+		return genesisDependencyError(authtypes.ModuleName, "invalid params (%s)", err)
+	}
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if bondDenom == "" {
+		return genesisDependencyError(stakingtypes.ModuleName, "no bond denom")
+	}
+	if k.bankKeeper.GetSupply(ctx, bondDenom).IsZero() {
+		return genesisDependencyError(banktypes.ModuleName, "no supply of the bond denom %s", bondDenom)
+	}
+	return nil
+}
+
+// genesisDependencyError returns the error of a module whose genesis is not initialized before
+// the genesis of the mint module
+func genesisDependencyError(module, format string, args ...interface{}) error {
+	return errors.Wrapf(
+		types.ErrModuleOrder,
+		"the %s module is not initialized: %s, order the %s InitGenesis before %s",
+		module, fmt.Sprintf(format, args...), module, types.ModuleName,
+	)
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestCheckGenesisDependencies(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+
+	t.Run("should fail without auth params", func(t *testing.T) {
+		err := tk.MintKeeper.CheckGenesisDependencies(ctx)
+		require.ErrorIs(t, err, types.ErrModuleOrder)
+		require.ErrorContains(t, err, "order the auth InitGenesis before mint")
+	})
+
+	tk.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	t.Run("should fail without supply of the bond denom", func(t *testing.T) {
+		err := tk.MintKeeper.CheckGenesisDependencies(ctx)
+		require.ErrorIs(t, err, types.ErrModuleOrder)
+		require.ErrorContains(t, err, "no supply of the bond denom stake, order the bank InitGenesis before mint")
+	})
+
+	t.Run("should pass with the dependencies initialized", func(t *testing.T) {
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))))
+		require.NoError(t, tk.MintKeeper.CheckGenesisDependencies(ctx))
+	})
+}
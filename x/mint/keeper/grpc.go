@@ -1,35 +0,0 @@
-package keeper
-
-import (
-	"context"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	"github.com/ignite/modules/x/mint/types"
-)
-
-var _ types.QueryServer = Keeper{}
-
-// Params returns params of the mint module.
-func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
-	params := k.GetParams(ctx)
-
-	return &types.QueryParamsResponse{Params: params}, nil
-}
-
-// Inflation returns minter.Inflation of the mint module.
-func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
-	minter := k.GetMinter(ctx)
-
-	return &types.QueryInflationResponse{Inflation: minter.Inflation}, nil
-}
-
-// AnnualProvisions returns minter.AnnualProvisions of the mint module.
-func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
-	minter := k.GetMinter(ctx)
-
-	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
-}
@@ -2,14 +2,26 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"strings"
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	testapp "github.com/ignite/modules/app"
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/querier"
 	"github.com/ignite/modules/x/mint/types"
 )
 
@@ -26,7 +38,7 @@ func (suite *MintTestSuite) SetupTest() {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
-	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	types.RegisterQueryServer(queryHelper, querier.NewQuerier(app.MintKeeper))
 	queryClient := types.NewQueryClient(queryHelper)
 
 	suite.app = app
@@ -41,6 +53,13 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(params.Params, app.MintKeeper.GetParams(ctx))
+	suite.Require().Nil(params.Derived)
+
+	params, err = queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{Verbose: true})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(params.Derived)
+	suite.Require().Equal(ctx.BlockHeight(), params.Derived.Height)
+	suite.Require().Equal(types.NewPauseState(params.Params), params.Derived.PauseState)
 
 	inflation, err := queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
 	suite.Require().NoError(err)
@@ -49,8 +68,848 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	annualProvisions, err := queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
+
+	minter, err := queryClient.Minter(gocontext.Background(), &types.QueryMinterRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(minter.Minter, app.MintKeeper.GetMinter(ctx))
 }
 
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
+
+func TestAdminCapabilities(t *testing.T) {
+	sdkCtx, tk, _ := testkeeper.NewTestSetup(t)
+	authority := tk.MintKeeper.GetAuthority()
+	fundedAddress := sample.Address(sample.Rand())
+
+	// the capabilities of the default state, the reasons of the unavailable messages by type URL
+	defaultReasons := map[string]string{
+		"/modules.mint.MsgReleaseReserve":         "the strategic reserve is empty",
+		"/modules.mint.MsgResumeMinting":          "minting is not auto-paused",
+		"/modules.mint.MsgRemoveFundedAddress":    "no funded address",
+		"/modules.mint.MsgSetFundedAddressWeight": "no funded address",
+	}
+
+	tests := []struct {
+		name     string
+		height   int64
+		params   func(*types.Params)
+		minter   func(*types.Minter)
+		reasons  map[string]string
+		expected types.PauseState
+	}{
+		{
+			name: "should return the capabilities when nothing is paused",
+		},
+		{
+			name: "should return the capabilities when minting is paused",
+			params: func(p *types.Params) {
+				p.PauseMinting = true
+			},
+			expected: types.PauseState{Minting: true},
+		},
+		{
+			name: "should return the capabilities when distribution categories are paused",
+			params: func(p *types.Params) {
+				p.PauseStakingShare = true
+				p.PauseFundedShare = true
+				p.PauseCommunityShare = true
+				p.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+			},
+			expected: types.PauseState{
+				StakingShare:    true,
+				FundedShare:     true,
+				CommunityShare:  true,
+				PausedShareMode: types.PAUSED_SHARE_MODE_BUFFER,
+			},
+		},
+		{
+			name: "should return MsgResumeMinting available when minting is auto-paused",
+			params: func(p *types.Params) {
+				p.PauseMinting = true
+			},
+			minter: func(m *types.Minter) {
+				m.AutoPaused = true
+			},
+			reasons:  map[string]string{"/modules.mint.MsgResumeMinting": ""},
+			expected: types.PauseState{Minting: true},
+		},
+		{
+			name: "should return MsgSetGoalBonded unavailable while a phase is active",
+			params: func(p *types.Params) {
+				*p = phasedParams()
+			},
+			height:  12,
+			reasons: map[string]string{"/modules.mint.MsgSetGoalBonded": "the active phase growth sets the goal bonded"},
+		},
+		{
+			name: "should return MsgSetGoalBonded available before the first phase",
+			params: func(p *types.Params) {
+				*p = phasedParams()
+			},
+			height: 4,
+		},
+		{
+			name: "should return MsgReleaseReserve available with a strategic reserve",
+			minter: func(m *types.Minter) {
+				m.StrategicReserve = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			},
+			reasons: map[string]string{"/modules.mint.MsgReleaseReserve": ""},
+		},
+		{
+			name: "should return the funded address messages available with funded addresses",
+			params: func(p *types.Params) {
+				p.FundedAddresses = []types.WeightedAddress{{Address: fundedAddress, Weight: sdk.OneDec()}}
+			},
+			reasons: map[string]string{
+				"/modules.mint.MsgRemoveFundedAddress":    "",
+				"/modules.mint.MsgSetFundedAddressWeight": "",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdkCtx.WithBlockHeight(tc.height)
+			params := types.DefaultParams()
+			if tc.params != nil {
+				tc.params(&params)
+			}
+			tk.MintKeeper.SetParams(ctx, params)
+			minter := types.DefaultInitialMinter()
+			if tc.minter != nil {
+				tc.minter(&minter)
+			}
+			tk.MintKeeper.SetMinter(ctx, minter)
+
+			res, err := querier.NewQuerier(tk.MintKeeper).AdminCapabilities(sdk.WrapSDKContext(ctx), &types.QueryAdminCapabilitiesRequest{})
+			require.NoError(t, err)
+			require.Equal(t, []string{authority}, res.Authorities)
+
+			var expected []types.AdminCapability
+			for _, typeURL := range []string{
+				"/modules.mint.MsgSetPaused",
+				"/modules.mint.MsgUpdateParams",
+				"/modules.mint.MsgSetGoalBonded",
+				"/modules.mint.MsgReleaseReserve",
+				"/modules.mint.MsgResumeMinting",
+				"/modules.mint.MsgAddFundedAddress",
+				"/modules.mint.MsgRemoveFundedAddress",
+				"/modules.mint.MsgSetFundedAddressWeight",
+				"/modules.mint.MsgSetMinCommunityPoolShare",
+			} {
+				reason := defaultReasons[typeURL]
+				if override, ok := tc.reasons[typeURL]; ok {
+					reason = override
+				}
+				expected = append(expected, types.AdminCapability{
+					TypeUrl:           typeURL,
+					Authority:         authority,
+					Available:         reason == "",
+					UnavailableReason: reason,
+				})
+			}
+			require.Equal(t, expected, res.Capabilities)
+			require.Equal(t, tc.expected, res.PauseState)
+
+			descriptors, err := tk.MintKeeper.GetParams(ctx).Describe()
+			require.NoError(t, err)
+			require.Equal(t, descriptors, res.ParamDescriptors)
+		})
+	}
+}
+
+func TestParamsImpact(t *testing.T) {
+	sdkCtx, tk, ts := testkeeper.NewTestSetup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)))
+
+	t.Run("should return no delta for the current params", func(t *testing.T) {
+		res, err := querier.NewQuerier(tk.MintKeeper).ParamsImpact(ctx, &types.QueryParamsImpactRequest{
+			ProposedParams: tk.MintKeeper.GetParams(sdkCtx),
+		})
+		require.NoError(t, err)
+		require.True(t, res.Current.Total.IsPositive())
+		require.Equal(t, res.Current, res.Proposed)
+		require.True(t, res.Delta.IsZero())
+		require.True(t, res.CategoryDeltas.Total().IsZero())
+	})
+
+	t.Run("should return the delta of proposed params", func(t *testing.T) {
+		proposed := tk.MintKeeper.GetParams(sdkCtx)
+		proposed.InflationMin = sdk.NewDecWithPrec(20, 2)
+		proposed.DistributionProportions = types.DistributionProportions{
+			Staking:         sdk.NewDecWithPrec(5, 1),
+			FundedAddresses: sdk.ZeroDec(),
+			CommunityPool:   sdk.NewDecWithPrec(5, 1),
+		}
+
+		res, err := querier.NewQuerier(tk.MintKeeper).ParamsImpact(ctx, &types.QueryParamsImpactRequest{ProposedParams: proposed})
+		require.NoError(t, err)
+		require.True(t, res.Delta.IsPositive())
+		require.Equal(t, res.Proposed.Total.Sub(res.Current.Total), res.Delta)
+		require.Equal(t, res.Delta, res.CategoryDeltas.Staking.Add(res.CategoryDeltas.FundedAddresses).
+			Add(res.CategoryDeltas.CommunityPool).Add(res.CategoryDeltas.Dust))
+		require.True(t, res.CategoryDeltas.Staking.IsPositive())
+		require.True(t, res.CategoryDeltas.CommunityPool.IsNegative())
+	})
+
+	t.Run("should reject invalid proposed params with the msg server error", func(t *testing.T) {
+		proposed := tk.MintKeeper.GetParams(sdkCtx)
+		proposed.InflationMax = sdk.NewDec(2)
+
+		_, err := querier.NewQuerier(tk.MintKeeper).ParamsImpact(ctx, &types.QueryParamsImpactRequest{ProposedParams: proposed})
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, msgErr := ts.MintSrv.UpdateParams(ctx, types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), proposed))
+		require.Equal(t, msgErr.Error(), err.Error())
+	})
+}
+
+func TestValidateParams(t *testing.T) {
+	sdkCtx, tk, _ := testkeeper.NewTestSetup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	t.Run("should return the effective values of valid params", func(t *testing.T) {
+		proposed := tk.MintKeeper.GetParams(sdkCtx)
+		proposed.PauseStakingShare = true
+
+		res, err := querier.NewQuerier(tk.MintKeeper).ValidateParams(ctx, &types.QueryValidateParamsRequest{Params: proposed})
+		require.NoError(t, err)
+		require.True(t, res.Valid)
+		require.Empty(t, res.Errors)
+		require.NotNil(t, res.Effective)
+		require.Equal(t, proposed, res.Effective.Params)
+		require.True(t, res.Effective.Proportions.Staking.IsZero())
+		require.Equal(t, sdk.OneDec(), res.Effective.Proportions.FundedAddresses.Add(res.Effective.Proportions.CommunityPool))
+		require.Equal(t, tk.MintKeeper.GetMinter(sdkCtx).NextInflationRate(proposed, tk.MintKeeper.BondedRatio(sdkCtx)),
+			res.Effective.NextInflation)
+	})
+
+	t.Run("should return the errors of invalid params", func(t *testing.T) {
+		proposed := tk.MintKeeper.GetParams(sdkCtx)
+		proposed.InflationMax = sdk.NewDec(2)
+
+		res, err := querier.NewQuerier(tk.MintKeeper).ValidateParams(ctx, &types.QueryValidateParamsRequest{Params: proposed})
+		require.NoError(t, err)
+		require.False(t, res.Valid)
+		require.Nil(t, res.Effective)
+		require.Len(t, res.Errors, 1)
+		require.Equal(t, string(types.KeyInflationMax), res.Errors[0].Field)
+	})
+
+	t.Run("should reject a nil request", func(t *testing.T) {
+		_, err := querier.NewQuerier(tk.MintKeeper).ValidateParams(ctx, nil)
+		require.Error(t, err)
+	})
+}
+
+// validatorStakingKeeper overrides the validators and the bonded tokens of the staking keeper
+type validatorStakingKeeper struct {
+	types.StakingKeeper
+	validators   map[string]stakingtypes.Validator
+	bondedTokens sdkmath.Int
+}
+
+func (k validatorStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	validator, found := k.validators[addr.String()]
+	return validator, found
+}
+
+func (k validatorStakingKeeper) TotalBondedTokens(sdk.Context) sdkmath.Int {
+	return k.bondedTokens
+}
+
+func TestDelegatorAPR(t *testing.T) {
+	valAddr := sdk.ValAddress(sample.AccAddress(r))
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Commission:      stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.ZeroDec()),
+	}
+	setup := func(t *testing.T, bondedTokens sdkmath.Int) (sdk.Context, testkeeper.TestKeepers) {
+		ctx, tk, _ := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+			return validatorStakingKeeper{
+				StakingKeeper: sk,
+				validators:    map[string]stakingtypes.Validator{valAddr.String(): validator},
+				bondedTokens:  bondedTokens,
+			}
+		})
+		tk.MintKeeper.SetMinter(ctx, types.NewMinter(sdk.NewDecWithPrec(10, 2), sdk.NewDec(1000)))
+		return ctx, tk
+	}
+
+	t.Run("should return the delegator APR with its components", func(t *testing.T) {
+		ctx, tk := setup(t, sdkmath.NewInt(10_000))
+		communityTax := tk.DistrKeeper.GetCommunityTax(ctx)
+
+		res, err := querier.NewQuerier(tk.MintKeeper).DelegatorAPR(sdk.WrapSDKContext(ctx), &types.QueryDelegatorAPRRequest{
+			ValidatorAddress: valAddr.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryDelegatorAPRResponse{
+			StakingApr:     sdk.NewDecWithPrec(3, 2),
+			CommunityTax:   communityTax,
+			CommissionRate: sdk.NewDecWithPrec(1, 1),
+			DelegatorApr:   types.NetAPR(sdk.NewDecWithPrec(3, 2), communityTax, sdk.NewDecWithPrec(1, 1)),
+			BondedTokens:   sdkmath.NewInt(10_000),
+		}, res)
+	})
+
+	t.Run("should prevent querying the APR of an invalid validator address", func(t *testing.T) {
+		ctx, tk := setup(t, sdkmath.NewInt(10_000))
+		_, err := querier.NewQuerier(tk.MintKeeper).DelegatorAPR(sdk.WrapSDKContext(ctx), &types.QueryDelegatorAPRRequest{
+			ValidatorAddress: "invalid",
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should prevent querying the APR of a missing validator", func(t *testing.T) {
+		ctx, tk := setup(t, sdkmath.NewInt(10_000))
+		_, err := querier.NewQuerier(tk.MintKeeper).DelegatorAPR(sdk.WrapSDKContext(ctx), &types.QueryDelegatorAPRRequest{
+			ValidatorAddress: sdk.ValAddress(sample.AccAddress(r)).String(),
+		})
+		require.ErrorIs(t, err, types.ErrValidatorNotFound)
+	})
+
+	t.Run("should prevent querying the APR without bonded tokens", func(t *testing.T) {
+		ctx, tk := setup(t, sdkmath.ZeroInt())
+		_, err := querier.NewQuerier(tk.MintKeeper).DelegatorAPR(sdk.WrapSDKContext(ctx), &types.QueryDelegatorAPRRequest{
+			ValidatorAddress: valAddr.String(),
+		})
+		require.ErrorIs(t, err, types.ErrNoBondedTokens)
+	})
+}
+
+func TestFeeAdvisory(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	q := querier.NewQuerier(tk.MintKeeper)
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	tk.MintKeeper.SetMinter(sdkCtx, types.NewMinter(sdk.NewDecWithPrec(10, 2), sdk.NewDec(100_000)))
+
+	t.Run("should return the advisory min gas price", func(t *testing.T) {
+		res, err := q.FeeAdvisory(ctx, &types.QueryFeeAdvisoryRequest{
+			TargetFeeCoverageRatio: sdk.NewDecWithPrec(5, 1),
+			AverageBlockGas:        1_000_000,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryFeeAdvisoryResponse{
+			AdvisoryMinGasPrice:   sdk.NewDecCoinFromDec(params.MintDenom, sdk.NewDecWithPrec(15, 5)),
+			StakingBlockProvision: sdk.NewDec(300),
+			TargetBlockFees:       sdk.NewDec(150),
+		}, res)
+	})
+
+	t.Run("should prevent nonsensical requests", func(t *testing.T) {
+		for _, req := range []*types.QueryFeeAdvisoryRequest{
+			nil,
+			{AverageBlockGas: 1000},
+			{TargetFeeCoverageRatio: sdk.ZeroDec(), AverageBlockGas: 1000},
+			{TargetFeeCoverageRatio: sdk.NewDec(2), AverageBlockGas: 1000},
+			{TargetFeeCoverageRatio: sdk.OneDec()},
+		} {
+			_, err := q.FeeAdvisory(ctx, req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
+
+// requireNextDistribution requires the estimated distribution to match the distribution of the
+// next block and returns the context of the next block
+func requireNextDistribution(t *testing.T, ctx sdk.Context, tk testkeeper.TestKeepers) sdk.Context {
+	estimated, err := querier.NewQuerier(tk.MintKeeper).EstimatedDistribution(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryEstimatedDistributionRequest{},
+	)
+	require.NoError(t, err)
+	params := tk.MintKeeper.GetParams(ctx)
+	denom := params.MintDenom
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+	zero := sdk.NewInt64Coin(denom, 0)
+	expected := &types.QueryEstimatedDistributionResponse{
+		BlockProvision:      zero,
+		Staking:             zero,
+		CommunityPool:       zero,
+		TruncationRemainder: zero,
+		FundedAddressesDust: zero,
+	}
+	var sources types.CommunityPoolSources
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		switch e := msg.(type) {
+		case *types.EventMintDistribution:
+			expected.BlockProvision = e.Minted
+			expected.Staking = e.Staking
+			expected.CommunityPool = e.CommunityPool
+			expected.FundedAddressesDust = e.Dust
+			expected.FundedAddresses = make([]types.FundedAddressAllocation, len(e.FundedAddresses))
+			for i, fundedAddress := range e.FundedAddresses {
+				expected.FundedAddresses[i] = types.FundedAddressAllocation{
+					Address: fundedAddress.Address,
+					Amount:  sdk.NewCoin(denom, fundedAddress.Amount.AmountOf(denom)),
+				}
+			}
+		case *types.EventCommunityPoolFunded:
+			sources = e.Sources
+		}
+	}
+	// the truncation remainder is the community pool share beyond the community pool proportion of
+	// the split coins, or the dust of the split sent to the community pool
+	if params.DustPolicy == types.DUST_POLICY_COMMUNITY_POOL {
+		split := sdk.NewCoin(denom, expected.BlockProvision.Amount.Sub(sources.AmountOf(types.CommunityPoolSourceFloorMinted).AmountOf(denom)))
+		expected.TruncationRemainder = expected.TruncationRemainder.
+			AddAmount(sources.AmountOf(types.CommunityPoolSourceShare).AmountOf(denom)).
+			Sub(tk.MintKeeper.GetProportion(ctx, split, params.DistributionProportions.CommunityPool))
+	}
+	expected.TruncationRemainder = expected.TruncationRemainder.AddAmount(sources.AmountOf(types.CommunityPoolSourceDust).AmountOf(denom))
+	require.Equal(t, expected, estimated, "height %d", ctx.BlockHeight())
+	return ctx
+}
+
+func TestEstimatedDistribution(t *testing.T) {
+	third := sdk.MustNewDecFromStr("0.333333333333333333")
+	proportions := types.DistributionProportions{
+		Staking:         third,
+		FundedAddresses: third,
+		CommunityPool:   sdk.OneDec().Sub(third.MulInt64(2)),
+	}
+	addrs := []string{sample.Address(r), sample.Address(r)}
+	// the block provision of the params is 1001 tokens for a supply of 10010 tokens
+	estimateParams := func() types.Params {
+		params := lowInflationParams()
+		params.BlocksPerYear = 1
+		params.DistributionProportions = proportions
+		params.FundedAddresses = []types.WeightedAddress{
+			{Address: addrs[0], Weight: sdk.NewDecWithPrec(5, 1)},
+			{Address: addrs[1], Weight: sdk.NewDecWithPrec(5, 1)},
+		}
+		return params
+	}
+
+	for _, tc := range []struct {
+		name   string
+		params func() types.Params
+		blocks int
+	}{
+		{
+			name:   "should estimate the amounts sent to each recipient",
+			params: estimateParams,
+			blocks: 3,
+		},
+		{
+			name: "should estimate the funded addresses share sent to the community pool without funded address",
+			params: func() types.Params {
+				params := estimateParams()
+				params.FundedAddresses = nil
+				params.DustPolicy = types.DUST_POLICY_FIRST_RECIPIENT
+				return params
+			},
+			blocks: 3,
+		},
+		{
+			name: "should estimate the release of the carry buffer",
+			params: func() types.Params {
+				params := estimateParams()
+				params.BlocksPerYear = 300
+				params.MinDistributableProvision = sdkmath.NewInt(5)
+				return params
+			},
+			blocks: 12,
+		},
+		{
+			name: "should estimate the provisions accrued over the minting interval",
+			params: func() types.Params {
+				params := estimateParams()
+				params.MintingInterval = 4
+				return params
+			},
+			blocks: 9,
+		},
+		{
+			name: "should estimate the drift correction",
+			params: func() types.Params {
+				params := estimateParams()
+				params.BlocksPerYear = 300
+				params.InflationRateChange = sdk.SmallestDec()
+				params.InflationMax = sdk.NewDecWithPrec(2, 1)
+				params.DriftCorrection = types.NewDriftCorrection(sdk.NewDecWithPrec(5, 1), 100)
+				return params
+			},
+			blocks: 12,
+		},
+		{
+			name: "should estimate the community funding top-up",
+			params: func() types.Params {
+				params := estimateParams()
+				params.CommunityFundingWindow = 1
+				params.MinAnnualCommunityFunding = sdk.NewInt64Coin(params.MintDenom, 1_000)
+				return params
+			},
+			blocks: 3,
+		},
+	} {
+		tc := tc
+		for _, ts := range testSetups {
+			ts := ts
+			t.Run(ts.name+"/"+tc.name, func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := tc.params()
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMin))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 10_010)))
+
+				for i := 0; i < tc.blocks; i++ {
+					ctx = requireNextDistribution(t, ctx, tk)
+				}
+			})
+		}
+	}
+
+	t.Run("should consume the gas of the estimate from the gas meter of the query", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := estimateParams()
+		tk.MintKeeper.SetParams(ctx, params)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 10_010)))
+
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(10_000_000))
+		_, err := tk.MintKeeper.EstimateDistribution(ctx)
+		require.NoError(t, err)
+		consumed := ctx.GasMeter().GasConsumed()
+		require.Positive(t, consumed)
+
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(consumed / 2))
+		defer func() {
+			require.IsType(t, sdk.ErrorOutOfGas{}, recover())
+		}()
+		_, _ = tk.MintKeeper.EstimateDistribution(ctx)
+		t.Fatal("the estimate should run out of gas")
+	})
+
+	t.Run("should prevent nil request", func(t *testing.T) {
+		for _, ts := range testSetups {
+			sdkCtx, tk, _ := ts.setup(t)
+			_, err := querier.NewQuerier(tk.MintKeeper).EstimatedDistribution(sdk.WrapSDKContext(sdkCtx), nil)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
+
+func TestUpcomingProvisions(t *testing.T) {
+	upcomingParams := func() types.Params {
+		params := phasedParams()
+		params.DriftCorrection = types.DriftCorrection{MaxFactor: sdk.NewDecWithPrec(1, 1), Horizon: 10}
+		params.MinDistributableProvision = sdkmath.NewInt(1_000)
+		return params
+	}
+
+	t.Run("should return the provisions minted by the begin blocker", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := upcomingParams()
+		fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000_000))))
+		supply := tk.BankKeeper.GetSupply(sdkCtx, params.MintDenom).Amount
+		// the max supply is reached in the blocks of the provisions
+		params.MaxSupply = supply.AddRaw(20_000_000)
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		hooks := testkeeper.NewMintHooksMock()
+		tk.MintKeeper.SetHooks(hooks)
+
+		before := dumpMintStore(sdkCtx)
+		res, err := querier.NewQuerier(tk.MintKeeper).UpcomingProvisions(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryUpcomingProvisionsRequest{Count: 30},
+		)
+		require.NoError(t, err)
+		require.Equal(t, before, dumpMintStore(sdkCtx), "the query must not write to the store")
+		require.Equal(t, sdkCtx.BlockHeight()+1, res.StartHeight)
+		require.Equal(t, params.MintDenom, res.Denom)
+		require.Len(t, res.Provisions, 30)
+
+		firstClampedHeight := int64(0)
+		for i, provision := range res.Provisions {
+			height := res.StartHeight + int64(i)
+			ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+			minted := sdkmath.ZeroInt()
+			if coin, ok := hooks.MintedCoins[height]; ok {
+				minted = coin.Amount
+			}
+			require.Equal(t, minted, provision, "height %d", height)
+			if firstClampedHeight != 0 {
+				continue
+			}
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type == "modules.mint.EventMintCapped" {
+					firstClampedHeight = height
+				}
+			}
+			minter := tk.MintKeeper.GetMinter(ctx)
+			blockParams := params.AtHeight(height)
+			if minter.Inflation.Equal(blockParams.InflationMin) || minter.Inflation.Equal(blockParams.InflationMax) {
+				firstClampedHeight = height
+			}
+		}
+		require.NotZero(t, res.FirstClampedHeight)
+		require.Equal(t, firstClampedHeight, res.FirstClampedHeight)
+		require.Equal(t, params.MaxSupply, tk.BankKeeper.GetSupply(sdkCtx, params.MintDenom).Amount)
+	})
+
+	t.Run("should prevent an invalid count", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		k := querier.NewQuerier(tk.MintKeeper)
+		for _, count := range []uint64{0, types.MaxUpcomingProvisions + 1} {
+			_, err := k.UpcomingProvisions(sdk.WrapSDKContext(sdkCtx), &types.QueryUpcomingProvisionsRequest{Count: count})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("should return the maximum number of provisions within the latency budget", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := upcomingParams()
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000_000))))
+
+		start := time.Now()
+		res, err := querier.NewQuerier(tk.MintKeeper).UpcomingProvisions(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryUpcomingProvisionsRequest{Count: types.MaxUpcomingProvisions},
+		)
+		require.NoError(t, err)
+		require.Len(t, res.Provisions, types.MaxUpcomingProvisions)
+		require.Less(t, time.Since(start), 2*time.Second)
+	})
+}
+
+func BenchmarkUpcomingProvisions(b *testing.B) {
+	sdkCtx, tk, _ := testkeeper.NewTestSetup(b)
+	params := phasedParams()
+	params.DriftCorrection = types.DriftCorrection{MaxFactor: sdk.NewDecWithPrec(1, 1), Horizon: 10}
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	k := querier.NewQuerier(tk.MintKeeper)
+	req := &types.QueryUpcomingProvisionsRequest{Count: types.MaxUpcomingProvisions}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := k.UpcomingProvisions(sdk.WrapSDKContext(sdkCtx), req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestAnnualFundedProvisions(t *testing.T) {
+	addr1, addr2 := sample.Address(r), sample.Address(r)
+	fundedParams := func() types.Params {
+		params := types.DefaultParams()
+		params.FundedAddresses = []types.WeightedAddress{
+			{Address: addr1, Weight: sdk.MustNewDecFromStr("0.333333333333333333")},
+			{Address: addr2, Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+		}
+		return params
+	}
+
+	t.Run("should return the annual provisions of all the funded addresses", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := fundedParams()
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		// the funded addresses share of the annual provisions is 400000
+		tk.MintKeeper.SetMinter(sdkCtx, types.NewMinter(sdk.NewDecWithPrec(1, 1), sdk.MustNewDecFromStr("1000000.9")))
+
+		res, err := querier.NewQuerier(tk.MintKeeper).AnnualFundedProvisions(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryAnnualFundedProvisionsRequest{},
+		)
+		require.NoError(t, err)
+		require.Equal(t, []types.FundedAddressAllocation{
+			{Address: addr1, Amount: sdk.NewInt64Coin(params.MintDenom, 133_333)},
+			{Address: addr2, Amount: sdk.NewInt64Coin(params.MintDenom, 266_666)},
+		}, res.AnnualProvisions)
+	})
+
+	t.Run("should return the annual provisions of a single funded address", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := fundedParams()
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		tk.MintKeeper.SetMinter(sdkCtx, types.NewMinter(sdk.NewDecWithPrec(1, 1), sdk.NewDec(1_000_000)))
+
+		res, err := querier.NewQuerier(tk.MintKeeper).AnnualFundedProvisions(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryAnnualFundedProvisionsRequest{Address: strings.ToUpper(addr2)},
+		)
+		require.NoError(t, err)
+		require.Equal(t, []types.FundedAddressAllocation{
+			{Address: addr2, Amount: sdk.NewInt64Coin(params.MintDenom, 266_666)},
+		}, res.AnnualProvisions)
+	})
+
+	t.Run("should return zero annual provisions", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := fundedParams()
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		tk.MintKeeper.SetMinter(sdkCtx, types.NewMinter(sdk.ZeroDec(), sdk.ZeroDec()))
+
+		res, err := querier.NewQuerier(tk.MintKeeper).AnnualFundedProvisions(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryAnnualFundedProvisionsRequest{Address: addr1},
+		)
+		require.NoError(t, err)
+		require.Len(t, res.AnnualProvisions, 1)
+		require.Equal(t, addr1, res.AnnualProvisions[0].Address)
+		require.Equal(t, params.MintDenom, res.AnnualProvisions[0].Amount.Denom)
+		require.True(t, res.AnnualProvisions[0].Amount.IsZero())
+	})
+
+	t.Run("should prevent querying an address that is not funded", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		tk.MintKeeper.SetParams(sdkCtx, fundedParams())
+		k := querier.NewQuerier(tk.MintKeeper)
+
+		_, err := k.AnnualFundedProvisions(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryAnnualFundedProvisionsRequest{Address: sample.Address(r)},
+		)
+		require.ErrorIs(t, err, types.ErrFundedAddressNotFound)
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = k.AnnualFundedProvisions(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryAnnualFundedProvisionsRequest{Address: "invalid"},
+		)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestParamsFieldsQueries(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	q := querier.NewQuerier(tk.MintKeeper)
+
+	t.Run("should return the fields of params without funded addresses", func(t *testing.T) {
+		params := types.DefaultParams()
+		tk.MintKeeper.SetParams(ctx, params)
+
+		denom, err := q.MintDenom(wctx, &types.QueryMintDenomRequest{})
+		require.NoError(t, err)
+		require.Equal(t, sdk.DefaultBondDenom, denom.MintDenom)
+
+		proportions, err := q.DistributionProportions(wctx, &types.QueryDistributionProportionsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, params.DistributionProportions, proportions.DistributionProportions)
+
+		funded, err := q.FundedAddresses(wctx, &types.QueryFundedAddressesRequest{Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		require.Empty(t, funded.FundedAddresses)
+		require.Empty(t, funded.Pagination.NextKey)
+		require.Zero(t, funded.Pagination.Total)
+	})
+
+	params := types.DefaultParams()
+	params.MintDenom = "foo"
+	params.MinAnnualCommunityFunding.Denom = "foo"
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(5, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(4, 1),
+		CommunityPool:    sdk.NewDecWithPrec(1, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	for i := 0; i < 5; i++ {
+		params.FundedAddresses = append(params.FundedAddresses, types.WeightedAddress{
+			Address: sample.Address(r),
+			Weight:  sdk.NewDecWithPrec(2, 1),
+		})
+	}
+	require.NoError(t, params.Validate())
+	tk.MintKeeper.SetParams(ctx, params)
+	funded := params.FundedAddresses
+
+	t.Run("should return the fields of the params", func(t *testing.T) {
+		denom, err := q.MintDenom(wctx, &types.QueryMintDenomRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "foo", denom.MintDenom)
+
+		proportions, err := q.DistributionProportions(wctx, &types.QueryDistributionProportionsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, params.DistributionProportions, proportions.DistributionProportions)
+
+		res, err := q.FundedAddresses(wctx, &types.QueryFundedAddressesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, funded, res.FundedAddresses)
+		require.Empty(t, res.Pagination.NextKey)
+	})
+
+	tests := []struct {
+		name     string
+		page     *query.PageRequest
+		expected []types.WeightedAddress
+		nextKey  []byte
+		total    uint64
+		err      string
+	}{
+		{
+			name:     "should return the first page with the key of the next page",
+			page:     &query.PageRequest{Limit: 2, CountTotal: true},
+			expected: funded[:2],
+			nextKey:  []byte(funded[2].Address),
+			total:    5,
+		},
+		{
+			name:     "should return the page of the key",
+			page:     &query.PageRequest{Key: []byte(funded[2].Address), Limit: 2},
+			expected: funded[2:4],
+			nextKey:  []byte(funded[4].Address),
+		},
+		{
+			name:     "should return the last page without next key",
+			page:     &query.PageRequest{Key: []byte(funded[4].Address), Limit: 2},
+			expected: funded[4:],
+		},
+		{
+			name:     "should return a full last page without next key",
+			page:     &query.PageRequest{Offset: 3, Limit: 2},
+			expected: funded[3:],
+		},
+		{
+			name:     "should return an empty page past the last funded address",
+			page:     &query.PageRequest{Offset: 5, Limit: 2},
+			expected: []types.WeightedAddress{},
+		},
+		{
+			name:     "should return the pages in the reverse order",
+			page:     &query.PageRequest{Limit: 2, Reverse: true},
+			expected: []types.WeightedAddress{funded[4], funded[3]},
+			nextKey:  []byte(funded[2].Address),
+		},
+		{
+			name: "should prevent a page with a key and an offset",
+			page: &query.PageRequest{Key: []byte(funded[2].Address), Offset: 1},
+			err:  "either offset or key is expected, got both",
+		},
+		{
+			name: "should prevent a page with the key of an address not funded",
+			page: &query.PageRequest{Key: []byte(sample.Address(r))},
+			err:  "of the page key not found",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := q.FundedAddresses(wctx, &types.QueryFundedAddressesRequest{Pagination: tc.page})
+			if tc.err != "" {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res.FundedAddresses)
+			require.Equal(t, tc.nextKey, res.Pagination.NextKey)
+			require.Equal(t, tc.total, res.Pagination.Total)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// guardedSubspace serializes the accesses to the params subspace. The subspace appends the separator
+// to the shared slice of its name to prefix the store on every access, so that concurrent accesses
+// from keeper copies used on different goroutines race on the same memory.
+type guardedSubspace struct {
+	subspace paramtypes.Subspace
+	mu       *sync.Mutex
+}
+
+func newGuardedSubspace(subspace paramtypes.Subspace) guardedSubspace {
+	return guardedSubspace{
+		subspace: subspace,
+		mu:       &sync.Mutex{},
+	}
+}
+
+// GetParamSet gets the params from the subspace, it panics if a param is not set
+func (s guardedSubspace) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subspace.GetParamSet(ctx, ps)
+}
+
+// GetParamSetIfExists gets the params set in the subspace
+func (s guardedSubspace) GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subspace.GetParamSetIfExists(ctx, ps)
+}
+
+// Get gets the param from the subspace, it panics if the param is not set
+func (s guardedSubspace) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subspace.Get(ctx, key, ptr)
+}
+
+// Has returns true if the param is set in the subspace
+func (s guardedSubspace) Has(ctx sdk.Context, key []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.subspace.Has(ctx, key)
+}
+
+// Set sets the param in the subspace without validation
+func (s guardedSubspace) Set(ctx sdk.Context, key []byte, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subspace.Set(ctx, key, value)
+}
+
+// SetParamSet validates and sets the params in the subspace
+func (s guardedSubspace) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subspace.SetParamSet(ctx, ps)
+}
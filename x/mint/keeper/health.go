@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// Health returns the health report of the minting at the height of the context, the app can
+// include it in the status or health metadata of the node
+func (k Keeper) Health(ctx sdk.Context) types.MintHealth {
+	params := k.GetParams(ctx).AtHeight(ctx.BlockHeight())
+	consistency := k.GetDenomConsistency(ctx, params.MintDenom, k.StakingTokenSupply(ctx))
+	return types.NewMintHealth(
+		params,
+		k.GetMinter(ctx),
+		ctx.BlockHeight(),
+		k.lastMintHeight(ctx),
+		consistency.Mismatch,
+	)
+}
+
+// lastMintHeight returns the height of the last recorded allocation of minted coins, zero if no
+// allocation is recorded
+func (k Keeper) lastMintHeight(ctx sdk.Context) int64 {
+	var height int64
+	k.collections.distributionHistory.Iterate(ctx, storeRange{reverse: true}, func(h int64, _ types.BlockDistribution) bool {
+		height = h
+		return true
+	})
+	return height
+}
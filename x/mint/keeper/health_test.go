@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testapp "github.com/ignite/modules/app"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// updateParams updates the params in a committed block
+func updateParams(t *testing.T, app *testapp.App, update func(*types.Params)) {
+	ctx, _ := beginBlock(app, time.Now().UTC())
+	params := app.MintKeeper.GetParams(ctx)
+	update(&params)
+	require.NoError(t, params.Validate())
+	app.MintKeeper.SetParams(ctx, params)
+	endBlock(app, ctx)
+}
+
+// lastBlockHealth returns the health of the minting at the last committed block
+func lastBlockHealth(t *testing.T, app *testapp.App) types.MintHealth {
+	ctx, err := app.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	return app.MintKeeper.Health(ctx)
+}
+
+func TestHealth(t *testing.T) {
+	app := setup(false)
+	commitBlocks(app, 3)
+
+	t.Run("should report active minting", func(t *testing.T) {
+		health := lastBlockHealth(t, app)
+		require.Equal(t, types.HealthStatusActive, health.Status)
+		require.Equal(t, app.LastBlockHeight(), health.Height)
+		require.Equal(t, app.LastBlockHeight(), health.LastMintHeight)
+		require.Zero(t, health.BlocksSinceMint)
+		require.True(t, health.Inflation.IsPositive())
+	})
+
+	t.Run("should report paused minting", func(t *testing.T) {
+		updateParams(t, app, func(params *types.Params) {
+			params.PauseMinting = true
+		})
+		// the block updating the params has minted
+		lastMintHeight := app.LastBlockHeight()
+		commitBlocks(app, 2)
+
+		health := lastBlockHealth(t, app)
+		require.Equal(t, types.HealthStatusPaused, health.Status)
+		require.Equal(t, lastMintHeight, health.LastMintHeight)
+		require.EqualValues(t, 2, health.BlocksSinceMint)
+	})
+
+	t.Run("should report terminal minting", func(t *testing.T) {
+		updateParams(t, app, func(params *types.Params) {
+			params.PauseMinting = false
+			params.InflationMax = sdk.ZeroDec()
+			params.InflationMin = sdk.ZeroDec()
+		})
+		lastMintHeight := lastBlockHealth(t, app).LastMintHeight
+		commitBlocks(app, 2)
+
+		health := lastBlockHealth(t, app)
+		require.Equal(t, types.HealthStatusTerminal, health.Status)
+		require.True(t, health.Inflation.IsZero())
+		require.Equal(t, lastMintHeight, health.LastMintHeight)
+		require.Equal(t, health.Height-lastMintHeight, health.BlocksSinceMint)
+	})
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// recordInflationSnapshot records the snapshot of the inflation of the minter every
+// inflation_snapshot_interval blocks, the snapshots older than inflation_snapshot_retention blocks
+// are pruned
+func (k Keeper) recordInflationSnapshot(ctx sdk.Context, params types.Params, minter types.Minter) {
+	if !params.IsInflationSnapshotHeight(ctx.BlockHeight()) {
+		return
+	}
+	k.SetInflationSnapshot(ctx, types.NewInflationSnapshot(ctx.BlockHeight(), minter))
+
+	if prunedTo, pruned := params.InflationSnapshotPrunedTo(ctx.BlockHeight()); pruned {
+		k.pruneInflationSnapshots(ctx, prunedTo)
+	}
+}
+
+// pruneInflationSnapshots deletes the snapshots of the inflation up to a height, included. At most
+// InflationSnapshotPruneCap snapshots are pruned at once, the snapshots left behind by a reduced
+// retention or interval are pruned in the next snapshot heights or by a maintenance run.
+func (k Keeper) pruneInflationSnapshots(ctx sdk.Context, toHeight int64) {
+	r := storeRange{end: heightKey{}.Encode(toHeight + 1)}
+	k.collections.inflationSnapshots.RemoveRange(ctx, r, types.InflationSnapshotPruneCap)
+}
+
+// SetInflationSnapshot records a snapshot of the inflation
+func (k Keeper) SetInflationSnapshot(ctx sdk.Context, snapshot types.InflationSnapshot) {
+	k.collections.inflationSnapshots.Set(ctx, snapshot.Height, snapshot)
+}
+
+// GetInflationSnapshot returns the snapshot of the inflation of a block
+func (k Keeper) GetInflationSnapshot(ctx sdk.Context, height int64) (snapshot types.InflationSnapshot, found bool) {
+	return k.collections.inflationSnapshots.Get(ctx, height)
+}
+
+// GetInflationSnapshots returns the snapshots of the inflation from the oldest with a height
+// between fromHeight and toHeight, toHeight is not bounding if zero. The first page starts at
+// fromHeight unless an offset is requested. The iteration stops with the error of the context once
+// it is done.
+func (k Keeper) GetInflationSnapshots(
+	ctx sdk.Context,
+	fromHeight, toHeight int64,
+	pagination *query.PageRequest,
+) ([]types.InflationSnapshot, *query.PageResponse, error) {
+	store := k.collections.inflationSnapshots.store(ctx, nil)
+	if fromHeight > 0 && (pagination == nil || (len(pagination.Key) == 0 && pagination.Offset == 0)) {
+		start := query.PageRequest{}
+		if pagination != nil {
+			start = *pagination
+		}
+		start.Key = heightKey{}.Encode(fromHeight)
+		pagination = &start
+	}
+
+	var snapshots []types.InflationSnapshot
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if err := ctx.Context().Err(); err != nil {
+			return false, err
+		}
+		var snapshot types.InflationSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return false, err
+		}
+		if snapshot.Height < fromHeight || (toHeight > 0 && snapshot.Height > toHeight) {
+			return false, nil
+		}
+		if accumulate {
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	return snapshots, pageRes, err
+}
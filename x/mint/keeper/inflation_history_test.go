@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// snapshotHeights returns the heights of the snapshots of the inflation
+func snapshotHeights(snapshots []types.InflationSnapshot) (heights []int64) {
+	for _, snapshot := range snapshots {
+		heights = append(heights, snapshot.Height)
+	}
+	return heights
+}
+
+// recordedSnapshotHeights returns the heights of all the snapshots of the inflation in the store
+func recordedSnapshotHeights(t *testing.T, ctx sdk.Context, tk testkeeper.TestKeepers) []int64 {
+	snapshots, _, err := tk.MintKeeper.GetInflationSnapshots(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	return snapshotHeights(snapshots)
+}
+
+func TestInflationHistory(t *testing.T) {
+	// advance runs the begin blocker of the heights and returns the minter of each block
+	advance := func(t *testing.T, ctx sdk.Context, tk testkeeper.TestKeepers, from, to int64) map[int64]types.Minter {
+		minters := make(map[int64]types.Minter)
+		for height := from; height <= to; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+			minters[height] = tk.MintKeeper.GetMinter(ctx)
+		}
+		return minters
+	}
+	setup := func(t *testing.T, interval, retention uint64) (sdk.Context, testkeeper.TestKeepers, types.Params) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := types.DefaultParams()
+		params.BlocksPerYear = 100
+		params.InflationSnapshotInterval = interval
+		params.InflationSnapshotRetention = retention
+		tk.MintKeeper.SetParams(ctx, params)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(100000))))
+		return ctx, tk, params
+	}
+
+	t.Run("should snapshot the inflation every interval", func(t *testing.T) {
+		ctx, tk, _ := setup(t, 3, 0)
+		minters := advance(t, ctx, tk, 1, 10)
+
+		require.Equal(t, []int64{3, 6, 9}, recordedSnapshotHeights(t, ctx, tk))
+		snapshot, found := tk.MintKeeper.GetInflationSnapshot(ctx, 6)
+		require.True(t, found)
+		require.Equal(t, types.NewInflationSnapshot(6, minters[6]), snapshot)
+		require.Equal(t, minters[6].Inflation, snapshot.Inflation)
+		require.Equal(t, minters[6].AnnualProvisions, snapshot.AnnualProvisions)
+		require.Equal(t, minters[6].LastBlockInputs.BondedRatio, snapshot.BondedRatio)
+		require.NotEqual(t, minters[3].Inflation, snapshot.Inflation)
+	})
+
+	t.Run("should snapshot the inflation while minting is paused", func(t *testing.T) {
+		ctx, tk, params := setup(t, 2, 0)
+		params.PauseMinting = true
+		tk.MintKeeper.SetParams(ctx, params)
+		minters := advance(t, ctx, tk, 1, 4)
+
+		require.Equal(t, []int64{2, 4}, recordedSnapshotHeights(t, ctx, tk))
+		snapshot, _ := tk.MintKeeper.GetInflationSnapshot(ctx, 4)
+		require.Equal(t, types.NewInflationSnapshot(4, minters[4]), snapshot)
+	})
+
+	t.Run("should not snapshot the inflation with a zero interval", func(t *testing.T) {
+		ctx, tk, _ := setup(t, 0, 0)
+		advance(t, ctx, tk, 1, 10)
+		require.Empty(t, recordedSnapshotHeights(t, ctx, tk))
+	})
+
+	t.Run("should prune the snapshots older than the retention", func(t *testing.T) {
+		ctx, tk, params := setup(t, 3, 9)
+		advance(t, ctx, tk, 1, 9)
+		require.Equal(t, []int64{3, 6, 9}, recordedSnapshotHeights(t, ctx, tk))
+
+		advance(t, ctx, tk, 10, 15)
+		require.Equal(t, []int64{9, 12, 15}, recordedSnapshotHeights(t, ctx, tk))
+
+		// a reduced retention prunes all the older snapshots at the next snapshot
+		params.InflationSnapshotRetention = 3
+		tk.MintKeeper.SetParams(ctx, params)
+		advance(t, ctx, tk, 16, 18)
+		require.Equal(t, []int64{18}, recordedSnapshotHeights(t, ctx, tk))
+	})
+
+	t.Run("should prune at most InflationSnapshotPruneCap snapshots in a block", func(t *testing.T) {
+		ctx, tk, _ := setup(t, 200, 10)
+		for height := int64(1); height <= 150; height++ {
+			tk.MintKeeper.SetInflationSnapshot(ctx, types.InflationSnapshot{Height: height})
+		}
+		advance(t, ctx, tk, 200, 200)
+
+		heights := recordedSnapshotHeights(t, ctx, tk)
+		require.Len(t, heights, 150-types.InflationSnapshotPruneCap+1)
+		require.EqualValues(t, types.InflationSnapshotPruneCap+1, heights[0])
+	})
+}
+
+func TestInflationHistoryQuery(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	q := querier.NewQuerier(tk.MintKeeper)
+	snapshot := func(height int64) types.InflationSnapshot {
+		return types.InflationSnapshot{
+			Height:           height,
+			Inflation:        sdk.NewDecWithPrec(height, 2),
+			AnnualProvisions: sdk.NewDec(height * 1000),
+			BondedRatio:      sdk.NewDecWithPrec(67, 2),
+		}
+	}
+	for height := int64(10); height <= 100; height += 10 {
+		tk.MintKeeper.SetInflationSnapshot(sdkCtx, snapshot(height))
+	}
+
+	t.Run("should return the snapshots between the heights", func(t *testing.T) {
+		res, err := q.InflationHistory(ctx, &types.QueryInflationHistoryRequest{FromHeight: 25, ToHeight: 60})
+		require.NoError(t, err)
+		require.Equal(t, []int64{30, 40, 50, 60}, snapshotHeights(res.Snapshots))
+		require.Equal(t, snapshot(30), res.Snapshots[0])
+	})
+
+	t.Run("should page through the snapshots from the height", func(t *testing.T) {
+		var got []int64
+		req := &types.QueryInflationHistoryRequest{FromHeight: 40, Pagination: &query.PageRequest{Limit: 3}}
+		for {
+			res, err := q.InflationHistory(ctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Snapshots), 3)
+			got = append(got, snapshotHeights(res.Snapshots)...)
+			if len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}
+		}
+		require.Equal(t, []int64{40, 50, 60, 70, 80, 90, 100}, got)
+	})
+
+	t.Run("should bound the page size", func(t *testing.T) {
+		res, err := q.InflationHistory(ctx, &types.QueryInflationHistoryRequest{
+			Pagination: &query.PageRequest{Limit: types.InflationHistoryMaxLimit + 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Snapshots, 10)
+	})
+
+	t.Run("should prevent invalid heights", func(t *testing.T) {
+		for _, req := range []*types.QueryInflationHistoryRequest{
+			nil,
+			{FromHeight: -1},
+			{FromHeight: 5, ToHeight: 4},
+		} {
+			_, err := q.InflationHistory(ctx, req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
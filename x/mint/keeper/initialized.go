@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetInitializedHeight returns the first height minted with the genesis state of the module
+func (k Keeper) GetInitializedHeight(ctx sdk.Context) (height int64, found bool) {
+	return k.collections.initializedHeight.Get(ctx)
+}
+
+// RecordModuleInitialized records the height the module is initialized at and emits and logs the
+// summary of the mint configuration loaded, so a wrong configuration is noticed from the first
+// block. The genesis state is initialized before the first block of the chain, the first height
+// is the height of the context or 1 for a chain starting at the default initial height.
+func (k Keeper) RecordModuleInitialized(ctx sdk.Context) error {
+	height := ctx.BlockHeight()
+	if height < 1 {
+		height = 1
+	}
+	k.collections.initializedHeight.Set(ctx, height)
+
+	event := types.NewEventModuleInitialized(height, k.GetMinter(ctx), k.GetParams(ctx))
+	k.Logger(ctx).Info(
+		"mint module initialized",
+		"height", event.Height,
+		"mint_denom", event.MintDenom,
+		"inflation", event.Inflation.String(),
+		"staking", event.DistributionProportions.Staking.String(),
+		"funded_addresses", event.DistributionProportions.FundedAddresses.String(),
+		"community_pool", event.DistributionProportions.CommunityPool.String(),
+		"strategic_reserve", event.DistributionProportions.StrategicReserveRatio().String(),
+		"funded_address_count", event.FundedAddresses,
+		"features", strings.Join(event.Features, ","),
+	)
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// moduleInitializedEvents returns the EventModuleInitialized events emitted in the context
+func moduleInitializedEvents(t *testing.T, ctx sdk.Context) []types.EventModuleInitialized {
+	var events []types.EventModuleInitialized
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventModuleInitialized" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, *parsed.(*types.EventModuleInitialized))
+	}
+	return events
+}
+
+func TestModuleInitialized(t *testing.T) {
+	t.Run("should emit the summary of the default genesis state", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		ctx = ctx.WithBlockHeight(0).WithEventManager(sdk.NewEventManager())
+		genesis := types.DefaultGenesis()
+		mint.InitGenesis(ctx, tk.MintKeeper, tk.AccountKeeper, genesis)
+
+		require.Equal(t, []types.EventModuleInitialized{{
+			Height:                  1,
+			MintDenom:               sdk.DefaultBondDenom,
+			Inflation:               genesis.Minter.Inflation,
+			DistributionProportions: types.DefaultDistributionProportions,
+			Features:                []string{},
+		}}, moduleInitializedEvents(t, ctx))
+
+		height, found := tk.MintKeeper.GetInitializedHeight(ctx)
+		require.True(t, found)
+		require.EqualValues(t, 1, height)
+	})
+
+	t.Run("should emit the summary of a customized genesis state", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		ctx = ctx.WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+		genesis := types.DefaultGenesis()
+		genesis.Minter = types.InitialMinter(sdk.NewDecWithPrec(9, 2))
+		genesis.Params.MintDenom = "foo"
+		genesis.Params.MinAnnualCommunityFunding.Denom = "foo"
+		genesis.Params.DistributionProportions = types.DistributionProportions{
+			Staking:          sdk.NewDecWithPrec(5, 1),
+			FundedAddresses:  sdk.NewDecWithPrec(2, 1),
+			CommunityPool:    sdk.NewDecWithPrec(3, 1),
+			StrategicReserve: sdk.ZeroDec(),
+		}
+		genesis.Params.FundedAddresses = []types.WeightedAddress{
+			{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(5, 1)},
+			{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(5, 1)},
+		}
+		genesis.Params.MaxSupply = sdkmath.NewInt(1_000_000_000)
+		genesis.Params.MintingInterval = 10
+		genesis.Params.PauseCommunityShare = true
+		mint.InitGenesis(ctx, tk.MintKeeper, tk.AccountKeeper, genesis)
+
+		require.Equal(t, []types.EventModuleInitialized{{
+			Height:                  100,
+			MintDenom:               "foo",
+			Inflation:               sdk.NewDecWithPrec(9, 2),
+			DistributionProportions: genesis.Params.DistributionProportions,
+			FundedAddresses:         2,
+			Features:                []string{"pause_community_share", "max_supply", "minting_interval"},
+		}}, moduleInitializedEvents(t, ctx))
+
+		res, err := querier.NewQuerier(tk.MintKeeper).Status(sdk.WrapSDKContext(ctx), &types.QueryStatusRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 100, res.InitializedAtHeight)
+	})
+
+	t.Run("should return a zero initialized height if unknown", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		_, found := tk.MintKeeper.GetInitializedHeight(ctx)
+		require.False(t, found)
+
+		res, err := querier.NewQuerier(tk.MintKeeper).Status(sdk.WrapSDKContext(ctx), &types.QueryStatusRequest{})
+		require.NoError(t, err)
+		require.Zero(t, res.InitializedAtHeight)
+	})
+}
@@ -2,17 +2,21 @@ package keeper_test
 
 import (
 	"encoding/json"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	testapp "github.com/ignite/modules/app"
 	"github.com/ignite/modules/testutil"
 )
 
-func setup(isCheckTx bool) *testapp.App {
+func setup(isCheckTx bool, baseAppOptions ...func(*baseapp.BaseApp)) *testapp.App {
 	chainID := "simapp-chain-id"
-	app, genesisState := testutil.GenApp(chainID, !isCheckTx, 5)
+	app, genesisState := testutil.GenApp(chainID, !isCheckTx, 5, baseAppOptions...)
 	if !isCheckTx {
 		// init chain must be called to stop deliverState from being nil
 		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
@@ -33,3 +37,22 @@ func setup(isCheckTx bool) *testapp.App {
 
 	return app
 }
+
+// beginBlock begins the block following the last committed block at the time and returns the
+// context of the block
+func beginBlock(app *testapp.App, blockTime time.Time) (sdk.Context, abci.ResponseBeginBlock) {
+	header := tmproto.Header{
+		ChainID: "simapp-chain-id",
+		Height:  app.LastBlockHeight() + 1,
+		Time:    blockTime,
+	}
+	res := app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	return app.NewContext(false, header), res
+}
+
+// endBlock ends and commits the block of the context
+func endBlock(app *testapp.App, ctx sdk.Context) abci.ResponseEndBlock {
+	res := app.EndBlock(abci.RequestEndBlock{Height: ctx.BlockHeight()})
+	app.Commit()
+	return res
+}
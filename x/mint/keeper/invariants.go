@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const (
+	cumulativeCountersRoute      = "cumulative-counters"
+	moduleAccountBalanceRoute    = "module-account-balance"
+	distributionProportionsRoute = "distribution-proportions"
+)
+
+// RegisterInvariants registers all module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, cumulativeCountersRoute,
+		CumulativeCountersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, moduleAccountBalanceRoute,
+		ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, distributionProportionsRoute,
+		DistributionProportionsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := CumulativeCountersInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ModuleAccountBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return DistributionProportionsInvariant(k)(ctx)
+	}
+}
+
+// CumulativeCountersInvariant checks the stored cumulative minted amount is equal to the sum of
+// the category totals, the buffered paused shares and the dust accumulator
+func CumulativeCountersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		minter, found := k.getStoredMinter(ctx)
+		if !found {
+			return "", false
+		}
+
+		expected := k.expectedCumulativeMinted(ctx, minter)
+		broken := !minter.CumulativeMinted.Equal(expected)
+		return sdk.FormatInvariant(
+			types.ModuleName, cumulativeCountersRoute,
+			fmt.Sprintf("cumulative minted %s, expected %s from the category totals, the paused shares and the carried dust",
+				minter.CumulativeMinted, expected),
+		), broken
+	}
+}
+
+// ModuleAccountBalanceInvariant checks the balance of the module account is equal to the total
+// of the ledger entries of the buffered coins. The balance is zero at the end of a block unless
+// coins are buffered, coins held by the module account without ledger entry are stranded.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if _, found := k.getStoredMinter(ctx); !found {
+			return "", false
+		}
+
+		balance := k.ModuleAccountBalance(ctx)
+		totalBuffered := k.TotalBuffered(ctx)
+		broken := !balance.IsEqual(totalBuffered)
+		return sdk.FormatInvariant(
+			types.ModuleName, moduleAccountBalanceRoute,
+			fmt.Sprintf("module account balance %s, expected %s from the ledger entries", balance, totalBuffered),
+		), broken
+	}
+}
+
+// DistributionProportionsInvariant checks the stored distribution proportions sum to 1 and the
+// weights of the funded addresses sum to 1, the minted coins would otherwise be partially
+// distributed or overdrawn
+func DistributionProportionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if _, found := k.getStoredMinter(ctx); !found {
+			return "", false
+		}
+
+		params := k.GetParams(ctx)
+		proportions := params.DistributionProportions
+		var msg string
+		broken := false
+		if proportions.Staking.IsNil() || proportions.FundedAddresses.IsNil() || proportions.CommunityPool.IsNil() {
+			msg += "distribution proportions are not set\n"
+			broken = true
+		} else if total := proportions.Staking.Add(proportions.FundedAddresses).Add(proportions.CommunityPool).Add(proportions.StrategicReserveRatio()); !total.Equal(sdk.OneDec()) {
+			msg += fmt.Sprintf("distribution proportions sum to %s, expected 1\n", total)
+			broken = true
+		}
+
+		if len(params.FundedAddresses) > 0 {
+			weights := sdk.ZeroDec()
+			for _, fa := range params.FundedAddresses {
+				if fa.Weight.IsNil() {
+					continue
+				}
+				weights = weights.Add(fa.Weight)
+			}
+			if !weights.Equal(sdk.OneDec()) {
+				msg += fmt.Sprintf("weights of the %d funded addresses sum to %s, expected 1\n", len(params.FundedAddresses), weights)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, distributionProportionsRoute, msg), broken
+	}
+}
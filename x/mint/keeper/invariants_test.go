@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/simulation"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestDistributionProportionsInvariant(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		corrupt func(params *types.Params)
+		msg     string
+	}{
+		{
+			name:    "valid params",
+			corrupt: func(*types.Params) {},
+		},
+		{
+			name: "proportions sum below 1",
+			corrupt: func(params *types.Params) {
+				params.DistributionProportions.CommunityPool = sdk.MustNewDecFromStr("0.1")
+			},
+			msg: "distribution proportions sum to 0.800000000000000000, expected 1",
+		},
+		{
+			name: "proportions sum above 1",
+			corrupt: func(params *types.Params) {
+				params.DistributionProportions.Staking = sdk.OneDec()
+			},
+			msg: "distribution proportions sum to 1.700000000000000000, expected 1",
+		},
+		{
+			name: "funded address weights sum below 1",
+			corrupt: func(params *types.Params) {
+				params.FundedAddresses[1].Weight = sdk.MustNewDecFromStr("0.25")
+			},
+			msg: "weights of the 2 funded addresses sum to 0.750000000000000000, expected 1",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, tk, _ := testSetups[0].setup(t)
+			tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+			params := types.DefaultParams()
+			params.FundedAddresses = []types.WeightedAddress{
+				{Address: sample.Address(r), Weight: sdk.MustNewDecFromStr("0.5")},
+				{Address: sample.Address(r), Weight: sdk.MustNewDecFromStr("0.5")},
+			}
+			tk.MintKeeper.SetParams(ctx, params)
+
+			// the subspace setters skip the validation of the params
+			tc.corrupt(&params)
+			subspace, found := tk.ParamsKeeper.GetSubspace(types.ModuleName)
+			require.True(t, found)
+			subspace.Set(ctx, types.KeyDistributionProportions, params.DistributionProportions)
+			subspace.Set(ctx, types.KeyFundedAddresses, params.FundedAddresses)
+
+			res, broken := keeper.DistributionProportionsInvariant(tk.MintKeeper)(ctx)
+			require.Equal(t, tc.msg != "", broken)
+			require.Contains(t, res, tc.msg)
+
+			res, broken = keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.Equal(t, tc.msg != "", broken)
+			require.Contains(t, res, tc.msg)
+		})
+	}
+}
+
+func TestModuleAccountBalanceInvariantStrandedCoins(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+	_, broken := keeper.ModuleAccountBalanceInvariant(tk.MintKeeper)(ctx)
+	require.False(t, broken)
+
+	// coins left in the module account by an interrupted distribution are reported
+	stranded := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 42))
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, stranded))
+	res, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, res, "module-account-balance")
+	require.Contains(t, res, "module account balance 42stake")
+}
+
+func TestInvariantsRandomizedParams(t *testing.T) {
+	for seed := int64(0); seed < 2; seed++ {
+		ctx, tk, ts := testSetups[0].setup(t)
+		simRand := rand.New(rand.NewSource(seed))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000)))
+		tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+		for height := int64(1); height <= 1000; height++ {
+			ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+			// the params are updated through a randomized governance proposal every 100 blocks
+			if height%100 == 1 {
+				msg := simulation.SimulateMsgUpdateParams(simRand, ctx, nil).(*types.MsgUpdateParams)
+				msg.Authority = tk.MintKeeper.GetAuthority()
+				_, err := ts.MintSrv.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+				require.NoError(t, err, "seed %d height %d", seed, height)
+			}
+
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx), "seed %d height %d", seed, height)
+			if height%50 != 0 {
+				continue
+			}
+			res, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.False(t, broken, "seed %d height %d: %s", seed, height, res)
+		}
+	}
+}
@@ -1,38 +1,152 @@
 package keeper
 
 import (
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	errorsignite "github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/pkg/errors"
 	"github.com/ignite/modules/x/mint/types"
 )
 
-// Keeper of the mint store
+// Keeper of the mint store.
+//
+// The keeper holds no state beyond its configuration, which is immutable after construction: the
+// state is read from and written to the store of the context, and any cache is scoped to a context.
+// A keeper can therefore be used from streaming or background goroutines reading from their own
+// branched contexts, like query contexts, while blocks are committed. The contexts themselves are
+// not safe for concurrent use.
 type Keeper struct {
 	cdc              codec.BinaryCodec
-	storeKey         storetypes.StoreKey
-	paramSpace       paramtypes.Subspace
+	storeService     corestore.KVStoreService
+	collections      storeCollections
+	paramSpace       guardedSubspace
 	stakingKeeper    types.StakingKeeper
 	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	distrKeeper      types.DistrKeeper
 	feeCollectorName string
+
+	// the address capable of executing authority messages, typically the x/gov module account
+	authority string
+
+	// recompute the inconsistent cumulative counters from the category totals
+	selfHeal bool
+
+	// alternative source of the staking supply used to compute the annual provisions
+	supplySource types.SupplySource
+
+	// send restriction invoked before the payouts of the funded addresses
+	sendRestriction types.SendRestrictionFn
+
+	// hooks invoked once the minted coins are distributed
+	hooks types.MintHooks
+
+	// write the module store directly during the begin blocker instead of batching the writes
+	disableWriteBatching bool
+
+	// number of keys of the write batch flushing the batch to the module store
+	writeBatchLimit int
+
+	// report the duration and the gas of the stages of the begin blocker
+	profiling bool
+
+	// prover of the state of the app at a height, the state proofs are not served without prover
+	stateProver types.StateProver
+}
+
+// KeeperOption configures the mint Keeper.
+type KeeperOption func(*Keeper)
+
+// WithSelfHeal enables the self-healing of the inconsistent cumulative counters: the cumulative
+// minted amount is recomputed from the category totals and counter decreases are rejected.
+func WithSelfHeal() KeeperOption {
+	return func(k *Keeper) {
+		k.selfHeal = true
+	}
+}
+
+// WithSupplySource sets an alternative source of the staking supply used to compute the annual
+// provisions, it replaces or is combined with the staking keeper supply depending on the
+// supply_source_mode param.
+func WithSupplySource(source types.SupplySource) KeeperOption {
+	return func(k *Keeper) {
+		k.supplySource = source
+	}
+}
+
+// EnforceSendRestrictions invokes the send restriction before the payouts of the funded addresses,
+// typically the restriction registered in the bank keeper. A payout blocked by the restriction is
+// escrowed in the pending payout of the address instead of failing the block.
+func EnforceSendRestrictions(restriction types.SendRestrictionFn) KeeperOption {
+	return func(k *Keeper) {
+		k.sendRestriction = restriction
+	}
+}
+
+// WithoutWriteBatching disables the batching of the writes to the module store during the begin
+// blocker, each write is applied to the module store when it is made.
+func WithoutWriteBatching() KeeperOption {
+	return func(k *Keeper) {
+		k.disableWriteBatching = true
+	}
 }
 
-// NewKeeper creates a new mint Keeper instance
+// WithWriteBatchLimit sets the number of keys written to the write batch of the begin blocker
+// flushing the batch to the module store, DefaultWriteBatchLimit by default. A limit below one
+// keeps the default.
+func WithWriteBatchLimit(limit int) KeeperOption {
+	return func(k *Keeper) {
+		if limit > 0 {
+			k.writeBatchLimit = limit
+		}
+	}
+}
+
+// WithProfiling enables the profiling of the begin blocker: the duration and the gas of each stage
+// of the begin blocker and of each distribution leg are reported to the telemetry, and summarized
+// by a debug log at the end of each block. It is a debug option, the profiling is skipped without
+// overhead when disabled.
+func WithProfiling() KeeperOption {
+	return func(k *Keeper) {
+		k.profiling = true
+	}
+}
+
+// WithStateProver sets the prover serving the StateProof query, typically a prover querying the
+// stores of the app through ABCI returned by NewABCIStateProver.
+func WithStateProver(prover types.StateProver) KeeperOption {
+	return func(k *Keeper) {
+		k.stateProver = prover
+	}
+}
+
+// NewKeeper creates a new mint Keeper instance using the module store key
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string, opts ...KeeperOption,
+) Keeper {
+	return NewKeeperWithStoreService(cdc, NewKVStoreService(key), paramSpace, sk, ak, bk, dk, feeCollectorName, authority, opts...)
+}
+
+// NewKeeperWithStoreService creates a new mint Keeper instance using a store service
+// to access the module store
+func NewKeeperWithStoreService(
+	cdc codec.BinaryCodec, storeService corestore.KVStoreService, paramSpace paramtypes.Subspace,
+	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper,
+	feeCollectorName string, authority string, opts ...KeeperOption,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
-		panic("the mint module account has not been set")
+		panic(types.ErrModuleAccountNotSet)
 	}
 
 	// set KeyTable if it has not already been set
@@ -40,16 +154,37 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
+	k := Keeper{
 		cdc:              cdc,
-		storeKey:         key,
-		paramSpace:       paramSpace,
+		storeService:     batchedStoreService{KVStoreService: storeService},
+		paramSpace:       newGuardedSubspace(paramSpace),
 		stakingKeeper:    sk,
 		accountKeeper:    ak,
 		bankKeeper:       bk,
 		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		writeBatchLimit:  DefaultWriteBatchLimit,
 	}
+	k.collections = newStoreCollections(cdc, k.storeService)
+	for _, opt := range opts {
+		opt(&k)
+	}
+	return k
+}
+
+// SetHooks sets the mint hooks, the hooks must be set before the keeper is copied into the app
+// module. It panics if the hooks are already set.
+func (k *Keeper) SetHooks(hooks types.MintHooks) {
+	if k.hooks != nil {
+		panic("cannot set mint hooks twice")
+	}
+	k.hooks = hooks
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // Logger returns a module-specific logger.
@@ -57,23 +192,75 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
-// GetMinter gets the minter
-func (k Keeper) GetMinter(ctx sdk.Context) (minter types.Minter) {
-	store := ctx.KVStore(k.storeKey)
-	b := store.Get(types.MinterKey)
-	if b == nil {
+// GetMinter gets the minter, the consistency of its cumulative counters is checked on read
+func (k Keeper) GetMinter(ctx sdk.Context) types.Minter {
+	minter, found := k.getStoredMinter(ctx)
+	if !found {
 		panic("stored minter should not have been nil")
 	}
 
-	k.cdc.MustUnmarshal(b, &minter)
-	return
+	k.checkCounters(ctx, &minter)
+	return minter
 }
 
-// SetMinter sets the minter
+// ReadMinter gets the minter without checking its counters, for the readers that must not emit
+// events nor heal the minter
+func (k Keeper) ReadMinter(ctx sdk.Context) types.Minter {
+	minter, found := k.getStoredMinter(ctx)
+	if !found {
+		panic("stored minter should not have been nil")
+	}
+	return minter
+}
+
+// SetMinter sets the minter and refreshes the summary, decreases of the cumulative counters are detected
 func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
-	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshal(&minter)
-	store.Set(types.MinterKey, b)
+	if stored, found := k.getStoredMinter(ctx); found {
+		k.enforceMonotonicCounters(ctx, stored, &minter)
+	}
+
+	k.collections.minter.Set(ctx, minter)
+
+	// the params may not be set yet during genesis initialization
+	var params types.Params
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	k.setSummary(ctx, types.NewSummary(minter, params))
+}
+
+// getStoredMinter gets the minter as stored
+func (k Keeper) getStoredMinter(ctx sdk.Context) (minter types.Minter, found bool) {
+	minter, found = k.collections.minter.Get(ctx)
+	if !found {
+		return minter, false
+	}
+
+	// minters stored before the carry buffer was introduced have no value set
+	if minter.CarryBuffer.IsNil() {
+		minter.CarryBuffer = sdk.ZeroDec()
+	}
+	// same for the inputs of the last block and the cumulative counters
+	if minter.LastBlockInputs.BondedRatio.IsNil() {
+		minter.LastBlockInputs.BondedRatio = sdk.ZeroDec()
+	}
+	if minter.LastBlockInputs.StakingSupply.IsNil() {
+		minter.LastBlockInputs.StakingSupply = sdkmath.ZeroInt()
+	}
+	if minter.CumulativeMinted.IsNil() {
+		minter.CumulativeMinted = sdkmath.ZeroInt()
+	}
+	if minter.CumulativeFunded.IsNil() {
+		minter.CumulativeFunded = sdkmath.ZeroInt()
+	}
+	minter.CumulativeDistributed.Normalize()
+	if minter.CommunityFunding.YearStartCommunityPool.IsNil() {
+		minter.CommunityFunding.YearStartCommunityPool = sdkmath.ZeroInt()
+	}
+	// the target emissions of the minters stored before the drift tracking start from the
+	// realized emissions
+	if minter.TargetCumulativeEmission.IsNil() {
+		minter.TargetCumulativeEmission = sdk.NewDecFromInt(minter.CumulativeMinted)
+	}
+	return minter, true
 }
 
 // GetParams returns the total set of minting parameters.
@@ -82,9 +269,66 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
-// SetParams sets the total set of minting parameters.
+// SetParams sets the total set of minting parameters and refreshes the summary.
+// The weight changes of the funded addresses are recorded in their history. The
+// change is recorded as the last params change from the keeper source.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.SetParamsWithChange(ctx, params, types.NewParamsChange(ctx, k.authority, types.ParamsChangeSourceKeeper))
+}
+
+// SetParamsWithChange sets the total set of minting parameters like SetParams and
+// records the provided change as the last params change.
+func (k Keeper) SetParamsWithChange(ctx sdk.Context, params types.Params, change types.ParamsChange) {
+	// only the canonical form of the funded addresses is stored
+	params.FundedAddresses = types.NormalizeWeightedAddresses(params.FundedAddresses)
+
+	// the params are not set yet during genesis initialization
+	var oldParams types.Params
+	k.paramSpace.GetParamSetIfExists(ctx, &oldParams)
+	k.recordFundedAddressChanges(ctx, oldParams.FundedAddresses, params.FundedAddresses)
+
 	k.paramSpace.SetParamSet(ctx, &params)
+	k.setLastParamsChange(ctx, change)
+
+	// the minter may not be set yet during genesis initialization
+	if !k.hasMinter(ctx) {
+		return
+	}
+	minter := k.GetMinter(ctx)
+	// the block provision is kept continuous from the last block, the blocks per year of a minter
+	// without block set before the first block are effective immediately
+	if oldParams.BlocksPerYear != 0 && oldParams.BlocksPerYear != params.BlocksPerYear && minter.LastBlockInputs.Height > 0 {
+		k.startBlocksPerYearTransition(ctx, &minter, oldParams, params)
+	}
+	k.setSummary(ctx, types.NewSummary(minter, params))
+}
+
+// startBlocksPerYearTransition starts the transition of the blocks per year used to compute the
+// block provision on a change of the blocks per year, the block provision stays continuous at the
+// moment of the change and reaches the provision at the new blocks per year at the end of the
+// transition
+func (k Keeper) startBlocksPerYearTransition(ctx sdk.Context, minter *types.Minter, oldParams, params types.Params) {
+	// the blocks per year of a pending transition are the starting point of the new transition,
+	// which starts at the next block so the first block minted after the change mints the same
+	// provision
+	oldProvisionParams := minter.ProvisionParams(oldParams, ctx.BlockHeight())
+	minter.BlocksPerYearTransition = types.NewBlocksPerYearTransition(
+		oldProvisionParams.BlocksPerYear,
+		params.BlocksPerYear,
+		ctx.BlockHeight()+1,
+	)
+	k.SetMinter(ctx, *minter)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBlocksPerYearTransition{
+		OldBlocksPerYear: oldParams.BlocksPerYear,
+		NewBlocksPerYear: params.BlocksPerYear,
+		BlockProvision:   minter.ExactBlockProvision(oldProvisionParams),
+		StartHeight:      minter.BlocksPerYearTransition.StartHeight,
+		EndHeight:        minter.BlocksPerYearTransition.EndHeight,
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to emit blocks per year transition event: %s", err))
+	}
 }
 
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
@@ -99,62 +343,46 @@ func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
 	return k.stakingKeeper.BondedRatio(ctx)
 }
 
-// MintCoin implements an alias call to the underlying supply keeper's
-// MintCoin to be used in BeginBlocker.
-func (k Keeper) MintCoin(ctx sdk.Context, coin sdk.Coin) error {
-	return k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin))
+// TotalBondedTokens implements an alias call to the underlying staking keeper's
+// TotalBondedTokens.
+func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdkmath.Int {
+	return k.stakingKeeper.TotalBondedTokens(ctx)
 }
 
-// GetProportion gets the balance of the `MintedDenom` from minted coins and returns coins according to the `AllocationRatio`.
-func (k Keeper) GetProportion(_ sdk.Context, mintedCoin sdk.Coin, ratio sdk.Dec) sdk.Coin {
-	return sdk.NewCoin(mintedCoin.Denom, sdk.NewDecFromInt(mintedCoin.Amount).Mul(ratio).TruncateInt())
+// GetValidator implements an alias call to the underlying staking keeper's
+// GetValidator.
+func (k Keeper) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	return k.stakingKeeper.GetValidator(ctx, addr)
 }
 
-// DistributeMintedCoin implements distribution of minted coins from mint
-// to be used in BeginBlocker.
-func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
-	params := k.GetParams(ctx)
-	proportions := params.DistributionProportions
+// GetCommunityTax implements an alias call to the underlying distribution keeper's
+// GetCommunityTax.
+func (k Keeper) GetCommunityTax(ctx sdk.Context) sdk.Dec {
+	return k.distrKeeper.GetCommunityTax(ctx)
+}
 
-	// allocate staking rewards into fee collector account to be moved to on next begin blocker by staking module
-	stakingRewardsCoins := sdk.NewCoins(k.GetProportion(ctx, mintedCoin, proportions.Staking))
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, stakingRewardsCoins)
-	if err != nil {
-		return err
-	}
-
-	fundedAddrsCoin := k.GetProportion(ctx, mintedCoin, proportions.FundedAddresses)
-	fundedAddrsCoins := sdk.NewCoins(fundedAddrsCoin)
-	if len(params.FundedAddresses) == 0 {
-		// fund community pool when rewards address is empty
-		if err = k.distrKeeper.FundCommunityPool(
-			ctx,
-			fundedAddrsCoins,
-			k.accountKeeper.GetModuleAddress(types.ModuleName),
-		); err != nil {
-			return err
-		}
-	} else {
-		// allocate developer rewards to developer addresses by weight
-		for _, w := range params.FundedAddresses {
-			fundedAddrCoins := sdk.NewCoins(k.GetProportion(ctx, fundedAddrsCoin, w.Weight))
-			devAddr, err := sdk.AccAddressFromBech32(w.Address)
-			if err != nil {
-				return errorsignite.Critical(err.Error())
-			}
-			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, devAddr, fundedAddrCoins)
-			if err != nil {
-				return err
-			}
-		}
-	}
+// GetSupply implements an alias call to the underlying bank keeper's
+// GetSupply.
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return k.bankKeeper.GetSupply(ctx, denom)
+}
 
-	// subtract from original provision to ensure no coins left over after the allocations
-	communityPoolCoins := sdk.NewCoins(mintedCoin).Sub(stakingRewardsCoins...).Sub(fundedAddrsCoins...)
-	err = k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, k.accountKeeper.GetModuleAddress(types.ModuleName))
-	if err != nil {
-		return err
-	}
+// ModuleAddress returns the address of the module account
+func (k Keeper) ModuleAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
 
-	return err
+// StateProver returns the prover serving the StateProof query, nil if not set
+func (k Keeper) StateProver() types.StateProver {
+	return k.stateProver
+}
+
+// MintCoin implements an alias call to the underlying supply keeper's
+// MintCoin to be used in BeginBlocker. The coin is added to the total minted amount of its denom.
+func (k Keeper) MintCoin(ctx sdk.Context, coin sdk.Coin) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return errors.Wrapf(types.ErrMintFailed, "%s: %s", coin, err)
+	}
+	k.setTotalMintedOf(ctx, k.GetTotalMintedOf(ctx, coin.Denom).Add(coin))
+	return nil
 }
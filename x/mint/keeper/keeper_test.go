@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+var r *rand.Rand
+
+// initialize random generator
+func init() {
+	s := rand.NewSource(1)
+	r = rand.New(s)
+}
+
+// testSetups contains the test setups initializing the mint keeper with a store key and with
+// a store service, keeper tests are run against both setups to ensure the keepers are equivalent
+var testSetups = []struct {
+	name  string
+	setup func(testing.TB) (sdk.Context, testkeeper.TestKeepers, testkeeper.TestMsgServers)
+}{
+	{
+		name:  "store key",
+		setup: testkeeper.NewTestSetup,
+	},
+	{
+		name:  "store service",
+		setup: testkeeper.NewTestSetupWithStoreService,
+	},
+}
+
+// unsetModuleAccountKeeper is an account keeper where no module account is set
+type unsetModuleAccountKeeper struct {
+	types.AccountKeeper
+}
+
+func (unsetModuleAccountKeeper) GetModuleAddress(string) sdk.AccAddress {
+	return nil
+}
+
+func TestNewKeeperWithoutModuleAccount(t *testing.T) {
+	require.PanicsWithValue(t, types.ErrModuleAccountNotSet, func() {
+		keeper.NewKeeper(
+			nil, sdk.NewKVStoreKey(types.StoreKey), paramtypes.Subspace{},
+			nil, unsetModuleAccountKeeper{}, nil, nil,
+			authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+	})
+}
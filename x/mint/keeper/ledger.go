@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// TotalBuffered returns the sum of the ledger entries of the coins buffered in the module account,
+// the dust accumulator included
+func (k Keeper) TotalBuffered(ctx sdk.Context) sdk.Coins {
+	return k.GetMinter(ctx).TotalBuffered().Add(k.carriedDust(ctx)...)
+}
+
+// Ledger returns the sub-balances of the coins buffered in the module account by ledger entry,
+// the entries of the minter followed by the dust accumulator
+func (k Keeper) Ledger(ctx sdk.Context) []types.LedgerEntry {
+	return append(k.GetMinter(ctx).Ledger(), types.LedgerEntry{
+		Name:   types.LedgerEntryCarriedDust,
+		Amount: k.carriedDust(ctx),
+	})
+}
+
+// ModuleAccountBalance returns the balance of the module account
+func (k Keeper) ModuleAccountBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// bookUnaccountedDust books the balance of the module account not covered by the ledger entries
+// in the dust entry and returns the booked coins. Before the ledger, the dust kept in the module
+// account was only counted in the category totals.
+func (k Keeper) bookUnaccountedDust(ctx sdk.Context) sdk.Coins {
+	minter := k.GetMinter(ctx)
+	unaccounted, hasNeg := k.ModuleAccountBalance(ctx).SafeSub(k.TotalBuffered(ctx)...)
+	if hasNeg || unaccounted.IsZero() {
+		return nil
+	}
+	minter.Book(types.LedgerEntryDust, unaccounted)
+	k.SetMinter(ctx, minter)
+	return unaccounted
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestLedgerConservation(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	for _, ts := range testSetups {
+		ts := ts
+		t.Run(ts.name, func(t *testing.T) {
+			ctx, tk, _ := ts.setup(t)
+			// the staking and community pool shares are buffered and the funded addresses share
+			// of 40 coins leaves 1 coin of dust in the module account every block
+			params := types.DefaultParams()
+			params.FundedAddresses = []types.WeightedAddress{
+				{Address: sample.Address(r), Weight: sdk.MustNewDecFromStr("0.333333333333333333")},
+				{Address: sample.Address(r), Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+			}
+			params.PauseStakingShare = true
+			params.PauseCommunityShare = true
+			params.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+			mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+			distribute := func(height int64) {
+				ctx := ctx.WithBlockHeight(height)
+				require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+				require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+				require.True(t, tk.MintKeeper.ModuleAccountBalance(ctx).IsEqual(tk.MintKeeper.TotalBuffered(ctx)))
+				msg, broken := keeper.ModuleAccountBalanceInvariant(tk.MintKeeper)(ctx)
+				require.False(t, broken, msg)
+				msg, broken = keeper.AllInvariants(tk.MintKeeper)(ctx)
+				require.False(t, broken, msg)
+			}
+			for height := int64(1); height <= 3; height++ {
+				distribute(height)
+			}
+
+			res, err := querier.NewQuerier(tk.MintKeeper).ModuleAccount(sdk.WrapSDKContext(ctx), &types.QueryModuleAccountRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tk.AccountKeeper.GetModuleAddress(types.ModuleName).String(), res.Address)
+			require.Equal(t, stake(183), res.Balance)
+			require.Equal(t, stake(183), res.TotalBuffered)
+			require.Equal(t, []types.LedgerEntry{
+				{Name: types.LedgerEntryPausedStaking, Amount: stake(90)},
+				{Name: types.LedgerEntryPausedFundedAddresses},
+				{Name: types.LedgerEntryPausedCommunityPool, Amount: stake(90)},
+				{Name: types.LedgerEntryDust, Amount: stake(3)},
+				{Name: types.LedgerEntryPendingPayouts},
+				{Name: types.LedgerEntryStrategicReserve},
+				{Name: types.LedgerEntryCarriedDust},
+			}, res.Entries)
+
+			// the paused shares are released once resumed, the dust stays in the module account
+			params.PauseStakingShare = false
+			params.PauseCommunityShare = false
+			tk.MintKeeper.SetParams(ctx, params)
+			distribute(4)
+
+			res, err = querier.NewQuerier(tk.MintKeeper).ModuleAccount(sdk.WrapSDKContext(ctx), &types.QueryModuleAccountRequest{})
+			require.NoError(t, err)
+			require.Equal(t, stake(4), res.Balance)
+			require.Equal(t, []types.LedgerEntry{
+				{Name: types.LedgerEntryPausedStaking},
+				{Name: types.LedgerEntryPausedFundedAddresses},
+				{Name: types.LedgerEntryPausedCommunityPool},
+				{Name: types.LedgerEntryDust, Amount: stake(4)},
+				{Name: types.LedgerEntryPendingPayouts},
+				{Name: types.LedgerEntryStrategicReserve},
+				{Name: types.LedgerEntryCarriedDust},
+			}, res.Entries)
+		})
+	}
+}
+
+func TestModuleAccountBalanceInvariant(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+	// coins held by the module account without ledger entry break the invariant
+	dust := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, dust))
+	_, broken := keeper.ModuleAccountBalanceInvariant(tk.MintKeeper)(ctx)
+	require.True(t, broken)
+
+	// the migration books them in the dust entry
+	require.NoError(t, keeper.NewMigrator(tk.MintKeeper).Migrate2to3(ctx))
+	require.Equal(t, dust, tk.MintKeeper.GetMinter(ctx).BufferedDust)
+	_, broken = keeper.ModuleAccountBalanceInvariant(tk.MintKeeper)(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetLastMaintenanceHeight returns the height of the last maintenance run
+func (k Keeper) GetLastMaintenanceHeight(ctx sdk.Context) (height int64, found bool) {
+	return k.collections.lastMaintenanceHeight.Get(ctx)
+}
+
+func (k Keeper) setLastMaintenanceHeight(ctx sdk.Context, height int64) {
+	k.collections.lastMaintenanceHeight.Set(ctx, height)
+}
+
+// RunMaintenance cleans up to limit records of the store, a limit of zero or above
+// MaintenanceMaxLimit cleans up to MaintenanceMaxLimit records. In order, the shares buffered for
+// the categories no longer paused are flushed, the allocations older than
+// DistributionHistoryRetention blocks and the snapshots of the inflation outside of the retention
+// are pruned, and the weight changes of the addresses removed from the funded addresses for more
+// than DistributionHistoryRetention blocks are deleted. The gas consumed is proportional to the
+// records cleaned. The maintenance runs at most once every maintenance_interval blocks, the
+// begin blocker bounds the growth of the store without it.
+func (k Keeper) RunMaintenance(ctx sdk.Context, limit uint64) (types.MaintenanceSummary, error) {
+	summary := types.NewMaintenanceSummary()
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+	if last, found := k.GetLastMaintenanceHeight(ctx); found && height < last+int64(params.MaintenanceInterval) {
+		return summary, errors.Wrapf(
+			types.ErrMaintenanceTooSoon,
+			"last run at height %d, next run from height %d",
+			last,
+			last+int64(params.MaintenanceInterval),
+		)
+	}
+	if limit == 0 || limit > types.MaintenanceMaxLimit {
+		limit = types.MaintenanceMaxLimit
+	}
+
+	if err := k.flushPausedShares(ctx, params, limit, &summary); err != nil {
+		return summary, err
+	}
+
+	if from := height - types.DistributionHistoryRetention + 1; from > 0 {
+		summary.PrunedDistributions = pruneRecords(ctx, k.collections.distributionHistory, from, limit-summary.Work, &summary)
+		summary.Work += summary.PrunedDistributions
+	}
+	if prunedTo, pruned := params.InflationSnapshotPrunedTo(height); pruned {
+		summary.PrunedInflationSnapshots = pruneRecords(ctx, k.collections.inflationSnapshots, prunedTo+1, limit-summary.Work, &summary)
+		summary.Work += summary.PrunedInflationSnapshots
+	}
+
+	k.pruneExpiredFundedAddresses(ctx, params, limit, &summary)
+
+	ctx.GasMeter().ConsumeGas(types.MaintenanceGasPerWork*summary.Work, "mint maintenance")
+	k.setLastMaintenanceHeight(ctx, height)
+	return summary, nil
+}
+
+// flushPausedShares releases the shares buffered for the categories no longer paused, each
+// released ledger entry is a record cleaned. The entries are released at once by a distribution
+// of no minted coins, the flush is skipped when the limit doesn't allow it.
+func (k Keeper) flushPausedShares(ctx sdk.Context, params types.Params, limit uint64, summary *types.MaintenanceSummary) error {
+	minter := k.GetMinter(ctx)
+	var entries uint64
+	for _, entry := range []struct {
+		paused bool
+		coins  sdk.Coins
+	}{
+		{paused: params.PauseStakingShare, coins: minter.PausedShares.Staking},
+		{paused: params.PauseFundedShare, coins: minter.PausedShares.FundedAddresses},
+		{paused: params.PauseCommunityShare, coins: minter.PausedShares.CommunityPool},
+	} {
+		if !entry.paused && !entry.coins.IsZero() {
+			entries++
+			summary.Flushed = summary.Flushed.Add(entry.coins...)
+		}
+	}
+	if entries == 0 {
+		return nil
+	}
+	if entries > limit-summary.Work {
+		summary.Flushed = sdk.NewCoins()
+		summary.Complete = false
+		return nil
+	}
+
+	err := k.distributeCoin(
+		ctx,
+		params,
+		sdk.NewCoin(params.MintDenom, sdk.ZeroInt()),
+		types.NewCategoryTotals(),
+		types.ZeroCommunityFundingTopUp(),
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	summary.Work += entries
+	return nil
+}
+
+// pruneRecords deletes up to limit records of a map keyed by height, below a height. The summary
+// is marked incomplete when records are left behind.
+func pruneRecords[V any](
+	ctx sdk.Context,
+	records storeMap[int64, V],
+	belowHeight int64,
+	limit uint64,
+	summary *types.MaintenanceSummary,
+) (pruned uint64) {
+	pruned, more := records.RemoveRange(ctx, storeRange{end: heightKey{}.Encode(belowHeight)}, limit)
+	if more {
+		summary.Complete = false
+	}
+	return pruned
+}
+
+// pruneExpiredFundedAddresses deletes the weight changes of the addresses removed from the funded
+// addresses for more than DistributionHistoryRetention blocks, their income can no longer be
+// queried. The changes of an address are deleted from the oldest, an address is reported once all
+// its changes are deleted.
+func (k Keeper) pruneExpiredFundedAddresses(
+	ctx sdk.Context,
+	params types.Params,
+	limit uint64,
+	summary *types.MaintenanceSummary,
+) {
+	funded := make(map[string]bool, len(params.FundedAddresses))
+	for _, w := range params.FundedAddresses {
+		funded[w.Address] = true
+	}
+
+	var start []byte
+	for {
+		// the weight changes are ordered by address, the next address follows the changes of the
+		// previous one
+		var addr string
+		found := false
+		k.collections.fundedAddressHistory.Iterate(ctx, storeRange{start: start}, func(key addressSequence, _ types.FundedAddressWeightChange) bool {
+			addr, found = key.Address, true
+			return true
+		})
+		if !found {
+			return
+		}
+		addrPrefix := addressSequenceKey{}.Prefix(addr)
+		start = sdk.PrefixEndBytes(addrPrefix)
+		if funded[addr] || !k.isFundedAddressExpired(ctx, addr) {
+			continue
+		}
+
+		if summary.Work == limit {
+			summary.Complete = false
+			return
+		}
+		pruned, more := k.collections.fundedAddressHistory.RemoveRange(ctx, prefixRange(addrPrefix), limit-summary.Work)
+		summary.PrunedWeightChanges += pruned
+		summary.Work += pruned
+		if more {
+			summary.Complete = false
+			return
+		}
+		summary.ExpiredFundedAddresses = append(summary.ExpiredFundedAddresses, addr)
+	}
+}
+
+// isFundedAddressExpired returns true if the last weight change of the address removed it from
+// the funded addresses more than DistributionHistoryRetention blocks ago
+func (k Keeper) isFundedAddressExpired(ctx sdk.Context, addr string) bool {
+	expired := false
+	r := prefixRange(addressSequenceKey{}.Prefix(addr))
+	r.reverse = true
+	k.collections.fundedAddressHistory.Iterate(ctx, r, func(_ addressSequence, change types.FundedAddressWeightChange) bool {
+		expired = change.Action == types.WeightChangeRemoved &&
+			change.Height+types.DistributionHistoryRetention <= ctx.BlockHeight()
+		return true
+	})
+	return expired
+}
+
+// collectKeys returns up to limit keys of the iterator, more is true if keys are left behind.
+// The iterator is closed.
+func collectKeys(it storetypes.Iterator, limit uint64) (keys [][]byte, more bool) {
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if uint64(len(keys)) == limit {
+			return keys, true
+		}
+		keys = append(keys, it.Key())
+	}
+	return keys, false
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgRunMaintenance(t *testing.T) {
+	ctx, tk, ts := testSetups[0].setup(t)
+	params := tk.MintKeeper.GetParams(ctx)
+	signer := sample.Address(r)
+	height := int64(10)
+
+	t.Run("should run the maintenance and emit the summary", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(height)
+		res, err := ts.MintSrv.RunMaintenance(sdk.WrapSDKContext(ctx), types.NewMsgRunMaintenance(signer, 0))
+		require.NoError(t, err)
+		require.True(t, res.Summary.Complete)
+		require.Zero(t, res.Summary.Work)
+
+		last, found := tk.MintKeeper.GetLastMaintenanceHeight(ctx)
+		require.True(t, found)
+		require.EqualValues(t, height, last)
+		var event *types.EventMaintenance
+		for _, e := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(e))
+			if err != nil {
+				continue
+			}
+			if e, ok := msg.(*types.EventMaintenance); ok {
+				event = e
+			}
+		}
+		require.NotNil(t, event)
+		require.Equal(t, signer, event.Signer)
+		require.Equal(t, res.Summary.Work, event.Summary.Work)
+		require.Equal(t, res.Summary.Complete, event.Summary.Complete)
+	})
+
+	t.Run("should prevent running the maintenance before the interval", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(height + int64(params.MaintenanceInterval) - 1)
+		_, err := ts.MintSrv.RunMaintenance(sdk.WrapSDKContext(ctx), types.NewMsgRunMaintenance(signer, 0))
+		require.ErrorIs(t, err, types.ErrMaintenanceTooSoon)
+	})
+
+	t.Run("should run the maintenance once the interval elapsed", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(height + int64(params.MaintenanceInterval))
+		_, err := ts.MintSrv.RunMaintenance(sdk.WrapSDKContext(ctx), types.NewMsgRunMaintenance(signer, 0))
+		require.NoError(t, err)
+	})
+
+	t.Run("should prevent running the maintenance with an invalid signer", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(height + 2*int64(params.MaintenanceInterval))
+		_, err := ts.MintSrv.RunMaintenance(sdk.WrapSDKContext(ctx), types.NewMsgRunMaintenance("invalid", 0))
+		require.Error(t, err)
+	})
+}
+
+func TestRunMaintenancePartialWork(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	params := tk.MintKeeper.GetParams(ctx)
+	params.InflationSnapshotInterval = 1
+	params.InflationSnapshotRetention = 10
+	tk.MintKeeper.SetParams(ctx, params)
+
+	// the allocations of the paused heights and the snapshots left behind by a reduced retention
+	// are not pruned by the begin blocker
+	height := int64(types.DistributionHistoryRetention + 20)
+	for h := int64(1); h <= 30; h++ {
+		tk.MintKeeper.SetBlockDistribution(ctx, types.BlockDistribution{Height: h})
+	}
+	for h := height - 30; h <= height; h++ {
+		tk.MintKeeper.SetInflationSnapshot(ctx, types.InflationSnapshot{Height: h})
+	}
+
+	ctx = ctx.WithBlockHeight(height).WithGasMeter(sdk.NewInfiniteGasMeter())
+	summary, err := tk.MintKeeper.RunMaintenance(ctx, 15)
+	require.NoError(t, err)
+	require.False(t, summary.Complete)
+	require.EqualValues(t, 15, summary.Work)
+	require.EqualValues(t, 15, summary.PrunedDistributions)
+	require.Zero(t, summary.PrunedInflationSnapshots)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(15*types.MaintenanceGasPerWork))
+	_, found := tk.MintKeeper.GetBlockDistribution(ctx, 15)
+	require.False(t, found)
+	_, found = tk.MintKeeper.GetBlockDistribution(ctx, 16)
+	require.True(t, found)
+
+	// the next run completes the maintenance
+	ctx = ctx.WithBlockHeight(height + int64(params.MaintenanceInterval)).WithGasMeter(sdk.NewInfiniteGasMeter())
+	summary, err = tk.MintKeeper.RunMaintenance(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, summary.Complete)
+	require.EqualValues(t, 15, summary.PrunedDistributions)
+	require.EqualValues(t, 31, summary.PrunedInflationSnapshots)
+	require.EqualValues(t, summary.PrunedDistributions+summary.PrunedInflationSnapshots, summary.Work)
+	_, found = tk.MintKeeper.GetBlockDistribution(ctx, 30)
+	require.False(t, found)
+}
+
+func TestRunMaintenanceFlushPausedShares(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	params := types.DefaultParams()
+	params.FundedAddresses = []types.WeightedAddress{{Address: sample.Address(r), Weight: sdk.OneDec()}}
+	params.PauseStakingShare = true
+	params.PauseFundedShare = true
+	params.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+	tk.MintKeeper.SetParams(ctx, params)
+	tk.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+	require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+	paused := tk.MintKeeper.GetMinter(ctx).PausedShares
+	require.False(t, paused.Staking.IsZero())
+	require.False(t, paused.FundedAddresses.IsZero())
+
+	// the shares buffered while paused are flushed by the maintenance once the categories are resumed
+	params.PauseStakingShare = false
+	params.PauseFundedShare = false
+	tk.MintKeeper.SetParams(ctx, params)
+	feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	before := tk.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+
+	summary, err := tk.MintKeeper.RunMaintenance(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), 1)
+	require.NoError(t, err)
+	require.False(t, summary.Complete)
+	require.Zero(t, summary.Work)
+	require.True(t, summary.Flushed.IsZero())
+	require.Equal(t, paused, tk.MintKeeper.GetMinter(ctx).PausedShares)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.MaintenanceInterval))
+	summary, err = tk.MintKeeper.RunMaintenance(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), 2)
+	require.NoError(t, err)
+	require.True(t, summary.Complete)
+	require.EqualValues(t, 2, summary.Work)
+	require.True(t, summary.Flushed.IsEqual(paused.Staking.Add(paused.FundedAddresses...)))
+	require.True(t, tk.MintKeeper.GetMinter(ctx).PausedShares.Total().IsZero())
+	after := tk.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	require.True(t, after.Sub(before).Amount.Equal(paused.Staking.AmountOf(sdk.DefaultBondDenom)))
+
+	msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestRunMaintenanceExpiredFundedAddresses(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	removed, recentlyRemoved, funded := sample.Address(r), sample.Address(r), sample.Address(r)
+	params := tk.MintKeeper.GetParams(ctx)
+	params.FundedAddresses = []types.WeightedAddress{{Address: funded, Weight: sdk.OneDec()}}
+	tk.MintKeeper.SetParams(ctx, params)
+	for _, change := range []types.FundedAddressWeightChange{
+		{Address: removed, Height: 1, OldWeight: sdk.ZeroDec(), NewWeight: sdk.OneDec(), Action: types.WeightChangeAdded},
+		{Address: removed, Height: 2, OldWeight: sdk.OneDec(), NewWeight: sdk.NewDecWithPrec(5, 1), Action: types.WeightChangeUpdated},
+		{Address: removed, Height: 3, OldWeight: sdk.NewDecWithPrec(5, 1), NewWeight: sdk.ZeroDec(), Action: types.WeightChangeRemoved},
+		{Address: recentlyRemoved, Height: 1, OldWeight: sdk.ZeroDec(), NewWeight: sdk.OneDec(), Action: types.WeightChangeAdded},
+		{Address: recentlyRemoved, Height: 500, OldWeight: sdk.OneDec(), NewWeight: sdk.ZeroDec(), Action: types.WeightChangeRemoved},
+		{Address: funded, Height: 1, OldWeight: sdk.ZeroDec(), NewWeight: sdk.OneDec(), Action: types.WeightChangeAdded},
+	} {
+		tk.MintKeeper.AppendFundedAddressWeightChange(ctx, change)
+	}
+	ctx = ctx.WithBlockHeight(types.DistributionHistoryRetention + 3).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	// the changes of the expired address are partially deleted up to the limit
+	summary, err := tk.MintKeeper.RunMaintenance(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, summary.Complete)
+	require.EqualValues(t, 2, summary.PrunedWeightChanges)
+	require.Empty(t, summary.ExpiredFundedAddresses)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.MaintenanceInterval))
+	summary, err = tk.MintKeeper.RunMaintenance(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, summary.Complete)
+	require.EqualValues(t, 1, summary.PrunedWeightChanges)
+	require.Equal(t, []string{removed}, summary.ExpiredFundedAddresses)
+
+	changes, _, err := tk.MintKeeper.GetFundedAddressHistory(ctx, removed, nil)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	for _, addr := range []string{recentlyRemoved, funded} {
+		changes, _, err := tk.MintKeeper.GetFundedAddressHistory(ctx, addr, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, changes)
+	}
+}
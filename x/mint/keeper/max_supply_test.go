@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// mintCapped returns the EventMintCapped events of the block
+func mintCapped(t *testing.T, ctx sdk.Context) (events []*types.EventMintCapped) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventMintCapped" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, parsed.(*types.EventMintCapped))
+	}
+	return events
+}
+
+// mintShortfall returns the EventMintShortfall events of the block
+func mintShortfall(t *testing.T, ctx sdk.Context) (events []*types.EventMintShortfall) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventMintShortfall" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, parsed.(*types.EventMintShortfall))
+	}
+	return events
+}
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	third := sdk.MustNewDecFromStr("0.333333333333333333")
+
+	// cappedParams returns params minting 1% of the supply per block, with a max supply of 1035
+	// tokens and a share of funded addresses whose dust is assigned in round robin
+	cappedParams := func() types.Params {
+		params := lowInflationParams()
+		params.BlocksPerYear = 1
+		params.InflationMin = sdk.NewDecWithPrec(1, 2)
+		params.InflationMax = sdk.NewDecWithPrec(1, 2)
+		params.MaxSupply = sdkmath.NewInt(1035)
+		params.DistributionProportions = types.DistributionProportions{
+			Staking:         sdk.NewDecWithPrec(4, 1),
+			FundedAddresses: sdk.NewDecWithPrec(3, 1),
+			CommunityPool:   sdk.NewDecWithPrec(3, 1),
+		}
+		params.FundedAddresses = []types.WeightedAddress{
+			{Address: sample.Address(r), Weight: third},
+			{Address: sample.Address(r), Weight: third},
+			{Address: sample.Address(r), Weight: sdk.OneDec().Sub(third.MulInt64(2))},
+		}
+		params.DustAssignment = types.DUST_ASSIGNMENT_ROUND_ROBIN
+		return params
+	}
+
+	t.Run("should mint up to the max supply", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := cappedParams()
+		require.NoError(t, params.Validate())
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		tk.MintKeeper.SetMinter(sdkCtx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+		mintAddr := tk.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+		// the supplies after each block, the fourth block mints the 5 tokens of headroom
+		expected := []int64{1010, 1020, 1030, 1035, 1035, 1035}
+		for i, supply := range expected {
+			height := int64(i + 1)
+			ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+			total := tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+			require.True(t, total.LTE(params.MaxSupply), "height %d: supply %s", height, total)
+			require.Equal(t, sdkmath.NewInt(supply), total, "height %d", height)
+			require.True(t, tk.BankKeeper.GetAllBalances(ctx, mintAddr).IsZero(), "height %d", height)
+
+			capped := mintCapped(t, ctx)
+			switch height {
+			case 1, 2, 3:
+				require.Empty(t, capped)
+			case 4:
+				require.Equal(t, []*types.EventMintCapped{{
+					MaxSupply: params.MaxSupply,
+					Supply:    sdkmath.NewInt(1030),
+					Minted:    sdkmath.NewInt(5),
+					Capped:    sdkmath.NewInt(5),
+				}}, capped)
+			default:
+				require.Len(t, capped, 1)
+				require.True(t, capped[0].Minted.IsZero())
+			}
+		}
+
+		// the minted coins are distributed, the drift is reset by the max supply
+		minter := tk.MintKeeper.GetMinter(sdkCtx)
+		require.Equal(t, sdkmath.NewInt(35), minter.CumulativeMinted)
+		require.Equal(t, sdkmath.NewInt(35), minter.CumulativeDistributed.Total())
+		require.Equal(t, sdk.NewDec(35), minter.TargetCumulativeEmission)
+	})
+
+	t.Run("should mint nothing above the max supply", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := cappedParams()
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		tk.MintKeeper.SetMinter(sdkCtx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(2000))))
+
+		ctx := sdkCtx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(2000), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+		require.Len(t, mintCapped(t, ctx), 1)
+	})
+
+	t.Run("should estimate the distribution of the headroom", func(t *testing.T) {
+		sdkCtx, tk, _ := testSetups[0].setup(t)
+		params := cappedParams()
+		params.FundedAddresses = nil
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		tk.MintKeeper.SetMinter(sdkCtx, types.NewMinter(params.InflationMax, sdk.NewDec(10)))
+		fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1030))))
+
+		res, err := querier.NewQuerier(tk.MintKeeper).EstimatedDistribution(
+			sdk.WrapSDKContext(sdkCtx),
+			&types.QueryEstimatedDistributionRequest{},
+		)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 5), res.BlockProvision)
+		require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 2), res.Staking)
+		require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 3), res.CommunityPool)
+	})
+}
+
+func TestBeginBlockerShortfallPolicy(t *testing.T) {
+	shares := func(staking, funded, communityPool int64) types.CategoryTotals {
+		totals := types.NewCategoryTotals()
+		totals.Staking = sdkmath.NewInt(staking)
+		totals.FundedAddresses = sdkmath.NewInt(funded)
+		totals.CommunityPool = sdkmath.NewInt(communityPool)
+		return totals
+	}
+
+	for _, tc := range []struct {
+		name     string
+		policy   types.ShortfallPolicy
+		priority []string
+		// allocated and shortfall of the categories in the capped blocks 3 and 4
+		allocated []types.CategoryTotals
+		shortfall []types.CategoryTotals
+	}{
+		{
+			name:      "pro rata",
+			policy:    types.SHORTFALL_POLICY_PRO_RATA,
+			priority:  types.DefaultShortfallPriority,
+			allocated: []types.CategoryTotals{shares(24, 14, 11), shares(0, 0, 0)},
+			shortfall: []types.CategoryTotals{shares(27, 16, 10), shares(51, 30, 21)},
+		},
+		{
+			name:      "priority",
+			policy:    types.SHORTFALL_POLICY_PRIORITY,
+			priority:  []string{types.CategoryCommunityPool, types.CategoryFundedAddresses, types.CategoryStaking},
+			allocated: []types.CategoryTotals{shares(0, 28, 21), shares(0, 0, 0)},
+			shortfall: []types.CategoryTotals{shares(51, 2, 0), shares(51, 30, 21)},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdkCtx, tk, _ := testSetups[0].setup(t)
+
+			// the params mint 1% of the supply per block, the supply of 10000 tokens reaches the
+			// max supply of 10250 tokens in the third block
+			params := lowInflationParams()
+			params.BlocksPerYear = 1
+			params.InflationMin = sdk.NewDecWithPrec(1, 2)
+			params.InflationMax = sdk.NewDecWithPrec(1, 2)
+			params.MaxSupply = sdkmath.NewInt(10250)
+			params.DistributionProportions = types.DistributionProportions{
+				Staking:         sdk.NewDecWithPrec(5, 1),
+				FundedAddresses: sdk.NewDecWithPrec(3, 1),
+				CommunityPool:   sdk.NewDecWithPrec(2, 1),
+			}
+			params.FundedAddresses = []types.WeightedAddress{{Address: sample.Address(r), Weight: sdk.OneDec()}}
+			params.ShortfallPolicy = tc.policy
+			params.ShortfallPriority = tc.priority
+			require.NoError(t, params.Validate())
+			tk.MintKeeper.SetParams(sdkCtx, params)
+			tk.MintKeeper.SetMinter(sdkCtx, types.InitialMinter(params.InflationMax))
+			fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(10000))))
+
+			// the blocks below the max supply are distributed by the proportions under both
+			// policies
+			uncapped := []types.CategoryTotals{shares(50, 30, 20), shares(50, 30, 21)}
+			for i, expected := range uncapped {
+				height := int64(i + 1)
+				ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				require.Empty(t, mintShortfall(t, ctx), "height %d", height)
+
+				distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, height)
+				require.True(t, found)
+				require.Equal(t, expected, distribution.Distributed, "height %d", height)
+			}
+
+			for i := range tc.allocated {
+				height := int64(len(uncapped) + i + 1)
+				ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				require.Equal(t, []*types.EventMintShortfall{{
+					Policy:    tc.policy,
+					Allocated: tc.allocated[i],
+					Shortfall: tc.shortfall[i],
+				}}, mintShortfall(t, ctx), "height %d", height)
+
+				if tc.allocated[i].Total().IsPositive() {
+					distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, height)
+					require.True(t, found)
+					require.Equal(t, tc.allocated[i], distribution.Distributed, "height %d", height)
+				}
+			}
+			require.Equal(t, params.MaxSupply, tk.BankKeeper.GetSupply(sdkCtx, params.MintDenom).Amount)
+		})
+	}
+}
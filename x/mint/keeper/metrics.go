@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// displayAmount returns the amount of a denom in the display denom of its metadata, or in the
+// denom itself if it has no metadata. The amounts are reported as floats of the display denom so
+// large amounts of base units don't overflow the metric sinks.
+func (k Keeper) displayAmount(ctx sdk.Context, denom string, amount sdk.Dec) float32 {
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				amount = amount.QuoInt(sdkmath.NewIntWithDecimal(1, int(unit.Exponent)))
+				break
+			}
+		}
+	}
+	return decFloat32(amount)
+}
+
+// setMintMetrics sets the gauges of the minter and of the coins minted for the block, the metrics
+// of the dry run of the begin blocker are not reported
+func (k Keeper) setMintMetrics(ctx sdk.Context, minter types.Minter, bondedRatio sdk.Dec, mintedCoin sdk.Coin) {
+	if isDryRun(ctx) {
+		return
+	}
+	telemetry.ModuleSetGauge(types.ModuleName, k.displayAmount(ctx, mintedCoin.Denom, sdk.NewDecFromInt(mintedCoin.Amount)), types.MetricKeyMintedTokens)
+	telemetry.ModuleSetGauge(types.ModuleName, decFloat32(minter.Inflation), types.MetricKeyInflation)
+	telemetry.ModuleSetGauge(types.ModuleName, k.displayAmount(ctx, mintedCoin.Denom, minter.AnnualProvisions), types.MetricKeyAnnualProvisions)
+	telemetry.ModuleSetGauge(types.ModuleName, decFloat32(bondedRatio), types.MetricKeyBondedRatio)
+}
+
+// incrDistributionMetrics increments the counters of the tokens distributed to each destination,
+// the shares of the funded addresses are labeled by address up to MetricMaxFundedAddressLabels
+// addresses and summed beyond
+func (k Keeper) incrDistributionMetrics(
+	ctx sdk.Context,
+	denom string,
+	staking, communityPool sdkmath.Int,
+	fundedAddresses []types.FundedAddressDistribution,
+) {
+	if isDryRun(ctx) {
+		return
+	}
+	incr := func(destination, address string, amount sdkmath.Int) {
+		labels := []metrics.Label{
+			telemetry.NewLabel(types.MetricLabelModule, types.ModuleName),
+			telemetry.NewLabel(types.MetricLabelDenom, denom),
+			telemetry.NewLabel(types.MetricLabelDestination, destination),
+		}
+		if address != "" {
+			labels = append(labels, telemetry.NewLabel(types.MetricLabelAddress, address))
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{types.MetricKeyDistributedTokens},
+			k.displayAmount(ctx, denom, sdk.NewDecFromInt(amount)),
+			labels,
+		)
+	}
+
+	incr(types.MetricDestinationStaking, "", staking)
+	incr(types.MetricDestinationCommunityPool, "", communityPool)
+	if len(fundedAddresses) > types.MetricMaxFundedAddressLabels {
+		total := sdkmath.ZeroInt()
+		for _, fundedAddress := range fundedAddresses {
+			total = total.Add(fundedAddress.Amount.AmountOf(denom))
+		}
+		incr(types.MetricDestinationFundedAddress, types.MetricAddressOther, total)
+		return
+	}
+	for _, fundedAddress := range fundedAddresses {
+		incr(types.MetricDestinationFundedAddress, fundedAddress.Address, fundedAddress.Amount.AmountOf(denom))
+	}
+}
+
+// decFloat32 returns a decimal as a float of a metric
+func decFloat32(d sdk.Dec) float32 {
+	value, err := d.Float64()
+	if err != nil {
+		return 0
+	}
+	return float32(value)
+}
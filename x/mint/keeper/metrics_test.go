@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"flag"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+// metricsRecorder is a metric sink recording the kind, the name and the labels of the metrics
+// emitted by the module, the metrics labeled with the name of the module
+type metricsRecorder struct {
+	mu      sync.Mutex
+	metrics map[string]bool
+}
+
+func (r *metricsRecorder) record(kind string, key []string, labels []metrics.Label) {
+	pairs := make([]string, len(labels))
+	module := false
+	for i, label := range labels {
+		pairs[i] = label.Name + "=" + label.Value
+		module = module || label.Value == types.ModuleName
+	}
+	if !module {
+		return
+	}
+	sort.Strings(pairs)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.metrics[kind+" "+strings.Join(key, ".")+" {"+strings.Join(pairs, ",")+"}"] = true
+}
+
+func (r *metricsRecorder) SetGauge(key []string, val float32) { r.SetGaugeWithLabels(key, val, nil) }
+func (r *metricsRecorder) SetGaugeWithLabels(key []string, _ float32, labels []metrics.Label) {
+	r.record("gauge", key, labels)
+}
+func (r *metricsRecorder) EmitKey(key []string, _ float32) { r.record("key", key, nil) }
+func (r *metricsRecorder) IncrCounter(key []string, val float32) {
+	r.IncrCounterWithLabels(key, val, nil)
+}
+func (r *metricsRecorder) IncrCounterWithLabels(key []string, _ float32, labels []metrics.Label) {
+	r.record("counter", key, labels)
+}
+func (r *metricsRecorder) AddSample(key []string, val float32) { r.AddSampleWithLabels(key, val, nil) }
+func (r *metricsRecorder) AddSampleWithLabels(key []string, _ float32, labels []metrics.Label) {
+	r.record("sample", key, labels)
+}
+
+// recordMetrics records the metrics emitted until the end of the test in the global metric sink
+func recordMetrics(t *testing.T) *metricsRecorder {
+	recorder := &metricsRecorder{metrics: make(map[string]bool)}
+	conf := metrics.DefaultConfig("")
+	conf.EnableHostname = false
+	conf.EnableHostnameLabel = false
+	conf.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(conf, recorder)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := metrics.NewGlobal(conf, &metrics.BlackholeSink{})
+		require.NoError(t, err)
+	})
+	return recorder
+}
+
+// TestMetricsStability snapshots the metrics emitted by the blocks of a chain with all the
+// features of the module active, the profiling included. The names and the labels of the metrics are an API for the
+// dashboards of the operators, the golden file is updated with -update when they deliberately
+// change.
+func TestMetricsStability(t *testing.T) {
+	sdkCtx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, keeper.WithProfiling())
+	tk.MintKeeper.SetHooks(testkeeper.NewMintHooksMock())
+
+	// the addresses are labels of the metrics, they don't depend on the tests run before
+	addrRand := rand.New(rand.NewSource(1))
+	params := phasedParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(5, 1),
+		FundedAddresses: sdk.NewDecWithPrec(3, 1),
+		CommunityPool:   sdk.NewDecWithPrec(2, 1),
+	}
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: sample.Address(addrRand), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: sample.Address(addrRand), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	params.MinAnnualCommunityFunding = sdk.NewInt64Coin(params.MintDenom, 100_000)
+	params.MinDistributableProvision = sdkmath.NewInt(10)
+	params.DriftCorrection = types.DriftCorrection{MaxFactor: sdk.NewDecWithPrec(1, 1), Horizon: 10}
+	require.NoError(t, params.Validate())
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	tk.MintKeeper.SetMinter(sdkCtx, types.InitialMinter(sdk.NewDecWithPrec(13, 2)))
+	fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000))))
+
+	recorder := recordMetrics(t)
+	for height := int64(1); height <= 12; height++ {
+		ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+	}
+
+	names := make([]string, 0, len(recorder.metrics))
+	for name := range recorder.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	output := []byte(strings.Join(names, "\n") + "\n")
+
+	path := filepath.Join("testdata", "metrics.golden")
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll("testdata", 0o750))
+		require.NoError(t, os.WriteFile(path, output, 0o600))
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(output), "the metrics changed, run the tests with -update if deliberate")
+}
+
+// gatherMetrics returns the gauges and the counters gathered by the in-memory sink of the
+// telemetry by their name and labels
+func gatherMetrics(t *testing.T, m *telemetry.Metrics) (gauges, counters map[string]float32) {
+	res, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+	var summary metrics.MetricsSummary
+	require.NoError(t, json.Unmarshal(res.Metrics, &summary))
+
+	name := func(name string, labels map[string]string) string {
+		pairs := make([]string, 0, len(labels))
+		for key, value := range labels {
+			if key != types.MetricLabelModule {
+				pairs = append(pairs, key+"="+value)
+			}
+		}
+		sort.Strings(pairs)
+		return name + "{" + strings.Join(pairs, ",") + "}"
+	}
+	gauges = make(map[string]float32)
+	for _, gauge := range summary.Gauges {
+		gauges[name(gauge.Name, gauge.DisplayLabels)] = gauge.Value
+	}
+	counters = make(map[string]float32)
+	for _, counter := range summary.Counters {
+		counters[name(counter.Name, counter.DisplayLabels)] = float32(counter.Sum)
+	}
+	return gauges, counters
+}
+
+func TestMetrics(t *testing.T) {
+	enableTelemetry := func(t *testing.T) *telemetry.Metrics {
+		m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, err := metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+			require.NoError(t, err)
+		})
+		return m
+	}
+	fundedParams := func(fundedAddresses int) types.Params {
+		params := types.DefaultParams()
+		params.DistributionProportions = types.DistributionProportions{
+			Staking:          sdk.NewDecWithPrec(5, 1),
+			FundedAddresses:  sdk.NewDecWithPrec(3, 1),
+			CommunityPool:    sdk.NewDecWithPrec(2, 1),
+			StrategicReserve: sdk.ZeroDec(),
+		}
+		for i := 0; i < fundedAddresses; i++ {
+			params.FundedAddresses = append(params.FundedAddresses, types.WeightedAddress{
+				Address: sample.Address(r),
+				Weight:  sdk.OneDec().QuoInt64(int64(fundedAddresses)),
+			})
+		}
+		params.FundedAddresses[0].Weight = params.FundedAddresses[0].Weight.Add(
+			sdk.OneDec().Sub(params.FundedAddresses[0].Weight.MulInt64(int64(fundedAddresses))),
+		)
+		return params
+	}
+
+	t.Run("should emit the amounts in the display denom", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := fundedParams(2)
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			Base:    params.MintDenom,
+			Display: "mega" + params.MintDenom,
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: params.MintDenom, Exponent: 0},
+				{Denom: "mega" + params.MintDenom, Exponent: 6},
+			},
+		})
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000_000_000))))
+		m := enableTelemetry(t)
+
+		ctx = ctx.WithBlockHeight(1)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		minter := tk.MintKeeper.GetMinter(ctx)
+		distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, 1)
+		require.True(t, found)
+
+		mega := func(amount sdk.Dec) float32 {
+			value, err := amount.QuoInt64(1_000_000).Float64()
+			require.NoError(t, err)
+			return float32(value)
+		}
+		gauges, counters := gatherMetrics(t, m)
+		inflation, err := minter.Inflation.Float64()
+		require.NoError(t, err)
+		require.Equal(t, float32(inflation), gauges["test.inflation{}"])
+		require.Equal(t, mega(minter.AnnualProvisions), gauges["test.annual_provisions{}"])
+		require.Equal(t, mega(sdk.NewDecFromInt(distribution.Minted)), gauges["test.minted_tokens{}"])
+		require.Contains(t, gauges, "test.bonded_ratio{}")
+
+		require.Equal(t, mega(sdk.NewDecFromInt(distribution.Distributed.Staking)),
+			counters["test.distributed_tokens{denom=stake,destination=staking}"])
+		require.Equal(t, mega(sdk.NewDecFromInt(distribution.Distributed.CommunityPool)),
+			counters["test.distributed_tokens{denom=stake,destination=community_pool}"])
+		for _, fundedAddress := range distribution.FundedAddresses {
+			key := "test.distributed_tokens{address=" + fundedAddress.Address + ",denom=stake,destination=funded_address}"
+			require.Equal(t, mega(sdk.NewDecFromInt(fundedAddress.Amount.AmountOf(params.MintDenom))), counters[key])
+		}
+	})
+
+	t.Run("should sum the funded addresses beyond the maximum number of labels", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := fundedParams(types.MetricMaxFundedAddressLabels + 1)
+		require.NoError(t, params.Validate())
+		tk.MintKeeper.SetParams(ctx, params)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000_000_000))))
+		m := enableTelemetry(t)
+
+		ctx = ctx.WithBlockHeight(1)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, 1)
+		require.True(t, found)
+
+		_, counters := gatherMetrics(t, m)
+		var fundedKeys []string
+		for key := range counters {
+			if strings.Contains(key, "destination=funded_address") {
+				fundedKeys = append(fundedKeys, key)
+			}
+		}
+		key := "test.distributed_tokens{address=other,denom=stake,destination=funded_address}"
+		require.Equal(t, []string{key}, fundedKeys)
+		total := sdkmath.ZeroInt()
+		for _, fundedAddress := range distribution.FundedAddresses {
+			total = total.Add(fundedAddress.Amount.AmountOf(params.MintDenom))
+		}
+		require.True(t, total.IsPositive())
+		require.Equal(t, float32(total.Int64()), counters[key])
+	})
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by building the summary of the mint state
+// from the minter and the params.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.migrate(ctx, 1, func() error {
+		m.keeper.RefreshSummary(ctx)
+		return nil
+	})
+}
+
+// Migrate2to3 migrates from version 2 to 3 by booking the dust held in the module account in
+// the dust entry of the ledger.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.migrate(ctx, 2, func() error {
+		m.keeper.bookUnaccountedDust(ctx)
+		return nil
+	})
+}
+
+// Migrate3to4 migrates from version 3 to 4 by setting the params added since version 3 to their
+// default value, like before each migration.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.keeper.migrate(ctx, 3, func() error {
+		return nil
+	})
+}
+
+// Migrate4to5 migrates from version 4 to 5 by setting the funding windows of the funded addresses
+// to zero heights, the funded addresses stored before version 5 stay funded at every height.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return m.keeper.migrate(ctx, 4, func() error {
+		m.keeper.resetFundingWindows(ctx)
+		return nil
+	})
+}
+
+// Migrate5to6 migrates from version 5 to 6 by setting the mint configurations to an empty list:
+// the single mint denom layout of the params becomes the mint configuration of the mint denom.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return m.keeper.migrate(ctx, 5, func() error {
+		return nil
+	})
+}
+
+// Migrate6to7 migrates from version 6 to 7 by converting the store of the cosmos-sdk x/mint
+// module, the store of the module is left unchanged. A chain replacing the cosmos-sdk x/mint
+// module sets the version of the module to types.SDKMintSchemaVersion in its upgrade handler.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	sdkMintStore := m.keeper.isSDKMintStore(ctx)
+	return m.keeper.migrate(ctx, 6, func() error {
+		if sdkMintStore {
+			return m.keeper.migrateSDKMintStore(ctx)
+		}
+		return nil
+	})
+}
+
+// RepairStore re-derives the derived records of a store at the current schema version from its
+// authoritative records, the params, the minter and the balance of the module account. It can
+// be run from an upgrade handler after a faulty migration. The repair is idempotent, the report
+// of a consistent store is empty.
+func (m Migrator) RepairStore(ctx sdk.Context) (types.RepairReport, error) {
+	return m.keeper.repairStore(ctx)
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// variableBondedRatioStakingKeeper overrides the bonded ratio of the staking keeper, the ratio can
+// be changed between blocks
+type variableBondedRatioStakingKeeper struct {
+	types.StakingKeeper
+	bondedRatio *sdk.Dec
+}
+
+func (k variableBondedRatioStakingKeeper) BondedRatio(sdk.Context) sdk.Dec {
+	return *k.bondedRatio
+}
+
+// mintSkippedEvents returns the EventMintSkipped events emitted in the context
+func mintSkippedEvents(t *testing.T, ctx sdk.Context) []types.EventMintSkipped {
+	var events []types.EventMintSkipped
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventMintSkipped); ok {
+			events = append(events, *e)
+		}
+	}
+	return events
+}
+
+func TestBeginBlockerMinBondedRatio(t *testing.T) {
+	bondedRatio := sdk.NewDecWithPrec(6, 1)
+	ctx, tk, _ := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+		return variableBondedRatioStakingKeeper{StakingKeeper: sk, bondedRatio: &bondedRatio}
+	})
+
+	// the block provision is about 10 tokens, with a drift correction that would catch up the
+	// skipped blocks if they were counted in the target emissions
+	params := lowInflationParams()
+	params.BlocksPerYear = 10
+	params.MinBondedRatio = sdk.NewDecWithPrec(5, 1)
+	params.DriftCorrection = types.DriftCorrection{MaxFactor: sdk.NewDecWithPrec(5, 1), Horizon: 10}
+	tk.MintKeeper.SetParams(ctx, params)
+	tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+	fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+
+	for _, block := range []struct {
+		name        string
+		bondedRatio sdk.Dec
+		skipped     bool
+	}{
+		{name: "above the floor", bondedRatio: sdk.NewDecWithPrec(6, 1)},
+		{name: "at the floor", bondedRatio: sdk.NewDecWithPrec(5, 1)},
+		{name: "dipping below the floor", bondedRatio: sdk.NewDecWithPrec(3, 1), skipped: true},
+		{name: "staying below the floor", bondedRatio: sdk.NewDecWithPrec(2, 1), skipped: true},
+		{name: "just below the floor", bondedRatio: sdk.MustNewDecFromStr("0.499999999999999999"), skipped: true},
+		{name: "recovering above the floor", bondedRatio: sdk.NewDecWithPrec(7, 1)},
+		{name: "staying above the floor", bondedRatio: sdk.NewDecWithPrec(7, 1)},
+	} {
+		height := ctx.BlockHeight() + 1
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		bondedRatio = block.bondedRatio
+		before := tk.MintKeeper.GetMinter(ctx)
+		supply := tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx), block.name)
+
+		minter := tk.MintKeeper.GetMinter(ctx)
+		require.Equal(t, height, minter.LastBlockInputs.Height, block.name)
+		require.Equal(t, block.bondedRatio, minter.LastBlockInputs.BondedRatio, block.name)
+		minted := tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.Sub(supply)
+		if block.skipped {
+			require.Equal(t, []types.EventMintSkipped{{
+				BondedRatio:    block.bondedRatio,
+				MinBondedRatio: params.MinBondedRatio,
+			}}, mintSkippedEvents(t, ctx), block.name)
+			require.True(t, minted.IsZero(), block.name)
+			require.Equal(t, before.CumulativeMinted, minter.CumulativeMinted, block.name)
+			require.Equal(t, before.TargetCumulativeEmission, minter.TargetCumulativeEmission, block.name)
+			require.Equal(t, before.CarryBuffer, minter.CarryBuffer, block.name)
+			continue
+		}
+		require.Empty(t, mintSkippedEvents(t, ctx), block.name)
+
+		// the minting resumes at the block provision without catching up the skipped blocks
+		require.Equal(t, sdkmath.NewInt(10), minted, block.name)
+		require.Equal(t, minted, mintEvent(t, ctx).Amount, block.name)
+		res, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+		require.False(t, broken, res)
+	}
+	require.Equal(t, sdkmath.NewInt(40), tk.MintKeeper.GetMinter(ctx).CumulativeMinted)
+}
+
+func TestProjectionsMinBondedRatio(t *testing.T) {
+	bondedRatio := sdk.NewDecWithPrec(3, 1)
+	ctx, tk, _ := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+		return variableBondedRatioStakingKeeper{StakingKeeper: sk, bondedRatio: &bondedRatio}
+	})
+	params := lowInflationParams()
+	params.BlocksPerYear = 10
+	params.MinBondedRatio = sdk.NewDecWithPrec(5, 1)
+	tk.MintKeeper.SetParams(ctx, params)
+	tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+	fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+	q := querier.NewQuerier(tk.MintKeeper)
+
+	t.Run("should project no provision below the min bonded ratio", func(t *testing.T) {
+		upcoming, err := q.UpcomingProvisions(sdk.WrapSDKContext(ctx), &types.QueryUpcomingProvisionsRequest{Count: 3})
+		require.NoError(t, err)
+		require.Equal(t, []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()}, upcoming.Provisions)
+
+		impact, err := q.ParamsImpact(sdk.WrapSDKContext(ctx), &types.QueryParamsImpactRequest{ProposedParams: params})
+		require.NoError(t, err)
+		require.True(t, impact.Current.Total.IsZero())
+		require.True(t, impact.Proposed.Total.IsZero())
+
+		// the begin blocker skips the minting of the simulated blocks
+		supply := tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+1)))
+		require.Equal(t, supply, tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	})
+
+	t.Run("should project the provisions at the min bonded ratio", func(t *testing.T) {
+		bondedRatio = params.MinBondedRatio
+		upcoming, err := q.UpcomingProvisions(sdk.WrapSDKContext(ctx), &types.QueryUpcomingProvisionsRequest{Count: 3})
+		require.NoError(t, err)
+		for _, provision := range upcoming.Provisions {
+			require.Equal(t, sdkmath.NewInt(10), provision)
+		}
+
+		impact, err := q.ParamsImpact(sdk.WrapSDKContext(ctx), &types.QueryParamsImpactRequest{ProposedParams: params})
+		require.NoError(t, err)
+		require.True(t, impact.Current.Total.IsPositive())
+		require.True(t, impact.Delta.IsZero())
+	})
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// mintConfiguredDenoms mints the coins of the denoms of the mint configurations for the previous
+// block, in the order of the configurations
+func (k Keeper) mintConfiguredDenoms(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if len(params.MintConfigs) == 0 {
+		return nil
+	}
+	bondedRatio := k.BondedRatio(ctx)
+	for _, config := range params.MintConfigs {
+		if err := k.mintConfiguredDenom(ctx, params, config, bondedRatio); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mintConfiguredDenom mints the coins of the denom of a mint configuration for the previous block.
+// The inflation of the denom follows the inflation schedule of the configuration and its annual
+// provisions are computed against the bank supply of the denom. Like the mint denom, the denom is
+// not minted while minting is paused or the bonded ratio is below the min bonded ratio, the blocks
+// per year of a pending transition are interpolated and the provisions accrue in the carry buffer
+// until the end of the minting interval. The fractional part of the provisions is carried over to
+// the next block.
+func (k Keeper) mintConfiguredDenom(ctx sdk.Context, params types.Params, config types.MintConfig, bondedRatio sdk.Dec) error {
+	params = params.WithMintConfig(config)
+	minter := k.GetMinter(ctx)
+	denomMinter := minter.GetDenomMinter(config)
+	denomMinter.Inflation = types.Minter{Inflation: denomMinter.Inflation}.NextInflationRate(params, bondedRatio)
+	denomMinter.AnnualProvisions = denomMinter.Inflation.MulInt(k.bankKeeper.GetSupply(ctx, config.MintDenom).Amount)
+
+	// the minter of the denom keeps tracking the inflation while minting is paused or skipped
+	if params.PauseMinting || (params.MinBondedRatio.IsPositive() && bondedRatio.LT(params.MinBondedRatio)) {
+		minter.SetDenomMinter(denomMinter)
+		k.SetMinter(ctx, minter)
+		return nil
+	}
+
+	provisionParams := minter.ProvisionParams(params, ctx.BlockHeight())
+	provision := denomMinter.CarryBuffer.Add(denomMinter.AnnualProvisions.QuoInt64(int64(provisionParams.BlocksPerYear)))
+	if !params.IsMintingHeight(ctx.BlockHeight()) {
+		denomMinter.CarryBuffer = provision
+		minter.SetDenomMinter(denomMinter)
+		k.SetMinter(ctx, minter)
+		return nil
+	}
+
+	mintedCoin := sdk.NewCoin(config.MintDenom, provision.TruncateInt())
+	denomMinter.CarryBuffer = provision.Sub(sdk.NewDecFromInt(mintedCoin.Amount))
+	denomMinter.CumulativeMinted = denomMinter.CumulativeMinted.Add(mintedCoin.Amount)
+	minter.SetDenomMinter(denomMinter)
+
+	// the minted amount is counted and the coins are minted and distributed on a branch of the
+	// context written once the distribution succeeded, so a failed distribution mints no coin
+	mintCtx, write := ctx.CacheContext()
+	k.SetMinter(mintCtx, minter)
+	if mintedCoin.IsPositive() {
+		if err := k.MintCoin(mintCtx, mintedCoin); err != nil {
+			return err
+		}
+		if err := k.distributeConfiguredDenom(mintCtx, params, mintedCoin); err != nil {
+			return err
+		}
+	}
+	write()
+
+	if mintedCoin.IsPositive() && k.hooks != nil && !isDryRun(ctx) {
+		if err := k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDenomMint{
+		Denom:            config.MintDenom,
+		BondedRatio:      bondedRatio,
+		Inflation:        denomMinter.Inflation,
+		AnnualProvisions: denomMinter.AnnualProvisions,
+		Amount:           mintedCoin.Amount,
+	})
+}
+
+// distributeConfiguredDenom distributes the minted coins of the denom of a mint configuration by
+// the distribution proportions of the params with the mint configuration applied. The staking
+// share is sent to the fee collector, the funded addresses share to the funded addresses by weight
+// and the strategic reserve share is kept in the module account. The truncation remainder of the
+// funded addresses share, the share of a funded address outside of its funding window and the
+// funded addresses share without funded address fund the community pool. The params with mint
+// configurations can't pause the distribution categories or set another dust policy.
+func (k Keeper) distributeConfiguredDenom(ctx sdk.Context, params types.Params, mintedCoin sdk.Coin) error {
+	minter := k.GetMinter(ctx)
+	proportions := params.DistributionProportions
+	var allocations allocationIndex
+
+	stakingCoins := sdk.NewCoins(k.GetProportion(ctx, mintedCoin, proportions.Staking))
+	fundedAddrsCoin := k.GetProportion(ctx, mintedCoin, proportions.FundedAddresses)
+	reserveCoins := sdk.NewCoins(k.GetProportion(ctx, mintedCoin, proportions.StrategicReserveRatio()))
+	communityPoolCoins := sdk.NewCoins(mintedCoin).
+		Sub(stakingCoins...).
+		Sub(sdk.NewCoins(fundedAddrsCoin)...).
+		Sub(reserveCoins...)
+
+	minter.Book(types.LedgerEntryStrategicReserve, reserveCoins)
+	fundedShares, dustCoins, err := k.fundedAddressShares(ctx, params.FundedAddresses, sdk.NewCoins(fundedAddrsCoin))
+	if err != nil {
+		return err
+	}
+	if !stakingCoins.IsZero() {
+		_, redirectedCoins, err := k.sendStakingShare(ctx, params, stakingCoins, allocations.next())
+		if err != nil {
+			return errorsignite.Wrapf(types.ErrDistributionFailed, "staking share: %s", err)
+		}
+		stakingCoins = stakingCoins.Sub(redirectedCoins...)
+		communityPoolCoins = communityPoolCoins.Add(redirectedCoins...)
+	}
+
+	var fundedAddresses []types.FundedAddressDistribution
+	if len(params.FundedAddresses) == 0 {
+		communityPoolCoins = communityPoolCoins.Add(fundedAddrsCoin)
+	} else if fundedAddrsCoin.IsPositive() {
+		fundedAddresses = make([]types.FundedAddressDistribution, len(params.FundedAddresses))
+		for i, w := range params.FundedAddresses {
+			index := allocations.next()
+			fundedAddrCoins := fundedShares[i].coins
+			if !w.IsFundedAt(ctx.BlockHeight()) {
+				fundedAddresses[i] = types.FundedAddressDistribution{Address: w.Address, Amount: sdk.NewCoins()}
+				communityPoolCoins = communityPoolCoins.Add(fundedAddrCoins...)
+				continue
+			}
+			fundedAddresses[i] = types.FundedAddressDistribution{Address: w.Address, Amount: fundedAddrCoins}
+			if w.PayoutMode == types.PAYOUT_MODE_PULL {
+				minter.BookPayout(w.Address, ctx.BlockHeight(), fundedAddrCoins)
+				continue
+			}
+			recipient, blocked, err := k.restrictPayout(ctx, &minter, w.Address, fundedShares[i].account, fundedAddrCoins, index)
+			if err != nil {
+				return err
+			}
+			if blocked {
+				continue
+			}
+			err = transferWithAllocationIndex(ctx, index, func(ctx sdk.Context) error {
+				return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, fundedAddrCoins)
+			})
+			if err != nil {
+				return errorsignite.Wrapf(types.ErrDistributionFailed, "funded address %s: %s", w.Address, err)
+			}
+			fundedAddresses[i].Recipient = recipient.String()
+		}
+		communityPoolCoins = communityPoolCoins.Add(dustCoins...)
+	}
+
+	if !communityPoolCoins.IsZero() {
+		err := transferWithAllocationIndex(ctx, allocations.next(), func(ctx sdk.Context) error {
+			return k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		})
+		if err != nil {
+			return errorsignite.Wrapf(types.ErrDistributionFailed, "community pool: %s", err)
+		}
+	}
+	k.SetMinter(ctx, minter)
+
+	denom := mintedCoin.Denom
+	k.incrDistributionMetrics(ctx, denom, stakingCoins.AmountOf(denom), communityPoolCoins.AmountOf(denom), fundedAddresses)
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintDistribution{
+		Minted:           mintedCoin,
+		Staking:          sdk.NewCoin(denom, stakingCoins.AmountOf(denom)),
+		CommunityPool:    sdk.NewCoin(denom, communityPoolCoins.AmountOf(denom)),
+		FundedAddresses:  fundedAddresses,
+		Dust:             sdk.NewCoin(denom, sdk.ZeroInt()),
+		StrategicReserve: sdk.NewCoin(denom, reserveCoins.AmountOf(denom)),
+	})
+}
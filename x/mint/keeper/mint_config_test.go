@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// fooMintConfig returns the mint configuration of the foo denom pinning its inflation to 20% and
+// splitting its minted coins between the staking and the community pool
+func fooMintConfig() types.MintConfig {
+	return types.NewMintConfig(
+		"foo",
+		sdk.NewDecWithPrec(13, 2),
+		sdk.NewDecWithPrec(2, 1),
+		sdk.NewDecWithPrec(2, 1),
+		sdk.NewDecWithPrec(67, 2),
+		types.DistributionProportions{
+			Staking:          sdk.NewDecWithPrec(5, 1),
+			FundedAddresses:  sdk.ZeroDec(),
+			CommunityPool:    sdk.NewDecWithPrec(5, 1),
+			StrategicReserve: sdk.ZeroDec(),
+		},
+	)
+}
+
+func TestMintConfigs(t *testing.T) {
+	t.Run("should mint each denom independently", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(
+			sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000)),
+			sdk.NewCoin("foo", sdkmath.NewInt(1000)),
+		))
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(1010), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+		require.Equal(t, sdkmath.NewInt(1020), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+
+		feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		require.Equal(t, sdkmath.NewInt(10), tk.BankKeeper.GetBalance(ctx, feeCollector, "foo").Amount)
+		communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+		require.Equal(t, sdkmath.NewInt(10), communityPool.AmountOf("foo"))
+
+		minter := tk.MintKeeper.GetMinter(ctx)
+		require.Equal(t, sdkmath.NewInt(10), minter.CumulativeMinted)
+		denomMinter := minter.GetDenomMinter(fooMintConfig())
+		require.Equal(t, sdk.NewDecWithPrec(2, 1), denomMinter.Inflation)
+		require.Equal(t, sdk.NewDec(200), denomMinter.AnnualProvisions)
+		require.Equal(t, sdkmath.NewInt(20), denomMinter.CumulativeMinted)
+		require.Equal(t, sdk.ZeroDec(), denomMinter.CarryBuffer)
+
+		var denomMint *types.EventDenomMint
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			event, err := sdk.ParseTypedEvent(abci.Event(e))
+			if err != nil {
+				continue
+			}
+			if ev, ok := event.(*types.EventDenomMint); ok {
+				denomMint = ev
+			}
+		}
+		require.NotNil(t, denomMint)
+		require.Equal(t, "foo", denomMint.Denom)
+		require.Equal(t, sdkmath.NewInt(20), denomMint.Amount)
+
+		msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+		require.False(t, broken, msg)
+	})
+
+	t.Run("should carry the fractional provisions of a denom", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := lowInflationParams()
+		params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(1000))))
+
+		// 200 annual provisions over 300 blocks is 2/3 token per block
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(1000), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(1001), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+		denomMinter := tk.MintKeeper.GetMinter(ctx).GetDenomMinter(fooMintConfig())
+		require.Equal(t, sdkmath.OneInt(), denomMinter.CumulativeMinted)
+		require.True(t, denomMinter.CarryBuffer.IsPositive())
+	})
+
+	t.Run("should not mint the denoms while minting is paused", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := lowInflationParams()
+		params.PauseMinting = true
+		params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(1000))))
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(1000), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+		denomMinter := tk.MintKeeper.GetMinter(ctx).GetDenomMinter(fooMintConfig())
+		require.Equal(t, sdk.NewDec(200), denomMinter.AnnualProvisions)
+		require.True(t, denomMinter.CumulativeMinted.IsZero())
+	})
+
+	t.Run("should not mint the denoms below the min bonded ratio", func(t *testing.T) {
+		bondedRatio := sdk.NewDecWithPrec(4, 1)
+		ctx, tk, _ := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+			return variableBondedRatioStakingKeeper{StakingKeeper: sk, bondedRatio: &bondedRatio}
+		})
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		params.MinBondedRatio = sdk.NewDecWithPrec(5, 1)
+		params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(1000))))
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(1000), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+		denomMinter := tk.MintKeeper.GetMinter(ctx).GetDenomMinter(fooMintConfig())
+		require.Equal(t, sdk.NewDec(200), denomMinter.AnnualProvisions)
+		require.Equal(t, sdk.ZeroDec(), denomMinter.CarryBuffer)
+
+		// the denom is minted again once the bonded ratio recovers, the skipped block is not caught up
+		bondedRatio = sdk.NewDecWithPrec(6, 1)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(1020), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+	})
+
+	t.Run("should accrue the provisions of a denom over the minting interval", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		params.MintingInterval = 3
+		params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(1000))))
+
+		for height := int64(1); height < 3; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+			require.Equal(t, sdkmath.NewInt(1000), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+			require.Equal(t, sdk.NewDec(20*height), tk.MintKeeper.GetMinter(ctx).GetDenomMinter(fooMintConfig()).CarryBuffer)
+		}
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(3)))
+		require.Equal(t, sdkmath.NewInt(1060), tk.BankKeeper.GetSupply(ctx, "foo").Amount)
+		require.Equal(t, sdk.ZeroDec(), tk.MintKeeper.GetMinter(ctx).GetDenomMinter(fooMintConfig()).CarryBuffer)
+	})
+
+	t.Run("should distribute the coins of a denom by the proportions of its configuration", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		fundedAddr := sample.Address(r)
+		config := fooMintConfig()
+		config.DistributionProportions = types.DistributionProportions{
+			Staking:          sdk.NewDecWithPrec(2, 1),
+			FundedAddresses:  sdk.NewDecWithPrec(3, 1),
+			CommunityPool:    sdk.NewDecWithPrec(4, 1),
+			StrategicReserve: sdk.NewDecWithPrec(1, 1),
+		}
+		params := types.DefaultParams()
+		params.FundedAddresses = []types.WeightedAddress{{Address: fundedAddr, Weight: sdk.OneDec()}}
+		params.MintConfigs = []types.MintConfig{config}
+		tk.MintKeeper.SetParams(ctx, params)
+
+		mintedCoin := sdk.NewCoin("foo", sdkmath.NewInt(100))
+		require.NoError(t, tk.MintKeeper.MintCoin(ctx, mintedCoin))
+		require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+		feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		require.Equal(t, sdkmath.NewInt(20), tk.BankKeeper.GetBalance(ctx, feeCollector, "foo").Amount)
+		require.Equal(t, sdkmath.NewInt(30), tk.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(fundedAddr), "foo").Amount)
+		communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+		require.Equal(t, sdkmath.NewInt(40), communityPool.AmountOf("foo"))
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin("foo", sdkmath.NewInt(10))), tk.MintKeeper.GetMinter(ctx).StrategicReserve)
+
+		msg, broken := keeper.ModuleAccountBalanceInvariant(tk.MintKeeper)(ctx)
+		require.False(t, broken, msg)
+	})
+
+	t.Run("should mint like the single mint denom layout without mint configuration", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Equal(t, sdkmath.NewInt(1010), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+		require.Empty(t, tk.MintKeeper.GetMinter(ctx).DenomMinters)
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			require.NotEqual(t, "modules.mint.EventDenomMint", e.Type)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMintingInterval(t *testing.T) {
+	// the block provision of the params is 10 tokens for a supply of 1000 tokens
+	intervalParams := func(interval uint64) types.Params {
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		params.MintingInterval = interval
+		return params
+	}
+
+	t.Run("should mint every block with an interval of 1", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := intervalParams(1)
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		// the reference chain mints with the params before the minting interval
+		refCtx, refTk, _ := testSetups[0].setup(t)
+		refParams := intervalParams(types.DefaultMintingInterval)
+		refTk.MintKeeper.SetParams(refCtx, refParams)
+		refTk.MintKeeper.SetMinter(refCtx, types.InitialMinter(refParams.InflationMax))
+		fundSupply(t, refCtx, refTk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		for height := int64(1); height <= 3; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+			require.NoError(t, refTk.MintKeeper.BeginBlocker(refCtx.WithBlockHeight(height)))
+			require.Equal(t, refTk.BankKeeper.GetSupply(refCtx, params.MintDenom), tk.BankKeeper.GetSupply(ctx, params.MintDenom))
+			require.Equal(t, refTk.MintKeeper.GetMinter(refCtx), tk.MintKeeper.GetMinter(ctx))
+			require.Equal(t, sdk.ZeroDec(), tk.MintKeeper.GetMinter(ctx).CarryBuffer)
+		}
+		require.Equal(t, sdkmath.NewInt(1030), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	})
+
+	t.Run("should accrue the provisions of the epoch and mint them at its end", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := intervalParams(5)
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		for height := int64(1); height < 5; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+			require.Equal(t, sdkmath.NewInt(1000), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+			require.Equal(t, sdk.NewDec(10*height), tk.MintKeeper.GetMinter(ctx).CarryBuffer)
+		}
+		require.True(t, tk.MintKeeper.GetTotalMinted(ctx).IsZero())
+
+		// the accrued provisions are minted and distributed in one shot
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(5)))
+		require.Equal(t, sdkmath.NewInt(1050), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+		minter := tk.MintKeeper.GetMinter(ctx)
+		require.Equal(t, sdk.ZeroDec(), minter.CarryBuffer)
+		require.Equal(t, sdkmath.NewInt(50), minter.CumulativeMinted)
+		mintAddr := tk.AccountKeeper.GetModuleAddress(types.ModuleName)
+		require.True(t, tk.BankKeeper.GetAllBalances(ctx, mintAddr).IsZero())
+	})
+
+	t.Run("should keep the accrued provisions across a genesis export and import", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := intervalParams(5)
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+		for height := int64(1); height <= 3; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+		}
+
+		// the chain is restarted in the middle of the epoch
+		genesis := mint.ExportGenesis(ctx, tk.MintKeeper)
+		require.NoError(t, genesis.Validate())
+		require.Equal(t, sdk.NewDec(30), genesis.Minter.CarryBuffer)
+
+		freshCtx, freshTk, _ := testSetups[0].setup(t)
+		mint.InitGenesis(freshCtx, freshTk.MintKeeper, freshTk.AccountKeeper, genesis)
+		fundSupply(t, freshCtx, freshTk, sdk.NewCoins(tk.BankKeeper.GetSupply(ctx, params.MintDenom)))
+		require.Equal(t, genesis.Minter.CarryBuffer, freshTk.MintKeeper.GetMinter(freshCtx).CarryBuffer)
+
+		for height := int64(4); height <= 5; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+			require.NoError(t, freshTk.MintKeeper.BeginBlocker(freshCtx.WithBlockHeight(height)))
+		}
+		require.Equal(t, sdkmath.NewInt(1050), freshTk.BankKeeper.GetSupply(freshCtx, params.MintDenom).Amount)
+		require.Equal(t, tk.BankKeeper.GetSupply(ctx, params.MintDenom), freshTk.BankKeeper.GetSupply(freshCtx, params.MintDenom))
+		require.Equal(t, tk.MintKeeper.GetMinter(ctx), freshTk.MintKeeper.GetMinter(freshCtx))
+	})
+}
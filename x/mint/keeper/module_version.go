@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetModuleVersion returns the version of the behavior of the module and the migrations completed
+// on the chain. The stores written before the module version was recorded return the module
+// version of their schema version without migration.
+func (k Keeper) GetModuleVersion(ctx sdk.Context) types.ModuleVersion {
+	moduleVersion, found := k.collections.moduleVersion.Get(ctx)
+	if !found {
+		version, found := k.GetSchemaVersion(ctx)
+		if !found {
+			version = 1
+		}
+		return types.NewModuleVersion(version)
+	}
+	if moduleVersion.Migrations == nil {
+		moduleVersion.Migrations = []types.MigrationRecord{}
+	}
+	return moduleVersion
+}
+
+func (k Keeper) setModuleVersion(ctx sdk.Context, moduleVersion types.ModuleVersion) {
+	k.collections.moduleVersion.Set(ctx, moduleVersion)
+}
+
+// recordMigration appends the migration from a version at the current height to the module
+// version
+func (k Keeper) recordMigration(ctx sdk.Context, from uint64) {
+	k.setModuleVersion(ctx, k.GetModuleVersion(ctx).AddMigration(from, ctx.BlockHeight()))
+}
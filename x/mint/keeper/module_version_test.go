@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestModuleVersion(t *testing.T) {
+	t.Run("should record the module version without migration on genesis initialization", func(t *testing.T) {
+		app := setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+		require.Equal(t, types.NewModuleVersion(types.SchemaVersion), app.MintKeeper.GetModuleVersion(ctx))
+	})
+
+	t.Run("should append each migration exactly once", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		migrator := keeper.NewMigrator(tk.MintKeeper)
+		require.Equal(t, types.NewModuleVersion(1), tk.MintKeeper.GetModuleVersion(ctx))
+
+		migrations := []func(ctx sdk.Context) error{
+			migrator.Migrate1to2,
+			migrator.Migrate2to3,
+			migrator.Migrate3to4,
+			migrator.Migrate4to5,
+			migrator.Migrate5to6,
+			migrator.Migrate6to7,
+		}
+		expected := []types.MigrationRecord{}
+		for i, migration := range migrations {
+			from := uint64(i + 1)
+			height := int64(10 * (i + 1))
+			require.NoError(t, migration(ctx.WithBlockHeight(height)))
+			expected = append(expected, types.MigrationRecord{
+				FromVersion:      from,
+				ToVersion:        from + 1,
+				Height:           height,
+				BehaviorRevision: types.BehaviorRevision(from + 1),
+			})
+
+			moduleVersion := tk.MintKeeper.GetModuleVersion(ctx)
+			require.Equal(t, from+1, moduleVersion.ConsensusVersion)
+			require.Equal(t, types.BehaviorRevision(from+1), moduleVersion.BehaviorRevision)
+			require.Equal(t, expected, moduleVersion.Migrations)
+		}
+		require.Equal(t, types.SchemaVersion, tk.MintKeeper.GetModuleVersion(ctx).ConsensusVersion)
+
+		// a migration run again fails and is not appended
+		for _, migration := range migrations {
+			require.ErrorIs(t, migration(ctx.WithBlockHeight(100)), types.ErrSchemaVersion)
+		}
+		require.Equal(t, expected, tk.MintKeeper.GetModuleVersion(ctx).Migrations)
+	})
+
+	t.Run("should return the module version with the query", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		require.NoError(t, keeper.NewMigrator(tk.MintKeeper).Migrate1to2(ctx.WithBlockHeight(5)))
+
+		q := querier.NewQuerier(tk.MintKeeper)
+		res, err := q.ModuleVersion(sdk.WrapSDKContext(ctx), &types.QueryModuleVersionRequest{})
+		require.NoError(t, err)
+		require.Equal(t, tk.MintKeeper.GetModuleVersion(ctx), res.ModuleVersion)
+		require.Len(t, res.ModuleVersion.Migrations, 1)
+
+		_, err = q.ModuleVersion(sdk.WrapSDKContext(ctx), nil)
+		require.Error(t, err)
+	})
+}
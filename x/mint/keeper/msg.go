@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// checkExpectedChainID rejects an authority message crafted for another chain, the check is
+// skipped when the message has no expected chain-id
+func checkExpectedChainID(ctx sdk.Context, expectedChainID string) error {
+	if expectedChainID != "" && expectedChainID != ctx.ChainID() {
+		return errors.Wrapf(types.ErrChainIDMismatch, "expected %s, got %s", expectedChainID, ctx.ChainID())
+	}
+	return nil
+}
+
+// updateFundedAddresses replaces the funded addresses with the addresses returned by update, the
+// other params are kept unchanged. The update is rejected as a full update of the params would be.
+func (k msgServer) updateFundedAddresses(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	authority,
+	expectedChainID string,
+	update func([]types.WeightedAddress) ([]types.WeightedAddress, error),
+) ([]types.WeightedAddress, error) {
+	if authority != k.authority {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+	if err := checkExpectedChainID(ctx, expectedChainID); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	fundedAddresses, err := update(params.FundedAddresses)
+	if err != nil {
+		return nil, err
+	}
+	params.FundedAddresses = fundedAddresses
+	if err := k.setProposedParams(ctx, msg, authority, params); err != nil {
+		return nil, err
+	}
+	return params.FundedAddresses, nil
+}
+
+// setProposedParams replaces the params with the params proposed by a message of the authority.
+// The params are validated and the begin blocker of the next block is dry-run with them, so
+// params passing the validation but breaking the minting are rejected by every message setting
+// the params.
+func (k msgServer) setProposedParams(ctx sdk.Context, msg sdk.Msg, authority string, params types.Params) error {
+	if err := validateProposedParams(params); err != nil {
+		return err
+	}
+	change := types.NewParamsChange(ctx, authority, sdk.MsgTypeURL(msg))
+	if err := k.DryRunBeginBlocker(ctx, params, change); err != nil {
+		return err
+	}
+	k.SetParamsWithChange(ctx, params, change)
+	return nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// AddFundedAddress adds a funded address, the weights of the other funded addresses are scaled
+// down so the weights still sum to 1
+func (k msgServer) AddFundedAddress(goCtx context.Context, msg *types.MsgAddFundedAddress) (*types.MsgAddFundedAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fundedAddresses, err := k.updateFundedAddresses(
+		ctx,
+		msg,
+		msg.Authority,
+		msg.ExpectedChainId,
+		func(fundedAddresses []types.WeightedAddress) ([]types.WeightedAddress, error) {
+			return types.AddFundedAddress(fundedAddresses, msg.FundedAddress)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddFundedAddressResponse{FundedAddresses: fundedAddresses}, nil
+}
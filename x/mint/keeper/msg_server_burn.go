@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// Burn burns coins of the mint denom of the signer, reducing the supply
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	total, err := k.BurnCoin(ctx, signer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{TotalBurned: total}, nil
+}
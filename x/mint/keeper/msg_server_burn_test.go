@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// burnEvent returns the last burn event of the context
+func burnEvent(t *testing.T, ctx sdk.Context) *types.EventBurn {
+	var burned *types.EventBurn
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventBurn); ok {
+			burned = e
+		}
+	}
+	require.NotNil(t, burned, "no burn event")
+	return burned
+}
+
+func TestMsgBurn(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	burner := sample.AccAddress(r)
+	stake := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
+	}
+
+	funds := sdk.NewCoins(stake(1000), sdk.NewInt64Coin("foo", 1000))
+	require.NoError(t, tk.BankKeeper.MintCoins(sdkCtx, types.ModuleName, funds))
+	require.NoError(t, tk.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, burner, funds))
+	tk.MintKeeper.SetMinter(sdkCtx, types.DefaultInitialMinter())
+
+	burn := func(amount sdk.Coin) (*types.MsgBurnResponse, sdk.Context, error) {
+		ctx := sdkCtx.WithEventManager(sdk.NewEventManager())
+		res, err := ts.MintSrv.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(burner.String(), amount))
+		if err == nil {
+			msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.False(t, broken, msg)
+		}
+		return res, ctx, err
+	}
+
+	t.Run("should burn the coins of the signer and reduce the supply", func(t *testing.T) {
+		supply := tk.BankKeeper.GetSupply(sdkCtx, sdk.DefaultBondDenom)
+		res, ctx, err := burn(stake(300))
+		require.NoError(t, err)
+		require.Equal(t, stake(300), res.TotalBurned)
+		require.Equal(t, stake(700), tk.BankKeeper.GetBalance(sdkCtx, burner, sdk.DefaultBondDenom))
+		require.Equal(t, supply.Sub(stake(300)), tk.BankKeeper.GetSupply(sdkCtx, sdk.DefaultBondDenom))
+		require.True(t, tk.MintKeeper.ModuleAccountBalance(sdkCtx).IsZero())
+		require.Equal(t, &types.EventBurn{
+			Address:     burner.String(),
+			Amount:      stake(300),
+			TotalBurned: stake(300),
+		}, burnEvent(t, ctx))
+	})
+
+	t.Run("should accumulate the total burned amount", func(t *testing.T) {
+		res, _, err := burn(stake(200))
+		require.NoError(t, err)
+		require.Equal(t, stake(500), res.TotalBurned)
+		require.Equal(t, stake(500), tk.MintKeeper.GetTotalBurnedOf(sdkCtx, sdk.DefaultBondDenom))
+
+		q := querier.NewQuerier(tk.MintKeeper)
+		queryRes, err := q.TotalBurned(sdk.WrapSDKContext(sdkCtx), &types.QueryTotalBurnedRequest{})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(stake(500)), queryRes.TotalBurned)
+	})
+
+	t.Run("should prevent burning more than the balance", func(t *testing.T) {
+		_, _, err := burn(stake(501))
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+		require.Equal(t, stake(500), tk.BankKeeper.GetBalance(sdkCtx, burner, sdk.DefaultBondDenom))
+		require.Equal(t, stake(500), tk.MintKeeper.GetTotalBurnedOf(sdkCtx, sdk.DefaultBondDenom))
+		require.True(t, tk.MintKeeper.ModuleAccountBalance(sdkCtx).IsZero())
+	})
+
+	t.Run("should prevent burning another denom than the mint denom", func(t *testing.T) {
+		_, _, err := burn(sdk.NewInt64Coin("foo", 100))
+		require.ErrorIs(t, err, types.ErrInvalidBurn)
+		require.Equal(t, sdk.NewInt64Coin("foo", 1000), tk.BankKeeper.GetBalance(sdkCtx, burner, "foo"))
+		require.Equal(t, sdk.NewCoins(stake(500)), tk.MintKeeper.GetTotalBurned(sdkCtx))
+	})
+
+	t.Run("should prevent burning a zero amount", func(t *testing.T) {
+		_, _, err := burn(stake(0))
+		require.ErrorIs(t, err, types.ErrInvalidBurn)
+	})
+
+	t.Run("should prevent an invalid signer", func(t *testing.T) {
+		_, err := ts.MintSrv.Burn(sdk.WrapSDKContext(sdkCtx), types.NewMsgBurn("invalid", stake(1)))
+		require.ErrorIs(t, err, errors.ErrInvalidAddress)
+	})
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// ClaimDistribution transfers the pending payout of a funded address to the address, the payout
+// can be claimed after the address left the pull payout mode or the funded addresses
+func (k msgServer) ClaimDistribution(
+	goCtx context.Context,
+	msg *types.MsgClaimDistribution,
+) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	minter := k.GetMinter(ctx)
+	payout, found := minter.ClaimPayout(msg.Address)
+	if !found || payout.Amount.IsZero() {
+		return nil, errors.Wrapf(types.ErrNoPendingPayout, "%s", msg.Address)
+	}
+	// the payout stays pending while the send restriction blocks it
+	recipient, err := k.restrictedRecipient(ctx, addr, payout.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, payout.Amount); err != nil {
+		return nil, err
+	}
+	k.SetMinter(ctx, minter)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDistributionClaimed{
+		Address:    payout.Address,
+		Amount:     payout.Amount,
+		FromHeight: payout.FromHeight,
+		ToHeight:   payout.ToHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimDistributionResponse{Amount: payout.Amount}, nil
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// claimedEvent returns the last distribution claimed event of the context
+func claimedEvent(t *testing.T, ctx sdk.Context) *types.EventDistributionClaimed {
+	var claimed *types.EventDistributionClaimed
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventDistributionClaimed); ok {
+			claimed = e
+		}
+	}
+	require.NotNil(t, claimed, "no distribution claimed event")
+	return claimed
+}
+
+func TestMsgClaimDistribution(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	pullAddr, pushAddr := sample.AccAddress(r), sample.AccAddress(r)
+
+	// the funded addresses share of 40 coins is split evenly between the addresses
+	params := types.DefaultParams()
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: pullAddr.String(), Weight: sdk.NewDecWithPrec(5, 1), PayoutMode: types.PAYOUT_MODE_PULL},
+		{Address: pushAddr.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	tk.MintKeeper.SetMinter(sdkCtx, types.DefaultInitialMinter())
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	distribute := func(height int64) {
+		ctx := sdkCtx.WithBlockHeight(height)
+		require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+		require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+		msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+		require.False(t, broken, msg)
+	}
+	claim := func(height int64, addr sdk.AccAddress) (*types.MsgClaimDistributionResponse, sdk.Context, error) {
+		ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		res, err := ts.MintSrv.ClaimDistribution(sdk.WrapSDKContext(ctx), types.NewMsgClaimDistribution(addr.String()))
+		if err == nil {
+			msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.False(t, broken, msg)
+		}
+		return res, ctx, err
+	}
+	balance := func(addr sdk.AccAddress) sdk.Coins {
+		return tk.BankKeeper.GetAllBalances(sdkCtx, addr)
+	}
+
+	t.Run("should accumulate the share of an address in pull mode", func(t *testing.T) {
+		for height := int64(1); height <= 3; height++ {
+			distribute(height)
+		}
+		require.True(t, balance(pullAddr).IsZero())
+		require.Equal(t, stake(60), balance(pushAddr))
+
+		payout, found := tk.MintKeeper.GetMinter(sdkCtx).GetPendingPayout(pullAddr.String())
+		require.True(t, found)
+		require.Equal(t, types.PendingPayout{
+			Address:    pullAddr.String(),
+			Amount:     stake(60),
+			FromHeight: 1,
+			ToHeight:   3,
+		}, payout)
+		require.Equal(t, stake(60), tk.MintKeeper.ModuleAccountBalance(sdkCtx))
+	})
+
+	t.Run("should transfer the pending payout to the claiming address", func(t *testing.T) {
+		res, ctx, err := claim(4, pullAddr)
+		require.NoError(t, err)
+		require.Equal(t, stake(60), res.Amount)
+		require.Equal(t, stake(60), balance(pullAddr))
+		require.True(t, tk.MintKeeper.ModuleAccountBalance(sdkCtx).IsZero())
+		require.Equal(t, &types.EventDistributionClaimed{
+			Address:    pullAddr.String(),
+			Amount:     stake(60),
+			FromHeight: 1,
+			ToHeight:   3,
+		}, claimedEvent(t, ctx))
+
+		_, found := tk.MintKeeper.GetMinter(sdkCtx).GetPendingPayout(pullAddr.String())
+		require.False(t, found)
+	})
+
+	t.Run("should prevent claiming without pending payout", func(t *testing.T) {
+		_, _, err := claim(4, pullAddr)
+		require.ErrorIs(t, err, types.ErrNoPendingPayout)
+
+		_, _, err = claim(4, pushAddr)
+		require.ErrorIs(t, err, types.ErrNoPendingPayout)
+	})
+
+	t.Run("should keep the pending payout when switching to push mode", func(t *testing.T) {
+		distribute(5)
+		distribute(6)
+
+		params.FundedAddresses[0].PayoutMode = types.PAYOUT_MODE_PUSH
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		distribute(7)
+
+		// the share of the block is sent while the accumulated shares are still pending
+		require.Equal(t, stake(80), balance(pullAddr))
+		payout, found := tk.MintKeeper.GetMinter(sdkCtx).GetPendingPayout(pullAddr.String())
+		require.True(t, found)
+		require.Equal(t, stake(40), payout.Amount)
+		require.EqualValues(t, 5, payout.FromHeight)
+		require.EqualValues(t, 6, payout.ToHeight)
+
+		res, ctx, err := claim(8, pullAddr)
+		require.NoError(t, err)
+		require.Equal(t, stake(40), res.Amount)
+		require.Equal(t, stake(120), balance(pullAddr))
+		require.EqualValues(t, 5, claimedEvent(t, ctx).FromHeight)
+		require.EqualValues(t, 6, claimedEvent(t, ctx).ToHeight)
+	})
+
+	t.Run("should keep the pending payout across genesis export", func(t *testing.T) {
+		params.FundedAddresses[0].PayoutMode = types.PAYOUT_MODE_PULL
+		tk.MintKeeper.SetParams(sdkCtx, params)
+		distribute(9)
+
+		genesis := mint.ExportGenesis(sdkCtx, tk.MintKeeper)
+		require.NoError(t, genesis.Validate())
+		require.Len(t, genesis.Minter.PendingPayouts, 1)
+
+		tk.MintKeeper.SetMinter(sdkCtx, types.DefaultInitialMinter())
+		mint.InitGenesis(sdkCtx, tk.MintKeeper, tk.AccountKeeper, genesis)
+
+		res, _, err := claim(10, pullAddr)
+		require.NoError(t, err)
+		require.Equal(t, stake(20), res.Amount)
+	})
+
+	t.Run("should prevent claiming with an invalid address", func(t *testing.T) {
+		_, err := ts.MintSrv.ClaimDistribution(sdk.WrapSDKContext(sdkCtx), &types.MsgClaimDistribution{Address: "invalid"})
+		require.ErrorIs(t, err, errors.ErrInvalidAddress)
+	})
+}
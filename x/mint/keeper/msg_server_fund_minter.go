@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// FundMinter distributes coins of the mint denom of the signer by the distribution proportions
+func (k msgServer) FundMinter(goCtx context.Context, msg *types.MsgFundMinter) (*types.MsgFundMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	total, err := k.FundMinterCoin(ctx, signer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundMinterResponse{CumulativeFunded: total}, nil
+}
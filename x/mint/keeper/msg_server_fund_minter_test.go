@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgFundMinter(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	funder := sample.AccAddress(r)
+	stake := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
+	}
+
+	funds := sdk.NewCoins(stake(2000), sdk.NewInt64Coin("foo", 1000))
+	require.NoError(t, tk.BankKeeper.MintCoins(sdkCtx, types.ModuleName, funds))
+	require.NoError(t, tk.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, funder, funds))
+	tk.MintKeeper.SetMinter(sdkCtx, types.DefaultInitialMinter())
+	feeCollector := tk.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	fund := func(amount sdk.Coin) (*types.MsgFundMinterResponse, sdk.Context, error) {
+		ctx := sdkCtx.WithEventManager(sdk.NewEventManager())
+		res, err := ts.MintSrv.FundMinter(sdk.WrapSDKContext(ctx), types.NewMsgFundMinter(funder.String(), amount))
+		if err == nil {
+			msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.False(t, broken, msg)
+		}
+		return res, ctx, err
+	}
+
+	t.Run("should distribute the coins to the community pool without funded addresses", func(t *testing.T) {
+		supply := tk.BankKeeper.GetSupply(sdkCtx, sdk.DefaultBondDenom)
+		res, ctx, err := fund(stake(1000))
+		require.NoError(t, err)
+		require.Equal(t, stake(1000), res.CumulativeFunded)
+		require.Equal(t, stake(1000), tk.BankKeeper.GetBalance(sdkCtx, funder, sdk.DefaultBondDenom))
+		require.Equal(t, supply, tk.BankKeeper.GetSupply(sdkCtx, sdk.DefaultBondDenom))
+		require.True(t, tk.MintKeeper.ModuleAccountBalance(sdkCtx).IsZero())
+
+		// the share of the funded addresses goes to the community pool
+		require.Equal(t, stake(300), tk.BankKeeper.GetBalance(sdkCtx, feeCollector, sdk.DefaultBondDenom))
+		communityPool, _ := tk.DistrKeeper.GetFeePoolCommunityCoins(sdkCtx).TruncateDecimal()
+		require.Equal(t, sdk.NewCoins(stake(700)), communityPool)
+
+		var funded *types.EventMinterFunded
+		var distribution *types.EventMintDistribution
+		for _, event := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			switch e := msg.(type) {
+			case *types.EventMinterFunded:
+				require.Nil(t, distribution, "funding event emitted after the distribution")
+				funded = e
+			case *types.EventMintDistribution:
+				distribution = e
+			}
+		}
+		require.Equal(t, &types.EventMinterFunded{
+			Address:          funder.String(),
+			Amount:           stake(1000),
+			CumulativeFunded: stake(1000),
+		}, funded)
+		require.NotNil(t, distribution)
+
+		// the funding is not accounted as minted
+		minter := tk.MintKeeper.GetMinter(sdkCtx)
+		require.True(t, minter.CumulativeMinted.IsZero())
+		require.Equal(t, sdkmath.NewInt(1000), minter.CumulativeFunded)
+		_, found := tk.MintKeeper.GetBlockDistribution(sdkCtx, sdkCtx.BlockHeight())
+		require.False(t, found)
+	})
+
+	t.Run("should accumulate the cumulative funded amount", func(t *testing.T) {
+		res, _, err := fund(stake(500))
+		require.NoError(t, err)
+		require.Equal(t, stake(1500), res.CumulativeFunded)
+		require.Equal(t, sdkmath.NewInt(1500), tk.MintKeeper.GetMinter(sdkCtx).CumulativeFunded)
+	})
+
+	t.Run("should prevent funding more than the balance", func(t *testing.T) {
+		_, _, err := fund(stake(501))
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+		require.Equal(t, stake(500), tk.BankKeeper.GetBalance(sdkCtx, funder, sdk.DefaultBondDenom))
+		require.Equal(t, sdkmath.NewInt(1500), tk.MintKeeper.GetMinter(sdkCtx).CumulativeFunded)
+		require.True(t, tk.MintKeeper.ModuleAccountBalance(sdkCtx).IsZero())
+	})
+
+	t.Run("should prevent funding with another denom than the mint denom", func(t *testing.T) {
+		_, _, err := fund(sdk.NewInt64Coin("foo", 100))
+		require.ErrorIs(t, err, types.ErrInvalidFunding)
+		require.Equal(t, sdk.NewInt64Coin("foo", 1000), tk.BankKeeper.GetBalance(sdkCtx, funder, "foo"))
+	})
+
+	t.Run("should prevent funding a zero amount", func(t *testing.T) {
+		_, _, err := fund(stake(0))
+		require.ErrorIs(t, err, types.ErrInvalidFunding)
+	})
+
+	t.Run("should prevent an invalid signer", func(t *testing.T) {
+		_, err := ts.MintSrv.FundMinter(sdk.WrapSDKContext(sdkCtx), types.NewMsgFundMinter("invalid", stake(1)))
+		require.ErrorIs(t, err, errors.ErrInvalidAddress)
+	})
+}
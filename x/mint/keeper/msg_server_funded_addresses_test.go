@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgUpdateFundedAddresses(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+	addr1, addr2, addr3 := sample.Address(r), sample.Address(r), sample.Address(r)
+
+	requireFundedAddresses := func(t *testing.T, expected []types.WeightedAddress) {
+		params := tk.MintKeeper.GetParams(sdkCtx)
+		require.Len(t, params.FundedAddresses, len(expected))
+		sum := sdk.ZeroDec()
+		for i, w := range expected {
+			require.Equal(t, w.Address, params.FundedAddresses[i].Address)
+			require.True(t, w.Weight.Equal(params.FundedAddresses[i].Weight), "%s: %s", w.Address, params.FundedAddresses[i].Weight)
+			sum = sum.Add(params.FundedAddresses[i].Weight)
+		}
+		if len(expected) > 0 {
+			require.True(t, sum.Equal(sdk.OneDec()))
+		}
+		msg, broken := keeper.AllInvariants(tk.MintKeeper)(sdkCtx)
+		require.False(t, broken, msg)
+	}
+
+	t.Run("should prevent updating the funded addresses from a non authority address", func(t *testing.T) {
+		signer := sample.Address(r)
+		_, err := ts.MintSrv.AddFundedAddress(ctx, types.NewMsgAddFundedAddress(signer, types.WeightedAddress{
+			Address: addr1,
+			Weight:  sdk.OneDec(),
+		}))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+		_, err = ts.MintSrv.RemoveFundedAddress(ctx, types.NewMsgRemoveFundedAddress(signer, addr1))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+		_, err = ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(signer, addr1, sdk.OneDec()))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("should prevent adding the first funded address with a weight below 1", func(t *testing.T) {
+		_, err := ts.MintSrv.AddFundedAddress(ctx, types.NewMsgAddFundedAddress(authority, types.WeightedAddress{
+			Address: addr1,
+			Weight:  sdk.NewDecWithPrec(5, 1),
+		}))
+		require.ErrorIs(t, err, types.ErrInvalidWeightSum)
+	})
+
+	t.Run("should add funded addresses scaling down the other weights", func(t *testing.T) {
+		params := tk.MintKeeper.GetParams(sdkCtx)
+		params.FundedAddresses = nil
+		tk.MintKeeper.SetParams(sdkCtx, params)
+
+		res, err := ts.MintSrv.AddFundedAddress(ctx, types.NewMsgAddFundedAddress(authority, types.WeightedAddress{
+			Address: addr1,
+			Weight:  sdk.OneDec(),
+		}))
+		require.NoError(t, err)
+		require.Len(t, res.FundedAddresses, 1)
+
+		_, err = ts.MintSrv.AddFundedAddress(ctx, types.NewMsgAddFundedAddress(authority, types.WeightedAddress{
+			Address: addr2,
+			Weight:  sdk.NewDecWithPrec(4, 1),
+		}))
+		require.NoError(t, err)
+		res, err = ts.MintSrv.AddFundedAddress(ctx, types.NewMsgAddFundedAddress(authority, types.WeightedAddress{
+			Address: addr3,
+			Weight:  sdk.NewDecWithPrec(5, 1),
+		}))
+		require.NoError(t, err)
+		expected := []types.WeightedAddress{
+			{Address: addr1, Weight: sdk.NewDecWithPrec(3, 1)},
+			{Address: addr2, Weight: sdk.NewDecWithPrec(2, 1)},
+			{Address: addr3, Weight: sdk.NewDecWithPrec(5, 1)},
+		}
+		require.Equal(t, tk.MintKeeper.GetParams(sdkCtx).FundedAddresses, res.FundedAddresses)
+		requireFundedAddresses(t, expected)
+
+		history, _, err := tk.MintKeeper.GetFundedAddressHistory(sdkCtx, addr3, nil)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.Equal(t, types.WeightChangeAdded, history[0].Action)
+	})
+
+	t.Run("should prevent adding an already funded address", func(t *testing.T) {
+		_, err := ts.MintSrv.AddFundedAddress(ctx, types.NewMsgAddFundedAddress(authority, types.WeightedAddress{
+			Address: addr1,
+			Weight:  sdk.NewDecWithPrec(1, 1),
+		}))
+		require.ErrorIs(t, err, types.ErrInvalidFundedAddress)
+	})
+
+	t.Run("should set the weight of a funded address scaling the other weights", func(t *testing.T) {
+		_, err := ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(authority, addr3, sdk.NewDecWithPrec(75, 2)))
+		require.NoError(t, err)
+		requireFundedAddresses(t, []types.WeightedAddress{
+			{Address: addr1, Weight: sdk.NewDecWithPrec(15, 2)},
+			{Address: addr2, Weight: sdk.NewDecWithPrec(1, 1)},
+			{Address: addr3, Weight: sdk.NewDecWithPrec(75, 2)},
+		})
+	})
+
+	t.Run("should keep the weights summing to 1", func(t *testing.T) {
+		params := tk.MintKeeper.GetParams(sdkCtx)
+
+		// a weight of 1 leaves no weight to the other funded addresses
+		_, err := ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(authority, addr3, sdk.OneDec()))
+		require.ErrorIs(t, err, types.ErrInvalidWeightSum)
+
+		// the weights scaled down to zero are rejected
+		_, err = ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(
+			authority,
+			addr3,
+			sdk.OneDec().Sub(sdk.SmallestDec()),
+		))
+		require.ErrorIs(t, err, types.ErrInvalidWeightSum)
+
+		// the weights split in thirds are rounded with the remainder assigned to the last scaled weight
+		_, err = ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(authority, addr1, sdk.NewDecWithPrec(1, 1)))
+		require.NoError(t, err)
+		_, err = ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(authority, addr2, sdk.NewDecWithPrec(1, 1)))
+		require.NoError(t, err)
+		_, err = ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(
+			authority,
+			addr1,
+			sdk.OneDec().QuoInt64(3),
+		))
+		require.NoError(t, err)
+		sum := sdk.ZeroDec()
+		for _, w := range tk.MintKeeper.GetParams(sdkCtx).FundedAddresses {
+			sum = sum.Add(w.Weight)
+		}
+		require.True(t, sum.Equal(sdk.OneDec()), sum.String())
+		msg, broken := keeper.AllInvariants(tk.MintKeeper)(sdkCtx)
+		require.False(t, broken, msg)
+
+		tk.MintKeeper.SetParams(sdkCtx, params)
+	})
+
+	t.Run("should prevent updating a non funded address", func(t *testing.T) {
+		_, err := ts.MintSrv.SetFundedAddressWeight(ctx, types.NewMsgSetFundedAddressWeight(authority, sample.Address(r), sdk.NewDecWithPrec(1, 1)))
+		require.ErrorIs(t, err, types.ErrFundedAddressNotFound)
+		_, err = ts.MintSrv.RemoveFundedAddress(ctx, types.NewMsgRemoveFundedAddress(authority, sample.Address(r)))
+		require.ErrorIs(t, err, types.ErrFundedAddressNotFound)
+	})
+
+	t.Run("should remove funded addresses scaling up the other weights", func(t *testing.T) {
+		_, err := ts.MintSrv.RemoveFundedAddress(ctx, types.NewMsgRemoveFundedAddress(authority, addr3))
+		require.NoError(t, err)
+		requireFundedAddresses(t, []types.WeightedAddress{
+			{Address: addr1, Weight: sdk.NewDecWithPrec(6, 1)},
+			{Address: addr2, Weight: sdk.NewDecWithPrec(4, 1)},
+		})
+
+		history, _, err := tk.MintKeeper.GetFundedAddressHistory(sdkCtx, addr3, nil)
+		require.NoError(t, err)
+		require.Equal(t, types.WeightChangeRemoved, history[len(history)-1].Action)
+	})
+
+	t.Run("should switch to the community pool when removing the last funded address", func(t *testing.T) {
+		_, err := ts.MintSrv.RemoveFundedAddress(ctx, types.NewMsgRemoveFundedAddress(authority, addr1))
+		require.NoError(t, err)
+		res, err := ts.MintSrv.RemoveFundedAddress(ctx, types.NewMsgRemoveFundedAddress(authority, addr2))
+		require.NoError(t, err)
+		require.Empty(t, res.FundedAddresses)
+		requireFundedAddresses(t, nil)
+
+		params := tk.MintKeeper.GetParams(sdkCtx)
+		proportions, _ := types.EffectiveProportions(params)
+		require.True(t, proportions.FundedAddresses.IsZero())
+		require.True(t, proportions.CommunityPool.Equal(
+			params.DistributionProportions.CommunityPool.Add(params.DistributionProportions.FundedAddresses),
+		))
+	})
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// ReleaseReserve sends coins of the strategic reserve locked in the module account to the
+// recipient. The release fails if it exceeds the balance of the reserve.
+func (k msgServer) ReleaseReserve(goCtx context.Context, msg *types.MsgReleaseReserve) (*types.MsgReleaseReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := checkExpectedChainID(ctx, msg.ExpectedChainId); err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	minter := k.GetMinter(ctx)
+	if err := minter.ReleaseStrategicReserve(msg.Amount); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+	k.SetMinter(ctx, minter)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventReserveReleased{
+		Authority: msg.Authority,
+		Recipient: msg.Recipient,
+		Amount:    msg.Amount,
+		Remaining: minter.StrategicReserve,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseReserveResponse{Remaining: minter.StrategicReserve}, nil
+}
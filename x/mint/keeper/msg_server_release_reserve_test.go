@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgReleaseReserve(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+	recipient := sample.AccAddress(r)
+
+	// 10 coins of the 100 minted coins accrue in the strategic reserve every block
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(3, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(4, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		StrategicReserve: sdk.NewDecWithPrec(1, 1),
+	}
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	tk.MintKeeper.SetMinter(sdkCtx, types.DefaultInitialMinter())
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tk.BankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+		require.NoError(t, tk.MintKeeper.DistributeMintedCoin(sdkCtx, mintedCoin))
+	}
+
+	assertReserve := func(balance, released sdk.Coins) {
+		res, err := querier.NewQuerier(tk.MintKeeper).StrategicReserve(ctx, &types.QueryStrategicReserveRequest{})
+		require.NoError(t, err)
+		require.Equal(t, balance, res.Balance)
+		require.Equal(t, sdk.NewInt(30), res.Accrued)
+		require.Equal(t, released, res.Released)
+		require.True(t, balance.IsEqual(tk.MintKeeper.ModuleAccountBalance(sdkCtx)))
+
+		msg, broken := keeper.AllInvariants(tk.MintKeeper)(sdkCtx)
+		require.False(t, broken, msg)
+	}
+	assertReserve(stake(30), nil)
+
+	t.Run("should exclude the strategic reserve from the staking supply", func(t *testing.T) {
+		supply, _ := tk.MintKeeper.EffectiveStakingSupply(sdkCtx, params, sdk.NewInt(1000))
+		require.Equal(t, sdk.NewInt(970), supply)
+		supply, _ = tk.MintKeeper.EffectiveStakingSupply(sdkCtx, params, sdk.NewInt(20))
+		require.True(t, supply.IsZero())
+	})
+
+	t.Run("should prevent releasing the reserve from a non authority address", func(t *testing.T) {
+		_, err := ts.MintSrv.ReleaseReserve(ctx, types.NewMsgReleaseReserve(sample.Address(r), recipient.String(), stake(10)))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("should prevent releasing more than the reserve", func(t *testing.T) {
+		_, err := ts.MintSrv.ReleaseReserve(ctx, types.NewMsgReleaseReserve(authority, recipient.String(), stake(31)))
+		require.ErrorIs(t, err, types.ErrInsufficientReserve)
+		require.True(t, tk.BankKeeper.GetAllBalances(sdkCtx, recipient).IsZero())
+		assertReserve(stake(30), nil)
+	})
+
+	t.Run("should release the reserve to the recipient", func(t *testing.T) {
+		sdkCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+		res, err := ts.MintSrv.ReleaseReserve(sdk.WrapSDKContext(sdkCtx), types.NewMsgReleaseReserve(authority, recipient.String(), stake(25)))
+		require.NoError(t, err)
+		require.Equal(t, stake(5), res.Remaining)
+		require.Equal(t, stake(25), tk.BankKeeper.GetAllBalances(sdkCtx, recipient))
+		assertReserve(stake(5), stake(25))
+
+		events := sdkCtx.EventManager().Events()
+		event, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+		require.NoError(t, err)
+		require.Equal(t, &types.EventReserveReleased{
+			Authority: authority,
+			Recipient: recipient.String(),
+			Amount:    stake(25),
+			Remaining: stake(5),
+		}, event)
+	})
+}
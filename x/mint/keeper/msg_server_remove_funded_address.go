@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// RemoveFundedAddress removes a funded address, the weights of the remaining funded addresses are
+// scaled up so the weights still sum to 1. Removing the last funded address sends the funded
+// addresses share to the community pool.
+func (k msgServer) RemoveFundedAddress(goCtx context.Context, msg *types.MsgRemoveFundedAddress) (*types.MsgRemoveFundedAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fundedAddresses, err := k.updateFundedAddresses(
+		ctx,
+		msg,
+		msg.Authority,
+		msg.ExpectedChainId,
+		func(fundedAddresses []types.WeightedAddress) ([]types.WeightedAddress, error) {
+			return types.RemoveFundedAddress(fundedAddresses, msg.Address)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFundedAddressResponse{FundedAddresses: fundedAddresses}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// ResumeMinting resumes minting and resets the consecutive distribution failures of the minter
+func (k msgServer) ResumeMinting(goCtx context.Context, msg *types.MsgResumeMinting) (*types.MsgResumeMintingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := checkExpectedChainID(ctx, msg.ExpectedChainId); err != nil {
+		return nil, err
+	}
+
+	minter := k.GetMinter(ctx)
+	minter.ConsecutiveDistributionFailures = 0
+	minter.AutoPaused = false
+	k.SetMinter(ctx, minter)
+
+	params := k.GetParams(ctx)
+	params.PauseMinting = false
+	k.SetParamsWithChange(ctx, params, types.NewParamsChange(ctx, msg.Authority, sdk.MsgTypeURL(msg)))
+
+	return &types.MsgResumeMintingResponse{}, nil
+}
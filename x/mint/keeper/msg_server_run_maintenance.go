@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// RunMaintenance runs the maintenance of the store, any account can run it once every
+// maintenance_interval blocks and pays the gas of the records cleaned
+func (k msgServer) RunMaintenance(goCtx context.Context, msg *types.MsgRunMaintenance) (*types.MsgRunMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return nil, errors.Wrapf(errors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	summary, err := k.Keeper.RunMaintenance(ctx, msg.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMaintenance{
+		Signer:  msg.Signer,
+		Summary: summary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRunMaintenanceResponse{Summary: summary}, nil
+}
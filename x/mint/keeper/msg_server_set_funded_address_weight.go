@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// SetFundedAddressWeight sets the weight of a funded address, the weights of the other funded
+// addresses are scaled so the weights still sum to 1
+func (k msgServer) SetFundedAddressWeight(goCtx context.Context, msg *types.MsgSetFundedAddressWeight) (*types.MsgSetFundedAddressWeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fundedAddresses, err := k.updateFundedAddresses(
+		ctx,
+		msg,
+		msg.Authority,
+		msg.ExpectedChainId,
+		func(fundedAddresses []types.WeightedAddress) ([]types.WeightedAddress, error) {
+			return types.SetFundedAddressWeight(fundedAddresses, msg.Address, msg.Weight)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFundedAddressWeightResponse{FundedAddresses: fundedAddresses}, nil
+}
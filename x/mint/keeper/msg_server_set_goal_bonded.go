@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// SetGoalBonded sets the goal bonded ratio. With transition blocks, the goal used to compute the
+// inflation rate moves linearly from the current goal, including the goal of a pending transition,
+// to the new goal over the transition blocks. The goal bonded can't be set once a phase is active:
+// the goal bonded of the active phase replaces the param, the goal bonded of the phases is changed
+// with MsgUpdateParams instead.
+func (k msgServer) SetGoalBonded(goCtx context.Context, msg *types.MsgSetGoalBonded) (*types.MsgSetGoalBondedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := checkExpectedChainID(ctx, msg.ExpectedChainId); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateGoalBonded(msg.GoalBonded); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	if phase, ok := params.ActivePhase(ctx.BlockHeight()); ok {
+		return nil, errors.Wrapf(
+			types.ErrGoalBondedPhase,
+			"phase %s started at height %d sets the goal bonded to %s",
+			phase.Name,
+			phase.StartHeight,
+			phase.GoalBonded,
+		)
+	}
+	minter := k.GetMinter(ctx)
+	currentGoal := minter.EffectiveGoalBonded(params, ctx.BlockHeight())
+
+	minter.GoalBondedTransition = nil
+	if msg.TransitionBlocks > 0 {
+		minter.GoalBondedTransition = &types.GoalBondedTransition{
+			From:        currentGoal,
+			To:          msg.GoalBonded,
+			StartHeight: ctx.BlockHeight(),
+			EndHeight:   ctx.BlockHeight() + int64(msg.TransitionBlocks),
+		}
+	}
+	params.GoalBonded = msg.GoalBonded
+	if err := k.setProposedParams(ctx, msg, msg.Authority, params); err != nil {
+		return nil, err
+	}
+	k.SetMinter(ctx, minter)
+
+	return &types.MsgSetGoalBondedResponse{}, nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// fixedBondedRatioStakingKeeper overrides the bonded ratio of the staking keeper
+type fixedBondedRatioStakingKeeper struct {
+	types.StakingKeeper
+	bondedRatio sdk.Dec
+}
+
+func (k fixedBondedRatioStakingKeeper) BondedRatio(sdk.Context) sdk.Dec {
+	return k.bondedRatio
+}
+
+func TestMsgSetGoalBonded(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+
+	t.Run("should prevent setting the goal bonded from a non authority address", func(t *testing.T) {
+		_, err := ts.MintSrv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(sample.Address(r), sdk.NewDecWithPrec(5, 1), 0))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("should prevent setting an invalid goal bonded", func(t *testing.T) {
+		_, err := ts.MintSrv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(11, 1), 0))
+		require.ErrorIs(t, err, types.ErrInvalidGoalBonded)
+	})
+
+	t.Run("should prevent setting the goal bonded with invalid params", func(t *testing.T) {
+		sdkCtx, tk, ts := testSetups[0].setup(t)
+		authority := tk.MintKeeper.GetAuthority()
+		// the subspace setter skips the validation of the params
+		subspace, found := tk.ParamsKeeper.GetSubspace(types.ModuleName)
+		require.True(t, found)
+		subspace.Set(sdkCtx, types.KeyFundedAddresses, []types.WeightedAddress{{Address: "cosmos1invalid", Weight: sdk.OneDec()}})
+
+		_, err := ts.MintSrv.SetGoalBonded(sdk.WrapSDKContext(sdkCtx), types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(5, 1), 0))
+		require.ErrorIs(t, err, types.ErrInvalidFundedAddress)
+		require.Equal(t, types.DefaultGoalBonded, tk.MintKeeper.GetParams(sdkCtx).GoalBonded)
+	})
+
+	t.Run("should set the goal bonded immediately", func(t *testing.T) {
+		_, err := ts.MintSrv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(5, 1), 0))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecWithPrec(5, 1), tk.MintKeeper.GetParams(sdkCtx).GoalBonded)
+		require.Nil(t, tk.MintKeeper.GetMinter(sdkCtx).GoalBondedTransition)
+	})
+
+	t.Run("should set the goal bonded with a transition", func(t *testing.T) {
+		_, err := ts.MintSrv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(7, 1), 10))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecWithPrec(7, 1), tk.MintKeeper.GetParams(sdkCtx).GoalBonded)
+		require.Equal(t, &types.GoalBondedTransition{
+			From:        sdk.NewDecWithPrec(5, 1),
+			To:          sdk.NewDecWithPrec(7, 1),
+			StartHeight: 10,
+			EndHeight:   20,
+		}, tk.MintKeeper.GetMinter(sdkCtx).GoalBondedTransition)
+
+		res, err := querier.NewQuerier(tk.MintKeeper).Minter(ctx, &types.QueryMinterRequest{})
+		require.NoError(t, err)
+		require.Equal(t, tk.MintKeeper.GetMinter(sdkCtx).GoalBondedTransition, res.Minter.GoalBondedTransition)
+	})
+
+	t.Run("should start a new transition from the goal of the pending transition", func(t *testing.T) {
+		ctx := sdk.WrapSDKContext(sdkCtx.WithBlockHeight(15))
+		_, err := ts.MintSrv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(4, 1), 5))
+		require.NoError(t, err)
+		require.Equal(t, &types.GoalBondedTransition{
+			From:        sdk.NewDecWithPrec(6, 1),
+			To:          sdk.NewDecWithPrec(4, 1),
+			StartHeight: 15,
+			EndHeight:   20,
+		}, tk.MintKeeper.GetMinter(sdkCtx).GoalBondedTransition)
+	})
+}
+
+func TestMsgSetGoalBondedActivePhase(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	authority := tk.MintKeeper.GetAuthority()
+	params := phasedParams()
+	tk.MintKeeper.SetParams(sdkCtx, params)
+
+	t.Run("should set the goal bonded before the first phase", func(t *testing.T) {
+		ctx := sdk.WrapSDKContext(sdkCtx.WithBlockHeight(4))
+		_, err := ts.MintSrv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(5, 1), 0))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecWithPrec(5, 1), tk.MintKeeper.GetParams(sdkCtx).GoalBonded)
+	})
+
+	t.Run("should prevent setting the goal bonded while a phase is active", func(t *testing.T) {
+		ctx := sdk.WrapSDKContext(sdkCtx.WithBlockHeight(12))
+		_, err := ts.MintSrv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(6, 1), 0))
+		require.ErrorIs(t, err, types.ErrGoalBondedPhase)
+		require.ErrorContains(t, err, "phase growth")
+		require.Equal(t, sdk.NewDecWithPrec(5, 1), tk.MintKeeper.GetParams(sdkCtx).GoalBonded)
+	})
+}
+
+func TestBeginBlockerGoalBondedTransition(t *testing.T) {
+	bondedRatio := sdk.NewDecWithPrec(55, 2)
+	transition := types.GoalBondedTransition{
+		From:        sdk.NewDecWithPrec(5, 1),
+		To:          sdk.NewDecWithPrec(7, 1),
+		StartHeight: 10,
+		EndHeight:   20,
+	}
+
+	for _, tc := range []struct {
+		height     int64
+		goalBonded sdk.Dec
+		settled    bool
+	}{
+		{height: 10, goalBonded: sdk.NewDecWithPrec(5, 1)},
+		{height: 15, goalBonded: sdk.NewDecWithPrec(6, 1)},
+		{height: 18, goalBonded: sdk.NewDecWithPrec(66, 2)},
+		{height: 20, goalBonded: sdk.NewDecWithPrec(7, 1), settled: true},
+	} {
+		ctx, tk, _ := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+			return fixedBondedRatioStakingKeeper{StakingKeeper: sk, bondedRatio: bondedRatio}
+		})
+		ctx = ctx.WithBlockHeight(tc.height)
+
+		params := types.DefaultParams()
+		params.GoalBonded = transition.To
+		tk.MintKeeper.SetParams(ctx, params)
+		minter := types.DefaultInitialMinter()
+		minter.GoalBondedTransition = &transition
+		tk.MintKeeper.SetMinter(ctx, minter)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000))))
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+		inflationParams := params
+		inflationParams.GoalBonded = tc.goalBonded
+		got := tk.MintKeeper.GetMinter(ctx)
+		require.Equal(t, minter.NextInflationRate(inflationParams, bondedRatio), got.Inflation, "height %d", tc.height)
+		if tc.settled {
+			require.Nil(t, got.GoalBondedTransition)
+		} else {
+			require.Equal(t, &transition, got.GoalBondedTransition)
+		}
+	}
+
+	t.Run("should settle the transition once the goal bonded param changed", func(t *testing.T) {
+		ctx, tk, _ := testkeeper.NewTestSetup(t)
+		ctx = ctx.WithBlockHeight(15)
+		params := types.DefaultParams()
+		params.GoalBonded = sdk.NewDecWithPrec(8, 1)
+		tk.MintKeeper.SetParams(ctx, params)
+		minter := types.DefaultInitialMinter()
+		minter.GoalBondedTransition = &transition
+		tk.MintKeeper.SetMinter(ctx, minter)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000))))
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		require.Nil(t, tk.MintKeeper.GetMinter(ctx).GoalBondedTransition)
+	})
+}
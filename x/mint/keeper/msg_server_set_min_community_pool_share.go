@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// SetMinCommunityPoolShare sets the minimum community pool share the distribution proportions must
+// meet, the current proportions must meet the new minimum
+func (k msgServer) SetMinCommunityPoolShare(goCtx context.Context, msg *types.MsgSetMinCommunityPoolShare) (*types.MsgSetMinCommunityPoolShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := checkExpectedChainID(ctx, msg.ExpectedChainId); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateMinCommunityPoolShareChange(msg.MinCommunityPoolShare, msg.AcknowledgeMinCommunityPoolShare); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	if params.MinCommunityPoolShare.Equal(msg.MinCommunityPoolShare) {
+		return nil, types.ErrNoParamChange
+	}
+	params.MinCommunityPoolShare = msg.MinCommunityPoolShare
+	if err := k.setProposedParams(ctx, msg, msg.Authority, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMinCommunityPoolShareResponse{}, nil
+}
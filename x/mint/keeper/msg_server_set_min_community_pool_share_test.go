@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgSetMinCommunityPoolShare(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+	fundedAddr := sample.Address(r)
+
+	// the community pool receives 0.1 of the minted coins
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(5, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(4, 1),
+		CommunityPool:    sdk.NewDecWithPrec(1, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	params.FundedAddresses = []types.WeightedAddress{{Address: fundedAddr, Weight: sdk.OneDec()}}
+	tk.MintKeeper.SetParams(sdkCtx, params)
+
+	setMinShare := func(share sdk.Dec) *types.MsgSetMinCommunityPoolShare {
+		msg := types.NewMsgSetMinCommunityPoolShare(authority, share)
+		msg.AcknowledgeMinCommunityPoolShare = true
+		return msg
+	}
+	withProportions := func(staking, funded, community string) types.Params {
+		p := tk.MintKeeper.GetParams(sdkCtx)
+		p.DistributionProportions = types.DistributionProportions{
+			Staking:          sdk.MustNewDecFromStr(staking),
+			FundedAddresses:  sdk.MustNewDecFromStr(funded),
+			CommunityPool:    sdk.MustNewDecFromStr(community),
+			StrategicReserve: sdk.ZeroDec(),
+		}
+		return p
+	}
+
+	t.Run("should prevent setting the min community pool share from a non authority address", func(t *testing.T) {
+		msg := setMinShare(sdk.NewDecWithPrec(5, 2))
+		msg.Authority = sample.Address(r)
+		_, err := ts.MintSrv.SetMinCommunityPoolShare(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("should prevent setting an unacknowledged min community pool share", func(t *testing.T) {
+		_, err := ts.MintSrv.SetMinCommunityPoolShare(ctx, types.NewMsgSetMinCommunityPoolShare(authority, sdk.NewDecWithPrec(5, 2)))
+		require.ErrorIs(t, err, types.ErrMinCommunityPoolShare)
+		require.True(t, tk.MintKeeper.GetParams(sdkCtx).MinCommunityPoolShare.IsZero())
+	})
+
+	t.Run("should prevent setting a min community pool share above the current community pool share", func(t *testing.T) {
+		_, err := ts.MintSrv.SetMinCommunityPoolShare(ctx, setMinShare(sdk.MustNewDecFromStr("0.100000000000000001")))
+		require.ErrorIs(t, err, types.ErrInvalidProportions)
+		require.ErrorContains(t, err, "required minimum 0.100000000000000001")
+	})
+
+	t.Run("should prevent setting a min community pool share greater than one", func(t *testing.T) {
+		_, err := ts.MintSrv.SetMinCommunityPoolShare(ctx, setMinShare(sdk.NewDec(2)))
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+	})
+
+	t.Run("should set a min community pool share equal to the current community pool share", func(t *testing.T) {
+		_, err := ts.MintSrv.SetMinCommunityPoolShare(ctx, setMinShare(sdk.NewDecWithPrec(1, 1)))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecWithPrec(1, 1), tk.MintKeeper.GetParams(sdkCtx).MinCommunityPoolShare)
+
+		change, found := tk.MintKeeper.GetLastParamsChange(sdkCtx)
+		require.True(t, found)
+		require.Equal(t, "/modules.mint.MsgSetMinCommunityPoolShare", change.MsgType)
+
+		_, err = ts.MintSrv.SetMinCommunityPoolShare(ctx, setMinShare(sdk.NewDecWithPrec(1, 1)))
+		require.ErrorIs(t, err, types.ErrNoParamChange)
+	})
+
+	t.Run("should prevent updating the params to a community pool share below the minimum", func(t *testing.T) {
+		msg := types.NewMsgUpdateParams(authority, withProportions("0.55", "0.36", "0.09"))
+		_, err := ts.MintSrv.UpdateParams(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidProportions)
+		require.ErrorContains(t, err, "required minimum 0.100000000000000000")
+	})
+
+	t.Run("should update the params to a community pool share equal to the minimum", func(t *testing.T) {
+		msg := types.NewMsgUpdateParams(authority, withProportions("0.6", "0.3", "0.1"))
+		_, err := ts.MintSrv.UpdateParams(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecWithPrec(3, 1), tk.MintKeeper.GetParams(sdkCtx).DistributionProportions.FundedAddresses)
+	})
+
+	t.Run("should prevent changing the min community pool share with the params update", func(t *testing.T) {
+		p := withProportions("0.5", "0.3", "0.2")
+		p.MinCommunityPoolShare = sdk.NewDecWithPrec(2, 1)
+		_, err := ts.MintSrv.UpdateParams(ctx, types.NewMsgUpdateParams(authority, p))
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+		require.ErrorContains(t, err, "/modules.mint.MsgSetMinCommunityPoolShare")
+		require.Equal(t, sdk.NewDecWithPrec(1, 1), tk.MintKeeper.GetParams(sdkCtx).MinCommunityPoolShare)
+	})
+
+	t.Run("should count the funded addresses share in the community pool share without funded address", func(t *testing.T) {
+		p := withProportions("0.9", "0.05", "0.05")
+		p.FundedAddresses = nil
+		_, err := ts.MintSrv.UpdateParams(ctx, types.NewMsgUpdateParams(authority, p))
+		require.NoError(t, err)
+
+		// adding the first funded address drops the community pool share to 0.05
+		_, err = ts.MintSrv.AddFundedAddress(ctx, types.NewMsgAddFundedAddress(authority, types.WeightedAddress{
+			Address: fundedAddr,
+			Weight:  sdk.OneDec(),
+		}))
+		require.ErrorIs(t, err, types.ErrInvalidProportions)
+		require.Empty(t, tk.MintKeeper.GetParams(sdkCtx).FundedAddresses)
+	})
+
+	t.Run("should lower the min community pool share", func(t *testing.T) {
+		_, err := ts.MintSrv.SetMinCommunityPoolShare(ctx, setMinShare(sdk.ZeroDec()))
+		require.NoError(t, err)
+		require.True(t, tk.MintKeeper.GetParams(sdkCtx).MinCommunityPoolShare.IsZero())
+
+		msg, broken := keeper.AllInvariants(tk.MintKeeper)(sdkCtx)
+		require.False(t, broken, msg)
+	})
+}
+
+func TestMigrateMinCommunityPoolShare(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+	migrator := keeper.NewMigrator(tk.MintKeeper)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+
+	// the stores written before the min community pool share have no value in the subspace
+	keys := ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()
+	subspace := prefix.NewStore(ctx.KVStore(keys[paramstypes.StoreKey]), []byte(types.ModuleName+"/"))
+	require.True(t, subspace.Has(types.KeyMinCommunityPoolShare))
+	subspace.Delete(types.KeyMinCommunityPoolShare)
+
+	require.NoError(t, migrator.Migrate2to3(ctx))
+	require.True(t, subspace.Has(types.KeyMinCommunityPoolShare))
+	require.True(t, tk.MintKeeper.GetParams(ctx).MinCommunityPoolShare.IsZero())
+}
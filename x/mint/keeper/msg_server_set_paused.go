@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// SetPaused pauses or resumes minting or the distribution of a category of the minted coins
+func (k msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := checkExpectedChainID(ctx, msg.ExpectedChainId); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	switch msg.Target {
+	case types.PAUSE_TARGET_MINTING:
+		params.PauseMinting = msg.Paused
+	case types.PAUSE_TARGET_STAKING_SHARE:
+		params.PauseStakingShare = msg.Paused
+	case types.PAUSE_TARGET_FUNDED_SHARE:
+		params.PauseFundedShare = msg.Paused
+	case types.PAUSE_TARGET_COMMUNITY_SHARE:
+		params.PauseCommunityShare = msg.Paused
+	default:
+		return nil, errors.Wrapf(types.ErrInvalidPauseTarget, "%d", msg.Target)
+	}
+	if err := k.checkAutoPausedResume(ctx, params); err != nil {
+		return nil, err
+	}
+	if err := k.setProposedParams(ctx, msg, msg.Authority, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPausedResponse{}, nil
+}
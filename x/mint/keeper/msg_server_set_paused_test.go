@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgSetPaused(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+
+	tests := []struct {
+		name     string
+		msg      types.MsgSetPaused
+		expected func(types.Params) bool
+		err      error
+	}{
+		{
+			name: "should pause minting",
+			msg:  *types.NewMsgSetPaused(authority, types.PAUSE_TARGET_MINTING, true),
+			expected: func(p types.Params) bool {
+				return p.PauseMinting
+			},
+		},
+		{
+			name: "should pause the staking share",
+			msg:  *types.NewMsgSetPaused(authority, types.PAUSE_TARGET_STAKING_SHARE, true),
+			expected: func(p types.Params) bool {
+				return p.PauseStakingShare
+			},
+		},
+		{
+			name: "should pause the funded share",
+			msg:  *types.NewMsgSetPaused(authority, types.PAUSE_TARGET_FUNDED_SHARE, true),
+			expected: func(p types.Params) bool {
+				return p.PauseFundedShare
+			},
+		},
+		{
+			name: "should pause the community share",
+			msg:  *types.NewMsgSetPaused(authority, types.PAUSE_TARGET_COMMUNITY_SHARE, true),
+			expected: func(p types.Params) bool {
+				return p.PauseCommunityShare
+			},
+		},
+		{
+			name: "should resume minting",
+			msg:  *types.NewMsgSetPaused(authority, types.PAUSE_TARGET_MINTING, false),
+			expected: func(p types.Params) bool {
+				return !p.PauseMinting && p.PauseStakingShare
+			},
+		},
+		{
+			name: "should prevent setting pause flags from a non authority address",
+			msg:  *types.NewMsgSetPaused(sample.Address(r), types.PAUSE_TARGET_MINTING, true),
+			err:  types.ErrUnauthorized,
+		},
+		{
+			name: "should prevent setting pause flags for an invalid target",
+			msg:  *types.NewMsgSetPaused(authority, types.PauseTarget(100), true),
+			err:  types.ErrInvalidPauseTarget,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ts.MintSrv.SetPaused(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.expected(tk.MintKeeper.GetParams(sdkCtx)))
+		})
+	}
+}
+
+func TestMsgSetPausedMintConfigs(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+	params := types.DefaultParams()
+	params.MintConfigs = []types.MintConfig{fooMintConfig()}
+	tk.MintKeeper.SetParams(sdkCtx, params)
+
+	// minting is paused for all the denoms, the share pauses only apply to the mint denom
+	_, err := ts.MintSrv.SetPaused(ctx, types.NewMsgSetPaused(authority, types.PAUSE_TARGET_MINTING, true))
+	require.NoError(t, err)
+	_, err = ts.MintSrv.SetPaused(ctx, types.NewMsgSetPaused(authority, types.PAUSE_TARGET_STAKING_SHARE, true))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	require.False(t, tk.MintKeeper.GetParams(sdkCtx).PauseStakingShare)
+}
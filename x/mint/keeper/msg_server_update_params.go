@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// UpdateParams updates all the parameters of the module
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := checkExpectedChainID(ctx, msg.ExpectedChainId); err != nil {
+		return nil, err
+	}
+	// the message may not have been normalized by ValidateBasic when executed by the authority
+	if err := msg.ResolveInflationBasisPoints(); err != nil {
+		return nil, err
+	}
+	params := msg.Params
+	params.FundedAddresses = types.NormalizeWeightedAddresses(params.FundedAddresses)
+	// the params are validated before they are compared with the current params, the invalid
+	// params are rejected as invalid rather than as a large change
+	if err := validateProposedParams(params); err != nil {
+		return nil, err
+	}
+	currentParams := k.GetParams(ctx)
+	if !params.MinCommunityPoolShare.Equal(currentParams.MinCommunityPoolShare) {
+		return nil, errors.Wrapf(
+			types.ErrInvalidParams,
+			"min community pool share can only be changed by %s",
+			sdk.MsgTypeURL(&types.MsgSetMinCommunityPoolShare{}),
+		)
+	}
+	if bytes.Equal(k.cdc.MustMarshal(&params), k.cdc.MustMarshal(&currentParams)) {
+		return nil, types.ErrNoParamChange
+	}
+	if err := currentParams.CheckLargeChange(params, msg.AcknowledgeLargeChange); err != nil {
+		return nil, err
+	}
+	if err := k.checkAutoPausedResume(ctx, params); err != nil {
+		return nil, err
+	}
+	if params.BootstrapOverride != currentParams.BootstrapOverride {
+		if err := params.BootstrapOverride.CheckEndHeight(ctx.BlockHeight()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.setProposedParams(ctx, msg, msg.Authority, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateProposedParams checks the params proposed to replace the params of the module
+func validateProposedParams(params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return types.InvalidParamsError(err)
+	}
+	return nil
+}
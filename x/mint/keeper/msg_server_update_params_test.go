@@ -0,0 +1,559 @@
+package keeper_test
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+	fundedAddr := sample.Address(r)
+	acknowledged := func(msg *types.MsgUpdateParams) types.MsgUpdateParams {
+		msg.AcknowledgeLargeChange = true
+		return *msg
+	}
+
+	params := types.DefaultParams()
+	params.InflationMax = sdk.NewDecWithPrec(30, 2)
+	params.FundedAddresses = []types.WeightedAddress{{Address: fundedAddr, Weight: sdk.OneDec()}}
+
+	invalidParams := types.DefaultParams()
+	invalidParams.InflationMax = sdk.NewDec(2)
+	invalidProportions := types.DefaultParams()
+	invalidProportions.DistributionProportions.Staking = sdk.OneDec()
+	zeroGoalBonded := types.DefaultParams()
+	zeroGoalBonded.GoalBonded = sdk.ZeroDec()
+	invalidWeightSum := types.DefaultParams()
+	invalidWeightSum.FundedAddresses = []types.WeightedAddress{{Address: fundedAddr, Weight: sdk.NewDecWithPrec(5, 1)}}
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "should update the params",
+			msg:  acknowledged(types.NewMsgUpdateParams(authority, params)),
+		},
+		{
+			name: "should prevent updating the params from a non authority address",
+			msg:  *types.NewMsgUpdateParams(sample.Address(r), params),
+			err:  types.ErrUnauthorized,
+		},
+		{
+			name: "should prevent updating invalid params",
+			msg:  *types.NewMsgUpdateParams(authority, invalidParams),
+			err:  types.ErrInvalidParams,
+		},
+		{
+			name: "should prevent updating the params with a zero goal bonded ratio",
+			msg:  *types.NewMsgUpdateParams(authority, zeroGoalBonded),
+			err:  types.ErrInvalidParams,
+		},
+		{
+			name: "should prevent updating the params with invalid distribution proportions",
+			msg:  *types.NewMsgUpdateParams(authority, invalidProportions),
+			err:  types.ErrInvalidProportions,
+		},
+		{
+			name: "should prevent updating the params with an invalid funded address weight sum",
+			msg:  *types.NewMsgUpdateParams(authority, invalidWeightSum),
+			err:  types.ErrInvalidWeightSum,
+		},
+		{
+			name: "should prevent updating the params without change",
+			msg:  acknowledged(types.NewMsgUpdateParams(authority, params)),
+			err:  types.ErrNoParamChange,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ts.MintSrv.UpdateParams(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Params, tk.MintKeeper.GetParams(sdkCtx))
+
+			changes, _, err := tk.MintKeeper.GetFundedAddressHistory(sdkCtx, fundedAddr, nil)
+			require.NoError(t, err)
+			require.Len(t, changes, 1)
+			require.Equal(t, types.WeightChangeAdded, changes[0].Action)
+		})
+	}
+}
+
+func TestMsgUpdateParamsInflationBasisPoints(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	authority := tk.MintKeeper.GetAuthority()
+
+	t.Run("should prevent updating the params with inconsistent basis points", func(t *testing.T) {
+		msg := types.NewMsgUpdateParams(authority, types.DefaultParams())
+		msg.InflationMaxBps = types.NewBasisPoints(2100)
+		_, err := ts.MintSrv.UpdateParams(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidBasisPoints)
+	})
+
+	t.Run("should prevent updating the params with out of range basis points", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.InflationMax = sdk.Dec{}
+		msg := types.NewMsgUpdateParams(authority, params)
+		msg.InflationMaxBps = types.NewBasisPoints(10001)
+		_, err := ts.MintSrv.UpdateParams(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidBasisPoints)
+	})
+
+	t.Run("should update the inflation bounds in basis points", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.InflationMin = sdk.Dec{}
+		params.InflationMax = sdk.Dec{}
+		msg := types.NewMsgUpdateParams(authority, params)
+		msg.InflationMinBps = types.NewBasisPoints(800)
+		msg.InflationMaxBps = types.NewBasisPoints(2200)
+		_, err := ts.MintSrv.UpdateParams(ctx, msg)
+		require.NoError(t, err)
+
+		updated := tk.MintKeeper.GetParams(sdkCtx)
+		require.Equal(t, sdk.NewDecWithPrec(8, 2), updated.InflationMin)
+		require.Equal(t, sdk.NewDecWithPrec(22, 2), updated.InflationMax)
+	})
+}
+
+func TestMsgUpdateParamsLargeChange(t *testing.T) {
+	// the default params have a threshold of 0.05 with max and min inflations of 0.2 and 0.07
+	withBounds := func(max, min string) types.Params {
+		params := types.DefaultParams()
+		params.InflationMax = sdk.MustNewDecFromStr(max)
+		params.InflationMin = sdk.MustNewDecFromStr(min)
+		return params
+	}
+	raisedThreshold := withBounds("0.3", "0.07")
+	raisedThreshold.LargeChangeThreshold = sdk.NewDecWithPrec(2, 1)
+	withPhase := func(max string) types.Params {
+		params := types.DefaultParams()
+		params.Phases = []types.Phase{{
+			Name:         "boost",
+			StartHeight:  100,
+			InflationMin: params.InflationMin,
+			InflationMax: sdk.MustNewDecFromStr(max),
+			GoalBonded:   params.GoalBonded,
+		}}
+		return params
+	}
+	withMintConfig := types.DefaultParams()
+	withMintConfig.MintConfigs = []types.MintConfig{fooMintConfig()}
+
+	tests := []struct {
+		name         string
+		params       types.Params
+		threshold    sdk.Dec
+		acknowledged bool
+		err          error
+		errMsg       string
+	}{
+		{
+			name:   "should update the max inflation by the threshold",
+			params: withBounds("0.25", "0.07"),
+		},
+		{
+			name:   "should update the min inflation by the threshold",
+			params: withBounds("0.2", "0.02"),
+		},
+		{
+			name:   "should update both bounds by the threshold",
+			params: withBounds("0.25", "0.02"),
+		},
+		{
+			name:   "should prevent updating the max inflation by more than the threshold",
+			params: withBounds("0.250000000000000001", "0.07"),
+			err:    types.ErrLargeChange,
+			errMsg: "inflation_max 0.200000000000000000 -> 0.250000000000000001 (delta 0.050000000000000001)",
+		},
+		{
+			name:   "should prevent updating the min inflation by more than the threshold",
+			params: withBounds("0.2", "0.019999999999999999"),
+			err:    types.ErrLargeChange,
+			errMsg: "inflation_min 0.070000000000000000 -> 0.019999999999999999 (delta 0.050000000000000001)",
+		},
+		{
+			name:   "should list the moves of both bounds",
+			params: withBounds("0.3", "0"),
+			err:    types.ErrLargeChange,
+			errMsg: "inflation_max 0.200000000000000000 -> 0.300000000000000000 (delta 0.100000000000000000), " +
+				"inflation_min 0.070000000000000000 -> 0.000000000000000000 (delta 0.070000000000000000)",
+		},
+		{
+			name:   "should add a phase moving the max inflation by the threshold",
+			params: withPhase("0.25"),
+		},
+		{
+			name:   "should prevent adding a phase moving the max inflation by more than the threshold",
+			params: withPhase("0.3"),
+			err:    types.ErrLargeChange,
+			errMsg: "phases[boost].inflation_max 0.200000000000000000 -> 0.300000000000000000 (delta 0.100000000000000000)",
+		},
+		{
+			name:   "should prevent adding a mint configuration with bounds above the threshold",
+			params: withMintConfig,
+			err:    types.ErrLargeChange,
+			errMsg: "mint_configs[foo].inflation_max 0.000000000000000000 -> 0.200000000000000000 (delta 0.200000000000000000), " +
+				"mint_configs[foo].inflation_min 0.000000000000000000 -> 0.200000000000000000 (delta 0.200000000000000000)",
+		},
+		{
+			name:         "should update the bounds by more than the threshold when acknowledged",
+			params:       withBounds("0.3", "0"),
+			acknowledged: true,
+		},
+		{
+			name:   "should prevent raising the threshold with the large change",
+			params: raisedThreshold,
+			err:    types.ErrLargeChange,
+		},
+		{
+			name:      "should prevent any move with a zero threshold",
+			params:    withBounds("0.200000000000000001", "0.07"),
+			threshold: sdk.ZeroDec(),
+			err:       types.ErrLargeChange,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sdkCtx, tk, ts := testSetups[0].setup(t)
+			if !tc.threshold.IsNil() {
+				params := types.DefaultParams()
+				params.LargeChangeThreshold = tc.threshold
+				tk.MintKeeper.SetParams(sdkCtx, params)
+				tc.params.LargeChangeThreshold = tc.threshold
+			}
+			msg := types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), tc.params)
+			msg.AcknowledgeLargeChange = tc.acknowledged
+
+			_, err := ts.MintSrv.UpdateParams(sdk.WrapSDKContext(sdkCtx), msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.params, tk.MintKeeper.GetParams(sdkCtx))
+		})
+	}
+}
+
+func TestMsgUpdateParamsCanonicalFundedAddresses(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	fundedAddr := sample.Address(r)
+	params := types.DefaultParams()
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: strings.ToUpper(fundedAddr), Weight: sdk.OneDec()},
+	}
+
+	// the message is executed without ValidateBasic like by the governance module
+	msg := types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), params)
+	msg.AcknowledgeLargeChange = true
+	_, err := ts.MintSrv.UpdateParams(sdk.WrapSDKContext(sdkCtx), msg)
+	require.NoError(t, err)
+
+	// only the canonical form is stored and recorded in the history
+	require.Equal(t, fundedAddr, tk.MintKeeper.GetParams(sdkCtx).FundedAddresses[0].Address)
+	changes, _, err := tk.MintKeeper.GetFundedAddressHistory(sdkCtx, fundedAddr, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, fundedAddr, changes[0].Address)
+
+	// the canonical form of the same params is no change
+	params.FundedAddresses[0].Address = fundedAddr
+	msg = types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), params)
+	_, err = ts.MintSrv.UpdateParams(sdk.WrapSDKContext(sdkCtx), msg)
+	require.ErrorIs(t, err, types.ErrNoParamChange)
+}
+
+func TestMsgUpdateParamsDryRun(t *testing.T) {
+	// params passing the validation but breaking the begin blocker
+	blockedFundedAddress := types.DefaultParams()
+	blockedFundedAddress.FundedAddresses = []types.WeightedAddress{{
+		Address: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+		Weight:  sdk.OneDec(),
+	}}
+	overflowingBlocksPerYear := types.DefaultParams()
+	overflowingBlocksPerYear.BlocksPerYear = math.MaxUint64
+
+	tests := []struct {
+		name   string
+		params types.Params
+		errMsg string
+	}{
+		{
+			name:   "should prevent a funded address blocked by the bank",
+			params: blockedFundedAddress,
+			errMsg: "is not allowed to receive funds",
+		},
+		{
+			name:   "should prevent a number of blocks per year overflowing the heights",
+			params: overflowingBlocksPerYear,
+			errMsg: "negative coin amount",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sdkCtx, tk, ts := testSetups[0].setup(t)
+			fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000)))
+			require.NoError(t, tc.params.Validate())
+			initialParams := tk.MintKeeper.GetParams(sdkCtx)
+			initialMinter := tk.MintKeeper.GetMinter(sdkCtx)
+
+			msg := types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), tc.params)
+			msg.AcknowledgeLargeChange = true
+			_, err := ts.MintSrv.UpdateParams(sdk.WrapSDKContext(sdkCtx), msg)
+			require.ErrorIs(t, err, types.ErrBeginBlockerDryRun)
+			require.ErrorContains(t, err, tc.errMsg)
+
+			// the dry-run is discarded
+			require.Equal(t, initialParams, tk.MintKeeper.GetParams(sdkCtx))
+			require.Equal(t, initialMinter, tk.MintKeeper.GetMinter(sdkCtx))
+			_, found := tk.MintKeeper.GetBlockDistribution(sdkCtx, sdkCtx.BlockHeight()+1)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestAuthorityMsgsDryRun(t *testing.T) {
+	// params passing the validation but breaking the begin blocker, set without a message
+	blockedFundedAddress := types.DefaultParams()
+	blockedFundedAddress.FundedAddresses = []types.WeightedAddress{{
+		Address: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+		Weight:  sdk.OneDec(),
+	}}
+
+	tests := []struct {
+		name string
+		run  func(ctx context.Context, srv types.MsgServer, authority string) error
+	}{
+		{
+			name: "should prevent MsgSetPaused keeping params breaking the begin blocker",
+			run: func(ctx context.Context, srv types.MsgServer, authority string) error {
+				_, err := srv.SetPaused(ctx, types.NewMsgSetPaused(authority, types.PAUSE_TARGET_STAKING_SHARE, true))
+				return err
+			},
+		},
+		{
+			name: "should prevent MsgSetGoalBonded keeping params breaking the begin blocker",
+			run: func(ctx context.Context, srv types.MsgServer, authority string) error {
+				_, err := srv.SetGoalBonded(ctx, types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(5, 1), 10))
+				return err
+			},
+		},
+		{
+			name: "should prevent MsgSetMinCommunityPoolShare keeping params breaking the begin blocker",
+			run: func(ctx context.Context, srv types.MsgServer, authority string) error {
+				msg := types.NewMsgSetMinCommunityPoolShare(authority, sdk.NewDecWithPrec(5, 2))
+				msg.AcknowledgeMinCommunityPoolShare = true
+				_, err := srv.SetMinCommunityPoolShare(ctx, msg)
+				return err
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sdkCtx, tk, ts := testSetups[0].setup(t)
+			fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000)))
+			tk.MintKeeper.SetParams(sdkCtx, blockedFundedAddress)
+			initialParams := tk.MintKeeper.GetParams(sdkCtx)
+			initialMinter := tk.MintKeeper.GetMinter(sdkCtx)
+
+			err := tc.run(sdk.WrapSDKContext(sdkCtx), ts.MintSrv, tk.MintKeeper.GetAuthority())
+			require.ErrorIs(t, err, types.ErrBeginBlockerDryRun)
+			require.ErrorContains(t, err, "is not allowed to receive funds")
+			require.Equal(t, initialParams, tk.MintKeeper.GetParams(sdkCtx))
+			require.Equal(t, initialMinter, tk.MintKeeper.GetMinter(sdkCtx))
+		})
+	}
+}
+
+func TestMsgUpdateParamsDryRunSideEffects(t *testing.T) {
+	ctx, tk := writeBatchSetup(t, keeper.WithProfiling())
+	hooks := testkeeper.NewMintHooksMock()
+	tk.MintKeeper.SetHooks(hooks)
+	msgSrv := keeper.NewMsgServerImpl(tk.MintKeeper)
+	ctx = ctx.WithBlockHeight(1)
+
+	// the dry run of the begin blocker calls no hook and reports no metric
+	recorder := recordMetrics(t)
+	params := tk.MintKeeper.GetParams(ctx)
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(4, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(4, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	_, err := msgSrv.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+	require.Equal(t, params.DistributionProportions, tk.MintKeeper.GetParams(ctx).DistributionProportions)
+	require.Empty(t, hooks.MintedCoins)
+	require.Empty(t, recorder.metrics)
+
+	// the begin blocker of the next block calls the hooks and reports the metrics
+	require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(2)))
+	require.Contains(t, hooks.MintedCoins, int64(2))
+	require.True(t, recorder.metrics["sample "+types.MetricKeyBeginBlocker+" {module=mint}"])
+	require.True(t, recorder.metrics["sample "+types.MetricKeyStageDuration+" {module=mint,stage="+types.ProfileStageHooks+"}"])
+}
+
+func TestBlocksPerYearChange(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000)))
+
+	transitionEvent := func(ctx sdk.Context) *types.EventBlocksPerYearTransition {
+		var transition *types.EventBlocksPerYearTransition
+		for _, event := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			if e, ok := msg.(*types.EventBlocksPerYearTransition); ok {
+				transition = e
+			}
+		}
+		return transition
+	}
+	height := int64(0)
+	mintBlock := func(t *testing.T) sdkmath.Int {
+		height++
+		ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		return mintEvent(t, ctx).Amount
+	}
+	settle := func(t *testing.T) {
+		for tk.MintKeeper.GetMinter(sdkCtx).BlocksPerYearTransition != nil {
+			mintBlock(t)
+		}
+	}
+
+	// a short year keeps the transitions, lasting a day of blocks, short
+	params := tk.MintKeeper.GetParams(sdkCtx)
+	params.BlocksPerYear = 365 * 20
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	settle(t)
+
+	requireContinuous := func(t *testing.T, change func(ctx sdk.Context, params types.Params) types.Params) {
+		params := tk.MintKeeper.GetParams(sdkCtx)
+		previous := mintBlock(t)
+		before := mintBlock(t)
+		require.True(t, before.IsPositive())
+
+		// the params change in a transaction of the block
+		ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		newParams := change(ctx, params)
+		event := transitionEvent(ctx)
+		require.NotNil(t, event)
+		require.Equal(t, params.BlocksPerYear, event.OldBlocksPerYear)
+		require.Equal(t, newParams.BlocksPerYear, event.NewBlocksPerYear)
+		require.Equal(t, height+1, event.StartHeight)
+		require.Equal(t, height+1+int64(newParams.BlocksPerYear/365), event.EndHeight)
+		require.True(t, sdk.NewDecFromInt(before).Sub(event.BlockProvision).Abs().LTE(sdk.OneDec()))
+		require.Equal(t, event.EndHeight, tk.MintKeeper.GetMinter(sdkCtx).BlocksPerYearTransition.EndHeight)
+
+		// the coins minted in the block after the change only move with the inflation and the supply
+		// like between the blocks before the change, within one coin, then move to the provision at
+		// the new blocks per year without jump. The inflation moves up to twice as fast per block at
+		// half the blocks per year.
+		minted := mintBlock(t)
+		require.True(t, minted.Sub(before).Abs().LTE(before.Sub(previous).Abs().MulRaw(2).AddRaw(1)),
+			"before %s then %s, after %s", previous, before, minted)
+		for height < event.EndHeight {
+			next := mintBlock(t)
+			require.True(t, next.Sub(minted).Abs().LTE(before.QuoRaw(10)), "height %d: %s then %s", height, minted, next)
+			minted = next
+		}
+		require.Nil(t, tk.MintKeeper.GetMinter(sdkCtx).BlocksPerYearTransition)
+
+		expected := sdk.NewDecFromInt(before).MulInt64(int64(params.BlocksPerYear)).QuoInt64(int64(newParams.BlocksPerYear))
+		require.True(t, sdk.NewDecFromInt(minted).Sub(expected).Abs().LTE(expected.QuoInt64(100)),
+			"expected about %s, got %s", expected, minted)
+	}
+
+	t.Run("should keep the minted coins continuous when doubling the blocks per year", func(t *testing.T) {
+		requireContinuous(t, func(ctx sdk.Context, params types.Params) types.Params {
+			params.BlocksPerYear *= 2
+			_, err := ts.MintSrv.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), params))
+			require.NoError(t, err)
+			return params
+		})
+	})
+
+	t.Run("should keep the minted coins continuous when the keeper halves the blocks per year", func(t *testing.T) {
+		requireContinuous(t, func(ctx sdk.Context, params types.Params) types.Params {
+			params.BlocksPerYear /= 2
+			tk.MintKeeper.SetParams(ctx, params)
+			return params
+		})
+	})
+
+	t.Run("should start the transition from a pending transition", func(t *testing.T) {
+		params := tk.MintKeeper.GetParams(sdkCtx)
+		mintBlock(t)
+		ctx := sdkCtx.WithBlockHeight(height)
+		doubled := params
+		doubled.BlocksPerYear *= 2
+		tk.MintKeeper.SetParams(ctx, doubled)
+		mintBlock(t)
+		mintBlock(t)
+		pending := tk.MintKeeper.GetMinter(sdkCtx).BlocksPerYearTransition.BlocksPerYearAt(height)
+
+		tk.MintKeeper.SetParams(ctx.WithBlockHeight(height), params)
+		transition := tk.MintKeeper.GetMinter(sdkCtx).BlocksPerYearTransition
+		require.Equal(t, pending, transition.From)
+		require.Equal(t, params.BlocksPerYear, transition.To)
+		settle(t)
+	})
+
+	t.Run("should not start a transition when the blocks per year are unchanged", func(t *testing.T) {
+		ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		params := tk.MintKeeper.GetParams(ctx)
+		params.InflationMax = params.InflationMax.Add(sdk.NewDecWithPrec(1, 2))
+		tk.MintKeeper.SetParams(ctx, params)
+		require.Nil(t, transitionEvent(ctx))
+		require.Nil(t, tk.MintKeeper.GetMinter(ctx).BlocksPerYearTransition)
+	})
+}
+
+func TestInflationCalculationModeSwitch(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000)))
+	params := tk.MintKeeper.GetParams(sdkCtx)
+	tk.MintKeeper.SetMinter(sdkCtx, types.InitialMinter(sdk.NewDecWithPrec(10, 2)))
+	rateChange := params.InflationRateChange.QuoInt64(int64(params.BlocksPerYear))
+
+	// nothing is bonded, the goal bonded inflation increases toward the max inflation
+	require.Equal(t, types.INFLATION_CALCULATION_MODE_GOAL_BONDED, params.InflationCalculationMode)
+	require.True(t, tk.MintKeeper.BondedRatio(sdkCtx).IsZero())
+	require.NoError(t, tk.MintKeeper.BeginBlocker(sdkCtx.WithBlockHeight(1)))
+	inflation := tk.MintKeeper.GetMinter(sdkCtx).Inflation
+	require.Equal(t, sdk.NewDecWithPrec(10, 2).Add(rateChange), inflation)
+
+	params.InflationCalculationMode = types.INFLATION_CALCULATION_MODE_LINEAR
+	_, err := ts.MintSrv.UpdateParams(sdk.WrapSDKContext(sdkCtx), types.NewMsgUpdateParams(tk.MintKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+
+	// the linear inflation decreases from the next block
+	require.NoError(t, tk.MintKeeper.BeginBlocker(sdkCtx.WithBlockHeight(2)))
+	require.Equal(t, inflation.Sub(rateChange), tk.MintKeeper.GetMinter(sdkCtx).Inflation)
+}
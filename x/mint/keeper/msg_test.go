@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgExpectedChainID(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	ctx := sdk.WrapSDKContext(sdkCtx.WithChainID("mint-1"))
+	authority := tk.MintKeeper.GetAuthority()
+
+	execute := func(expectedChainID string, paused bool) []error {
+		setPaused := types.NewMsgSetPaused(authority, types.PAUSE_TARGET_MINTING, paused)
+		setPaused.ExpectedChainId = expectedChainID
+		_, setPausedErr := ts.MintSrv.SetPaused(ctx, setPaused)
+
+		params := tk.MintKeeper.GetParams(sdkCtx)
+		params.BlocksPerYear++
+		updateParams := types.NewMsgUpdateParams(authority, params)
+		updateParams.ExpectedChainId = expectedChainID
+		_, updateParamsErr := ts.MintSrv.UpdateParams(ctx, updateParams)
+
+		setGoalBonded := types.NewMsgSetGoalBonded(authority, sdk.NewDecWithPrec(50, 2), 0)
+		setGoalBonded.ExpectedChainId = expectedChainID
+		_, setGoalBondedErr := ts.MintSrv.SetGoalBonded(ctx, setGoalBonded)
+
+		return []error{setPausedErr, updateParamsErr, setGoalBondedErr}
+	}
+
+	for _, err := range execute("mint-2", true) {
+		require.ErrorIs(t, err, types.ErrChainIDMismatch)
+	}
+	require.Equal(t, types.DefaultParams(), tk.MintKeeper.GetParams(sdkCtx))
+
+	for _, err := range execute("mint-1", true) {
+		require.NoError(t, err)
+	}
+	require.True(t, tk.MintKeeper.GetParams(sdkCtx).PauseMinting)
+
+	// the check is skipped without expected chain-id
+	for _, err := range execute("", false) {
+		require.NoError(t, err)
+	}
+	require.False(t, tk.MintKeeper.GetParams(sdkCtx).PauseMinting)
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetLastParamsChange returns the last change of the params, the params changed directly
+// through the params subspace are not recorded
+func (k Keeper) GetLastParamsChange(ctx sdk.Context) (change types.ParamsChange, found bool) {
+	return k.collections.paramsChange.Get(ctx)
+}
+
+func (k Keeper) setLastParamsChange(ctx sdk.Context, change types.ParamsChange) {
+	k.collections.paramsChange.Set(ctx, change)
+}
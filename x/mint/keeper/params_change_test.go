@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestLastParamsChange(t *testing.T) {
+	sdkCtx, tk, ts := testSetups[0].setup(t)
+	authority := tk.MintKeeper.GetAuthority()
+
+	t.Run("should record the genesis as the source of the params", func(t *testing.T) {
+		mint.InitGenesis(sdkCtx, tk.MintKeeper, tk.AccountKeeper, types.DefaultGenesis())
+
+		change, found := tk.MintKeeper.GetLastParamsChange(sdkCtx)
+		require.True(t, found)
+		require.Equal(t, types.GenesisParamsChange(), change)
+	})
+
+	t.Run("should record the message and the transaction of the change", func(t *testing.T) {
+		txBytes := []byte("tx")
+		ctx := sdkCtx.WithBlockHeight(10).WithTxBytes(txBytes)
+		msg := types.NewMsgSetPaused(authority, types.PAUSE_TARGET_MINTING, true)
+		_, err := ts.MintSrv.SetPaused(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		change, found := tk.MintKeeper.GetLastParamsChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ParamsChange{
+			Height:    10,
+			Authority: authority,
+			MsgType:   sdk.MsgTypeURL(msg),
+			TxHash:    fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		}, change)
+
+		params, err := querier.NewQuerier(tk.MintKeeper).Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &change, params.LastChange)
+
+		status, err := querier.NewQuerier(tk.MintKeeper).Status(sdk.WrapSDKContext(ctx), &types.QueryStatusRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &change, status.LastParamsChange)
+	})
+
+	t.Run("should not record a rejected change", func(t *testing.T) {
+		ctx := sdkCtx.WithBlockHeight(11)
+		msg := types.NewMsgSetPaused(sample.Address(r), types.PAUSE_TARGET_MINTING, false)
+		_, err := ts.MintSrv.SetPaused(sdk.WrapSDKContext(ctx), msg)
+		require.Error(t, err)
+
+		change, found := tk.MintKeeper.GetLastParamsChange(ctx)
+		require.True(t, found)
+		require.EqualValues(t, 10, change.Height)
+	})
+
+	t.Run("should record the keeper as the source of the params", func(t *testing.T) {
+		ctx := sdkCtx.WithBlockHeight(12)
+		tk.MintKeeper.SetParams(ctx, types.DefaultParams())
+
+		change, found := tk.MintKeeper.GetLastParamsChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ParamsChange{
+			Height:    12,
+			Authority: authority,
+			MsgType:   types.ParamsChangeSourceKeeper,
+		}, change)
+	})
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// phasedParams returns params with a schedule of three phases and an inflation changing by 0.01%
+// per block while nothing is bonded
+func phasedParams() types.Params {
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	params.InflationRateChange = sdk.NewDecWithPrec(1, 2)
+	params.Phases = []types.Phase{
+		{
+			Name:         "bootstrap",
+			StartHeight:  5,
+			InflationMin: sdk.NewDecWithPrec(15, 2),
+			InflationMax: sdk.NewDecWithPrec(20, 2),
+			GoalBonded:   types.DefaultGoalBonded,
+		},
+		{
+			Name:         "growth",
+			StartHeight:  10,
+			InflationMin: sdk.NewDecWithPrec(5, 2),
+			InflationMax: sdk.NewDecWithPrec(10, 2),
+			GoalBonded:   types.DefaultGoalBonded,
+		},
+		{
+			Name:         "maturity",
+			StartHeight:  20,
+			InflationMin: sdk.NewDecWithPrec(8, 2),
+			InflationMax: sdk.NewDecWithPrec(12, 2),
+			GoalBonded:   types.DefaultGoalBonded,
+		},
+	}
+	return params
+}
+
+func TestBeginBlockerPhases(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	params := phasedParams()
+	require.NoError(t, params.Validate())
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	tk.MintKeeper.SetMinter(sdkCtx, types.InitialMinter(sdk.NewDecWithPrec(13, 2)))
+	fundSupply(t, sdkCtx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000))))
+
+	// the inflation continues from the previous block, clamped into the bounds of a new phase
+	expected := map[int64]sdk.Dec{
+		1:  sdk.MustNewDecFromStr("0.1301"),
+		4:  sdk.MustNewDecFromStr("0.1304"),
+		5:  sdk.MustNewDecFromStr("0.15"),
+		6:  sdk.MustNewDecFromStr("0.1501"),
+		9:  sdk.MustNewDecFromStr("0.1504"),
+		10: sdk.MustNewDecFromStr("0.10"),
+		19: sdk.MustNewDecFromStr("0.10"),
+		20: sdk.MustNewDecFromStr("0.1001"),
+		21: sdk.MustNewDecFromStr("0.1002"),
+	}
+	for height := int64(1); height <= 21; height++ {
+		ctx := sdkCtx.WithBlockHeight(height)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+		if inflation, ok := expected[height]; ok {
+			require.Equal(t, inflation, tk.MintKeeper.GetMinter(ctx).Inflation, "height %d", height)
+		}
+	}
+}
+
+func TestActivePhaseQueries(t *testing.T) {
+	sdkCtx, tk, _ := testSetups[0].setup(t)
+	params := phasedParams()
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	querier := querier.NewQuerier(tk.MintKeeper)
+
+	for _, tc := range []struct {
+		height int64
+		phase  *types.Phase
+	}{
+		{height: 4, phase: nil},
+		{height: 5, phase: &params.Phases[0]},
+		{height: 12, phase: &params.Phases[1]},
+		{height: 20, phase: &params.Phases[2]},
+	} {
+		ctx := sdk.WrapSDKContext(sdkCtx.WithBlockHeight(tc.height))
+
+		paramsRes, err := querier.Params(ctx, &types.QueryParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, params, paramsRes.Params)
+		require.Equal(t, tc.phase, paramsRes.ActivePhase, "height %d", tc.height)
+
+		statusRes, err := querier.Status(ctx, &types.QueryStatusRequest{})
+		require.NoError(t, err)
+		require.Equal(t, tc.phase, statusRes.ActivePhase, "height %d", tc.height)
+	}
+}
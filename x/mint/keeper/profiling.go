@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// blockProfileKey is the context key of the profile of the block being profiled
+type blockProfileKey struct{}
+
+// stageProfile is the duration and the gas consumed by a stage of the begin blocker, summed over
+// the runs of the stage in the block
+type stageProfile struct {
+	stage    string
+	duration time.Duration
+	gas      uint64
+}
+
+// blockProfile is the profile of the stages of the begin blocker of a block, in the order of their
+// first run
+type blockProfile struct {
+	stages []stageProfile
+}
+
+// add adds a run of a stage to the profile
+func (p *blockProfile) add(stage string, duration time.Duration, gas uint64) {
+	for i := range p.stages {
+		if p.stages[i].stage == stage {
+			p.stages[i].duration += duration
+			p.stages[i].gas += gas
+			return
+		}
+	}
+	p.stages = append(p.stages, stageProfile{stage: stage, duration: duration, gas: gas})
+}
+
+// noopStage ends a stage that is not profiled
+func noopStage() {}
+
+// profileBlock returns a context profiling the stages of the begin blocker and the function
+// logging the summary of the profile of the block. The context is returned unchanged if the
+// profiling is disabled or the begin blocker is dry run.
+func (k Keeper) profileBlock(ctx sdk.Context) (sdk.Context, func()) {
+	if !k.profiling || isDryRun(ctx) {
+		return ctx, noopStage
+	}
+
+	profile := &blockProfile{}
+	return ctx.WithValue(blockProfileKey{}, profile), func() {
+		keyvals := make([]interface{}, 0, 2+2*len(profile.stages))
+		keyvals = append(keyvals, "height", ctx.BlockHeight())
+		for _, s := range profile.stages {
+			keyvals = append(keyvals, s.stage, fmt.Sprintf("%s gas=%d", s.duration, s.gas))
+		}
+		k.Logger(ctx).Debug("begin blocker profile", keyvals...)
+	}
+}
+
+// profileStage starts the profiling of a stage of the begin blocker and returns the function ending
+// it, the duration and the gas consumed by the stage are reported to the telemetry and added to
+// the profile of the block. Nothing is measured if the context doesn't profile the block.
+func (k Keeper) profileStage(ctx sdk.Context, stage string) func() {
+	if !k.profiling {
+		return noopStage
+	}
+	profile, ok := ctx.Value(blockProfileKey{}).(*blockProfile)
+	if !ok {
+		return noopStage
+	}
+
+	start := time.Now()
+	gasStart := ctx.GasMeter().GasConsumed()
+	return func() {
+		duration := time.Since(start)
+		gas := ctx.GasMeter().GasConsumed() - gasStart
+		labels := []metrics.Label{
+			telemetry.NewLabel(types.MetricLabelModule, types.ModuleName),
+			telemetry.NewLabel(types.MetricLabelStage, stage),
+		}
+		metrics.MeasureSinceWithLabels([]string{types.MetricKeyStageDuration}, start.UTC(), labels)
+		telemetry.IncrCounterWithLabels([]string{types.MetricKeyStageGas}, float32(gas), labels)
+		profile.add(stage, duration, gas)
+	}
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// stageMetrics returns the names of the duration samples and of the gas counters of a stage
+func stageMetrics(stage string) []string {
+	return []string{
+		"sample " + types.MetricKeyStageDuration + " {module=mint,stage=" + stage + "}",
+		"counter " + types.MetricKeyStageGas + " {module=mint,stage=" + stage + "}",
+	}
+}
+
+func TestProfiling(t *testing.T) {
+	t.Run("should report every stage of a profiled block", func(t *testing.T) {
+		ctx, tk := writeBatchSetup(t, keeper.WithProfiling())
+		tk.MintKeeper.SetHooks(&branchingHooks{keeper: tk.MintKeeper})
+
+		recorder := recordMetrics(t)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())))
+		for _, stage := range types.ProfileStages {
+			for _, name := range stageMetrics(stage) {
+				require.True(t, recorder.metrics[name], "missing %s", name)
+			}
+		}
+	})
+
+	t.Run("should report no stage without profiling", func(t *testing.T) {
+		ctx, tk := writeBatchSetup(t)
+		tk.MintKeeper.SetHooks(&branchingHooks{keeper: tk.MintKeeper})
+
+		recorder := recordMetrics(t)
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())))
+		for _, stage := range types.ProfileStages {
+			for _, name := range stageMetrics(stage) {
+				require.False(t, recorder.metrics[name], "unexpected %s", name)
+			}
+		}
+		require.True(t, recorder.metrics["sample "+types.MetricKeyBeginBlocker+" {module=mint}"])
+	})
+}
+
+// BenchmarkBeginBlockerProfiling compares the begin blocker of a keeper without profiling, the
+// disabled stages are skipped without allocation, and of a profiled keeper
+func BenchmarkBeginBlockerProfiling(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		opts []keeper.KeeperOption
+	}{
+		{name: "disabled"},
+		{name: "profiled", opts: []keeper.KeeperOption{keeper.WithProfiling()}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			ctx, tk := writeBatchSetup(b, bc.opts...)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(int64(i+1))))
+			}
+		})
+	}
+}
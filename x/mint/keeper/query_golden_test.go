@@ -0,0 +1,322 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+const (
+	// queryService is the full name of the query service of the module
+	queryService = "modules.mint.Query"
+
+	// queryFixtureHeight is the height of the canonical state the queries are run against
+	queryFixtureHeight = 20
+
+	// the addresses of the canonical state are fixed so the golden responses don't depend on the
+	// tests run before
+	queryFundedAddr1 = "cosmos1ezptsm3npn54qx9vvpah4nymre59ykr9967vj9"
+	queryFundedAddr2 = "cosmos1aqn8ynvr3jmq67879qulzrwhchq5dtrvh6h4er"
+	queryHolderAddr  = "cosmos1d45kuapdw96k2une945x7mryv4ez6tfdhn89tf"
+)
+
+var (
+	queryValAddr = sdk.ValAddress(sdk.MustAccAddressFromBech32(queryHolderAddr))
+
+	// queryGenesisTime is the time of the first block of the canonical state
+	queryGenesisTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// queryGoldenCase is a request to a method of the query service run against the canonical state,
+// the proto-JSON of its response is compared with the golden file testdata/query/<name>.golden.
+// Every method of the query service must have at least one case. The golden file of a case
+// expecting an error code holds the gRPC status of the error instead.
+type queryGoldenCase struct {
+	name    string
+	method  string
+	request gogoproto.Message
+	code    codes.Code
+}
+
+// queryGoldenCases returns the requests of the golden responses, a new query endpoint registers
+// its requests here
+func queryGoldenCases(params types.Params) []queryGoldenCase {
+	proposedParams := params
+	proposedParams.InflationMax = sdk.NewDecWithPrec(25, 2)
+
+	return []queryGoldenCase{
+		{name: "Params", method: "Params", request: &types.QueryParamsRequest{}},
+		{name: "Inflation", method: "Inflation", request: &types.QueryInflationRequest{}},
+		{name: "AnnualProvisions", method: "AnnualProvisions", request: &types.QueryAnnualProvisionsRequest{}},
+		{name: "Minter", method: "Minter", request: &types.QueryMinterRequest{}},
+		{name: "AdminCapabilities", method: "AdminCapabilities", request: &types.QueryAdminCapabilitiesRequest{}},
+		{
+			name:    "FundedAddressHistory",
+			method:  "FundedAddressHistory",
+			request: &types.QueryFundedAddressHistoryRequest{Address: queryFundedAddr1},
+		},
+		{
+			name:    "ParamsImpact",
+			method:  "ParamsImpact",
+			request: &types.QueryParamsImpactRequest{ProposedParams: proposedParams},
+		},
+		{name: "Status", method: "Status", request: &types.QueryStatusRequest{}},
+		{
+			name:    "ValidateParams",
+			method:  "ValidateParams",
+			request: &types.QueryValidateParamsRequest{Params: proposedParams},
+		},
+		{
+			name:    "DelegatorAPR",
+			method:  "DelegatorAPR",
+			request: &types.QueryDelegatorAPRRequest{ValidatorAddress: queryValAddr.String()},
+		},
+		{name: "ModuleAccount", method: "ModuleAccount", request: &types.QueryModuleAccountRequest{}},
+		{name: "EmissionDrift", method: "EmissionDrift", request: &types.QueryEmissionDriftRequest{}},
+		{
+			name:   "FeeAdvisory",
+			method: "FeeAdvisory",
+			request: &types.QueryFeeAdvisoryRequest{
+				TargetFeeCoverageRatio: sdk.NewDecWithPrec(5, 1),
+				AverageBlockGas:        1_000_000,
+			},
+		},
+		{
+			name:    "DistributionHistory",
+			method:  "DistributionHistory",
+			request: &types.QueryDistributionHistoryRequest{FromHeight: 15, ToHeight: queryFixtureHeight},
+		},
+		{
+			name:   "AverageInflation",
+			method: "AverageInflation",
+			request: &types.QueryAverageInflationRequest{
+				FromTime: queryGenesisTime,
+				ToTime:   queryGenesisTime.Add(time.Minute),
+			},
+		},
+		{
+			name:    "EmissionReport",
+			method:  "EmissionReport",
+			request: &types.QueryEmissionReportRequest{FromHeight: 1, ToHeight: queryFixtureHeight},
+		},
+		{
+			name:    "EstimatedDistribution",
+			method:  "EstimatedDistribution",
+			request: &types.QueryEstimatedDistributionRequest{},
+		},
+		{
+			name:   "AddressMintIncome",
+			method: "AddressMintIncome",
+			request: &types.QueryAddressMintIncomeRequest{
+				Address:    queryFundedAddr1,
+				FromHeight: 1,
+				ToHeight:   queryFixtureHeight,
+			},
+		},
+		{name: "TotalBurned", method: "TotalBurned", request: &types.QueryTotalBurnedRequest{}},
+		{name: "TotalMinted", method: "TotalMinted", request: &types.QueryTotalMintedRequest{}},
+		{
+			name:    "InflationHistory",
+			method:  "InflationHistory",
+			request: &types.QueryInflationHistoryRequest{ToHeight: queryFixtureHeight},
+		},
+		{name: "StrategicReserve", method: "StrategicReserve", request: &types.QueryStrategicReserveRequest{}},
+		{
+			name:    "UpcomingProvisions",
+			method:  "UpcomingProvisions",
+			request: &types.QueryUpcomingProvisionsRequest{Count: 5},
+		},
+		{
+			name:    "AnnualFundedProvisions",
+			method:  "AnnualFundedProvisions",
+			request: &types.QueryAnnualFundedProvisionsRequest{},
+		},
+		{
+			name:    "AnnualFundedProvisionsAddress",
+			method:  "AnnualFundedProvisions",
+			request: &types.QueryAnnualFundedProvisionsRequest{Address: queryFundedAddr2},
+		},
+		{name: "ModuleVersion", method: "ModuleVersion", request: &types.QueryModuleVersionRequest{}},
+		// the canonical state is not committed, the keeper has no state prover
+		{
+			name:    "StateProof",
+			method:  "StateProof",
+			request: &types.QueryStateProofRequest{KeyName: "minter"},
+			code:    codes.Unimplemented,
+		},
+		{name: "MintDenom", method: "MintDenom", request: &types.QueryMintDenomRequest{}},
+		{
+			name:    "DistributionProportions",
+			method:  "DistributionProportions",
+			request: &types.QueryDistributionProportionsRequest{},
+		},
+		{
+			name:    "FundedAddresses",
+			method:  "FundedAddresses",
+			request: &types.QueryFundedAddressesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}},
+		},
+	}
+}
+
+// queryFixture seeds the canonical state of the golden responses: the params with funded
+// addresses and a strategic reserve, a validator, the minter, the schema version and the
+// counters, the history and the snapshots of queryFixtureHeight blocks, and a burn
+func queryFixture(t *testing.T) (sdk.Context, testkeeper.TestKeepers, types.Params) {
+	ctx, tk, ts := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+		return validatorStakingKeeper{
+			StakingKeeper: sk,
+			validators: map[string]stakingtypes.Validator{queryValAddr.String(): {
+				OperatorAddress: queryValAddr.String(),
+				Commission:      stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.ZeroDec()),
+			}},
+			bondedTokens: sdkmath.NewInt(400_000_000),
+		}
+	})
+
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100_000
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(4, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(3, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		StrategicReserve: sdk.NewDecWithPrec(1, 1),
+	}
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: queryFundedAddr1, Weight: sdk.NewDecWithPrec(4, 1)},
+		{Address: queryFundedAddr2, Weight: sdk.NewDecWithPrec(6, 1), PayoutMode: types.PAYOUT_MODE_PULL},
+	}
+	params.InflationSnapshotInterval = 5
+	require.NoError(t, params.Validate())
+	tk.MintKeeper.SetParams(ctx, params)
+	tk.MintKeeper.SetMinter(ctx, types.InitialMinter(sdk.NewDecWithPrec(13, 2)))
+	require.NoError(t, tk.MintKeeper.InitSchemaVersion(ctx))
+
+	holder := sdk.MustAccAddressFromBech32(queryHolderAddr)
+	supply := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000_000_000))
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, supply))
+	require.NoError(t, tk.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, supply))
+
+	for height := int64(1); height <= queryFixtureHeight; height++ {
+		ctx = ctx.
+			WithBlockHeight(height).
+			WithBlockTime(queryGenesisTime.Add(time.Duration(height-1) * 6 * time.Second)).
+			WithEventManager(sdk.NewEventManager())
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+	}
+	_, err := ts.MintSrv.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{
+		Signer: queryHolderAddr,
+		Amount: sdk.NewInt64Coin(params.MintDenom, 1_000),
+	})
+	require.NoError(t, err)
+
+	return ctx, tk, params
+}
+
+// marshalGoldenResponse returns the indented proto-JSON of the response with sorted fields
+func marshalGoldenResponse(t *testing.T, res gogoproto.Message) []byte {
+	bz, err := codec.ProtoMarshalJSON(res, nil)
+	require.NoError(t, err)
+	sorted, err := sdk.SortJSON(bz)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	require.NoError(t, json.Indent(&out, sorted, "", "  "))
+	out.WriteString("\n")
+	return out.Bytes()
+}
+
+// TestQueryGoldenResponses runs every method of the query service against the canonical state and
+// compares the responses with the golden files, the external contract of the queries. The golden
+// files are rewritten when the tests are run with -update after a deliberate change.
+func TestQueryGoldenResponses(t *testing.T) {
+	ctx, tk, params := queryFixture(t)
+	helper := baseapp.NewQueryServerTestHelper(ctx, cdctypes.NewInterfaceRegistry())
+	types.RegisterQueryServer(helper, querier.NewQuerier(tk.MintKeeper))
+
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(queryService)
+	require.NoError(t, err)
+	methods := desc.(protoreflect.ServiceDescriptor).Methods()
+
+	dir := filepath.Join("testdata", "query")
+	if *updateGolden {
+		require.NoError(t, os.RemoveAll(dir))
+		require.NoError(t, os.MkdirAll(dir, 0o750))
+	}
+	for _, tc := range queryGoldenCases(params) {
+		t.Run(tc.name, func(t *testing.T) {
+			method := methods.ByName(protoreflect.Name(tc.method))
+			require.NotNil(t, method, "unknown query method %s", tc.method)
+			require.Equal(t, method.Input().FullName(), protoreflect.FullName(gogoproto.MessageName(tc.request)))
+
+			res := reflect.New(gogoproto.MessageType(string(method.Output().FullName())).Elem()).Interface().(gogoproto.Message)
+			err := helper.Invoke(sdk.WrapSDKContext(ctx), "/"+queryService+"/"+tc.method, tc.request, res)
+			var output []byte
+			if tc.code != codes.OK {
+				require.Equal(t, tc.code, status.Code(err), err)
+				output = marshalGoldenResponse(t, status.Convert(err).Proto())
+			} else {
+				require.NoError(t, err)
+				output = marshalGoldenResponse(t, res)
+			}
+
+			path := filepath.Join(dir, tc.name+".golden")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(path, output, 0o600))
+			}
+			expected, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(output), "the response changed, run the tests with -update if deliberate")
+		})
+	}
+}
+
+// TestQueryGoldenCoverage checks that every method of the query service has a golden response
+// and that every golden file belongs to a request of the harness
+func TestQueryGoldenCoverage(t *testing.T) {
+	cases := queryGoldenCases(types.DefaultParams())
+	covered := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, tc := range cases {
+		require.False(t, names[tc.name], "duplicated golden case %s", tc.name)
+		covered[tc.method], names[tc.name] = true, true
+	}
+
+	server := reflect.TypeOf((*types.QueryServer)(nil)).Elem()
+	for i := 0; i < server.NumMethod(); i++ {
+		method := server.Method(i).Name
+		require.True(t, covered[method], "the query method %s has no golden response, add its request to queryGoldenCases", method)
+	}
+
+	files, err := filepath.Glob(filepath.Join("testdata", "query", "*.golden"))
+	require.NoError(t, err)
+	var stale []string
+	for _, file := range files {
+		if name := strings.TrimSuffix(filepath.Base(file), ".golden"); !names[name] {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+	require.Empty(t, stale, "golden files without request, run the tests with -update")
+}
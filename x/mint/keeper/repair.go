@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// repairStore re-derives the schema version, the normalized minter, the dust entry of the ledger
+// and the summary from the authoritative records of the store. A store written with another
+// schema version, without minter or with a ledger exceeding the balance of the module account
+// cannot be repaired.
+func (k Keeper) repairStore(ctx sdk.Context) (report types.RepairReport, err error) {
+	version, found := k.GetSchemaVersion(ctx)
+	switch {
+	case !found:
+		k.setSchemaVersion(ctx, types.SchemaVersion)
+		report.Add(types.RepairedRecordSchemaVersion, fmt.Sprintf("missing version set to %d", types.SchemaVersion))
+	case version != types.SchemaVersion:
+		return report, errors.Wrapf(
+			types.ErrSchemaVersion,
+			"store schema version %d, expected %d, the store must be migrated first",
+			version,
+			types.SchemaVersion,
+		)
+	}
+
+	// the fields unset in the stored minter are normalized when the minter is read
+	stored := k.collections.minter.Encoded(ctx)
+	if stored == nil {
+		return report, errors.Wrap(types.ErrUnrepairableStore, "minter not found")
+	}
+	minter := k.GetMinter(ctx)
+	if !bytes.Equal(stored, k.cdc.MustMarshal(&minter)) {
+		k.SetMinter(ctx, minter)
+		report.Add(types.RepairedRecordMinter, "unset fields normalized")
+	}
+
+	balance := k.ModuleAccountBalance(ctx)
+	if buffered := k.TotalBuffered(ctx); !buffered.IsAllLTE(balance) {
+		return report, errors.Wrapf(
+			types.ErrUnrepairableStore,
+			"ledger entries (%s) exceed the balance of the module account (%s)",
+			buffered,
+			balance,
+		)
+	}
+	if booked := k.bookUnaccountedDust(ctx); !booked.IsZero() {
+		report.Add(types.RepairedRecordLedger, fmt.Sprintf("unaccounted balance %s booked in the dust entry", booked))
+	}
+
+	summary := types.NewSummary(k.GetMinter(ctx), k.GetParams(ctx))
+	if !bytes.Equal(k.collections.summary.Encoded(ctx), k.cdc.MustMarshal(&summary)) {
+		k.setSummary(ctx, summary)
+		report.Add(types.RepairedRecordSummary, "rebuilt from the minter and the params")
+	}
+	return report, nil
+}
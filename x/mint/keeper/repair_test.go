@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	testapp "github.com/ignite/modules/app"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// legacyMinterBytes returns a minter encoded with only the inflation and the annual provisions,
+// as stored before the other fields were introduced
+func legacyMinterBytes(t *testing.T, minter types.Minter) []byte {
+	inflation, err := minter.Inflation.Marshal()
+	require.NoError(t, err)
+	annualProvisions, err := minter.AnnualProvisions.Marshal()
+	require.NoError(t, err)
+
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendBytes(b, inflation)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	return protowire.AppendBytes(b, annualProvisions)
+}
+
+func TestRepairStore(t *testing.T) {
+	dust := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	staleSummary := types.Summary{MintDenom: "foo"}
+
+	tests := []struct {
+		name     string
+		corrupt  func(t *testing.T, ctx sdk.Context, app *testapp.App, store sdk.KVStore)
+		expected []string
+		err      error
+	}{
+		{
+			name:    "should not repair a consistent store",
+			corrupt: func(*testing.T, sdk.Context, *testapp.App, sdk.KVStore) {},
+		},
+		{
+			name: "should set a missing schema version",
+			corrupt: func(_ *testing.T, _ sdk.Context, _ *testapp.App, store sdk.KVStore) {
+				store.Delete(types.SchemaVersionKey)
+			},
+			expected: []string{types.RepairedRecordSchemaVersion},
+		},
+		{
+			name: "should normalize a minter with unset fields",
+			corrupt: func(t *testing.T, ctx sdk.Context, app *testapp.App, store sdk.KVStore) {
+				store.Set(types.MinterKey, legacyMinterBytes(t, app.MintKeeper.GetMinter(ctx)))
+			},
+			expected: []string{types.RepairedRecordMinter},
+		},
+		{
+			name: "should book the unaccounted balance of the module account",
+			corrupt: func(t *testing.T, ctx sdk.Context, app *testapp.App, _ sdk.KVStore) {
+				require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, dust))
+			},
+			expected: []string{types.RepairedRecordLedger},
+		},
+		{
+			name: "should rebuild a missing summary",
+			corrupt: func(_ *testing.T, _ sdk.Context, _ *testapp.App, store sdk.KVStore) {
+				store.Delete(types.SummaryKey)
+			},
+			expected: []string{types.RepairedRecordSummary},
+		},
+		{
+			name: "should rebuild a stale summary",
+			corrupt: func(_ *testing.T, _ sdk.Context, app *testapp.App, store sdk.KVStore) {
+				store.Set(types.SummaryKey, app.AppCodec().MustMarshal(&staleSummary))
+			},
+			expected: []string{types.RepairedRecordSummary},
+		},
+		{
+			name: "should repair several records",
+			corrupt: func(t *testing.T, ctx sdk.Context, app *testapp.App, store sdk.KVStore) {
+				require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, dust))
+				store.Delete(types.SchemaVersionKey)
+				store.Delete(types.SummaryKey)
+			},
+			// the summary is rewritten with the minter booking the dust
+			expected: []string{
+				types.RepairedRecordSchemaVersion,
+				types.RepairedRecordLedger,
+			},
+		},
+		{
+			name: "should prevent repairing a store with another schema version",
+			corrupt: func(_ *testing.T, _ sdk.Context, _ *testapp.App, store sdk.KVStore) {
+				store.Set(types.SchemaVersionKey, sdk.Uint64ToBigEndian(types.SchemaVersion-1))
+			},
+			err: types.ErrSchemaVersion,
+		},
+		{
+			name: "should prevent repairing a store without minter",
+			corrupt: func(_ *testing.T, _ sdk.Context, _ *testapp.App, store sdk.KVStore) {
+				store.Delete(types.MinterKey)
+			},
+			err: types.ErrUnrepairableStore,
+		},
+		{
+			name: "should prevent repairing a ledger exceeding the balance of the module account",
+			corrupt: func(_ *testing.T, ctx sdk.Context, app *testapp.App, _ sdk.KVStore) {
+				minter := app.MintKeeper.GetMinter(ctx)
+				minter.Book(types.LedgerEntryDust, dust)
+				app.MintKeeper.SetMinter(ctx, minter)
+			},
+			err: types.ErrUnrepairableStore,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app := setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			tc.corrupt(t, ctx, app, ctx.KVStore(app.GetKey(types.StoreKey)))
+
+			migrator := keeper.NewMigrator(app.MintKeeper)
+			report, err := migrator.RepairStore(ctx)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			records := make([]string, 0, len(report.Repairs))
+			for _, repair := range report.Repairs {
+				records = append(records, repair.Record)
+			}
+			require.ElementsMatch(t, tc.expected, records)
+
+			// the repaired store is consistent and the repair is idempotent
+			version, found := app.MintKeeper.GetSchemaVersion(ctx)
+			require.True(t, found)
+			require.Equal(t, types.SchemaVersion, version)
+			summary, found := app.MintKeeper.GetSummary(ctx)
+			require.True(t, found)
+			require.Equal(t, types.NewSummary(app.MintKeeper.GetMinter(ctx), app.MintKeeper.GetParams(ctx)), summary)
+			_, broken := keeper.AllInvariants(app.MintKeeper)(ctx)
+			require.False(t, broken)
+
+			report, err = migrator.RepairStore(ctx)
+			require.NoError(t, err)
+			require.Empty(t, report.Repairs)
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+var (
+	sanitySeed   = flag.Int64("sanity-seed", 1, "seed of the module sanity test")
+	sanityBlocks = flag.Int64("sanity-blocks", 3000, "number of blocks of the module sanity test")
+)
+
+// sanityMutation is a change of the module state applied by the sanity test between two blocks
+type sanityMutation struct {
+	name  string
+	apply func(r *rand.Rand, s *sanityChain) (string, error)
+}
+
+// sanityChain is the chain advanced by the sanity test and the log of the mutations applied to it
+type sanityChain struct {
+	ctx       sdk.Context
+	tk        testkeeper.TestKeepers
+	srv       types.MsgServer
+	authority string
+	addresses []string
+	log       []string
+}
+
+func (s *sanityChain) params() types.Params {
+	return s.tk.MintKeeper.GetParams(s.ctx)
+}
+
+func (s *sanityChain) updateParams(params types.Params) error {
+	msg := types.NewMsgUpdateParams(s.authority, params)
+	msg.AcknowledgeLargeChange = true
+	_, err := s.srv.UpdateParams(sdk.WrapSDKContext(s.ctx), msg)
+	return err
+}
+
+// sanityMutations are the valid changes of the module state applied between the blocks, a
+// mutation returns the description of the change for the log
+var sanityMutations = []sanityMutation{
+	{
+		name: "boost inflation",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			params.InflationMin = sdk.NewDecWithPrec(r.Int63n(10), 2)
+			params.InflationMax = params.InflationMin.Add(params.InflationRateChange).Add(sdk.NewDecWithPrec(r.Int63n(30), 2))
+			return fmt.Sprintf("inflation [%s, %s]", params.InflationMin, params.InflationMax), s.updateParams(params)
+		},
+	},
+	{
+		name: "blocks per year",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			params.BlocksPerYear = uint64(100 + r.Int63n(10_000))
+			return fmt.Sprintf("blocks per year %d", params.BlocksPerYear), s.updateParams(params)
+		},
+	},
+	{
+		name: "max supply",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			params.MaxSupply = sdkmath.ZeroInt()
+			if r.Intn(2) == 0 {
+				supply := s.tk.BankKeeper.GetSupply(s.ctx, params.MintDenom).Amount
+				params.MaxSupply = supply.AddRaw(r.Int63n(100_000))
+			}
+			return fmt.Sprintf("max supply %s", params.MaxSupply), s.updateParams(params)
+		},
+	},
+	{
+		name: "min distributable provision",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			params.MinDistributableProvision = sdkmath.NewInt(r.Int63n(3) * r.Int63n(50_000))
+			return fmt.Sprintf("min distributable provision %s", params.MinDistributableProvision), s.updateParams(params)
+		},
+	},
+	{
+		name: "minting interval",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			params.MintingInterval = uint64(1 + r.Int63n(3)*r.Int63n(10))
+			return fmt.Sprintf("minting interval %d", params.MintingInterval), s.updateParams(params)
+		},
+	},
+	{
+		name: "drift correction",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			params.DriftCorrection = types.NewDriftCorrection(sdk.NewDecWithPrec(r.Int63n(10), 1), uint64(1+r.Int63n(100)))
+			return fmt.Sprintf("drift correction %s over %d blocks", params.DriftCorrection.MaxFactor, params.DriftCorrection.Horizon), s.updateParams(params)
+		},
+	},
+	{
+		name: "schedule phase",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			min := sdk.NewDecWithPrec(r.Int63n(10), 2)
+			phase := types.Phase{
+				Name:         fmt.Sprintf("phase-%d", s.ctx.BlockHeight()),
+				StartHeight:  s.ctx.BlockHeight() + 1 + r.Int63n(200),
+				InflationMin: min,
+				InflationMax: min.Add(params.InflationRateChange).Add(sdk.NewDecWithPrec(r.Int63n(30), 2)),
+				GoalBonded:   sdk.NewDecWithPrec(1+r.Int63n(99), 2),
+			}
+			// the phases started are kept, the scheduled ones are replaced
+			var phases []types.Phase
+			for _, p := range params.Phases {
+				if p.StartHeight < phase.StartHeight {
+					phases = append(phases, p)
+				}
+			}
+			params.Phases = append(phases, phase)
+			return fmt.Sprintf("phase at %d inflation [%s, %s]", phase.StartHeight, phase.InflationMin, phase.InflationMax), s.updateParams(params)
+		},
+	},
+	{
+		name: "bootstrap override",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			params := s.params()
+			params.BootstrapOverride = types.BootstrapOverride{}
+			if r.Intn(2) == 0 {
+				params.BootstrapOverride = types.BootstrapOverride{
+					Recipient: s.addresses[r.Intn(len(s.addresses))],
+					EndHeight: s.ctx.BlockHeight() + 1 + r.Int63n(100),
+				}
+			}
+			return fmt.Sprintf("bootstrap override until %d", params.BootstrapOverride.EndHeight), s.updateParams(params)
+		},
+	},
+	{
+		name: "pause",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			target := types.PauseTarget(r.Intn(len(types.PauseTarget_name)))
+			paused := r.Intn(3) == 0
+			_, err := s.srv.SetPaused(sdk.WrapSDKContext(s.ctx), types.NewMsgSetPaused(s.authority, target, paused))
+			return fmt.Sprintf("%s paused %t", target, paused), err
+		},
+	},
+	{
+		name: "goal bonded",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			goal := sdk.NewDecWithPrec(1+r.Int63n(99), 2)
+			blocks := uint64(r.Int63n(200))
+			if phase, ok := s.params().ActivePhase(s.ctx.BlockHeight()); ok {
+				return fmt.Sprintf("goal bonded set by phase %s", phase.Name), nil
+			}
+			_, err := s.srv.SetGoalBonded(sdk.WrapSDKContext(s.ctx), types.NewMsgSetGoalBonded(s.authority, goal, blocks))
+			return fmt.Sprintf("goal bonded %s over %d blocks", goal, blocks), err
+		},
+	},
+	{
+		name: "add funded address",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			weight := sdk.NewDecWithPrec(1+r.Int63n(100), 2)
+			if len(s.params().FundedAddresses) == 0 {
+				weight = sdk.OneDec()
+			}
+			address := types.WeightedAddress{
+				Address:    sample.Address(r),
+				Weight:     weight,
+				PayoutMode: types.PayoutMode(r.Intn(len(types.PayoutMode_name))),
+			}
+			_, err := s.srv.AddFundedAddress(sdk.WrapSDKContext(s.ctx), types.NewMsgAddFundedAddress(s.authority, address))
+			if err == nil {
+				s.addresses = append(s.addresses, address.Address)
+			}
+			return fmt.Sprintf("add %s weight %s %s", address.Address, address.Weight, address.PayoutMode), err
+		},
+	},
+	{
+		name: "remove funded address",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			funded := s.params().FundedAddresses
+			if len(funded) == 0 {
+				return "no funded address", nil
+			}
+			address := funded[r.Intn(len(funded))].Address
+			_, err := s.srv.RemoveFundedAddress(sdk.WrapSDKContext(s.ctx), types.NewMsgRemoveFundedAddress(s.authority, address))
+			return fmt.Sprintf("remove %s", address), err
+		},
+	},
+	{
+		name: "reweight funded address",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			funded := s.params().FundedAddresses
+			if len(funded) < 2 {
+				return "no funded address to reweight", nil
+			}
+			address := funded[r.Intn(len(funded))].Address
+			weight := sdk.NewDecWithPrec(1+r.Int63n(99), 2)
+			_, err := s.srv.SetFundedAddressWeight(sdk.WrapSDKContext(s.ctx), types.NewMsgSetFundedAddressWeight(s.authority, address, weight))
+			return fmt.Sprintf("reweight %s to %s", address, weight), err
+		},
+	},
+	{
+		name: "claim distribution",
+		apply: func(r *rand.Rand, s *sanityChain) (string, error) {
+			address := s.addresses[r.Intn(len(s.addresses))]
+			if _, found := s.tk.MintKeeper.GetMinter(s.ctx).GetPendingPayout(address); !found {
+				return fmt.Sprintf("no pending payout for %s", address), nil
+			}
+			_, err := s.srv.ClaimDistribution(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimDistribution(address))
+			return fmt.Sprintf("claim %s", address), err
+		},
+	},
+}
+
+// TestModuleSanity advances the chain for thousands of blocks applying random valid mutations of
+// the params, pauses, goal bonded transitions and funded addresses between the blocks, and checks
+// the global invariants of the module after every block. A failure logs the seed and the
+// mutations applied, rerun with -sanity-seed to reproduce it.
+func TestModuleSanity(t *testing.T) {
+	seed, blocks := *sanitySeed, *sanityBlocks
+	if testing.Short() {
+		blocks /= 10
+	}
+	r := rand.New(rand.NewSource(seed)) //nolint:gosec
+
+	ctx, tk, ts := testSetups[0].setup(t)
+	s := &sanityChain{
+		tk:        tk,
+		srv:       ts.MintSrv,
+		authority: tk.MintKeeper.GetAuthority(),
+		addresses: []string{sample.Address(r)},
+	}
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Logf("seed %d, mutations:\n%s", seed, strings.Join(s.log, "\n"))
+		}
+	})
+
+	params := tk.MintKeeper.GetParams(ctx)
+	fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000_000_000)))
+
+	// the realized emissions of the mint denom are bounded by the provisions at the max inflation
+	// of each block, the accrued and buffered provisions included
+	initialMinted := tk.MintKeeper.GetTotalMintedOf(ctx, params.MintDenom).Amount
+	maxEmission := sdk.ZeroDec()
+
+	for height := int64(1); height <= blocks; height++ {
+		s.ctx = ctx.WithBlockHeight(height)
+
+		if r.Intn(20) == 0 {
+			mutation := sanityMutations[r.Intn(len(sanityMutations))]
+			require.NotPanics(t, func() {
+				desc, err := mutation.apply(r, s)
+				s.log = append(s.log, fmt.Sprintf("%d: %s: %s", height, mutation.name, desc))
+				if err != nil && !errors.Is(err, types.ErrNoParamChange) {
+					require.NoError(t, err, "height %d: %s", height, mutation.name)
+				}
+			}, "height %d: %s", height, mutation.name)
+		}
+
+		params := tk.MintKeeper.GetParams(s.ctx)
+		before := tk.MintKeeper.GetMinter(s.ctx)
+		supplyBefore := tk.BankKeeper.GetSupply(s.ctx, params.MintDenom).Amount
+		mintedBefore := tk.MintKeeper.GetTotalMintedOf(s.ctx, params.MintDenom).Amount
+
+		require.NotPanics(t, func() {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(s.ctx), "height %d", height)
+		}, "height %d", height)
+
+		requireMintConsistency(t, s.ctx, tk.MintKeeper, before)
+		minter := tk.MintKeeper.GetMinter(s.ctx)
+		minted := tk.MintKeeper.GetTotalMintedOf(s.ctx, params.MintDenom).Amount
+		require.True(t, minted.GTE(mintedBefore), "height %d: total minted decreased", height)
+
+		// the supply of the mint denom only increases by the coins minted by the module
+		supply := tk.BankKeeper.GetSupply(s.ctx, params.MintDenom).Amount
+		require.True(t, supply.Sub(supplyBefore).Equal(minted.Sub(mintedBefore)),
+			"height %d: supply increased by %s, minted %s", height, supply.Sub(supplyBefore), minted.Sub(mintedBefore))
+
+		blockParams := params.AtHeight(height)
+		maxEmission = maxEmission.Add(blockParams.InflationMax.
+			MulInt(minter.LastBlockInputs.StakingSupply).
+			QuoInt(sdkmath.NewInt(int64(blockParams.BlocksPerYear)))).
+			Add(sdk.SmallestDec())
+		realized := sdk.NewDecFromInt(minted.Sub(initialMinted)).Add(minter.CarryBuffer)
+		require.True(t, realized.LTE(maxEmission), "height %d: realized emissions %s above the max %s", height, realized, maxEmission)
+	}
+	require.True(t, tk.MintKeeper.GetTotalMintedOf(ctx, params.MintDenom).Amount.GT(initialMinted), "nothing minted")
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetSchemaVersion returns the version of the schema of the store, the stores written before the
+// version was recorded have no version
+func (k Keeper) GetSchemaVersion(ctx sdk.Context) (version uint64, found bool) {
+	return k.collections.schemaVersion.Get(ctx)
+}
+
+func (k Keeper) setSchemaVersion(ctx sdk.Context, version uint64) {
+	k.collections.schemaVersion.Set(ctx, version)
+}
+
+// checkSchemaVersion checks the store has the expected schema version, a store without version is
+// accepted as it was written before the version was recorded
+func (k Keeper) checkSchemaVersion(ctx sdk.Context, expected uint64) error {
+	version, found := k.GetSchemaVersion(ctx)
+	if found && version != expected {
+		return errors.Wrapf(types.ErrSchemaVersion, "store schema version %d, expected %d", version, expected)
+	}
+	return nil
+}
+
+// InitSchemaVersion records the current schema version in the store initialized from the
+// genesis state, with the module version of the schema version if not recorded yet. The store
+// must not have been written with another schema version.
+func (k Keeper) InitSchemaVersion(ctx sdk.Context) error {
+	if err := k.checkSchemaVersion(ctx, types.SchemaVersion); err != nil {
+		return err
+	}
+	k.setSchemaVersion(ctx, types.SchemaVersion)
+	k.setModuleVersion(ctx, k.GetModuleVersion(ctx))
+	return nil
+}
+
+// migrate runs the migration of the store from the schema version and records the next version
+// and the migration in the module version. The params missing from the subspace are set to their
+// default value before the migration, so the migrations can read the params of the current
+// release.
+func (k Keeper) migrate(ctx sdk.Context, from uint64, migration func() error) error {
+	if err := k.checkSchemaVersion(ctx, from); err != nil {
+		return err
+	}
+	k.setMissingParams(ctx)
+	if err := migration(); err != nil {
+		return err
+	}
+	k.setSchemaVersion(ctx, from+1)
+	k.recordMigration(ctx, from)
+	return nil
+}
+
+// setMissingParams sets the params missing from the subspace, the params added after the release
+// that wrote the store, to their default value
+func (k Keeper) setMissingParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
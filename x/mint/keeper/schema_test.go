@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestSchemaVersion(t *testing.T) {
+	t.Run("should record the schema version on genesis initialization", func(t *testing.T) {
+		app := setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+		version, found := app.MintKeeper.GetSchemaVersion(ctx)
+		require.True(t, found)
+		require.Equal(t, types.SchemaVersion, version)
+		require.NoError(t, app.MintKeeper.InitSchemaVersion(ctx))
+	})
+
+	t.Run("should record the schema version of each migration", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		migrator := keeper.NewMigrator(tk.MintKeeper)
+
+		// the stores written before the version was recorded have no version
+		_, found := tk.MintKeeper.GetSchemaVersion(ctx)
+		require.False(t, found)
+
+		require.NoError(t, migrator.Migrate1to2(ctx))
+		version, found := tk.MintKeeper.GetSchemaVersion(ctx)
+		require.True(t, found)
+		require.EqualValues(t, 2, version)
+
+		require.NoError(t, migrator.Migrate2to3(ctx))
+		version, _ = tk.MintKeeper.GetSchemaVersion(ctx)
+		require.EqualValues(t, 3, version)
+
+		require.NoError(t, migrator.Migrate3to4(ctx))
+		version, _ = tk.MintKeeper.GetSchemaVersion(ctx)
+		require.EqualValues(t, 4, version)
+
+		require.NoError(t, migrator.Migrate4to5(ctx))
+		version, _ = tk.MintKeeper.GetSchemaVersion(ctx)
+		require.EqualValues(t, 5, version)
+
+		require.NoError(t, migrator.Migrate5to6(ctx))
+		version, _ = tk.MintKeeper.GetSchemaVersion(ctx)
+		require.EqualValues(t, 6, version)
+
+		require.NoError(t, migrator.Migrate6to7(ctx))
+		version, _ = tk.MintKeeper.GetSchemaVersion(ctx)
+		require.Equal(t, types.SchemaVersion, version)
+	})
+
+	t.Run("should reset the funding windows of the funded addresses", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := types.DefaultParams()
+		params.FundedAddresses = []types.WeightedAddress{
+			{Address: sample.Address(r), Weight: sdk.OneDec(), StartHeight: 10, EndHeight: 20},
+		}
+		tk.MintKeeper.SetParams(ctx, params)
+
+		require.NoError(t, keeper.NewMigrator(tk.MintKeeper).Migrate4to5(ctx))
+		params.FundedAddresses[0].StartHeight = 0
+		params.FundedAddresses[0].EndHeight = 0
+		require.Equal(t, params.FundedAddresses, tk.MintKeeper.GetParams(ctx).FundedAddresses)
+		version, _ := tk.MintKeeper.GetSchemaVersion(ctx)
+		require.EqualValues(t, 5, version)
+	})
+
+	t.Run("should keep the single mint denom layout as the mint configuration of the mint denom", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		migrator := keeper.NewMigrator(tk.MintKeeper)
+		require.NoError(t, migrator.Migrate4to5(ctx))
+		params := tk.MintKeeper.GetParams(ctx)
+
+		require.NoError(t, migrator.Migrate5to6(ctx))
+		migrated := tk.MintKeeper.GetParams(ctx)
+		require.Empty(t, migrated.MintConfigs)
+		require.Equal(t, []types.MintConfig{params.PrimaryMintConfig()}, migrated.AllMintConfigs())
+		version, _ := tk.MintKeeper.GetSchemaVersion(ctx)
+		require.EqualValues(t, 6, version)
+	})
+
+	t.Run("should prevent migrating a store from another schema version", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		migrator := keeper.NewMigrator(tk.MintKeeper)
+		require.NoError(t, migrator.Migrate1to2(ctx))
+
+		require.ErrorIs(t, migrator.Migrate1to2(ctx), types.ErrSchemaVersion)
+		require.ErrorIs(t, tk.MintKeeper.InitSchemaVersion(ctx), types.ErrSchemaVersion)
+		version, _ := tk.MintKeeper.GetSchemaVersion(ctx)
+		require.EqualValues(t, 2, version)
+	})
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// isSDKMintStore returns true if the store was written by the cosmos-sdk x/mint module: the
+// schema version is not recorded and the subspace has no distribution proportions, they are set
+// by every release of the module
+func (k Keeper) isSDKMintStore(ctx sdk.Context) bool {
+	if _, found := k.GetSchemaVersion(ctx); found {
+		return false
+	}
+	return !k.paramSpace.Has(ctx, types.KeyDistributionProportions)
+}
+
+// migrateSDKMintStore converts the minter and the params of the cosmos-sdk x/mint module. The
+// inflation and the annual provisions of the minter are kept. The params are read from the store
+// where cosmos-sdk v0.47 writes them, or from the subspace for the earlier versions, the other
+// params are set to their default value except the distribution proportions, all the minted
+// coins go to staking, and the funded addresses, none are funded.
+func (k Keeper) migrateSDKMintStore(ctx sdk.Context) error {
+	minter := types.DefaultInitialMinter()
+	legacyMinter, found, err := k.collections.sdkMint.minter.Load(ctx)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidSDKMintStore, "minter: %s", err)
+	}
+	if found {
+		minter.Inflation = legacyMinter.Inflation
+		minter.AnnualProvisions = legacyMinter.AnnualProvisions
+	}
+
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:          sdk.OneDec(),
+		FundedAddresses:  sdk.ZeroDec(),
+		CommunityPool:    sdk.ZeroDec(),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	params.FundedAddresses = []types.WeightedAddress{}
+	legacyParams, found, err := k.collections.sdkMint.params.Load(ctx)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidSDKMintStore, "params: %s", err)
+	}
+	if found {
+		params.MintDenom = legacyParams.MintDenom
+		params.InflationRateChange = legacyParams.InflationRateChange
+		params.InflationMax = legacyParams.InflationMax
+		params.InflationMin = legacyParams.InflationMin
+		params.GoalBonded = legacyParams.GoalBonded
+		params.BlocksPerYear = legacyParams.BlocksPerYear
+	} else {
+		// the keys of the params of cosmos-sdk x/mint are the keys of the same params of the module
+		for key, value := range map[string]interface{}{
+			string(types.KeyMintDenom):           &params.MintDenom,
+			string(types.KeyInflationRateChange): &params.InflationRateChange,
+			string(types.KeyInflationMax):        &params.InflationMax,
+			string(types.KeyInflationMin):        &params.InflationMin,
+			string(types.KeyGoalBonded):          &params.GoalBonded,
+			string(types.KeyBlocksPerYear):       &params.BlocksPerYear,
+		} {
+			if k.paramSpace.Has(ctx, []byte(key)) {
+				k.paramSpace.Get(ctx, []byte(key), value)
+			}
+		}
+	}
+	params.MinAnnualCommunityFunding.Denom = params.MintDenom
+	if err := params.Validate(); err != nil {
+		return types.InvalidParamsError(err)
+	}
+
+	// the params key of cosmos-sdk x/mint is the summary key of the module, the summary is
+	// written with the minter
+	k.collections.sdkMint.minter.Remove(ctx)
+	k.collections.sdkMint.params.Remove(ctx)
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, minter)
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkminttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// seedSDKMintStore replaces the module store and the module subspace with the store written by
+// the cosmos-sdk x/mint module: the minter, the params if the sdk v0.47 layout is used, the
+// subspace otherwise, only the params of cosmos-sdk x/mint are kept in the subspace
+func seedSDKMintStore(t *testing.T, ctx sdk.Context, minter sdkminttypes.Minter, params *sdkminttypes.Params) {
+	keys := ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()
+	clearStore := func(store sdk.KVStore, keep func(key []byte) bool) {
+		var keys [][]byte
+		it := store.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			if !keep(it.Key()) {
+				keys = append(keys, it.Key())
+			}
+		}
+		require.NoError(t, it.Close())
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	sdkParamKeys := map[string]bool{
+		string(sdkminttypes.KeyMintDenom):           true,
+		string(sdkminttypes.KeyInflationRateChange): true,
+		string(sdkminttypes.KeyInflationMax):        true,
+		string(sdkminttypes.KeyInflationMin):        true,
+		string(sdkminttypes.KeyGoalBonded):          true,
+		string(sdkminttypes.KeyBlocksPerYear):       true,
+	}
+	subspace := prefix.NewStore(ctx.KVStore(keys[paramstypes.StoreKey]), []byte(types.ModuleName+"/"))
+	clearStore(subspace, func(key []byte) bool {
+		return sdkParamKeys[string(key)]
+	})
+
+	store := ctx.KVStore(keys[types.StoreKey])
+	clearStore(store, func([]byte) bool { return false })
+	b, err := minter.Marshal()
+	require.NoError(t, err)
+	store.Set(sdkminttypes.MinterKey, b)
+	if params != nil {
+		b, err = params.Marshal()
+		require.NoError(t, err)
+		store.Set(sdkminttypes.ParamsKey, b)
+	}
+}
+
+func TestMigrateSDKMintStore(t *testing.T) {
+	legacyMinter := sdkminttypes.NewMinter(sdk.NewDecWithPrec(8, 2), sdk.NewDec(1_000_000))
+	staking := types.DistributionProportions{
+		Staking:          sdk.OneDec(),
+		FundedAddresses:  sdk.ZeroDec(),
+		CommunityPool:    sdk.ZeroDec(),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	requireMigrated := func(t *testing.T, ctx sdk.Context, k keeper.Keeper, params sdkminttypes.Params) {
+		minter := k.GetMinter(ctx)
+		require.Equal(t, legacyMinter.Inflation, minter.Inflation)
+		require.Equal(t, legacyMinter.AnnualProvisions, minter.AnnualProvisions)
+		require.True(t, minter.CumulativeMinted.IsZero())
+
+		migrated := k.GetParams(ctx)
+		require.NoError(t, migrated.Validate())
+		require.Equal(t, params.MintDenom, migrated.MintDenom)
+		require.Equal(t, params.InflationRateChange, migrated.InflationRateChange)
+		require.Equal(t, params.InflationMax, migrated.InflationMax)
+		require.Equal(t, params.InflationMin, migrated.InflationMin)
+		require.Equal(t, params.GoalBonded, migrated.GoalBonded)
+		require.Equal(t, params.BlocksPerYear, migrated.BlocksPerYear)
+		require.Equal(t, staking, migrated.DistributionProportions)
+		require.Empty(t, migrated.FundedAddresses)
+
+		summary, found := k.GetSummary(ctx)
+		require.True(t, found)
+		require.Equal(t, types.NewSummary(minter, migrated), summary)
+		version, _ := k.GetSchemaVersion(ctx)
+		require.Equal(t, types.SchemaVersion, version)
+
+		// the coins minted from the migrated state go to staking
+		require.NoError(t, k.BeginBlocker(ctx.WithBlockHeight(1)))
+		require.True(t, k.GetMinter(ctx).CumulativeDistributed.Staking.IsPositive())
+		msg, broken := keeper.AllInvariants(k)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	t.Run("should migrate the store of cosmos-sdk v0.47", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000_000))))
+		params := sdkminttypes.NewParams(
+			sdk.DefaultBondDenom,
+			sdk.NewDecWithPrec(10, 2),
+			sdk.NewDecWithPrec(15, 2),
+			sdk.NewDecWithPrec(5, 2),
+			sdk.NewDecWithPrec(60, 2),
+			1000,
+		)
+		seedSDKMintStore(t, ctx, legacyMinter, &params)
+
+		require.NoError(t, keeper.NewMigrator(tk.MintKeeper).Migrate6to7(ctx))
+		requireMigrated(t, ctx, tk.MintKeeper, params)
+	})
+
+	t.Run("should migrate the store of cosmos-sdk v0.46 with the params in the subspace", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000_000))))
+		params := types.DefaultParams()
+		params.InflationMax = sdk.NewDecWithPrec(30, 2)
+		params.BlocksPerYear = 1000
+		tk.MintKeeper.SetParams(ctx, params)
+		seedSDKMintStore(t, ctx, legacyMinter, nil)
+
+		require.NoError(t, keeper.NewMigrator(tk.MintKeeper).Migrate6to7(ctx))
+		requireMigrated(t, ctx, tk.MintKeeper, sdkminttypes.NewParams(
+			params.MintDenom,
+			params.InflationRateChange,
+			params.InflationMax,
+			params.InflationMin,
+			params.GoalBonded,
+			params.BlocksPerYear,
+		))
+	})
+
+	t.Run("should leave the store of the module unchanged", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		migrator := keeper.NewMigrator(tk.MintKeeper)
+		require.NoError(t, migrator.Migrate5to6(ctx))
+		params := tk.MintKeeper.GetParams(ctx)
+		minter := tk.MintKeeper.GetMinter(ctx)
+
+		require.NoError(t, migrator.Migrate6to7(ctx))
+		require.Equal(t, params, tk.MintKeeper.GetParams(ctx))
+		require.Equal(t, minter, tk.MintKeeper.GetMinter(ctx))
+	})
+
+	t.Run("should prevent migrating an invalid minter", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		seedSDKMintStore(t, ctx, legacyMinter, nil)
+		ctx.KVStore(ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()[types.StoreKey]).
+			Set(sdkminttypes.MinterKey, []byte{0xff})
+
+		err := keeper.NewMigrator(tk.MintKeeper).Migrate6to7(ctx)
+		require.ErrorIs(t, err, types.ErrInvalidSDKMintStore)
+	})
+
+	t.Run("should prevent migrating invalid params", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := sdkminttypes.DefaultParams()
+		params.InflationMin = sdk.NewDecWithPrec(30, 2)
+		seedSDKMintStore(t, ctx, legacyMinter, &params)
+
+		err := keeper.NewMigrator(tk.MintKeeper).Migrate6to7(ctx)
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+	})
+}
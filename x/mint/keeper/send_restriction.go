@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// restrictedRecipient invokes the send restriction of the keeper for a send of coins from the module
+// account to the recipient and returns the address receiving the coins, the recipient is returned
+// unchanged if the keeper doesn't enforce send restrictions
+func (k Keeper) restrictedRecipient(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, error) {
+	if k.sendRestriction == nil || coins.IsZero() {
+		return recipient, nil
+	}
+	to, err := k.sendRestriction(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), recipient, coins)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrPayoutRestricted, "%s: %s", recipient, err)
+	}
+	return to, nil
+}
+
+// restrictPayout returns the address receiving the payout of a funded address in push payout mode.
+// If the send restriction blocks the payout, the coins are escrowed in the pending payout of the
+// address to be claimed once the restriction is lifted, EventPayoutRestricted is emitted and
+// blocked is true. The event carries the index of the allocation of the payout.
+func (k Keeper) restrictPayout(
+	ctx sdk.Context,
+	minter *types.Minter,
+	address string,
+	recipient sdk.AccAddress,
+	coins sdk.Coins,
+	index uint32,
+) (to sdk.AccAddress, blocked bool, err error) {
+	to, restrictionErr := k.restrictedRecipient(ctx, recipient, coins)
+	if restrictionErr == nil {
+		return to, false, nil
+	}
+
+	minter.BookPayout(address, ctx.BlockHeight(), coins)
+	return nil, true, ctx.EventManager().EmitTypedEvent(&types.EventPayoutRestricted{
+		Address:         address,
+		Amount:          coins,
+		Reason:          restrictionErr.Error(),
+		AllocationIndex: index,
+	})
+}
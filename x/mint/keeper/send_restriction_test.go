@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// restrictedEvents returns the payout restricted events of the context
+func restrictedEvents(t *testing.T, ctx sdk.Context) []types.EventPayoutRestricted {
+	var events []types.EventPayoutRestricted
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventPayoutRestricted" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, *parsed.(*types.EventPayoutRestricted))
+	}
+	return events
+}
+
+func TestEnforceSendRestrictions(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	addrs := []sdk.AccAddress{sample.AccAddress(r), sample.AccAddress(r), sample.AccAddress(r)}
+	redirected := sample.AccAddress(r)
+
+	// the restriction blocks the second address and redirects the coins of the third address
+	blocked := true
+	restriction := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		switch {
+		case blocked && toAddr.Equals(addrs[1]):
+			return nil, errors.New("sanctioned address")
+		case toAddr.Equals(addrs[2]):
+			return redirected, nil
+		}
+		return toAddr, nil
+	}
+	sdkCtx, tk, ts := testkeeper.NewTestSetupWithMintKeeperOptions(t, keeper.EnforceSendRestrictions(restriction))
+
+	// the funded addresses share of 40 coins is split into 20, 12 and 8 coins
+	params := types.DefaultParams()
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: addrs[0].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: addrs[1].String(), Weight: sdk.NewDecWithPrec(3, 1)},
+		{Address: addrs[2].String(), Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+	tk.MintKeeper.SetParams(sdkCtx, params)
+	tk.MintKeeper.SetMinter(sdkCtx, types.DefaultInitialMinter())
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	balance := func(addr sdk.AccAddress) sdk.Coins {
+		return tk.BankKeeper.GetAllBalances(sdkCtx, addr)
+	}
+
+	t.Run("should escrow the blocked payout and pay the other addresses", func(t *testing.T) {
+		for height := int64(1); height <= 2; height++ {
+			ctx := sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintedCoin)))
+			require.NoError(t, tk.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+			events := restrictedEvents(t, ctx)
+			require.Len(t, events, 1)
+			require.Equal(t, addrs[1].String(), events[0].Address)
+			require.Equal(t, stake(12), events[0].Amount)
+			require.Contains(t, events[0].Reason, "sanctioned address")
+
+			msg, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+			require.False(t, broken, msg)
+		}
+
+		require.Equal(t, stake(40), balance(addrs[0]))
+		require.True(t, balance(addrs[1]).IsZero())
+		require.True(t, balance(addrs[2]).IsZero())
+		require.Equal(t, stake(16), balance(redirected))
+
+		// the blocked share is escrowed in the module account and counted as distributed
+		minter := tk.MintKeeper.GetMinter(sdkCtx)
+		payout, found := minter.GetPendingPayout(addrs[1].String())
+		require.True(t, found)
+		require.Equal(t, types.PendingPayout{
+			Address:    addrs[1].String(),
+			Amount:     stake(24),
+			FromHeight: 1,
+			ToHeight:   2,
+		}, payout)
+		require.Equal(t, stake(24), balance(tk.AccountKeeper.GetModuleAddress(types.ModuleName)))
+		require.Equal(t, int64(80), minter.CumulativeDistributed.FundedAddresses.Int64())
+	})
+
+	t.Run("should prevent the claim of the escrowed payout while blocked", func(t *testing.T) {
+		ctx := sdkCtx.WithBlockHeight(3)
+		_, err := ts.MintSrv.ClaimDistribution(sdk.WrapSDKContext(ctx), types.NewMsgClaimDistribution(addrs[1].String()))
+		require.ErrorIs(t, err, types.ErrPayoutRestricted)
+
+		_, found := tk.MintKeeper.GetMinter(sdkCtx).GetPendingPayout(addrs[1].String())
+		require.True(t, found)
+		require.True(t, balance(addrs[1]).IsZero())
+	})
+
+	t.Run("should claim the escrowed payout once the restriction is lifted", func(t *testing.T) {
+		blocked = false
+		ctx := sdkCtx.WithBlockHeight(3)
+		res, err := ts.MintSrv.ClaimDistribution(sdk.WrapSDKContext(ctx), types.NewMsgClaimDistribution(addrs[1].String()))
+		require.NoError(t, err)
+		require.Equal(t, stake(24), res.Amount)
+		require.Equal(t, stake(24), balance(addrs[1]))
+		require.True(t, balance(tk.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+	})
+}
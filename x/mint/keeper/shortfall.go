@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// categoryShares returns the shares of the staking, funded addresses, strategic reserve and
+// community pool categories of an amount of minted coins split by the distribution proportions,
+// the community pool share is the remainder of the other shares
+func (k Keeper) categoryShares(ctx sdk.Context, params types.Params, amount sdk.Coin) types.CategoryTotals {
+	proportions := params.DistributionProportions
+	shares := types.NewCategoryTotals()
+	shares.Staking = k.GetProportion(ctx, amount, proportions.Staking).Amount
+	shares.FundedAddresses = k.GetProportion(ctx, amount, proportions.FundedAddresses).Amount
+	shares.StrategicReserve = k.GetProportion(ctx, amount, proportions.StrategicReserveRatio()).Amount
+	shares.CommunityPool = amount.Amount.Sub(shares.Staking).Sub(shares.FundedAddresses).Sub(shares.StrategicReserve)
+	return shares
+}
+
+// cappedShares returns the shares of the categories of the coins minted for a block when the max
+// supply reduces them below the block provision, and the shortfall of each category from its
+// share of the provision. With SHORTFALL_POLICY_PRO_RATA, the minted coins are split by the
+// distribution proportions. With SHORTFALL_POLICY_PRIORITY, the categories receive their share of
+// the provision in the order of the shortfall priority until the minted coins are exhausted, the
+// strategic reserve last.
+func (k Keeper) cappedShares(
+	ctx sdk.Context,
+	params types.Params,
+	provision,
+	minted sdk.Coin,
+) (shares, shortfall types.CategoryTotals) {
+	planned := k.categoryShares(ctx, params, provision)
+	if params.ShortfallPolicy == types.SHORTFALL_POLICY_PRIORITY {
+		shares = types.NewCategoryTotals()
+		remaining := minted.Amount
+		for _, category := range params.ShortfallPriority {
+			share, plannedShare := categoryShare(&shares, category), categoryShare(&planned, category)
+			*share = sdkmath.MinInt(*plannedShare, remaining)
+			remaining = remaining.Sub(*share)
+		}
+		shares.StrategicReserve = sdkmath.MinInt(planned.StrategicReserve, remaining)
+	} else {
+		shares = k.categoryShares(ctx, params, minted)
+	}
+
+	shortfall = types.NewCategoryTotals()
+	shortfall.Staking = planned.Staking.Sub(shares.Staking)
+	shortfall.FundedAddresses = planned.FundedAddresses.Sub(shares.FundedAddresses)
+	shortfall.CommunityPool = planned.CommunityPool.Sub(shares.CommunityPool)
+	shortfall.StrategicReserve = planned.StrategicReserve.Sub(shares.StrategicReserve)
+	return shares, shortfall
+}
+
+// categoryShare returns the total of the distribution category
+func categoryShare(totals *types.CategoryTotals, category string) *sdkmath.Int {
+	switch category {
+	case types.CategoryStaking:
+		return &totals.Staking
+	case types.CategoryFundedAddresses:
+		return &totals.FundedAddresses
+	default:
+		return &totals.CommunityPool
+	}
+}
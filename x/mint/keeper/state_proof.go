@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// ABCIQuerier is the ABCI query handler of the app, implemented by the base app
+type ABCIQuerier interface {
+	Query(req abci.RequestQuery) abci.ResponseQuery
+}
+
+// abciStateProver proves the state of the app with the proven store queries of the ABCI query
+// handler of the app
+type abciStateProver struct {
+	querier ABCIQuerier
+}
+
+// NewABCIStateProver returns a state prover querying the stores of the app through its ABCI query
+// handler. The state of a height can only be proven while its version is kept by the pruning of
+// the app, and not for the heights below 2.
+func NewABCIStateProver(querier ABCIQuerier) types.StateProver {
+	return abciStateProver{querier: querier}
+}
+
+// ProveState implements types.StateProver
+func (p abciStateProver) ProveState(storeName string, key []byte, height int64) (types.StateProof, error) {
+	res := p.querier.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if res.Code != 0 {
+		return types.StateProof{}, fmt.Errorf("query of the %s store failed with code %d: %s", storeName, res.Code, res.Log)
+	}
+	if res.ProofOps == nil {
+		return types.StateProof{}, fmt.Errorf("no proof returned by the %s store", storeName)
+	}
+	return types.StateProof{
+		StoreName: storeName,
+		Key:       key,
+		Value:     res.Value,
+		Height:    res.Height,
+		Proof:     res.ProofOps,
+	}, nil
+}
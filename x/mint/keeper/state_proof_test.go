@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/x/mint/client/cli"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestStateProof(t *testing.T) {
+	app := setup(false)
+
+	// the app hash of each height, committed in the header of the next block
+	appHashes := make(map[int64][]byte)
+	for i := 0; i < 5; i++ {
+		commitBlocks(app, 1)
+		appHashes[app.LastBlockHeight()] = app.LastCommitID().Hash
+	}
+	ctx, err := app.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	q := querier.NewQuerier(app.MintKeeper)
+	query := func(name string, height int64) []types.StateProof {
+		res, err := q.StateProof(sdk.WrapSDKContext(ctx), &types.QueryStateProofRequest{KeyName: name, Height: height})
+		require.NoError(t, err)
+		return res.Proofs
+	}
+
+	t.Run("should prove the minter of each height", func(t *testing.T) {
+		for _, height := range []int64{2, 3, 5} {
+			proofs := query("minter", height)
+			require.Len(t, proofs, 1)
+			proof := proofs[0]
+			require.EqualValues(t, height, proof.Height)
+			require.Equal(t, types.StoreKey, proof.StoreName)
+			require.Equal(t, types.MinterKey, proof.Key)
+			require.NoError(t, cli.VerifyStateProof(proof, appHashes[height]))
+
+			var minter types.Minter
+			require.NoError(t, app.AppCodec().Unmarshal(proof.Value, &minter))
+			require.EqualValues(t, height, minter.LastBlockInputs.Height)
+
+			// the proof doesn't hold for the state of another height
+			require.Error(t, cli.VerifyStateProof(proof, appHashes[height-1]))
+		}
+	})
+
+	t.Run("should prove the current height by default", func(t *testing.T) {
+		proofs := query("summary", 0)
+		require.Len(t, proofs, 1)
+		require.EqualValues(t, app.LastBlockHeight(), proofs[0].Height)
+		require.NotEmpty(t, proofs[0].Value)
+		require.NoError(t, cli.VerifyStateProof(proofs[0], appHashes[app.LastBlockHeight()]))
+	})
+
+	t.Run("should prove every param", func(t *testing.T) {
+		var params types.Params
+		proofs := query("params", 4)
+		require.Len(t, proofs, len(params.ParamSetPairs()))
+		for _, proof := range proofs {
+			require.NotEmpty(t, proof.Value)
+			require.NoError(t, cli.VerifyStateProof(proof, appHashes[4]))
+		}
+	})
+
+	t.Run("should prove the absence of a key", func(t *testing.T) {
+		for _, height := range []int64{2, 4} {
+			proofs := query("fee_collector_name", height)
+			require.Len(t, proofs, 1)
+			require.Empty(t, proofs[0].Value)
+			require.NoError(t, cli.VerifyStateProof(proofs[0], appHashes[height]))
+
+			// a value can't be proven with the proof of absence
+			proof := proofs[0]
+			proof.Value = []byte("fee_collector")
+			require.Error(t, cli.VerifyStateProof(proof, appHashes[height]))
+		}
+	})
+
+	t.Run("should reject a tampered value", func(t *testing.T) {
+		proof := query("minter", 3)[0]
+		proof.Value = append([]byte{}, proof.Value...)
+		proof.Value[0]++
+		require.Error(t, cli.VerifyStateProof(proof, appHashes[3]))
+
+		// nor can the absence of an existing key be proven
+		proof.Value = nil
+		require.Error(t, cli.VerifyStateProof(proof, appHashes[3]))
+	})
+
+	t.Run("should reject invalid requests", func(t *testing.T) {
+		_, err := q.StateProof(sdk.WrapSDKContext(ctx), &types.QueryStateProofRequest{KeyName: "unknown"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = q.StateProof(sdk.WrapSDKContext(ctx), &types.QueryStateProofRequest{
+			KeyName: "minter",
+			Height:  app.LastBlockHeight() + 1,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// the state of the first height can't be proven
+		_, err = q.StateProof(sdk.WrapSDKContext(ctx), &types.QueryStateProofRequest{KeyName: "minter", Height: 1})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestStateProofWithoutProver(t *testing.T) {
+	ctx, tk, _ := testSetups[0].setup(t)
+
+	_, err := querier.NewQuerier(tk.MintKeeper).StateProof(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryStateProofRequest{KeyName: "minter"},
+	)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
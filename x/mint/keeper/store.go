@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"io"
+
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ corestore.KVStoreService = kvStoreService{}
+	_ corestore.KVStore        = kvStore{}
+	_ storetypes.KVStore       = kvStoreAdapter{}
+)
+
+// NewKVStoreService returns a store service opening the module store of the
+// provided store key from the SDK context. It allows to use a keeper relying on
+// the store service with an application still providing store keys.
+func NewKVStoreService(key storetypes.StoreKey) corestore.KVStoreService {
+	return kvStoreService{key: key}
+}
+
+type kvStoreService struct {
+	key storetypes.StoreKey
+}
+
+// OpenKVStore implements corestore.KVStoreService
+func (s kvStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	return kvStore{store: sdk.UnwrapSDKContext(ctx).KVStore(s.key)}
+}
+
+// kvStore wraps a SDK KVStore to implement the core store interface.
+type kvStore struct {
+	store storetypes.KVStore
+}
+
+// Get implements corestore.KVStore
+func (s kvStore) Get(key []byte) ([]byte, error) {
+	return s.store.Get(key), nil
+}
+
+// Has implements corestore.KVStore
+func (s kvStore) Has(key []byte) (bool, error) {
+	return s.store.Has(key), nil
+}
+
+// Set implements corestore.KVStore
+func (s kvStore) Set(key, value []byte) error {
+	s.store.Set(key, value)
+	return nil
+}
+
+// Delete implements corestore.KVStore
+func (s kvStore) Delete(key []byte) error {
+	s.store.Delete(key)
+	return nil
+}
+
+// Iterator implements corestore.KVStore
+func (s kvStore) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.Iterator(start, end), nil
+}
+
+// ReverseIterator implements corestore.KVStore
+func (s kvStore) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.ReverseIterator(start, end), nil
+}
+
+// kvStoreAdapter adapts a core KVStore to the SDK KVStore interface, it allows to use the
+// SDK store utilities such as the prefix store and the query pagination with the store service.
+type kvStoreAdapter struct {
+	store corestore.KVStore
+}
+
+func newKVStoreAdapter(store corestore.KVStore) storetypes.KVStore {
+	return kvStoreAdapter{store: store}
+}
+
+// GetStoreType implements storetypes.KVStore
+func (s kvStoreAdapter) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeIAVL
+}
+
+// CacheWrap implements storetypes.KVStore
+func (s kvStoreAdapter) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements storetypes.KVStore
+func (s kvStoreAdapter) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements storetypes.KVStore
+func (s kvStoreAdapter) Get(key []byte) []byte {
+	value, err := s.store.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements storetypes.KVStore
+func (s kvStoreAdapter) Has(key []byte) bool {
+	found, err := s.store.Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return found
+}
+
+// Set implements storetypes.KVStore
+func (s kvStoreAdapter) Set(key, value []byte) {
+	if err := s.store.Set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+// Delete implements storetypes.KVStore
+func (s kvStoreAdapter) Delete(key []byte) {
+	if err := s.store.Delete(key); err != nil {
+		panic(err)
+	}
+}
+
+// Iterator implements storetypes.KVStore
+func (s kvStoreAdapter) Iterator(start, end []byte) storetypes.Iterator {
+	it, err := s.store.Iterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
+
+// ReverseIterator implements storetypes.KVStore
+func (s kvStoreAdapter) ReverseIterator(start, end []byte) storetypes.Iterator {
+	it, err := s.store.ReverseIterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestKVStoreService(t *testing.T) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	store := keeper.NewKVStoreService(key).OpenKVStore(ctx)
+	sdkStore := ctx.KVStore(key)
+
+	t.Run("should write to the module store", func(t *testing.T) {
+		require.NoError(t, store.Set([]byte{0x01}, []byte("foo")))
+		require.NoError(t, store.Set([]byte{0x02}, []byte("bar")))
+		require.Equal(t, []byte("foo"), sdkStore.Get([]byte{0x01}))
+		require.Equal(t, []byte("bar"), sdkStore.Get([]byte{0x02}))
+	})
+
+	t.Run("should read from the module store", func(t *testing.T) {
+		sdkStore.Set([]byte{0x03}, []byte("baz"))
+		v, err := store.Get([]byte{0x03})
+		require.NoError(t, err)
+		require.Equal(t, []byte("baz"), v)
+
+		has, err := store.Has([]byte{0x03})
+		require.NoError(t, err)
+		require.True(t, has)
+
+		v, err = store.Get([]byte{0x04})
+		require.NoError(t, err)
+		require.Nil(t, v)
+	})
+
+	t.Run("should iterate the module store", func(t *testing.T) {
+		it, err := store.Iterator([]byte{0x01}, []byte{0x03})
+		require.NoError(t, err)
+		var keys [][]byte
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		require.NoError(t, it.Close())
+		require.Equal(t, [][]byte{{0x01}, {0x02}}, keys)
+
+		it, err = store.ReverseIterator(nil, nil)
+		require.NoError(t, err)
+		require.True(t, it.Valid())
+		require.Equal(t, []byte{0x03}, it.Key())
+		require.NoError(t, it.Close())
+	})
+
+	t.Run("should delete from the module store", func(t *testing.T) {
+		require.NoError(t, store.Delete([]byte{0x01}))
+		require.False(t, sdkStore.Has([]byte{0x01}))
+	})
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// DefaultStreamBufferSize is the default number of distributions buffered for each stream client
+const DefaultStreamBufferSize = 100
+
+var (
+	_ types.StreamServer       = &DistributionStream{}
+	_ baseapp.StreamingService = &DistributionStream{}
+)
+
+// DistributionStream streams the allocation of the minted coins of the committed blocks. It is
+// registered as a streaming service of the app, the commit hook feeds the distributions to the
+// stream clients. The distributions are buffered for each client, the oldest distributions are
+// dropped when a client does not keep up.
+type DistributionStream struct {
+	keeper     Keeper
+	queryCtx   func() (sdk.Context, error)
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[*distributionSubscriber]struct{}
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewDistributionStream creates a new distribution stream. queryCtx returns a context on the
+// latest committed state to read the recorded distributions requested by the clients.
+func NewDistributionStream(k Keeper, queryCtx func() (sdk.Context, error), bufferSize int) *DistributionStream {
+	if bufferSize <= 0 {
+		bufferSize = DefaultStreamBufferSize
+	}
+	return &DistributionStream{
+		keeper:      k,
+		queryCtx:    queryCtx,
+		bufferSize:  bufferSize,
+		subscribers: make(map[*distributionSubscriber]struct{}),
+		closed:      make(chan struct{}),
+	}
+}
+
+// StreamDistributions implements types.StreamServer
+func (s *DistributionStream) StreamDistributions(
+	req *types.StreamDistributionsRequest,
+	srv types.Stream_StreamDistributionsServer,
+) error {
+	if req == nil || req.FromHeight < 0 {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// subscribe before reading the recorded distributions so no block is missed, the
+	// distributions both recorded and received are only sent once
+	sub := newDistributionSubscriber(s.bufferSize)
+	s.subscribe(sub)
+	defer s.unsubscribe(sub)
+
+	var lastHeight int64
+	if req.FromHeight > 0 {
+		ctx, err := s.queryCtx()
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		// the replay of the recorded distributions stops once the client is gone
+		var sendErr error
+		err = s.keeper.IterateBlockDistributions(ctx.WithContext(srv.Context()), req.FromHeight, func(distribution types.BlockDistribution) bool {
+			sendErr = srv.Send(&types.StreamDistributionsResponse{Distribution: distribution})
+			lastHeight = distribution.Height
+			return sendErr != nil
+		})
+		if err == nil && sendErr != nil && srv.Context().Err() != nil {
+			err = srv.Context().Err()
+		}
+		if err != nil {
+			return errors.IterationError(err)
+		}
+		if sendErr != nil {
+			return sendErr
+		}
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return errors.IterationError(srv.Context().Err())
+		case <-s.closed:
+			return status.Error(codes.Unavailable, "distribution stream closed")
+		case <-sub.notify:
+		}
+
+		for {
+			distribution, dropped, ok := sub.pop()
+			if !ok {
+				break
+			}
+			if distribution.Height <= lastHeight {
+				continue
+			}
+			err := srv.Send(&types.StreamDistributionsResponse{
+				Distribution: distribution,
+				Dropped:      dropped,
+			})
+			if err != nil {
+				return err
+			}
+			lastHeight = distribution.Height
+		}
+	}
+}
+
+// ListenCommit implements baseapp.ABCIListener, the distribution of the committed block is sent
+// to the stream clients
+func (s *DistributionStream) ListenCommit(ctx context.Context, _ abci.ResponseCommit) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	distribution, found := s.keeper.GetBlockDistribution(sdkCtx, sdkCtx.BlockHeight())
+	if !found {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers {
+		sub.push(distribution)
+	}
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener
+func (s *DistributionStream) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener
+func (s *DistributionStream) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener
+func (s *DistributionStream) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// Stream implements baseapp.StreamingService, the distributions are pushed by the commit hook
+func (s *DistributionStream) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService, the stream doesn't listen to the store writes
+func (s *DistributionStream) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements baseapp.StreamingService, the streams of the clients are ended
+func (s *DistributionStream) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	return nil
+}
+
+func (s *DistributionStream) subscribe(sub *distributionSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers[sub] = struct{}{}
+}
+
+func (s *DistributionStream) unsubscribe(sub *distributionSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// distributionSubscriber buffers the distributions to send to a stream client
+type distributionSubscriber struct {
+	mu      sync.Mutex
+	queue   []types.BlockDistribution
+	size    int
+	dropped uint64
+
+	// notify signals the distributions to send
+	notify chan struct{}
+}
+
+func newDistributionSubscriber(size int) *distributionSubscriber {
+	return &distributionSubscriber{
+		size:   size,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// push buffers a distribution, the oldest distribution is dropped when the buffer is full
+func (sub *distributionSubscriber) push(distribution types.BlockDistribution) {
+	sub.mu.Lock()
+	if len(sub.queue) >= sub.size {
+		sub.queue = sub.queue[1:]
+		sub.dropped++
+	}
+	sub.queue = append(sub.queue, distribution)
+	sub.mu.Unlock()
+
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the oldest buffered distribution and the number of dropped distributions
+func (sub *distributionSubscriber) pop() (distribution types.BlockDistribution, dropped uint64, ok bool) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if len(sub.queue) == 0 {
+		return distribution, sub.dropped, false
+	}
+	distribution = sub.queue[0]
+	sub.queue = sub.queue[1:]
+	return distribution, sub.dropped, true
+}
@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	testapp "github.com/ignite/modules/app"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// newDistributionStream returns an app with a distribution stream registered as streaming service
+func newDistributionStream(bufferSize int) (*testapp.App, *keeper.DistributionStream) {
+	app := setup(false)
+	stream := keeper.NewDistributionStream(
+		app.MintKeeper,
+		func() (sdk.Context, error) {
+			return app.CreateQueryContext(0, false)
+		},
+		bufferSize,
+	)
+	app.SetStreamingService(stream)
+	return app, stream
+}
+
+// commitBlocks commits new blocks in the app
+func commitBlocks(app *testapp.App, n int) {
+	for i := 0; i < n; i++ {
+		header := tmproto.Header{
+			ChainID: "simapp-chain-id",
+			Height:  app.LastBlockHeight() + 1,
+			Time:    time.Now(),
+		}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+}
+
+func TestStreamDistributions(t *testing.T) {
+	app, stream := newDistributionStream(keeper.DefaultStreamBufferSize)
+	commitBlocks(app, 3)
+
+	// serve the stream with an in-process gRPC server
+	grpcCodec := codec.NewProtoCodec(app.InterfaceRegistry()).GRPCCodec()
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	types.RegisterStreamServer(server, stream)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := types.NewStreamClient(conn).StreamDistributions(ctx, &types.StreamDistributionsRequest{
+		FromHeight: 2,
+	})
+	require.NoError(t, err)
+
+	// the recorded distributions are sent from the requested height, then the new ones
+	for _, height := range []int64{2, 3} {
+		res, err := client.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, res.Distribution.Height)
+	}
+	commitBlocks(app, 2)
+	for _, height := range []int64{4, 5} {
+		res, err := client.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, res.Distribution.Height)
+		require.Zero(t, res.Dropped)
+
+		expected, found := app.MintKeeper.GetBlockDistribution(app.NewContext(true, tmproto.Header{}), height)
+		require.True(t, found)
+		require.Equal(t, expected, res.Distribution)
+		require.True(t, res.Distribution.Minted.IsPositive())
+	}
+}
+
+// blockingStreamServer is a stream server waiting for the test to receive the sent distributions
+type blockingStreamServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *types.StreamDistributionsResponse
+}
+
+func (s blockingStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s blockingStreamServer) Send(res *types.StreamDistributionsResponse) error {
+	select {
+	case s.sent <- res:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func TestStreamDistributionsBackpressure(t *testing.T) {
+	app, stream := newDistributionStream(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := blockingStreamServer{ctx: ctx, sent: make(chan *types.StreamDistributionsResponse)}
+	done := make(chan error, 1)
+	go func() {
+		done <- stream.StreamDistributions(&types.StreamDistributionsRequest{}, srv)
+	}()
+
+	// wait for the subscription of the client
+	var first *types.StreamDistributionsResponse
+	require.Eventually(t, func() bool {
+		commitBlocks(app, 1)
+		select {
+		case first = <-srv.sent:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the client doesn't receive the distributions of the new blocks, the oldest are dropped
+	commitBlocks(app, 10)
+	lastHeight := app.LastBlockHeight()
+
+	var received []*types.StreamDistributionsResponse
+	for len(received) == 0 || received[len(received)-1].Distribution.Height < lastHeight {
+		select {
+		case res := <-srv.sent:
+			received = append(received, res)
+		case <-time.After(5 * time.Second):
+			t.Fatal("distribution not received")
+		}
+	}
+	for i := 1; i < len(received); i++ {
+		require.Greater(t, received[i].Distribution.Height, received[i-1].Distribution.Height)
+	}
+	last := received[len(received)-1]
+	require.EqualValues(t, lastHeight-first.Distribution.Height, len(received)+int(last.Dropped))
+	require.Positive(t, last.Dropped)
+
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-done))
+}
+
+func TestStreamDistributionsReplayCancel(t *testing.T) {
+	app, stream := newDistributionStream(2)
+	commitBlocks(app, 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := blockingStreamServer{ctx: ctx, sent: make(chan *types.StreamDistributionsResponse)}
+	done := make(chan error, 1)
+	go func() {
+		done <- stream.StreamDistributions(&types.StreamDistributionsRequest{FromHeight: 1}, srv)
+	}()
+
+	// the client leaves after the first replayed distribution
+	select {
+	case res := <-srv.sent:
+		require.Positive(t, res.Distribution.Height)
+	case <-time.After(5 * time.Second):
+		t.Fatal("distribution not received")
+	}
+	cancel()
+
+	select {
+	case err := <-done:
+		require.Equal(t, codes.Canceled, status.Code(err))
+	case <-time.After(5 * time.Second):
+		t.Fatal("replay not stopped")
+	}
+}
+
+func TestStreamDistributionsClose(t *testing.T) {
+	_, stream := newDistributionStream(2)
+
+	srv := blockingStreamServer{ctx: context.Background(), sent: make(chan *types.StreamDistributionsResponse)}
+	done := make(chan error, 1)
+	go func() {
+		done <- stream.StreamDistributions(&types.StreamDistributionsRequest{}, srv)
+	}()
+	require.NoError(t, stream.Close())
+	require.Error(t, <-done)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// GetSummary returns the summary of the mint state
+func (k Keeper) GetSummary(ctx sdk.Context) (summary types.Summary, found bool) {
+	return k.collections.summary.Get(ctx)
+}
+
+// RefreshSummary rebuilds the summary of the mint state from the minter and the params.
+// The summary is refreshed by SetMinter and SetParams, params changed directly through
+// the params subspace are reflected on the next block when the minter is updated.
+func (k Keeper) RefreshSummary(ctx sdk.Context) {
+	k.setSummary(ctx, types.NewSummary(k.GetMinter(ctx), k.GetParams(ctx)))
+}
+
+func (k Keeper) setSummary(ctx sdk.Context, summary types.Summary) {
+	k.collections.summary.Set(ctx, summary)
+}
+
+func (k Keeper) hasMinter(ctx sdk.Context) bool {
+	return k.collections.minter.Has(ctx)
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/x/mint"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// requireSummary checks the stored summary is derived from the current minter and params
+func requireSummary(t *testing.T, ctx sdk.Context, tk testkeeper.TestKeepers) types.Summary {
+	summary, found := tk.MintKeeper.GetSummary(ctx)
+	require.True(t, found)
+	expected := types.NewSummary(tk.MintKeeper.GetMinter(ctx), tk.MintKeeper.GetParams(ctx))
+	require.Equal(t, expected.MintDenom, summary.MintDenom)
+	require.True(t, expected.Inflation.Equal(summary.Inflation))
+	require.True(t, expected.AnnualProvisions.Equal(summary.AnnualProvisions))
+	require.True(t, expected.CumulativeMinted.Equal(summary.CumulativeMinted))
+	return summary
+}
+
+func TestSummary(t *testing.T) {
+	for _, ts := range testSetups {
+		ts := ts
+		t.Run(ts.name, func(t *testing.T) {
+			t.Run("should refresh the summary when the minter is set", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				minter := types.InitialMinter(sdk.NewDecWithPrec(5, 2))
+				minter.AnnualProvisions = sdk.NewDec(1000)
+				minter.CumulativeMinted = sdkmath.NewInt(500)
+				tk.MintKeeper.SetMinter(ctx, minter)
+
+				summary := requireSummary(t, ctx, tk)
+				require.Equal(t, sdkmath.NewInt(500), summary.CumulativeMinted)
+			})
+
+			t.Run("should refresh the summary when the params are set", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := types.DefaultParams()
+				params.MintDenom = "foo"
+				tk.MintKeeper.SetParams(ctx, params)
+
+				summary := requireSummary(t, ctx, tk)
+				require.Equal(t, "foo", summary.MintDenom)
+			})
+
+			t.Run("should refresh the summary when the pause flags are set", func(t *testing.T) {
+				ctx, tk, ts := ts.setup(t)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(sdk.NewDecWithPrec(5, 2)))
+				tk.MintKeeper.RefreshSummary(ctx)
+				_, err := ts.MintSrv.SetPaused(
+					sdk.WrapSDKContext(ctx),
+					types.NewMsgSetPaused(tk.MintKeeper.GetAuthority(), types.PAUSE_TARGET_MINTING, true),
+				)
+				require.NoError(t, err)
+				requireSummary(t, ctx, tk)
+			})
+
+			t.Run("should refresh the summary and track the cumulative minted in begin blocker", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				params := lowInflationParams()
+				params.BlocksPerYear = 10
+				tk.MintKeeper.SetParams(ctx, params)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+				fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				summary := requireSummary(t, ctx, tk)
+				require.Equal(t, sdkmath.NewInt(10), summary.CumulativeMinted)
+				require.True(t, sdk.NewDec(100).Equal(summary.AnnualProvisions))
+
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				summary = requireSummary(t, ctx, tk)
+				require.Equal(t, sdkmath.NewInt(20), summary.CumulativeMinted)
+
+				// the cumulative minted is not increased while minting is paused
+				params.PauseMinting = true
+				tk.MintKeeper.SetParams(ctx, params)
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+				summary = requireSummary(t, ctx, tk)
+				require.Equal(t, sdkmath.NewInt(20), summary.CumulativeMinted)
+			})
+
+			t.Run("should refresh the summary on genesis initialization", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				genesis := types.DefaultGenesis()
+				genesis.Params.MintDenom = "foo"
+				genesis.Minter.CumulativeMinted = sdkmath.NewInt(100)
+				mint.InitGenesis(ctx, tk.MintKeeper, tk.AccountKeeper, genesis)
+
+				summary := requireSummary(t, ctx, tk)
+				require.Equal(t, "foo", summary.MintDenom)
+				require.Equal(t, sdkmath.NewInt(100), summary.CumulativeMinted)
+			})
+
+			t.Run("should rebuild the summary with the migration", func(t *testing.T) {
+				ctx, tk, _ := ts.setup(t)
+				tk.MintKeeper.SetMinter(ctx, types.InitialMinter(sdk.NewDecWithPrec(5, 2)))
+				require.NoError(t, keeper.NewMigrator(tk.MintKeeper).Migrate1to2(ctx))
+				requireSummary(t, ctx, tk)
+			})
+		})
+	}
+}
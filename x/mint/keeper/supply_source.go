@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// stakingSupplySource is a supply source delegating to the staking keeper
+type stakingSupplySource struct {
+	stakingKeeper types.StakingKeeper
+}
+
+// NewStakingSupplySource returns a supply source returning the staking token supply of the
+// staking keeper, alternative supply sources can wrap it to add the supply staked through derivatives
+func NewStakingSupplySource(sk types.StakingKeeper) types.SupplySource {
+	return stakingSupplySource{stakingKeeper: sk}
+}
+
+// EffectiveStakedSupply implements types.SupplySource
+func (s stakingSupplySource) EffectiveStakedSupply(ctx sdk.Context) sdkmath.Int {
+	return s.stakingKeeper.StakingTokenSupply(ctx)
+}
+
+// EffectiveStakingSupply returns the staking supply used to compute the annual provisions and
+// the name of its source. The staking keeper supply is used if the keeper has no supply source,
+// otherwise it is replaced or combined with the supply of the supply source depending on the
+// supply source mode. The supply excluded from the circulating supply, the strategic reserve locked
+// in the module account, is deducted from the staking supply.
+func (k Keeper) EffectiveStakingSupply(ctx sdk.Context, params types.Params, stakingSupply sdkmath.Int) (sdkmath.Int, string) {
+	source := types.SupplySourceStakingKeeper
+	if k.supplySource != nil {
+		stakingSupply, source = types.SelectStakingSupply(params.SupplySourceMode, stakingSupply, k.supplySource.EffectiveStakedSupply(ctx))
+	}
+	minter, _ := k.getStoredMinter(ctx)
+	return sdkmath.MaxInt(stakingSupply.Sub(minter.ExcludedSupply(params.MintDenom)), sdkmath.ZeroInt()), source
+}
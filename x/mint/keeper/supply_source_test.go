@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// mockSupplySource returns a fixed effective staked supply
+type mockSupplySource struct {
+	supply sdkmath.Int
+}
+
+func (s mockSupplySource) EffectiveStakedSupply(sdk.Context) sdkmath.Int {
+	return s.supply
+}
+
+func TestBeginBlockerSupplySource(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           []keeper.KeeperOption
+		mode           types.SupplySourceMode
+		expectedSupply int64
+		expectedSource string
+	}{
+		{
+			name:           "should use the staking keeper supply without supply source",
+			expectedSupply: 1000,
+			expectedSource: types.SupplySourceStakingKeeper,
+		},
+		{
+			name:           "should replace the staking keeper supply with the supply source",
+			opts:           []keeper.KeeperOption{keeper.WithSupplySource(mockSupplySource{sdkmath.NewInt(3000)})},
+			expectedSupply: 3000,
+			expectedSource: types.SupplySourceExternal,
+		},
+		{
+			name:           "should use the larger staking keeper supply",
+			opts:           []keeper.KeeperOption{keeper.WithSupplySource(mockSupplySource{sdkmath.NewInt(500)})},
+			mode:           types.SUPPLY_SOURCE_MODE_MAX,
+			expectedSupply: 1000,
+			expectedSource: types.SupplySourceStakingKeeper,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, tc.opts...)
+			params := lowInflationParams()
+			params.BlocksPerYear = 10
+			params.SupplySourceMode = tc.mode
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+			fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1000))))
+
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+
+			// the annual provisions are 10% of the supply, minted in 10 blocks
+			minter := tk.MintKeeper.GetMinter(ctx)
+			require.Equal(t, sdkmath.NewInt(tc.expectedSupply), minter.LastBlockInputs.StakingSupply)
+			require.Equal(t, tc.expectedSource, minter.LastBlockInputs.SupplySource)
+			require.Equal(t, sdk.NewDecWithPrec(1, 1).MulInt64(tc.expectedSupply), minter.AnnualProvisions)
+			require.Equal(t, sdkmath.NewInt(1000+tc.expectedSupply/100), tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+		})
+	}
+}
+
+func TestStakingSupplySource(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	source := keeper.NewStakingSupplySource(tk.StakingKeeper)
+	require.Equal(t, tk.StakingKeeper.StakingTokenSupply(ctx), source.EffectiveStakedSupply(ctx))
+	require.Equal(t, sdkmath.NewInt(1000), source.EffectiveStakedSupply(ctx))
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestParamsFromTokenomicsYearOne(t *testing.T) {
+	const supply = 1_000_000_000_000
+
+	tests := []struct {
+		name      string
+		inflation int64
+		blockTime string
+	}{
+		{
+			name:      "should emit the inflation of 100 blocks a year",
+			inflation: 10,
+			blockTime: "87h39m36s",
+		},
+		{
+			name:      "should emit the inflation of 365 daily blocks a year",
+			inflation: 7,
+			blockTime: "1d",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params, _, err := types.ParamsFromTokenomics(types.TokenomicsSpec{
+				MintDenom:            sdk.DefaultBondDenom,
+				InitialInflation:     sdk.NewDec(tc.inflation),
+				BlockTime:            tc.blockTime,
+				StakingShare:         sdk.NewDec(70),
+				FundedAddressesShare: sdk.ZeroDec(),
+				CommunityPoolShare:   sdk.NewDec(30),
+			})
+			require.NoError(t, err)
+
+			ctx, tk, _ := testSetups[0].setup(t)
+			tk.MintKeeper.SetParams(ctx, params)
+			tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+			fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdkmath.NewInt(supply))))
+
+			for i := uint64(0); i < params.BlocksPerYear; i++ {
+				require.NoError(t, tk.MintKeeper.BeginBlocker(ctx))
+			}
+
+			// the annual provisions are recomputed from the supply at each block, the emissions
+			// compound over the year
+			rate := float64(tc.inflation) / 100
+			blocks := float64(params.BlocksPerYear)
+			expected := supply * (math.Pow(1+rate/blocks, blocks) - 1)
+			emitted := tk.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.SubRaw(supply)
+			require.InEpsilon(t, expected, float64(emitted.Int64()), 1e-6)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTotalMintedOf returns the total amount of a denom minted by the module
+func (k Keeper) GetTotalMintedOf(ctx sdk.Context, denom string) sdk.Coin {
+	amount, found := k.collections.totalMinted.Get(ctx, denom)
+	if !found {
+		amount = sdkmath.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// GetTotalMinted returns the total amount of each denom minted by the module
+func (k Keeper) GetTotalMinted(ctx sdk.Context) sdk.Coins {
+	return iterateTotals(ctx, k.collections.totalMinted)
+}
+
+// SetTotalMinted sets the total amount of each denom minted by the module, the totals of the
+// denoms not listed are removed
+func (k Keeper) SetTotalMinted(ctx sdk.Context, total sdk.Coins) {
+	k.collections.totalMinted.Clear(ctx)
+	for _, coin := range total {
+		k.setTotalMintedOf(ctx, coin)
+	}
+}
+
+func (k Keeper) setTotalMintedOf(ctx sdk.Context, total sdk.Coin) {
+	k.collections.totalMinted.Set(ctx, total.Denom, total.Amount)
+}
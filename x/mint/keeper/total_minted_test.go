@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestTotalMinted(t *testing.T) {
+	t.Run("should add the coins minted by the begin blocker to the total minted", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := lowInflationParams()
+		params.BlocksPerYear = 10
+		tk.MintKeeper.SetParams(ctx, params)
+		tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+		require.True(t, tk.MintKeeper.GetTotalMinted(ctx).IsZero())
+
+		supply := tk.BankKeeper.GetSupply(ctx, params.MintDenom)
+		for height := int64(1); height <= 3; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+		}
+		minted := tk.BankKeeper.GetSupply(ctx, params.MintDenom).Sub(supply)
+		require.True(t, minted.IsPositive())
+		require.Equal(t, sdk.NewCoins(minted), tk.MintKeeper.GetTotalMinted(ctx))
+		require.Equal(t, minted, tk.MintKeeper.GetTotalMintedOf(ctx, params.MintDenom))
+		require.Equal(t, sdk.NewInt64Coin("foo", 0), tk.MintKeeper.GetTotalMintedOf(ctx, "foo"))
+	})
+
+	t.Run("should keep the total minted across a genesis export and import", func(t *testing.T) {
+		// the params of the test setup are kept so the genesis initialization doesn't rescale the
+		// annual provisions on a fresh store
+		ctx, tk, _ := testSetups[0].setup(t)
+		params := types.DefaultParams()
+		mint.InitGenesis(ctx, tk.MintKeeper, tk.AccountKeeper, &types.GenesisState{
+			Minter:      types.InitialMinter(params.InflationMax),
+			Params:      params,
+			TotalMinted: sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 500)),
+		})
+		fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1_000_000_000_000)))
+
+		for height := int64(1); height <= 3; height++ {
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(height)))
+		}
+		total := tk.MintKeeper.GetTotalMintedOf(ctx, params.MintDenom)
+		require.True(t, total.Amount.GT(sdkmath.NewInt(500)))
+
+		genesis := mint.ExportGenesis(ctx, tk.MintKeeper)
+		require.NoError(t, genesis.Validate())
+		require.Equal(t, sdk.NewCoins(total), genesis.TotalMinted)
+
+		freshCtx, freshTk, _ := testSetups[0].setup(t)
+		mint.InitGenesis(freshCtx, freshTk.MintKeeper, freshTk.AccountKeeper, genesis)
+		require.Equal(t, genesis.TotalMinted, freshTk.MintKeeper.GetTotalMinted(freshCtx))
+		require.Equal(t, genesis, mint.ExportGenesis(freshCtx, freshTk.MintKeeper))
+	})
+
+	t.Run("should return the total minted with the query", func(t *testing.T) {
+		ctx, tk, _ := testSetups[0].setup(t)
+		total := sdk.NewCoins(sdk.NewInt64Coin("foo", 20), sdk.NewInt64Coin("stake", 100))
+		tk.MintKeeper.SetTotalMinted(ctx, total)
+
+		q := querier.NewQuerier(tk.MintKeeper)
+		res, err := q.TotalMinted(sdk.WrapSDKContext(ctx), &types.QueryTotalMintedRequest{})
+		require.NoError(t, err)
+		require.Equal(t, total, res.TotalMinted)
+
+		// the totals not listed are removed
+		tk.MintKeeper.SetTotalMinted(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+		res, err = q.TotalMinted(sdk.WrapSDKContext(ctx), &types.QueryTotalMintedRequest{})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), res.TotalMinted)
+
+		_, err = q.TotalMinted(sdk.WrapSDKContext(ctx), nil)
+		require.Error(t, err)
+	})
+}
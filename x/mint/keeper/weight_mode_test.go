@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// delegatorBondedStakingKeeper overrides the staking supply and the bonded delegations of the
+// delegators of the staking keeper, the delegations can be changed between blocks
+type delegatorBondedStakingKeeper struct {
+	types.StakingKeeper
+	supply sdkmath.Int
+	bonded map[string]sdkmath.Int
+}
+
+func (k delegatorBondedStakingKeeper) StakingTokenSupply(sdk.Context) sdkmath.Int {
+	return k.supply
+}
+
+func (k delegatorBondedStakingKeeper) GetDelegatorBonded(_ sdk.Context, delegator sdk.AccAddress) sdkmath.Int {
+	if bonded, ok := k.bonded[delegator.String()]; ok {
+		return bonded
+	}
+	return sdkmath.ZeroInt()
+}
+
+func TestBeginBlockerStakeWeightedAddresses(t *testing.T) {
+	bonded := make(map[string]sdkmath.Int)
+	ctx, tk, _ := testkeeper.NewTestSetupWithMintStakingKeeper(t, func(sk types.StakingKeeper) types.StakingKeeper {
+		return delegatorBondedStakingKeeper{
+			StakingKeeper: sk,
+			supply:        sdkmath.NewInt(250_000),
+			bonded:        bonded,
+		}
+	})
+	fixed, partner1, partner2 := sample.Address(r), sample.Address(r), sample.Address(r)
+
+	// the block provision is 2500 tokens, 1000 for the funded addresses: 500 for the fixed address
+	// and 500 split between the partners by their bonded delegations
+	params := lowInflationParams()
+	params.BlocksPerYear = 10
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: fixed, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: partner1, Weight: sdk.NewDecWithPrec(25, 2), WeightMode: types.WEIGHT_MODE_STAKE_WEIGHTED},
+		{Address: partner2, Weight: sdk.NewDecWithPrec(25, 2), WeightMode: types.WEIGHT_MODE_STAKE_WEIGHTED},
+	}
+	tk.MintKeeper.SetParams(ctx, params)
+	tk.MintKeeper.SetMinter(ctx, types.InitialMinter(params.InflationMax))
+	fundSupply(t, ctx, tk, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 250_000)))
+
+	for _, block := range []struct {
+		name     string
+		bonded   [2]int64
+		expected [2]int64
+	}{
+		{name: "split by bonded delegations", bonded: [2]int64{100, 300}, expected: [2]int64{125, 375}},
+		{name: "changed delegations", bonded: [2]int64{300, 100}, expected: [2]int64{375, 125}},
+		{name: "new delegator", bonded: [2]int64{0, 50}, expected: [2]int64{0, 500}},
+		{name: "equal split without delegations", bonded: [2]int64{0, 0}, expected: [2]int64{250, 250}},
+		{name: "truncated split", bonded: [2]int64{1, 2}, expected: [2]int64{166, 333}},
+	} {
+		height := ctx.BlockHeight() + 1
+		ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		bonded[partner1] = sdkmath.NewInt(block.bonded[0])
+		bonded[partner2] = sdkmath.NewInt(block.bonded[1])
+
+		require.NoError(t, tk.MintKeeper.BeginBlocker(ctx), block.name)
+
+		// the estimate of the next block uses the current delegations
+		estimated, err := querier.NewQuerier(tk.MintKeeper).EstimatedDistribution(
+			sdk.WrapSDKContext(ctx),
+			&types.QueryEstimatedDistributionRequest{},
+		)
+		require.NoError(t, err, block.name)
+
+		distribution, found := tk.MintKeeper.GetBlockDistribution(ctx, height)
+		require.True(t, found, block.name)
+		require.Len(t, distribution.FundedAddresses, 3, block.name)
+		for i, expected := range []int64{500, block.expected[0], block.expected[1]} {
+			fundedAddress := distribution.FundedAddresses[i]
+			require.Equal(t, params.FundedAddresses[i].Address, fundedAddress.Address, block.name)
+			require.Equal(t, sdkmath.NewInt(expected), fundedAddress.Amount.AmountOf(params.MintDenom), "%s: index %d", block.name, i)
+			require.Equal(t, sdk.NewInt64Coin(params.MintDenom, expected), estimated.FundedAddresses[i].Amount, "%s: index %d", block.name, i)
+		}
+		res, broken := keeper.AllInvariants(tk.MintKeeper)(ctx)
+		require.False(t, broken, res)
+	}
+}
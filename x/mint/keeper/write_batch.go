@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"io"
+
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultWriteBatchLimit is the default number of keys written to the write batch of the begin
+// blocker flushing the batch to the module store
+const DefaultWriteBatchLimit = 1000
+
+// batchedStoreService opens the write batch of the context, if any, instead of the module store
+type batchedStoreService struct {
+	corestore.KVStoreService
+}
+
+// OpenKVStore implements corestore.KVStoreService
+func (s batchedStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	switch ms := sdk.UnwrapSDKContext(ctx).MultiStore().(type) {
+	case batchMultiStore:
+		return kvStore{store: ms.batch}
+	case batchCacheMultiStore:
+		return kvStore{store: ms.batch}
+	}
+	return s.KVStoreService.OpenKVStore(ctx)
+}
+
+// batchMultiStore is the multistore of a context batching the writes to the module store. A
+// branch of the multistore, created by a hook or by the begin blocker to discard the writes of a
+// failed distribution, branches the batch with the other stores: the branch reads the batched
+// writes and its writes to the module store are written to the batch, not to the module store,
+// once the branch is written.
+type batchMultiStore struct {
+	storetypes.MultiStore
+	batch storetypes.CacheKVStore
+}
+
+// branch returns a branch of the multistore with the batch branched
+func (ms batchMultiStore) branch(branch storetypes.CacheMultiStore) batchCacheMultiStore {
+	return batchCacheMultiStore{batchMultiStore{MultiStore: branch, batch: cachekv.NewStore(ms.batch)}}
+}
+
+// CacheMultiStore implements storetypes.MultiStore
+func (ms batchMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return ms.branch(ms.MultiStore.CacheMultiStore())
+}
+
+// CacheWrap implements storetypes.MultiStore
+func (ms batchMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements storetypes.MultiStore
+func (ms batchMultiStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.branch(ms.MultiStore.CacheWrapWithTrace(w, tc).(storetypes.CacheMultiStore))
+}
+
+// batchCacheMultiStore is a branch of a batchMultiStore
+type batchCacheMultiStore struct {
+	batchMultiStore
+}
+
+// Write implements storetypes.CacheMultiStore, the writes to the module store are written to the
+// batch of the branched multistore
+func (ms batchCacheMultiStore) Write() {
+	ms.batch.Write()
+	ms.MultiStore.(storetypes.CacheMultiStore).Write()
+}
+
+// writeBatch is the batch of the writes of a block to the module store. The batch is flushed to
+// the module store once it holds limit keys, the writes of a block touching many keys, such as the
+// income of many funded addresses, are written in several flushes instead of accumulating in the
+// batch.
+type writeBatch struct {
+	*cachekv.Store
+	limit int
+	keys  map[string]struct{}
+}
+
+func newWriteBatch(parent storetypes.KVStore, limit int) *writeBatch {
+	return &writeBatch{Store: cachekv.NewStore(parent), limit: limit, keys: make(map[string]struct{})}
+}
+
+// Set implements storetypes.KVStore
+func (b *writeBatch) Set(key, value []byte) {
+	b.Store.Set(key, value)
+	b.track(key)
+}
+
+// Delete implements storetypes.KVStore
+func (b *writeBatch) Delete(key []byte) {
+	b.Store.Delete(key)
+	b.track(key)
+}
+
+// Write flushes the batch to the module store
+func (b *writeBatch) Write() {
+	b.Store.Write()
+	b.keys = make(map[string]struct{})
+}
+
+// track records a key written to the batch and flushes the batch once it holds limit keys
+func (b *writeBatch) track(key []byte) {
+	b.keys[string(key)] = struct{}{}
+	if len(b.keys) >= b.limit {
+		b.Write()
+	}
+}
+
+// batchWrites returns a context batching the writes to the module store until the returned flush
+// function is called. The batch reads its own writes and the module store, a key written several
+// times between two flushes is written once to the module store, deleted keys included. The
+// context is returned unchanged if its writes are already batched or the batching is disabled.
+//
+// The batch holds at most writeBatchLimit keys, it is flushed early once the limit is reached so
+// its size is bounded whatever the number of keys written by the block. The writes of a branch are
+// added to the batch once the branch is written, they are flushed early with the other writes of
+// the batch.
+func (k Keeper) batchWrites(ctx sdk.Context) (sdk.Context, func()) {
+	if k.disableWriteBatching {
+		return ctx, func() {}
+	}
+	switch ctx.MultiStore().(type) {
+	case batchMultiStore, batchCacheMultiStore:
+		return ctx, func() {}
+	}
+
+	batch := newWriteBatch(newKVStoreAdapter(k.storeService.OpenKVStore(ctx)), k.writeBatchLimit)
+	return ctx.WithMultiStore(batchMultiStore{MultiStore: ctx.MultiStore(), batch: batch}), batch.Write
+}
@@ -0,0 +1,296 @@
+package keeper_test
+
+import (
+	"io"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/ignite/modules/testutil/keeper"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// countingMultiStore counts the writes and the deletes of the module store
+type countingMultiStore struct {
+	storetypes.MultiStore
+	key    storetypes.StoreKey
+	writes *int
+}
+
+func (ms countingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store := ms.MultiStore.GetKVStore(key)
+	if key == ms.key {
+		return countingKVStore{KVStore: store, writes: ms.writes}
+	}
+	return store
+}
+
+// CacheMultiStore branches the multistore, the writes of the branch to the module store are
+// counted once the branch is written
+func (ms countingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return countingCacheMultiStore{
+		cacheMultiStore: ms.MultiStore.CacheMultiStore(),
+		key:             ms.key,
+		store:           cachekv.NewStore(ms.GetKVStore(ms.key)),
+	}
+}
+
+// cacheMultiStore is the type of the embedded branch of countingCacheMultiStore, an embedded
+// field can't be named after the CacheMultiStore method it promotes
+type cacheMultiStore = storetypes.CacheMultiStore
+
+// countingCacheMultiStore is a branch of a countingMultiStore
+type countingCacheMultiStore struct {
+	cacheMultiStore
+	key   storetypes.StoreKey
+	store *cachekv.Store
+}
+
+func (ms countingCacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if key == ms.key {
+		return ms.store
+	}
+	return ms.cacheMultiStore.GetKVStore(key)
+}
+
+// CacheMultiStore branches the branch, the writes of the nested branch to the module store are
+// counted once both branches are written
+func (ms countingCacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return countingCacheMultiStore{
+		cacheMultiStore: ms.cacheMultiStore.CacheMultiStore(),
+		key:             ms.key,
+		store:           cachekv.NewStore(ms.store),
+	}
+}
+
+func (ms countingCacheMultiStore) Write() {
+	ms.store.Write()
+	ms.cacheMultiStore.Write()
+}
+
+type countingKVStore struct {
+	storetypes.KVStore
+	writes *int
+}
+
+func (s countingKVStore) Set(key, value []byte) {
+	*s.writes++
+	s.KVStore.Set(key, value)
+}
+
+func (s countingKVStore) Delete(key []byte) {
+	*s.writes++
+	s.KVStore.Delete(key)
+}
+
+// mintStoreKey returns the key of the module store of the multistore of a test setup
+func mintStoreKey(ctx sdk.Context) storetypes.StoreKey {
+	return ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()[types.StoreKey]
+}
+
+// dumpMintStore returns the records of the module store by key
+func dumpMintStore(ctx sdk.Context) map[string][]byte {
+	records := make(map[string][]byte)
+	it := ctx.KVStore(mintStoreKey(ctx)).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		records[string(it.Key())] = it.Value()
+	}
+	return records
+}
+
+// writeBatchSetup returns a test setup with the records written at each block enabled: the
+// inflation snapshots, the distribution history, the income of the funded addresses, the pending
+// payouts of the ledger and the minter of a mint configuration, written again after the minting of
+// the mint denom
+func writeBatchSetup(t testing.TB, opts ...keeper.KeeperOption) (sdk.Context, testkeeper.TestKeepers) {
+	ctx, tk, _ := testkeeper.NewTestSetupWithMintKeeperOptions(t, opts...)
+	addrRand := rand.New(rand.NewSource(1))
+	params := types.DefaultParams()
+	params.BlocksPerYear = 1000
+	params.InflationSnapshotInterval = 1
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: sample.Address(addrRand), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: sample.Address(addrRand), Weight: sdk.NewDecWithPrec(3, 1), PayoutMode: types.PAYOUT_MODE_PULL},
+		{Address: sample.Address(addrRand), Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+	params.MintConfigs = []types.MintConfig{fooMintConfig()}
+	tk.MintKeeper.SetParams(ctx, params)
+	coins := sdk.NewCoins(
+		sdk.NewCoin(params.MintDenom, sdkmath.NewInt(1_000_000_000)),
+		sdk.NewCoin("foo", sdkmath.NewInt(1_000_000_000)),
+	)
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, tk.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sample.AccAddress(addrRand), coins))
+	return ctx, tk
+}
+
+// countBlockWrites runs the begin blocker of a block and returns the writes to the module store
+func countBlockWrites(t testing.TB, ctx sdk.Context, k keeper.Keeper, height int64) int {
+	writes := 0
+	ms := countingMultiStore{MultiStore: ctx.MultiStore(), key: mintStoreKey(ctx), writes: &writes}
+	require.NoError(t, k.BeginBlocker(ctx.WithBlockHeight(height).WithMultiStore(ms)))
+	return writes
+}
+
+func TestWriteBatching(t *testing.T) {
+	t.Run("should write the same state as the unbatched writes with fewer writes", func(t *testing.T) {
+		batchedCtx, batched := writeBatchSetup(t)
+		unbatchedCtx, unbatched := writeBatchSetup(t, keeper.WithoutWriteBatching())
+
+		for height := int64(1); height <= 5; height++ {
+			batchedWrites := countBlockWrites(t, batchedCtx, batched.MintKeeper, height)
+			unbatchedWrites := countBlockWrites(t, unbatchedCtx, unbatched.MintKeeper, height)
+			require.Less(t, batchedWrites, unbatchedWrites, "height %d", height)
+
+			require.Equal(t, dumpMintStore(unbatchedCtx), dumpMintStore(batchedCtx), "height %d", height)
+			require.Equal(t, unbatched.MintKeeper.GetMinter(unbatchedCtx), batched.MintKeeper.GetMinter(batchedCtx))
+			for _, fundedAddr := range batched.MintKeeper.GetParams(batchedCtx).FundedAddresses {
+				addr := sdk.MustAccAddressFromBech32(fundedAddr.Address)
+				require.Equal(t,
+					unbatched.BankKeeper.GetAllBalances(unbatchedCtx, addr),
+					batched.BankKeeper.GetAllBalances(batchedCtx, addr),
+				)
+			}
+		}
+	})
+
+	t.Run("should write each key once", func(t *testing.T) {
+		ctx, tk := writeBatchSetup(t)
+		countBlockWrites(t, ctx, tk.MintKeeper, 1)
+
+		before := dumpMintStore(ctx)
+		writes := countBlockWrites(t, ctx, tk.MintKeeper, 2)
+		changed := 0
+		for key, value := range dumpMintStore(ctx) {
+			if string(before[key]) != string(value) {
+				changed++
+			}
+		}
+		require.Positive(t, changed)
+		require.LessOrEqual(t, writes, changed)
+	})
+
+	t.Run("should flush the batch once it holds the limit of keys", func(t *testing.T) {
+		batchedCtx, batched := writeBatchSetup(t)
+		limitedCtx, limited := writeBatchSetup(t, keeper.WithWriteBatchLimit(1))
+		unbatchedCtx, unbatched := writeBatchSetup(t, keeper.WithoutWriteBatching())
+
+		for height := int64(1); height <= 3; height++ {
+			batchedWrites := countBlockWrites(t, batchedCtx, batched.MintKeeper, height)
+			limitedWrites := countBlockWrites(t, limitedCtx, limited.MintKeeper, height)
+			unbatchedWrites := countBlockWrites(t, unbatchedCtx, unbatched.MintKeeper, height)
+
+			// a batch of a single key is flushed at each write
+			require.Equal(t, unbatchedWrites, limitedWrites, "height %d", height)
+			require.Less(t, batchedWrites, limitedWrites, "height %d", height)
+			require.Equal(t, dumpMintStore(unbatchedCtx), dumpMintStore(limitedCtx), "height %d", height)
+		}
+	})
+
+	for _, tc := range []struct {
+		name   string
+		branch func(sdk.Context) sdk.Context
+	}{
+		{
+			name: "cache multistore",
+			branch: func(ctx sdk.Context) sdk.Context {
+				branch, _ := ctx.CacheContext()
+				return branch
+			},
+		},
+		{
+			name: "cache wrap",
+			branch: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(storetypes.CacheMultiStore))
+			},
+		},
+		{
+			name: "traced cache wrap",
+			branch: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithMultiStore(ctx.MultiStore().CacheWrapWithTrace(io.Discard, nil).(storetypes.CacheMultiStore))
+			},
+		},
+		{
+			name: "nested cache multistore",
+			branch: func(ctx sdk.Context) sdk.Context {
+				branch, _ := ctx.CacheContext()
+				nested, _ := branch.CacheContext()
+				return nested
+			},
+		},
+	} {
+		tc := tc
+		t.Run("should read the batched writes from a branch of the context with a "+tc.name, func(t *testing.T) {
+			ctx, tk := writeBatchSetup(t)
+			hooks := &branchingHooks{keeper: tk.MintKeeper, branch: tc.branch}
+			tk.MintKeeper.SetHooks(hooks)
+
+			require.NoError(t, tk.MintKeeper.BeginBlocker(ctx.WithBlockHeight(1)))
+			require.Equal(t, tk.MintKeeper.GetMinter(ctx).CumulativeMinted, hooks.branchMinter.CumulativeMinted)
+			require.True(t, hooks.branchMinter.CumulativeMinted.IsPositive())
+
+			// the writes of the discarded branch are not applied
+			require.False(t, tk.MintKeeper.GetMinter(ctx).CarryBuffer.Equal(discardedCarryBuffer))
+		})
+	}
+}
+
+var discardedCarryBuffer = sdk.NewDec(42)
+
+// branchingHooks reads the minter from a branch of the context and writes the minter in the
+// branch, the branch is discarded. The context is branched with CacheContext if branch is nil.
+type branchingHooks struct {
+	keeper       keeper.Keeper
+	branch       func(sdk.Context) sdk.Context
+	branchMinter types.Minter
+}
+
+func (h *branchingHooks) AfterDistributeMintedCoin(ctx sdk.Context, _ sdk.Coin) error {
+	var branch sdk.Context
+	if h.branch != nil {
+		branch = h.branch(ctx)
+	} else {
+		branch, _ = ctx.CacheContext()
+	}
+	h.branchMinter = h.keeper.GetMinter(branch)
+	minter := h.branchMinter
+	minter.CarryBuffer = discardedCarryBuffer
+	h.keeper.SetMinter(branch, minter)
+	return nil
+}
+
+// BenchmarkBeginBlockerWrites reports the writes to the module store of a block with and without
+// write batching, the batched blocks must write less
+func BenchmarkBeginBlockerWrites(b *testing.B) {
+	writesPerBlock := make(map[string]float64)
+	for _, bc := range []struct {
+		name string
+		opts []keeper.KeeperOption
+	}{
+		{name: "batched"},
+		{name: "unbatched", opts: []keeper.KeeperOption{keeper.WithoutWriteBatching()}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			ctx, tk := writeBatchSetup(b, bc.opts...)
+			writes := 0
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				writes += countBlockWrites(b, ctx, tk.MintKeeper, int64(i+1))
+			}
+			writesPerBlock[bc.name] = float64(writes) / float64(b.N)
+			b.ReportMetric(writesPerBlock[bc.name], "writes/block")
+		})
+	}
+	if writesPerBlock["batched"] >= writesPerBlock["unbatched"] {
+		b.Fatalf("batched blocks write %.1f times, unbatched %.1f times", writesPerBlock["batched"], writesPerBlock["unbatched"])
+	}
+}
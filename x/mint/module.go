@@ -16,6 +16,7 @@ import (
 
 	"github.com/ignite/modules/x/mint/client/cli"
 	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
 	"github.com/ignite/modules/x/mint/types"
 )
 
@@ -32,6 +33,75 @@ var (
 // AppModuleBasic defines the basic application module used by the mint module.
 type AppModuleBasic struct {
 	cdc codec.Codec
+
+	// app-specific default genesis values, the module defaults are used when not set
+	defaultParams *types.Params
+	defaultMinter *types.Minter
+
+	// strictGenesis disables the decoding of the legacy genesis states
+	strictGenesis bool
+}
+
+// ModuleOption configures the mint module.
+type ModuleOption func(*AppModuleBasic)
+
+// WithDefaultGenesisParams sets the params of the default genesis of the module.
+func WithDefaultGenesisParams(params types.Params) ModuleOption {
+	return func(b *AppModuleBasic) {
+		b.defaultParams = &params
+	}
+}
+
+// WithDefaultGenesisMinter sets the minter of the default genesis of the module.
+func WithDefaultGenesisMinter(minter types.Minter) ModuleOption {
+	return func(b *AppModuleBasic) {
+		b.defaultMinter = &minter
+	}
+}
+
+// WithProfile sets the params and the minter of the default genesis of the module from a
+// registered profile, the options applied after it override its values. It panics if the
+// profile is unknown.
+func WithProfile(profile string) ModuleOption {
+	genesis := types.MustDefaultGenesisForProfile(profile)
+	return func(b *AppModuleBasic) {
+		b.defaultParams = &genesis.Params
+		b.defaultMinter = &genesis.Minter
+	}
+}
+
+// WithStrictGenesis only accepts genesis states in the proto-JSON encoding, the legacy genesis
+// states are not decoded.
+func WithStrictGenesis() ModuleOption {
+	return func(b *AppModuleBasic) {
+		b.strictGenesis = true
+	}
+}
+
+// NewAppModuleBasic creates a new AppModuleBasic object. It panics if the default genesis
+// resulting from the options is invalid.
+func NewAppModuleBasic(opts ...ModuleOption) AppModuleBasic {
+	var b AppModuleBasic
+	for _, opt := range opts {
+		opt(&b)
+	}
+
+	if err := b.defaultGenesis().Validate(); err != nil {
+		panic(fmt.Sprintf("invalid %s default genesis: %s", types.ModuleName, err))
+	}
+	return b
+}
+
+// defaultGenesis returns the default genesis state with the app-specific values
+func (b AppModuleBasic) defaultGenesis() *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	if b.defaultParams != nil {
+		genesis.Params = *b.defaultParams
+	}
+	if b.defaultMinter != nil {
+		genesis.Minter = *b.defaultMinter
+	}
+	return genesis
 }
 
 // Name returns the mint module's name.
@@ -39,23 +109,30 @@ func (AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
-func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+// RegisterLegacyAminoCodec registers the mint module's types on the LegacyAmino codec.
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
 
 // RegisterInterfaces registers the module's interface types
-func (b AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+func (b AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
 
 // DefaultGenesis returns default genesis state as raw bytes for the mint
 // module.
-func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(types.DefaultGenesis())
+func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(b.defaultGenesis())
 }
 
-// ValidateGenesis performs genesis state validation for the mint module.
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
-	var genState types.GenesisState
-	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+// ValidateGenesis performs genesis state validation for the mint module. A legacy genesis state is
+// validated once converted, unless the module only accepts strict genesis states.
+func (b AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	genState, _, err := types.UnmarshalGenesis(cdc, bz, b.strictGenesis)
+	if err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
+	genState.Normalize()
 
 	return genState.Validate()
 }
@@ -67,8 +144,10 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	}
 }
 
-// GetTxCmd returns no root tx command for the mint module.
-func (AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
+// GetTxCmd returns the root tx command for the mint module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
 
 // GetQueryCmd returns the root query command for the mint module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
@@ -83,14 +162,19 @@ type AppModule struct {
 	authKeeper types.AccountKeeper
 }
 
-// NewAppModule creates a new AppModule object
+// NewAppModule creates a new AppModule object. It panics if the default genesis resulting
+// from the options is invalid.
 func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
 	ak types.AccountKeeper,
+	opts ...ModuleOption,
 ) AppModule {
+	basic := NewAppModuleBasic(opts...)
+	basic.cdc = cdc
+
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{cdc: cdc},
+		AppModuleBasic: basic,
 		keeper:         keeper,
 		authKeeper:     ak,
 	}
@@ -102,21 +186,55 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers the mint module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), querier.NewQuerier(am.keeper))
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the mint module. It returns
-// no validator updates.
+// no validator updates. It panics if the genesis of a module the mint module
+// depends on is not initialized yet. The fields of a legacy genesis state mapped
+// onto the genesis state are logged.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState types.GenesisState
-	cdc.MustUnmarshalJSON(data, &genesisState)
+	genesisState, mappings, err := types.UnmarshalGenesis(cdc, data, am.strictGenesis)
+	if err != nil {
+		panic(fmt.Sprintf("failed to unmarshal %s genesis state: %s", types.ModuleName, err))
+	}
+	for _, mapping := range mappings {
+		ctx.Logger().Info("mapped legacy genesis field", "legacy", mapping.Legacy, "field", mapping.Current)
+	}
+
+	if err := am.keeper.CheckGenesisDependencies(ctx); err != nil {
+		panic(err)
+	}
 
-	InitGenesis(ctx, am.keeper, am.authKeeper, &genesisState)
+	InitGenesis(ctx, am.keeper, am.authKeeper, genesisState)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -128,7 +246,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return types.SchemaVersion }
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -21,7 +21,14 @@ func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
-// WeightedOperations doesn't return any mint module operation.
+// ProposalMsgs returns the msgs of the governance proposals of the mint module used by the
+// simulations.
+func (AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
+}
+
+// WeightedOperations doesn't return any mint module operation, the params are updated
+// through the governance proposals of ProposalMsgs.
 func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
 	return nil
 }
@@ -1,6 +1,8 @@
 package mint_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -17,10 +19,13 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	testapp "github.com/ignite/modules/app"
 	"github.com/ignite/modules/cmd"
+	"github.com/ignite/modules/x/mint"
+	minttypes "github.com/ignite/modules/x/mint/types"
 )
 
 func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
@@ -60,6 +65,166 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	require.NotNil(t, acc)
 }
 
+func TestInitGenesisModuleOrder(t *testing.T) {
+	// moveBefore returns the order with the module moved before another module
+	moveBefore := func(order []string, module, before string) []string {
+		moved := make([]string, 0, len(order))
+		for _, name := range order {
+			switch name {
+			case module:
+			case before:
+				moved = append(moved, module, name)
+			default:
+				moved = append(moved, name)
+			}
+		}
+		return moved
+	}
+
+	for _, tc := range []struct {
+		name   string
+		before string
+		err    string
+	}{
+		{
+			name:   "should fail with the auth module initialized after mint",
+			before: authtypes.ModuleName,
+			err:    "the auth module is not initialized: invalid params",
+		},
+		{
+			name:   "should fail with the staking module initialized after mint",
+			before: stakingtypes.ModuleName,
+			err:    "the staking module is not initialized: no bond denom, order the staking InitGenesis before mint",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			chainID := "test-chain-id"
+			app := testapp.New(
+				log.NewNopLogger(),
+				dbm.NewMemDB(),
+				nil,
+				true,
+				map[int64]bool{},
+				testapp.DefaultNodeHome,
+				0,
+				cmd.MakeEncodingConfig(testapp.ModuleBasics),
+				simtestutil.EmptyAppOptions{},
+				baseapp.SetChainID(chainID),
+			)
+			cmdApp := app.(*testapp.App)
+			mm := cmdApp.ModuleManager()
+			mm.SetOrderInitGenesis(moveBefore(mm.OrderInitGenesis, minttypes.ModuleName, tc.before)...)
+			require.ErrorIs(t, mint.ValidateModuleOrder(mm.OrderInitGenesis, mm.OrderBeginBlockers), minttypes.ErrModuleOrder)
+
+			stateBytes, err := tmjson.Marshal(GenesisStateWithSingleValidator(t, cmdApp))
+			require.NoError(t, err)
+			var initErr error
+			func() {
+				defer func() {
+					initErr, _ = recover().(error)
+				}()
+				app.InitChain(abcitypes.RequestInitChain{
+					AppStateBytes: stateBytes,
+					ChainId:       chainID,
+				})
+			}()
+			require.ErrorIs(t, initErr, minttypes.ErrModuleOrder)
+			require.ErrorContains(t, initErr, tc.err)
+		})
+	}
+}
+
+func TestDefaultGenesisOptions(t *testing.T) {
+	encodingConfig := cmd.MakeEncodingConfig(testapp.ModuleBasics)
+	cdc := encodingConfig.Marshaler
+
+	params := minttypes.DefaultParams()
+	params.MintDenom = "foo"
+	params.DistributionProportions = minttypes.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(5, 1),
+		FundedAddresses: sdk.ZeroDec(),
+		CommunityPool:   sdk.NewDecWithPrec(5, 1),
+	}
+	minter := minttypes.InitialMinter(sdk.NewDecWithPrec(1, 1))
+
+	t.Run("should emit the overridden defaults in the default genesis", func(t *testing.T) {
+		basic := mint.NewAppModuleBasic(
+			mint.WithDefaultGenesisParams(params),
+			mint.WithDefaultGenesisMinter(minter),
+		)
+		bz := basic.DefaultGenesis(cdc)
+		require.NoError(t, basic.ValidateGenesis(cdc, nil, bz))
+
+		var genesis minttypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(bz, &genesis))
+		require.Equal(t, "foo", genesis.Params.MintDenom)
+		require.True(t, params.DistributionProportions.Staking.Equal(genesis.Params.DistributionProportions.Staking))
+		require.True(t, minter.Inflation.Equal(genesis.Minter.Inflation))
+	})
+
+	t.Run("should emit the module defaults without options", func(t *testing.T) {
+		basic := mint.NewAppModuleBasic()
+		bz := basic.DefaultGenesis(cdc)
+		require.NoError(t, basic.ValidateGenesis(cdc, nil, bz))
+
+		var genesis minttypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(bz, &genesis))
+		require.Equal(t, minttypes.DefaultMintDenom, genesis.Params.MintDenom)
+	})
+
+	t.Run("should validate the default genesis of the test app", func(t *testing.T) {
+		genesisState := testapp.ModuleBasics.DefaultGenesis(cdc)
+		require.NoError(t, testapp.ModuleBasics.ValidateGenesis(cdc, encodingConfig.TxConfig, genesisState))
+
+		var genesis minttypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(genesisState[minttypes.ModuleName], &genesis))
+		require.True(t, sdk.ZeroDec().Equal(genesis.Params.DistributionProportions.FundedAddresses))
+	})
+
+	t.Run("should emit the defaults of a profile in the default genesis", func(t *testing.T) {
+		basic := mint.NewAppModuleBasic(mint.WithProfile(minttypes.ProfileDevnet))
+		bz := basic.DefaultGenesis(cdc)
+		require.NoError(t, basic.ValidateGenesis(cdc, nil, bz))
+
+		var genesis minttypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(bz, &genesis))
+		devnet, err := minttypes.DefaultParamsForProfile(minttypes.ProfileDevnet)
+		require.NoError(t, err)
+		require.Equal(t, devnet.BlocksPerYear, genesis.Params.BlocksPerYear)
+
+		// the options applied after the profile override its values
+		basic = mint.NewAppModuleBasic(mint.WithProfile(minttypes.ProfileDevnet), mint.WithDefaultGenesisParams(params))
+		require.NoError(t, cdc.UnmarshalJSON(basic.DefaultGenesis(cdc), &genesis))
+		require.Equal(t, "foo", genesis.Params.MintDenom)
+		require.Equal(t, params.BlocksPerYear, genesis.Params.BlocksPerYear)
+
+		require.Panics(t, func() {
+			mint.WithProfile("foo")
+		})
+	})
+
+	t.Run("should validate a legacy genesis unless the genesis is strict", func(t *testing.T) {
+		bz, err := os.ReadFile(filepath.Join("types", "testdata", "legacy_genesis", "sdk_mint.json"))
+		require.NoError(t, err)
+		require.NoError(t, mint.NewAppModuleBasic().ValidateGenesis(cdc, nil, bz))
+		require.Error(t, mint.NewAppModuleBasic(mint.WithStrictGenesis()).ValidateGenesis(cdc, nil, bz))
+	})
+
+	t.Run("should prevent creating the module with invalid default genesis", func(t *testing.T) {
+		invalidParams := params
+		invalidParams.BlocksPerYear = 0
+		require.Panics(t, func() {
+			mint.NewAppModuleBasic(mint.WithDefaultGenesisParams(invalidParams))
+		})
+
+		invalidMinter := minter
+		invalidMinter.Inflation = sdk.NewDec(-1)
+		require.Panics(t, func() {
+			mint.NewAppModuleBasic(mint.WithDefaultGenesisMinter(invalidMinter))
+		})
+	})
+}
+
 // GenesisStateWithSingleValidator initializes GenesisState with a single validator and genesis accounts
 // that also act as delegators.
 func GenesisStateWithSingleValidator(t *testing.T, app *testapp.App) testapp.GenesisState {
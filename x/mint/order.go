@@ -0,0 +1,66 @@
+package mint
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// InitGenesisAfter returns the modules whose genesis must be initialized before the genesis of
+// the mint module: the auth module creating the module account, the bank module holding the
+// supply and the staking module holding the bond denom
+func (AppModule) InitGenesisAfter() []string {
+	return []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName}
+}
+
+// BeginBlockBefore returns the modules whose begin blocker must run after the begin blocker of
+// the mint module: the distribution module allocating the staking share minted in the block
+func (AppModule) BeginBlockBefore() []string {
+	return []string{distrtypes.ModuleName}
+}
+
+// ValidateModuleOrder checks the position of the mint module in the genesis initialization and
+// begin blocker orders of an app, typically after the orders are set in the app constructor. The
+// modules of the orders the mint module depends on must be ordered as recommended by
+// InitGenesisAfter and BeginBlockBefore, the modules missing from an order are ignored.
+func ValidateModuleOrder(initGenesis, beginBlockers []string) error {
+	var am AppModule
+	for _, module := range am.InitGenesisAfter() {
+		if orderedAfter(initGenesis, module, types.ModuleName) {
+			return errors.Wrapf(
+				types.ErrModuleOrder,
+				"the %s InitGenesis is ordered after %s, order it before",
+				module, types.ModuleName,
+			)
+		}
+	}
+	for _, module := range am.BeginBlockBefore() {
+		if orderedAfter(beginBlockers, types.ModuleName, module) {
+			return errors.Wrapf(
+				types.ErrModuleOrder,
+				"the %s BeginBlocker is ordered before %s, order it after",
+				module, types.ModuleName,
+			)
+		}
+	}
+	return nil
+}
+
+// orderedAfter returns true if both modules are in the order and the first module is ordered
+// after the second
+func orderedAfter(order []string, first, second string) bool {
+	firstIndex, secondIndex := -1, -1
+	for i, module := range order {
+		switch module {
+		case first:
+			firstIndex = i
+		case second:
+			secondIndex = i
+		}
+	}
+	return firstIndex != -1 && secondIndex != -1 && firstIndex > secondIndex
+}
@@ -0,0 +1,57 @@
+package mint_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint"
+	minttypes "github.com/ignite/modules/x/mint/types"
+)
+
+func TestValidateModuleOrder(t *testing.T) {
+	tests := []struct {
+		name          string
+		initGenesis   []string
+		beginBlockers []string
+		err           string
+	}{
+		{
+			name:          "should accept the recommended order",
+			initGenesis:   []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName},
+			beginBlockers: []string{minttypes.ModuleName, distrtypes.ModuleName},
+		},
+		{
+			name:          "should ignore the modules missing from the orders",
+			initGenesis:   []string{authtypes.ModuleName, minttypes.ModuleName},
+			beginBlockers: []string{distrtypes.ModuleName},
+		},
+		{
+			name:          "should prevent initializing the genesis of a dependency after mint",
+			initGenesis:   []string{authtypes.ModuleName, banktypes.ModuleName, minttypes.ModuleName, stakingtypes.ModuleName},
+			beginBlockers: []string{minttypes.ModuleName, distrtypes.ModuleName},
+			err:           "the staking InitGenesis is ordered after mint, order it before",
+		},
+		{
+			name:          "should prevent the distribution begin blocker before mint",
+			initGenesis:   []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName},
+			beginBlockers: []string{distrtypes.ModuleName, minttypes.ModuleName},
+			err:           "the distribution BeginBlocker is ordered before mint, order it after",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mint.ValidateModuleOrder(tc.initGenesis, tc.beginBlockers)
+			if tc.err != "" {
+				require.ErrorIs(t, err, minttypes.ErrModuleOrder)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
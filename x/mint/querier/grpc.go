@@ -0,0 +1,709 @@
+package querier
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// iterationContext returns the context of a query iterating over the store, bound to the request
+// context so the iteration stops once the request is cancelled
+func iterationContext(c context.Context) sdk.Context {
+	return sdk.UnwrapSDKContext(c).WithContext(c)
+}
+
+// boundedPageRequest returns the page request with a limit of at most maxLimit items, a request
+// without limit or above the maximum gets the maximum and continues with the next key
+func boundedPageRequest(pagination *query.PageRequest, maxLimit uint64) *query.PageRequest {
+	if pagination == nil {
+		return &query.PageRequest{Limit: maxLimit}
+	}
+	if pagination.Limit == 0 || pagination.Limit > maxLimit {
+		bounded := *pagination
+		bounded.Limit = maxLimit
+		return &bounded
+	}
+	return pagination
+}
+
+// Params returns params of the mint module, with the values derived from them at the current
+// height if verbose.
+func (q Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.keeper.GetParams(ctx)
+
+	res := &types.QueryParamsResponse{Params: params}
+	if change, found := q.keeper.GetLastParamsChange(ctx); found {
+		res.LastChange = &change
+	}
+	if phase, active := params.ActivePhase(ctx.BlockHeight()); active {
+		res.ActivePhase = &phase
+	}
+	if req != nil && req.Verbose {
+		derived := types.NewDerivedParams(
+			q.keeper.ReadMinter(ctx),
+			params,
+			ctx.BlockHeight(),
+			q.keeper.GetSupply(ctx, params.MintDenom).Amount,
+			q.keeper.BondedRatio(ctx),
+		)
+		res.Derived = &derived
+	}
+	return res, nil
+}
+
+// Inflation returns minter.Inflation of the mint module.
+func (q Querier) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := q.keeper.ReadMinter(ctx)
+
+	return &types.QueryInflationResponse{Inflation: minter.Inflation}, nil
+}
+
+// AnnualProvisions returns minter.AnnualProvisions of the mint module.
+func (q Querier) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := q.keeper.ReadMinter(ctx)
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
+}
+
+// Minter returns the minter of the mint module.
+func (q Querier) Minter(c context.Context, _ *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := q.keeper.ReadMinter(ctx)
+
+	return &types.QueryMinterResponse{Minter: minter}, nil
+}
+
+// AdminCapabilities returns the messages executable by the authority of the mint module
+// and whether they are currently available.
+func (q Querier) AdminCapabilities(c context.Context, _ *types.QueryAdminCapabilitiesRequest) (*types.QueryAdminCapabilitiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.keeper.GetParams(ctx)
+	minter := q.keeper.ReadMinter(ctx)
+	authority := q.keeper.GetAuthority()
+	descriptors, err := params.Describe()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	capability := func(msg sdk.Msg, unavailableReason string) types.AdminCapability {
+		return types.AdminCapability{
+			TypeUrl:           sdk.MsgTypeURL(msg),
+			Authority:         authority,
+			Available:         unavailableReason == "",
+			UnavailableReason: unavailableReason,
+		}
+	}
+
+	// the restrictions of MsgUpdateParams and MsgSetPaused bind the proposed params, not the
+	// state, they are reported by the validation of the proposed params
+	var goalBondedReason, reserveReason, resumeReason, fundedAddressReason string
+	if phase, active := params.ActivePhase(ctx.BlockHeight()); active {
+		goalBondedReason = fmt.Sprintf("the active phase %s sets the goal bonded", phase.Name)
+	}
+	if minter.StrategicReserve.IsZero() {
+		reserveReason = "the strategic reserve is empty"
+	}
+	if !minter.AutoPaused {
+		resumeReason = "minting is not auto-paused"
+	}
+	if len(params.FundedAddresses) == 0 {
+		fundedAddressReason = "no funded address"
+	}
+
+	return &types.QueryAdminCapabilitiesResponse{
+		Authorities: []string{authority},
+		Capabilities: []types.AdminCapability{
+			capability(&types.MsgSetPaused{}, ""),
+			capability(&types.MsgUpdateParams{}, ""),
+			capability(&types.MsgSetGoalBonded{}, goalBondedReason),
+			capability(&types.MsgReleaseReserve{}, reserveReason),
+			capability(&types.MsgResumeMinting{}, resumeReason),
+			capability(&types.MsgAddFundedAddress{}, ""),
+			capability(&types.MsgRemoveFundedAddress{}, fundedAddressReason),
+			capability(&types.MsgSetFundedAddressWeight{}, fundedAddressReason),
+			capability(&types.MsgSetMinCommunityPoolShare{}, ""),
+		},
+		PauseState:       types.NewPauseState(params),
+		ParamDescriptors: descriptors,
+	}, nil
+}
+
+// Status returns the pause state of the mint module and the consistency of the supplies the
+// provisions are computed against.
+func (q Querier) Status(c context.Context, _ *types.QueryStatusRequest) (*types.QueryStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.keeper.GetParams(ctx)
+
+	res := &types.QueryStatusResponse{
+		PauseState:       types.NewPauseState(params),
+		DenomConsistency: q.keeper.GetDenomConsistency(ctx, params.MintDenom, q.keeper.StakingTokenSupply(ctx)),
+	}
+	if change, found := q.keeper.GetLastParamsChange(ctx); found {
+		res.LastParamsChange = &change
+	}
+	if phase, active := params.ActivePhase(ctx.BlockHeight()); active {
+		res.ActivePhase = &phase
+	}
+	res.InitializedAtHeight, _ = q.keeper.GetInitializedHeight(ctx)
+	return res, nil
+}
+
+// FundedAddressHistory returns the recorded weight changes of a funded address.
+func (q Querier) FundedAddressHistory(
+	c context.Context,
+	req *types.QueryFundedAddressHistoryRequest,
+) (*types.QueryFundedAddressHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := iterationContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	changes, pageRes, err := q.keeper.GetFundedAddressHistory(ctx, addr.String(), boundedPageRequest(req.Pagination, types.FundedAddressHistoryMaxLimit))
+	if err != nil {
+		return nil, errors.IterationError(err)
+	}
+
+	return &types.QueryFundedAddressHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// ParamsImpact returns the emissions of the year of blocks following the current block under the
+// current params and under the proposed params with the current bonded ratio, projected with the
+// mint schedule of the begin blocker.
+func (q Querier) ParamsImpact(c context.Context, req *types.QueryParamsImpactRequest) (*types.QueryParamsImpactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	// proposals are rejected with the errors of the msg server
+	if err := req.ProposedParams.Validate(); err != nil {
+		return nil, types.InvalidParamsError(err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	minter := q.keeper.ReadMinter(ctx)
+	bondedRatio := q.keeper.BondedRatio(ctx)
+	params := q.keeper.GetParams(ctx)
+	startHeight := ctx.BlockHeight() + 1
+	project := func(params types.Params) types.EmissionProjection {
+		stakingSupply, _ := q.keeper.EffectiveStakingSupply(ctx, params, q.keeper.StakingTokenSupply(ctx))
+		supply := q.keeper.GetSupply(ctx, params.MintDenom).Amount
+		return types.ProjectEmissions(minter, params, startHeight, bondedRatio, stakingSupply, supply)
+	}
+	current := project(params)
+	proposed := project(req.ProposedParams)
+
+	return &types.QueryParamsImpactResponse{
+		Current:  current,
+		Proposed: proposed,
+		Delta:    proposed.Total.Sub(current.Total),
+		CategoryDeltas: types.CategoryTotals{
+			Staking:          proposed.Categories.Staking.Sub(current.Categories.Staking),
+			FundedAddresses:  proposed.Categories.FundedAddresses.Sub(current.Categories.FundedAddresses),
+			CommunityPool:    proposed.Categories.CommunityPool.Sub(current.Categories.CommunityPool),
+			Dust:             proposed.Categories.Dust.Sub(current.Categories.Dust),
+			StrategicReserve: proposed.Categories.StrategicReserve.Sub(current.Categories.StrategicReserve),
+		},
+	}, nil
+}
+
+// ValidateParams validates the params proposed to replace the params of the mint module and
+// returns the values derived from them once applied to the current minter.
+func (q Querier) ValidateParams(c context.Context, req *types.QueryValidateParamsRequest) (*types.QueryValidateParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fieldErrors := req.Params.FieldErrors()
+	if len(fieldErrors) > 0 {
+		return &types.QueryValidateParamsResponse{Errors: fieldErrors}, nil
+	}
+	effective := types.NewEffectiveParams(q.keeper.ReadMinter(ctx), req.Params, q.keeper.BondedRatio(ctx))
+
+	return &types.QueryValidateParamsResponse{
+		Valid:     true,
+		Effective: &effective,
+	}, nil
+}
+
+// DelegatorAPR returns the estimated annual rate of the staking rewards minted for the delegators
+// of a validator, net of the community tax and the commission of the validator.
+func (q Querier) DelegatorAPR(c context.Context, req *types.QueryDelegatorAPRRequest) (*types.QueryDelegatorAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	validator, found := q.keeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, errors.Wrap(types.ErrValidatorNotFound, req.ValidatorAddress)
+	}
+	bondedTokens := q.keeper.TotalBondedTokens(ctx)
+	if !bondedTokens.IsPositive() {
+		return nil, types.ErrNoBondedTokens
+	}
+
+	stakingAPR := types.StakingAPR(q.keeper.ReadMinter(ctx), q.keeper.GetParams(ctx), bondedTokens)
+	communityTax := q.keeper.GetCommunityTax(ctx)
+	commissionRate := validator.Commission.Rate
+
+	return &types.QueryDelegatorAPRResponse{
+		StakingApr:     stakingAPR,
+		CommunityTax:   communityTax,
+		CommissionRate: commissionRate,
+		DelegatorApr:   types.NetAPR(stakingAPR, communityTax, commissionRate),
+		BondedTokens:   bondedTokens,
+	}, nil
+}
+
+// ModuleAccount returns the balance of the module account broken down by ledger entry.
+func (q Querier) ModuleAccount(c context.Context, req *types.QueryModuleAccountRequest) (*types.QueryModuleAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleAccountResponse{
+		Address:       q.keeper.ModuleAddress().String(),
+		Balance:       q.keeper.ModuleAccountBalance(ctx),
+		Entries:       q.keeper.Ledger(ctx),
+		TotalBuffered: q.keeper.TotalBuffered(ctx),
+	}, nil
+}
+
+// EmissionDrift returns the drift of the realized emissions from the target emissions.
+func (q Querier) EmissionDrift(c context.Context, req *types.QueryEmissionDriftRequest) (*types.QueryEmissionDriftResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := q.keeper.ReadMinter(ctx)
+	drift, relativeDrift := minter.EmissionDrift()
+
+	return &types.QueryEmissionDriftResponse{
+		TargetEmission:   minter.TargetCumulativeEmission,
+		RealizedEmission: minter.CumulativeMinted,
+		Drift:            drift,
+		RelativeDrift:    relativeDrift,
+		CarryBuffer:      minter.CarryBuffer,
+	}, nil
+}
+
+// FeeAdvisory returns an advisory minimum gas price for the fees of a block to cover a target
+// ratio of the staking share of the block provision, the price is not used by consensus.
+func (q Querier) FeeAdvisory(c context.Context, req *types.QueryFeeAdvisoryRequest) (*types.QueryFeeAdvisoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := q.keeper.ReadMinter(ctx)
+	params := q.keeper.GetParams(ctx)
+
+	price, err := types.AdvisoryMinGasPrice(minter, params, req.TargetFeeCoverageRatio, req.AverageBlockGas)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	stakingProvision := types.StakingBlockProvision(minter, params)
+
+	return &types.QueryFeeAdvisoryResponse{
+		AdvisoryMinGasPrice:   price,
+		StakingBlockProvision: stakingProvision,
+		TargetBlockFees:       stakingProvision.Mul(req.TargetFeeCoverageRatio),
+	}, nil
+}
+
+// DistributionHistory returns the recorded allocations of the coins minted in each block.
+func (q Querier) DistributionHistory(
+	c context.Context,
+	req *types.QueryDistributionHistoryRequest,
+) (*types.QueryDistributionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+	if req.ToHeight > 0 && req.ToHeight < req.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "to height %d is lower than from height %d", req.ToHeight, req.FromHeight)
+	}
+	ctx := iterationContext(c)
+
+	distributions, pageRes, err := q.keeper.GetBlockDistributions(
+		ctx,
+		req.FromHeight,
+		req.ToHeight,
+		boundedPageRequest(req.Pagination, types.DistributionHistoryMaxLimit),
+	)
+	if err != nil {
+		return nil, errors.IterationError(err)
+	}
+
+	return &types.QueryDistributionHistoryResponse{Distributions: distributions, Pagination: pageRes}, nil
+}
+
+// InflationHistory returns the snapshots of the inflation recorded every
+// inflation_snapshot_interval blocks.
+func (q Querier) InflationHistory(
+	c context.Context,
+	req *types.QueryInflationHistoryRequest,
+) (*types.QueryInflationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+	if req.ToHeight > 0 && req.ToHeight < req.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "to height %d is lower than from height %d", req.ToHeight, req.FromHeight)
+	}
+	ctx := iterationContext(c)
+
+	snapshots, pageRes, err := q.keeper.GetInflationSnapshots(
+		ctx,
+		req.FromHeight,
+		req.ToHeight,
+		boundedPageRequest(req.Pagination, types.InflationHistoryMaxLimit),
+	)
+	if err != nil {
+		return nil, errors.IterationError(err)
+	}
+
+	return &types.QueryInflationHistoryResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// AverageInflation returns the time-weighted mean of the inflation rates of the recorded blocks
+// over a period and the fraction of the period covered by the records.
+func (q Querier) AverageInflation(
+	c context.Context,
+	req *types.QueryAverageInflationRequest,
+) (*types.QueryAverageInflationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !req.ToTime.After(req.FromTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "to time %s must be after from time %s", req.ToTime, req.FromTime)
+	}
+	ctx := iterationContext(c)
+
+	average, coveredFraction, err := q.keeper.AverageInflationBetween(ctx, req.FromTime, req.ToTime)
+	if err != nil {
+		return nil, errors.IterationError(err)
+	}
+
+	return &types.QueryAverageInflationResponse{
+		AverageInflation: average,
+		CoveredFraction:  coveredFraction,
+	}, nil
+}
+
+// EmissionReport returns the emissions of a range of heights assembled from the recorded
+// allocations of the minted coins, the hash of the canonical encoding of the report and the
+// context to verify the records with store proofs.
+func (q Querier) EmissionReport(
+	c context.Context,
+	req *types.QueryEmissionReportRequest,
+) (*types.QueryEmissionReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := iterationContext(c)
+	if err := types.ValidateEmissionReportRange(req.FromHeight, req.ToHeight, ctx.BlockHeight()); err != nil {
+		return nil, err
+	}
+
+	report, err := q.keeper.GenerateEmissionReport(ctx, req.FromHeight, req.ToHeight)
+	if err != nil {
+		return nil, errors.IterationError(err)
+	}
+	hash, err := report.Hash()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEmissionReportResponse{
+		Report: report,
+		Hash:   hash,
+		ProofContext: types.EmissionReportProofContext{
+			Height:    ctx.BlockHeight(),
+			StoreName: types.StoreKey,
+			KeyPrefix: types.DistributionHistoryKeyPrefix,
+		},
+	}, nil
+}
+
+// EstimatedDistribution returns the distribution of the coins minted by the next block, minted
+// and distributed on a discarded branch of the state like the next block.
+func (q Querier) EstimatedDistribution(
+	c context.Context,
+	req *types.QueryEstimatedDistributionRequest,
+) (*types.QueryEstimatedDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.keeper.EstimateDistribution(sdk.UnwrapSDKContext(c))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &res, nil
+}
+
+// AddressMintIncome returns the shares of the minted coins distributed to a funded address in a
+// range of heights from the recorded allocations, and the income of the address since it is
+// tracked.
+func (q Querier) AddressMintIncome(
+	c context.Context,
+	req *types.QueryAddressMintIncomeRequest,
+) (*types.QueryAddressMintIncomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	ctx := iterationContext(c)
+
+	amount, recordedBlocks, err := q.keeper.GetAddressMintIncome(ctx, req.Address, req.FromHeight, req.ToHeight)
+	switch {
+	case errors.IsOf(err, types.ErrInvalidHeightRange, types.ErrInsufficientHistory):
+		return nil, err
+	case err != nil:
+		return nil, errors.IterationError(err)
+	}
+
+	return &types.QueryAddressMintIncomeResponse{
+		Amount:         amount,
+		RecordedBlocks: recordedBlocks,
+		Lifetime:       q.keeper.GetFundedAddressIncome(ctx, req.Address),
+	}, nil
+}
+
+// TotalBurned returns the total amount of each denom burned with MsgBurn
+func (q Querier) TotalBurned(
+	c context.Context,
+	req *types.QueryTotalBurnedRequest,
+) (*types.QueryTotalBurnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalBurnedResponse{TotalBurned: q.keeper.GetTotalBurned(ctx)}, nil
+}
+
+// TotalMinted returns the total amount of each denom minted by the module
+func (q Querier) TotalMinted(
+	c context.Context,
+	req *types.QueryTotalMintedRequest,
+) (*types.QueryTotalMintedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalMintedResponse{TotalMinted: q.keeper.GetTotalMinted(ctx)}, nil
+}
+
+// StrategicReserve returns the balance of the strategic reserve locked in the module account, the
+// cumulative share of the minted coins accrued in the reserve and the coins released from it
+func (q Querier) StrategicReserve(
+	c context.Context,
+	req *types.QueryStrategicReserveRequest,
+) (*types.QueryStrategicReserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := q.keeper.ReadMinter(ctx)
+
+	return &types.QueryStrategicReserveResponse{
+		Balance:  minter.StrategicReserve,
+		Accrued:  minter.CumulativeDistributed.StrategicReserve,
+		Released: minter.StrategicReserveReleased,
+	}, nil
+}
+
+// UpcomingProvisions returns the provisions of the next blocks of the mint denom simulated from
+// the minter, the current bonded ratio and the schedule of the params. The simulation doesn't
+// write to the store.
+func (q Querier) UpcomingProvisions(
+	c context.Context,
+	req *types.QueryUpcomingProvisionsRequest,
+) (*types.QueryUpcomingProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Count == 0 || req.Count > types.MaxUpcomingProvisions {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", types.MaxUpcomingProvisions)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := q.keeper.GetParams(ctx)
+	stakingSupply, _ := q.keeper.EffectiveStakingSupply(ctx, params, q.keeper.StakingTokenSupply(ctx))
+	supply := q.keeper.GetSupply(ctx, params.MintDenom).Amount
+	startHeight := ctx.BlockHeight() + 1
+	provisions, firstClampedHeight := types.UpcomingProvisions(
+		q.keeper.ReadMinter(ctx),
+		params,
+		startHeight,
+		req.Count,
+		q.keeper.BondedRatio(ctx),
+		stakingSupply,
+		supply,
+	)
+
+	return &types.QueryUpcomingProvisionsResponse{
+		StartHeight:        startHeight,
+		Denom:              params.MintDenom,
+		Provisions:         provisions,
+		FirstClampedHeight: firstClampedHeight,
+	}, nil
+}
+
+// AnnualFundedProvisions returns the coins projected to be distributed to the funded addresses over
+// a year at the current annual provisions: the annual provisions truncated to the mint denom, its
+// funded addresses proportion and the weight of each address, truncated with GetProportion as in
+// DistributeMintedCoin. The projection doesn't account for the funding windows, the pauses, the
+// max supply and the bootstrap override.
+func (q Querier) AnnualFundedProvisions(
+	c context.Context,
+	req *types.QueryAnnualFundedProvisionsRequest,
+) (*types.QueryAnnualFundedProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var address string
+	if req.Address != "" {
+		normalized, err := types.NormalizeAddress(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+		}
+		address = normalized
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := q.keeper.GetParams(ctx)
+	annualProvisions := sdk.NewCoin(params.MintDenom, q.keeper.ReadMinter(ctx).AnnualProvisions.TruncateInt())
+	funded := q.keeper.GetProportion(ctx, annualProvisions, params.DistributionProportions.FundedAddresses)
+	res := &types.QueryAnnualFundedProvisionsResponse{
+		AnnualProvisions: []types.FundedAddressAllocation{},
+	}
+	for _, w := range q.keeper.StakeWeightedAddresses(ctx, params.FundedAddresses) {
+		if address != "" && w.Address != address {
+			continue
+		}
+		res.AnnualProvisions = append(res.AnnualProvisions, types.FundedAddressAllocation{
+			Address: w.Address,
+			Amount:  q.keeper.GetProportion(ctx, funded, w.Weight),
+		})
+	}
+	if address != "" && len(res.AnnualProvisions) == 0 {
+		return nil, errors.Wrap(types.ErrFundedAddressNotFound, address)
+	}
+	return res, nil
+}
+
+// ModuleVersion returns the consensus version of the module, the behavior revision of the version
+// and the migrations completed on the chain
+func (q Querier) ModuleVersion(
+	c context.Context,
+	req *types.QueryModuleVersionRequest,
+) (*types.QueryModuleVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleVersionResponse{ModuleVersion: q.keeper.GetModuleVersion(ctx)}, nil
+}
+
+// StateProof returns the values of the store keys holding a part of the mint state at a height
+// with their commitment proofs against the app hash of the height, it requires a state prover
+func (q Querier) StateProof(
+	c context.Context,
+	req *types.QueryStateProofRequest,
+) (*types.QueryStateProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if q.keeper.StateProver() == nil {
+		return nil, status.Error(codes.Unimplemented, "the state proofs are not served by the node")
+	}
+	keys, err := types.StateKeys(req.KeyName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	if height < 0 || height > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d out of range, the current height is %d", height, ctx.BlockHeight())
+	}
+	proofs := make([]types.StateProof, 0, len(keys))
+	for _, key := range keys {
+		proof, err := q.keeper.StateProver().ProveState(key.StoreName, key.Key, height)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "the state of height %d can't be proven: %s", height, err)
+		}
+		proofs = append(proofs, proof)
+	}
+	return &types.QueryStateProofResponse{Proofs: proofs}, nil
+}
+
+// MintDenom returns the mint_denom param.
+func (q Querier) MintDenom(c context.Context, _ *types.QueryMintDenomRequest) (*types.QueryMintDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMintDenomResponse{MintDenom: q.keeper.GetParams(ctx).MintDenom}, nil
+}
+
+// DistributionProportions returns the distribution_proportions param.
+func (q Querier) DistributionProportions(
+	c context.Context,
+	_ *types.QueryDistributionProportionsRequest,
+) (*types.QueryDistributionProportionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDistributionProportionsResponse{
+		DistributionProportions: q.keeper.GetParams(ctx).DistributionProportions,
+	}, nil
+}
+
+// FundedAddresses returns the page of the funded_addresses param, in the order of the params.
+func (q Querier) FundedAddresses(
+	c context.Context,
+	req *types.QueryFundedAddressesRequest,
+) (*types.QueryFundedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addresses, pageRes, err := types.PaginateFundedAddresses(
+		q.keeper.GetParams(ctx).FundedAddresses,
+		boundedPageRequest(req.Pagination, types.FundedAddressesMaxLimit),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryFundedAddressesResponse{FundedAddresses: addresses, Pagination: pageRes}, nil
+}
@@ -0,0 +1,79 @@
+package querier
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// MintKeeper defines the reads of the mint keeper the query server is built on. It has no method
+// writing the state of the context, so a query handler can't change the state and diverge the app
+// hash of the querying nodes. EstimateDistribution is the one method executing writes, it runs the
+// minting of the next block on a branch of the state that is discarded.
+type MintKeeper interface {
+	GetAuthority() string
+	GetParams(ctx sdk.Context) types.Params
+	GetInitializedHeight(ctx sdk.Context) (int64, bool)
+	GetLastParamsChange(ctx sdk.Context) (types.ParamsChange, bool)
+	// ReadMinter gets the minter without checking its counters, the check emits events and may
+	// heal the minter
+	ReadMinter(ctx sdk.Context) types.Minter
+	GetFundedAddressHistory(
+		ctx sdk.Context,
+		address string,
+		pagination *query.PageRequest,
+	) ([]types.FundedAddressWeightChange, *query.PageResponse, error)
+	GetBlockDistributions(
+		ctx sdk.Context,
+		fromHeight, toHeight int64,
+		pagination *query.PageRequest,
+	) ([]types.BlockDistribution, *query.PageResponse, error)
+	GetInflationSnapshots(
+		ctx sdk.Context,
+		fromHeight, toHeight int64,
+		pagination *query.PageRequest,
+	) ([]types.InflationSnapshot, *query.PageResponse, error)
+	AverageInflationBetween(ctx sdk.Context, from, to time.Time) (sdk.Dec, sdk.Dec, error)
+	GenerateEmissionReport(ctx sdk.Context, fromHeight, toHeight int64) (types.EmissionReport, error)
+	// EstimateDistribution mints and distributes the next block on a discarded branch of the state,
+	// the gas of its writes is consumed from the gas meter of the query
+	EstimateDistribution(ctx sdk.Context) (types.QueryEstimatedDistributionResponse, error)
+	GetFundedAddressIncome(ctx sdk.Context, address string) types.FundedAddressIncome
+	GetAddressMintIncome(ctx sdk.Context, address string, fromHeight, toHeight int64) (sdk.Coins, uint64, error)
+	GetTotalBurned(ctx sdk.Context) sdk.Coins
+	GetTotalMinted(ctx sdk.Context) sdk.Coins
+	GetModuleVersion(ctx sdk.Context) types.ModuleVersion
+	GetDenomConsistency(ctx sdk.Context, mintDenom string, stakingSupply sdkmath.Int) types.DenomConsistency
+	GetProportion(ctx sdk.Context, mintedCoin sdk.Coin, ratio sdk.Dec) sdk.Coin
+	StakeWeightedAddresses(ctx sdk.Context, fundedAddresses []types.WeightedAddress) []types.WeightedAddress
+	Ledger(ctx sdk.Context) []types.LedgerEntry
+	TotalBuffered(ctx sdk.Context) sdk.Coins
+	ModuleAddress() sdk.AccAddress
+	ModuleAccountBalance(ctx sdk.Context) sdk.Coins
+	StakingTokenSupply(ctx sdk.Context) sdkmath.Int
+	EffectiveStakingSupply(ctx sdk.Context, params types.Params, stakingSupply sdkmath.Int) (sdkmath.Int, string)
+	BondedRatio(ctx sdk.Context) sdk.Dec
+	TotalBondedTokens(ctx sdk.Context) sdkmath.Int
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	GetCommunityTax(ctx sdk.Context) sdk.Dec
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	// StateProver returns the prover of the StateProof query, nil if the node doesn't serve the proofs
+	StateProver() types.StateProver
+}
+
+// Querier serves the gRPC query service of the mint module from the reads of the keeper
+type Querier struct {
+	keeper MintKeeper
+}
+
+// NewQuerier returns the query server of the mint module
+func NewQuerier(k MintKeeper) Querier {
+	return Querier{keeper: k}
+}
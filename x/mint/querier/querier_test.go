@@ -0,0 +1,43 @@
+package querier_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/keeper"
+	"github.com/ignite/modules/x/mint/querier"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestQuerier(t *testing.T) {
+	t.Run("should serve the query service", func(t *testing.T) {
+		var q interface{} = querier.Querier{}
+		_, ok := q.(types.QueryServer)
+		require.True(t, ok)
+	})
+
+	t.Run("should not serve the query service from the keeper", func(t *testing.T) {
+		var k interface{} = keeper.Keeper{}
+		_, ok := k.(types.QueryServer)
+		require.False(t, ok)
+	})
+
+	t.Run("should be built on the keeper", func(t *testing.T) {
+		var k interface{} = keeper.Keeper{}
+		_, ok := k.(querier.MintKeeper)
+		require.True(t, ok)
+	})
+
+	t.Run("should not require the write methods of the keeper", func(t *testing.T) {
+		mintKeeper := reflect.TypeOf((*querier.MintKeeper)(nil)).Elem()
+		for _, prefix := range []string{"Set", "Append", "MintCoin", "Distribute", "Refresh", "Claim", "Burn", "BeginBlocker", "DryRun"} {
+			for i := 0; i < mintKeeper.NumMethod(); i++ {
+				name := mintKeeper.Method(i).Name
+				require.False(t, strings.HasPrefix(name, prefix), "write method %s", name)
+			}
+		}
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/ignite/modules/x/mint/types"
@@ -20,6 +22,59 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key, types.SummaryKey):
+			var summaryA, summaryB types.Summary
+			cdc.MustUnmarshal(kvA.Value, &summaryA)
+			cdc.MustUnmarshal(kvB.Value, &summaryB)
+			return fmt.Sprintf("%v\n%v", summaryA, summaryB)
+		case bytes.Equal(kvA.Key, types.ParamsChangeKey):
+			var changeA, changeB types.ParamsChange
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+		case bytes.Equal(kvA.Key, types.SchemaVersionKey), bytes.Equal(kvA.Key, types.LastMaintenanceHeightKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key, types.ModuleVersionKey):
+			var versionA, versionB types.ModuleVersion
+			cdc.MustUnmarshal(kvA.Value, &versionA)
+			cdc.MustUnmarshal(kvB.Value, &versionB)
+			return fmt.Sprintf("%v\n%v", versionA, versionB)
+		case bytes.Equal(kvA.Key, types.DustAccumulatorKey):
+			var accumulatorA, accumulatorB sdk.Coin
+			cdc.MustUnmarshal(kvA.Value, &accumulatorA)
+			cdc.MustUnmarshal(kvB.Value, &accumulatorB)
+			return fmt.Sprintf("%v\n%v", accumulatorA, accumulatorB)
+		case bytes.Equal(kvA.Key, types.FeeCollectorNameKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.HasPrefix(kvA.Key, types.FundedAddressHistoryKeyPrefix):
+			var changeA, changeB types.FundedAddressWeightChange
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+		case bytes.HasPrefix(kvA.Key, types.DistributionHistoryKeyPrefix):
+			var distributionA, distributionB types.BlockDistribution
+			cdc.MustUnmarshal(kvA.Value, &distributionA)
+			cdc.MustUnmarshal(kvB.Value, &distributionB)
+			return fmt.Sprintf("%v\n%v", distributionA, distributionB)
+		case bytes.HasPrefix(kvA.Key, types.FundedAddressIncomeKeyPrefix):
+			var incomeA, incomeB types.FundedAddressIncome
+			cdc.MustUnmarshal(kvA.Value, &incomeA)
+			cdc.MustUnmarshal(kvB.Value, &incomeB)
+			return fmt.Sprintf("%v\n%v", incomeA, incomeB)
+		case bytes.HasPrefix(kvA.Key, types.TotalBurnedKeyPrefix), bytes.HasPrefix(kvA.Key, types.TotalMintedKeyPrefix):
+			var totalA, totalB sdkmath.Int
+			if err := totalA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := totalB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", totalA, totalB)
+		case bytes.HasPrefix(kvA.Key, types.InflationSnapshotKeyPrefix):
+			var snapshotA, snapshotB types.InflationSnapshot
+			cdc.MustUnmarshal(kvA.Value, &snapshotA)
+			cdc.MustUnmarshal(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/stretchr/testify/require"
@@ -19,10 +20,50 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc.Marshaler)
 
 	minter := types.NewMinter(sdk.OneDec(), sdk.NewDec(15))
+	summary := types.NewSummary(minter, types.DefaultParams())
+	change := types.ParamsChange{Height: 10, Authority: "authority"}
+	weightChange := types.FundedAddressWeightChange{
+		Address:   "addr",
+		Height:    10,
+		OldWeight: sdk.ZeroDec(),
+		NewWeight: sdk.OneDec(),
+	}
+	distribution := types.BlockDistribution{
+		Height:      10,
+		Minted:      sdk.NewInt(100),
+		Distributed: types.NewCategoryTotals(),
+		Inflation:   sdk.OneDec(),
+	}
+	income := types.FundedAddressIncome{Address: "addr", Total: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	burned := sdkmath.NewInt(42)
+	burnedBz, err := burned.Marshal()
+	require.NoError(t, err)
+	snapshot := types.InflationSnapshot{
+		Height:           10,
+		Inflation:        sdk.OneDec(),
+		AnnualProvisions: sdk.NewDec(15),
+		BondedRatio:      sdk.NewDecWithPrec(67, 2),
+	}
+
+	moduleVersion := types.NewModuleVersion(1).AddMigration(1, 10)
+	accumulator := sdk.NewInt64Coin("stake", 2)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.Marshaler.MustMarshal(&minter)},
+			{Key: types.SummaryKey, Value: cdc.Marshaler.MustMarshal(&summary)},
+			{Key: types.ParamsChangeKey, Value: cdc.Marshaler.MustMarshal(&change)},
+			{Key: types.SchemaVersionKey, Value: sdk.Uint64ToBigEndian(types.SchemaVersion)},
+			{Key: types.FeeCollectorNameKey, Value: []byte("fee_collector")},
+			{Key: types.FundedAddressHistoryKey("addr", 1), Value: cdc.Marshaler.MustMarshal(&weightChange)},
+			{Key: types.DistributionHistoryKey(10), Value: cdc.Marshaler.MustMarshal(&distribution)},
+			{Key: types.FundedAddressIncomeKey("addr"), Value: cdc.Marshaler.MustMarshal(&income)},
+			{Key: types.TotalBurnedKey("stake"), Value: burnedBz},
+			{Key: types.InflationSnapshotKey(10), Value: cdc.Marshaler.MustMarshal(&snapshot)},
+			{Key: types.TotalMintedKey("stake"), Value: burnedBz},
+			{Key: types.ModuleVersionKey, Value: cdc.Marshaler.MustMarshal(&moduleVersion)},
+			{Key: types.DustAccumulatorKey, Value: cdc.Marshaler.MustMarshal(&accumulator)},
+			{Key: types.LastMaintenanceHeightKey, Value: sdk.Uint64ToBigEndian(42)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +72,19 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"Summary", fmt.Sprintf("%v\n%v", summary, summary)},
+		{"ParamsChange", fmt.Sprintf("%v\n%v", change, change)},
+		{"SchemaVersion", fmt.Sprintf("%d\n%d", types.SchemaVersion, types.SchemaVersion)},
+		{"FeeCollectorName", "fee_collector\nfee_collector"},
+		{"FundedAddressHistory", fmt.Sprintf("%v\n%v", weightChange, weightChange)},
+		{"DistributionHistory", fmt.Sprintf("%v\n%v", distribution, distribution)},
+		{"FundedAddressIncome", fmt.Sprintf("%v\n%v", income, income)},
+		{"TotalBurned", "42\n42"},
+		{"InflationSnapshot", fmt.Sprintf("%v\n%v", snapshot, snapshot)},
+		{"TotalMinted", "42\n42"},
+		{"ModuleVersion", fmt.Sprintf("%v\n%v", moduleVersion, moduleVersion)},
+		{"DustAccumulator", fmt.Sprintf("%v\n%v", accumulator, accumulator)},
+		{"LastMaintenanceHeight", "42\n42"},
 		{"other", ""},
 	}
 
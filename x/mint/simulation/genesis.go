@@ -33,7 +33,9 @@ func GenInflation(r *rand.Rand) sdk.Dec {
 
 // GenInflationRateChange randomized InflationRateChange
 func GenInflationRateChange(r *rand.Rand) sdk.Dec {
-	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+	// the rate change is positive and bounded by the range of the inflation bounds
+	maxRateChange := GenInflationMax().Sub(GenInflationMin()).MulInt64(100).TruncateInt64()
+	return sdk.NewDecWithPrec(r.Int63n(maxRateChange)+1, 2)
 }
 
 // GenInflationMax randomized InflationMax
@@ -59,9 +61,10 @@ func GenDistributionProportions(r *rand.Rand) types.DistributionProportions {
 	communityPool := left - funded
 
 	return types.DistributionProportions{
-		Staking:         sdk.NewDecWithPrec(staking, 2),
-		FundedAddresses: sdk.NewDecWithPrec(funded, 2),
-		CommunityPool:   sdk.NewDecWithPrec(communityPool, 2),
+		Staking:          sdk.NewDecWithPrec(staking, 2),
+		FundedAddresses:  sdk.NewDecWithPrec(funded, 2),
+		CommunityPool:    sdk.NewDecWithPrec(communityPool, 2),
+		StrategicReserve: sdk.ZeroDec(),
 	}
 }
 
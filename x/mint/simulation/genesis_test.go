@@ -260,7 +260,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec6, mintGenesis.Params.DistributionProportions.CommunityPool)
 	require.Equal(t, "0stake", mintGenesis.Minter.BlockProvision(mintGenesis.Params).String())
 	require.Equal(t, "0.170000000000000000", mintGenesis.Minter.NextAnnualProvisions(mintGenesis.Params, sdkmath.OneInt()).String())
-	require.Equal(t, "0.169999926644441493", mintGenesis.Minter.NextInflationRate(mintGenesis.Params, sdk.OneDec()).String())
+	require.Equal(t, "0.169999993756973744", mintGenesis.Minter.NextInflationRate(mintGenesis.Params, sdk.OneDec()).String())
 	require.Equal(t, "0.170000000000000000", mintGenesis.Minter.Inflation.String())
 	require.Equal(t, "0.000000000000000000", mintGenesis.Minter.AnnualProvisions.String())
 	for _, addr := range mintGenesis.Params.FundedAddresses {
@@ -295,3 +295,27 @@ func TestRandomizedGenState1(t *testing.T) {
 		require.Panicsf(t, func() { simulation.RandomizedGenState(&tt.simState) }, tt.panicMsg)
 	}
 }
+
+// TestRandomizedGenStateValid tests the randomized genesis states always pass the validation.
+func TestRandomizedGenStateValid(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	for seed := int64(0); seed < 500; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+		simulation.RandomizedGenState(&simState)
+
+		var mintGenesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &mintGenesis)
+		require.NoError(t, mintGenesis.Validate(), "seed %d", seed)
+	}
+}
@@ -19,7 +19,7 @@ func TestParamChanges(t *testing.T) {
 		simValue    string
 		subspace    string
 	}{
-		{"mint/InflationRateChange", "InflationRateChange", "\"0.230000000000000000\"", "mint"},
+		{"mint/InflationRateChange", "InflationRateChange", "\"0.020000000000000000\"", "mint"},
 		{"mint/InflationMax", "InflationMax", "\"0.200000000000000000\"", "mint"},
 		{"mint/InflationMin", "InflationMin", "\"0.070000000000000000\"", "mint"},
 		{"mint/GoalBonded", "GoalBonded", "\"0.670000000000000000\"", "mint"},
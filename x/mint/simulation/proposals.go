@@ -0,0 +1,51 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams int = 100
+
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params" //nolint:gosec
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams submitted by the gov module, the
+// randomized params always pass the validation of the params
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := types.DefaultParams()
+	params.InflationRateChange = GenInflationRateChange(r)
+	params.InflationMax = GenInflationMax()
+	params.InflationMin = GenInflationMin()
+	params.GoalBonded = GenGoalBonded()
+	params.DistributionProportions = GenDistributionProportions(r)
+	params.FundedAddresses = GenFundedAddresses(r)
+
+	msg := types.NewMsgUpdateParams(authority.String(), params)
+	// the randomized params are unrelated to the current params and are most likely a large change
+	msg.AcknowledgeLargeChange = true
+	return msg
+}
@@ -0,0 +1,44 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/simulation"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestProposalMsgs(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, nil)
+	r := rand.New(rand.NewSource(1))
+	accounts := simtypes.RandomAccounts(r, 3)
+
+	weightedProposalMsgs := simulation.ProposalMsgs()
+	require.Len(t, weightedProposalMsgs, 1)
+
+	w0 := weightedProposalMsgs[0]
+	require.Equal(t, simulation.OpWeightMsgUpdateParams, w0.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgUpdateParams, w0.DefaultWeight())
+
+	msg, ok := w0.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgUpdateParams)
+	require.True(t, ok)
+	require.Equal(t, authtypes.NewModuleAddress(govtypes.ModuleName).String(), msg.Authority)
+	require.True(t, msg.AcknowledgeLargeChange)
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestSimulateMsgUpdateParamsValid(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, nil)
+	for seed := int64(0); seed < 1000; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		msg := simulation.SimulateMsgUpdateParams(r, ctx, nil).(*types.MsgUpdateParams)
+		require.NoError(t, msg.Params.Validate(), "seed %d", seed)
+	}
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// DistributionHistoryRetainedFrom returns the first height whose allocation is retained in the
+// distribution history at the height
+func DistributionHistoryRetainedFrom(height int64) int64 {
+	if from := height - DistributionHistoryRetention + 1; from > 1 {
+		return from
+	}
+	return 1
+}
+
+// AddressIncome returns the share of the address in the allocation of the block, it returns
+// false if the funded addresses share of the block was distributed before the shares of the
+// funded addresses were recorded
+func (d BlockDistribution) AddressIncome(address string) (sdk.Coins, bool) {
+	if len(d.FundedAddresses) == 0 {
+		return sdk.NewCoins(), d.Distributed.FundedAddresses.IsNil() || !d.Distributed.FundedAddresses.IsPositive()
+	}
+	income := sdk.NewCoins()
+	for _, share := range d.FundedAddresses {
+		if share.Address == address {
+			income = income.Add(share.Amount...)
+		}
+	}
+	return income, true
+}
+
+// SumAddressIncome returns the total of the shares of the address in the allocations of the
+// blocks of a range of heights and the number of allocations of the range, the allocations
+// outside of the range are ignored. Each height must be allocated once and the shares of the
+// funded addresses must be recorded in the allocations of the range.
+func SumAddressIncome(
+	address string,
+	fromHeight,
+	toHeight int64,
+	distributions []BlockDistribution,
+) (income sdk.Coins, recordedBlocks uint64, err error) {
+	income = sdk.NewCoins()
+	heights := make(map[int64]bool)
+	for _, distribution := range distributions {
+		if distribution.Height < fromHeight || distribution.Height > toHeight {
+			continue
+		}
+		if heights[distribution.Height] {
+			return nil, 0, errors.Wrapf(ErrInvalidHeightRange, "height %d allocated twice", distribution.Height)
+		}
+		heights[distribution.Height] = true
+
+		share, ok := distribution.AddressIncome(address)
+		if !ok {
+			return nil, 0, errors.Wrapf(
+				ErrInsufficientHistory,
+				"the shares of the funded addresses are not recorded at height %d",
+				distribution.Height,
+			)
+		}
+		income = income.Add(share...)
+		recordedBlocks++
+	}
+	return income, recordedBlocks, nil
+}
+
+// Add adds the share of a block to the income of the funded address
+func (i *FundedAddressIncome) Add(height int64, share sdk.Coins) {
+	if share.IsZero() {
+		return
+	}
+	if i.Total.IsZero() {
+		i.FirstHeight = height
+	}
+	i.Total = i.Total.Add(share...)
+	i.LastHeight = height
+}
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// incomeDistribution returns the allocation of a block distributing an amount of stake to the
+// address
+func incomeDistribution(height int64, address string, amount int64) types.BlockDistribution {
+	distributed := types.NewCategoryTotals()
+	distributed.FundedAddresses = sdkmath.NewInt(amount)
+	return types.BlockDistribution{
+		Height:      height,
+		Distributed: distributed,
+		FundedAddresses: []types.FundedAddressDistribution{
+			{Address: address, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))},
+		},
+	}
+}
+
+func TestDistributionHistoryRetainedFrom(t *testing.T) {
+	require.EqualValues(t, 1, types.DistributionHistoryRetainedFrom(1))
+	require.EqualValues(t, 1, types.DistributionHistoryRetainedFrom(types.DistributionHistoryRetention))
+	require.EqualValues(t, 2, types.DistributionHistoryRetainedFrom(types.DistributionHistoryRetention+1))
+}
+
+func TestSumAddressIncome(t *testing.T) {
+	const address, other = "funded", "other"
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	tests := []struct {
+		name           string
+		distributions  []types.BlockDistribution
+		income         sdk.Coins
+		recordedBlocks uint64
+		err            error
+	}{
+		{
+			name: "should sum the shares of the address in the range",
+			distributions: []types.BlockDistribution{
+				incomeDistribution(1, address, 5),
+				incomeDistribution(2, address, 10),
+				incomeDistribution(3, other, 7),
+				incomeDistribution(4, address, 20),
+				incomeDistribution(5, address, 40),
+			},
+			income:         stake(30),
+			recordedBlocks: 3,
+		},
+		{
+			name: "should count the allocations without funded addresses share",
+			distributions: []types.BlockDistribution{
+				incomeDistribution(2, address, 10),
+				{Height: 3, Distributed: types.NewCategoryTotals()},
+			},
+			income:         stake(10),
+			recordedBlocks: 2,
+		},
+		{
+			name: "should prevent a height allocated twice",
+			distributions: []types.BlockDistribution{
+				incomeDistribution(2, address, 10),
+				incomeDistribution(2, address, 10),
+			},
+			err: types.ErrInvalidHeightRange,
+		},
+		{
+			name: "should prevent an allocation without the shares of the funded addresses",
+			distributions: []types.BlockDistribution{
+				incomeDistribution(2, address, 10),
+				{Height: 3, Distributed: incomeDistribution(3, address, 10).Distributed},
+			},
+			err: types.ErrInsufficientHistory,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			income, recordedBlocks, err := types.SumAddressIncome(address, 2, 4, tc.distributions)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.income.IsEqual(income))
+			require.Equal(t, tc.recordedBlocks, recordedBlocks)
+		})
+	}
+}
+
+func TestFundedAddressIncomeAdd(t *testing.T) {
+	income := types.FundedAddressIncome{Address: "funded", Total: sdk.NewCoins()}
+	income.Add(2, sdk.NewCoins())
+	require.Zero(t, income.FirstHeight)
+
+	income.Add(3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	income.Add(5, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)))
+	income.Add(6, sdk.NewCoins())
+	require.Equal(t, types.FundedAddressIncome{
+		Address:     "funded",
+		Total:       sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)),
+		FirstHeight: 3,
+		LastHeight:  5,
+	}, income)
+}
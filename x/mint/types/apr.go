@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingAPR returns the annual rate of the staking share of the annual provisions over the
+// bonded tokens, the staking share follows the redirections of the paused shares. The rate is
+// zero while minting is paused or without bonded tokens.
+func StakingAPR(minter Minter, params Params, bondedTokens sdkmath.Int) sdk.Dec {
+	if params.PauseMinting || !bondedTokens.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	proportions, _ := EffectiveProportions(params)
+	return minter.AnnualProvisions.Mul(proportions.Staking).QuoInt(bondedTokens)
+}
+
+// NetAPR returns the rate of the delegators from the staking APR, net of the community tax
+// deducted from the staking rewards and of the commission rate of the validator
+func NetAPR(stakingAPR, communityTax, commissionRate sdk.Dec) sdk.Dec {
+	return stakingAPR.
+		Mul(sdk.OneDec().Sub(communityTax)).
+		Mul(sdk.OneDec().Sub(commissionRate))
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestStakingAPR(t *testing.T) {
+	minter := types.NewMinter(sdk.NewDecWithPrec(10, 2), sdk.NewDec(1000))
+	bondedTokens := sdkmath.NewInt(10_000)
+
+	tests := []struct {
+		name         string
+		params       func(*types.Params)
+		bondedTokens sdkmath.Int
+		expected     sdk.Dec
+	}{
+		{
+			name:         "should return the staking share of the annual provisions over the bonded tokens",
+			bondedTokens: bondedTokens,
+			expected:     sdk.NewDecWithPrec(3, 2),
+		},
+		{
+			name: "should return zero while the staking share is paused",
+			params: func(p *types.Params) {
+				p.PauseStakingShare = true
+			},
+			bondedTokens: bondedTokens,
+			expected:     sdk.ZeroDec(),
+		},
+		{
+			name: "should return zero while minting is paused",
+			params: func(p *types.Params) {
+				p.PauseMinting = true
+			},
+			bondedTokens: bondedTokens,
+			expected:     sdk.ZeroDec(),
+		},
+		{
+			name:         "should return zero without bonded tokens",
+			bondedTokens: sdkmath.ZeroInt(),
+			expected:     sdk.ZeroDec(),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			if tc.params != nil {
+				tc.params(&params)
+			}
+			require.Equal(t, tc.expected, types.StakingAPR(minter, params, tc.bondedTokens))
+		})
+	}
+}
+
+func TestNetAPR(t *testing.T) {
+	require.Equal(t,
+		sdk.MustNewDecFromStr("0.02646"),
+		types.NetAPR(sdk.NewDecWithPrec(3, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 1)),
+	)
+	require.Equal(t,
+		sdk.NewDecWithPrec(3, 2),
+		types.NetAPR(sdk.NewDecWithPrec(3, 2), sdk.ZeroDec(), sdk.ZeroDec()),
+	)
+}
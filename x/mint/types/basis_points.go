@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const (
+	// BasisPointsPrecision is the number of decimals of a rate in basis points
+	BasisPointsPrecision = 4
+
+	// MaxInflationBasisPoints is the largest inflation rate in basis points, a rate of 1
+	MaxInflationBasisPoints = 10000
+)
+
+// NewBasisPoints returns a rate of the number of basis points
+func NewBasisPoints(value int64) *BasisPoints {
+	return &BasisPoints{Value: value}
+}
+
+// ToDec returns the rate of the basis points, the conversion is exact
+func (bps BasisPoints) ToDec() sdk.Dec {
+	return sdk.NewDecWithPrec(bps.Value, BasisPointsPrecision)
+}
+
+// BasisPointsFromDec returns the basis points of the rate, the rate must be a whole number of
+// basis points
+func BasisPointsFromDec(rate sdk.Dec) (BasisPoints, error) {
+	if rate.IsNil() {
+		return BasisPoints{}, errors.Wrap(ErrInvalidBasisPoints, "nil rate")
+	}
+	scaled := rate.MulInt64(MaxInflationBasisPoints)
+	if !scaled.IsInteger() || !scaled.TruncateInt().IsInt64() {
+		return BasisPoints{}, errors.Wrapf(ErrInvalidBasisPoints, "rate %s is not a whole number of basis points", rate)
+	}
+	return BasisPoints{Value: scaled.TruncateInt64()}, nil
+}
+
+// ValidateInflationBasisPoints checks an inflation rate in basis points is in [0, 10000], the
+// bounds of an inflation rate
+func ValidateInflationBasisPoints(bps BasisPoints) error {
+	if bps.Value < 0 || bps.Value > MaxInflationBasisPoints {
+		return errors.Wrapf(
+			ErrInvalidBasisPoints,
+			"%d basis points out of the [0, %d] range",
+			bps.Value, MaxInflationBasisPoints,
+		)
+	}
+	return nil
+}
+
+// resolveBasisPoints returns the rate set either as a decimal or in basis points, a rate set in
+// both representations must have the same value in both
+func resolveBasisPoints(name string, rate sdk.Dec, bps *BasisPoints) (sdk.Dec, error) {
+	if bps == nil {
+		return rate, nil
+	}
+	if err := ValidateInflationBasisPoints(*bps); err != nil {
+		return rate, errors.Wrapf(err, "invalid %s", name)
+	}
+	converted := bps.ToDec()
+	if !rate.IsNil() && !rate.Equal(converted) {
+		return rate, errors.Wrapf(
+			ErrInvalidBasisPoints,
+			"%s (%s) inconsistent with %d basis points (%s)",
+			name, rate, bps.Value, converted,
+		)
+	}
+	return converted, nil
+}
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestBasisPoints(t *testing.T) {
+	t.Run("should convert the basis points exactly", func(t *testing.T) {
+		require.Equal(t, sdk.ZeroDec(), types.NewBasisPoints(0).ToDec())
+		require.Equal(t, sdk.NewDecWithPrec(7, 2), types.NewBasisPoints(700).ToDec())
+		require.Equal(t, sdk.NewDecWithPrec(1, 4), types.NewBasisPoints(1).ToDec())
+		require.Equal(t, sdk.OneDec(), types.NewBasisPoints(types.MaxInflationBasisPoints).ToDec())
+	})
+
+	t.Run("should round-trip the basis points", func(t *testing.T) {
+		for _, value := range []int64{0, 1, 7, 700, 1234, 9999, 10000} {
+			bps, err := types.BasisPointsFromDec(types.NewBasisPoints(value).ToDec())
+			require.NoError(t, err)
+			require.Equal(t, value, bps.Value)
+		}
+		for _, rate := range []string{"0", "0.07", "0.2", "0.1234", "1"} {
+			dec := sdk.MustNewDecFromStr(rate)
+			bps, err := types.BasisPointsFromDec(dec)
+			require.NoError(t, err)
+			require.Equal(t, dec, bps.ToDec())
+		}
+	})
+
+	t.Run("should prevent converting a rate not a whole number of basis points", func(t *testing.T) {
+		_, err := types.BasisPointsFromDec(sdk.MustNewDecFromStr("0.00005"))
+		require.ErrorIs(t, err, types.ErrInvalidBasisPoints)
+		_, err = types.BasisPointsFromDec(sdk.SmallestDec())
+		require.ErrorIs(t, err, types.ErrInvalidBasisPoints)
+		_, err = types.BasisPointsFromDec(sdk.Dec{})
+		require.ErrorIs(t, err, types.ErrInvalidBasisPoints)
+	})
+
+	t.Run("should validate the inflation basis points range", func(t *testing.T) {
+		require.NoError(t, types.ValidateInflationBasisPoints(*types.NewBasisPoints(0)))
+		require.NoError(t, types.ValidateInflationBasisPoints(*types.NewBasisPoints(types.MaxInflationBasisPoints)))
+		require.ErrorIs(t, types.ValidateInflationBasisPoints(*types.NewBasisPoints(-1)), types.ErrInvalidBasisPoints)
+		require.ErrorIs(t, types.ValidateInflationBasisPoints(*types.NewBasisPoints(10001)), types.ErrInvalidBasisPoints)
+	})
+}
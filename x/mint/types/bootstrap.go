@@ -0,0 +1,46 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// moduleNameRegex matches the names of the module accounts
+var moduleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// isModuleName returns true if the name can be the name of a module account, a name with the
+// prefix of the account addresses is an invalid address
+func isModuleName(name string) bool {
+	return moduleNameRegex.MatchString(name) &&
+		!strings.HasPrefix(name, sdk.GetConfig().GetBech32AccountAddrPrefix()+"1")
+}
+
+// Enabled returns true if the bootstrap override has a recipient
+func (o BootstrapOverride) Enabled() bool {
+	return o.Recipient != ""
+}
+
+// IsActiveAt returns true if the coins minted at the height are sent to the recipient of the
+// bootstrap override, until the end height excluded
+func (o BootstrapOverride) IsActiveAt(height int64) bool {
+	return o.Enabled() && height < o.EndHeight
+}
+
+// IsModuleAccount returns true if the recipient of the bootstrap override is the name of a module
+// account rather than an address
+func (o BootstrapOverride) IsModuleAccount() bool {
+	return isModuleName(o.Recipient)
+}
+
+// CheckEndHeight checks an enabled bootstrap override still applies to the blocks after the
+// height, an override can't be set once the chain has passed its end height
+func (o BootstrapOverride) CheckEndHeight(height int64) error {
+	if o.Enabled() && o.EndHeight <= height {
+		return errors.Wrapf(ErrBootstrapEnded, "end height %d passed at height %d", o.EndHeight, height)
+	}
+	return nil
+}
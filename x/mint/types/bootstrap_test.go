@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestBootstrapOverrideIsActiveAt(t *testing.T) {
+	override := types.BootstrapOverride{Recipient: "claim", EndHeight: 10}
+	require.True(t, override.IsActiveAt(1))
+	require.True(t, override.IsActiveAt(9))
+	require.False(t, override.IsActiveAt(10))
+	require.False(t, override.IsActiveAt(11))
+	require.False(t, types.BootstrapOverride{}.IsActiveAt(1))
+}
+
+func TestBootstrapOverrideIsModuleAccount(t *testing.T) {
+	require.True(t, types.BootstrapOverride{Recipient: "claim"}.IsModuleAccount())
+	require.True(t, types.BootstrapOverride{Recipient: "fee_collector"}.IsModuleAccount())
+	require.False(t, types.BootstrapOverride{Recipient: sample.Address(r)}.IsModuleAccount())
+	require.False(t, types.BootstrapOverride{}.IsModuleAccount())
+}
+
+func TestBootstrapOverrideCheckEndHeight(t *testing.T) {
+	override := types.BootstrapOverride{Recipient: "claim", EndHeight: 10}
+	require.NoError(t, override.CheckEndHeight(9))
+	require.ErrorIs(t, override.CheckEndHeight(10), types.ErrBootstrapEnded)
+	require.ErrorIs(t, override.CheckEndHeight(11), types.ErrBootstrapEnded)
+	require.NoError(t, types.BootstrapOverride{}.CheckEndHeight(11))
+}
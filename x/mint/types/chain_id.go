@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strings"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// ValidateExpectedChainID checks the expected chain-id of an authority message, an empty chain-id
+// disables the check of the chain the message is executed on
+func ValidateExpectedChainID(chainID string) error {
+	if chainID == "" {
+		return nil
+	}
+	if len(chainID) > cmttypes.MaxChainIDLen {
+		return errors.Wrapf(ErrInvalidChainID, "chain-id %s longer than %d characters", chainID, cmttypes.MaxChainIDLen)
+	}
+	if strings.ContainsAny(chainID, " \t\n\r") {
+		return errors.Wrapf(ErrInvalidChainID, "chain-id %q contains whitespaces", chainID)
+	}
+	return nil
+}
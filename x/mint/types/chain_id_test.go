@@ -0,0 +1,17 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestValidateExpectedChainID(t *testing.T) {
+	require.NoError(t, types.ValidateExpectedChainID(""))
+	require.NoError(t, types.ValidateExpectedChainID("mint-1"))
+	require.ErrorIs(t, types.ValidateExpectedChainID("mint 1"), types.ErrInvalidChainID)
+	require.ErrorIs(t, types.ValidateExpectedChainID(strings.Repeat("a", 51)), types.ErrInvalidChainID)
+}
@@ -2,12 +2,55 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-var amino = codec.NewLegacyAmino()
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetPaused{}, "mint/SetPaused", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "mint/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetGoalBonded{}, "mint/SetGoalBonded", nil)
+	cdc.RegisterConcrete(&MsgClaimDistribution{}, "mint/ClaimDistribution", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "mint/Burn", nil)
+	cdc.RegisterConcrete(&MsgReleaseReserve{}, "mint/ReleaseReserve", nil)
+	cdc.RegisterConcrete(&MsgFundMinter{}, "mint/FundMinter", nil)
+	cdc.RegisterConcrete(&MsgResumeMinting{}, "mint/ResumeMinting", nil)
+	cdc.RegisterConcrete(&MsgRunMaintenance{}, "mint/RunMaintenance", nil)
+	cdc.RegisterConcrete(&MsgAddFundedAddress{}, "mint/AddFundedAddress", nil)
+	cdc.RegisterConcrete(&MsgRemoveFundedAddress{}, "mint/RemoveFundedAddress", nil)
+	cdc.RegisterConcrete(&MsgSetFundedAddressWeight{}, "mint/SetFundedAddressWeight", nil)
+	cdc.RegisterConcrete(&MsgSetMinCommunityPoolShare{}, "mint/SetMinCommunityPoolShare", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPaused{},
+		&MsgUpdateParams{},
+		&MsgSetGoalBonded{},
+		&MsgClaimDistribution{},
+		&MsgBurn{},
+		&MsgReleaseReserve{},
+		&MsgFundMinter{},
+		&MsgResumeMinting{},
+		&MsgRunMaintenance{},
+		&MsgAddFundedAddress{},
+		&MsgRemoveFundedAddress{},
+		&MsgSetFundedAddressWeight{},
+		&MsgSetMinCommunityPoolShare{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
 
 func init() {
+	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
 }
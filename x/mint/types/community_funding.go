@@ -0,0 +1,119 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// BudgetYear returns the index of the budget year of the height, the budget year N spans the
+// heights from N * blocksPerYear + 1 to (N + 1) * blocksPerYear
+func BudgetYear(height int64, blocksPerYear uint64) int64 {
+	if height <= 0 {
+		return 0
+	}
+	return (height - 1) / int64(blocksPerYear)
+}
+
+// RemainingBudgetYearBlocks returns the number of blocks of the budget year of the height from the
+// height, including it
+func RemainingBudgetYearBlocks(height int64, blocksPerYear uint64) int64 {
+	return (BudgetYear(height, blocksPerYear)+1)*int64(blocksPerYear) - height + 1
+}
+
+// NewCommunityFunding returns the community funding of a budget year starting with the community
+// pool total of the cumulative distributed amounts
+func NewCommunityFunding(budgetYear int64, communityPool sdkmath.Int) CommunityFunding {
+	return CommunityFunding{
+		BudgetYear:             budgetYear,
+		YearStartCommunityPool: communityPool,
+	}
+}
+
+// Funded returns the community pool funding of the budget year from the community pool total of
+// the cumulative distributed amounts
+func (cf CommunityFunding) Funded(communityPool sdkmath.Int) sdkmath.Int {
+	if cf.YearStartCommunityPool.IsNil() {
+		return communityPool
+	}
+	return communityPool.Sub(cf.YearStartCommunityPool)
+}
+
+// CommunityFundingTopUp is the top-up of the community pool funding of a block to reach the
+// minimum annual community funding
+type CommunityFundingTopUp struct {
+	// Shortfall is the amount missing to reach the minimum before the top-up
+	Shortfall sdkmath.Int
+	// Minted is the amount minted in addition to the block provision
+	Minted sdkmath.Int
+	// Reallocated is the amount reallocated from the staking share of the block
+	Reallocated sdkmath.Int
+}
+
+// ZeroCommunityFundingTopUp returns an empty top-up
+func ZeroCommunityFundingTopUp() CommunityFundingTopUp {
+	return CommunityFundingTopUp{
+		Shortfall:   sdkmath.ZeroInt(),
+		Minted:      sdkmath.ZeroInt(),
+		Reallocated: sdkmath.ZeroInt(),
+	}
+}
+
+// Total returns the total amount of the top-up
+func (t CommunityFundingTopUp) Total() sdkmath.Int {
+	return t.Minted.Add(t.Reallocated)
+}
+
+// NewCommunityFundingTopUp returns the top-up of the community pool funding of the block at the
+// height. In the funding window at the end of the budget year, the shortfall of the year, after the
+// planned community pool share of the block, is spread over the remaining blocks of the year and
+// taken from the sources by priority: minted within the provision at the maximum inflation rate or
+// reallocated from the staking share of the block. There is no top-up while the community pool
+// share is paused, since the top-up would be buffered.
+func NewCommunityFundingTopUp(
+	params Params,
+	minter Minter,
+	height int64,
+	stakingSupply,
+	minted sdkmath.Int,
+) CommunityFundingTopUp {
+	topUp := ZeroCommunityFundingTopUp()
+	if !params.MinAnnualCommunityFunding.IsPositive() || params.PauseCommunityShare {
+		return topUp
+	}
+	remaining := RemainingBudgetYearBlocks(height, params.BlocksPerYear)
+	if remaining > int64(params.CommunityFundingWindow) {
+		return topUp
+	}
+
+	planned := ProjectShares(params, minted)
+	funded := minter.CommunityFunding.Funded(minter.CumulativeDistributed.CommunityPool).Add(planned.CommunityPool)
+	shortfall := params.MinAnnualCommunityFunding.Amount.Sub(funded)
+	if !shortfall.IsPositive() {
+		return topUp
+	}
+	topUp.Shortfall = shortfall
+
+	// the shortfall is spread over the remaining blocks of the year, rounded up
+	target := shortfall.AddRaw(remaining - 1).QuoRaw(remaining)
+	for _, source := range params.CommunityFundingPriority {
+		missing := target.Sub(topUp.Total())
+		if !missing.IsPositive() {
+			break
+		}
+		switch source {
+		case COMMUNITY_FUNDING_SOURCE_MINT:
+			maxProvision := params.InflationMax.MulInt(stakingSupply).QuoInt64(int64(params.BlocksPerYear)).TruncateInt()
+			topUp.Minted = topUp.Minted.Add(clampTopUp(missing, maxProvision.Sub(minted)))
+		case COMMUNITY_FUNDING_SOURCE_STAKING:
+			topUp.Reallocated = topUp.Reallocated.Add(clampTopUp(missing, planned.Staking))
+		}
+	}
+	return topUp
+}
+
+// clampTopUp returns the missing amount bounded by the non-negative available amount
+func clampTopUp(missing, available sdkmath.Int) sdkmath.Int {
+	if !available.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+	return sdkmath.MinInt(missing, available)
+}
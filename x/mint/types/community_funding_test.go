@@ -0,0 +1,162 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestBudgetYear(t *testing.T) {
+	for _, tc := range []struct {
+		height    int64
+		year      int64
+		remaining int64
+	}{
+		{height: 1, year: 0, remaining: 100},
+		{height: 99, year: 0, remaining: 2},
+		{height: 100, year: 0, remaining: 1},
+		{height: 101, year: 1, remaining: 100},
+		{height: 250, year: 2, remaining: 51},
+	} {
+		require.Equal(t, tc.year, types.BudgetYear(tc.height, 100), "height %d", tc.height)
+		require.Equal(t, tc.remaining, types.RemainingBudgetYearBlocks(tc.height, 100), "height %d", tc.height)
+	}
+}
+
+func TestNewCommunityFundingTopUp(t *testing.T) {
+	// with a staking supply of 100000, the provision at the maximum inflation rate is 200 per
+	// block and, without funded address, 70% of the block provision goes to the community pool
+	stakingSupply := sdkmath.NewInt(100_000)
+	newParams := func() types.Params {
+		params := types.DefaultParams()
+		params.BlocksPerYear = 100
+		params.CommunityFundingWindow = 10
+		params.MinAnnualCommunityFunding = sdk.NewInt64Coin(params.MintDenom, 1000)
+		return params
+	}
+	newMinter := func(yearStart, communityPool int64) types.Minter {
+		minter := types.DefaultInitialMinter()
+		minter.CommunityFunding = types.NewCommunityFunding(0, sdkmath.NewInt(yearStart))
+		minter.CumulativeDistributed.CommunityPool = sdkmath.NewInt(communityPool)
+		return minter
+	}
+
+	tests := []struct {
+		name        string
+		params      func(*types.Params)
+		minter      types.Minter
+		height      int64
+		minted      int64
+		shortfall   int64
+		topMinted   int64
+		reallocated int64
+	}{
+		{
+			name:   "should not top up outside the funding window",
+			minter: newMinter(0, 500),
+			height: 90,
+			minted: 100,
+		},
+		{
+			name:      "should mint the shortfall spread over the remaining blocks",
+			minter:    newMinter(0, 500),
+			height:    91,
+			minted:    100,
+			shortfall: 430,
+			topMinted: 43,
+		},
+		{
+			name: "should reallocate the staking share first by priority",
+			params: func(p *types.Params) {
+				p.CommunityFundingPriority = []types.CommunityFundingSource{
+					types.COMMUNITY_FUNDING_SOURCE_STAKING,
+					types.COMMUNITY_FUNDING_SOURCE_MINT,
+				}
+			},
+			minter:      newMinter(0, 500),
+			height:      91,
+			minted:      100,
+			shortfall:   430,
+			topMinted:   13,
+			reallocated: 30,
+		},
+		{
+			name:        "should reallocate the staking share without inflation headroom",
+			minter:      newMinter(0, 500),
+			height:      91,
+			minted:      200,
+			shortfall:   360,
+			reallocated: 36,
+		},
+		{
+			name:        "should top up within the available sources in the last block",
+			minter:      newMinter(0, 500),
+			height:      100,
+			minted:      100,
+			shortfall:   430,
+			topMinted:   100,
+			reallocated: 30,
+		},
+		{
+			name: "should not top up without funding source",
+			params: func(p *types.Params) {
+				p.CommunityFundingPriority = nil
+			},
+			minter:    newMinter(0, 500),
+			height:    100,
+			minted:    100,
+			shortfall: 430,
+		},
+		{
+			name:        "should only count the funding of the budget year",
+			minter:      newMinter(400, 500),
+			height:      100,
+			minted:      100,
+			shortfall:   830,
+			topMinted:   100,
+			reallocated: 30,
+		},
+		{
+			name:   "should not top up once the minimum is reached",
+			minter: newMinter(0, 1000),
+			height: 100,
+			minted: 100,
+		},
+		{
+			name: "should not top up while the community pool share is paused",
+			params: func(p *types.Params) {
+				p.PauseCommunityShare = true
+			},
+			minter: newMinter(0, 500),
+			height: 100,
+			minted: 100,
+		},
+		{
+			name: "should not top up without minimum",
+			params: func(p *types.Params) {
+				p.MinAnnualCommunityFunding = types.DefaultMinAnnualCommunityFunding
+			},
+			minter: newMinter(0, 500),
+			height: 100,
+			minted: 100,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := newParams()
+			if tc.params != nil {
+				tc.params(&params)
+			}
+			topUp := types.NewCommunityFundingTopUp(params, tc.minter, tc.height, stakingSupply, sdkmath.NewInt(tc.minted))
+			require.Equal(t, types.CommunityFundingTopUp{
+				Shortfall:   sdkmath.NewInt(tc.shortfall),
+				Minted:      sdkmath.NewInt(tc.topMinted),
+				Reallocated: sdkmath.NewInt(tc.reallocated),
+			}, topUp)
+		})
+	}
+}
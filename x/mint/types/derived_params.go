@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDerivedParams returns the values derived from the params at the height for the minter, the
+// supply of the mint denom and the bonded ratio
+func NewDerivedParams(minter Minter, params Params, height int64, supply sdkmath.Int, bondedRatio sdk.Dec) DerivedParams {
+	atHeight := params.AtHeight(height)
+	derived := DerivedParams{
+		Height:                  height,
+		InflationMin:            atHeight.InflationMin,
+		InflationMax:            atHeight.InflationMax,
+		GoalBonded:              minter.EffectiveGoalBonded(atHeight, height),
+		BootstrapOverrideActive: params.BootstrapOverride.IsActiveAt(height),
+		MaxSupplyUtilization:    sdk.ZeroDec(),
+		MaxSupplyHeadroom:       sdkmath.ZeroInt(),
+		PauseState:              NewPauseState(params),
+		AutoPaused:              minter.AutoPaused,
+		MintingSkipped:          params.MinBondedRatio.IsPositive() && bondedRatio.LT(params.MinBondedRatio),
+	}
+	if bps, err := BasisPointsFromDec(atHeight.InflationMin); err == nil {
+		derived.InflationMinBps = &bps
+	}
+	if bps, err := BasisPointsFromDec(atHeight.InflationMax); err == nil {
+		derived.InflationMaxBps = &bps
+	}
+	if phase, active := params.ActivePhase(height); active {
+		derived.ActivePhase = &phase
+	}
+	for _, phase := range params.Phases {
+		if phase.StartHeight > height {
+			phase := phase
+			derived.NextPhase = &phase
+			break
+		}
+	}
+	if transition := minter.GoalBondedTransition; transition != nil &&
+		height < transition.EndHeight && transition.To.Equal(atHeight.GoalBonded) {
+		derived.GoalBondedTransition = transition
+	}
+	if headroom, capped := params.MaxSupplyHeadroom(supply); capped {
+		derived.MaxSupplyCapped = true
+		derived.MaxSupplyHeadroom = headroom
+		derived.MaxSupplyUtilization = sdk.NewDecFromInt(supply).QuoInt(params.MaxSupply)
+	}
+
+	// the share of the funded addresses outside of their funding window is sent to the community
+	// pool, along with the proportions of the paused categories
+	outOfWindow := sdk.ZeroDec()
+	normalized := NormalizeWeights(params.FundedAddresses)
+	for i, w := range params.FundedAddresses {
+		if !w.IsFundedAt(height) {
+			derived.FundedAddressesOutOfWindow = append(derived.FundedAddressesOutOfWindow, w.Address)
+			outOfWindow = outOfWindow.Add(normalized[i].Weight)
+		}
+	}
+	proportionsParams := params
+	switch {
+	case derived.BootstrapOverrideActive:
+		// all the minted coins are sent to the recipient of the bootstrap override as a funded address
+		proportionsParams.DistributionProportions = DistributionProportions{
+			Staking:          sdk.ZeroDec(),
+			FundedAddresses:  sdk.OneDec(),
+			CommunityPool:    sdk.ZeroDec(),
+			StrategicReserve: sdk.ZeroDec(),
+		}
+		proportionsParams.FundedAddresses = []WeightedAddress{{Address: params.BootstrapOverride.Recipient, Weight: sdk.OneDec()}}
+	case !params.PauseFundedShare:
+		proportions := &proportionsParams.DistributionProportions
+		redirected := proportions.FundedAddresses.Mul(outOfWindow)
+		proportions.FundedAddresses = proportions.FundedAddresses.Sub(redirected)
+		proportions.CommunityPool = proportions.CommunityPool.Add(redirected)
+	}
+	derived.EffectiveProportions, derived.BufferedProportion = EffectiveProportions(proportionsParams)
+	return derived
+}
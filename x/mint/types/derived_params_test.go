@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestNewDerivedParams(t *testing.T) {
+	minter := types.DefaultInitialMinter()
+	supply := sdkmath.NewInt(1000)
+	bondedRatio := sdk.NewDecWithPrec(67, 2)
+
+	t.Run("should derive the params without modifier", func(t *testing.T) {
+		params := types.DefaultParams()
+		derived := types.NewDerivedParams(minter, params, 10, supply, bondedRatio)
+		proportions, _ := types.EffectiveProportions(params)
+		require.EqualValues(t, 10, derived.Height)
+		require.Equal(t, proportions, derived.EffectiveProportions)
+		require.True(t, derived.BufferedProportion.IsZero())
+		require.Equal(t, params.InflationMin, derived.InflationMin)
+		require.Equal(t, params.InflationMax, derived.InflationMax)
+		require.Equal(t, types.NewBasisPoints(700), derived.InflationMinBps)
+		require.Equal(t, types.NewBasisPoints(2000), derived.InflationMaxBps)
+		require.Equal(t, params.GoalBonded, derived.GoalBonded)
+		require.Nil(t, derived.ActivePhase)
+		require.Nil(t, derived.NextPhase)
+		require.Nil(t, derived.GoalBondedTransition)
+		require.False(t, derived.BootstrapOverrideActive)
+		require.Empty(t, derived.FundedAddressesOutOfWindow)
+		require.False(t, derived.MaxSupplyCapped)
+		require.True(t, derived.MaxSupplyUtilization.IsZero())
+		require.True(t, derived.MaxSupplyHeadroom.IsZero())
+		require.Equal(t, types.NewPauseState(params), derived.PauseState)
+		require.False(t, derived.AutoPaused)
+		require.False(t, derived.MintingSkipped)
+	})
+
+	t.Run("should distribute all the minted coins to the bootstrap override recipient", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.BootstrapOverride = types.BootstrapOverride{Recipient: sample.Address(r), EndHeight: 100}
+		derived := types.NewDerivedParams(minter, params, 10, supply, bondedRatio)
+		require.True(t, derived.BootstrapOverrideActive)
+		require.Equal(t, sdk.OneDec(), derived.EffectiveProportions.FundedAddresses)
+		require.True(t, derived.EffectiveProportions.Staking.IsZero())
+		require.True(t, derived.EffectiveProportions.CommunityPool.IsZero())
+
+		derived = types.NewDerivedParams(minter, params, 100, supply, bondedRatio)
+		require.False(t, derived.BootstrapOverrideActive)
+	})
+
+	t.Run("should redirect the share of the funded addresses out of their window", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.DistributionProportions = types.DistributionProportions{
+			Staking:          sdk.NewDecWithPrec(5, 1),
+			FundedAddresses:  sdk.NewDecWithPrec(4, 1),
+			CommunityPool:    sdk.NewDecWithPrec(1, 1),
+			StrategicReserve: sdk.ZeroDec(),
+		}
+		future := sample.Address(r)
+		params.FundedAddresses = []types.WeightedAddress{
+			{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(3, 1)},
+			{Address: future, Weight: sdk.NewDecWithPrec(1, 1), StartHeight: 50},
+		}
+		derived := types.NewDerivedParams(minter, params, 10, supply, bondedRatio)
+		require.Equal(t, []string{future}, derived.FundedAddressesOutOfWindow)
+		require.Equal(t, sdk.NewDecWithPrec(3, 1), derived.EffectiveProportions.FundedAddresses)
+		require.Equal(t, sdk.NewDecWithPrec(2, 1), derived.EffectiveProportions.CommunityPool)
+	})
+
+	t.Run("should report the active and next phases and the pending transition", func(t *testing.T) {
+		params := types.DefaultParams()
+		phase := types.Phase{
+			Name:         "first",
+			StartHeight:  5,
+			InflationMin: sdk.NewDecWithPrec(1, 2),
+			InflationMax: sdk.NewDecWithPrec(9, 2),
+			GoalBonded:   sdk.NewDecWithPrec(6, 1),
+		}
+		next := phase
+		next.Name, next.StartHeight = "second", 20
+		params.Phases = []types.Phase{phase, next}
+		minter := minter
+		minter.GoalBondedTransition = &types.GoalBondedTransition{
+			From:        sdk.NewDecWithPrec(4, 1),
+			To:          phase.GoalBonded,
+			StartHeight: 0,
+			EndHeight:   20,
+		}
+
+		derived := types.NewDerivedParams(minter, params, 10, supply, bondedRatio)
+		require.Equal(t, &phase, derived.ActivePhase)
+		require.Equal(t, &next, derived.NextPhase)
+		require.Equal(t, phase.InflationMin, derived.InflationMin)
+		require.Equal(t, phase.InflationMax, derived.InflationMax)
+		require.Equal(t, minter.GoalBondedTransition, derived.GoalBondedTransition)
+		require.Equal(t, sdk.NewDecWithPrec(5, 1), derived.GoalBonded)
+
+		derived = types.NewDerivedParams(minter, params, 20, supply, bondedRatio)
+		require.Equal(t, &next, derived.ActivePhase)
+		require.Nil(t, derived.NextPhase)
+		require.Nil(t, derived.GoalBondedTransition)
+	})
+
+	t.Run("should report the utilization of the max supply", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MaxSupply = sdkmath.NewInt(4000)
+		derived := types.NewDerivedParams(minter, params, 10, supply, bondedRatio)
+		require.True(t, derived.MaxSupplyCapped)
+		require.Equal(t, sdk.NewDecWithPrec(25, 2), derived.MaxSupplyUtilization)
+		require.Equal(t, sdkmath.NewInt(3000), derived.MaxSupplyHeadroom)
+	})
+
+	t.Run("should report the pauses and the skipped minting", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.PauseCommunityShare = true
+		params.MinBondedRatio = sdk.NewDecWithPrec(7, 1)
+		minter := minter
+		minter.AutoPaused = true
+		derived := types.NewDerivedParams(minter, params, 10, supply, bondedRatio)
+		require.True(t, derived.PauseState.CommunityShare)
+		proportions := params.DistributionProportions
+		require.Equal(t, proportions.CommunityPool.Add(proportions.FundedAddresses), derived.BufferedProportion)
+		require.True(t, derived.AutoPaused)
+		require.True(t, derived.MintingSkipped)
+	})
+}
@@ -0,0 +1,323 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Distribution categories of the minted coins
+const (
+	CategoryStaking          = "staking"
+	CategoryFundedAddresses  = "funded_addresses"
+	CategoryCommunityPool    = "community_pool"
+	CategoryStrategicReserve = "strategic_reserve"
+)
+
+// DustCategories are the distribution categories the dust is assigned to in round robin
+var DustCategories = []string{CategoryStaking, CategoryFundedAddresses, CategoryCommunityPool}
+
+// DustCategory returns the distribution category receiving the dust of a block in round robin
+func DustCategory(height int64) string {
+	return DustCategories[height%int64(len(DustCategories))]
+}
+
+// AttributeKeyAllocationIndex is the attribute of the events of the bank transfers of an
+// allocation of the distribution of a block with the index of the allocation, the index of the
+// allocation_index field of the typed events of the allocation
+const AttributeKeyAllocationIndex = "allocation_index"
+
+// Cumulative counters of the minter that are not distribution categories
+const (
+	CounterCumulativeMinted = "cumulative_minted"
+	CounterCumulativeFunded = "cumulative_funded"
+	CounterDust             = "dust"
+)
+
+// Actions applied to the share of a paused distribution category
+const (
+	PausedShareActionRedirected = "redirected_to_community_pool"
+	PausedShareActionBuffered   = "buffered"
+)
+
+// Sources of the community pool funding of a block
+const (
+	CommunityPoolSourceShare                 = "community_pool_share"
+	CommunityPoolSourceRedirectedStaking     = "redirected_staking_share"
+	CommunityPoolSourceRedirectedFunded      = "redirected_funded_addresses_share"
+	CommunityPoolSourceUnallocatedFunded     = "unallocated_funded_addresses_share"
+	CommunityPoolSourceOutOfWindowFunded     = "out_of_window_funded_address_share"
+	CommunityPoolSourceReleasedCommunityPool = "released_community_pool_share"
+	CommunityPoolSourceDust                  = "dust"
+	CommunityPoolSourceFloorMinted           = "community_funding_floor_minted"
+	CommunityPoolSourceFloorReallocated      = "community_funding_floor_reallocated_staking"
+	CommunityPoolSourceMissingFeeCollector   = "missing_fee_collector_staking_share"
+)
+
+// Labels of the sources of the community pool funding, telling whether an amount is funded by
+// design or because a share could not be distributed
+const (
+	CommunityPoolLabelConfiguredShare  = "configured_share"
+	CommunityPoolLabelRemainderDust    = "remainder_dust"
+	CommunityPoolLabelEmptyFundedList  = "empty_funded_list"
+	CommunityPoolLabelBlockedRecipient = "blocked_recipient"
+	CommunityPoolLabelPausedCategory   = "paused_category"
+)
+
+// CommunityPoolLabels are the labels of the sources of the community pool funding
+var CommunityPoolLabels = []string{
+	CommunityPoolLabelConfiguredShare,
+	CommunityPoolLabelRemainderDust,
+	CommunityPoolLabelEmptyFundedList,
+	CommunityPoolLabelBlockedRecipient,
+	CommunityPoolLabelPausedCategory,
+}
+
+// CommunityPoolSourceLabel returns the label of a source of the community pool funding: the
+// community pool share and the community funding floor are configured shares, the funded
+// addresses share without funded address or of an address outside its funding window is funded
+// for an empty funded list, the staking share without fee collector for a blocked recipient, and
+// the shares redirected or released by a pause for a paused category.
+func CommunityPoolSourceLabel(source string) string {
+	switch source {
+	case CommunityPoolSourceDust:
+		return CommunityPoolLabelRemainderDust
+	case CommunityPoolSourceUnallocatedFunded, CommunityPoolSourceOutOfWindowFunded:
+		return CommunityPoolLabelEmptyFundedList
+	case CommunityPoolSourceMissingFeeCollector:
+		return CommunityPoolLabelBlockedRecipient
+	case CommunityPoolSourceRedirectedStaking, CommunityPoolSourceRedirectedFunded, CommunityPoolSourceReleasedCommunityPool:
+		return CommunityPoolLabelPausedCategory
+	default:
+		return CommunityPoolLabelConfiguredShare
+	}
+}
+
+// CommunityPoolSources accumulates the amounts sent to the community pool by source.
+type CommunityPoolSources []CommunityPoolSource
+
+// Add adds an amount to a source, sources are kept in the order they are first added and are
+// labeled with the label of the source.
+func (s CommunityPoolSources) Add(source string, amount sdk.Coins) CommunityPoolSources {
+	if amount.IsZero() {
+		return s
+	}
+	for i := range s {
+		if s[i].Source == source {
+			s[i].Amount = s[i].Amount.Add(amount...)
+			return s
+		}
+	}
+	return append(s, CommunityPoolSource{Source: source, Amount: amount, Label: CommunityPoolSourceLabel(source)})
+}
+
+// AmountOf returns the amount of a source.
+func (s CommunityPoolSources) AmountOf(source string) sdk.Coins {
+	for _, src := range s {
+		if src.Source == source {
+			return src.Amount
+		}
+	}
+	return sdk.NewCoins()
+}
+
+// Total returns the total amount of the sources.
+func (s CommunityPoolSources) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, source := range s {
+		total = total.Add(source.Amount...)
+	}
+	return total
+}
+
+// Total returns the total amount of buffered paused shares.
+func (ps PausedShares) Total() sdk.Coins {
+	return sdk.NewCoins().
+		Add(ps.Staking...).
+		Add(ps.FundedAddresses...).
+		Add(ps.CommunityPool...)
+}
+
+// Validate checks the buffered paused shares are valid coins.
+func (ps PausedShares) Validate() error {
+	if err := ps.Staking.Validate(); err != nil {
+		return fmt.Errorf("invalid staking paused share: %w", err)
+	}
+	if err := ps.FundedAddresses.Validate(); err != nil {
+		return fmt.Errorf("invalid funded addresses paused share: %w", err)
+	}
+	if err := ps.CommunityPool.Validate(); err != nil {
+		return fmt.Errorf("invalid community pool paused share: %w", err)
+	}
+	return nil
+}
+
+// NewCategoryTotals returns category totals initialized to zero.
+func NewCategoryTotals() CategoryTotals {
+	return CategoryTotals{
+		Staking:          sdkmath.ZeroInt(),
+		FundedAddresses:  sdkmath.ZeroInt(),
+		CommunityPool:    sdkmath.ZeroInt(),
+		Dust:             sdkmath.ZeroInt(),
+		StrategicReserve: sdkmath.ZeroInt(),
+	}
+}
+
+// Normalize sets the nil totals to zero.
+func (ct *CategoryTotals) Normalize() {
+	for _, total := range []*sdkmath.Int{&ct.Staking, &ct.FundedAddresses, &ct.CommunityPool, &ct.Dust, &ct.StrategicReserve} {
+		if total.IsNil() {
+			*total = sdkmath.ZeroInt()
+		}
+	}
+}
+
+// Add returns the sum of the category totals by category, the nil totals count as zero.
+func (ct CategoryTotals) Add(other CategoryTotals) CategoryTotals {
+	ct.Normalize()
+	other.Normalize()
+	return CategoryTotals{
+		Staking:          ct.Staking.Add(other.Staking),
+		FundedAddresses:  ct.FundedAddresses.Add(other.FundedAddresses),
+		CommunityPool:    ct.CommunityPool.Add(other.CommunityPool),
+		Dust:             ct.Dust.Add(other.Dust),
+		StrategicReserve: ct.StrategicReserve.Add(other.StrategicReserve),
+	}
+}
+
+// Total returns the sum of the category totals.
+func (ct CategoryTotals) Total() sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	for _, amount := range []sdkmath.Int{ct.Staking, ct.FundedAddresses, ct.CommunityPool, ct.Dust, ct.StrategicReserve} {
+		if !amount.IsNil() {
+			total = total.Add(amount)
+		}
+	}
+	return total
+}
+
+// Validate checks the category totals are not negative.
+func (ct CategoryTotals) Validate() error {
+	for _, total := range []struct {
+		name   string
+		amount sdkmath.Int
+	}{
+		{name: CategoryStaking, amount: ct.Staking},
+		{name: CategoryFundedAddresses, amount: ct.FundedAddresses},
+		{name: CategoryCommunityPool, amount: ct.CommunityPool},
+		{name: CounterDust, amount: ct.Dust},
+		{name: CategoryStrategicReserve, amount: ct.StrategicReserve},
+	} {
+		if !total.amount.IsNil() && total.amount.IsNegative() {
+			return fmt.Errorf("cumulative distributed %s should not be negative, is %s", total.name, total.amount)
+		}
+	}
+	return nil
+}
+
+// TotalAmount returns the sum of the amounts of the coins regardless of their denom.
+func TotalAmount(coins sdk.Coins) sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	for _, coin := range coins {
+		total = total.Add(coin.Amount)
+	}
+	return total
+}
+
+const (
+	// DistributionHistoryRetention is the number of blocks the allocations of the minted coins are kept
+	DistributionHistoryRetention = 10000
+
+	// DistributionHistoryMaxLimit is the maximum number of allocations returned per request, the
+	// next allocations are returned with the next key of the page
+	DistributionHistoryMaxLimit = 100
+)
+
+// NewBlockDistribution returns the allocation of the coins minted in a block from the category
+// totals before and after the distribution, the nil totals count as zero.
+func NewBlockDistribution(
+	height int64,
+	blockTime time.Time,
+	inflation sdk.Dec,
+	minted sdkmath.Int,
+	before, after CategoryTotals,
+) BlockDistribution {
+	before.Normalize()
+	after.Normalize()
+	return BlockDistribution{
+		Height:    height,
+		Time:      blockTime,
+		Inflation: inflation,
+		Minted:    minted,
+		Distributed: CategoryTotals{
+			Staking:          after.Staking.Sub(before.Staking),
+			FundedAddresses:  after.FundedAddresses.Sub(before.FundedAddresses),
+			CommunityPool:    after.CommunityPool.Sub(before.CommunityPool),
+			Dust:             after.Dust.Sub(before.Dust),
+			StrategicReserve: after.StrategicReserve.Sub(before.StrategicReserve),
+		},
+	}
+}
+
+// IsTimed returns true if the allocation has the time and the inflation rate of the block, they
+// are not set for the blocks recorded before they were tracked
+func (d BlockDistribution) IsTimed() bool {
+	return !d.Time.IsZero() && !d.Inflation.IsNil()
+}
+
+// AddCommunityPoolFunding adds the amounts of the sources of a community pool funding to the
+// cumulative community pool funding of their label, the labels are kept in the order they are
+// first funded.
+func (m *Minter) AddCommunityPoolFunding(sources CommunityPoolSources) {
+	for _, source := range sources {
+		found := false
+		for i := range m.CumulativeCommunityPoolFunding {
+			if total := &m.CumulativeCommunityPoolFunding[i]; total.Label == source.Label {
+				total.Amount = total.Amount.Add(source.Amount...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.CumulativeCommunityPoolFunding = append(m.CumulativeCommunityPoolFunding, CommunityPoolFundingTotal{
+				Label:  source.Label,
+				Amount: source.Amount,
+			})
+		}
+	}
+}
+
+// CommunityPoolFundingOf returns the cumulative amount sent to the community pool with a label
+func (m Minter) CommunityPoolFundingOf(label string) sdk.Coins {
+	for _, total := range m.CumulativeCommunityPoolFunding {
+		if total.Label == label {
+			return total.Amount
+		}
+	}
+	return sdk.NewCoins()
+}
+
+// validateCommunityPoolFunding checks that the cumulative community pool funding has valid
+// amounts for distinct labels of the community pool sources
+func validateCommunityPoolFunding(totals []CommunityPoolFundingTotal) error {
+	labels := make(map[string]bool, len(totals))
+	for _, total := range totals {
+		known := false
+		for _, label := range CommunityPoolLabels {
+			known = known || label == total.Label
+		}
+		if !known {
+			return fmt.Errorf("unknown community pool funding label %q", total.Label)
+		}
+		if labels[total.Label] {
+			return fmt.Errorf("duplicated community pool funding label %q", total.Label)
+		}
+		labels[total.Label] = true
+		if err := total.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid community pool funding of label %s: %w", total.Label, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestAddCommunityPoolFunding(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("foo", amount))
+	}
+
+	minter := types.DefaultInitialMinter()
+	minter.AddCommunityPoolFunding(types.CommunityPoolSources{}.
+		Add(types.CommunityPoolSourceShare, coins(10)).
+		Add(types.CommunityPoolSourceRedirectedStaking, coins(5)).
+		Add(types.CommunityPoolSourceReleasedCommunityPool, coins(3)))
+	minter.AddCommunityPoolFunding(types.CommunityPoolSources{}.
+		Add(types.CommunityPoolSourceDust, coins(1)).
+		Add(types.CommunityPoolSourceFloorMinted, coins(2)).
+		Add(types.CommunityPoolSourceUnallocatedFunded, coins(4)).
+		Add(types.CommunityPoolSourceOutOfWindowFunded, coins(6)).
+		Add(types.CommunityPoolSourceMissingFeeCollector, coins(7)))
+
+	require.Equal(t, []types.CommunityPoolFundingTotal{
+		{Label: types.CommunityPoolLabelConfiguredShare, Amount: coins(12)},
+		{Label: types.CommunityPoolLabelPausedCategory, Amount: coins(8)},
+		{Label: types.CommunityPoolLabelRemainderDust, Amount: coins(1)},
+		{Label: types.CommunityPoolLabelEmptyFundedList, Amount: coins(10)},
+		{Label: types.CommunityPoolLabelBlockedRecipient, Amount: coins(7)},
+	}, minter.CumulativeCommunityPoolFunding)
+	require.Equal(t, coins(8), minter.CommunityPoolFundingOf(types.CommunityPoolLabelPausedCategory))
+	require.NoError(t, minter.Validate())
+
+	t.Run("should return no coins for a label not funded", func(t *testing.T) {
+		require.True(t, types.DefaultInitialMinter().CommunityPoolFundingOf(types.CommunityPoolLabelRemainderDust).IsZero())
+	})
+
+	t.Run("should prevent validate for unknown label", func(t *testing.T) {
+		invalid := types.DefaultInitialMinter()
+		invalid.CumulativeCommunityPoolFunding = []types.CommunityPoolFundingTotal{{Label: "foo", Amount: coins(1)}}
+		require.Error(t, invalid.Validate())
+	})
+
+	t.Run("should prevent validate for duplicated label", func(t *testing.T) {
+		invalid := types.DefaultInitialMinter()
+		invalid.CumulativeCommunityPoolFunding = []types.CommunityPoolFundingTotal{
+			{Label: types.CommunityPoolLabelConfiguredShare, Amount: coins(1)},
+			{Label: types.CommunityPoolLabelConfiguredShare, Amount: coins(2)},
+		}
+		require.Error(t, invalid.Validate())
+	})
+
+	t.Run("should prevent validate for invalid amount", func(t *testing.T) {
+		invalid := types.DefaultInitialMinter()
+		invalid.CumulativeCommunityPoolFunding = []types.CommunityPoolFundingTotal{
+			{Label: types.CommunityPoolLabelConfiguredShare, Amount: sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}}},
+		}
+		require.Error(t, invalid.Validate())
+	})
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDriftCorrection returns a drift correction bounded by the max factor of the block provision
+// closing the drift over the horizon
+func NewDriftCorrection(maxFactor sdk.Dec, horizon uint64) DriftCorrection {
+	return DriftCorrection{
+		MaxFactor: maxFactor,
+		Horizon:   horizon,
+	}
+}
+
+// Enabled returns true if the block provisions are corrected
+func (dc DriftCorrection) Enabled() bool {
+	return !dc.MaxFactor.IsNil() && dc.MaxFactor.IsPositive()
+}
+
+// EmissionDrift returns the drift of the cumulative minted amount from the target emissions and
+// the drift relative to the target emissions, zero if there is no target emission yet
+func (m Minter) EmissionDrift() (drift, relative sdk.Dec) {
+	drift = sdk.NewDecFromInt(m.CumulativeMinted).Sub(m.TargetCumulativeEmission)
+	if !m.TargetCumulativeEmission.IsPositive() {
+		return drift, sdk.ZeroDec()
+	}
+	return drift, drift.Quo(m.TargetCumulativeEmission)
+}
+
+// DriftCorrection returns the correction added to the exact block provision to close the drift
+// of the realized emissions over the horizon of the drift correction. The carry buffer is minted
+// later so it is not part of the gap to close. The gap of a block is rounded up to a whole coin
+// to absorb the truncation of the minted coins, the correction is bounded by the max factor of
+// the provision and the corrected provision never exceeds the provision at the max inflation.
+func (m Minter) DriftCorrection(params Params, stakingSupply sdkmath.Int) sdk.Dec {
+	dc := params.DriftCorrection
+	if !dc.Enabled() {
+		return sdk.ZeroDec()
+	}
+
+	provision := m.ExactBlockProvision(params)
+	gap := m.TargetCumulativeEmission.Sub(sdk.NewDecFromInt(m.CumulativeMinted)).Sub(m.CarryBuffer)
+	correction := gap.QuoInt64(int64(dc.Horizon)).Ceil()
+
+	bound := provision.Mul(dc.MaxFactor)
+	if correction.GT(bound) {
+		correction = bound
+	}
+	if correction.LT(bound.Neg()) {
+		correction = bound.Neg()
+	}
+
+	maxProvision := params.InflationMax.MulInt(stakingSupply).QuoInt(sdkmath.NewInt(int64(params.BlocksPerYear)))
+	if correction.IsPositive() && provision.Add(correction).GT(maxProvision) {
+		correction = sdk.MaxDec(maxProvision.Sub(provision), sdk.ZeroDec())
+	}
+	return correction
+}
+
+// driftCorrection returns the correction added to the exact provision of consecutive blocks, the
+// correction of a block for each block bounded by the drift so the blocks don't overshoot it
+func (m Minter) driftCorrection(params Params, stakingSupply sdkmath.Int, blocks uint64) sdk.Dec {
+	correction := m.DriftCorrection(params, stakingSupply)
+	if blocks <= 1 {
+		return correction
+	}
+	correction = correction.MulInt64(int64(blocks))
+	gap := m.TargetCumulativeEmission.Sub(sdk.NewDecFromInt(m.CumulativeMinted)).Sub(m.CarryBuffer)
+	if correction.Abs().GT(gap.Abs()) {
+		return gap
+	}
+	return correction
+}
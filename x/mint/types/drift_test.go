@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMinterEmissionDrift(t *testing.T) {
+	minter := types.DefaultInitialMinter()
+	drift, relative := minter.EmissionDrift()
+	require.True(t, drift.IsZero())
+	require.True(t, relative.IsZero())
+
+	minter.CumulativeMinted = sdkmath.NewInt(990)
+	minter.TargetCumulativeEmission = sdk.NewDec(1000)
+	drift, relative = minter.EmissionDrift()
+	require.Equal(t, sdk.NewDec(-10), drift)
+	require.Equal(t, sdk.NewDecWithPrec(-1, 2), relative)
+}
+
+func TestMinterDriftCorrection(t *testing.T) {
+	// the exact block provision is 100 and the provision at the max inflation is 200
+	params := types.DefaultParams()
+	params.InflationMax = sdk.NewDecWithPrec(2, 1)
+	params.BlocksPerYear = 10
+	params.DriftCorrection = types.NewDriftCorrection(sdk.NewDecWithPrec(9, 1), 10)
+	stakingSupply := sdkmath.NewInt(10000)
+	newMinter := func(minted, target, carry int64) types.Minter {
+		minter := types.NewMinter(sdk.NewDecWithPrec(1, 1), sdk.NewDec(1000))
+		minter.CumulativeMinted = sdkmath.NewInt(minted)
+		minter.TargetCumulativeEmission = sdk.NewDec(target)
+		minter.CarryBuffer = sdk.NewDec(carry)
+		return minter
+	}
+
+	tests := []struct {
+		name     string
+		minter   types.Minter
+		params   func(types.Params) types.Params
+		expected sdk.Dec
+	}{
+		{
+			name:     "should close the drift over the horizon",
+			minter:   newMinter(500, 1000, 0),
+			expected: sdk.NewDec(50),
+		},
+		{
+			name:     "should reduce the provision when too much has been minted",
+			minter:   newMinter(1500, 1000, 0),
+			expected: sdk.NewDec(-50),
+		},
+		{
+			name:     "should round up the correction to a whole coin",
+			minter:   newMinter(995, 1000, 0),
+			expected: sdk.OneDec(),
+		},
+		{
+			name:     "should exclude the carry buffer from the drift",
+			minter:   newMinter(500, 1000, 400),
+			expected: sdk.NewDec(10),
+		},
+		{
+			name:   "should bound the correction by the max factor",
+			minter: newMinter(0, 10000, 0),
+			params: func(p types.Params) types.Params {
+				p.DriftCorrection.MaxFactor = sdk.NewDecWithPrec(2, 1)
+				return p
+			},
+			expected: sdk.NewDec(20),
+		},
+		{
+			name:   "should bound the negative correction by the max factor",
+			minter: newMinter(10000, 0, 0),
+			params: func(p types.Params) types.Params {
+				p.DriftCorrection.MaxFactor = sdk.NewDecWithPrec(2, 1)
+				return p
+			},
+			expected: sdk.NewDec(-20),
+		},
+		{
+			name:   "should not exceed the provision at the max inflation",
+			minter: newMinter(0, 10000, 0),
+			params: func(p types.Params) types.Params {
+				p.InflationMax = sdk.NewDecWithPrec(105, 3)
+				return p
+			},
+			expected: sdk.NewDec(5),
+		},
+		{
+			name:   "should not correct when the inflation is the max inflation",
+			minter: newMinter(0, 10000, 0),
+			params: func(p types.Params) types.Params {
+				p.InflationMax = sdk.NewDecWithPrec(1, 1)
+				return p
+			},
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name:   "should not correct when disabled",
+			minter: newMinter(500, 1000, 0),
+			params: func(p types.Params) types.Params {
+				p.DriftCorrection.MaxFactor = sdk.ZeroDec()
+				return p
+			},
+			expected: sdk.ZeroDec(),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := params
+			if tc.params != nil {
+				p = tc.params(p)
+			}
+			require.True(t, tc.expected.Equal(tc.minter.DriftCorrection(p, stakingSupply)))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEffectiveParams returns the values derived from valid params once applied to the minter at
+// the bonded ratio
+func NewEffectiveParams(minter Minter, params Params, bondedRatio sdk.Dec) EffectiveParams {
+	proportions, buffered := EffectiveProportions(params)
+	nextInflation := minter.NextInflationRate(params, bondedRatio)
+
+	inflationClamp := ""
+	switch unboundedInflation := minter.unboundedNextInflationRate(params, bondedRatio); {
+	case unboundedInflation.GT(params.InflationMax):
+		inflationClamp = string(KeyInflationMax)
+	case unboundedInflation.LT(params.InflationMin):
+		inflationClamp = string(KeyInflationMin)
+	}
+
+	return EffectiveParams{
+		Params:                    params,
+		NormalizedFundedAddresses: NormalizeWeights(params.FundedAddresses),
+		Proportions:               proportions,
+		BufferedProportion:        buffered,
+		NextInflation:             nextInflation,
+		InflationClamp:            inflationClamp,
+	}
+}
+
+// EffectiveProportions returns the proportions of the minted coins distributed to each category
+// and the proportion held in the minter, following the redirections of ProjectShares
+func EffectiveProportions(params Params) (proportions DistributionProportions, buffered sdk.Dec) {
+	proportions = params.DistributionProportions
+	proportions.StrategicReserve = proportions.StrategicReserveRatio()
+	buffered = sdk.ZeroDec()
+
+	redirect := func(share *sdk.Dec, paused bool) {
+		if !paused {
+			return
+		}
+		if params.PausedShareMode == PAUSED_SHARE_MODE_COMMUNITY_POOL {
+			proportions.CommunityPool = proportions.CommunityPool.Add(*share)
+		} else {
+			buffered = buffered.Add(*share)
+		}
+		*share = sdk.ZeroDec()
+	}
+	redirect(&proportions.Staking, params.PauseStakingShare)
+	redirect(&proportions.FundedAddresses, params.PauseFundedShare)
+	if len(params.FundedAddresses) == 0 {
+		proportions.CommunityPool = proportions.CommunityPool.Add(proportions.FundedAddresses)
+		proportions.FundedAddresses = sdk.ZeroDec()
+	}
+	if params.PauseCommunityShare {
+		buffered = buffered.Add(proportions.CommunityPool)
+		proportions.CommunityPool = sdk.ZeroDec()
+	}
+	return proportions, buffered
+}
+
+// NormalizeWeights returns the weighted addresses with their weights divided by their sum
+func NormalizeWeights(weightedAddresses []WeightedAddress) []WeightedAddress {
+	weightSum := sdk.ZeroDec()
+	for _, w := range weightedAddresses {
+		weightSum = weightSum.Add(w.Weight)
+	}
+	if !weightSum.IsPositive() {
+		return weightedAddresses
+	}
+
+	normalized := make([]WeightedAddress, len(weightedAddresses))
+	for i, w := range weightedAddresses {
+		normalized[i] = WeightedAddress{
+			Address:    w.Address,
+			Weight:     w.Weight.Quo(weightSum),
+			PayoutMode: w.PayoutMode,
+			WeightMode: w.WeightMode,
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestNewEffectiveParams(t *testing.T) {
+	minter := types.InitialMinter(sdk.NewDecWithPrec(10, 2))
+	params := types.DefaultParams()
+	params.InflationMin = sdk.NewDecWithPrec(5, 2)
+	params.InflationMax = sdk.NewDecWithPrec(20, 2)
+
+	t.Run("should not clamp the inflation within the bounds", func(t *testing.T) {
+		effective := types.NewEffectiveParams(minter, params, params.GoalBonded)
+		require.Equal(t, minter.Inflation, effective.NextInflation)
+		require.Empty(t, effective.InflationClamp)
+	})
+
+	t.Run("should report the binding max inflation", func(t *testing.T) {
+		capped := params
+		capped.InflationMax = minter.Inflation
+		effective := types.NewEffectiveParams(minter, capped, sdk.ZeroDec())
+		require.Equal(t, capped.InflationMax, effective.NextInflation)
+		require.Equal(t, string(types.KeyInflationMax), effective.InflationClamp)
+	})
+
+	t.Run("should report the binding min inflation", func(t *testing.T) {
+		floored := params
+		floored.InflationMin = minter.Inflation
+		effective := types.NewEffectiveParams(minter, floored, sdk.OneDec())
+		require.Equal(t, floored.InflationMin, effective.NextInflation)
+		require.Equal(t, string(types.KeyInflationMin), effective.InflationClamp)
+	})
+}
+
+func TestEffectiveProportions(t *testing.T) {
+	proportions := types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(5, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(3, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	fundedAddresses := []types.WeightedAddress{{Address: sample.Address(sample.Rand()), Weight: sdk.OneDec()}}
+
+	tests := []struct {
+		name        string
+		params      func(*types.Params)
+		expected    types.DistributionProportions
+		expectedBuf sdk.Dec
+	}{
+		{
+			name:        "should keep the proportions",
+			params:      func(*types.Params) {},
+			expected:    proportions,
+			expectedBuf: sdk.ZeroDec(),
+		},
+		{
+			name: "should send the funded addresses share to the community pool without funded address",
+			params: func(p *types.Params) {
+				p.FundedAddresses = nil
+			},
+			expected: types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(5, 1),
+				FundedAddresses:  sdk.ZeroDec(),
+				CommunityPool:    sdk.NewDecWithPrec(5, 1),
+				StrategicReserve: sdk.ZeroDec(),
+			},
+			expectedBuf: sdk.ZeroDec(),
+		},
+		{
+			name: "should redirect the paused shares to the community pool",
+			params: func(p *types.Params) {
+				p.PauseStakingShare = true
+			},
+			expected: types.DistributionProportions{
+				Staking:          sdk.ZeroDec(),
+				FundedAddresses:  sdk.NewDecWithPrec(3, 1),
+				CommunityPool:    sdk.NewDecWithPrec(7, 1),
+				StrategicReserve: sdk.ZeroDec(),
+			},
+			expectedBuf: sdk.ZeroDec(),
+		},
+		{
+			name: "should buffer the paused shares",
+			params: func(p *types.Params) {
+				p.PauseFundedShare = true
+				p.PauseCommunityShare = true
+				p.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+			},
+			expected: types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(5, 1),
+				FundedAddresses:  sdk.ZeroDec(),
+				CommunityPool:    sdk.ZeroDec(),
+				StrategicReserve: sdk.ZeroDec(),
+			},
+			expectedBuf: sdk.NewDecWithPrec(5, 1),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.DistributionProportions = proportions
+			params.FundedAddresses = fundedAddresses
+			tc.params(&params)
+
+			effective, buffered := types.EffectiveProportions(params)
+			require.Equal(t, tc.expected, effective)
+			require.Equal(t, tc.expectedBuf, buffered)
+		})
+	}
+}
+
+func TestNormalizeWeights(t *testing.T) {
+	addr1, addr2 := sample.Address(sample.Rand()), sample.Address(sample.Rand())
+
+	normalized := types.NormalizeWeights([]types.WeightedAddress{
+		{Address: addr1, Weight: sdk.NewDec(1)},
+		{Address: addr2, Weight: sdk.NewDec(3)},
+	})
+	require.Equal(t, []types.WeightedAddress{
+		{Address: addr1, Weight: sdk.NewDecWithPrec(25, 2)},
+		{Address: addr2, Weight: sdk.NewDecWithPrec(75, 2)},
+	}, normalized)
+	require.Empty(t, types.NormalizeWeights(nil))
+}
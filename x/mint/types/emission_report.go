@@ -0,0 +1,63 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// NewEmissionReport returns the empty emission report of a range of heights
+func NewEmissionReport(fromHeight, toHeight int64) EmissionReport {
+	return EmissionReport{
+		FromHeight:  fromHeight,
+		ToHeight:    toHeight,
+		Minted:      sdkmath.ZeroInt(),
+		Distributed: NewCategoryTotals(),
+	}
+}
+
+// ValidateEmissionReportRange checks the range of heights of an emission report is not empty and
+// ends at most at the current height, the report of the range can't change once assembled
+func ValidateEmissionReportRange(fromHeight, toHeight, currentHeight int64) error {
+	switch {
+	case fromHeight < 0:
+		return errors.Wrapf(ErrInvalidHeightRange, "from height %d is negative", fromHeight)
+	case toHeight < fromHeight:
+		return errors.Wrapf(ErrInvalidHeightRange, "to height %d is lower than from height %d", toHeight, fromHeight)
+	case toHeight > currentHeight:
+		return errors.Wrapf(ErrInvalidHeightRange, "to height %d is greater than the current height %d", toHeight, currentHeight)
+	}
+	return nil
+}
+
+// Add adds the allocation of a recorded block to the report, the allocations are added in the
+// order of their heights
+func (r *EmissionReport) Add(distribution BlockDistribution) {
+	if r.RecordedBlocks == 0 {
+		r.FirstTime = distribution.Time
+	}
+	r.RecordedBlocks++
+	r.LastTime = distribution.Time
+	if !distribution.Minted.IsNil() {
+		r.Minted = r.Minted.Add(distribution.Minted)
+	}
+	r.Distributed = r.Distributed.Add(distribution.Distributed)
+}
+
+// CanonicalBytes returns the canonical encoding of the report, its canonical JSON representation,
+// see Params.MarshalCanonicalJSON for the format
+func (r EmissionReport) CanonicalBytes() ([]byte, error) {
+	return marshalCanonicalJSON(&r)
+}
+
+// Hash returns the SHA-256 hash of the canonical encoding of the report
+func (r EmissionReport) Hash() ([]byte, error) {
+	bz, err := r.CanonicalBytes()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
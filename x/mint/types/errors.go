@@ -0,0 +1,61 @@
+package types
+
+// DONTCOVER
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// x/mint module sentinel errors
+var (
+	ErrUnauthorized          = errors.RegisterWithGRPCCode(ModuleName, 2, codes.PermissionDenied, "unauthorized authority")
+	ErrInvalidParams         = errors.RegisterWithGRPCCode(ModuleName, 3, codes.InvalidArgument, "invalid params")
+	ErrInvalidProportions    = errors.RegisterWithGRPCCode(ModuleName, 4, codes.InvalidArgument, "invalid distribution proportions")
+	ErrInvalidWeightSum      = errors.RegisterWithGRPCCode(ModuleName, 5, codes.InvalidArgument, "invalid funded address weight sum")
+	ErrInvalidPauseTarget    = errors.RegisterWithGRPCCode(ModuleName, 6, codes.InvalidArgument, "invalid pause target")
+	ErrNoParamChange         = errors.RegisterWithGRPCCode(ModuleName, 7, codes.FailedPrecondition, "no param change")
+	ErrModuleAccountNotSet   = errors.RegisterWithGRPCCode(ModuleName, 8, codes.Internal, "the mint module account has not been set")
+	ErrMintFailed            = errors.RegisterWithGRPCCode(ModuleName, 9, codes.Internal, "minting failed")
+	ErrDistributionFailed    = errors.RegisterWithGRPCCode(ModuleName, 10, codes.Internal, "distribution of the minted coins failed")
+	ErrInvalidFundedAddress  = errors.RegisterWithGRPCCode(ModuleName, 11, codes.InvalidArgument, "invalid funded address")
+	ErrInvalidGoalBonded     = errors.RegisterWithGRPCCode(ModuleName, 12, codes.InvalidArgument, "invalid goal bonded")
+	ErrValidatorNotFound     = errors.RegisterWithGRPCCode(ModuleName, 13, codes.NotFound, "validator not found")
+	ErrNoBondedTokens        = errors.RegisterWithGRPCCode(ModuleName, 14, codes.FailedPrecondition, "no bonded tokens")
+	ErrInvalidChainID        = errors.RegisterWithGRPCCode(ModuleName, 15, codes.InvalidArgument, "invalid expected chain-id")
+	ErrChainIDMismatch       = errors.RegisterWithGRPCCode(ModuleName, 16, codes.FailedPrecondition, "chain-id mismatch")
+	ErrUnknownProfile        = errors.RegisterWithGRPCCode(ModuleName, 17, codes.NotFound, "unknown profile")
+	ErrInvalidProfile        = errors.RegisterWithGRPCCode(ModuleName, 18, codes.InvalidArgument, "invalid profile")
+	ErrNoPendingPayout       = errors.RegisterWithGRPCCode(ModuleName, 19, codes.FailedPrecondition, "no pending payout to claim")
+	ErrLargeChange           = errors.RegisterWithGRPCCode(ModuleName, 20, codes.FailedPrecondition, "unacknowledged large params change")
+	ErrSchemaVersion         = errors.RegisterWithGRPCCode(ModuleName, 21, codes.FailedPrecondition, "unexpected store schema version")
+	ErrUnrepairableStore     = errors.RegisterWithGRPCCode(ModuleName, 22, codes.DataLoss, "unrepairable store")
+	ErrPayoutRestricted      = errors.RegisterWithGRPCCode(ModuleName, 23, codes.PermissionDenied, "payout blocked by the send restriction")
+	ErrInvalidHeightRange    = errors.RegisterWithGRPCCode(ModuleName, 24, codes.InvalidArgument, "invalid height range")
+	ErrFeeCollectorNotFound  = errors.RegisterWithGRPCCode(ModuleName, 25, codes.NotFound, "fee collector module account not found")
+	ErrBeginBlockerDryRun    = errors.RegisterWithGRPCCode(ModuleName, 26, codes.InvalidArgument, "params fail the begin blocker dry-run")
+	ErrInvalidTokenomics     = errors.RegisterWithGRPCCode(ModuleName, 27, codes.InvalidArgument, "invalid tokenomics spec")
+	ErrInsufficientHistory   = errors.RegisterWithGRPCCode(ModuleName, 28, codes.OutOfRange, "insufficient history")
+	ErrInvalidBurn           = errors.RegisterWithGRPCCode(ModuleName, 29, codes.InvalidArgument, "invalid burn")
+	ErrModuleOrder           = errors.RegisterWithGRPCCode(ModuleName, 30, codes.FailedPrecondition, "invalid module order")
+	ErrInsufficientReserve   = errors.RegisterWithGRPCCode(ModuleName, 31, codes.FailedPrecondition, "insufficient strategic reserve")
+	ErrInvalidSDKMintStore   = errors.RegisterWithGRPCCode(ModuleName, 32, codes.DataLoss, "invalid cosmos-sdk x/mint store")
+	ErrBootstrapEnded        = errors.RegisterWithGRPCCode(ModuleName, 33, codes.FailedPrecondition, "bootstrap override ended")
+	ErrFundedAddressNotFound = errors.RegisterWithGRPCCode(ModuleName, 34, codes.NotFound, "funded address not found")
+	ErrInvalidFunding        = errors.RegisterWithGRPCCode(ModuleName, 35, codes.InvalidArgument, "invalid minter funding")
+	ErrAutoPaused            = errors.RegisterWithGRPCCode(ModuleName, 36, codes.FailedPrecondition, "minting auto paused")
+	ErrMaintenanceTooSoon    = errors.RegisterWithGRPCCode(ModuleName, 37, codes.ResourceExhausted, "maintenance run too soon")
+	ErrInvalidBasisPoints    = errors.RegisterWithGRPCCode(ModuleName, 38, codes.InvalidArgument, "invalid basis points")
+	ErrMinCommunityPoolShare = errors.RegisterWithGRPCCode(ModuleName, 39, codes.FailedPrecondition, "unacknowledged min community pool share change")
+	ErrGoalBondedPhase       = errors.RegisterWithGRPCCode(ModuleName, 40, codes.FailedPrecondition, "goal bonded set by the active phase")
+)
+
+// InvalidParamsError wraps a params validation error with ErrInvalidParams, the errors already
+// wrapping a more specific sentinel error of the module keep it
+func InvalidParamsError(err error) error {
+	if errors.IsOf(err, ErrInvalidProportions, ErrInvalidWeightSum, ErrInvalidFundedAddress) {
+		return errors.Wrap(err, "invalid params")
+	}
+	return errors.Wrap(ErrInvalidParams, err.Error())
+}
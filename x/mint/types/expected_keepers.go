@@ -4,23 +4,41 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // StakingKeeper defines the expected staking keeper
 type StakingKeeper interface {
 	StakingTokenSupply(ctx sdk.Context) sdkmath.Int
 	BondedRatio(ctx sdk.Context) sdk.Dec
+	BondDenom(ctx sdk.Context) string
+	TotalBondedTokens(ctx sdk.Context) sdkmath.Int
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int
 }
 
+// SupplySource defines an alternative source of the staked supply, for example including the
+// tokens staked through liquid staking derivatives
+type SupplySource interface {
+	EffectiveStakedSupply(ctx sdk.Context) sdkmath.Int
+}
+
+// SendRestrictionFn is a send restriction with the signature of the bank keeper send restrictions,
+// it returns the address receiving the coins or an error if the send is blocked
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
 // AccountKeeper defines the contract required for account APIs.
 type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
+	GetParams(ctx sdk.Context) types.Params
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper.
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetCommunityTax(ctx sdk.Context) sdk.Dec
 }
 
 // BankKeeper defines the contract needed to be fulfilled for banking and supply
@@ -28,5 +46,10 @@ type DistrKeeper interface {
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 }
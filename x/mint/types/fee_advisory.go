@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateFeeCoverageRatio checks the target ratio of the staking provision covered by the fees
+// is in (0, 1], the fees can't be sustainable beyond replacing the whole staking provision
+func ValidateFeeCoverageRatio(ratio sdk.Dec) error {
+	if ratio.IsNil() {
+		return errors.New("fee coverage ratio cannot be nil")
+	}
+	if !ratio.IsPositive() {
+		return fmt.Errorf("fee coverage ratio must be positive: %s", ratio)
+	}
+	if ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee coverage ratio must be lower than or equal to 1: %s", ratio)
+	}
+	return nil
+}
+
+// StakingBlockProvision returns the staking share of the exact block provision, the staking share
+// follows the redirections of the paused shares. The provision is zero while minting is paused.
+func StakingBlockProvision(minter Minter, params Params) sdk.Dec {
+	if params.PauseMinting {
+		return sdk.ZeroDec()
+	}
+	proportions, _ := EffectiveProportions(params)
+	return minter.ExactBlockProvision(params).Mul(proportions.Staking)
+}
+
+// AdvisoryMinGasPrice returns the price per gas unit in the mint denom for the fees of a block
+// using blockGas gas to cover the coverage ratio of the staking share of the block provision.
+// The price is advisory, to inform the configuration of the validators, it is not used by
+// consensus.
+func AdvisoryMinGasPrice(minter Minter, params Params, coverageRatio sdk.Dec, blockGas uint64) (sdk.DecCoin, error) {
+	if err := ValidateFeeCoverageRatio(coverageRatio); err != nil {
+		return sdk.DecCoin{}, err
+	}
+	if blockGas == 0 {
+		return sdk.DecCoin{}, errors.New("block gas must be positive")
+	}
+	fees := StakingBlockProvision(minter, params).Mul(coverageRatio)
+	return sdk.NewDecCoinFromDec(params.MintDenom, fees.QuoInt(sdkmath.NewIntFromUint64(blockGas))), nil
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestAdvisoryMinGasPrice(t *testing.T) {
+	// the block provision is 1000 tokens, the staking share is 300 tokens
+	minter := types.NewMinter(sdk.NewDecWithPrec(10, 2), sdk.NewDec(100_000))
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+
+	tests := []struct {
+		name     string
+		params   func(*types.Params)
+		ratio    sdk.Dec
+		blockGas uint64
+		expected sdk.Dec
+		err      bool
+	}{
+		{
+			name:     "should return the price of the fees covering the staking share",
+			ratio:    sdk.OneDec(),
+			blockGas: 1_000_000,
+			expected: sdk.NewDecWithPrec(3, 4),
+		},
+		{
+			name:     "should return the price of the fees covering a ratio of the staking share",
+			ratio:    sdk.NewDecWithPrec(5, 1),
+			blockGas: 1_000_000,
+			expected: sdk.NewDecWithPrec(15, 5),
+		},
+		{
+			name: "should return a zero price while the staking share is paused",
+			params: func(p *types.Params) {
+				p.PauseStakingShare = true
+			},
+			ratio:    sdk.OneDec(),
+			blockGas: 1000,
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name: "should return a zero price while minting is paused",
+			params: func(p *types.Params) {
+				p.PauseMinting = true
+			},
+			ratio:    sdk.OneDec(),
+			blockGas: 1000,
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name:     "should prevent a zero ratio",
+			ratio:    sdk.ZeroDec(),
+			blockGas: 1000,
+			err:      true,
+		},
+		{
+			name:     "should prevent a negative ratio",
+			ratio:    sdk.NewDec(-1),
+			blockGas: 1000,
+			err:      true,
+		},
+		{
+			name:     "should prevent a ratio above 1",
+			ratio:    sdk.OneDec().Add(sdk.SmallestDec()),
+			blockGas: 1000,
+			err:      true,
+		},
+		{
+			name:     "should prevent a nil ratio",
+			ratio:    sdk.Dec{},
+			blockGas: 1000,
+			err:      true,
+		},
+		{
+			name:  "should prevent a zero block gas",
+			ratio: sdk.OneDec(),
+			err:   true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := params
+			if tc.params != nil {
+				tc.params(&params)
+			}
+			price, err := types.AdvisoryMinGasPrice(minter, params, tc.ratio, tc.blockGas)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, params.MintDenom, price.Denom)
+			require.True(t, tc.expected.Equal(price.Amount), "expected %s, got %s", tc.expected, price.Amount)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FundedAddressHistoryRetention is the maximum number of weight changes kept per funded address
+	FundedAddressHistoryRetention = 100
+
+	// FundedAddressHistoryMaxLimit is the maximum number of weight changes returned per request,
+	// the next changes are returned with the next key of the page
+	FundedAddressHistoryMaxLimit = 50
+
+	// WeightChangeAdded is the action of a funded address added to the params
+	WeightChangeAdded = "added"
+	// WeightChangeRemoved is the action of a funded address removed from the params
+	WeightChangeRemoved = "removed"
+	// WeightChangeUpdated is the action of a funded address whose weight has been updated
+	WeightChangeUpdated = "updated"
+)
+
+// DiffFundedAddresses returns the weight changes between two lists of funded addresses.
+// The comparison is independent of the order of the lists, the weights of an address
+// listed several times are summed. The changes are sorted by address.
+func DiffFundedAddresses(height int64, old, new []WeightedAddress) []FundedAddressWeightChange {
+	oldWeights, newWeights := fundedAddressWeights(old), fundedAddressWeights(new)
+
+	addresses := make([]string, 0, len(oldWeights)+len(newWeights))
+	for addr := range oldWeights {
+		addresses = append(addresses, addr)
+	}
+	for addr := range newWeights {
+		if _, ok := oldWeights[addr]; !ok {
+			addresses = append(addresses, addr)
+		}
+	}
+	sort.Strings(addresses)
+
+	var changes []FundedAddressWeightChange
+	for _, addr := range addresses {
+		oldWeight, inOld := oldWeights[addr]
+		newWeight, inNew := newWeights[addr]
+
+		change := FundedAddressWeightChange{
+			Address:   addr,
+			Height:    height,
+			OldWeight: sdk.ZeroDec(),
+			NewWeight: sdk.ZeroDec(),
+		}
+		switch {
+		case !inOld:
+			change.Action = WeightChangeAdded
+			change.NewWeight = newWeight
+		case !inNew:
+			change.Action = WeightChangeRemoved
+			change.OldWeight = oldWeight
+		case !oldWeight.Equal(newWeight):
+			change.Action = WeightChangeUpdated
+			change.OldWeight = oldWeight
+			change.NewWeight = newWeight
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// fundedAddressWeights returns the total weight of each funded address by normalized address
+func fundedAddressWeights(addresses []WeightedAddress) map[string]sdk.Dec {
+	weights := make(map[string]sdk.Dec, len(addresses))
+	for _, wa := range addresses {
+		addr := wa.Address
+		if accAddr, err := sdk.AccAddressFromBech32(addr); err == nil {
+			addr = accAddr.String()
+		}
+		weight := wa.Weight
+		if weight.IsNil() {
+			weight = sdk.ZeroDec()
+		}
+		if total, ok := weights[addr]; ok {
+			weight = total.Add(weight)
+		}
+		weights[addr] = weight
+	}
+	return weights
+}
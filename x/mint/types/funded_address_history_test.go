@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestDiffFundedAddresses(t *testing.T) {
+	r := sample.Rand()
+	addr1, addr2, addr3 := sample.Address(r), sample.Address(r), sample.Address(r)
+	weighted := func(addr string, weight int64) types.WeightedAddress {
+		return types.WeightedAddress{Address: addr, Weight: sdk.NewDecWithPrec(weight, 1)}
+	}
+	change := func(addr string, oldWeight, newWeight int64, action string) types.FundedAddressWeightChange {
+		return types.FundedAddressWeightChange{
+			Address:   addr,
+			Height:    10,
+			OldWeight: sdk.NewDecWithPrec(oldWeight, 1),
+			NewWeight: sdk.NewDecWithPrec(newWeight, 1),
+			Action:    action,
+		}
+	}
+	sorted := func(changes ...types.FundedAddressWeightChange) []types.FundedAddressWeightChange {
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].Address < changes[j].Address
+		})
+		return changes
+	}
+
+	tests := []struct {
+		name     string
+		old      []types.WeightedAddress
+		new      []types.WeightedAddress
+		expected []types.FundedAddressWeightChange
+	}{
+		{
+			name: "should return no change for the same lists",
+			old:  []types.WeightedAddress{weighted(addr1, 4), weighted(addr2, 6)},
+			new:  []types.WeightedAddress{weighted(addr1, 4), weighted(addr2, 6)},
+		},
+		{
+			name: "should return no change for reordered lists",
+			old:  []types.WeightedAddress{weighted(addr1, 4), weighted(addr2, 6)},
+			new:  []types.WeightedAddress{weighted(addr2, 6), weighted(addr1, 4)},
+		},
+		{
+			name:     "should return the added addresses",
+			new:      []types.WeightedAddress{weighted(addr1, 4), weighted(addr2, 6)},
+			expected: sorted(change(addr1, 0, 4, types.WeightChangeAdded), change(addr2, 0, 6, types.WeightChangeAdded)),
+		},
+		{
+			name:     "should return the removed addresses",
+			old:      []types.WeightedAddress{weighted(addr1, 4), weighted(addr2, 6)},
+			new:      []types.WeightedAddress{weighted(addr2, 10)},
+			expected: sorted(change(addr1, 4, 0, types.WeightChangeRemoved), change(addr2, 6, 10, types.WeightChangeUpdated)),
+		},
+		{
+			name: "should return the changes of reordered lists",
+			old:  []types.WeightedAddress{weighted(addr1, 4), weighted(addr2, 6)},
+			new:  []types.WeightedAddress{weighted(addr3, 2), weighted(addr2, 5), weighted(addr1, 3)},
+			expected: sorted(
+				change(addr1, 4, 3, types.WeightChangeUpdated),
+				change(addr2, 6, 5, types.WeightChangeUpdated),
+				change(addr3, 0, 2, types.WeightChangeAdded),
+			),
+		},
+		{
+			name: "should sum the weights of duplicated addresses",
+			old:  []types.WeightedAddress{weighted(addr1, 2), weighted(addr1, 2), weighted(addr2, 6)},
+			new:  []types.WeightedAddress{weighted(addr1, 4), weighted(addr2, 3), weighted(addr2, 3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.DiffFundedAddresses(10, tt.old, tt.new))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// AddFundedAddress returns the funded addresses with the address added, the weights of the other
+// funded addresses are scaled down so the weights still sum to 1
+func AddFundedAddress(addresses []WeightedAddress, added WeightedAddress) ([]WeightedAddress, error) {
+	addr, err := NormalizeAddress(added.Address)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidFundedAddress, "invalid address %s: %s", added.Address, err)
+	}
+	if fundedAddressIndex(addresses, addr) >= 0 {
+		return nil, errors.Wrapf(ErrInvalidFundedAddress, "address %s is already funded", addr)
+	}
+	if err := validateFundedAddressWeight(added.Weight); err != nil {
+		return nil, err
+	}
+	added.Address = addr
+
+	updated := append(append(make([]WeightedAddress, 0, len(addresses)+1), addresses...), added)
+	if err := scaleWeights(updated, len(updated)-1); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// RemoveFundedAddress returns the funded addresses with the address removed, the weights of the
+// remaining funded addresses are scaled up so the weights still sum to 1. Removing the last funded
+// address sends the funded addresses share to the community pool.
+func RemoveFundedAddress(addresses []WeightedAddress, address string) ([]WeightedAddress, error) {
+	i, err := findFundedAddress(addresses, address)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := append(append(make([]WeightedAddress, 0, len(addresses)-1), addresses[:i]...), addresses[i+1:]...)
+	if len(updated) == 0 {
+		return updated, nil
+	}
+	if err := scaleWeights(updated, -1); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// SetFundedAddressWeight returns the funded addresses with the weight of the address set, the
+// weights of the other funded addresses are scaled so the weights still sum to 1
+func SetFundedAddressWeight(addresses []WeightedAddress, address string, weight sdk.Dec) ([]WeightedAddress, error) {
+	i, err := findFundedAddress(addresses, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFundedAddressWeight(weight); err != nil {
+		return nil, err
+	}
+
+	updated := append(make([]WeightedAddress, 0, len(addresses)), addresses...)
+	updated[i].Weight = weight
+	if err := scaleWeights(updated, i); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// scaleWeights scales the weights of the funded addresses, except the weight at the fixed index,
+// so the weights sum to 1. The truncation remainder of the scaling is added to the last scaled
+// weight. A negative fixed index scales all the weights.
+func scaleWeights(addresses []WeightedAddress, fixed int) error {
+	target, sum := sdk.OneDec(), sdk.ZeroDec()
+	last := -1
+	for i, w := range addresses {
+		if i == fixed {
+			target = target.Sub(w.Weight)
+			continue
+		}
+		sum = sum.Add(w.Weight)
+		last = i
+	}
+	if last < 0 {
+		if !target.IsZero() {
+			return errors.Wrapf(
+				ErrInvalidWeightSum,
+				"the weight of the only funded address %s must be 1, got %s",
+				addresses[fixed].Address, addresses[fixed].Weight,
+			)
+		}
+		return nil
+	}
+	if !target.IsPositive() {
+		return errors.Wrapf(
+			ErrInvalidWeightSum,
+			"the weight %s of %s leaves no weight to the other funded addresses",
+			addresses[fixed].Weight, addresses[fixed].Address,
+		)
+	}
+	if !sum.IsPositive() {
+		return errors.Wrap(ErrInvalidWeightSum, "the funded addresses have no weight to scale")
+	}
+
+	scaled := sdk.ZeroDec()
+	for i := range addresses {
+		if i == fixed || i == last {
+			continue
+		}
+		addresses[i].Weight = addresses[i].Weight.Mul(target).QuoTruncate(sum)
+		scaled = scaled.Add(addresses[i].Weight)
+	}
+	addresses[last].Weight = target.Sub(scaled)
+	for _, w := range addresses {
+		if !w.Weight.IsPositive() {
+			return errors.Wrapf(ErrInvalidWeightSum, "the weight of %s is scaled down to zero", w.Address)
+		}
+	}
+	return nil
+}
+
+// validateFundedAddressWeight checks the weight of a funded address is in (0, 1]
+func validateFundedAddressWeight(weight sdk.Dec) error {
+	if weight.IsNil() || !weight.IsPositive() || weight.GT(sdk.OneDec()) {
+		return errors.Wrapf(ErrInvalidWeightSum, "the weight %s must be in (0, 1]", weight)
+	}
+	return nil
+}
+
+// findFundedAddress returns the index of the address in the funded addresses, the address is
+// compared in its canonical form
+func findFundedAddress(addresses []WeightedAddress, address string) (int, error) {
+	addr, err := NormalizeAddress(address)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalidFundedAddress, "invalid address %s: %s", address, err)
+	}
+	i := fundedAddressIndex(addresses, addr)
+	if i < 0 {
+		return 0, errors.Wrap(ErrFundedAddressNotFound, addr)
+	}
+	return i, nil
+}
+
+// fundedAddressIndex returns the index of the canonical address in the funded addresses, -1 if the
+// address is not funded
+func fundedAddressIndex(addresses []WeightedAddress, addr string) int {
+	for i, w := range addresses {
+		if normalized, err := NormalizeAddress(w.Address); err == nil && normalized == addr {
+			return i
+		}
+	}
+	return -1
+}
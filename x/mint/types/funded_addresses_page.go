@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// FundedAddressesMaxLimit is the maximum number of funded addresses returned per request, the next
+// funded addresses are returned with the next key of the page
+const FundedAddressesMaxLimit = 100
+
+// PaginateFundedAddresses returns the page of the funded addresses in the order of the params, or
+// in the reverse order. The key of a page is the address of its first funded address, a page is
+// requested either by key or by offset. The total is counted for the requests by offset.
+func PaginateFundedAddresses(
+	addresses []WeightedAddress,
+	pagination *query.PageRequest,
+) ([]WeightedAddress, *query.PageResponse, error) {
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	if len(pagination.Key) > 0 && pagination.Offset > 0 {
+		return nil, nil, errors.New("either offset or key is expected, got both")
+	}
+
+	ordered := addresses
+	if pagination.Reverse {
+		ordered = make([]WeightedAddress, len(addresses))
+		for i, address := range addresses {
+			ordered[len(addresses)-1-i] = address
+		}
+	}
+
+	var start uint64
+	if len(pagination.Key) > 0 {
+		addr, err := NormalizeAddress(string(pagination.Key))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid page key: %w", err)
+		}
+		i := fundedAddressIndex(ordered, addr)
+		if i < 0 {
+			return nil, nil, fmt.Errorf("funded address %s of the page key not found", addr)
+		}
+		start = uint64(i)
+	} else {
+		start = pagination.Offset
+	}
+
+	total := uint64(len(ordered))
+	if start > total {
+		start = total
+	}
+	end := total
+	if pagination.Limit > 0 && pagination.Limit < total-start {
+		end = start + pagination.Limit
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = []byte(ordered[end].Address)
+	}
+	if pagination.CountTotal && len(pagination.Key) == 0 {
+		pageRes.Total = total
+	}
+	return ordered[start:end], pageRes, nil
+}
@@ -0,0 +1,10 @@
+package types
+
+// IsFundedAt returns true if the height is in the funding window of the funded address, from its
+// start height included to its end height excluded. A zero height doesn't bound the window.
+func (w WeightedAddress) IsFundedAt(height int64) bool {
+	if w.StartHeight > 0 && height < w.StartHeight {
+		return false
+	}
+	return w.EndHeight == 0 || height < w.EndHeight
+}
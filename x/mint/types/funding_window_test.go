@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestWeightedAddressIsFundedAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  types.WeightedAddress
+		height   int64
+		expected bool
+	}{
+		{
+			name:     "should fund an address without window",
+			address:  types.WeightedAddress{},
+			height:   1,
+			expected: true,
+		},
+		{
+			name:     "should not fund before the start height",
+			address:  types.WeightedAddress{StartHeight: 10, EndHeight: 20},
+			height:   9,
+			expected: false,
+		},
+		{
+			name:     "should fund at the start height",
+			address:  types.WeightedAddress{StartHeight: 10, EndHeight: 20},
+			height:   10,
+			expected: true,
+		},
+		{
+			name:     "should fund before the end height",
+			address:  types.WeightedAddress{StartHeight: 10, EndHeight: 20},
+			height:   19,
+			expected: true,
+		},
+		{
+			name:     "should not fund at the end height",
+			address:  types.WeightedAddress{StartHeight: 10, EndHeight: 20},
+			height:   20,
+			expected: false,
+		},
+		{
+			name:     "should fund after the start height without end height",
+			address:  types.WeightedAddress{StartHeight: 10},
+			height:   1_000_000,
+			expected: true,
+		},
+		{
+			name:     "should fund before the end height without start height",
+			address:  types.WeightedAddress{EndHeight: 20},
+			height:   1,
+			expected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.address.IsFundedAt(tc.height))
+		})
+	}
+}
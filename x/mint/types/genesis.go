@@ -1,21 +1,63 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new GenesisState object
 
 // DefaultGenesis creates a default GenesisState object
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Minter: DefaultInitialMinter(),
-		Params: DefaultParams(),
+		Minter:      DefaultInitialMinter(),
+		Params:      DefaultParams(),
+		TotalMinted: sdk.NewCoins(),
 	}
 }
 
+// Normalize accepts both shapes of the params of the genesis state, the single mint denom
+// layout and the params listing the mint denom in the mint configurations
+func (gs *GenesisState) Normalize() {
+	gs.Params.NormalizeMintConfigs()
+}
+
 // Validate validates the provided genesis state to ensure the
 // expected invariants holds.
 func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := gs.Minter.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.validateTotalMinted(); err != nil {
+		return err
+	}
+	if gs.DustAccumulator != nil {
+		if err := gs.DustAccumulator.Validate(); err != nil {
+			return fmt.Errorf("invalid dust accumulator: %w", err)
+		}
+	}
+	return nil
+}
 
-	return gs.Minter.Validate()
+// validateTotalMinted checks the total minted amounts are positive and only list the denoms
+// minted by the module: the mint denom and the denoms of the mint configurations
+func (gs GenesisState) validateTotalMinted() error {
+	if err := gs.TotalMinted.Validate(); err != nil {
+		return fmt.Errorf("invalid total minted: %w", err)
+	}
+	minted := map[string]bool{gs.Params.MintDenom: true}
+	for _, config := range gs.Params.MintConfigs {
+		minted[config.MintDenom] = true
+	}
+	for _, coin := range gs.TotalMinted {
+		if !minted[coin.Denom] {
+			return fmt.Errorf("total minted denom %s is not minted by the module", coin.Denom)
+		}
+	}
+	return nil
 }
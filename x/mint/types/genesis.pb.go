@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +31,11 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// total_minted is the total amount of each denom minted by the module
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_minted,json=totalMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_minted"`
+	// dust_accumulator is the truncation remainder carried over to the next
+	// block by the carry over dust policy
+	DustAccumulator *types.Coin `protobuf:"bytes,4,opt,name=dust_accumulator,json=dustAccumulator,proto3" json:"dust_accumulator,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +85,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTotalMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDustAccumulator() *types.Coin {
+	if m != nil {
+		return m.DustAccumulator
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "modules.mint.GenesisState")
 }
@@ -85,20 +106,28 @@ func init() {
 func init() { proto.RegisterFile("modules/mint/genesis.proto", fileDescriptor_e8a2a04191f8ae45) }
 
 var fileDescriptor_e8a2a04191f8ae45 = []byte{
-	// 206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcd, 0x4f, 0x29,
-	0xcd, 0x49, 0x2d, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xca, 0xe9, 0x81, 0xe4, 0xa4, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x94, 0x38, 0x8a, 0x7e, 0x10, 0x01,
-	0x91, 0x50, 0x2a, 0xe3, 0xe2, 0x71, 0x87, 0x98, 0x16, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc4,
-	0xc5, 0x06, 0x92, 0x4d, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xd1, 0x43, 0x36,
-	0x5d, 0xcf, 0x17, 0x2c, 0xe7, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x25, 0x48, 0x4f,
-	0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0x13, 0x36, 0x3d, 0x01, 0x60, 0x39, 0x98, 0x1e, 0x88,
-	0x4a, 0x27, 0x87, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4b, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x4c, 0xcf, 0xcb, 0x2c, 0x49, 0xd5,
-	0x87, 0x39, 0xbe, 0x02, 0xe2, 0xfc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x07, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x6a, 0x21, 0x8a, 0x1b, 0x01, 0x00, 0x00,
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0xb6, 0xf4, 0x90, 0x16, 0xbe, 0x8f, 0x50, 0x30, 0xf6, 0xb0, 0x2d, 0x1e, 0xa4,
+	0x17, 0x77, 0x6d, 0x7d, 0x01, 0xad, 0x82, 0x27, 0x41, 0xea, 0xcd, 0x4b, 0xd9, 0x24, 0x4b, 0x5c,
+	0x6c, 0x76, 0x4a, 0x76, 0x22, 0xfa, 0x16, 0x3e, 0x87, 0xf8, 0x20, 0x3d, 0xf6, 0xe8, 0x49, 0xa5,
+	0x79, 0x11, 0xd9, 0xdd, 0x14, 0x2b, 0x88, 0x97, 0x64, 0xe1, 0x37, 0xbf, 0x99, 0xf9, 0x4f, 0xd0,
+	0xcf, 0x21, 0x2d, 0x17, 0x42, 0xb3, 0x5c, 0x2a, 0x64, 0x99, 0x50, 0x42, 0x4b, 0x4d, 0x97, 0x05,
+	0x20, 0x84, 0xdd, 0x9a, 0x51, 0xc3, 0xfa, 0xbd, 0x0c, 0x32, 0xb0, 0x80, 0x99, 0x97, 0xab, 0xe9,
+	0x93, 0x04, 0x74, 0x0e, 0x9a, 0xc5, 0x5c, 0x0b, 0xf6, 0x30, 0x8e, 0x05, 0xf2, 0x31, 0x4b, 0x40,
+	0xaa, 0x9a, 0xef, 0xfd, 0xe8, 0x6f, 0x3e, 0x0e, 0x1c, 0xbc, 0x36, 0x82, 0xee, 0xa5, 0x1b, 0x77,
+	0x83, 0x1c, 0x45, 0x38, 0x09, 0xda, 0x06, 0x8b, 0x22, 0xf2, 0x87, 0xfe, 0xa8, 0x33, 0xe9, 0xd1,
+	0xdd, 0xf1, 0xf4, 0xca, 0xb2, 0x69, 0x6b, 0xf5, 0x3e, 0xf0, 0x66, 0x75, 0xa5, 0x71, 0x96, 0xbc,
+	0xe0, 0xb9, 0x8e, 0x1a, 0xbf, 0x39, 0xd7, 0x96, 0x6d, 0x1d, 0x57, 0x19, 0xaa, 0xa0, 0x8b, 0x80,
+	0x7c, 0x31, 0xb7, 0x3d, 0xd2, 0xa8, 0x39, 0x6c, 0x8e, 0x3a, 0x93, 0x7d, 0xea, 0x82, 0x50, 0x13,
+	0x84, 0xd6, 0x41, 0xe8, 0x39, 0x48, 0x35, 0x3d, 0x36, 0xfa, 0xcb, 0xc7, 0x60, 0x94, 0x49, 0xbc,
+	0x2b, 0x63, 0x9a, 0x40, 0xce, 0xea, 0xd4, 0xee, 0x77, 0xa4, 0xd3, 0x7b, 0x86, 0x4f, 0x4b, 0xa1,
+	0xad, 0xa0, 0x67, 0x1d, 0x3b, 0xc0, 0x6e, 0x9c, 0x86, 0x17, 0xc1, 0xff, 0xb4, 0xd4, 0x38, 0xe7,
+	0x49, 0x52, 0xe6, 0xe5, 0x82, 0x23, 0x14, 0x51, 0x6b, 0xe8, 0xff, 0x39, 0x73, 0xf6, 0xcf, 0x28,
+	0x67, 0xdf, 0xc6, 0xf4, 0x74, 0xb5, 0x21, 0xfe, 0x7a, 0x43, 0xfc, 0xcf, 0x0d, 0xf1, 0x9f, 0x2b,
+	0xe2, 0xad, 0x2b, 0xe2, 0xbd, 0x55, 0xc4, 0xbb, 0x3d, 0xdc, 0x59, 0x4b, 0x66, 0x4a, 0xa2, 0x60,
+	0xdb, 0x9b, 0x3f, 0xba, 0xab, 0xdb, 0xd5, 0xe2, 0xb6, 0xbd, 0xfb, 0xc9, 0xd7, 0x00, 0x50, 0xdb,
+	0x9a, 0x34, 0xf2, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +150,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DustAccumulator != nil {
+		{
+			size, err := m.DustAccumulator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalMinted) > 0 {
+		for iNdEx := len(m.TotalMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -165,6 +220,16 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TotalMinted) > 0 {
+		for _, e := range m.TotalMinted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DustAccumulator != nil {
+		l = m.DustAccumulator.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -269,6 +334,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalMinted = append(m.TotalMinted, types.Coin{})
+			if err := m.TotalMinted[len(m.TotalMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DustAccumulator == nil {
+				m.DustAccumulator = &types.Coin{}
+			}
+			if err := m.DustAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/modules/x/mint/types"
@@ -13,6 +15,18 @@ func TestValidateGenesis(t *testing.T) {
 	// set inflation min to larger than inflation max
 	invalid.Params.InflationMin = invalid.Params.InflationMax.Add(invalid.Params.InflationMax)
 
+	withTotalMinted := func(total sdk.Coins) *types.GenesisState {
+		genesis := types.DefaultGenesis()
+		genesis.Params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		genesis.TotalMinted = total
+		return genesis
+	}
+	withDustAccumulator := func(accumulator sdk.Coin) *types.GenesisState {
+		genesis := types.DefaultGenesis()
+		genesis.DustAccumulator = &accumulator
+		return genesis
+	}
+
 	tests := []struct {
 		name    string
 		genesis *types.GenesisState
@@ -28,6 +42,31 @@ func TestValidateGenesis(t *testing.T) {
 			genesis: invalid,
 			isValid: false,
 		},
+		{
+			name:    "should validate the total minted of the mint denom and the configured denoms",
+			genesis: withTotalMinted(sdk.NewCoins(sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+			isValid: true,
+		},
+		{
+			name:    "should prevent a negative total minted",
+			genesis: withTotalMinted(sdk.Coins{{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}}),
+			isValid: false,
+		},
+		{
+			name:    "should prevent a total minted of a denom not minted by the module",
+			genesis: withTotalMinted(sdk.NewCoins(sdk.NewInt64Coin("bar", 10))),
+			isValid: false,
+		},
+		{
+			name:    "should validate a dust accumulator",
+			genesis: withDustAccumulator(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)),
+			isValid: true,
+		},
+		{
+			name:    "should prevent a negative dust accumulator",
+			genesis: withDustAccumulator(sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}),
+			isValid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -40,3 +79,18 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisStateNormalize(t *testing.T) {
+	defaults := types.DefaultGenesis()
+	genesis := types.DefaultGenesis()
+	genesis.Params.MintDenom = ""
+	genesis.Params.MintConfigs = []types.MintConfig{defaults.Params.PrimaryMintConfig(), fooMintConfig()}
+	require.Error(t, genesis.Validate())
+
+	// the genesis listing the mint denom in the mint configurations is imported like the single
+	// mint denom layout with an additional denom
+	genesis.Normalize()
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, defaults.Params.MintDenom, genesis.Params.MintDenom)
+	require.Equal(t, []types.MintConfig{fooMintConfig()}, genesis.Params.MintConfigs)
+}
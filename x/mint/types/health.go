@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// HealthStatusActive is the status of the minting when coins are minted at each block
+	HealthStatusActive = "active"
+
+	// HealthStatusPaused is the status of the minting when it is paused by the params
+	HealthStatusPaused = "paused"
+
+	// HealthStatusDenomMismatch is the status of the minting when it is skipped because the
+	// supplies of the mint denom mismatch
+	HealthStatusDenomMismatch = "denom_mismatch"
+
+	// HealthStatusTerminal is the status of the minting when no coin can be minted with the
+	// current params: the maximum inflation rate is zero
+	HealthStatusTerminal = "terminal"
+)
+
+// MintHealth is a compact health report of the minting, it is meant to be included in the status
+// or health metadata of a node so that the health checks can alert without an indexer
+type MintHealth struct {
+	Status string `json:"status"`
+	// height of the block the report is assembled at
+	Height int64 `json:"height"`
+	// height of the last block with minted coins, zero if no block in the distribution history
+	// retention has minted coins
+	LastMintHeight int64 `json:"last_mint_height"`
+	// number of blocks since the last block with minted coins, since genesis if the last mint
+	// height is zero
+	BlocksSinceMint  int64   `json:"blocks_since_mint"`
+	Inflation        sdk.Dec `json:"inflation"`
+	AnnualProvisions sdk.Dec `json:"annual_provisions"`
+}
+
+// NewMintHealth returns the health report of the minting at the height
+func NewMintHealth(params Params, minter Minter, height, lastMintHeight int64, denomMismatch bool) MintHealth {
+	status := HealthStatusActive
+	switch {
+	case params.PauseMinting:
+		status = HealthStatusPaused
+	case denomMismatch:
+		status = HealthStatusDenomMismatch
+	case params.InflationMax.IsZero():
+		status = HealthStatusTerminal
+	}
+
+	return MintHealth{
+		Status:           status,
+		Height:           height,
+		LastMintHeight:   lastMintHeight,
+		BlocksSinceMint:  height - lastMintHeight,
+		Inflation:        minter.Inflation,
+		AnnualProvisions: minter.AnnualProvisions,
+	}
+}
+
+// Active returns true if coins are minted at each block
+func (h MintHealth) Active() bool {
+	return h.Status == HealthStatusActive
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestNewMintHealth(t *testing.T) {
+	terminal := types.DefaultParams()
+	terminal.InflationMax = sdk.ZeroDec()
+	terminal.InflationMin = sdk.ZeroDec()
+	paused := types.DefaultParams()
+	paused.PauseMinting = true
+	pausedTerminal := terminal
+	pausedTerminal.PauseMinting = true
+
+	tests := []struct {
+		name          string
+		params        types.Params
+		denomMismatch bool
+		expected      string
+	}{
+		{
+			name:     "should report active minting",
+			params:   types.DefaultParams(),
+			expected: types.HealthStatusActive,
+		},
+		{
+			name:     "should report paused minting",
+			params:   paused,
+			expected: types.HealthStatusPaused,
+		},
+		{
+			name:          "should report skipped minting on a denom mismatch",
+			params:        types.DefaultParams(),
+			denomMismatch: true,
+			expected:      types.HealthStatusDenomMismatch,
+		},
+		{
+			name:     "should report terminal minting",
+			params:   terminal,
+			expected: types.HealthStatusTerminal,
+		},
+		{
+			name:     "should report paused minting before terminal minting",
+			params:   pausedTerminal,
+			expected: types.HealthStatusPaused,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			minter := types.DefaultInitialMinter()
+			health := types.NewMintHealth(tc.params, minter, 120, 100, tc.denomMismatch)
+			require.Equal(t, types.MintHealth{
+				Status:           tc.expected,
+				Height:           120,
+				LastMintHeight:   100,
+				BlocksSinceMint:  20,
+				Inflation:        minter.Inflation,
+				AnnualProvisions: minter.AnnualProvisions,
+			}, health)
+			require.Equal(t, tc.expected == types.HealthStatusActive, health.Active())
+		})
+	}
+}
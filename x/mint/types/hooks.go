@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintHooks defines the hooks invoked by the mint module, for example to let other modules react
+// to the coins minted for a block without polling the bank module
+type MintHooks interface {
+	// AfterDistributeMintedCoin is invoked once the coins minted for a block are distributed, it is
+	// not invoked for the blocks without minted coins
+	AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error
+}
+
+var _ MintHooks = MultiMintHooks{}
+
+// MultiMintHooks combines multiple mint hooks, all the hooks are run in the order they are given
+type MultiMintHooks []MintHooks
+
+// NewMultiMintHooks returns the hooks combining the hooks
+func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
+	return hooks
+}
+
+// AfterDistributeMintedCoin implements MintHooks, the first error of the hooks is returned
+func (h MultiMintHooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterDistributeMintedCoin(ctx, mintedCoin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
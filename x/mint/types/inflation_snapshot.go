@@ -0,0 +1,31 @@
+package types
+
+const (
+	// InflationHistoryMaxLimit is the maximum number of snapshots of the inflation returned per
+	// request, the next snapshots are returned with the next key of the page
+	InflationHistoryMaxLimit = 100
+)
+
+// NewInflationSnapshot returns the snapshot of the inflation of the minter at a height
+func NewInflationSnapshot(height int64, minter Minter) InflationSnapshot {
+	return InflationSnapshot{
+		Height:           height,
+		Inflation:        minter.Inflation,
+		AnnualProvisions: minter.AnnualProvisions,
+		BondedRatio:      minter.LastBlockInputs.BondedRatio,
+	}
+}
+
+// IsInflationSnapshotHeight returns true if the inflation is snapshotted at the height
+func (p Params) IsInflationSnapshotHeight(height int64) bool {
+	return p.InflationSnapshotInterval > 0 && height > 0 && uint64(height)%p.InflationSnapshotInterval == 0
+}
+
+// InflationSnapshotPrunedTo returns the highest height of the snapshots pruned once the inflation
+// is snapshotted at the height, pruned is false if no snapshot is pruned
+func (p Params) InflationSnapshotPrunedTo(height int64) (prunedTo int64, pruned bool) {
+	if p.InflationSnapshotRetention == 0 || uint64(height) < p.InflationSnapshotRetention {
+		return 0, false
+	}
+	return height - int64(p.InflationSnapshotRetention), true
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// MarshalCanonicalJSON returns the canonical JSON representation of the params. Fields use their
+// proto names and are sorted, decimals use a fixed 18 digits precision, and the output is indented
+// so that representations of the same params are always identical.
+func (p Params) MarshalCanonicalJSON() ([]byte, error) {
+	return marshalCanonicalJSON(&p)
+}
+
+// MarshalCanonicalJSON returns the canonical JSON representation of the minter, see
+// Params.MarshalCanonicalJSON for the format.
+func (m Minter) MarshalCanonicalJSON() ([]byte, error) {
+	return marshalCanonicalJSON(&m)
+}
+
+// MarshalCanonicalJSON returns the canonical JSON representation of the derived params, see
+// Params.MarshalCanonicalJSON for the format.
+func (d DerivedParams) MarshalCanonicalJSON() ([]byte, error) {
+	return marshalCanonicalJSON(&d)
+}
+
+// ValidateJSON checks that the provided bytes are a valid JSON representation of the params.
+// Unknown and malformed fields are reported by name, and the decoded params must be valid
+// and round-trip to the same canonical representation.
+func ValidateJSON(bz []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return fmt.Errorf("invalid params JSON: %w", err)
+	}
+
+	// decode each field separately to report the malformed fields by name
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field, err := json.Marshal(map[string]json.RawMessage{name: fields[name]})
+		if err != nil {
+			return fmt.Errorf("invalid field %s: %w", name, err)
+		}
+		var p Params
+		if err := ModuleCdc.UnmarshalJSON(field, &p); err != nil {
+			return fmt.Errorf("invalid field %s: %w", name, err)
+		}
+	}
+
+	// all the params must be provided, either with their proto or JSON name
+	required, err := paramsFieldNames()
+	if err != nil {
+		return err
+	}
+	for _, name := range required {
+		_, hasProtoName := fields[name]
+		_, hasJSONName := fields[lowerCamelCase(name)]
+		if !hasProtoName && !hasJSONName {
+			return fmt.Errorf("missing field %s", name)
+		}
+	}
+
+	var p Params
+	if err := ModuleCdc.UnmarshalJSON(bz, &p); err != nil {
+		return fmt.Errorf("invalid params JSON: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	canonical, err := p.MarshalCanonicalJSON()
+	if err != nil {
+		return err
+	}
+	var roundTrip Params
+	if err := ModuleCdc.UnmarshalJSON(canonical, &roundTrip); err != nil {
+		return fmt.Errorf("params do not round-trip: %w", err)
+	}
+	roundTripCanonical, err := roundTrip.MarshalCanonicalJSON()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(canonical, roundTripCanonical) {
+		return fmt.Errorf("params do not round-trip to the same canonical representation")
+	}
+
+	return nil
+}
+
+// paramsFieldNames returns the sorted proto names of the params fields
+func paramsFieldNames() ([]string, error) {
+	bz, err := codec.ProtoMarshalJSON(&Params{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// lowerCamelCase converts a proto field name into its JSON name
+func lowerCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func marshalCanonicalJSON(msg proto.Message) ([]byte, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	sorted, err := sdk.SortJSON(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, sorted, "", "  "); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
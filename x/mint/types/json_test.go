@@ -0,0 +1,154 @@
+package types_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+// requireGolden compares the output with the golden file, the golden file is rewritten when the
+// tests are run with -update
+func requireGolden(t *testing.T, name string, output []byte) {
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(path, output, 0o600))
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(output))
+}
+
+func goldenParams() types.Params {
+	params := types.DefaultParams()
+	params.FundedAddresses = []types.WeightedAddress{
+		{
+			Address: "cosmos1ezptsm3npn54qx9vvpah4nymre59ykr9967vj9",
+			Weight:  sdk.NewDecWithPrec(4, 1),
+		},
+		{
+			Address: "cosmos1aqn8ynvr3jmq67879qulzrwhchq5dtrvh6h4er",
+			Weight:  sdk.NewDecWithPrec(6, 1),
+		},
+	}
+	params.MinDistributableProvision = sdkmath.NewInt(10)
+	params.PauseFundedShare = true
+	params.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+	return params
+}
+
+func TestParamsMarshalCanonicalJSON(t *testing.T) {
+	bz, err := goldenParams().MarshalCanonicalJSON()
+	require.NoError(t, err)
+	requireGolden(t, "params.golden", bz)
+
+	// an equivalent Dec representation produces the same output
+	params := goldenParams()
+	params.InflationMax = sdk.MustNewDecFromStr("0.2")
+	same, err := params.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	require.Equal(t, bz, same)
+}
+
+func TestMinterMarshalCanonicalJSON(t *testing.T) {
+	minter := types.DefaultInitialMinter()
+	minter.CarryBuffer = sdk.MustNewDecFromStr("0.5")
+	minter.PausedShares.FundedAddresses = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	bz, err := minter.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	requireGolden(t, "minter.golden", bz)
+}
+
+// withField returns the JSON object with the field replaced by the provided value
+func withField(t *testing.T, bz []byte, name string, value interface{}) string {
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	fields[name] = value
+	out, err := json.Marshal(fields)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestValidateJSON(t *testing.T) {
+	canonical, err := goldenParams().MarshalCanonicalJSON()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		json string
+		err  string
+	}{
+		{
+			name: "should validate canonical params",
+			json: string(canonical),
+		},
+		{
+			name: "should validate params with camel case fields and partial precision",
+			json: `{"mintDenom":"stake","inflationRateChange":"0.13","inflationMax":"0.2","inflationMin":"0.07",
+"goalBonded":"0.67","blocksPerYear":"6311520","distributionProportions":{"staking":"0.3",
+"fundedAddresses":"0.4","communityPool":"0.3","strategicReserve":"0"},"fundedAddresses":[],"minDistributableProvision":"0",
+"pauseMinting":false,"pauseStakingShare":false,"pauseFundedShare":false,"pauseCommunityShare":false,
+"pausedShareMode":"PAUSED_SHARE_MODE_COMMUNITY_POOL","dustAssignment":"DUST_ASSIGNMENT_MODULE_ACCOUNT","emitMintPlanned":false,"supplySourceMode":"SUPPLY_SOURCE_MODE_REPLACE",
+"minAnnualCommunityFunding":{"denom":"stake","amount":"0"},"communityFundingPriority":["COMMUNITY_FUNDING_SOURCE_MINT"],
+"communityFundingWindow":"17280","driftCorrection":{"maxFactor":"0","horizon":"518400"},"largeChangeThreshold":"0.05","stakingRewardsRecipient":"","phases":[],"maxSupply":"0","shortfallPolicy":"SHORTFALL_POLICY_PRO_RATA","shortfallPriority":["staking","funded_addresses","community_pool"],
+"inflationSnapshotInterval":"1000","inflationSnapshotRetention":"6311520","mintConfigs":[],"inflationCalculationMode":"INFLATION_CALCULATION_MODE_GOAL_BONDED",
+"bootstrapOverride":{"recipient":"","endHeight":"0"},"minBondedRatio":"0","autoPauseThreshold":"0",
+"dustPolicy":"DUST_POLICY_COMMUNITY_POOL","maintenanceInterval":"100","mintingInterval":"1","minCommunityPoolShare":"0"}`,
+		},
+		{
+			name: "should prevent validate malformed JSON",
+			json: `{"mint_denom":`,
+			err:  "invalid params JSON",
+		},
+		{
+			name: "should prevent validate unknown field",
+			json: `{"mint_denom":"stake","foo":"bar"}`,
+			err:  "invalid field foo",
+		},
+		{
+			name: "should prevent validate malformed decimal field",
+			json: `{"mint_denom":"stake","inflation_max":"foo"}`,
+			err:  "invalid field inflation_max",
+		},
+		{
+			name: "should prevent validate malformed integer field",
+			json: `{"mint_denom":"stake","blocks_per_year":"-1"}`,
+			err:  "invalid field blocks_per_year",
+		},
+		{
+			name: "should prevent validate missing field",
+			json: `{"mint_denom":"stake","auto_pause_threshold":"0","blocks_per_year":"100","bootstrap_override":{"recipient":"","end_height":"0"},"community_funding_priority":[],"community_funding_window":"1","drift_correction":{"max_factor":"0","horizon":"1"}}`,
+			err:  "missing field distribution_proportions",
+		},
+		{
+			name: "should prevent validate missing nested field",
+			json: withField(t, canonical, "distribution_proportions", map[string]string{}),
+			err:  "invalid params",
+		},
+		{
+			name: "should prevent validate invalid params",
+			json: withField(t, canonical, "blocks_per_year", "0"),
+			err:  "invalid params",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateJSON([]byte(tc.json))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
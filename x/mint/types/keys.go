@@ -1,7 +1,60 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// SummaryKey is the key of the summary of the mint state, it is stored under a
+	// single key for interchain queries
+	SummaryKey = []byte{0x01}
+
+	// FundedAddressHistoryKeyPrefix is the prefix of the weight changes of the funded addresses
+	FundedAddressHistoryKeyPrefix = []byte{0x02}
+
+	// DistributionHistoryKeyPrefix is the prefix of the allocations of the minted coins of each block
+	DistributionHistoryKeyPrefix = []byte{0x03}
+
+	// ParamsChangeKey is the key of the last change of the params
+	ParamsChangeKey = []byte{0x04}
+
+	// SchemaVersionKey is the key of the version of the schema of the store
+	SchemaVersionKey = []byte{0x05}
+
+	// FeeCollectorNameKey is the key of the name of the fee collector set at an upgrade
+	FeeCollectorNameKey = []byte{0x06}
+
+	// FundedAddressIncomeKeyPrefix is the prefix of the income of the funded addresses
+	FundedAddressIncomeKeyPrefix = []byte{0x07}
+
+	// TotalBurnedKeyPrefix is the prefix of the total amount of each denom burned with MsgBurn
+	TotalBurnedKeyPrefix = []byte{0x08}
+
+	// InflationSnapshotKeyPrefix is the prefix of the snapshots of the inflation
+	InflationSnapshotKeyPrefix = []byte{0x09}
+
+	// ModuleVersionKey is the key of the version of the behavior of the module and the migrations
+	// completed on the chain
+	ModuleVersionKey = []byte{0x0A}
+
+	// TotalMintedKeyPrefix is the prefix of the total amount of each denom minted by the module
+	TotalMintedKeyPrefix = []byte{0x0B}
+
+	// DustAccumulatorKey is the key of the truncation remainder of the category split carried over
+	// to the next block with the carry over dust policy
+	DustAccumulatorKey = []byte{0x0C}
+
+	// LastMaintenanceHeightKey is the key of the height of the last run of MsgRunMaintenance
+	LastMaintenanceHeightKey = []byte{0x0D}
+
+	// InitializedHeightKey is the key of the first height minted with the genesis state of the
+	// module
+	InitializedHeightKey = []byte{0x0E}
+)
 
 const (
 	// module name
@@ -9,4 +62,51 @@ const (
 
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
+
+	// RouterKey is the message route for mint
+	RouterKey = ModuleName
+
+	// SchemaVersion is the version of the schema of the store, it is the consensus version of
+	// the module
+	SchemaVersion uint64 = 7
+
+	// SDKMintSchemaVersion is the schema version from which the store of the cosmos-sdk x/mint
+	// module is migrated, the upgrade handler of a chain replacing the cosmos-sdk x/mint module
+	// sets the version of the module to it before running the migrations
+	SDKMintSchemaVersion uint64 = 6
 )
+
+// FundedAddressHistoryPrefix returns the store prefix of the weight changes of a funded address
+func FundedAddressHistoryPrefix(addr string) []byte {
+	return append(FundedAddressHistoryKeyPrefix, address.MustLengthPrefix([]byte(addr))...)
+}
+
+// FundedAddressHistoryKey returns the store key of a weight change of a funded address
+func FundedAddressHistoryKey(addr string, sequence uint64) []byte {
+	return append(FundedAddressHistoryPrefix(addr), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// DistributionHistoryKey returns the store key of the allocation of the minted coins of a block
+func DistributionHistoryKey(height int64) []byte {
+	return append(DistributionHistoryKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// FundedAddressIncomeKey returns the store key of the income of a funded address
+func FundedAddressIncomeKey(addr string) []byte {
+	return append(FundedAddressIncomeKeyPrefix, address.MustLengthPrefix([]byte(addr))...)
+}
+
+// TotalBurnedKey returns the store key of the total amount of a denom burned with MsgBurn
+func TotalBurnedKey(denom string) []byte {
+	return append(TotalBurnedKeyPrefix, []byte(denom)...)
+}
+
+// TotalMintedKey returns the store key of the total amount of a denom minted by the module
+func TotalMintedKey(denom string) []byte {
+	return append(TotalMintedKeyPrefix, []byte(denom)...)
+}
+
+// InflationSnapshotKey returns the store key of the snapshot of the inflation of a block
+func InflationSnapshotKey(height int64) []byte {
+	return append(InflationSnapshotKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
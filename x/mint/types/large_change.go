@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// InflationBoundDelta is the move of an inflation bound between the current and the proposed params
+type InflationBoundDelta struct {
+	Field    string
+	Current  sdk.Dec
+	Proposed sdk.Dec
+}
+
+// Magnitude returns the absolute move of the bound
+func (d InflationBoundDelta) Magnitude() sdk.Dec {
+	return d.Proposed.Sub(d.Current).Abs()
+}
+
+// String implements the Stringer interface.
+func (d InflationBoundDelta) String() string {
+	return fmt.Sprintf("%s %s -> %s (delta %s)", d.Field, d.Current, d.Proposed, d.Magnitude())
+}
+
+// LargeInflationBoundDeltas returns the moves of the inflation bounds from the params to the
+// proposed params exceeding the large change threshold of the params, a move exactly at the
+// threshold is not a large change. The bounds of the params, of the phases and of the mint
+// configurations are compared:
+//   - a phase is compared with the bounds applied at its start height by the other params, so a
+//     phase added, removed or moved is compared with the bounds it replaces or is replaced by
+//   - a mint configuration is compared with the mint configuration of the same denom, the bounds
+//     of a mint configuration added or removed are compared with zero bounds
+func (p Params) LargeInflationBoundDeltas(proposed Params) (deltas []InflationBoundDelta) {
+	candidates := inflationBoundDeltas("", p.InflationMax, p.InflationMin, proposed.InflationMax, proposed.InflationMin)
+	for _, phase := range proposed.Phases {
+		current := p.AtHeight(phase.StartHeight)
+		candidates = append(candidates, inflationBoundDeltas(
+			fmt.Sprintf("phases[%s].", phase.Name),
+			current.InflationMax, current.InflationMin, phase.InflationMax, phase.InflationMin,
+		)...)
+	}
+	for _, phase := range p.Phases {
+		applied := proposed.AtHeight(phase.StartHeight)
+		candidates = append(candidates, inflationBoundDeltas(
+			fmt.Sprintf("phases[%s].", phase.Name),
+			phase.InflationMax, phase.InflationMin, applied.InflationMax, applied.InflationMin,
+		)...)
+	}
+
+	for _, config := range proposed.MintConfigs {
+		current, found := p.GetMintConfig(config.MintDenom)
+		if !found {
+			current.InflationMax, current.InflationMin = sdk.ZeroDec(), sdk.ZeroDec()
+		}
+		candidates = append(candidates, inflationBoundDeltas(
+			fmt.Sprintf("mint_configs[%s].", config.MintDenom),
+			current.InflationMax, current.InflationMin, config.InflationMax, config.InflationMin,
+		)...)
+	}
+	for _, config := range p.MintConfigs {
+		if _, found := proposed.GetMintConfig(config.MintDenom); !found {
+			candidates = append(candidates, inflationBoundDeltas(
+				fmt.Sprintf("mint_configs[%s].", config.MintDenom),
+				config.InflationMax, config.InflationMin, sdk.ZeroDec(), sdk.ZeroDec(),
+			)...)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, delta := range candidates {
+		if delta.Magnitude().GT(p.LargeChangeThreshold) && !seen[delta.String()] {
+			seen[delta.String()] = true
+			deltas = append(deltas, delta)
+		}
+	}
+	return deltas
+}
+
+// inflationBoundDeltas returns the moves of the max and the min inflation of the fields with the
+// prefix
+func inflationBoundDeltas(prefix string, currentMax, currentMin, proposedMax, proposedMin sdk.Dec) []InflationBoundDelta {
+	return []InflationBoundDelta{
+		{Field: prefix + "inflation_max", Current: currentMax, Proposed: proposedMax},
+		{Field: prefix + "inflation_min", Current: currentMin, Proposed: proposedMin},
+	}
+}
+
+// CheckLargeChange returns an ErrLargeChange error listing the moves of the inflation bounds
+// exceeding the large change threshold of the params, unless the change is acknowledged
+func (p Params) CheckLargeChange(proposed Params, acknowledged bool) error {
+	deltas := p.LargeInflationBoundDeltas(proposed)
+	if len(deltas) == 0 || acknowledged {
+		return nil
+	}
+	moves := make([]string, len(deltas))
+	for i, delta := range deltas {
+		moves[i] = delta.String()
+	}
+	return errors.Wrapf(
+		ErrLargeChange,
+		"inflation bounds moved by more than the threshold of %s without acknowledge_large_change: %s",
+		p.LargeChangeThreshold,
+		strings.Join(moves, ", "),
+	)
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ledger entries of the coins buffered in the module account
+const (
+	LedgerEntryPausedStaking         = "paused_staking"
+	LedgerEntryPausedFundedAddresses = "paused_funded_addresses"
+	LedgerEntryPausedCommunityPool   = "paused_community_pool"
+	LedgerEntryDust                  = "dust"
+	LedgerEntryPendingPayouts        = "pending_payouts"
+	LedgerEntryStrategicReserve      = "strategic_reserve"
+)
+
+// LedgerEntries are the ledger entries in the order they are reported
+var LedgerEntries = []string{
+	LedgerEntryPausedStaking,
+	LedgerEntryPausedFundedAddresses,
+	LedgerEntryPausedCommunityPool,
+	LedgerEntryDust,
+	LedgerEntryPendingPayouts,
+	LedgerEntryStrategicReserve,
+}
+
+// LedgerEntryCarriedDust is the ledger entry of the dust accumulator, the truncation remainder
+// carried over to the next block is stored apart from the minter and reported after the entries
+// of the minter
+const LedgerEntryCarriedDust = "carried_dust"
+
+// PausedShareLedgerEntry returns the ledger entry buffering the share of a paused category
+func PausedShareLedgerEntry(category string) string {
+	switch category {
+	case CategoryStaking:
+		return LedgerEntryPausedStaking
+	case CategoryFundedAddresses:
+		return LedgerEntryPausedFundedAddresses
+	default:
+		return LedgerEntryPausedCommunityPool
+	}
+}
+
+// subBalance returns the sub-balance of the minter booking the ledger entry
+func (m *Minter) subBalance(entry string) *sdk.Coins {
+	switch entry {
+	case LedgerEntryPausedStaking:
+		return &m.PausedShares.Staking
+	case LedgerEntryPausedFundedAddresses:
+		return &m.PausedShares.FundedAddresses
+	case LedgerEntryPausedCommunityPool:
+		return &m.PausedShares.CommunityPool
+	case LedgerEntryDust:
+		return &m.BufferedDust
+	case LedgerEntryStrategicReserve:
+		return &m.StrategicReserve
+	case LedgerEntryPendingPayouts:
+		panic("the pending payouts are booked by funded address")
+	default:
+		panic(fmt.Sprintf("unknown ledger entry %s", entry))
+	}
+}
+
+// entryBalance returns the sub-balance of the ledger entry, the pending payouts entry is the sum
+// of the pending payouts of the funded addresses
+func (m Minter) entryBalance(entry string) sdk.Coins {
+	if entry == LedgerEntryPendingPayouts {
+		return m.TotalPendingPayouts()
+	}
+	return *m.subBalance(entry)
+}
+
+// Book adds coins buffered in the module account to the ledger entry
+func (m *Minter) Book(entry string, coins sdk.Coins) {
+	balance := m.subBalance(entry)
+	*balance = balance.Add(coins...)
+}
+
+// Release empties the ledger entry and returns its sub-balance
+func (m *Minter) Release(entry string) sdk.Coins {
+	balance := m.subBalance(entry)
+	released := *balance
+	*balance = nil
+	return released
+}
+
+// Ledger returns the sub-balances of the coins buffered in the module account by ledger entry
+func (m Minter) Ledger() []LedgerEntry {
+	entries := make([]LedgerEntry, len(LedgerEntries))
+	for i, entry := range LedgerEntries {
+		entries[i] = LedgerEntry{
+			Name:   entry,
+			Amount: m.entryBalance(entry),
+		}
+	}
+	return entries
+}
+
+// TotalBuffered returns the sum of the ledger entries, the coins held by the module account
+func (m Minter) TotalBuffered() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, entry := range LedgerEntries {
+		total = total.Add(m.entryBalance(entry)...)
+	}
+	return total
+}
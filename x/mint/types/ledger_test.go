@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMinterLedger(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	minter := types.DefaultInitialMinter()
+	require.True(t, minter.TotalBuffered().IsZero())
+
+	minter.Book(types.LedgerEntryPausedStaking, stake(30))
+	minter.Book(types.LedgerEntryPausedStaking, stake(20))
+	minter.Book(types.LedgerEntryPausedCommunityPool, stake(10))
+	minter.Book(types.LedgerEntryDust, stake(1))
+	minter.Book(types.LedgerEntryStrategicReserve, stake(3))
+	minter.BookPayout(sample.Address(sample.Rand()), 1, stake(5))
+	require.Equal(t, stake(50), minter.PausedShares.Staking)
+	require.Equal(t, stake(1), minter.BufferedDust)
+	require.Equal(t, stake(3), minter.StrategicReserve)
+	require.Equal(t, stake(69), minter.TotalBuffered())
+	require.Equal(t, []types.LedgerEntry{
+		{Name: types.LedgerEntryPausedStaking, Amount: stake(50)},
+		{Name: types.LedgerEntryPausedFundedAddresses},
+		{Name: types.LedgerEntryPausedCommunityPool, Amount: stake(10)},
+		{Name: types.LedgerEntryDust, Amount: stake(1)},
+		{Name: types.LedgerEntryPendingPayouts, Amount: stake(5)},
+		{Name: types.LedgerEntryStrategicReserve, Amount: stake(3)},
+	}, minter.Ledger())
+
+	require.Equal(t, stake(50), minter.Release(types.LedgerEntryPausedStaking))
+	require.True(t, minter.Release(types.LedgerEntryPausedStaking).IsZero())
+	require.Equal(t, stake(19), minter.TotalBuffered())
+
+	require.Panics(t, func() {
+		minter.Book("foo", stake(1))
+	})
+	require.Panics(t, func() {
+		minter.Book(types.LedgerEntryPendingPayouts, stake(1))
+	})
+}
+
+func TestPausedShareLedgerEntry(t *testing.T) {
+	require.Equal(t, types.LedgerEntryPausedStaking, types.PausedShareLedgerEntry(types.CategoryStaking))
+	require.Equal(t, types.LedgerEntryPausedFundedAddresses, types.PausedShareLedgerEntry(types.CategoryFundedAddresses))
+	require.Equal(t, types.LedgerEntryPausedCommunityPool, types.PausedShareLedgerEntry(types.CategoryCommunityPool))
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LegacyFieldMapping is a field of a legacy genesis state mapped onto a field of the genesis state
+type LegacyFieldMapping struct {
+	Legacy  string
+	Current string
+}
+
+// UnmarshalGenesis decodes the proto-JSON encoding of a genesis state. Unless strict, a genesis
+// state that can't be decoded, or is invalid once decoded, is decoded as a legacy genesis state
+// with DecodeLegacyGenesis, the legacy fields mapped onto the genesis state are returned. The
+// error of the proto-JSON decoding is returned if the legacy decoding fails too.
+func UnmarshalGenesis(cdc codec.JSONCodec, bz json.RawMessage, strict bool) (*GenesisState, []LegacyFieldMapping, error) {
+	var genesis GenesisState
+	err := cdc.UnmarshalJSON(bz, &genesis)
+	if err == nil {
+		normalized := genesis
+		normalized.Normalize()
+		if strict || normalized.Validate() == nil {
+			return &genesis, nil, nil
+		}
+	}
+	if strict {
+		return nil, nil, err
+	}
+
+	legacy, mappings, legacyErr := DecodeLegacyGenesis(bz)
+	if legacyErr != nil {
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w, and as a legacy genesis state: %s", err, legacyErr)
+		}
+		// the decoded genesis state is returned to report its validation error
+		return &genesis, nil, nil
+	}
+	return legacy, mappings, nil
+}
+
+// DecodeLegacyGenesis decodes a legacy amino-JSON genesis state of the mint module, the genesis
+// state of the Cosmos SDK mint module or of an earlier release of the module, and maps its known
+// fields onto the default genesis state: the minter and the params of the Cosmos SDK mint module,
+// the distribution proportions and the funded addresses, including their development fund names
+// of the modules the module is derived from. A field that is not known fails the decoding.
+func DecodeLegacyGenesis(bz json.RawMessage) (*GenesisState, []LegacyFieldMapping, error) {
+	var legacy legacyGenesisState
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&legacy); err != nil {
+		return nil, nil, err
+	}
+
+	genesis := DefaultGenesis()
+	var mappings []LegacyFieldMapping
+	mapField := func(legacyField, field string) {
+		mappings = append(mappings, LegacyFieldMapping{Legacy: legacyField, Current: field})
+	}
+	mapDec := func(legacyField, field string, value *sdk.Dec, target *sdk.Dec) {
+		if value != nil {
+			*target = *value
+			mapField(legacyField, field)
+		}
+	}
+
+	if m := legacy.Minter; m != nil {
+		mapDec("minter.inflation", "minter.inflation", m.Inflation, &genesis.Minter.Inflation)
+		mapDec("minter.annual_provisions", "minter.annual_provisions", m.AnnualProvisions, &genesis.Minter.AnnualProvisions)
+	}
+
+	if p := legacy.Params; p != nil {
+		params := &genesis.Params
+		if p.MintDenom != nil {
+			params.MintDenom = *p.MintDenom
+			params.MinAnnualCommunityFunding.Denom = *p.MintDenom
+			mapField("params.mint_denom", "params.mint_denom")
+		}
+		mapDec("params.inflation_rate_change", "params.inflation_rate_change", p.InflationRateChange, &params.InflationRateChange)
+		mapDec("params.inflation_max", "params.inflation_max", p.InflationMax, &params.InflationMax)
+		mapDec("params.inflation_min", "params.inflation_min", p.InflationMin, &params.InflationMin)
+		mapDec("params.goal_bonded", "params.goal_bonded", p.GoalBonded, &params.GoalBonded)
+		if p.BlocksPerYear != nil {
+			params.BlocksPerYear = uint64(*p.BlocksPerYear)
+			mapField("params.blocks_per_year", "params.blocks_per_year")
+		}
+		if dp := p.DistributionProportions; dp != nil {
+			proportions := &params.DistributionProportions
+			mapDec("params.distribution_proportions.staking", "params.distribution_proportions.staking",
+				dp.Staking, &proportions.Staking)
+			mapDec("params.distribution_proportions.funded_addresses", "params.distribution_proportions.funded_addresses",
+				dp.FundedAddresses, &proportions.FundedAddresses)
+			mapDec("params.distribution_proportions.development_fund", "params.distribution_proportions.funded_addresses",
+				dp.DevelopmentFund, &proportions.FundedAddresses)
+			mapDec("params.distribution_proportions.community_pool", "params.distribution_proportions.community_pool",
+				dp.CommunityPool, &proportions.CommunityPool)
+		}
+		for _, addresses := range []struct {
+			legacyField string
+			addresses   *[]legacyWeightedAddress
+		}{
+			{legacyField: "params.funded_addresses", addresses: p.FundedAddresses},
+			{legacyField: "params.development_fund_recipients", addresses: p.DevelopmentFundRecipients},
+		} {
+			if addresses.addresses == nil {
+				continue
+			}
+			params.FundedAddresses = make([]WeightedAddress, 0, len(*addresses.addresses))
+			for _, address := range *addresses.addresses {
+				params.FundedAddresses = append(params.FundedAddresses, WeightedAddress{
+					Address: address.Address,
+					Weight:  address.Weight,
+				})
+			}
+			mapField(addresses.legacyField, "params.funded_addresses")
+		}
+	}
+
+	return genesis, mappings, nil
+}
+
+// legacyGenesisState is the legacy amino-JSON genesis state, the fields are optional
+type legacyGenesisState struct {
+	Minter *legacyMinter `json:"minter"`
+	Params *legacyParams `json:"params"`
+}
+
+type legacyMinter struct {
+	Inflation        *sdk.Dec `json:"inflation"`
+	AnnualProvisions *sdk.Dec `json:"annual_provisions"`
+}
+
+type legacyParams struct {
+	MintDenom                 *string                        `json:"mint_denom"`
+	InflationRateChange       *sdk.Dec                       `json:"inflation_rate_change"`
+	InflationMax              *sdk.Dec                       `json:"inflation_max"`
+	InflationMin              *sdk.Dec                       `json:"inflation_min"`
+	GoalBonded                *sdk.Dec                       `json:"goal_bonded"`
+	BlocksPerYear             *legacyUint64                  `json:"blocks_per_year"`
+	DistributionProportions   *legacyDistributionProportions `json:"distribution_proportions"`
+	FundedAddresses           *[]legacyWeightedAddress       `json:"funded_addresses"`
+	DevelopmentFundRecipients *[]legacyWeightedAddress       `json:"development_fund_recipients"`
+}
+
+type legacyDistributionProportions struct {
+	Staking         *sdk.Dec `json:"staking"`
+	FundedAddresses *sdk.Dec `json:"funded_addresses"`
+	DevelopmentFund *sdk.Dec `json:"development_fund"`
+	CommunityPool   *sdk.Dec `json:"community_pool"`
+}
+
+type legacyWeightedAddress struct {
+	Address string  `json:"address"`
+	Weight  sdk.Dec `json:"weight"`
+}
+
+// legacyUint64 is an uint64 encoded as a string by amino-JSON or as a number
+type legacyUint64 uint64
+
+func (u *legacyUint64) UnmarshalJSON(bz []byte) error {
+	s := string(bytes.Trim(bz, `"`))
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid uint64 %s: %w", bz, err)
+	}
+	*u = legacyUint64(v)
+	return nil
+}
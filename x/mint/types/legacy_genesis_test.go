@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func readLegacyGenesis(t *testing.T, name string) []byte {
+	bz, err := os.ReadFile(filepath.Join("testdata", "legacy_genesis", name))
+	require.NoError(t, err)
+	return bz
+}
+
+func TestUnmarshalGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
+	t.Run("should decode a proto-JSON genesis state without mapping", func(t *testing.T) {
+		genesis := types.DefaultGenesis()
+		genesis.Params.MintDenom = "foo"
+		genesis.Params.MinAnnualCommunityFunding.Denom = "foo"
+
+		decoded, mappings, err := types.UnmarshalGenesis(cdc, cdc.MustMarshalJSON(genesis), false)
+		require.NoError(t, err)
+		require.Empty(t, mappings)
+		require.Equal(t, "foo", decoded.Params.MintDenom)
+		require.NoError(t, decoded.Validate())
+	})
+
+	t.Run("should convert the genesis state of the Cosmos SDK mint module", func(t *testing.T) {
+		bz := readLegacyGenesis(t, "sdk_mint.json")
+
+		genesis, mappings, err := types.UnmarshalGenesis(cdc, bz, false)
+		require.NoError(t, err)
+		require.NoError(t, genesis.Validate())
+		require.Equal(t, "stake", genesis.Params.MintDenom)
+		require.Equal(t, "stake", genesis.Params.MinAnnualCommunityFunding.Denom)
+		require.Equal(t, uint64(6311520), genesis.Params.BlocksPerYear)
+		require.Equal(t, sdk.NewDecWithPrec(13, 2), genesis.Minter.Inflation)
+		require.Equal(t, types.DefaultDistributionProportions, genesis.Params.DistributionProportions)
+		require.Len(t, mappings, 8)
+		require.Contains(t, mappings, types.LegacyFieldMapping{Legacy: "minter.annual_provisions", Current: "minter.annual_provisions"})
+		require.Contains(t, mappings, types.LegacyFieldMapping{Legacy: "params.blocks_per_year", Current: "params.blocks_per_year"})
+	})
+
+	t.Run("should convert the genesis state of the first release of the module", func(t *testing.T) {
+		bz := readLegacyGenesis(t, "ignite_mint_v0.json")
+
+		genesis, mappings, err := types.UnmarshalGenesis(cdc, bz, false)
+		require.NoError(t, err)
+		require.NoError(t, genesis.Validate())
+		require.Equal(t, sdk.NewDecWithPrec(4, 1), genesis.Params.DistributionProportions.FundedAddresses)
+		require.True(t, genesis.Params.DistributionProportions.StrategicReserve.IsZero())
+		require.Empty(t, genesis.Params.FundedAddresses)
+		require.Contains(t, mappings, types.LegacyFieldMapping{Legacy: "params.funded_addresses", Current: "params.funded_addresses"})
+	})
+
+	t.Run("should map the development fund fields onto the funded addresses", func(t *testing.T) {
+		addr := sample.Address(r)
+		bz := []byte(`{"params":{"distribution_proportions":{"staking":"0.3","development_fund":"0.4","community_pool":"0.3"},` +
+			`"development_fund_recipients":[{"address":"` + addr + `","weight":"1"}],"blocks_per_year":6311520}}`)
+
+		genesis, mappings, err := types.UnmarshalGenesis(cdc, bz, false)
+		require.NoError(t, err)
+		require.NoError(t, genesis.Validate())
+		require.Equal(t, sdk.NewDecWithPrec(4, 1), genesis.Params.DistributionProportions.FundedAddresses)
+		require.Equal(t, []types.WeightedAddress{{Address: addr, Weight: sdk.OneDec()}}, genesis.Params.FundedAddresses)
+		require.Contains(t, mappings, types.LegacyFieldMapping{
+			Legacy:  "params.distribution_proportions.development_fund",
+			Current: "params.distribution_proportions.funded_addresses",
+		})
+		require.Contains(t, mappings, types.LegacyFieldMapping{
+			Legacy:  "params.development_fund_recipients",
+			Current: "params.funded_addresses",
+		})
+	})
+
+	t.Run("should not decode the legacy genesis states in strict mode", func(t *testing.T) {
+		genesis, mappings, err := types.UnmarshalGenesis(cdc, readLegacyGenesis(t, "sdk_mint.json"), true)
+		require.NoError(t, err)
+		require.Empty(t, mappings)
+		require.Error(t, genesis.Validate())
+
+		_, _, err = types.UnmarshalGenesis(cdc, []byte(`{"params":{"development_fund_recipients":[]}}`), true)
+		require.Error(t, err)
+	})
+
+	t.Run("should prevent decoding unknown fields", func(t *testing.T) {
+		_, _, err := types.UnmarshalGenesis(cdc, []byte(`{"params":{"foo":"bar"}}`), false)
+		require.ErrorContains(t, err, "foo")
+	})
+
+	t.Run("should return an invalid genesis state that isn't a legacy genesis state", func(t *testing.T) {
+		genesis := types.DefaultGenesis()
+		genesis.Params.InflationMin = genesis.Params.InflationMax.Add(sdk.OneDec())
+
+		decoded, mappings, err := types.UnmarshalGenesis(cdc, cdc.MustMarshalJSON(genesis), false)
+		require.NoError(t, err)
+		require.Empty(t, mappings)
+		require.Error(t, decoded.Validate())
+	})
+}
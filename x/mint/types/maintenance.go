@@ -0,0 +1,25 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// MaintenanceMaxLimit is the maximum number of records cleaned by a maintenance run, a limit of
+	// zero runs the maintenance up to this limit
+	MaintenanceMaxLimit = 1000
+
+	// MaintenanceGasPerWork is the gas charged to the signer of a maintenance run per record cleaned
+	MaintenanceGasPerWork = 1000
+
+	// InflationSnapshotPruneCap is the maximum number of snapshots of the inflation pruned in a
+	// block, the snapshots left behind are pruned in the next snapshot heights or by a maintenance
+	// run
+	InflationSnapshotPruneCap = 100
+)
+
+// NewMaintenanceSummary returns an empty summary of a maintenance run
+func NewMaintenanceSummary() MaintenanceSummary {
+	return MaintenanceSummary{
+		Flushed:  sdk.NewCoins(),
+		Complete: true,
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// MaxSupplyHeadroom returns the amount of the mint denom that can still be minted before the
+// supply reaches the max supply, zero once reached. It returns false if the supply is unlimited.
+func (p Params) MaxSupplyHeadroom(supply sdkmath.Int) (sdkmath.Int, bool) {
+	if p.MaxSupply.IsNil() || !p.MaxSupply.IsPositive() {
+		return sdkmath.Int{}, false
+	}
+	if headroom := p.MaxSupply.Sub(supply); headroom.IsPositive() {
+		return headroom, true
+	}
+	return sdkmath.ZeroInt(), true
+}
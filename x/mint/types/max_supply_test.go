@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxSupplyHeadroom(t *testing.T) {
+	params := DefaultParams()
+
+	_, capped := params.MaxSupplyHeadroom(sdkmath.NewInt(1000))
+	require.False(t, capped)
+
+	params.MaxSupply = sdkmath.NewInt(1000)
+	for supply, headroom := range map[int64]int64{0: 1000, 999: 1, 1000: 0, 1500: 0} {
+		got, capped := params.MaxSupplyHeadroom(sdkmath.NewInt(supply))
+		require.True(t, capped)
+		require.Equal(t, sdkmath.NewInt(headroom), got, "supply %d", supply)
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgAddFundedAddress = "add_funded_address"
+
+var _ sdk.Msg = &MsgAddFundedAddress{}
+
+func NewMsgAddFundedAddress(authority string, fundedAddress WeightedAddress) *MsgAddFundedAddress {
+	return &MsgAddFundedAddress{
+		Authority:     authority,
+		FundedAddress: fundedAddress,
+	}
+}
+
+func (msg *MsgAddFundedAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddFundedAddress) Type() string {
+	return TypeMsgAddFundedAddress
+}
+
+func (msg *MsgAddFundedAddress) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgAddFundedAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddFundedAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FundedAddress.Address); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid funded address (%s)", err)
+	}
+	if err := validateFundedAddressWeight(msg.FundedAddress.Weight); err != nil {
+		return err
+	}
+	// the modes and the funding window are validated as for a single funded address
+	single := msg.FundedAddress
+	single.Weight = sdk.OneDec()
+	if err := validateWeightedAddresses([]WeightedAddress{single}); err != nil {
+		return errors.Wrapf(ErrInvalidFundedAddress, "invalid funded address: %s", err)
+	}
+	return ValidateExpectedChainID(msg.ExpectedChainId)
+}
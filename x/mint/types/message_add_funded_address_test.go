@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgAddFundedAddress_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgAddFundedAddress
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: *types.NewMsgAddFundedAddress("invalid_address", types.WeightedAddress{
+				Address: sample.Address(sample.Rand()),
+				Weight:  sdk.NewDecWithPrec(5, 1),
+			}),
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid funded address",
+			msg: *types.NewMsgAddFundedAddress(sample.Address(sample.Rand()), types.WeightedAddress{
+				Address: "invalid_address",
+				Weight:  sdk.NewDecWithPrec(5, 1),
+			}),
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "zero weight",
+			msg: *types.NewMsgAddFundedAddress(sample.Address(sample.Rand()), types.WeightedAddress{
+				Address: sample.Address(sample.Rand()),
+				Weight:  sdk.ZeroDec(),
+			}),
+			err: types.ErrInvalidWeightSum,
+		}, {
+			name: "weight above 1",
+			msg: *types.NewMsgAddFundedAddress(sample.Address(sample.Rand()), types.WeightedAddress{
+				Address: sample.Address(sample.Rand()),
+				Weight:  sdk.NewDecWithPrec(11, 1),
+			}),
+			err: types.ErrInvalidWeightSum,
+		}, {
+			name: "invalid funding window",
+			msg: *types.NewMsgAddFundedAddress(sample.Address(sample.Rand()), types.WeightedAddress{
+				Address:     sample.Address(sample.Rand()),
+				Weight:      sdk.NewDecWithPrec(5, 1),
+				StartHeight: 10,
+				EndHeight:   5,
+			}),
+			err: types.ErrInvalidFundedAddress,
+		}, {
+			name: "invalid expected chain-id",
+			msg: types.MsgAddFundedAddress{
+				Authority: sample.Address(sample.Rand()),
+				FundedAddress: types.WeightedAddress{
+					Address: sample.Address(sample.Rand()),
+					Weight:  sdk.NewDecWithPrec(5, 1),
+				},
+				ExpectedChainId: "mint 1",
+			},
+			err: types.ErrInvalidChainID,
+		}, {
+			name: "valid message",
+			msg: *types.NewMsgAddFundedAddress(sample.Address(sample.Rand()), types.WeightedAddress{
+				Address:    sample.Address(sample.Rand()),
+				Weight:     sdk.NewDecWithPrec(5, 1),
+				PayoutMode: types.PAYOUT_MODE_PULL,
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgBurn = "burn"
+
+var _ sdk.Msg = &MsgBurn{}
+
+func NewMsgBurn(signer string, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Signer: signer,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgBurn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBurn) Type() string {
+	return TypeMsgBurn
+}
+
+func (msg *MsgBurn) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgBurn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errors.Wrapf(errors.ErrInvalidCoins, "invalid amount (%s)", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return errors.Wrapf(errors.ErrInvalidCoins, "burned amount must be positive: %s", msg.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgBurn_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgBurn
+		err  error
+	}{
+		{
+			name: "invalid signer",
+			msg: types.MsgBurn{
+				Signer: "invalid_address",
+				Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: types.MsgBurn{
+				Signer: sample.Address(sample.Rand()),
+				Amount: sdk.Coin{Denom: "1", Amount: sdkmath.NewInt(10)},
+			},
+			err: errors.ErrInvalidCoins,
+		}, {
+			name: "negative amount",
+			msg: types.MsgBurn{
+				Signer: sample.Address(sample.Rand()),
+				Amount: sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-10)},
+			},
+			err: errors.ErrInvalidCoins,
+		}, {
+			name: "zero amount",
+			msg: types.MsgBurn{
+				Signer: sample.Address(sample.Rand()),
+				Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+			},
+			err: errors.ErrInvalidCoins,
+		}, {
+			name: "valid message",
+			msg: types.MsgBurn{
+				Signer: sample.Address(sample.Rand()),
+				Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgClaimDistribution = "claim_distribution"
+
+var _ sdk.Msg = &MsgClaimDistribution{}
+
+func NewMsgClaimDistribution(address string) *MsgClaimDistribution {
+	return &MsgClaimDistribution{
+		Address: address,
+	}
+}
+
+func (msg *MsgClaimDistribution) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimDistribution) Type() string {
+	return TypeMsgClaimDistribution
+}
+
+func (msg *MsgClaimDistribution) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+func (msg *MsgClaimDistribution) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimDistribution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgClaimDistribution_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgClaimDistribution
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgClaimDistribution{
+				Address: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: types.MsgClaimDistribution{
+				Address: sample.Address(sample.Rand()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgFundMinter = "fund_minter"
+
+var _ sdk.Msg = &MsgFundMinter{}
+
+func NewMsgFundMinter(signer string, amount sdk.Coin) *MsgFundMinter {
+	return &MsgFundMinter{
+		Signer: signer,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgFundMinter) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundMinter) Type() string {
+	return TypeMsgFundMinter
+}
+
+func (msg *MsgFundMinter) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgFundMinter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundMinter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errors.Wrapf(errors.ErrInvalidCoins, "invalid amount (%s)", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return errors.Wrapf(errors.ErrInvalidCoins, "funded amount must be positive: %s", msg.Amount)
+	}
+	return nil
+}
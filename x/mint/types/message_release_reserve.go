@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgReleaseReserve = "release_reserve"
+
+var _ sdk.Msg = &MsgReleaseReserve{}
+
+func NewMsgReleaseReserve(authority, recipient string, amount sdk.Coins) *MsgReleaseReserve {
+	return &MsgReleaseReserve{
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgReleaseReserve) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseReserve) Type() string {
+	return TypeMsgReleaseReserve
+}
+
+func (msg *MsgReleaseReserve) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgReleaseReserve) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseReserve) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errors.Wrapf(errors.ErrInvalidCoins, "invalid amount (%s)", err)
+	}
+	if !msg.Amount.IsAllPositive() {
+		return errors.Wrapf(errors.ErrInvalidCoins, "released amount must be positive: %s", msg.Amount)
+	}
+	return ValidateExpectedChainID(msg.ExpectedChainId)
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgReleaseReserve_ValidateBasic(t *testing.T) {
+	r := sample.Rand()
+	tests := []struct {
+		name string
+		msg  types.MsgReleaseReserve
+		err  error
+	}{
+		{
+			name: "invalid authority address",
+			msg: types.MsgReleaseReserve{
+				Authority: "invalid_address",
+				Recipient: sample.Address(r),
+				Amount:    sample.Coins(r),
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient address",
+			msg: types.MsgReleaseReserve{
+				Authority: sample.Address(r),
+				Recipient: "invalid_address",
+				Amount:    sample.Coins(r),
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid amount",
+			msg: types.MsgReleaseReserve{
+				Authority: sample.Address(r),
+				Recipient: sample.Address(r),
+				Amount:    sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}},
+			},
+			err: errors.ErrInvalidCoins,
+		}, {
+			name: "empty amount",
+			msg: types.MsgReleaseReserve{
+				Authority: sample.Address(r),
+				Recipient: sample.Address(r),
+			},
+			err: errors.ErrInvalidCoins,
+		}, {
+			name: "valid message",
+			msg: types.MsgReleaseReserve{
+				Authority: sample.Address(r),
+				Recipient: sample.Address(r),
+				Amount:    sample.Coins(r),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
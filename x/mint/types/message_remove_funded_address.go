@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgRemoveFundedAddress = "remove_funded_address"
+
+var _ sdk.Msg = &MsgRemoveFundedAddress{}
+
+func NewMsgRemoveFundedAddress(authority, address string) *MsgRemoveFundedAddress {
+	return &MsgRemoveFundedAddress{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (msg *MsgRemoveFundedAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveFundedAddress) Type() string {
+	return TypeMsgRemoveFundedAddress
+}
+
+func (msg *MsgRemoveFundedAddress) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveFundedAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveFundedAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid funded address (%s)", err)
+	}
+	return ValidateExpectedChainID(msg.ExpectedChainId)
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgRemoveFundedAddress_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgRemoveFundedAddress
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *types.NewMsgRemoveFundedAddress("invalid_address", sample.Address(sample.Rand())),
+			err:  errors.ErrInvalidAddress,
+		}, {
+			name: "invalid funded address",
+			msg:  *types.NewMsgRemoveFundedAddress(sample.Address(sample.Rand()), "invalid_address"),
+			err:  errors.ErrInvalidAddress,
+		}, {
+			name: "invalid expected chain-id",
+			msg: types.MsgRemoveFundedAddress{
+				Authority:       sample.Address(sample.Rand()),
+				Address:         sample.Address(sample.Rand()),
+				ExpectedChainId: "mint 1",
+			},
+			err: types.ErrInvalidChainID,
+		}, {
+			name: "valid message",
+			msg:  *types.NewMsgRemoveFundedAddress(sample.Address(sample.Rand()), sample.Address(sample.Rand())),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgResumeMinting = "resume_minting"
+
+var _ sdk.Msg = &MsgResumeMinting{}
+
+func NewMsgResumeMinting(authority string) *MsgResumeMinting {
+	return &MsgResumeMinting{
+		Authority: authority,
+	}
+}
+
+func (msg *MsgResumeMinting) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResumeMinting) Type() string {
+	return TypeMsgResumeMinting
+}
+
+func (msg *MsgResumeMinting) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgResumeMinting) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResumeMinting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return ValidateExpectedChainID(msg.ExpectedChainId)
+}
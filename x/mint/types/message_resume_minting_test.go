@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgResumeMinting_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgResumeMinting
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgResumeMinting{
+				Authority: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid expected chain-id",
+			msg: types.MsgResumeMinting{
+				Authority:       sample.Address(sample.Rand()),
+				ExpectedChainId: "mint 1",
+			},
+			err: types.ErrInvalidChainID,
+		}, {
+			name: "valid message",
+			msg:  *types.NewMsgResumeMinting(sample.Address(sample.Rand())),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgRunMaintenance = "run_maintenance"
+
+var _ sdk.Msg = &MsgRunMaintenance{}
+
+func NewMsgRunMaintenance(signer string, limit uint64) *MsgRunMaintenance {
+	return &MsgRunMaintenance{
+		Signer: signer,
+		Limit:  limit,
+	}
+}
+
+func (msg *MsgRunMaintenance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRunMaintenance) Type() string {
+	return TypeMsgRunMaintenance
+}
+
+func (msg *MsgRunMaintenance) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgRunMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRunMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if msg.Limit > MaintenanceMaxLimit {
+		return errors.Wrapf(errors.ErrInvalidRequest, "limit %d exceeds the maximum limit %d", msg.Limit, MaintenanceMaxLimit)
+	}
+	return nil
+}
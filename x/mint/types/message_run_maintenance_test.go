@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgRunMaintenance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgRunMaintenance
+		err  error
+	}{
+		{
+			name: "invalid signer",
+			msg: types.MsgRunMaintenance{
+				Signer: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "limit above the maximum",
+			msg: types.MsgRunMaintenance{
+				Signer: sample.Address(sample.Rand()),
+				Limit:  types.MaintenanceMaxLimit + 1,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid message with the maximum limit",
+			msg: types.MsgRunMaintenance{
+				Signer: sample.Address(sample.Rand()),
+				Limit:  types.MaintenanceMaxLimit,
+			},
+		}, {
+			name: "valid message without limit",
+			msg: types.MsgRunMaintenance{
+				Signer: sample.Address(sample.Rand()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
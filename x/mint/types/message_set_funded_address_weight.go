@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgSetFundedAddressWeight = "set_funded_address_weight"
+
+var _ sdk.Msg = &MsgSetFundedAddressWeight{}
+
+func NewMsgSetFundedAddressWeight(authority, address string, weight sdk.Dec) *MsgSetFundedAddressWeight {
+	return &MsgSetFundedAddressWeight{
+		Authority: authority,
+		Address:   address,
+		Weight:    weight,
+	}
+}
+
+func (msg *MsgSetFundedAddressWeight) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetFundedAddressWeight) Type() string {
+	return TypeMsgSetFundedAddressWeight
+}
+
+func (msg *MsgSetFundedAddressWeight) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetFundedAddressWeight) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetFundedAddressWeight) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid funded address (%s)", err)
+	}
+	if err := validateFundedAddressWeight(msg.Weight); err != nil {
+		return err
+	}
+	return ValidateExpectedChainID(msg.ExpectedChainId)
+}
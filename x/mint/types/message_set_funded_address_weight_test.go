@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgSetFundedAddressWeight_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgSetFundedAddressWeight
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *types.NewMsgSetFundedAddressWeight("invalid_address", sample.Address(sample.Rand()), sdk.NewDecWithPrec(5, 1)),
+			err:  errors.ErrInvalidAddress,
+		}, {
+			name: "invalid funded address",
+			msg:  *types.NewMsgSetFundedAddressWeight(sample.Address(sample.Rand()), "invalid_address", sdk.NewDecWithPrec(5, 1)),
+			err:  errors.ErrInvalidAddress,
+		}, {
+			name: "nil weight",
+			msg: types.MsgSetFundedAddressWeight{
+				Authority: sample.Address(sample.Rand()),
+				Address:   sample.Address(sample.Rand()),
+			},
+			err: types.ErrInvalidWeightSum,
+		}, {
+			name: "negative weight",
+			msg:  *types.NewMsgSetFundedAddressWeight(sample.Address(sample.Rand()), sample.Address(sample.Rand()), sdk.NewDec(-1)),
+			err:  types.ErrInvalidWeightSum,
+		}, {
+			name: "weight above 1",
+			msg:  *types.NewMsgSetFundedAddressWeight(sample.Address(sample.Rand()), sample.Address(sample.Rand()), sdk.NewDec(2)),
+			err:  types.ErrInvalidWeightSum,
+		}, {
+			name: "invalid expected chain-id",
+			msg: types.MsgSetFundedAddressWeight{
+				Authority:       sample.Address(sample.Rand()),
+				Address:         sample.Address(sample.Rand()),
+				Weight:          sdk.NewDecWithPrec(5, 1),
+				ExpectedChainId: "mint 1",
+			},
+			err: types.ErrInvalidChainID,
+		}, {
+			name: "valid message",
+			msg:  *types.NewMsgSetFundedAddressWeight(sample.Address(sample.Rand()), sample.Address(sample.Rand()), sdk.OneDec()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
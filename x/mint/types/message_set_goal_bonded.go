@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgSetGoalBonded = "set_goal_bonded"
+
+var _ sdk.Msg = &MsgSetGoalBonded{}
+
+func NewMsgSetGoalBonded(authority string, goalBonded sdk.Dec, transitionBlocks uint64) *MsgSetGoalBonded {
+	return &MsgSetGoalBonded{
+		Authority:        authority,
+		GoalBonded:       goalBonded,
+		TransitionBlocks: transitionBlocks,
+	}
+}
+
+func (msg *MsgSetGoalBonded) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetGoalBonded) Type() string {
+	return TypeMsgSetGoalBonded
+}
+
+func (msg *MsgSetGoalBonded) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetGoalBonded) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetGoalBonded) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if err := ValidateExpectedChainID(msg.ExpectedChainId); err != nil {
+		return err
+	}
+	return ValidateGoalBonded(msg.GoalBonded)
+}
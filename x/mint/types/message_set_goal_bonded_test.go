@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgSetGoalBonded_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgSetGoalBonded
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgSetGoalBonded{
+				Authority:  "invalid_address",
+				GoalBonded: sdk.NewDecWithPrec(5, 1),
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "zero goal bonded",
+			msg: types.MsgSetGoalBonded{
+				Authority:  sample.Address(sample.Rand()),
+				GoalBonded: sdk.ZeroDec(),
+			},
+			err: types.ErrInvalidGoalBonded,
+		}, {
+			name: "goal bonded above one",
+			msg: types.MsgSetGoalBonded{
+				Authority:  sample.Address(sample.Rand()),
+				GoalBonded: sdk.NewDecWithPrec(11, 1),
+			},
+			err: types.ErrInvalidGoalBonded,
+		}, {
+			name: "nil goal bonded",
+			msg: types.MsgSetGoalBonded{
+				Authority: sample.Address(sample.Rand()),
+			},
+			err: types.ErrInvalidGoalBonded,
+		}, {
+			name: "valid message",
+			msg: types.MsgSetGoalBonded{
+				Authority:        sample.Address(sample.Rand()),
+				GoalBonded:       sdk.OneDec(),
+				TransitionBlocks: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
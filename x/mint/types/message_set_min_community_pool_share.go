@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgSetMinCommunityPoolShare = "set_min_community_pool_share"
+
+var _ sdk.Msg = &MsgSetMinCommunityPoolShare{}
+
+func NewMsgSetMinCommunityPoolShare(authority string, minCommunityPoolShare sdk.Dec) *MsgSetMinCommunityPoolShare {
+	return &MsgSetMinCommunityPoolShare{
+		Authority:             authority,
+		MinCommunityPoolShare: minCommunityPoolShare,
+	}
+}
+
+func (msg *MsgSetMinCommunityPoolShare) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetMinCommunityPoolShare) Type() string {
+	return TypeMsgSetMinCommunityPoolShare
+}
+
+func (msg *MsgSetMinCommunityPoolShare) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetMinCommunityPoolShare) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetMinCommunityPoolShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if err := ValidateExpectedChainID(msg.ExpectedChainId); err != nil {
+		return err
+	}
+	return ValidateMinCommunityPoolShareChange(msg.MinCommunityPoolShare, msg.AcknowledgeMinCommunityPoolShare)
+}
+
+// ValidateMinCommunityPoolShareChange checks the new min community pool share is in [0, 1] and
+// its change is acknowledged
+func ValidateMinCommunityPoolShareChange(minCommunityPoolShare sdk.Dec, acknowledged bool) error {
+	if err := validateMinCommunityPoolShare(minCommunityPoolShare); err != nil {
+		return errors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if !acknowledged {
+		return errors.Wrapf(
+			ErrMinCommunityPoolShare,
+			"the min community pool share %s binds every later change of the distribution proportions",
+			minCommunityPoolShare,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgSetMinCommunityPoolShare_ValidateBasic(t *testing.T) {
+	acknowledged := func(msg *types.MsgSetMinCommunityPoolShare) types.MsgSetMinCommunityPoolShare {
+		msg.AcknowledgeMinCommunityPoolShare = true
+		return *msg
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetMinCommunityPoolShare
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  acknowledged(types.NewMsgSetMinCommunityPoolShare("invalid_address", sdk.NewDecWithPrec(5, 2))),
+			err:  errors.ErrInvalidAddress,
+		}, {
+			name: "invalid expected chain-id",
+			msg: types.MsgSetMinCommunityPoolShare{
+				Authority:                        sample.Address(sample.Rand()),
+				MinCommunityPoolShare:            sdk.NewDecWithPrec(5, 2),
+				AcknowledgeMinCommunityPoolShare: true,
+				ExpectedChainId:                  "mint 1",
+			},
+			err: types.ErrInvalidChainID,
+		}, {
+			name: "nil min community pool share",
+			msg:  acknowledged(types.NewMsgSetMinCommunityPoolShare(sample.Address(sample.Rand()), sdk.Dec{})),
+			err:  types.ErrInvalidParams,
+		}, {
+			name: "negative min community pool share",
+			msg:  acknowledged(types.NewMsgSetMinCommunityPoolShare(sample.Address(sample.Rand()), sdk.NewDecWithPrec(-1, 2))),
+			err:  types.ErrInvalidParams,
+		}, {
+			name: "min community pool share greater than one",
+			msg:  acknowledged(types.NewMsgSetMinCommunityPoolShare(sample.Address(sample.Rand()), sdk.MustNewDecFromStr("1.01"))),
+			err:  types.ErrInvalidParams,
+		}, {
+			name: "unacknowledged min community pool share",
+			msg:  *types.NewMsgSetMinCommunityPoolShare(sample.Address(sample.Rand()), sdk.NewDecWithPrec(5, 2)),
+			err:  types.ErrMinCommunityPoolShare,
+		}, {
+			name: "valid message",
+			msg:  acknowledged(types.NewMsgSetMinCommunityPoolShare(sample.Address(sample.Rand()), sdk.NewDecWithPrec(5, 2))),
+		}, {
+			name: "valid message with a zero min community pool share",
+			msg:  acknowledged(types.NewMsgSetMinCommunityPoolShare(sample.Address(sample.Rand()), sdk.ZeroDec())),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
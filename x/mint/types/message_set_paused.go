@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgSetPaused = "set_paused"
+
+var _ sdk.Msg = &MsgSetPaused{}
+
+func NewMsgSetPaused(authority string, target PauseTarget, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Authority: authority,
+		Target:    target,
+		Paused:    paused,
+	}
+}
+
+func (msg *MsgSetPaused) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPaused) Type() string {
+	return TypeMsgSetPaused
+}
+
+func (msg *MsgSetPaused) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetPaused) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if err := ValidateExpectedChainID(msg.ExpectedChainId); err != nil {
+		return err
+	}
+	if _, ok := PauseTarget_name[int32(msg.Target)]; !ok {
+		return errors.Wrapf(ErrInvalidPauseTarget, "%d", msg.Target)
+	}
+	return nil
+}
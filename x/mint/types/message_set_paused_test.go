@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgSetPaused_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgSetPaused
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgSetPaused{
+				Authority: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid target",
+			msg: types.MsgSetPaused{
+				Authority: sample.Address(sample.Rand()),
+				Target:    types.PauseTarget(100),
+			},
+			err: types.ErrInvalidPauseTarget,
+		}, {
+			name: "invalid expected chain-id",
+			msg: types.MsgSetPaused{
+				Authority:       sample.Address(sample.Rand()),
+				ExpectedChainId: "mint 1",
+			},
+			err: types.ErrInvalidChainID,
+		}, {
+			name: "valid message",
+			msg: types.MsgSetPaused{
+				Authority: sample.Address(sample.Rand()),
+				Target:    types.PAUSE_TARGET_FUNDED_SHARE,
+				Paused:    true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
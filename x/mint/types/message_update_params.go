@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const TypeMsgUpdateParams = "update_params"
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if err := ValidateExpectedChainID(msg.ExpectedChainId); err != nil {
+		return err
+	}
+	if err := msg.ResolveInflationBasisPoints(); err != nil {
+		return err
+	}
+	// the funded addresses are validated and then stored in their canonical form
+	msg.Params.FundedAddresses = NormalizeWeightedAddresses(msg.Params.FundedAddresses)
+	if err := msg.Params.Validate(); err != nil {
+		return InvalidParamsError(err)
+	}
+	return nil
+}
+
+// ResolveInflationBasisPoints sets the inflation bounds of the params provided in basis points, the
+// bounds provided in both representations must be equal
+func (msg *MsgUpdateParams) ResolveInflationBasisPoints() (err error) {
+	if msg.Params.InflationMin, err = resolveBasisPoints("min inflation", msg.Params.InflationMin, msg.InflationMinBps); err != nil {
+		return err
+	}
+	msg.Params.InflationMax, err = resolveBasisPoints("max inflation", msg.Params.InflationMax, msg.InflationMaxBps)
+	return err
+}
@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	invalidParams := types.DefaultParams()
+	invalidParams.InflationMax = sdk.NewDec(2)
+	invalidProportions := types.DefaultParams()
+	invalidProportions.DistributionProportions.Staking = sdk.OneDec()
+	invalidWeightSum := types.DefaultParams()
+	invalidWeightSum.FundedAddresses = []types.WeightedAddress{
+		{Address: sample.Address(sample.Rand()), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgUpdateParams{
+				Authority: "invalid_address",
+				Params:    types.DefaultParams(),
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid params",
+			msg: types.MsgUpdateParams{
+				Authority: sample.Address(sample.Rand()),
+				Params:    invalidParams,
+			},
+			err: types.ErrInvalidParams,
+		}, {
+			name: "invalid distribution proportions",
+			msg: types.MsgUpdateParams{
+				Authority: sample.Address(sample.Rand()),
+				Params:    invalidProportions,
+			},
+			err: types.ErrInvalidProportions,
+		}, {
+			name: "invalid funded address weight sum",
+			msg: types.MsgUpdateParams{
+				Authority: sample.Address(sample.Rand()),
+				Params:    invalidWeightSum,
+			},
+			err: types.ErrInvalidWeightSum,
+		}, {
+			name: "negative basis points",
+			msg: types.MsgUpdateParams{
+				Authority:       sample.Address(sample.Rand()),
+				Params:          types.DefaultParams(),
+				InflationMinBps: types.NewBasisPoints(-1),
+			},
+			err: types.ErrInvalidBasisPoints,
+		}, {
+			name: "basis points above 10000",
+			msg: types.MsgUpdateParams{
+				Authority:       sample.Address(sample.Rand()),
+				Params:          types.DefaultParams(),
+				InflationMaxBps: types.NewBasisPoints(10001),
+			},
+			err: types.ErrInvalidBasisPoints,
+		}, {
+			name: "inconsistent basis points",
+			msg: types.MsgUpdateParams{
+				Authority:       sample.Address(sample.Rand()),
+				Params:          types.DefaultParams(),
+				InflationMinBps: types.NewBasisPoints(800),
+			},
+			err: types.ErrInvalidBasisPoints,
+		}, {
+			name: "valid message",
+			msg: types.MsgUpdateParams{
+				Authority: sample.Address(sample.Rand()),
+				Params:    types.DefaultParams(),
+			},
+		}, {
+			name: "valid message with consistent basis points",
+			msg: types.MsgUpdateParams{
+				Authority:       sample.Address(sample.Rand()),
+				Params:          types.DefaultParams(),
+				InflationMinBps: types.NewBasisPoints(700),
+				InflationMaxBps: types.NewBasisPoints(2000),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateParams_ValidateBasicNormalizesFundedAddresses(t *testing.T) {
+	addr := sample.Address(sample.Rand())
+	params := types.DefaultParams()
+	params.FundedAddresses = []types.WeightedAddress{
+		{Address: mixedCase(addr), Weight: sdk.OneDec()},
+	}
+	msg := types.NewMsgUpdateParams(sample.Address(sample.Rand()), params)
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, addr, msg.Params.FundedAddresses[0].Address)
+
+	// the address duplicated once normalized is rejected
+	msg.Params.FundedAddresses = []types.WeightedAddress{
+		{Address: addr, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: strings.ToUpper(addr), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidFundedAddress)
+}
+
+func TestMsgUpdateParams_ValidateBasicResolvesInflationBasisPoints(t *testing.T) {
+	params := types.DefaultParams()
+	params.InflationMin = sdk.Dec{}
+	params.InflationMax = sdk.Dec{}
+	msg := types.NewMsgUpdateParams(sample.Address(sample.Rand()), params)
+	msg.InflationMinBps = types.NewBasisPoints(150)
+	msg.InflationMaxBps = types.NewBasisPoints(1500)
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, sdk.NewDecWithPrec(15, 3), msg.Params.InflationMin)
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), msg.Params.InflationMax)
+
+	// the bounds are still validated once converted
+	msg.Params.InflationMin = sdk.Dec{}
+	msg.InflationMinBps = types.NewBasisPoints(2000)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidParams)
+}
@@ -0,0 +1,112 @@
+package types
+
+// The names and the label keys of the telemetry metrics of the module. Dashboards and alerts are
+// built against them, they are part of the API of the module and must not be renamed. The metrics
+// are emitted with the module label set to ModuleName, the Prometheus name of a metric is its name
+// prefixed by the service name of the telemetry config of the node. The amounts of tokens are in the
+// display denom of the metadata of their denom, in the denom itself if it has no metadata.
+const (
+	// MetricKeyBeginBlocker is the sample of the duration of the begin blocker
+	MetricKeyBeginBlocker = "begin_blocker"
+
+	// MetricKeyMintedTokens is the gauge of the amount of tokens minted in the last block
+	MetricKeyMintedTokens = "minted_tokens"
+
+	// MetricKeyInflation is the gauge of the inflation of the minter
+	MetricKeyInflation = "inflation"
+
+	// MetricKeyAnnualProvisions is the gauge of the annual provisions of the minter
+	MetricKeyAnnualProvisions = "annual_provisions"
+
+	// MetricKeyBondedRatio is the gauge of the bonded ratio of the last block
+	MetricKeyBondedRatio = "bonded_ratio"
+
+	// MetricKeyDistributedTokens is the counter of the amount of tokens distributed to a
+	// destination
+	MetricKeyDistributedTokens = "distributed_tokens"
+
+	// MetricKeyStageDuration is the sample of the duration of a stage of the begin blocker of a
+	// profiled keeper
+	MetricKeyStageDuration = "stage_duration"
+
+	// MetricKeyStageGas is the counter of the gas consumed by a stage of the begin blocker of a
+	// profiled keeper
+	MetricKeyStageGas = "stage_gas"
+
+	// MetricLabelModule is the key of the label of the module emitting the metric
+	MetricLabelModule = "module"
+
+	// MetricLabelDenom is the key of the label of the denom of the distributed tokens
+	MetricLabelDenom = "denom"
+
+	// MetricLabelDestination is the key of the label of the destination of the distributed tokens
+	MetricLabelDestination = "destination"
+
+	// MetricLabelAddress is the key of the label of the funded address of the distributed tokens
+	MetricLabelAddress = "address"
+
+	// MetricLabelStage is the key of the label of the profiled stage of the begin blocker
+	MetricLabelStage = "stage"
+
+	// MetricDestinationStaking is the destination of the staking share
+	MetricDestinationStaking = "staking"
+
+	// MetricDestinationCommunityPool is the destination of the community pool share
+	MetricDestinationCommunityPool = "community_pool"
+
+	// MetricDestinationFundedAddress is the destination of the shares of the funded addresses
+	MetricDestinationFundedAddress = "funded_address"
+
+	// MetricAddressOther is the address label of the shares of the funded addresses when they
+	// are more than MetricMaxFundedAddressLabels
+	MetricAddressOther = "other"
+
+	// MetricMaxFundedAddressLabels is the maximum number of funded addresses labeled by their
+	// address, the shares of more funded addresses are summed to bound the cardinality
+	MetricMaxFundedAddressLabels = 10
+)
+
+// The stages of the begin blocker profiled by a keeper with profiling, the stages nest: the
+// distribution legs are part of the distribution, itself part of the mint denom stage.
+const (
+	// ProfileStageMintDenom is the minting of the coins of the mint denom
+	ProfileStageMintDenom = "mint_denom"
+
+	// ProfileStageMintCoins is the minting of the coins by the bank module
+	ProfileStageMintCoins = "mint_coins"
+
+	// ProfileStageDistribution is the distribution of the minted coins
+	ProfileStageDistribution = "distribution"
+
+	// ProfileStageDistributionStaking is the distribution leg of the staking share
+	ProfileStageDistributionStaking = "distribution_staking"
+
+	// ProfileStageDistributionFundedAddresses is the distribution leg of the shares of the funded
+	// addresses
+	ProfileStageDistributionFundedAddresses = "distribution_funded_addresses"
+
+	// ProfileStageDistributionCommunityPool is the distribution leg of the community pool share
+	ProfileStageDistributionCommunityPool = "distribution_community_pool"
+
+	// ProfileStageHooks is the call of the hooks after the distribution
+	ProfileStageHooks = "hooks"
+
+	// ProfileStageMintConfigs is the minting of the denoms of the mint configurations
+	ProfileStageMintConfigs = "mint_configs"
+
+	// ProfileStageFlush is the flush of the batched writes of the block
+	ProfileStageFlush = "flush"
+)
+
+// ProfileStages are the stages of the begin blocker profiled by a keeper with profiling
+var ProfileStages = []string{
+	ProfileStageMintDenom,
+	ProfileStageMintCoins,
+	ProfileStageDistribution,
+	ProfileStageDistributionStaking,
+	ProfileStageDistributionFundedAddresses,
+	ProfileStageDistributionCommunityPool,
+	ProfileStageHooks,
+	ProfileStageMintConfigs,
+	ProfileStageFlush,
+}
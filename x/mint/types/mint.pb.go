@@ -7,17 +7,22 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,12 +30,339 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PausedShareMode defines what happens to the share of a paused distribution
+// category.
+type PausedShareMode int32
+
+const (
+	// the share is sent to the community pool
+	PAUSED_SHARE_MODE_COMMUNITY_POOL PausedShareMode = 0
+	// the share is buffered in the module account until the category is resumed
+	PAUSED_SHARE_MODE_BUFFER PausedShareMode = 1
+)
+
+var PausedShareMode_name = map[int32]string{
+	0: "PAUSED_SHARE_MODE_COMMUNITY_POOL",
+	1: "PAUSED_SHARE_MODE_BUFFER",
+}
+
+var PausedShareMode_value = map[string]int32{
+	"PAUSED_SHARE_MODE_COMMUNITY_POOL": 0,
+	"PAUSED_SHARE_MODE_BUFFER":         1,
+}
+
+func (x PausedShareMode) String() string {
+	return proto.EnumName(PausedShareMode_name, int32(x))
+}
+
+func (PausedShareMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{0}
+}
+
+// SupplySourceMode defines how the supply of the supply source of the keeper
+// is used to compute the annual provisions, the staking keeper supply is used
+// if the keeper has no supply source.
+type SupplySourceMode int32
+
+const (
+	// the supply of the supply source replaces the staking keeper supply
+	SUPPLY_SOURCE_MODE_REPLACE SupplySourceMode = 0
+	// the largest of the supply source and the staking keeper supplies is used
+	SUPPLY_SOURCE_MODE_MAX SupplySourceMode = 1
+)
+
+var SupplySourceMode_name = map[int32]string{
+	0: "SUPPLY_SOURCE_MODE_REPLACE",
+	1: "SUPPLY_SOURCE_MODE_MAX",
+}
+
+var SupplySourceMode_value = map[string]int32{
+	"SUPPLY_SOURCE_MODE_REPLACE": 0,
+	"SUPPLY_SOURCE_MODE_MAX":     1,
+}
+
+func (x SupplySourceMode) String() string {
+	return proto.EnumName(SupplySourceMode_name, int32(x))
+}
+
+func (SupplySourceMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{1}
+}
+
+// InflationCalculationMode defines how the inflation rate of a block is
+// calculated from the inflation rate of the previous block, the inflation rate
+// is bounded by the min and max inflation in both modes.
+type InflationCalculationMode int32
+
+const (
+	// the inflation rate moves toward the goal bonded ratio by up to the
+	// inflation rate change per year, like the Cosmos SDK mint module
+	INFLATION_CALCULATION_MODE_GOAL_BONDED InflationCalculationMode = 0
+	// the inflation rate decreases by the inflation rate change per year
+	// regardless of the bonded ratio
+	INFLATION_CALCULATION_MODE_LINEAR InflationCalculationMode = 1
+)
+
+var InflationCalculationMode_name = map[int32]string{
+	0: "INFLATION_CALCULATION_MODE_GOAL_BONDED",
+	1: "INFLATION_CALCULATION_MODE_LINEAR",
+}
+
+var InflationCalculationMode_value = map[string]int32{
+	"INFLATION_CALCULATION_MODE_GOAL_BONDED": 0,
+	"INFLATION_CALCULATION_MODE_LINEAR":      1,
+}
+
+func (x InflationCalculationMode) String() string {
+	return proto.EnumName(InflationCalculationMode_name, int32(x))
+}
+
+func (InflationCalculationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{2}
+}
+
+// CommunityFundingSource defines a source of the top-up of the community pool
+// funding below the minimum annual community funding.
+type CommunityFundingSource int32
+
+const (
+	// the top-up is minted in addition to the block provision, within the
+	// provision at the maximum inflation rate
+	COMMUNITY_FUNDING_SOURCE_MINT CommunityFundingSource = 0
+	// the top-up is reallocated from the staking share of the block
+	COMMUNITY_FUNDING_SOURCE_STAKING CommunityFundingSource = 1
+)
+
+var CommunityFundingSource_name = map[int32]string{
+	0: "COMMUNITY_FUNDING_SOURCE_MINT",
+	1: "COMMUNITY_FUNDING_SOURCE_STAKING",
+}
+
+var CommunityFundingSource_value = map[string]int32{
+	"COMMUNITY_FUNDING_SOURCE_MINT":    0,
+	"COMMUNITY_FUNDING_SOURCE_STAKING": 1,
+}
+
+func (x CommunityFundingSource) String() string {
+	return proto.EnumName(CommunityFundingSource_name, int32(x))
+}
+
+func (CommunityFundingSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{3}
+}
+
+// DustAssignment defines the recipient of the truncation remainder of the
+// funded addresses share.
+type DustAssignment int32
+
+const (
+	// the remainder is kept in the module account
+	DUST_ASSIGNMENT_MODULE_ACCOUNT DustAssignment = 0
+	// the remainder is assigned to a distribution category rotating with the
+	// block height
+	DUST_ASSIGNMENT_ROUND_ROBIN DustAssignment = 1
+)
+
+var DustAssignment_name = map[int32]string{
+	0: "DUST_ASSIGNMENT_MODULE_ACCOUNT",
+	1: "DUST_ASSIGNMENT_ROUND_ROBIN",
+}
+
+var DustAssignment_value = map[string]int32{
+	"DUST_ASSIGNMENT_MODULE_ACCOUNT": 0,
+	"DUST_ASSIGNMENT_ROUND_ROBIN":    1,
+}
+
+func (x DustAssignment) String() string {
+	return proto.EnumName(DustAssignment_name, int32(x))
+}
+
+func (DustAssignment) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{4}
+}
+
+// DustPolicy defines the recipient of the truncation remainder of the split of
+// the minted coins between the distribution categories.
+type DustPolicy int32
+
+const (
+	// the remainder is sent to the community pool with its share
+	DUST_POLICY_COMMUNITY_POOL DustPolicy = 0
+	// the remainder is added to the share of the first funded address
+	DUST_POLICY_FIRST_RECIPIENT DustPolicy = 1
+	// the remainder is kept in the module account and split with the minted
+	// coins of the next block
+	DUST_POLICY_CARRY_OVER DustPolicy = 2
+)
+
+var DustPolicy_name = map[int32]string{
+	0: "DUST_POLICY_COMMUNITY_POOL",
+	1: "DUST_POLICY_FIRST_RECIPIENT",
+	2: "DUST_POLICY_CARRY_OVER",
+}
+
+var DustPolicy_value = map[string]int32{
+	"DUST_POLICY_COMMUNITY_POOL":  0,
+	"DUST_POLICY_FIRST_RECIPIENT": 1,
+	"DUST_POLICY_CARRY_OVER":      2,
+}
+
+func (x DustPolicy) String() string {
+	return proto.EnumName(DustPolicy_name, int32(x))
+}
+
+func (DustPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{5}
+}
+
+// ShortfallPolicy defines how the coins minted for a block are allocated to the
+// distribution categories when the max supply reduces them below the block
+// provision.
+type ShortfallPolicy int32
+
+const (
+	// the minted coins are split by the distribution proportions, every
+	// category bears the shortfall in proportion of its share
+	SHORTFALL_POLICY_PRO_RATA ShortfallPolicy = 0
+	// the categories receive their share of the provision in the order of the
+	// shortfall priority until the minted coins are exhausted
+	SHORTFALL_POLICY_PRIORITY ShortfallPolicy = 1
+)
+
+var ShortfallPolicy_name = map[int32]string{
+	0: "SHORTFALL_POLICY_PRO_RATA",
+	1: "SHORTFALL_POLICY_PRIORITY",
+}
+
+var ShortfallPolicy_value = map[string]int32{
+	"SHORTFALL_POLICY_PRO_RATA": 0,
+	"SHORTFALL_POLICY_PRIORITY": 1,
+}
+
+func (x ShortfallPolicy) String() string {
+	return proto.EnumName(ShortfallPolicy_name, int32(x))
+}
+
+func (ShortfallPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{6}
+}
+
+// PayoutMode defines how the share of a funded address is paid out.
+type PayoutMode int32
+
+const (
+	// the share is sent to the address at each block
+	PAYOUT_MODE_PUSH PayoutMode = 0
+	// the share is accumulated in the module account until claimed by the
+	// address
+	PAYOUT_MODE_PULL PayoutMode = 1
+)
+
+var PayoutMode_name = map[int32]string{
+	0: "PAYOUT_MODE_PUSH",
+	1: "PAYOUT_MODE_PULL",
+}
+
+var PayoutMode_value = map[string]int32{
+	"PAYOUT_MODE_PUSH": 0,
+	"PAYOUT_MODE_PULL": 1,
+}
+
+func (x PayoutMode) String() string {
+	return proto.EnumName(PayoutMode_name, int32(x))
+}
+
+func (PayoutMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{7}
+}
+
+// WeightMode defines how the weight of a funded address is applied.
+type WeightMode int32
+
+const (
+	// the address receives its fixed weight of the funded addresses share
+	WEIGHT_MODE_FIXED WeightMode = 0
+	// the weights of the stake weighted addresses are pooled and split between
+	// them proportionally to their bonded delegations at each distribution,
+	// equally when none of them has bonded delegations
+	WEIGHT_MODE_STAKE_WEIGHTED WeightMode = 1
+)
+
+var WeightMode_name = map[int32]string{
+	0: "WEIGHT_MODE_FIXED",
+	1: "WEIGHT_MODE_STAKE_WEIGHTED",
+}
+
+var WeightMode_value = map[string]int32{
+	"WEIGHT_MODE_FIXED":          0,
+	"WEIGHT_MODE_STAKE_WEIGHTED": 1,
+}
+
+func (x WeightMode) String() string {
+	return proto.EnumName(WeightMode_name, int32(x))
+}
+
+func (WeightMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{8}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 	// current annual expected provisions
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	// provisions not yet minted because they were below the minimum
+	// distributable provision, including the fractional remainder
+	CarryBuffer github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=carry_buffer,json=carryBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"carry_buffer"`
+	// shares of paused distribution categories buffered in the module account
+	PausedShares PausedShares `protobuf:"bytes,4,opt,name=paused_shares,json=pausedShares,proto3" json:"paused_shares"`
+	// inputs of the inflation decision of the last block
+	LastBlockInputs BlockInputs `protobuf:"bytes,5,opt,name=last_block_inputs,json=lastBlockInputs,proto3" json:"last_block_inputs"`
+	// total amount of coins minted since the minter has been initialized
+	CumulativeMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=cumulative_minted,json=cumulativeMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cumulative_minted"`
+	// total amounts distributed to each category, the cumulative minted amount
+	// is the sum of these totals and the buffered paused shares
+	CumulativeDistributed CategoryTotals `protobuf:"bytes,7,opt,name=cumulative_distributed,json=cumulativeDistributed,proto3" json:"cumulative_distributed"`
+	// goal_bonded_transition is the pending transition of the goal bonded ratio
+	GoalBondedTransition *GoalBondedTransition `protobuf:"bytes,8,opt,name=goal_bonded_transition,json=goalBondedTransition,proto3" json:"goal_bonded_transition,omitempty"`
+	// community pool funding of the current budget year
+	CommunityFunding CommunityFunding `protobuf:"bytes,9,opt,name=community_funding,json=communityFunding,proto3" json:"community_funding"`
+	// truncation remainders of the funded addresses share kept in the module
+	// account
+	BufferedDust github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=buffered_dust,json=bufferedDust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"buffered_dust"`
+	// integral of the block provisions of the configured schedule, including
+	// the blocks with minting paused, the realized emissions drift from it
+	TargetCumulativeEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=target_cumulative_emission,json=targetCumulativeEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_cumulative_emission"`
+	// pending_payouts are the shares of the funded addresses in pull payout
+	// mode buffered in the module account until claimed
+	PendingPayouts []PendingPayout `protobuf:"bytes,12,rep,name=pending_payouts,json=pendingPayouts,proto3" json:"pending_payouts"`
+	// cumulative_community_pool_funding is the cumulative amount sent to the
+	// community pool by label of its sources, counted since the labels are
+	// tracked
+	CumulativeCommunityPoolFunding []CommunityPoolFundingTotal `protobuf:"bytes,13,rep,name=cumulative_community_pool_funding,json=cumulativeCommunityPoolFunding,proto3" json:"cumulative_community_pool_funding"`
+	// strategic_reserve is the strategic reserve share of the minted coins
+	// locked in the module account until released by the authority
+	StrategicReserve github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=strategic_reserve,json=strategicReserve,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"strategic_reserve"`
+	// strategic_reserve_released is the cumulative amount released from the
+	// strategic reserve by the authority
+	StrategicReserveReleased github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=strategic_reserve_released,json=strategicReserveReleased,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"strategic_reserve_released"`
+	// denom_minters is the state of the minting of the denoms of the mint
+	// configurations, by denom
+	DenomMinters []DenomMinter `protobuf:"bytes,16,rep,name=denom_minters,json=denomMinters,proto3" json:"denom_minters"`
+	// total amount of the mint denom funded with MsgFundMinter and distributed
+	// with the minted coins, not part of the cumulative minted amount
+	CumulativeFunded github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=cumulative_funded,json=cumulativeFunded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cumulative_funded"`
+	// number of consecutive blocks the distribution of the minted coins of the
+	// mint denom failed, reset by a successful distribution
+	ConsecutiveDistributionFailures uint64 `protobuf:"varint,18,opt,name=consecutive_distribution_failures,json=consecutiveDistributionFailures,proto3" json:"consecutive_distribution_failures,omitempty"`
+	// auto_paused is set when minting has been paused by the auto pause
+	// threshold, minting is resumed with MsgResumeMinting
+	AutoPaused bool `protobuf:"varint,19,opt,name=auto_paused,json=autoPaused,proto3" json:"auto_paused,omitempty"`
+	// blocks_per_year_transition is the pending transition of the blocks per
+	// year used to compute the block provision after a change of the blocks per
+	// year param
+	BlocksPerYearTransition *BlocksPerYearTransition `protobuf:"bytes,20,opt,name=blocks_per_year_transition,json=blocksPerYearTransition,proto3" json:"blocks_per_year_transition,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -66,23 +398,123 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
-type WeightedAddress struct {
-	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+func (m *Minter) GetPausedShares() PausedShares {
+	if m != nil {
+		return m.PausedShares
+	}
+	return PausedShares{}
 }
 
-func (m *WeightedAddress) Reset()         { *m = WeightedAddress{} }
-func (m *WeightedAddress) String() string { return proto.CompactTextString(m) }
-func (*WeightedAddress) ProtoMessage()    {}
-func (*WeightedAddress) Descriptor() ([]byte, []int) {
+func (m *Minter) GetLastBlockInputs() BlockInputs {
+	if m != nil {
+		return m.LastBlockInputs
+	}
+	return BlockInputs{}
+}
+
+func (m *Minter) GetCumulativeDistributed() CategoryTotals {
+	if m != nil {
+		return m.CumulativeDistributed
+	}
+	return CategoryTotals{}
+}
+
+func (m *Minter) GetGoalBondedTransition() *GoalBondedTransition {
+	if m != nil {
+		return m.GoalBondedTransition
+	}
+	return nil
+}
+
+func (m *Minter) GetCommunityFunding() CommunityFunding {
+	if m != nil {
+		return m.CommunityFunding
+	}
+	return CommunityFunding{}
+}
+
+func (m *Minter) GetBufferedDust() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BufferedDust
+	}
+	return nil
+}
+
+func (m *Minter) GetPendingPayouts() []PendingPayout {
+	if m != nil {
+		return m.PendingPayouts
+	}
+	return nil
+}
+
+func (m *Minter) GetCumulativeCommunityPoolFunding() []CommunityPoolFundingTotal {
+	if m != nil {
+		return m.CumulativeCommunityPoolFunding
+	}
+	return nil
+}
+
+func (m *Minter) GetStrategicReserve() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StrategicReserve
+	}
+	return nil
+}
+
+func (m *Minter) GetStrategicReserveReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StrategicReserveReleased
+	}
+	return nil
+}
+
+func (m *Minter) GetDenomMinters() []DenomMinter {
+	if m != nil {
+		return m.DenomMinters
+	}
+	return nil
+}
+
+func (m *Minter) GetConsecutiveDistributionFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveDistributionFailures
+	}
+	return 0
+}
+
+func (m *Minter) GetAutoPaused() bool {
+	if m != nil {
+		return m.AutoPaused
+	}
+	return false
+}
+
+func (m *Minter) GetBlocksPerYearTransition() *BlocksPerYearTransition {
+	if m != nil {
+		return m.BlocksPerYearTransition
+	}
+	return nil
+}
+
+// CommunityPoolFundingTotal is the cumulative amount sent to the community
+// pool with a label.
+type CommunityPoolFundingTotal struct {
+	Label  string                                   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CommunityPoolFundingTotal) Reset()         { *m = CommunityPoolFundingTotal{} }
+func (m *CommunityPoolFundingTotal) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolFundingTotal) ProtoMessage()    {}
+func (*CommunityPoolFundingTotal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5baeea81b02a834f, []int{1}
 }
-func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+func (m *CommunityPoolFundingTotal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommunityPoolFundingTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommunityPoolFundingTotal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -92,49 +524,51 @@ func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *WeightedAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WeightedAddress.Merge(m, src)
+func (m *CommunityPoolFundingTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolFundingTotal.Merge(m, src)
 }
-func (m *WeightedAddress) XXX_Size() int {
+func (m *CommunityPoolFundingTotal) XXX_Size() int {
 	return m.Size()
 }
-func (m *WeightedAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+func (m *CommunityPoolFundingTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolFundingTotal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+var xxx_messageInfo_CommunityPoolFundingTotal proto.InternalMessageInfo
 
-func (m *WeightedAddress) GetAddress() string {
+func (m *CommunityPoolFundingTotal) GetLabel() string {
 	if m != nil {
-		return m.Address
+		return m.Label
 	}
 	return ""
 }
 
-type DistributionProportions struct {
-	// staking defines the proportion of the minted minted_denom that is to be
-	// allocated as staking rewards.
-	Staking github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking"`
-	// funded_addresses defines the proportion of the minted minted_denom that is
-	// to the set of funded addresses.
-	FundedAddresses github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=funded_addresses,json=fundedAddresses,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funded_addresses"`
-	// community_pool defines the proportion of the minted minted_denom that is
-	// to be allocated to the community pool.
-	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool"`
+func (m *CommunityPoolFundingTotal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
-func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
-func (*DistributionProportions) ProtoMessage()    {}
-func (*DistributionProportions) Descriptor() ([]byte, []int) {
+// LedgerEntry is a typed sub-balance of the coins buffered in the module
+// account.
+type LedgerEntry struct {
+	Name   string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5baeea81b02a834f, []int{2}
 }
-func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DistributionProportions.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -144,49 +578,56 @@ func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *DistributionProportions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DistributionProportions.Merge(m, src)
+func (m *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(m, src)
 }
-func (m *DistributionProportions) XXX_Size() int {
+func (m *LedgerEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *DistributionProportions) XXX_DiscardUnknown() {
-	xxx_messageInfo_DistributionProportions.DiscardUnknown(m)
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
 
-// Params holds parameters for the mint module.
-type Params struct {
-	// type of coin to mint
-	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
-	// maximum annual change in inflation rate
-	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change"`
-	// maximum inflation rate
-	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max"`
-	// minimum inflation rate
-	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min"`
-	// goal of percent bonded coins
-	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
-	// expected blocks per year
-	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
-	// distribution_proportions defines the proportion of the minted denom
-	DistributionProportions DistributionProportions `protobuf:"bytes,7,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
-	// list of funded addresses
-	FundedAddresses []WeightedAddress `protobuf:"bytes,8,rep,name=funded_addresses,json=fundedAddresses,proto3" json:"funded_addresses"`
+func (m *LedgerEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *LedgerEntry) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// CommunityFunding tracks the community pool funding of a budget year, the
+// funding of the year is the community pool total of the cumulative
+// distributed amounts minus its value at the start of the year.
+type CommunityFunding struct {
+	// index of the budget year, the budget year N spans the heights from
+	// N * blocks_per_year + 1 to (N + 1) * blocks_per_year
+	BudgetYear int64 `protobuf:"varint,1,opt,name=budget_year,json=budgetYear,proto3" json:"budget_year,omitempty"`
+	// community pool total of the cumulative distributed amounts at the start
+	// of the budget year
+	YearStartCommunityPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=year_start_community_pool,json=yearStartCommunityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"year_start_community_pool"`
+}
+
+func (m *CommunityFunding) Reset()         { *m = CommunityFunding{} }
+func (m *CommunityFunding) String() string { return proto.CompactTextString(m) }
+func (*CommunityFunding) ProtoMessage()    {}
+func (*CommunityFunding) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5baeea81b02a834f, []int{3}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *CommunityFunding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommunityFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommunityFunding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -196,407 +637,5533 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *CommunityFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityFunding.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *CommunityFunding) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *CommunityFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityFunding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_CommunityFunding proto.InternalMessageInfo
 
-func (m *Params) GetMintDenom() string {
+func (m *CommunityFunding) GetBudgetYear() int64 {
 	if m != nil {
-		return m.MintDenom
+		return m.BudgetYear
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetBlocksPerYear() uint64 {
+// GoalBondedTransition is a linear transition of the goal bonded ratio used to
+// compute the inflation rate, the goal moves from `from` at start_height to
+// `to` at end_height.
+type GoalBondedTransition struct {
+	From        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=from,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"from"`
+	To          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=to,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"to"`
+	StartHeight int64                                  `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64                                  `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *GoalBondedTransition) Reset()         { *m = GoalBondedTransition{} }
+func (m *GoalBondedTransition) String() string { return proto.CompactTextString(m) }
+func (*GoalBondedTransition) ProtoMessage()    {}
+func (*GoalBondedTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{4}
+}
+func (m *GoalBondedTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GoalBondedTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GoalBondedTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GoalBondedTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GoalBondedTransition.Merge(m, src)
+}
+func (m *GoalBondedTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *GoalBondedTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_GoalBondedTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GoalBondedTransition proto.InternalMessageInfo
+
+func (m *GoalBondedTransition) GetStartHeight() int64 {
 	if m != nil {
-		return m.BlocksPerYear
+		return m.StartHeight
 	}
 	return 0
 }
 
-func (m *Params) GetDistributionProportions() DistributionProportions {
+func (m *GoalBondedTransition) GetEndHeight() int64 {
 	if m != nil {
-		return m.DistributionProportions
+		return m.EndHeight
 	}
-	return DistributionProportions{}
+	return 0
 }
 
-func (m *Params) GetFundedAddresses() []WeightedAddress {
-	if m != nil {
-		return m.FundedAddresses
+// BlocksPerYearTransition is a linear transition of the blocks per year used to
+// compute the block provision, the blocks per year move from `from` at
+// start_height to `to` at end_height.
+type BlocksPerYearTransition struct {
+	From        uint64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To          uint64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	StartHeight int64  `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64  `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *BlocksPerYearTransition) Reset()         { *m = BlocksPerYearTransition{} }
+func (m *BlocksPerYearTransition) String() string { return proto.CompactTextString(m) }
+func (*BlocksPerYearTransition) ProtoMessage()    {}
+func (*BlocksPerYearTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{5}
+}
+func (m *BlocksPerYearTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlocksPerYearTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlocksPerYearTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *BlocksPerYearTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksPerYearTransition.Merge(m, src)
+}
+func (m *BlocksPerYearTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlocksPerYearTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksPerYearTransition.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterType((*Minter)(nil), "modules.mint.Minter")
-	proto.RegisterType((*WeightedAddress)(nil), "modules.mint.WeightedAddress")
-	proto.RegisterType((*DistributionProportions)(nil), "modules.mint.DistributionProportions")
-	proto.RegisterType((*Params)(nil), "modules.mint.Params")
+var xxx_messageInfo_BlocksPerYearTransition proto.InternalMessageInfo
+
+func (m *BlocksPerYearTransition) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("modules/mint/mint.proto", fileDescriptor_5baeea81b02a834f) }
+func (m *BlocksPerYearTransition) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
 
-var fileDescriptor_5baeea81b02a834f = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0xad, 0x6b, 0xa9, 0xbb, 0xd2, 0x61, 0x86, 0x1a, 0x26, 0x2d, 0xad, 0x2a, 0x31,
-	0xf5, 0xd2, 0x54, 0x2a, 0x37, 0xc4, 0x81, 0x95, 0x5e, 0x87, 0xaa, 0x80, 0x40, 0x4c, 0x42, 0x91,
-	0x9b, 0xb8, 0xa9, 0xd5, 0xc4, 0x8e, 0x6c, 0x07, 0xda, 0x6f, 0xc1, 0x11, 0x89, 0x0b, 0x07, 0x3e,
-	0xc2, 0xee, 0x5c, 0x77, 0x63, 0xda, 0x09, 0x71, 0x98, 0x50, 0xfb, 0x45, 0x90, 0x93, 0x34, 0x2b,
-	0x15, 0xbb, 0xe5, 0xd2, 0x3a, 0xef, 0x39, 0xbf, 0xe7, 0xf7, 0xe2, 0xff, 0x1f, 0x34, 0x02, 0xe6,
-	0x46, 0x3e, 0x16, 0xbd, 0x80, 0x50, 0x19, 0xff, 0x98, 0x21, 0x67, 0x92, 0xc1, 0xfd, 0x34, 0x61,
-	0xaa, 0xd8, 0xd1, 0xa1, 0xc7, 0x3c, 0x16, 0x27, 0x7a, 0x6a, 0x95, 0xec, 0x39, 0x7a, 0xec, 0x30,
-	0x11, 0x30, 0x61, 0x27, 0x89, 0xe4, 0x21, 0x49, 0xb5, 0x7f, 0x6a, 0xa0, 0x74, 0x46, 0xa8, 0xc4,
-	0x1c, 0x9e, 0x83, 0x0a, 0xa1, 0x13, 0x1f, 0x49, 0xc2, 0xa8, 0xae, 0xb5, 0xb4, 0x4e, 0x65, 0xf0,
-	0xfc, 0xf2, 0xa6, 0x59, 0xf8, 0x7d, 0xd3, 0x3c, 0xf1, 0x88, 0x9c, 0x46, 0x63, 0xd3, 0x61, 0x41,
-	0xfa, 0x7a, 0xfa, 0xd7, 0x15, 0xee, 0xac, 0x27, 0x17, 0x21, 0x16, 0xe6, 0x10, 0x3b, 0xd7, 0x17,
-	0x5d, 0x90, 0xd2, 0x87, 0xd8, 0xb1, 0x6e, 0x71, 0x90, 0x80, 0x07, 0x88, 0xd2, 0x08, 0xf9, 0xea,
-	0x0c, 0x1f, 0x89, 0x20, 0x8c, 0x0a, 0x7d, 0x27, 0x87, 0x1a, 0x07, 0x09, 0x76, 0x94, 0x51, 0xdb,
-	0x5f, 0x35, 0x50, 0x7f, 0x87, 0x89, 0x37, 0x95, 0xd8, 0x3d, 0x75, 0x5d, 0x8e, 0x85, 0x80, 0x7d,
-	0x50, 0x46, 0xc9, 0x32, 0x6d, 0x4c, 0xbf, 0xbe, 0xe8, 0x1e, 0xa6, 0x98, 0x74, 0xd3, 0x6b, 0xc9,
-	0x09, 0xf5, 0xac, 0xf5, 0x46, 0xf8, 0x06, 0x94, 0x3e, 0xc5, 0x98, 0x5c, 0xce, 0x99, 0xb2, 0xda,
-	0x3f, 0x76, 0x40, 0x63, 0x48, 0x84, 0xe4, 0x64, 0x1c, 0xa9, 0xc9, 0x8c, 0x38, 0x0b, 0x19, 0x57,
-	0x2b, 0x01, 0xdf, 0x82, 0xb2, 0x90, 0x68, 0x46, 0xa8, 0x97, 0xcb, 0xf8, 0xd7, 0x30, 0xe8, 0x81,
-	0x83, 0x49, 0x44, 0x5d, 0xec, 0xda, 0x69, 0x6f, 0x38, 0x9f, 0xd9, 0xd7, 0x13, 0xea, 0xe9, 0x1a,
-	0x0a, 0x1d, 0x70, 0xdf, 0x61, 0x41, 0x10, 0x51, 0x22, 0x17, 0x76, 0xc8, 0x98, 0xaf, 0xef, 0xe6,
-	0x50, 0xa6, 0x96, 0x31, 0x47, 0x8c, 0xf9, 0xed, 0xef, 0x7b, 0xa0, 0x34, 0x42, 0x1c, 0x05, 0x02,
-	0x1e, 0x03, 0xa0, 0x6e, 0xbd, 0xed, 0x62, 0xca, 0x82, 0x64, 0x66, 0x56, 0x45, 0x45, 0x86, 0x2a,
-	0x00, 0x43, 0xf0, 0x28, 0xbb, 0x81, 0x36, 0x47, 0x12, 0xdb, 0xce, 0x14, 0x51, 0x0f, 0xe7, 0xd2,
-	0xfc, 0xc3, 0x0c, 0x6d, 0x21, 0x89, 0x5f, 0xc6, 0x60, 0x88, 0x40, 0xed, 0xb6, 0x62, 0x80, 0xe6,
-	0xb9, 0xf4, 0xbf, 0x9f, 0x21, 0xcf, 0xd0, 0x7c, 0xab, 0x04, 0xa1, 0x7a, 0x31, 0xdf, 0x12, 0x84,
-	0xc2, 0x0f, 0xa0, 0xea, 0x31, 0xe4, 0xdb, 0x63, 0xa6, 0x3e, 0xaf, 0xbe, 0x97, 0x43, 0x01, 0xa0,
-	0x80, 0x83, 0x98, 0x07, 0x4f, 0x40, 0x7d, 0xec, 0x33, 0x67, 0x26, 0xec, 0x10, 0x73, 0x7b, 0x81,
-	0x11, 0xd7, 0x4b, 0x2d, 0xad, 0x53, 0xb4, 0x6a, 0x49, 0x78, 0x84, 0xf9, 0x7b, 0x8c, 0x38, 0x9c,
-	0x00, 0xdd, 0xdd, 0x50, 0x8a, 0x72, 0x8e, 0xb5, 0x54, 0xf4, 0x72, 0x4b, 0xeb, 0x54, 0xfb, 0x4f,
-	0xcc, 0x4d, 0xf3, 0x33, 0xef, 0xd0, 0xd5, 0xa0, 0xa8, 0x8e, 0x6e, 0x35, 0xdc, 0x3b, 0x64, 0xf7,
-	0xea, 0x3f, 0xf2, 0xb8, 0xd7, 0xda, 0xed, 0x54, 0xfb, 0xc7, 0xff, 0xf2, 0xb7, 0x5c, 0x25, 0xe5,
-	0x6e, 0xab, 0xe0, 0x59, 0xf1, 0xcb, 0xb7, 0x66, 0x61, 0xf0, 0xe2, 0x72, 0x69, 0x68, 0x57, 0x4b,
-	0x43, 0xfb, 0xb3, 0x34, 0xb4, 0xcf, 0x2b, 0xa3, 0x70, 0xb5, 0x32, 0x0a, 0xbf, 0x56, 0x46, 0xe1,
-	0x7c, 0x73, 0x82, 0xc4, 0xa3, 0x44, 0xe2, 0xde, 0xda, 0xdc, 0xe7, 0x89, 0xbd, 0xc7, 0x53, 0x1c,
-	0x97, 0x62, 0x87, 0x7e, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0x99, 0x32, 0x01, 0x49, 0xfb, 0x05,
-	0x00, 0x00,
+func (m *BlocksPerYearTransition) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlocksPerYearTransition) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CategoryTotals holds the total amounts distributed to each distribution
+// category of the minted coins.
+type CategoryTotals struct {
+	Staking         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staking"`
+	FundedAddresses github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=funded_addresses,json=fundedAddresses,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"funded_addresses"`
+	CommunityPool   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool"`
+	// truncation remainder of the funded addresses share kept in the module
+	// account
+	Dust github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=dust,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dust"`
+	// share accrued in the strategic reserve of the module account
+	StrategicReserve github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=strategic_reserve,json=strategicReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"strategic_reserve"`
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *CategoryTotals) Reset()         { *m = CategoryTotals{} }
+func (m *CategoryTotals) String() string { return proto.CompactTextString(m) }
+func (*CategoryTotals) ProtoMessage()    {}
+func (*CategoryTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{6}
+}
+func (m *CategoryTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CategoryTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CategoryTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *CategoryTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryTotals.Merge(m, src)
+}
+func (m *CategoryTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *CategoryTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryTotals proto.InternalMessageInfo
+
+// Summary is a compact record of the mint state stored under a single key so
+// that a single proof is enough for interchain queries. It is derived from the
+// minter and the params.
+type Summary struct {
+	MintDenom        string                                 `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	Inflation        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	CumulativeMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=cumulative_minted,json=cumulativeMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cumulative_minted"`
+}
+
+func (m *Summary) Reset()         { *m = Summary{} }
+func (m *Summary) String() string { return proto.CompactTextString(m) }
+func (*Summary) ProtoMessage()    {}
+func (*Summary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{7}
+}
+func (m *Summary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Summary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary.Merge(m, src)
+}
+func (m *Summary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary.DiscardUnknown(m)
 }
 
-func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Summary proto.InternalMessageInfo
+
+func (m *Summary) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlockInputs holds the values used by the minter to decide the inflation of
+// a block.
+type BlockInputs struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// bonded ratio used to compute the inflation rate
+	BondedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonded_ratio"`
+	// staking token supply used to compute the annual provisions
+	StakingSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=staking_supply,json=stakingSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staking_supply"`
+	// supply_source is the source of the staking token supply
+	SupplySource string `protobuf:"bytes,4,opt,name=supply_source,json=supplySource,proto3" json:"supply_source,omitempty"`
 }
 
-func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *BlockInputs) Reset()         { *m = BlockInputs{} }
+func (m *BlockInputs) String() string { return proto.CompactTextString(m) }
+func (*BlockInputs) ProtoMessage()    {}
+func (*BlockInputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{8}
+}
+func (m *BlockInputs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockInputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockInputs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BlockInputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInputs.Merge(m, src)
+}
+func (m *BlockInputs) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockInputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInputs proto.InternalMessageInfo
+
+func (m *BlockInputs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockInputs) GetSupplySource() string {
+	if m != nil {
+		return m.SupplySource
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PausedShares holds the minted coins buffered for each paused distribution
+// category, they are distributed to their category once it is resumed.
+type PausedShares struct {
+	Staking         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=staking,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking"`
+	FundedAddresses github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=funded_addresses,json=fundedAddresses,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funded_addresses"`
+	CommunityPool   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
 }
 
-func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CommunityPool.Size()
-		i -= size
-		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.FundedAddresses.Size()
-		i -= size
-		if _, err := m.FundedAddresses.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Staking.Size()
-		i -= size
-		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PausedShares) Reset()         { *m = PausedShares{} }
+func (m *PausedShares) String() string { return proto.CompactTextString(m) }
+func (*PausedShares) ProtoMessage()    {}
+func (*PausedShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{9}
+}
+func (m *PausedShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PausedShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedShares.Merge(m, src)
+}
+func (m *PausedShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedShares.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PausedShares proto.InternalMessageInfo
+
+func (m *PausedShares) GetStaking() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Staking
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PausedShares) GetFundedAddresses() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FundedAddresses
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FundedAddresses) > 0 {
-		for iNdEx := len(m.FundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMint(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+func (m *PausedShares) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
 	}
-	{
-		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+type WeightedAddress struct {
+	Address    string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	PayoutMode PayoutMode                             `protobuf:"varint,3,opt,name=payout_mode,json=payoutMode,proto3,enum=modules.mint.PayoutMode" json:"payout_mode,omitempty"`
+	// first height the address is funded at, zero for no lower bound
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// first height the address is no longer funded at, zero for no upper bound
+	EndHeight  int64      `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	WeightMode WeightMode `protobuf:"varint,6,opt,name=weight_mode,json=weightMode,proto3,enum=modules.mint.WeightMode" json:"weight_mode,omitempty"`
+}
+
+func (m *WeightedAddress) Reset()         { *m = WeightedAddress{} }
+func (m *WeightedAddress) String() string { return proto.CompactTextString(m) }
+func (*WeightedAddress) ProtoMessage()    {}
+func (*WeightedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{10}
+}
+func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.BlocksPerYear != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *WeightedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedAddress.Merge(m, src)
+}
+func (m *WeightedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+
+func (m *WeightedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	{
-		size := m.GoalBonded.Size()
-		i -= size
-		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *WeightedAddress) GetPayoutMode() PayoutMode {
+	if m != nil {
+		return m.PayoutMode
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.InflationMin.Size()
-		i -= size
-		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+	return PAYOUT_MODE_PUSH
+}
+
+func (m *WeightedAddress) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.InflationMax.Size()
-		i -= size
-		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *WeightedAddress) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.InflationRateChange.Size()
-		i -= size
-		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+func (m *WeightedAddress) GetWeightMode() WeightMode {
+	if m != nil {
+		return m.WeightMode
+	}
+	return WEIGHT_MODE_FIXED
+}
+
+// ParamsChange is the last change of the params of the module.
+type ParamsChange struct {
+	// height is the height of the change, 0 for the genesis params
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// authority is the address that changed the params, empty for the genesis
+	// params
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type is the type URL of the message that changed the params, or the
+	// source of the change when not changed by a message
+	MsgType string `protobuf:"bytes,3,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	// tx_hash is the hash of the transaction that changed the params, empty when
+	// not changed by a transaction
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *ParamsChange) Reset()         { *m = ParamsChange{} }
+func (m *ParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ParamsChange) ProtoMessage()    {}
+func (*ParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{11}
+}
+func (m *ParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsChange.Merge(m, src)
+}
+func (m *ParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsChange proto.InternalMessageInfo
+
+func (m *ParamsChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ParamsChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ParamsChange) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	return ""
 }
 
-func (m *WeightedAddress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsChange) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	return ""
+}
+
+// PendingPayout is the share of a funded address accumulated in the module
+// account until claimed.
+type PendingPayout struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// from_height is the height of the first share accumulated since the last
+	// claim
+	FromHeight int64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the height of the last accumulated share
+	ToHeight int64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *PendingPayout) Reset()         { *m = PendingPayout{} }
+func (m *PendingPayout) String() string { return proto.CompactTextString(m) }
+func (*PendingPayout) ProtoMessage()    {}
+func (*PendingPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{12}
+}
+func (m *PendingPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+}
+func (m *PendingPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPayout.Merge(m, src)
+}
+func (m *PendingPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPayout.DiscardUnknown(m)
 }
 
-func (m *DistributionProportions) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PendingPayout proto.InternalMessageInfo
+
+func (m *PendingPayout) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	l = m.Staking.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.FundedAddresses.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.CommunityPool.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	return ""
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingPayout) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	return nil
+}
+
+func (m *PendingPayout) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	l = m.InflationRateChange.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMax.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMin.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.GoalBonded.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	return 0
+}
+
+func (m *PendingPayout) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	l = m.DistributionProportions.Size()
+	return 0
+}
+
+type DistributionProportions struct {
+	// staking defines the proportion of the minted minted_denom that is to be
+	// allocated as staking rewards.
+	Staking github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking"`
+	// funded_addresses defines the proportion of the minted minted_denom that is
+	// to the set of funded addresses.
+	FundedAddresses github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=funded_addresses,json=fundedAddresses,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funded_addresses"`
+	// community_pool defines the proportion of the minted minted_denom that is
+	// to be allocated to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool"`
+	// strategic_reserve defines the proportion of the minted minted_denom that
+	// is locked in the strategic reserve until released by the authority.
+	StrategicReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=strategic_reserve,json=strategicReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"strategic_reserve"`
+}
+
+func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
+func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportions) ProtoMessage()    {}
+func (*DistributionProportions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{13}
+}
+func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportions.Merge(m, src)
+}
+func (m *DistributionProportions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
+
+// Params holds parameters for the mint module.
+type Params struct {
+	// type of coin to mint
+	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// maximum annual change in inflation rate
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change"`
+	// maximum inflation rate
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max"`
+	// minimum inflation rate
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min"`
+	// goal of percent bonded coins
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
+	// expected blocks per year
+	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// distribution_proportions defines the proportion of the minted denom
+	DistributionProportions DistributionProportions `protobuf:"bytes,7,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
+	// list of funded addresses
+	FundedAddresses []WeightedAddress `protobuf:"bytes,8,rep,name=funded_addresses,json=fundedAddresses,proto3" json:"funded_addresses"`
+	// minimum block provision to mint and distribute, smaller provisions are
+	// accumulated in the minter carry buffer
+	MinDistributableProvision github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_distributable_provision,json=minDistributableProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_distributable_provision"`
+	// pause all minting, supersedes the per-category pause flags
+	PauseMinting bool `protobuf:"varint,10,opt,name=pause_minting,json=pauseMinting,proto3" json:"pause_minting,omitempty"`
+	// pause the distribution of the staking share
+	PauseStakingShare bool `protobuf:"varint,11,opt,name=pause_staking_share,json=pauseStakingShare,proto3" json:"pause_staking_share,omitempty"`
+	// pause the distribution of the funded addresses share
+	PauseFundedShare bool `protobuf:"varint,12,opt,name=pause_funded_share,json=pauseFundedShare,proto3" json:"pause_funded_share,omitempty"`
+	// pause the distribution of the community pool share
+	PauseCommunityShare bool `protobuf:"varint,13,opt,name=pause_community_share,json=pauseCommunityShare,proto3" json:"pause_community_share,omitempty"`
+	// mode for the shares of paused categories, the community pool share is
+	// always buffered when paused
+	PausedShareMode PausedShareMode `protobuf:"varint,14,opt,name=paused_share_mode,json=pausedShareMode,proto3,enum=modules.mint.PausedShareMode" json:"paused_share_mode,omitempty"`
+	// recipient of the truncation remainder of the funded addresses share
+	DustAssignment DustAssignment `protobuf:"varint,15,opt,name=dust_assignment,json=dustAssignment,proto3,enum=modules.mint.DustAssignment" json:"dust_assignment,omitempty"`
+	// emit an EventMintPlanned before minting
+	EmitMintPlanned bool `protobuf:"varint,16,opt,name=emit_mint_planned,json=emitMintPlanned,proto3" json:"emit_mint_planned,omitempty"`
+	// use of the supply source of the keeper
+	SupplySourceMode SupplySourceMode `protobuf:"varint,17,opt,name=supply_source_mode,json=supplySourceMode,proto3,enum=modules.mint.SupplySourceMode" json:"supply_source_mode,omitempty"`
+	// minimum amount funded to the community pool per budget year, zero to
+	// disable the floor
+	MinAnnualCommunityFunding types.Coin `protobuf:"bytes,18,opt,name=min_annual_community_funding,json=minAnnualCommunityFunding,proto3" json:"min_annual_community_funding"`
+	// sources of the top-up of the community pool funding, by priority
+	CommunityFundingPriority []CommunityFundingSource `protobuf:"varint,19,rep,packed,name=community_funding_priority,json=communityFundingPriority,proto3,enum=modules.mint.CommunityFundingSource" json:"community_funding_priority,omitempty"`
+	// number of final blocks of the budget year the top-up is spread over
+	CommunityFundingWindow uint64 `protobuf:"varint,20,opt,name=community_funding_window,json=communityFundingWindow,proto3" json:"community_funding_window,omitempty"`
+	// correction of the block provisions closing the drift of the realized
+	// emissions from the target emissions
+	DriftCorrection DriftCorrection `protobuf:"bytes,21,opt,name=drift_correction,json=driftCorrection,proto3" json:"drift_correction"`
+	// largest move of inflation_max or inflation_min of a params update not
+	// acknowledged as a large change
+	LargeChangeThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=large_change_threshold,json=largeChangeThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"large_change_threshold"`
+	// recipient of the staking share in place of the fee collector, the name of
+	// a module account or an address, the staking share is sent to the fee
+	// collector if empty
+	StakingRewardsRecipient string `protobuf:"bytes,23,opt,name=staking_rewards_recipient,json=stakingRewardsRecipient,proto3" json:"staking_rewards_recipient,omitempty"`
+	// phases of the schedule ordered by start height, the bounds of the inflation
+	// and the goal bonded of the active phase replace the top-level ones
+	Phases []Phase `protobuf:"bytes,24,rep,name=phases,proto3" json:"phases"`
+	// maximum supply of the mint denom, the minted coins of a block are reduced
+	// to the remaining headroom and minting halts once the supply reaches it,
+	// zero for an unlimited supply
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,25,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// allocation of the minted coins to the distribution categories when the
+	// max supply reduces them below the block provision
+	ShortfallPolicy ShortfallPolicy `protobuf:"varint,26,opt,name=shortfall_policy,json=shortfallPolicy,proto3,enum=modules.mint.ShortfallPolicy" json:"shortfall_policy,omitempty"`
+	// distribution categories by priority for SHORTFALL_POLICY_PRIORITY, every
+	// category listed once
+	ShortfallPriority []string `protobuf:"bytes,27,rep,name=shortfall_priority,json=shortfallPriority,proto3" json:"shortfall_priority,omitempty"`
+	// number of blocks between two snapshots of the inflation, zero to disable
+	// the snapshots
+	InflationSnapshotInterval uint64 `protobuf:"varint,28,opt,name=inflation_snapshot_interval,json=inflationSnapshotInterval,proto3" json:"inflation_snapshot_interval,omitempty"`
+	// number of blocks the snapshots of the inflation are kept, zero to keep
+	// them forever
+	InflationSnapshotRetention uint64 `protobuf:"varint,29,opt,name=inflation_snapshot_retention,json=inflationSnapshotRetention,proto3" json:"inflation_snapshot_retention,omitempty"`
+	// mint configurations of the denoms minted in addition to the mint denom,
+	// each denom is minted and distributed independently
+	MintConfigs []MintConfig `protobuf:"bytes,30,rep,name=mint_configs,json=mintConfigs,proto3" json:"mint_configs"`
+	// calculation of the inflation rate of each block
+	InflationCalculationMode InflationCalculationMode `protobuf:"varint,31,opt,name=inflation_calculation_mode,json=inflationCalculationMode,proto3,enum=modules.mint.InflationCalculationMode" json:"inflation_calculation_mode,omitempty"`
+	// bootstrap override sending all the minted coins to a single recipient
+	// until an end height, disabled when the recipient is empty
+	BootstrapOverride BootstrapOverride `protobuf:"bytes,32,opt,name=bootstrap_override,json=bootstrapOverride,proto3" json:"bootstrap_override"`
+	// bonded ratio below which the minting of the mint denom is skipped, zero to
+	// never skip the minting
+	MinBondedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,33,opt,name=min_bonded_ratio,json=minBondedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_bonded_ratio"`
+	// number of consecutive blocks the distribution of the minted coins can fail
+	// before minting is paused, zero to never pause minting
+	AutoPauseThreshold uint64 `protobuf:"varint,34,opt,name=auto_pause_threshold,json=autoPauseThreshold,proto3" json:"auto_pause_threshold,omitempty"`
+	// recipient of the truncation remainder of the split of the minted coins
+	// between the distribution categories
+	DustPolicy DustPolicy `protobuf:"varint,35,opt,name=dust_policy,json=dustPolicy,proto3,enum=modules.mint.DustPolicy" json:"dust_policy,omitempty"`
+	// minimum number of blocks between two runs of MsgRunMaintenance
+	MaintenanceInterval uint64 `protobuf:"varint,36,opt,name=maintenance_interval,json=maintenanceInterval,proto3" json:"maintenance_interval,omitempty"`
+	// number of blocks of a minting epoch, the provisions accrue in the carry
+	// buffer of the minter and are minted at once at the end of each epoch, 1
+	// mints every block
+	MintingInterval uint64 `protobuf:"varint,37,opt,name=minting_interval,json=mintingInterval,proto3" json:"minting_interval,omitempty"`
+	// minimum share of the minted coins of the mint denom sent to the community
+	// pool by the distribution proportions, zero for no minimum, only changed by
+	// MsgSetMinCommunityPoolShare
+	MinCommunityPoolShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,38,opt,name=min_community_pool_share,json=minCommunityPoolShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_community_pool_share"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{14}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
+	}
+	return 0
+}
+
+func (m *Params) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+func (m *Params) GetFundedAddresses() []WeightedAddress {
+	if m != nil {
+		return m.FundedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetPauseMinting() bool {
+	if m != nil {
+		return m.PauseMinting
+	}
+	return false
+}
+
+func (m *Params) GetPauseStakingShare() bool {
+	if m != nil {
+		return m.PauseStakingShare
+	}
+	return false
+}
+
+func (m *Params) GetPauseFundedShare() bool {
+	if m != nil {
+		return m.PauseFundedShare
+	}
+	return false
+}
+
+func (m *Params) GetPauseCommunityShare() bool {
+	if m != nil {
+		return m.PauseCommunityShare
+	}
+	return false
+}
+
+func (m *Params) GetPausedShareMode() PausedShareMode {
+	if m != nil {
+		return m.PausedShareMode
+	}
+	return PAUSED_SHARE_MODE_COMMUNITY_POOL
+}
+
+func (m *Params) GetDustAssignment() DustAssignment {
+	if m != nil {
+		return m.DustAssignment
+	}
+	return DUST_ASSIGNMENT_MODULE_ACCOUNT
+}
+
+func (m *Params) GetEmitMintPlanned() bool {
+	if m != nil {
+		return m.EmitMintPlanned
+	}
+	return false
+}
+
+func (m *Params) GetSupplySourceMode() SupplySourceMode {
+	if m != nil {
+		return m.SupplySourceMode
+	}
+	return SUPPLY_SOURCE_MODE_REPLACE
+}
+
+func (m *Params) GetMinAnnualCommunityFunding() types.Coin {
+	if m != nil {
+		return m.MinAnnualCommunityFunding
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetCommunityFundingPriority() []CommunityFundingSource {
+	if m != nil {
+		return m.CommunityFundingPriority
+	}
+	return nil
+}
+
+func (m *Params) GetCommunityFundingWindow() uint64 {
+	if m != nil {
+		return m.CommunityFundingWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDriftCorrection() DriftCorrection {
+	if m != nil {
+		return m.DriftCorrection
+	}
+	return DriftCorrection{}
+}
+
+func (m *Params) GetStakingRewardsRecipient() string {
+	if m != nil {
+		return m.StakingRewardsRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetPhases() []Phase {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *Params) GetShortfallPolicy() ShortfallPolicy {
+	if m != nil {
+		return m.ShortfallPolicy
+	}
+	return SHORTFALL_POLICY_PRO_RATA
+}
+
+func (m *Params) GetShortfallPriority() []string {
+	if m != nil {
+		return m.ShortfallPriority
+	}
+	return nil
+}
+
+func (m *Params) GetInflationSnapshotInterval() uint64 {
+	if m != nil {
+		return m.InflationSnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetInflationSnapshotRetention() uint64 {
+	if m != nil {
+		return m.InflationSnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMintConfigs() []MintConfig {
+	if m != nil {
+		return m.MintConfigs
+	}
+	return nil
+}
+
+func (m *Params) GetInflationCalculationMode() InflationCalculationMode {
+	if m != nil {
+		return m.InflationCalculationMode
+	}
+	return INFLATION_CALCULATION_MODE_GOAL_BONDED
+}
+
+func (m *Params) GetBootstrapOverride() BootstrapOverride {
+	if m != nil {
+		return m.BootstrapOverride
+	}
+	return BootstrapOverride{}
+}
+
+func (m *Params) GetAutoPauseThreshold() uint64 {
+	if m != nil {
+		return m.AutoPauseThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetDustPolicy() DustPolicy {
+	if m != nil {
+		return m.DustPolicy
+	}
+	return DUST_POLICY_COMMUNITY_POOL
+}
+
+func (m *Params) GetMaintenanceInterval() uint64 {
+	if m != nil {
+		return m.MaintenanceInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMintingInterval() uint64 {
+	if m != nil {
+		return m.MintingInterval
+	}
+	return 0
+}
+
+// ParamDescriptor describes a param of the module.
+type ParamDescriptor struct {
+	// name is the proto name of the param used in the genesis and params JSON
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// key is the key of the param in the params subspace
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// type is the proto type of the param
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// value is the canonical JSON of the value of the param
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// default is the canonical JSON of the default value of the param
+	Default string `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	// bounds describes the valid values of the param
+	Bounds string `protobuf:"bytes,6,opt,name=bounds,proto3" json:"bounds,omitempty"`
+}
+
+func (m *ParamDescriptor) Reset()         { *m = ParamDescriptor{} }
+func (m *ParamDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ParamDescriptor) ProtoMessage()    {}
+func (*ParamDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{15}
+}
+func (m *ParamDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamDescriptor.Merge(m, src)
+}
+func (m *ParamDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamDescriptor proto.InternalMessageInfo
+
+func (m *ParamDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ParamDescriptor) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamDescriptor) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ParamDescriptor) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ParamDescriptor) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
+func (m *ParamDescriptor) GetBounds() string {
+	if m != nil {
+		return m.Bounds
+	}
+	return ""
+}
+
+// DriftCorrection defines the correction of the block provisions closing the
+// drift of the realized emissions from the target emissions.
+type DriftCorrection struct {
+	// max_factor bounds the correction of a block provision to this proportion
+	// of the provision, zero to disable the correction
+	MaxFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_factor,json=maxFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_factor"`
+	// horizon is the number of blocks the drift is closed over
+	Horizon uint64 `protobuf:"varint,2,opt,name=horizon,proto3" json:"horizon,omitempty"`
+}
+
+func (m *DriftCorrection) Reset()         { *m = DriftCorrection{} }
+func (m *DriftCorrection) String() string { return proto.CompactTextString(m) }
+func (*DriftCorrection) ProtoMessage()    {}
+func (*DriftCorrection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{16}
+}
+func (m *DriftCorrection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DriftCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DriftCorrection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DriftCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriftCorrection.Merge(m, src)
+}
+func (m *DriftCorrection) XXX_Size() int {
+	return m.Size()
+}
+func (m *DriftCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriftCorrection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriftCorrection proto.InternalMessageInfo
+
+func (m *DriftCorrection) GetHorizon() uint64 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+// BootstrapOverride redirects all the coins minted for the mint denom to a
+// single recipient until an end height, typically a launch incentives account
+// in the first blocks of a chain.
+type BootstrapOverride struct {
+	// recipient is the address or the module account name receiving the minted
+	// coins
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// end_height is the first height the minted coins are distributed by the
+	// distribution proportions again
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *BootstrapOverride) Reset()         { *m = BootstrapOverride{} }
+func (m *BootstrapOverride) String() string { return proto.CompactTextString(m) }
+func (*BootstrapOverride) ProtoMessage()    {}
+func (*BootstrapOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{17}
+}
+func (m *BootstrapOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BootstrapOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BootstrapOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BootstrapOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BootstrapOverride.Merge(m, src)
+}
+func (m *BootstrapOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *BootstrapOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_BootstrapOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BootstrapOverride proto.InternalMessageInfo
+
+func (m *BootstrapOverride) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *BootstrapOverride) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// MintConfig is the mint configuration of a denom minted in addition to the
+// mint denom, with its own inflation schedule and distribution proportions.
+type MintConfig struct {
+	MintDenom               string                                 `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	InflationRateChange     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change"`
+	InflationMax            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max"`
+	InflationMin            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min"`
+	GoalBonded              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
+	DistributionProportions DistributionProportions                `protobuf:"bytes,6,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
+}
+
+func (m *MintConfig) Reset()         { *m = MintConfig{} }
+func (m *MintConfig) String() string { return proto.CompactTextString(m) }
+func (*MintConfig) ProtoMessage()    {}
+func (*MintConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{18}
+}
+func (m *MintConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintConfig.Merge(m, src)
+}
+func (m *MintConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintConfig proto.InternalMessageInfo
+
+func (m *MintConfig) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+func (m *MintConfig) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+// DenomMinter is the state of the minting of a denom of a mint configuration.
+type DenomMinter struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// current annual inflation rate of the denom
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// current annual expected provisions of the denom
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	// fractional part of the provisions carried over to the next block
+	CarryBuffer github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=carry_buffer,json=carryBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"carry_buffer"`
+	// cumulative amount of the denom minted
+	CumulativeMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=cumulative_minted,json=cumulativeMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cumulative_minted"`
+}
+
+func (m *DenomMinter) Reset()         { *m = DenomMinter{} }
+func (m *DenomMinter) String() string { return proto.CompactTextString(m) }
+func (*DenomMinter) ProtoMessage()    {}
+func (*DenomMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{19}
+}
+func (m *DenomMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMinter.Merge(m, src)
+}
+func (m *DenomMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMinter proto.InternalMessageInfo
+
+func (m *DenomMinter) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// Phase is a phase of a multi-phase schedule, active from its start height to
+// the start height of the next phase.
+type Phase struct {
+	Name         string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartHeight  int64                                  `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min"`
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max"`
+	GoalBonded   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
+}
+
+func (m *Phase) Reset()         { *m = Phase{} }
+func (m *Phase) String() string { return proto.CompactTextString(m) }
+func (*Phase) ProtoMessage()    {}
+func (*Phase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{20}
+}
+func (m *Phase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Phase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Phase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Phase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Phase.Merge(m, src)
+}
+func (m *Phase) XXX_Size() int {
+	return m.Size()
+}
+func (m *Phase) XXX_DiscardUnknown() {
+	xxx_messageInfo_Phase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Phase proto.InternalMessageInfo
+
+func (m *Phase) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Phase) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// FundedAddressWeightChange records a change of the weight of a funded address.
+type FundedAddressWeightChange struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height of the block of the change
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// weight before the change, zero if the address has been added
+	OldWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=old_weight,json=oldWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_weight"`
+	// weight after the change, zero if the address has been removed
+	NewWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=new_weight,json=newWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_weight"`
+	// action is either added, removed or updated
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *FundedAddressWeightChange) Reset()         { *m = FundedAddressWeightChange{} }
+func (m *FundedAddressWeightChange) String() string { return proto.CompactTextString(m) }
+func (*FundedAddressWeightChange) ProtoMessage()    {}
+func (*FundedAddressWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{21}
+}
+func (m *FundedAddressWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundedAddressWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundedAddressWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundedAddressWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundedAddressWeightChange.Merge(m, src)
+}
+func (m *FundedAddressWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundedAddressWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundedAddressWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundedAddressWeightChange proto.InternalMessageInfo
+
+func (m *FundedAddressWeightChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FundedAddressWeightChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FundedAddressWeightChange) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+// BlockDistribution is the allocation of the coins minted in a block.
+type BlockDistribution struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// minted is the amount of coins minted in the block
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+	// distributed is the amount distributed to each category in the block,
+	// including the released paused shares
+	Distributed CategoryTotals `protobuf:"bytes,3,opt,name=distributed,proto3" json:"distributed"`
+	// time is the time of the block
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// inflation is the inflation rate of the block
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// funded_addresses are the shares of the funded addresses, in the order of
+	// the params, not recorded before the income of the funded addresses was
+	// tracked
+	FundedAddresses []FundedAddressDistribution `protobuf:"bytes,6,rep,name=funded_addresses,json=fundedAddresses,proto3" json:"funded_addresses"`
+}
+
+func (m *BlockDistribution) Reset()         { *m = BlockDistribution{} }
+func (m *BlockDistribution) String() string { return proto.CompactTextString(m) }
+func (*BlockDistribution) ProtoMessage()    {}
+func (*BlockDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{22}
+}
+func (m *BlockDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDistribution.Merge(m, src)
+}
+func (m *BlockDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDistribution proto.InternalMessageInfo
+
+func (m *BlockDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockDistribution) GetDistributed() CategoryTotals {
+	if m != nil {
+		return m.Distributed
+	}
+	return CategoryTotals{}
+}
+
+func (m *BlockDistribution) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *BlockDistribution) GetFundedAddresses() []FundedAddressDistribution {
+	if m != nil {
+		return m.FundedAddresses
+	}
+	return nil
+}
+
+// InflationSnapshot is the inflation of the minter recorded every
+// inflation_snapshot_interval blocks.
+type InflationSnapshot struct {
+	Height           int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Inflation        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	BondedRatio      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonded_ratio"`
+}
+
+func (m *InflationSnapshot) Reset()         { *m = InflationSnapshot{} }
+func (m *InflationSnapshot) String() string { return proto.CompactTextString(m) }
+func (*InflationSnapshot) ProtoMessage()    {}
+func (*InflationSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{23}
+}
+func (m *InflationSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSnapshot.Merge(m, src)
+}
+func (m *InflationSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSnapshot proto.InternalMessageInfo
+
+func (m *InflationSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// FundedAddressDistribution is the share of the minted coins of a block
+// distributed to a funded address
+type FundedAddressDistribution struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// recipient is the account receiving the coins, the address or the address
+	// the send restriction redirects the coins to, empty when the coins are kept
+	// in the module account for a pull payout or a blocked payout
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *FundedAddressDistribution) Reset()         { *m = FundedAddressDistribution{} }
+func (m *FundedAddressDistribution) String() string { return proto.CompactTextString(m) }
+func (*FundedAddressDistribution) ProtoMessage()    {}
+func (*FundedAddressDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{24}
+}
+func (m *FundedAddressDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundedAddressDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundedAddressDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundedAddressDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundedAddressDistribution.Merge(m, src)
+}
+func (m *FundedAddressDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundedAddressDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundedAddressDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundedAddressDistribution proto.InternalMessageInfo
+
+func (m *FundedAddressDistribution) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FundedAddressDistribution) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *FundedAddressDistribution) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// FundedAddressIncome is the total of the shares of the minted coins
+// distributed to a funded address since the income of the funded addresses is
+// tracked.
+type FundedAddressIncome struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Total   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// first_height is the height of the first share of the address
+	FirstHeight int64 `protobuf:"varint,3,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty"`
+	// last_height is the height of the last share of the address
+	LastHeight int64 `protobuf:"varint,4,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *FundedAddressIncome) Reset()         { *m = FundedAddressIncome{} }
+func (m *FundedAddressIncome) String() string { return proto.CompactTextString(m) }
+func (*FundedAddressIncome) ProtoMessage()    {}
+func (*FundedAddressIncome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{25}
+}
+func (m *FundedAddressIncome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundedAddressIncome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundedAddressIncome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundedAddressIncome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundedAddressIncome.Merge(m, src)
+}
+func (m *FundedAddressIncome) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundedAddressIncome) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundedAddressIncome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundedAddressIncome proto.InternalMessageInfo
+
+func (m *FundedAddressIncome) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FundedAddressIncome) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *FundedAddressIncome) GetFirstHeight() int64 {
+	if m != nil {
+		return m.FirstHeight
+	}
+	return 0
+}
+
+func (m *FundedAddressIncome) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+// EmissionReport is the emissions of a range of heights assembled from the
+// recorded allocations of the minted coins.
+type EmissionReport struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// recorded_blocks is the number of blocks of the range with a recorded
+	// allocation, the allocations are pruned after the retention of the history
+	// and not recorded for the blocks without minting
+	RecordedBlocks uint64 `protobuf:"varint,3,opt,name=recorded_blocks,json=recordedBlocks,proto3" json:"recorded_blocks,omitempty"`
+	// first_time and last_time are the times of the first and the last recorded
+	// blocks
+	FirstTime time.Time `protobuf:"bytes,4,opt,name=first_time,json=firstTime,proto3,stdtime" json:"first_time"`
+	LastTime  time.Time `protobuf:"bytes,5,opt,name=last_time,json=lastTime,proto3,stdtime" json:"last_time"`
+	// minted is the amount of coins minted in the recorded blocks
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+	// distributed is the amount distributed to each category in the recorded
+	// blocks
+	Distributed CategoryTotals `protobuf:"bytes,7,opt,name=distributed,proto3" json:"distributed"`
+}
+
+func (m *EmissionReport) Reset()         { *m = EmissionReport{} }
+func (m *EmissionReport) String() string { return proto.CompactTextString(m) }
+func (*EmissionReport) ProtoMessage()    {}
+func (*EmissionReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{26}
+}
+func (m *EmissionReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionReport.Merge(m, src)
+}
+func (m *EmissionReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionReport proto.InternalMessageInfo
+
+func (m *EmissionReport) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *EmissionReport) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *EmissionReport) GetRecordedBlocks() uint64 {
+	if m != nil {
+		return m.RecordedBlocks
+	}
+	return 0
+}
+
+func (m *EmissionReport) GetFirstTime() time.Time {
+	if m != nil {
+		return m.FirstTime
+	}
+	return time.Time{}
+}
+
+func (m *EmissionReport) GetLastTime() time.Time {
+	if m != nil {
+		return m.LastTime
+	}
+	return time.Time{}
+}
+
+func (m *EmissionReport) GetDistributed() CategoryTotals {
+	if m != nil {
+		return m.Distributed
+	}
+	return CategoryTotals{}
+}
+
+// EmissionProjection is a projection of the emissions of the first year with a
+// constant bonded ratio.
+type EmissionProjection struct {
+	// total is the amount of coins minted during the year
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// categories are the amounts distributed to each category, the shares of the
+	// paused categories held in the minter are not included
+	Categories CategoryTotals `protobuf:"bytes,2,opt,name=categories,proto3" json:"categories"`
+	// inflation is the inflation rate at the end of the year
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *EmissionProjection) Reset()         { *m = EmissionProjection{} }
+func (m *EmissionProjection) String() string { return proto.CompactTextString(m) }
+func (*EmissionProjection) ProtoMessage()    {}
+func (*EmissionProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{27}
+}
+func (m *EmissionProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionProjection.Merge(m, src)
+}
+func (m *EmissionProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionProjection proto.InternalMessageInfo
+
+func (m *EmissionProjection) GetCategories() CategoryTotals {
+	if m != nil {
+		return m.Categories
+	}
+	return CategoryTotals{}
+}
+
+// DenomConsistency compares the staking token supply with the bank supply of the
+// mint denom when the mint denom is the bond denom.
+type DenomConsistency struct {
+	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	BondDenom string `protobuf:"bytes,2,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// staking_supply is the supply returned by the staking keeper
+	StakingSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=staking_supply,json=stakingSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staking_supply"`
+	// bank_supply is the supply of the mint denom returned by the bank keeper
+	BankSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=bank_supply,json=bankSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bank_supply"`
+	// mismatch is true if only one of the supplies is zero, minting is skipped
+	// while the supplies mismatch
+	Mismatch bool `protobuf:"varint,5,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+}
+
+func (m *DenomConsistency) Reset()         { *m = DenomConsistency{} }
+func (m *DenomConsistency) String() string { return proto.CompactTextString(m) }
+func (*DenomConsistency) ProtoMessage()    {}
+func (*DenomConsistency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{28}
+}
+func (m *DenomConsistency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConsistency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConsistency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConsistency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConsistency.Merge(m, src)
+}
+func (m *DenomConsistency) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConsistency) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConsistency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConsistency proto.InternalMessageInfo
+
+func (m *DenomConsistency) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+func (m *DenomConsistency) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *DenomConsistency) GetMismatch() bool {
+	if m != nil {
+		return m.Mismatch
+	}
+	return false
+}
+
+// ModuleVersion is the version of the behavior of the module active on the
+// chain: the consensus version of the module, the behavior revision of the
+// version and the migrations completed on the chain.
+type ModuleVersion struct {
+	ConsensusVersion uint64 `protobuf:"varint,1,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
+	// behavior_revision identifies the behaviors of the module added by the
+	// consensus version
+	BehaviorRevision string `protobuf:"bytes,2,opt,name=behavior_revision,json=behaviorRevision,proto3" json:"behavior_revision,omitempty"`
+	// migrations are the migrations of the store completed on the chain, from the
+	// oldest
+	Migrations []MigrationRecord `protobuf:"bytes,3,rep,name=migrations,proto3" json:"migrations"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{29}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
+func (m *ModuleVersion) GetConsensusVersion() uint64 {
+	if m != nil {
+		return m.ConsensusVersion
+	}
+	return 0
+}
+
+func (m *ModuleVersion) GetBehaviorRevision() string {
+	if m != nil {
+		return m.BehaviorRevision
+	}
+	return ""
+}
+
+func (m *ModuleVersion) GetMigrations() []MigrationRecord {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+// MigrationRecord is a migration of the store completed on the chain.
+type MigrationRecord struct {
+	FromVersion uint64 `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   uint64 `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// height is the height of the upgrade running the migration
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// behavior_revision is the behavior revision of to_version
+	BehaviorRevision string `protobuf:"bytes,4,opt,name=behavior_revision,json=behaviorRevision,proto3" json:"behavior_revision,omitempty"`
+}
+
+func (m *MigrationRecord) Reset()         { *m = MigrationRecord{} }
+func (m *MigrationRecord) String() string { return proto.CompactTextString(m) }
+func (*MigrationRecord) ProtoMessage()    {}
+func (*MigrationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{30}
+}
+func (m *MigrationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationRecord.Merge(m, src)
+}
+func (m *MigrationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationRecord proto.InternalMessageInfo
+
+func (m *MigrationRecord) GetFromVersion() uint64 {
+	if m != nil {
+		return m.FromVersion
+	}
+	return 0
+}
+
+func (m *MigrationRecord) GetToVersion() uint64 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
+}
+
+func (m *MigrationRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MigrationRecord) GetBehaviorRevision() string {
+	if m != nil {
+		return m.BehaviorRevision
+	}
+	return ""
+}
+
+// MaintenanceSummary is the work done by a run of MsgRunMaintenance.
+type MaintenanceSummary struct {
+	// pruned_distributions is the number of allocations of the distribution
+	// history older than the retention pruned
+	PrunedDistributions uint64 `protobuf:"varint,1,opt,name=pruned_distributions,json=prunedDistributions,proto3" json:"pruned_distributions,omitempty"`
+	// pruned_inflation_snapshots is the number of snapshots of the inflation
+	// older than the inflation snapshot retention pruned
+	PrunedInflationSnapshots uint64 `protobuf:"varint,2,opt,name=pruned_inflation_snapshots,json=prunedInflationSnapshots,proto3" json:"pruned_inflation_snapshots,omitempty"`
+	// expired_funded_addresses are the addresses removed from the funded
+	// addresses for longer than the distribution history retention whose weight
+	// changes were pruned
+	ExpiredFundedAddresses []string `protobuf:"bytes,3,rep,name=expired_funded_addresses,json=expiredFundedAddresses,proto3" json:"expired_funded_addresses,omitempty"`
+	// pruned_weight_changes is the number of weight changes of the expired
+	// funded addresses pruned
+	PrunedWeightChanges uint64 `protobuf:"varint,4,opt,name=pruned_weight_changes,json=prunedWeightChanges,proto3" json:"pruned_weight_changes,omitempty"`
+	// flushed are the paused shares of the resumed categories distributed
+	Flushed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=flushed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flushed"`
+	// work is the number of records pruned and paused shares flushed, the gas
+	// charged to the signer is proportional to it
+	Work uint64 `protobuf:"varint,6,opt,name=work,proto3" json:"work,omitempty"`
+	// complete is false if the limit of the run was reached before all the
+	// maintenance was done
+	Complete bool `protobuf:"varint,7,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *MaintenanceSummary) Reset()         { *m = MaintenanceSummary{} }
+func (m *MaintenanceSummary) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceSummary) ProtoMessage()    {}
+func (*MaintenanceSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{31}
+}
+func (m *MaintenanceSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceSummary.Merge(m, src)
+}
+func (m *MaintenanceSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceSummary proto.InternalMessageInfo
+
+func (m *MaintenanceSummary) GetPrunedDistributions() uint64 {
+	if m != nil {
+		return m.PrunedDistributions
+	}
+	return 0
+}
+
+func (m *MaintenanceSummary) GetPrunedInflationSnapshots() uint64 {
+	if m != nil {
+		return m.PrunedInflationSnapshots
+	}
+	return 0
+}
+
+func (m *MaintenanceSummary) GetExpiredFundedAddresses() []string {
+	if m != nil {
+		return m.ExpiredFundedAddresses
+	}
+	return nil
+}
+
+func (m *MaintenanceSummary) GetPrunedWeightChanges() uint64 {
+	if m != nil {
+		return m.PrunedWeightChanges
+	}
+	return 0
+}
+
+func (m *MaintenanceSummary) GetFlushed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Flushed
+	}
+	return nil
+}
+
+func (m *MaintenanceSummary) GetWork() uint64 {
+	if m != nil {
+		return m.Work
+	}
+	return 0
+}
+
+func (m *MaintenanceSummary) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+// BasisPoints is a rate as an integer number of basis points, a basis point is
+// 0.0001.
+type BasisPoints struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *BasisPoints) Reset()         { *m = BasisPoints{} }
+func (m *BasisPoints) String() string { return proto.CompactTextString(m) }
+func (*BasisPoints) ProtoMessage()    {}
+func (*BasisPoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5baeea81b02a834f, []int{32}
+}
+func (m *BasisPoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasisPoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasisPoints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasisPoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasisPoints.Merge(m, src)
+}
+func (m *BasisPoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasisPoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasisPoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasisPoints proto.InternalMessageInfo
+
+func (m *BasisPoints) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("modules.mint.PausedShareMode", PausedShareMode_name, PausedShareMode_value)
+	proto.RegisterEnum("modules.mint.SupplySourceMode", SupplySourceMode_name, SupplySourceMode_value)
+	proto.RegisterEnum("modules.mint.InflationCalculationMode", InflationCalculationMode_name, InflationCalculationMode_value)
+	proto.RegisterEnum("modules.mint.CommunityFundingSource", CommunityFundingSource_name, CommunityFundingSource_value)
+	proto.RegisterEnum("modules.mint.DustAssignment", DustAssignment_name, DustAssignment_value)
+	proto.RegisterEnum("modules.mint.DustPolicy", DustPolicy_name, DustPolicy_value)
+	proto.RegisterEnum("modules.mint.ShortfallPolicy", ShortfallPolicy_name, ShortfallPolicy_value)
+	proto.RegisterEnum("modules.mint.PayoutMode", PayoutMode_name, PayoutMode_value)
+	proto.RegisterEnum("modules.mint.WeightMode", WeightMode_name, WeightMode_value)
+	proto.RegisterType((*Minter)(nil), "modules.mint.Minter")
+	proto.RegisterType((*CommunityPoolFundingTotal)(nil), "modules.mint.CommunityPoolFundingTotal")
+	proto.RegisterType((*LedgerEntry)(nil), "modules.mint.LedgerEntry")
+	proto.RegisterType((*CommunityFunding)(nil), "modules.mint.CommunityFunding")
+	proto.RegisterType((*GoalBondedTransition)(nil), "modules.mint.GoalBondedTransition")
+	proto.RegisterType((*BlocksPerYearTransition)(nil), "modules.mint.BlocksPerYearTransition")
+	proto.RegisterType((*CategoryTotals)(nil), "modules.mint.CategoryTotals")
+	proto.RegisterType((*Summary)(nil), "modules.mint.Summary")
+	proto.RegisterType((*BlockInputs)(nil), "modules.mint.BlockInputs")
+	proto.RegisterType((*PausedShares)(nil), "modules.mint.PausedShares")
+	proto.RegisterType((*WeightedAddress)(nil), "modules.mint.WeightedAddress")
+	proto.RegisterType((*ParamsChange)(nil), "modules.mint.ParamsChange")
+	proto.RegisterType((*PendingPayout)(nil), "modules.mint.PendingPayout")
+	proto.RegisterType((*DistributionProportions)(nil), "modules.mint.DistributionProportions")
+	proto.RegisterType((*Params)(nil), "modules.mint.Params")
+	proto.RegisterType((*ParamDescriptor)(nil), "modules.mint.ParamDescriptor")
+	proto.RegisterType((*DriftCorrection)(nil), "modules.mint.DriftCorrection")
+	proto.RegisterType((*BootstrapOverride)(nil), "modules.mint.BootstrapOverride")
+	proto.RegisterType((*MintConfig)(nil), "modules.mint.MintConfig")
+	proto.RegisterType((*DenomMinter)(nil), "modules.mint.DenomMinter")
+	proto.RegisterType((*Phase)(nil), "modules.mint.Phase")
+	proto.RegisterType((*FundedAddressWeightChange)(nil), "modules.mint.FundedAddressWeightChange")
+	proto.RegisterType((*BlockDistribution)(nil), "modules.mint.BlockDistribution")
+	proto.RegisterType((*InflationSnapshot)(nil), "modules.mint.InflationSnapshot")
+	proto.RegisterType((*FundedAddressDistribution)(nil), "modules.mint.FundedAddressDistribution")
+	proto.RegisterType((*FundedAddressIncome)(nil), "modules.mint.FundedAddressIncome")
+	proto.RegisterType((*EmissionReport)(nil), "modules.mint.EmissionReport")
+	proto.RegisterType((*EmissionProjection)(nil), "modules.mint.EmissionProjection")
+	proto.RegisterType((*DenomConsistency)(nil), "modules.mint.DenomConsistency")
+	proto.RegisterType((*ModuleVersion)(nil), "modules.mint.ModuleVersion")
+	proto.RegisterType((*MigrationRecord)(nil), "modules.mint.MigrationRecord")
+	proto.RegisterType((*MaintenanceSummary)(nil), "modules.mint.MaintenanceSummary")
+	proto.RegisterType((*BasisPoints)(nil), "modules.mint.BasisPoints")
+}
+
+func init() { proto.RegisterFile("modules/mint/mint.proto", fileDescriptor_5baeea81b02a834f) }
+
+var fileDescriptor_5baeea81b02a834f = []byte{
+	// 3653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x6f, 0x23, 0xc9,
+	0x79, 0x17, 0x1f, 0x7a, 0x7d, 0x94, 0x44, 0xaa, 0x46, 0xa3, 0x69, 0x69, 0x66, 0x24, 0x0d, 0x77,
+	0x77, 0x3c, 0xd9, 0x64, 0x25, 0xcf, 0x04, 0x08, 0x6c, 0xc3, 0x30, 0x4c, 0x91, 0xd4, 0x0c, 0x6d,
+	0x49, 0x64, 0x9a, 0xd4, 0xee, 0xca, 0x0b, 0xa3, 0xd3, 0xec, 0x2e, 0x92, 0x9d, 0xe9, 0xee, 0x22,
+	0xba, 0x9a, 0x7a, 0x18, 0x01, 0x72, 0x0b, 0x36, 0x39, 0x2d, 0x10, 0x20, 0x70, 0x90, 0x4b, 0x80,
+	0xdc, 0x16, 0x41, 0x90, 0x83, 0x91, 0x20, 0xff, 0x81, 0x8f, 0x86, 0x73, 0x09, 0x7c, 0xb0, 0x93,
+	0x5d, 0x20, 0xa7, 0x1c, 0x02, 0xf8, 0x92, 0x63, 0x50, 0x8f, 0x7e, 0x92, 0xdc, 0x97, 0x7b, 0x16,
+	0xc9, 0xc2, 0x97, 0x19, 0x76, 0xd5, 0x57, 0xbf, 0xaf, 0x1e, 0xdf, 0xbb, 0x4a, 0x70, 0xcf, 0x21,
+	0xe6, 0xc4, 0xc6, 0xf4, 0xc8, 0xb1, 0x5c, 0x9f, 0xff, 0x73, 0x38, 0xf6, 0x88, 0x4f, 0xd0, 0x9a,
+	0xec, 0x38, 0x64, 0x6d, 0xbb, 0x5b, 0x43, 0x32, 0x24, 0xbc, 0xe3, 0x88, 0xfd, 0x12, 0x34, 0xbb,
+	0x3b, 0x06, 0xa1, 0x0e, 0xa1, 0x9a, 0xe8, 0x10, 0x1f, 0xb2, 0x6b, 0x4f, 0x7c, 0x1d, 0xf5, 0x75,
+	0x8a, 0x8f, 0xae, 0x9e, 0xf6, 0xb1, 0xaf, 0x3f, 0x3d, 0x32, 0x88, 0xe5, 0xca, 0xfe, 0xfd, 0x21,
+	0x21, 0x43, 0x1b, 0x1f, 0xf1, 0xaf, 0xfe, 0x64, 0x70, 0xe4, 0x5b, 0x0e, 0xa6, 0xbe, 0xee, 0x8c,
+	0x05, 0x41, 0xf5, 0xd7, 0x1b, 0xb0, 0x74, 0x66, 0xb9, 0x3e, 0xf6, 0xd0, 0x0f, 0x60, 0xd5, 0x72,
+	0x07, 0xb6, 0xee, 0x5b, 0xc4, 0x55, 0x72, 0x07, 0xb9, 0x27, 0xab, 0xc7, 0xdf, 0xfe, 0xe9, 0x2f,
+	0xf7, 0x17, 0x7e, 0xf1, 0xcb, 0xfd, 0xc7, 0x43, 0xcb, 0x1f, 0x4d, 0xfa, 0x87, 0x06, 0x71, 0x24,
+	0x7f, 0xf9, 0xdf, 0x5b, 0xd4, 0x7c, 0x79, 0xe4, 0xdf, 0x8e, 0x31, 0x3d, 0x6c, 0x60, 0xe3, 0xe7,
+	0x3f, 0x79, 0x0b, 0xe4, 0xf4, 0x1a, 0xd8, 0x50, 0x23, 0x38, 0x64, 0xc1, 0xa6, 0xee, 0xba, 0x13,
+	0xdd, 0x66, 0x8b, 0xb8, 0xb2, 0xa8, 0x45, 0x5c, 0xaa, 0xe4, 0x33, 0xe0, 0x51, 0x11, 0xb0, 0x9d,
+	0x10, 0x15, 0x69, 0xb0, 0x66, 0xe8, 0x9e, 0x77, 0xab, 0xf5, 0x27, 0x83, 0x01, 0xf6, 0x94, 0x42,
+	0x06, 0x5c, 0x4a, 0x1c, 0xf1, 0x98, 0x03, 0xa2, 0x26, 0xac, 0x8f, 0xf5, 0x09, 0xc5, 0xa6, 0x46,
+	0x47, 0xba, 0x87, 0xa9, 0x52, 0x3c, 0xc8, 0x3d, 0x29, 0x3d, 0xdb, 0x3d, 0x8c, 0x1f, 0xe5, 0x61,
+	0x87, 0x93, 0x74, 0x39, 0xc5, 0x71, 0x91, 0x71, 0x57, 0xd7, 0xc6, 0xb1, 0x36, 0xf4, 0x7d, 0xd8,
+	0xb4, 0x75, 0xea, 0x6b, 0x7d, 0x9b, 0x18, 0x2f, 0x35, 0xcb, 0x1d, 0x4f, 0x7c, 0xaa, 0x2c, 0x72,
+	0xa8, 0x9d, 0x24, 0xd4, 0x31, 0xa3, 0x68, 0x71, 0x02, 0x89, 0x54, 0x66, 0x23, 0x63, 0xcd, 0x6c,
+	0x7f, 0x8d, 0x89, 0x33, 0x61, 0xbb, 0x7d, 0x85, 0x35, 0x36, 0x0a, 0x9b, 0xca, 0xd2, 0xe7, 0x5e,
+	0x79, 0xcb, 0xf5, 0x63, 0x2b, 0x6f, 0xb9, 0xbe, 0x5a, 0x89, 0x60, 0xb9, 0x98, 0x98, 0xe8, 0x12,
+	0xb6, 0x63, 0xac, 0x4c, 0x8b, 0xfa, 0x9e, 0xd5, 0x9f, 0x30, 0x7e, 0xcb, 0x7c, 0xf2, 0x0f, 0x92,
+	0x93, 0xaf, 0xeb, 0x3e, 0x1e, 0x12, 0xef, 0xb6, 0x47, 0x7c, 0xdd, 0x0e, 0xe6, 0x7f, 0x37, 0x42,
+	0x68, 0x44, 0x00, 0xe8, 0x5d, 0xd8, 0x1e, 0x12, 0xdd, 0xd6, 0xfa, 0xc4, 0x35, 0xb1, 0xa9, 0xf9,
+	0x9e, 0xee, 0x52, 0x8b, 0x8b, 0xe3, 0x0a, 0x87, 0xae, 0x26, 0xa1, 0x9f, 0x13, 0xdd, 0x3e, 0xe6,
+	0xa4, 0xbd, 0x90, 0x52, 0xdd, 0x1a, 0xce, 0x68, 0x45, 0x7f, 0x08, 0x9b, 0x06, 0x71, 0x9c, 0x89,
+	0x6b, 0xf9, 0xb7, 0xda, 0x60, 0xe2, 0x9a, 0x96, 0x3b, 0x54, 0x56, 0x39, 0xe8, 0x5e, 0x6a, 0xbe,
+	0x01, 0xd9, 0x89, 0xa0, 0x92, 0x33, 0xae, 0x18, 0xa9, 0x76, 0x34, 0x86, 0x75, 0x21, 0x61, 0xd8,
+	0xd4, 0xcc, 0x09, 0xf5, 0x15, 0x38, 0x28, 0xf0, 0xb3, 0x93, 0xbb, 0xc7, 0x54, 0xf2, 0x50, 0xaa,
+	0xe4, 0x61, 0x9d, 0x58, 0xee, 0xf1, 0xd7, 0x19, 0xd2, 0x87, 0xbf, 0xda, 0x7f, 0xf2, 0x19, 0x4e,
+	0x82, 0x0d, 0xa0, 0xea, 0x5a, 0xc0, 0xa1, 0x31, 0xa1, 0x3e, 0xfa, 0x11, 0xec, 0xfa, 0xba, 0x37,
+	0xc4, 0xbe, 0x16, 0x3b, 0x00, 0xec, 0x58, 0x94, 0x09, 0xbe, 0x52, 0xca, 0x40, 0xce, 0x15, 0x81,
+	0x5f, 0x0f, 0xe1, 0x9b, 0x12, 0x1d, 0x7d, 0x0f, 0xca, 0x63, 0xcc, 0x17, 0xae, 0x8d, 0xf5, 0x5b,
+	0xc2, 0x64, 0x75, 0x8d, 0xaf, 0xf7, 0x7e, 0x4a, 0xec, 0x05, 0x51, 0x87, 0xd3, 0xc8, 0xbd, 0xdb,
+	0x18, 0xc7, 0x1b, 0x29, 0xba, 0x81, 0x47, 0xb1, 0x05, 0x44, 0xe7, 0x32, 0x26, 0xc4, 0x0e, 0x0f,
+	0x67, 0x9d, 0xa3, 0x7f, 0x6d, 0xce, 0xe1, 0x74, 0x08, 0xb1, 0xe5, 0x41, 0x70, 0xc1, 0x92, 0x9c,
+	0xf6, 0x22, 0xdc, 0x59, 0xa4, 0xe8, 0x06, 0x36, 0xa9, 0xef, 0x31, 0x89, 0xb4, 0x0c, 0xcd, 0xc3,
+	0x14, 0x7b, 0x57, 0x58, 0xd9, 0xc8, 0xfe, 0xdc, 0x2a, 0x21, 0x17, 0x55, 0x30, 0x41, 0x7f, 0x9e,
+	0x83, 0xdd, 0x29, 0xd6, 0x9a, 0x87, 0x6d, 0xac, 0x53, 0x6c, 0x2a, 0xe5, 0xec, 0xe7, 0xa0, 0xa4,
+	0xe7, 0xa0, 0x4a, 0x66, 0xa8, 0x01, 0xeb, 0x26, 0x76, 0x89, 0x23, 0xec, 0x84, 0x47, 0x95, 0x8a,
+	0xe4, 0x9e, 0xd8, 0xeb, 0x06, 0x23, 0x11, 0xae, 0x21, 0xb0, 0x5f, 0x66, 0xd4, 0x94, 0x36, 0x39,
+	0xec, 0xd8, 0xb0, 0xa9, 0x6c, 0x66, 0x6b, 0x72, 0x4e, 0x38, 0x2a, 0xfa, 0x1e, 0x3c, 0x32, 0x88,
+	0x4b, 0xb1, 0x31, 0x49, 0xda, 0x1c, 0x8b, 0xb8, 0xda, 0x40, 0xb7, 0xec, 0x09, 0xb3, 0xc2, 0xe8,
+	0x20, 0xf7, 0xa4, 0xa8, 0xee, 0xc7, 0x08, 0x1b, 0x31, 0xba, 0x13, 0x49, 0x86, 0xf6, 0xa1, 0xa4,
+	0x4f, 0x7c, 0xa2, 0x09, 0x5b, 0xac, 0xdc, 0x39, 0xc8, 0x3d, 0x59, 0x51, 0x81, 0x35, 0x09, 0x8b,
+	0x8d, 0xfa, 0xb0, 0xcb, 0x4d, 0x32, 0xd5, 0xc6, 0xd8, 0xd3, 0x6e, 0xb1, 0xee, 0xc5, 0x0d, 0xd1,
+	0x16, 0xb7, 0x19, 0x6f, 0xcc, 0x30, 0xd0, 0xb4, 0x83, 0xbd, 0x4b, 0xac, 0x7b, 0x31, 0x5b, 0x74,
+	0xaf, 0x3f, 0xbb, 0xa3, 0xfa, 0x57, 0x39, 0xd8, 0x99, 0x2b, 0xcb, 0x68, 0x0b, 0x16, 0x6d, 0xbd,
+	0x8f, 0x6d, 0xe1, 0x84, 0x55, 0xf1, 0x81, 0x0c, 0x58, 0xd2, 0x1d, 0x32, 0x71, 0x7d, 0x25, 0x9f,
+	0xbd, 0xb0, 0x48, 0xe8, 0xea, 0x9f, 0xe5, 0xa0, 0x74, 0x8a, 0xcd, 0x21, 0xf6, 0x9a, 0xae, 0xef,
+	0xdd, 0x22, 0x04, 0x45, 0x57, 0x77, 0xb0, 0x9c, 0x09, 0xff, 0xfd, 0xe5, 0x4c, 0xe4, 0xef, 0x73,
+	0x50, 0x49, 0x9b, 0x62, 0x76, 0x76, 0xfd, 0x89, 0xc9, 0x0c, 0x20, 0x3b, 0x16, 0x3e, 0xa9, 0x82,
+	0x0a, 0xa2, 0x89, 0xed, 0x30, 0xba, 0x86, 0x1d, 0x7e, 0x60, 0xd4, 0xd7, 0x3d, 0x3f, 0x65, 0x59,
+	0x94, 0x7c, 0x06, 0xb2, 0xb9, 0xcd, 0xe0, 0xbb, 0x0c, 0x3d, 0x71, 0x7c, 0xd5, 0xff, 0xc9, 0xc1,
+	0xd6, 0x2c, 0x77, 0x84, 0x3a, 0x50, 0x1c, 0x78, 0xc4, 0xc9, 0x24, 0x9e, 0xe2, 0x48, 0xe8, 0x14,
+	0xf2, 0x3e, 0xc9, 0x24, 0x76, 0xca, 0xfb, 0x04, 0x3d, 0x82, 0x35, 0xb1, 0x59, 0x23, 0x6c, 0x0d,
+	0x47, 0x3e, 0x8f, 0x96, 0x0a, 0x6a, 0x89, 0xb7, 0xbd, 0xe0, 0x4d, 0xe8, 0x21, 0x00, 0x76, 0xcd,
+	0x80, 0xa0, 0xc8, 0x09, 0x56, 0xb1, 0x6b, 0x8a, 0xee, 0xea, 0x9f, 0xc2, 0xbd, 0x39, 0xf2, 0xcf,
+	0xa4, 0x27, 0x5c, 0x7c, 0x51, 0x4e, 0x7f, 0x23, 0x9c, 0x7e, 0x31, 0xa3, 0x09, 0xfc, 0xba, 0x00,
+	0x1b, 0xc9, 0x28, 0x03, 0xbd, 0x0d, 0xcb, 0xd4, 0xd7, 0x5f, 0x32, 0x3f, 0x92, 0xcb, 0xe0, 0xd4,
+	0x03, 0x30, 0x34, 0x84, 0x8a, 0x30, 0x74, 0x9a, 0x6e, 0x9a, 0x1e, 0xa6, 0x14, 0xd3, 0x4c, 0xc4,
+	0xaa, 0x2c, 0x50, 0x6b, 0x01, 0x28, 0x32, 0x60, 0x23, 0x25, 0xbd, 0x85, 0x0c, 0xd8, 0xac, 0x1b,
+	0x71, 0xa1, 0x65, 0xb2, 0xc9, 0x03, 0x97, 0x62, 0x06, 0xd0, 0x1c, 0x89, 0xf9, 0x84, 0x69, 0xff,
+	0xba, 0x98, 0x85, 0x4f, 0x48, 0x3b, 0xb3, 0xea, 0x2f, 0xf2, 0xb0, 0xdc, 0x9d, 0x38, 0x8e, 0xee,
+	0xdd, 0x32, 0x01, 0x61, 0x76, 0x58, 0xe3, 0xfe, 0x49, 0xda, 0xaa, 0x55, 0xd6, 0xc2, 0x7d, 0x58,
+	0x32, 0xb1, 0xc9, 0x7f, 0x09, 0x89, 0x4d, 0xe1, 0x95, 0x24, 0x36, 0x33, 0x63, 0xfc, 0xe2, 0xab,
+	0x88, 0xf1, 0xab, 0x1f, 0xe4, 0xa1, 0x14, 0x4f, 0x2f, 0xb6, 0x61, 0x49, 0x6a, 0x9f, 0xb0, 0xb9,
+	0xf2, 0x8b, 0xe5, 0x5a, 0x32, 0x56, 0xf7, 0xd8, 0x76, 0x64, 0xb2, 0xb9, 0x25, 0x81, 0xa8, 0x32,
+	0x40, 0xa6, 0x07, 0x52, 0xf7, 0x34, 0x3a, 0x19, 0x8f, 0xed, 0xdb, 0x6c, 0xf4, 0x40, 0x62, 0x76,
+	0x39, 0x24, 0x7a, 0x0d, 0xd6, 0x05, 0xb8, 0x46, 0xc9, 0xc4, 0x33, 0xb0, 0xd8, 0x54, 0x75, 0x4d,
+	0x34, 0x76, 0x79, 0x5b, 0xf5, 0x3f, 0xf2, 0xb0, 0x16, 0xcf, 0xe9, 0x10, 0x8e, 0xdb, 0x98, 0xcc,
+	0xfd, 0x60, 0x68, 0x72, 0xae, 0x66, 0x9a, 0x9c, 0xcc, 0xf9, 0x4d, 0x59, 0x20, 0x6f, 0x86, 0x05,
+	0xca, 0x9c, 0x6b, 0xd2, 0x20, 0x55, 0xff, 0x35, 0x0f, 0xe5, 0x77, 0xb8, 0x64, 0x85, 0x33, 0x41,
+	0xcf, 0x60, 0x59, 0x2e, 0x5c, 0x9a, 0x72, 0xe5, 0xe7, 0x3f, 0x79, 0x6b, 0x4b, 0xce, 0x41, 0x12,
+	0x75, 0x7d, 0xcf, 0x72, 0x87, 0x6a, 0x40, 0x88, 0x7a, 0xb0, 0x74, 0x2d, 0xc4, 0x35, 0x0b, 0x81,
+	0x94, 0x58, 0xe8, 0x9b, 0x50, 0x12, 0xa9, 0x8f, 0xe6, 0x10, 0x13, 0x73, 0x41, 0xdc, 0x78, 0xa6,
+	0xa4, 0xb3, 0x7e, 0x46, 0x70, 0x46, 0x4c, 0xac, 0xc2, 0x38, 0xfc, 0x3d, 0xe5, 0xe4, 0x8a, 0x9f,
+	0xe6, 0xe4, 0x16, 0x53, 0x4e, 0x8e, 0x31, 0x17, 0xd3, 0x10, 0xcc, 0x97, 0x66, 0x31, 0x17, 0x5b,
+	0x27, 0x98, 0x5f, 0x87, 0xbf, 0xab, 0x37, 0x4c, 0x70, 0x3d, 0xdd, 0xa1, 0xf5, 0x91, 0xee, 0x0e,
+	0xf1, 0x5c, 0x65, 0x7e, 0x00, 0xab, 0xfa, 0xc4, 0x1f, 0x11, 0xcf, 0xf2, 0x6f, 0xc5, 0xc6, 0xa9,
+	0x51, 0x03, 0xda, 0x81, 0x15, 0x87, 0x0e, 0x35, 0xb6, 0x49, 0x42, 0x07, 0xd5, 0x65, 0x87, 0x0e,
+	0x7b, 0xb7, 0x63, 0x8c, 0xee, 0xc1, 0xb2, 0x7f, 0xa3, 0x8d, 0x74, 0x3a, 0x92, 0x9a, 0xb3, 0xe4,
+	0xdf, 0xbc, 0xd0, 0xe9, 0xa8, 0xfa, 0x9f, 0x39, 0x58, 0x4f, 0x24, 0x84, 0x5f, 0xe8, 0x34, 0xbf,
+	0x8c, 0x78, 0x93, 0x85, 0x96, 0x2c, 0x3c, 0x49, 0x46, 0x21, 0xc0, 0x9a, 0xe4, 0x01, 0xdc, 0x87,
+	0x55, 0x9f, 0x24, 0xcf, 0x6f, 0xc5, 0x27, 0x32, 0x04, 0xf9, 0xb0, 0x00, 0xf7, 0xe2, 0xd9, 0x46,
+	0xc7, 0x23, 0x63, 0xe2, 0xf9, 0xdc, 0x6c, 0xff, 0x46, 0xb1, 0xc8, 0xb4, 0x34, 0x66, 0x1c, 0x8b,
+	0x4c, 0x33, 0x78, 0x25, 0xb1, 0xc8, 0x34, 0x9b, 0x54, 0x2c, 0x32, 0x33, 0x72, 0x28, 0x66, 0xe1,
+	0x47, 0xa7, 0x22, 0x87, 0xbf, 0xde, 0x86, 0x25, 0xa1, 0x10, 0x9f, 0x16, 0x38, 0x8c, 0xe1, 0x6e,
+	0xe8, 0xe9, 0x99, 0x87, 0xc3, 0x9a, 0xc1, 0x55, 0x28, 0x93, 0x7d, 0xbe, 0x13, 0x42, 0xab, 0xba,
+	0x8f, 0xa5, 0x6e, 0xea, 0xb0, 0x1e, 0x71, 0x74, 0xf4, 0x9b, 0x4c, 0xb6, 0x7a, 0x2d, 0x84, 0x3c,
+	0xd3, 0x6f, 0x52, 0x2c, 0x2c, 0x57, 0x29, 0x66, 0xcb, 0xc2, 0x72, 0xd1, 0x0f, 0xa1, 0x14, 0xab,
+	0xe3, 0x29, 0x8b, 0x19, 0x30, 0x80, 0xa8, 0xac, 0x87, 0x1e, 0x43, 0x39, 0x95, 0xa1, 0x73, 0x7b,
+	0x58, 0x54, 0xd7, 0x13, 0xf9, 0x36, 0x1a, 0x80, 0x92, 0x28, 0x15, 0x8c, 0x23, 0xad, 0x54, 0x96,
+	0x67, 0xe5, 0xf1, 0x73, 0x54, 0x58, 0x96, 0x3f, 0xee, 0x99, 0x73, 0x34, 0xfc, 0x7c, 0x86, 0x26,
+	0xae, 0x70, 0x53, 0xf5, 0x70, 0x96, 0x81, 0x0e, 0x75, 0x2b, 0x28, 0xe6, 0xa6, 0x15, 0xee, 0x4f,
+	0xe0, 0xbe, 0x63, 0xb9, 0x51, 0x99, 0x43, 0xef, 0xdb, 0x38, 0x0a, 0x2f, 0x95, 0xd5, 0xcf, 0xbd,
+	0x9d, 0xd3, 0x11, 0xd0, 0x8e, 0x63, 0xb9, 0x8d, 0x38, 0x7e, 0x18, 0x67, 0xb2, 0x68, 0x88, 0xd7,
+	0x46, 0x78, 0x84, 0xc9, 0xac, 0x16, 0xf0, 0x12, 0x89, 0x28, 0x5e, 0x9f, 0x89, 0x36, 0x74, 0x08,
+	0x77, 0x04, 0x51, 0x18, 0x9d, 0xb1, 0xa0, 0x88, 0xd7, 0x20, 0x57, 0xd4, 0x4d, 0xde, 0xd5, 0x95,
+	0x31, 0x16, 0xeb, 0x40, 0xbf, 0x07, 0x48, 0xd0, 0xcb, 0x8d, 0x12, 0xe4, 0x6b, 0x9c, 0xbc, 0xc2,
+	0x7b, 0x44, 0xa9, 0x47, 0x50, 0x3f, 0x83, 0xbb, 0x82, 0x3a, 0xb2, 0x3b, 0x62, 0xc0, 0x3a, 0x1f,
+	0x20, 0x58, 0x87, 0x09, 0xb8, 0x18, 0xd3, 0x82, 0xcd, 0x78, 0x55, 0x5e, 0xb8, 0xc9, 0x0d, 0xee,
+	0x26, 0x1f, 0xce, 0xad, 0xcc, 0x73, 0x5f, 0x59, 0x1e, 0x27, 0x1b, 0x50, 0x13, 0xca, 0x2c, 0x9b,
+	0xd1, 0x74, 0x4a, 0xad, 0xa1, 0xeb, 0x60, 0xd7, 0x57, 0xca, 0x1c, 0x28, 0x55, 0xda, 0x66, 0x45,
+	0xd9, 0x5a, 0x48, 0xa3, 0x6e, 0x98, 0x89, 0x6f, 0xf4, 0x26, 0x6c, 0x62, 0xc7, 0xf2, 0xf9, 0x3e,
+	0x6a, 0x63, 0x5b, 0x77, 0x5d, 0x6c, 0x2a, 0x15, 0xbe, 0x82, 0x32, 0xeb, 0x60, 0x7b, 0xd9, 0x11,
+	0xcd, 0xe8, 0x14, 0x50, 0x22, 0x04, 0x15, 0xd3, 0xdf, 0xe4, 0x5c, 0x53, 0x05, 0xea, 0x6e, 0x2c,
+	0x2a, 0xe5, 0xf3, 0xaf, 0xd0, 0x54, 0x0b, 0xfa, 0x23, 0x78, 0xc0, 0x04, 0x48, 0x26, 0x26, 0xd3,
+	0x85, 0x6f, 0x24, 0x6f, 0x19, 0xe6, 0xfa, 0x51, 0x21, 0x98, 0x4c, 0x48, 0x6a, 0x1c, 0x63, 0xaa,
+	0x12, 0xd3, 0x87, 0xdd, 0x29, 0x58, 0x6d, 0xec, 0x59, 0x22, 0x78, 0xb8, 0x73, 0x50, 0x78, 0xb2,
+	0xf1, 0xec, 0xf5, 0x4f, 0x2e, 0xac, 0x8b, 0xf9, 0xaa, 0x4a, 0xba, 0xb0, 0xde, 0x91, 0x28, 0xe8,
+	0x1b, 0xa0, 0x4c, 0xf3, 0xb8, 0xb6, 0x5c, 0x93, 0x5c, 0xf3, 0x32, 0x5c, 0x51, 0xdd, 0x4e, 0x8f,
+	0x7d, 0x87, 0xf7, 0x32, 0x85, 0x34, 0x3d, 0x6b, 0xc0, 0x2a, 0x40, 0x9e, 0x87, 0x0d, 0x9e, 0xf7,
+	0xdd, 0xe5, 0x6b, 0x4e, 0x89, 0x42, 0x83, 0x51, 0xd5, 0x43, 0xa2, 0x40, 0x21, 0xcd, 0x64, 0x33,
+	0xf2, 0x60, 0xdb, 0x66, 0x85, 0x71, 0x69, 0xfe, 0x35, 0x7f, 0xe4, 0x61, 0x3a, 0x22, 0xb6, 0xa9,
+	0x6c, 0x67, 0x60, 0xda, 0xb6, 0x38, 0xb6, 0x70, 0x00, 0xbd, 0x00, 0x19, 0x7d, 0x0b, 0x76, 0x02,
+	0xdd, 0xf2, 0xf0, 0xb5, 0xee, 0x99, 0x54, 0xf3, 0xb0, 0x61, 0x8d, 0x2d, 0x26, 0x8e, 0xf7, 0xb8,
+	0xa7, 0xba, 0x27, 0x09, 0x54, 0xd1, 0xaf, 0x06, 0xdd, 0xe8, 0x29, 0x2c, 0x8d, 0x47, 0x3a, 0x33,
+	0x43, 0x0a, 0x37, 0x43, 0x77, 0x52, 0x0a, 0xc0, 0xfa, 0xe4, 0x5a, 0x25, 0x21, 0x7a, 0x0f, 0xc0,
+	0xd1, 0x6f, 0x82, 0x24, 0x6b, 0x27, 0x03, 0x13, 0xb3, 0xea, 0xe8, 0x37, 0x32, 0xc1, 0x7a, 0x01,
+	0x15, 0x3a, 0x22, 0x9e, 0x3f, 0xd0, 0x6d, 0x5b, 0x1b, 0x13, 0xdb, 0x32, 0x6e, 0x95, 0xdd, 0x59,
+	0xaa, 0xd9, 0x0d, 0xa8, 0x3a, 0x9c, 0x48, 0x2d, 0xd3, 0x64, 0x03, 0x7a, 0x0b, 0x50, 0x0c, 0x29,
+	0x90, 0xb7, 0xfb, 0x07, 0x85, 0x27, 0xab, 0xea, 0x66, 0x44, 0x1c, 0x88, 0xd0, 0x77, 0xe0, 0x7e,
+	0xe4, 0xeb, 0xa8, 0xab, 0x8f, 0xe9, 0x88, 0xf8, 0x1a, 0x2f, 0x60, 0x5f, 0xe9, 0xb6, 0xf2, 0x80,
+	0x4b, 0xd1, 0x4e, 0x48, 0xd2, 0x95, 0x14, 0x2d, 0x49, 0x80, 0xbe, 0x0b, 0x0f, 0x66, 0x8c, 0xf7,
+	0xb0, 0x8f, 0x5d, 0x2e, 0x54, 0x0f, 0x39, 0xc0, 0xee, 0x14, 0x80, 0x1a, 0x50, 0xa0, 0x1a, 0xac,
+	0x71, 0xfd, 0x37, 0x88, 0x3b, 0xb0, 0x86, 0x54, 0xd9, 0xe3, 0x07, 0x92, 0x0a, 0xdc, 0x99, 0x25,
+	0xa8, 0x73, 0x02, 0x79, 0x2a, 0x25, 0x27, 0x6c, 0xa1, 0xc8, 0x84, 0x88, 0x81, 0x66, 0xe8, 0xb6,
+	0x31, 0x91, 0xbf, 0xb9, 0x8d, 0xd8, 0xe7, 0xfb, 0xf8, 0x38, 0x09, 0xd8, 0x0a, 0xe8, 0xeb, 0x11,
+	0x39, 0xb7, 0x15, 0x8a, 0x35, 0xa7, 0x07, 0xf5, 0x00, 0xf5, 0x09, 0xf1, 0x59, 0xb4, 0x34, 0xd6,
+	0xc8, 0x15, 0xf6, 0x3c, 0xcb, 0xc4, 0xca, 0x01, 0xd7, 0x9a, 0xfd, 0x54, 0xb9, 0x3b, 0xa0, 0x6b,
+	0x4b, 0x32, 0x39, 0xeb, 0xcd, 0x7e, 0xba, 0x03, 0x0d, 0xa0, 0xc2, 0x2c, 0x51, 0xa2, 0x48, 0xf0,
+	0x28, 0x03, 0x9d, 0xd9, 0x70, 0x2c, 0xf7, 0x38, 0x56, 0x27, 0xf8, 0x3a, 0x6c, 0x45, 0x55, 0xfd,
+	0x98, 0x7e, 0x56, 0xf9, 0x01, 0xa1, 0xb0, 0xbc, 0x1f, 0xe9, 0xd7, 0x37, 0xa1, 0xc4, 0x8d, 0xbc,
+	0x14, 0xc7, 0xd7, 0x66, 0x25, 0x54, 0xcc, 0xc0, 0x4b, 0x49, 0x04, 0x33, 0xfc, 0x8d, 0x9e, 0xc2,
+	0x96, 0xa3, 0x33, 0x21, 0x72, 0x75, 0xd7, 0xc0, 0x91, 0x38, 0xbd, 0xce, 0x99, 0xdd, 0x89, 0xf5,
+	0x85, 0x82, 0xf4, 0x3b, 0x7c, 0x1f, 0x98, 0xeb, 0x8c, 0xc8, 0xdf, 0xe0, 0xe4, 0x65, 0xd9, 0x1e,
+	0x92, 0x4e, 0x40, 0x61, 0x5b, 0x96, 0xba, 0x16, 0x13, 0xfe, 0xef, 0x71, 0x06, 0x5b, 0x77, 0xd7,
+	0xb1, 0xdc, 0x44, 0xf1, 0x9a, 0x3b, 0xbe, 0x6f, 0x15, 0x7f, 0xfc, 0xb7, 0xfb, 0x0b, 0xd5, 0xbf,
+	0xcc, 0x41, 0x99, 0xc7, 0xc6, 0x0d, 0x4c, 0x0d, 0xcf, 0x1a, 0xfb, 0xc4, 0x9b, 0x79, 0x07, 0x50,
+	0x81, 0xc2, 0x4b, 0x1c, 0x64, 0x89, 0xec, 0x27, 0xa3, 0x8a, 0xe5, 0x86, 0xfc, 0x37, 0xbb, 0xc8,
+	0xb8, 0xd2, 0xed, 0x49, 0x50, 0x50, 0x11, 0x1f, 0x48, 0x81, 0x65, 0x13, 0x0f, 0xf4, 0x89, 0x2d,
+	0xd2, 0xdc, 0x55, 0x35, 0xf8, 0x64, 0x99, 0x69, 0x9f, 0x4c, 0x5c, 0x93, 0x8a, 0xab, 0x6b, 0x55,
+	0x7e, 0x55, 0xdf, 0xcf, 0x41, 0x39, 0x65, 0xaa, 0x03, 0x83, 0x35, 0xd0, 0x0d, 0x9f, 0x78, 0xd9,
+	0x3c, 0x57, 0x70, 0xf4, 0x9b, 0x13, 0x0e, 0xc7, 0xa6, 0xc8, 0xd2, 0xde, 0x1f, 0xc9, 0x7a, 0x61,
+	0x51, 0x0d, 0x3e, 0xab, 0x1d, 0xd8, 0x9c, 0x12, 0x7f, 0x96, 0x39, 0x47, 0xb6, 0x59, 0x66, 0x11,
+	0x61, 0x43, 0x2a, 0xb3, 0xcf, 0xa7, 0xcb, 0xd7, 0x1f, 0x16, 0x01, 0x22, 0x03, 0xf0, 0xdb, 0x94,
+	0xe4, 0xff, 0x65, 0x4a, 0xf2, 0x49, 0xa9, 0xc6, 0x52, 0x76, 0xa9, 0x46, 0xf5, 0x9f, 0x0a, 0x50,
+	0x8a, 0x5d, 0xcc, 0x32, 0x0d, 0x8b, 0x0b, 0x8a, 0xf8, 0xf8, 0xaa, 0x14, 0xbc, 0xd3, 0x2f, 0x79,
+	0x8a, 0x59, 0xbf, 0xe4, 0x99, 0x59, 0x51, 0x5f, 0x7c, 0x25, 0x15, 0xf5, 0x8f, 0xf3, 0xb0, 0xc8,
+	0xe3, 0xae, 0x99, 0xe6, 0x34, 0x5d, 0x1f, 0xcc, 0x4f, 0xd7, 0x07, 0xa7, 0x74, 0xa4, 0x90, 0xb9,
+	0x8e, 0x4c, 0x69, 0x7a, 0x31, 0x73, 0x4d, 0x7f, 0xb5, 0x6a, 0x58, 0xfd, 0x97, 0x3c, 0xec, 0x9c,
+	0xc4, 0xb3, 0x69, 0x91, 0x71, 0x4b, 0x4b, 0xf6, 0x45, 0x8a, 0x8f, 0x51, 0xb1, 0x34, 0x9f, 0x28,
+	0x96, 0xbe, 0x07, 0x40, 0x6c, 0x53, 0xbb, 0x8e, 0xca, 0x85, 0xbf, 0xb1, 0x8e, 0x11, 0xdb, 0x7c,
+	0x27, 0x04, 0x77, 0xf1, 0x75, 0x00, 0x9e, 0xc5, 0x29, 0xac, 0xba, 0xf8, 0x5a, 0x82, 0x6f, 0xc3,
+	0x92, 0x2e, 0x52, 0x22, 0xe1, 0x7d, 0xe5, 0x57, 0xf5, 0x9f, 0x0b, 0xb0, 0xc9, 0xef, 0x7c, 0xe2,
+	0xa6, 0x69, 0x6e, 0xb1, 0xb8, 0x07, 0x4b, 0x52, 0x5f, 0xb2, 0xb8, 0xff, 0x94, 0x58, 0xa8, 0x01,
+	0xa5, 0xf8, 0x83, 0xb2, 0xc2, 0x67, 0x7e, 0x50, 0x16, 0x1f, 0x86, 0xbe, 0x01, 0x45, 0xdf, 0x72,
+	0x70, 0xf8, 0x2e, 0x4f, 0xbc, 0x81, 0x3c, 0x0c, 0xde, 0x40, 0x1e, 0xf6, 0x82, 0x37, 0x90, 0xc7,
+	0x2b, 0x6c, 0xf0, 0x07, 0xbf, 0xda, 0xcf, 0xa9, 0x7c, 0x44, 0xd2, 0x70, 0x2e, 0x66, 0x6b, 0x38,
+	0xdf, 0x9d, 0x51, 0x25, 0x5a, 0x9a, 0xf5, 0xc8, 0x29, 0x21, 0xc0, 0xf1, 0xc3, 0x98, 0x53, 0x2f,
+	0x62, 0xd7, 0x26, 0x9b, 0xad, 0x74, 0x0a, 0x32, 0xf7, 0xe4, 0xbe, 0x3a, 0xce, 0x21, 0x91, 0x55,
+	0x14, 0x33, 0xbe, 0x7a, 0xac, 0xfe, 0x57, 0x0e, 0x76, 0xe6, 0x1e, 0xc5, 0xff, 0xdd, 0x8b, 0x8c,
+	0x3f, 0x88, 0xc7, 0xa2, 0x85, 0x4f, 0x99, 0x5a, 0x44, 0x5a, 0xfd, 0xef, 0x1c, 0xdc, 0x49, 0x2c,
+	0xb7, 0xe5, 0x1a, 0xc4, 0xf9, 0x62, 0x46, 0x53, 0x87, 0x45, 0x9f, 0x69, 0xe7, 0xab, 0x58, 0xa7,
+	0x40, 0x66, 0x1e, 0x73, 0x60, 0x79, 0x34, 0xfd, 0x6c, 0x84, 0xb7, 0x49, 0x8f, 0xb9, 0x0f, 0x25,
+	0x5b, 0x8f, 0x28, 0xc4, 0x9d, 0x0d, 0xd8, 0x7a, 0x40, 0x50, 0xfd, 0x9b, 0x02, 0x6c, 0x04, 0x0f,
+	0x1c, 0x55, 0xcc, 0x62, 0xac, 0xf4, 0x35, 0x50, 0xee, 0x93, 0xaf, 0x81, 0xf2, 0xc9, 0x6b, 0x20,
+	0xf4, 0x35, 0x28, 0x7b, 0xd8, 0x20, 0x1e, 0x93, 0x4a, 0x51, 0x8a, 0xe6, 0xf3, 0x2a, 0xaa, 0x1b,
+	0x41, 0xb3, 0x78, 0x29, 0x83, 0xea, 0x00, 0x62, 0xf6, 0x9f, 0xdb, 0x4e, 0xad, 0xf2, 0x71, 0xac,
+	0x07, 0xd5, 0x60, 0xd5, 0xd6, 0x03, 0x8c, 0xc5, 0xcf, 0x81, 0xb1, 0xc2, 0x86, 0x71, 0x88, 0xc8,
+	0x8a, 0x2f, 0xbd, 0x3a, 0x2b, 0xbe, 0xfc, 0x85, 0xac, 0x78, 0xf5, 0xfd, 0x3c, 0xa0, 0xe0, 0x74,
+	0x3a, 0x1e, 0xf9, 0x63, 0x99, 0xf7, 0xa9, 0x81, 0x6c, 0x65, 0xf1, 0xb0, 0x47, 0x0a, 0xd3, 0x31,
+	0x80, 0x21, 0xe6, 0x63, 0xc9, 0x4b, 0xb4, 0xcf, 0x36, 0xdf, 0xd8, 0xa8, 0xa4, 0x59, 0x2d, 0x64,
+	0x6a, 0x56, 0xab, 0xff, 0x98, 0x87, 0x0a, 0x8f, 0xfa, 0xeb, 0xc4, 0xa5, 0x16, 0xf5, 0xb1, 0x6b,
+	0x7c, 0xea, 0xa3, 0x97, 0x87, 0x00, 0xcc, 0x9a, 0xc9, 0x6e, 0x79, 0x9d, 0xcb, 0x5a, 0x44, 0xf7,
+	0x97, 0xf2, 0xb0, 0xe2, 0x87, 0x50, 0xea, 0xeb, 0xee, 0xcb, 0x80, 0x43, 0x16, 0x6f, 0x55, 0x80,
+	0x01, 0x4a, 0xf8, 0x5d, 0x58, 0x71, 0x2c, 0xea, 0xe8, 0xbe, 0x31, 0xe2, 0xf2, 0xbf, 0xa2, 0x86,
+	0xdf, 0xd5, 0x7f, 0xc8, 0xc1, 0xfa, 0x19, 0x3f, 0xc0, 0xb7, 0xb1, 0xc7, 0xef, 0x35, 0x7e, 0x97,
+	0x3d, 0x01, 0x77, 0x29, 0x76, 0xe9, 0x84, 0x6a, 0x57, 0xa2, 0x51, 0xbe, 0x4c, 0xab, 0x84, 0x1d,
+	0x31, 0xe2, 0x3e, 0x1e, 0xe9, 0x57, 0x16, 0xf1, 0x34, 0x0f, 0xcb, 0x8b, 0x17, 0xb1, 0x89, 0x95,
+	0xa0, 0x43, 0x95, 0xed, 0x4c, 0x9b, 0x1d, 0x6b, 0xc8, 0xdd, 0x10, 0x77, 0x77, 0x33, 0x6e, 0x7e,
+	0xce, 0x82, 0x7e, 0x95, 0x1b, 0x82, 0x40, 0x7e, 0xa2, 0x61, 0xd5, 0x1f, 0xe7, 0xa0, 0x9c, 0xa2,
+	0xe2, 0x46, 0x8e, 0x59, 0xa3, 0xe4, 0x6c, 0xb9, 0x85, 0x0a, 0x26, 0xfa, 0x10, 0xc0, 0x27, 0x21,
+	0x81, 0x28, 0x56, 0xac, 0xfa, 0x24, 0xe8, 0x8e, 0x82, 0x80, 0x42, 0x22, 0x08, 0x98, 0xb9, 0xbe,
+	0xe2, 0xec, 0xf5, 0x55, 0xff, 0xa2, 0x00, 0xe8, 0x2c, 0x2a, 0x6a, 0x05, 0xaf, 0xae, 0x9e, 0xc2,
+	0xd6, 0xd8, 0x9b, 0xb8, 0xec, 0xf9, 0x7b, 0xcc, 0x33, 0x52, 0x39, 0xcb, 0x3b, 0xa2, 0x2f, 0xee,
+	0x34, 0x29, 0xfa, 0x36, 0xec, 0xca, 0x21, 0xd3, 0x65, 0x55, 0x2a, 0x67, 0xaf, 0x08, 0x8a, 0xa9,
+	0x80, 0x86, 0xb2, 0x0b, 0x01, 0x7c, 0x33, 0xb6, 0xd8, 0x83, 0xfb, 0xa9, 0x48, 0xaa, 0xc0, 0x4b,
+	0xc0, 0xdb, 0xb2, 0xff, 0x24, 0x75, 0xa3, 0xc6, 0x2e, 0x94, 0x04, 0x5f, 0xf9, 0x88, 0x42, 0x14,
+	0x4d, 0xc4, 0x9f, 0x6e, 0x84, 0x73, 0x8d, 0x27, 0x0b, 0xfc, 0x7d, 0xcf, 0xc0, 0x9e, 0xd0, 0x11,
+	0x4f, 0x53, 0xb2, 0x7f, 0xdf, 0x23, 0xb1, 0x59, 0x3a, 0x78, 0x4d, 0xbc, 0x97, 0xf2, 0x06, 0x93,
+	0xff, 0x66, 0x82, 0x6d, 0x10, 0x67, 0x6c, 0x63, 0x1f, 0x73, 0xeb, 0xb9, 0xa2, 0x86, 0xdf, 0xd5,
+	0xd7, 0xa0, 0x74, 0xac, 0x53, 0x8b, 0x76, 0x88, 0xe5, 0xfa, 0x34, 0x2a, 0xb1, 0x09, 0x57, 0x25,
+	0x3e, 0xde, 0x7c, 0x8f, 0x55, 0xf1, 0x92, 0x97, 0x5a, 0xaf, 0xc3, 0x41, 0xa7, 0x76, 0xd1, 0x6d,
+	0x36, 0xb4, 0xee, 0x8b, 0x9a, 0xda, 0xd4, 0xce, 0xda, 0x8d, 0xa6, 0x56, 0x6f, 0x9f, 0x9d, 0x5d,
+	0x9c, 0xb7, 0x7a, 0x97, 0x5a, 0xa7, 0xdd, 0x3e, 0xad, 0x2c, 0xa0, 0x07, 0xa0, 0x4c, 0x53, 0x1d,
+	0x5f, 0x9c, 0x9c, 0x34, 0xd5, 0x4a, 0x6e, 0xb7, 0xf8, 0xfe, 0xdf, 0xed, 0x2d, 0xbc, 0xd9, 0x83,
+	0x4a, 0xfa, 0x0e, 0x0a, 0xed, 0xc1, 0x6e, 0xf7, 0xa2, 0xd3, 0x39, 0xbd, 0xd4, 0xba, 0xed, 0x0b,
+	0xb5, 0x2e, 0x07, 0xaa, 0xcd, 0xce, 0x69, 0xad, 0xde, 0xac, 0x2c, 0xa0, 0x5d, 0xd8, 0x9e, 0xd1,
+	0x7f, 0x56, 0x7b, 0x37, 0x44, 0xa5, 0xa0, 0xcc, 0xab, 0x5a, 0xa3, 0x37, 0xe1, 0x71, 0xeb, 0xfc,
+	0xe4, 0xb4, 0xd6, 0x6b, 0xb5, 0xcf, 0xb5, 0x7a, 0xed, 0xb4, 0x7e, 0x21, 0x7f, 0x73, 0x94, 0xe7,
+	0xed, 0xda, 0xa9, 0x76, 0xdc, 0x3e, 0x6f, 0x34, 0x1b, 0x95, 0x05, 0xf4, 0x06, 0x3c, 0xfa, 0x04,
+	0xda, 0xd3, 0xd6, 0x79, 0xb3, 0x16, 0x2d, 0x65, 0x08, 0xdb, 0xb3, 0xaf, 0xa5, 0xd0, 0x23, 0x78,
+	0x18, 0x6d, 0xce, 0xc9, 0xc5, 0x79, 0xa3, 0x75, 0xfe, 0x3c, 0x9c, 0x7b, 0xeb, 0xbc, 0x57, 0x59,
+	0x60, 0x3b, 0x3a, 0x97, 0xa4, 0xdb, 0xab, 0x7d, 0xbf, 0x75, 0xfe, 0x3c, 0x64, 0xf4, 0x1e, 0x6c,
+	0x24, 0x6f, 0x0b, 0x51, 0x15, 0xf6, 0x1a, 0x17, 0xdd, 0x9e, 0x56, 0xeb, 0x76, 0x5b, 0xcf, 0xcf,
+	0xcf, 0x9a, 0xe7, 0x3d, 0x36, 0xc3, 0x8b, 0xd3, 0xa6, 0x56, 0xab, 0xd7, 0xdb, 0x17, 0x9c, 0xc3,
+	0x3e, 0xdc, 0x4f, 0xd3, 0xa8, 0xed, 0x8b, 0xf3, 0x86, 0xa6, 0xb6, 0x8f, 0x5b, 0xe7, 0x21, 0x38,
+	0x01, 0x88, 0x2a, 0xd5, 0xec, 0x28, 0xf8, 0xa0, 0x4e, 0xfb, 0xb4, 0x55, 0xbf, 0x9c, 0x3e, 0xe2,
+	0x00, 0x54, 0xf6, 0x9f, 0xb4, 0xd4, 0x6e, 0x4f, 0x53, 0x9b, 0xf5, 0x56, 0xa7, 0xd5, 0x3c, 0xef,
+	0x55, 0x72, 0xec, 0xac, 0x12, 0x00, 0x35, 0x55, 0xbd, 0xd4, 0xda, 0x6f, 0x37, 0xd5, 0x4a, 0x5e,
+	0x32, 0xbc, 0x80, 0x72, 0xea, 0xa6, 0x06, 0x3d, 0x84, 0x9d, 0xee, 0x8b, 0xb6, 0xda, 0x3b, 0xa9,
+	0x9d, 0x9e, 0x06, 0x23, 0x3b, 0x6a, 0x5b, 0x53, 0x6b, 0xbd, 0x5a, 0x65, 0x61, 0x4e, 0x77, 0xab,
+	0xad, 0xb6, 0x7a, 0x97, 0xe1, 0x3a, 0xbe, 0x03, 0x10, 0xbd, 0x9f, 0x42, 0x5b, 0x50, 0xe9, 0xd4,
+	0x2e, 0xdb, 0x17, 0x3d, 0x71, 0x72, 0x9d, 0x8b, 0xee, 0x8b, 0xca, 0xc2, 0x74, 0xeb, 0xe9, 0x69,
+	0x38, 0xbe, 0x05, 0x10, 0x3d, 0x81, 0x42, 0x77, 0x61, 0xf3, 0x9d, 0x66, 0xeb, 0xf9, 0x0b, 0x49,
+	0x79, 0xd2, 0x7a, 0x97, 0xcb, 0xc7, 0x1e, 0xec, 0xc6, 0x9b, 0xd9, 0x41, 0x35, 0x35, 0xd1, 0xd2,
+	0x6c, 0x04, 0x50, 0xc7, 0xdf, 0xfd, 0xe9, 0x47, 0x7b, 0xb9, 0x9f, 0x7d, 0xb4, 0x97, 0xfb, 0xf7,
+	0x8f, 0xf6, 0x72, 0x1f, 0x7c, 0xbc, 0xb7, 0xf0, 0xb3, 0x8f, 0xf7, 0x16, 0xfe, 0xed, 0xe3, 0xbd,
+	0x85, 0x1f, 0xc4, 0xdd, 0x96, 0x35, 0x74, 0x2d, 0x1f, 0x1f, 0x05, 0x7f, 0xdb, 0x77, 0x23, 0xfe,
+	0xba, 0x8f, 0xab, 0x79, 0x7f, 0x89, 0x87, 0x60, 0xbf, 0xff, 0xbf, 0x03, 0x00, 0x83, 0x7b, 0x81,
+	0x8c, 0xfa, 0x37, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksPerYearTransition != nil {
+		{
+			size, err := m.BlocksPerYearTransition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.AutoPaused {
+		i--
+		if m.AutoPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ConsecutiveDistributionFailures != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ConsecutiveDistributionFailures))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.CumulativeFunded.Size()
+		i -= size
+		if _, err := m.CumulativeFunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.DenomMinters) > 0 {
+		for iNdEx := len(m.DenomMinters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMinters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.StrategicReserveReleased) > 0 {
+		for iNdEx := len(m.StrategicReserveReleased) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StrategicReserveReleased[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.StrategicReserve) > 0 {
+		for iNdEx := len(m.StrategicReserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StrategicReserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CumulativeCommunityPoolFunding) > 0 {
+		for iNdEx := len(m.CumulativeCommunityPoolFunding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeCommunityPoolFunding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingPayouts) > 0 {
+		for iNdEx := len(m.PendingPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TargetCumulativeEmission.Size()
+		i -= size
+		if _, err := m.TargetCumulativeEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.BufferedDust) > 0 {
+		for iNdEx := len(m.BufferedDust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BufferedDust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.CommunityFunding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.GoalBondedTransition != nil {
+		{
+			size, err := m.GoalBondedTransition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.CumulativeDistributed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CumulativeMinted.Size()
+		i -= size
+		if _, err := m.CumulativeMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.LastBlockInputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.PausedShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CarryBuffer.Size()
+		i -= size
+		if _, err := m.CarryBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolFundingTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolFundingTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolFundingTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LedgerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LedgerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LedgerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.YearStartCommunityPool.Size()
+		i -= size
+		if _, err := m.YearStartCommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BudgetYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BudgetYear))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GoalBondedTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GoalBondedTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GoalBondedTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.To.Size()
+		i -= size
+		if _, err := m.To.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.From.Size()
+		i -= size
+		if _, err := m.From.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlocksPerYearTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlocksPerYearTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlocksPerYearTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.To != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CategoryTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CategoryTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StrategicReserve.Size()
+		i -= size
+		if _, err := m.StrategicReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Dust.Size()
+		i -= size
+		if _, err := m.Dust.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FundedAddresses.Size()
+		i -= size
+		if _, err := m.FundedAddresses.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Summary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeMinted.Size()
+		i -= size
+		if _, err := m.CumulativeMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockInputs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockInputs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockInputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupplySource) > 0 {
+		i -= len(m.SupplySource)
+		copy(dAtA[i:], m.SupplySource)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.SupplySource)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.StakingSupply.Size()
+		i -= size
+		if _, err := m.StakingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FundedAddresses) > 0 {
+		for iNdEx := len(m.FundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Staking) > 0 {
+		for iNdEx := len(m.Staking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Staking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.WeightMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PayoutMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PayoutMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StrategicReserve.Size()
+		i -= size
+		if _, err := m.StrategicReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FundedAddresses.Size()
+		i -= size
+		if _, err := m.FundedAddresses.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinCommunityPoolShare.Size()
+		i -= size
+		if _, err := m.MinCommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xb2
+	if m.MintingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintingInterval))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaintenanceInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MaintenanceInterval))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DustPolicy != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.DustPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AutoPauseThreshold != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.AutoPauseThreshold))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.MinBondedRatio.Size()
+		i -= size
+		if _, err := m.MinBondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.BootstrapOverride.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	if m.InflationCalculationMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationCalculationMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.MintConfigs) > 0 {
+		for iNdEx := len(m.MintConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.InflationSnapshotRetention != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSnapshotRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.InflationSnapshotInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSnapshotInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.ShortfallPriority) > 0 {
+		for iNdEx := len(m.ShortfallPriority) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ShortfallPriority[iNdEx])
+			copy(dAtA[i:], m.ShortfallPriority[iNdEx])
+			i = encodeVarintMint(dAtA, i, uint64(len(m.ShortfallPriority[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.ShortfallPolicy != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ShortfallPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.StakingRewardsRecipient) > 0 {
+		i -= len(m.StakingRewardsRecipient)
+		copy(dAtA[i:], m.StakingRewardsRecipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.StakingRewardsRecipient)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	{
+		size := m.LargeChangeThreshold.Size()
+		i -= size
+		if _, err := m.LargeChangeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size, err := m.DriftCorrection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.CommunityFundingWindow != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.CommunityFundingWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.CommunityFundingPriority) > 0 {
+		dAtA10 := make([]byte, len(m.CommunityFundingPriority)*10)
+		var j9 int
+		for _, num := range m.CommunityFundingPriority {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintMint(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	{
+		size, err := m.MinAnnualCommunityFunding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.SupplySourceMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.SupplySourceMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.EmitMintPlanned {
+		i--
+		if m.EmitMintPlanned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DustAssignment != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.DustAssignment))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PausedShareMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PausedShareMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PauseCommunityShare {
+		i--
+		if m.PauseCommunityShare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PauseFundedShare {
+		i--
+		if m.PauseFundedShare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PauseStakingShare {
+		i--
+		if m.PauseStakingShare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PauseMinting {
+		i--
+		if m.PauseMinting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MinDistributableProvision.Size()
+		i -= size
+		if _, err := m.MinDistributableProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.FundedAddresses) > 0 {
+		for iNdEx := len(m.FundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounds) > 0 {
+		i -= len(m.Bounds)
+		copy(dAtA[i:], m.Bounds)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Bounds)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Default) > 0 {
+		i -= len(m.Default)
+		copy(dAtA[i:], m.Default)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Default)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DriftCorrection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DriftCorrection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DriftCorrection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Horizon != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxFactor.Size()
+		i -= size
+		if _, err := m.MaxFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BootstrapOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BootstrapOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BootstrapOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeMinted.Size()
+		i -= size
+		if _, err := m.CumulativeMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CarryBuffer.Size()
+		i -= size
+		if _, err := m.CarryBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Phase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Phase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Phase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundedAddressWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundedAddressWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundedAddressWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.NewWeight.Size()
+		i -= size
+		if _, err := m.NewWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OldWeight.Size()
+		i -= size
+		if _, err := m.OldWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundedAddresses) > 0 {
+		for iNdEx := len(m.FundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintMint(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Distributed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundedAddressDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundedAddressDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundedAddressDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundedAddressIncome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundedAddressIncome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundedAddressIncome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FirstHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.FirstHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distributed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintMint(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FirstTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintMint(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	if m.RecordedBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.RecordedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Categories.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomConsistency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConsistency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConsistency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mismatch {
+		i--
+		if m.Mismatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.BankSupply.Size()
+		i -= size
+		if _, err := m.BankSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.StakingSupply.Size()
+		i -= size
+		if _, err := m.StakingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BehaviorRevision) > 0 {
+		i -= len(m.BehaviorRevision)
+		copy(dAtA[i:], m.BehaviorRevision)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.BehaviorRevision)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConsensusVersion != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ConsensusVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BehaviorRevision) > 0 {
+		i -= len(m.BehaviorRevision)
+		copy(dAtA[i:], m.BehaviorRevision)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.BehaviorRevision)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToVersion != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Work != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Work))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Flushed) > 0 {
+		for iNdEx := len(m.Flushed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flushed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PrunedWeightChanges != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PrunedWeightChanges))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExpiredFundedAddresses) > 0 {
+		for iNdEx := len(m.ExpiredFundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpiredFundedAddresses[iNdEx])
+			copy(dAtA[i:], m.ExpiredFundedAddresses[iNdEx])
+			i = encodeVarintMint(dAtA, i, uint64(len(m.ExpiredFundedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PrunedInflationSnapshots != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PrunedInflationSnapshots))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PrunedDistributions != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PrunedDistributions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BasisPoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasisPoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasisPoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CarryBuffer.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.PausedShares.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.LastBlockInputs.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CumulativeMinted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CumulativeDistributed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.GoalBondedTransition != nil {
+		l = m.GoalBondedTransition.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.CommunityFunding.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.BufferedDust) > 0 {
+		for _, e := range m.BufferedDust {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.TargetCumulativeEmission.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.PendingPayouts) > 0 {
+		for _, e := range m.PendingPayouts {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.CumulativeCommunityPoolFunding) > 0 {
+		for _, e := range m.CumulativeCommunityPoolFunding {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.StrategicReserve) > 0 {
+		for _, e := range m.StrategicReserve {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.StrategicReserveReleased) > 0 {
+		for _, e := range m.StrategicReserveReleased {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.DenomMinters) > 0 {
+		for _, e := range m.DenomMinters {
+			l = e.Size()
+			n += 2 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.CumulativeFunded.Size()
+	n += 2 + l + sovMint(uint64(l))
+	if m.ConsecutiveDistributionFailures != 0 {
+		n += 2 + sovMint(uint64(m.ConsecutiveDistributionFailures))
+	}
+	if m.AutoPaused {
+		n += 3
+	}
+	if m.BlocksPerYearTransition != nil {
+		l = m.BlocksPerYearTransition.Size()
+		n += 2 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *CommunityPoolFundingTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LedgerEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BudgetYear != 0 {
+		n += 1 + sovMint(uint64(m.BudgetYear))
+	}
+	l = m.YearStartCommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *GoalBondedTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.From.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *BlocksPerYearTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovMint(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovMint(uint64(m.To))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *CategoryTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.FundedAddresses.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Dust.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.StrategicReserve.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Summary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CumulativeMinted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *BlockInputs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.StakingSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = len(m.SupplySource)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *PausedShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Staking) > 0 {
+		for _, e := range m.Staking {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.FundedAddresses) > 0 {
+		for _, e := range m.FundedAddresses {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.PayoutMode != 0 {
+		n += 1 + sovMint(uint64(m.PayoutMode))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	if m.WeightMode != 0 {
+		n += 1 + sovMint(uint64(m.WeightMode))
+	}
+	return n
+}
+
+func (m *ParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovMint(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovMint(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.FundedAddresses.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.StrategicReserve.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.FundedAddresses) > 0 {
+		for _, e := range m.FundedAddresses {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.MinDistributableProvision.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.PauseMinting {
+		n += 2
+	}
+	if m.PauseStakingShare {
+		n += 2
+	}
+	if m.PauseFundedShare {
+		n += 2
+	}
+	if m.PauseCommunityShare {
+		n += 2
+	}
+	if m.PausedShareMode != 0 {
+		n += 1 + sovMint(uint64(m.PausedShareMode))
+	}
+	if m.DustAssignment != 0 {
+		n += 1 + sovMint(uint64(m.DustAssignment))
+	}
+	if m.EmitMintPlanned {
+		n += 3
+	}
+	if m.SupplySourceMode != 0 {
+		n += 2 + sovMint(uint64(m.SupplySourceMode))
+	}
+	l = m.MinAnnualCommunityFunding.Size()
+	n += 2 + l + sovMint(uint64(l))
+	if len(m.CommunityFundingPriority) > 0 {
+		l = 0
+		for _, e := range m.CommunityFundingPriority {
+			l += sovMint(uint64(e))
+		}
+		n += 2 + sovMint(uint64(l)) + l
+	}
+	if m.CommunityFundingWindow != 0 {
+		n += 2 + sovMint(uint64(m.CommunityFundingWindow))
+	}
+	l = m.DriftCorrection.Size()
+	n += 2 + l + sovMint(uint64(l))
+	l = m.LargeChangeThreshold.Size()
+	n += 2 + l + sovMint(uint64(l))
+	l = len(m.StakingRewardsRecipient)
+	if l > 0 {
+		n += 2 + l + sovMint(uint64(l))
+	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 2 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 2 + l + sovMint(uint64(l))
+	if m.ShortfallPolicy != 0 {
+		n += 2 + sovMint(uint64(m.ShortfallPolicy))
+	}
+	if len(m.ShortfallPriority) > 0 {
+		for _, s := range m.ShortfallPriority {
+			l = len(s)
+			n += 2 + l + sovMint(uint64(l))
+		}
+	}
+	if m.InflationSnapshotInterval != 0 {
+		n += 2 + sovMint(uint64(m.InflationSnapshotInterval))
+	}
+	if m.InflationSnapshotRetention != 0 {
+		n += 2 + sovMint(uint64(m.InflationSnapshotRetention))
+	}
+	if len(m.MintConfigs) > 0 {
+		for _, e := range m.MintConfigs {
+			l = e.Size()
+			n += 2 + l + sovMint(uint64(l))
+		}
+	}
+	if m.InflationCalculationMode != 0 {
+		n += 2 + sovMint(uint64(m.InflationCalculationMode))
+	}
+	l = m.BootstrapOverride.Size()
+	n += 2 + l + sovMint(uint64(l))
+	l = m.MinBondedRatio.Size()
+	n += 2 + l + sovMint(uint64(l))
+	if m.AutoPauseThreshold != 0 {
+		n += 2 + sovMint(uint64(m.AutoPauseThreshold))
+	}
+	if m.DustPolicy != 0 {
+		n += 2 + sovMint(uint64(m.DustPolicy))
+	}
+	if m.MaintenanceInterval != 0 {
+		n += 2 + sovMint(uint64(m.MaintenanceInterval))
+	}
+	if m.MintingInterval != 0 {
+		n += 2 + sovMint(uint64(m.MintingInterval))
+	}
+	l = m.MinCommunityPoolShare.Size()
+	n += 2 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ParamDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Default)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Bounds)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *DriftCorrection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Horizon != 0 {
+		n += 1 + sovMint(uint64(m.Horizon))
+	}
+	return n
+}
+
+func (m *BootstrapOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MintConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DenomMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CarryBuffer.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CumulativeMinted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Phase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *FundedAddressWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.OldWeight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.NewWeight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Distributed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
 	n += 1 + l + sovMint(uint64(l))
 	if len(m.FundedAddresses) > 0 {
 		for _, e := range m.FundedAddresses {
@@ -604,16 +6171,6350 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovMint(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *InflationSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *FundedAddressDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *FundedAddressIncome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.FirstHeight != 0 {
+		n += 1 + sovMint(uint64(m.FirstHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovMint(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *EmissionReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovMint(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovMint(uint64(m.ToHeight))
+	}
+	if m.RecordedBlocks != 0 {
+		n += 1 + sovMint(uint64(m.RecordedBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Distributed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *EmissionProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Categories.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DenomConsistency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.StakingSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.BankSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Mismatch {
+		n += 2
+	}
+	return n
+}
+
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusVersion != 0 {
+		n += 1 + sovMint(uint64(m.ConsensusVersion))
+	}
+	l = len(m.BehaviorRevision)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MigrationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromVersion != 0 {
+		n += 1 + sovMint(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovMint(uint64(m.ToVersion))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = len(m.BehaviorRevision)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrunedDistributions != 0 {
+		n += 1 + sovMint(uint64(m.PrunedDistributions))
+	}
+	if m.PrunedInflationSnapshots != 0 {
+		n += 1 + sovMint(uint64(m.PrunedInflationSnapshots))
+	}
+	if len(m.ExpiredFundedAddresses) > 0 {
+		for _, s := range m.ExpiredFundedAddresses {
+			l = len(s)
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.PrunedWeightChanges != 0 {
+		n += 1 + sovMint(uint64(m.PrunedWeightChanges))
+	}
+	if len(m.Flushed) > 0 {
+		for _, e := range m.Flushed {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.Work != 0 {
+		n += 1 + sovMint(uint64(m.Work))
+	}
+	if m.Complete {
+		n += 2
+	}
+	return n
+}
+
+func (m *BasisPoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovMint(uint64(m.Value))
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarryBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PausedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockInputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastBlockInputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeDistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBondedTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoalBondedTransition == nil {
+				m.GoalBondedTransition = &GoalBondedTransition{}
+			}
+			if err := m.GoalBondedTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityFunding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedDust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferedDust = append(m.BufferedDust, types.Coin{})
+			if err := m.BufferedDust[len(m.BufferedDust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCumulativeEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetCumulativeEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPayouts = append(m.PendingPayouts, PendingPayout{})
+			if err := m.PendingPayouts[len(m.PendingPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeCommunityPoolFunding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeCommunityPoolFunding = append(m.CumulativeCommunityPoolFunding, CommunityPoolFundingTotal{})
+			if err := m.CumulativeCommunityPoolFunding[len(m.CumulativeCommunityPoolFunding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategicReserve = append(m.StrategicReserve, types.Coin{})
+			if err := m.StrategicReserve[len(m.StrategicReserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserveReleased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategicReserveReleased = append(m.StrategicReserveReleased, types.Coin{})
+			if err := m.StrategicReserveReleased[len(m.StrategicReserveReleased)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMinters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMinters = append(m.DenomMinters, DenomMinter{})
+			if err := m.DenomMinters[len(m.DenomMinters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveDistributionFailures", wireType)
+			}
+			m.ConsecutiveDistributionFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveDistributionFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoPaused = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYearTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlocksPerYearTransition == nil {
+				m.BlocksPerYearTransition = &BlocksPerYearTransition{}
+			}
+			if err := m.BlocksPerYearTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolFundingTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolFundingTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolFundingTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LedgerEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LedgerEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityFunding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityFunding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityFunding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetYear", wireType)
+			}
+			m.BudgetYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BudgetYear |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearStartCommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YearStartCommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GoalBondedTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GoalBondedTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GoalBondedTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocksPerYearTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocksPerYearTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocksPerYearTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CategoryTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CategoryTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CategoryTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundedAddresses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrategicReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Summary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Summary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Summary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockInputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockInputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockInputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplySource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staking = append(m.Staking, types.Coin{})
+			if err := m.Staking[len(m.Staking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundedAddresses = append(m.FundedAddresses, types.Coin{})
+			if err := m.FundedAddresses[len(m.FundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutMode", wireType)
+			}
+			m.PayoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutMode |= PayoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightMode", wireType)
+			}
+			m.WeightMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightMode |= WeightMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundedAddresses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrategicReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundedAddresses = append(m.FundedAddresses, WeightedAddress{})
+			if err := m.FundedAddresses[len(m.FundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDistributableProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDistributableProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseMinting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseMinting = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStakingShare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseStakingShare = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseFundedShare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseFundedShare = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseCommunityShare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseCommunityShare = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedShareMode", wireType)
+			}
+			m.PausedShareMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedShareMode |= PausedShareMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustAssignment", wireType)
+			}
+			m.DustAssignment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustAssignment |= DustAssignment(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitMintPlanned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitMintPlanned = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySourceMode", wireType)
+			}
+			m.SupplySourceMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplySourceMode |= SupplySourceMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAnnualCommunityFunding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAnnualCommunityFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType == 0 {
+				var v CommunityFundingSource
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMint
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CommunityFundingSource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CommunityFundingPriority = append(m.CommunityFundingPriority, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMint
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMint
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMint
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.CommunityFundingPriority) == 0 {
+					m.CommunityFundingPriority = make([]CommunityFundingSource, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CommunityFundingSource
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMint
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CommunityFundingSource(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CommunityFundingPriority = append(m.CommunityFundingPriority, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityFundingPriority", wireType)
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityFundingWindow", wireType)
+			}
+			m.CommunityFundingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityFundingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftCorrection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DriftCorrection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeChangeThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LargeChangeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewardsRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingRewardsRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, Phase{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallPolicy", wireType)
+			}
+			m.ShortfallPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortfallPolicy |= ShortfallPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallPriority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortfallPriority = append(m.ShortfallPriority, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSnapshotInterval", wireType)
+			}
+			m.InflationSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSnapshotRetention", wireType)
+			}
+			m.InflationSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSnapshotRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintConfigs = append(m.MintConfigs, MintConfig{})
+			if err := m.MintConfigs[len(m.MintConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationCalculationMode", wireType)
+			}
+			m.InflationCalculationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationCalculationMode |= InflationCalculationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapOverride", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BootstrapOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseThreshold", wireType)
+			}
+			m.AutoPauseThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoPauseThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustPolicy", wireType)
+			}
+			m.DustPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustPolicy |= DustPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceInterval", wireType)
+			}
+			m.MaintenanceInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingInterval", wireType)
+			}
+			m.MintingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Default = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DriftCorrection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DriftCorrection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DriftCorrection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BootstrapOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BootstrapOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BootstrapOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarryBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Phase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Phase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Phase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundedAddressWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundedAddressWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundedAddressWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundedAddresses = append(m.FundedAddresses, FundedAddressDistribution{})
+			if err := m.FundedAddresses[len(m.FundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundedAddressDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundedAddressDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundedAddressDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundedAddressIncome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundedAddressIncome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundedAddressIncome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeight", wireType)
+			}
+			m.FirstHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedBlocks", wireType)
+			}
+			m.RecordedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FirstTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *EmissionProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -636,13 +12537,80 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Categories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
@@ -676,9 +12644,91 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomConsistency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomConsistency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomConsistency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -706,10 +12756,96 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mismatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mismatch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -731,7 +12867,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -754,15 +12890,34 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVersion", wireType)
+			}
+			m.ConsensusVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BehaviorRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -790,13 +12945,13 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.BehaviorRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -806,23 +12961,23 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Migrations = append(m.Migrations, MigrationRecord{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -847,7 +13002,7 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+func (m *MigrationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -870,17 +13025,17 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
 			}
-			var stringLen uint64
+			m.FromVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -890,31 +13045,16 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FromVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
 			}
-			var stringLen uint64
+			m.ToVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -924,29 +13064,33 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ToVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if err := m.FundedAddresses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BehaviorRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -974,9 +13118,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BehaviorRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -999,7 +13141,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,17 +13164,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedDistributions", wireType)
 			}
-			var stringLen uint64
+			m.PrunedDistributions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1042,29 +13184,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrunedDistributions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedInflationSnapshots", wireType)
 			}
-			var stringLen uint64
+			m.PrunedInflationSnapshots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1074,29 +13203,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrunedInflationSnapshots |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredFundedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1124,15 +13238,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpiredFundedAddresses = append(m.ExpiredFundedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedWeightChanges", wireType)
 			}
-			var stringLen uint64
+			m.PrunedWeightChanges = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1142,31 +13254,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrunedWeightChanges |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flushed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1176,31 +13273,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Flushed = append(m.Flushed, types.Coin{})
+			if err := m.Flushed[len(m.Flushed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
 			}
-			m.BlocksPerYear = 0
+			m.Work = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1210,16 +13307,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				m.Work |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1229,30 +13326,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMint
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMint
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasisPoints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasisPoints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasisPoints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1262,26 +13396,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FundedAddresses = append(m.FundedAddresses, WeightedAddress{})
-			if err := m.FundedAddresses[len(m.FundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -0,0 +1,234 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMintConfig returns the mint configuration of a denom minted in addition to the mint denom
+func NewMintConfig(
+	mintDenom string,
+	inflationRateChange,
+	inflationMax,
+	inflationMin,
+	goalBonded sdk.Dec,
+	proportions DistributionProportions,
+) MintConfig {
+	return MintConfig{
+		MintDenom:               mintDenom,
+		InflationRateChange:     inflationRateChange,
+		InflationMax:            inflationMax,
+		InflationMin:            inflationMin,
+		GoalBonded:              goalBonded,
+		DistributionProportions: proportions,
+	}
+}
+
+// PrimaryMintConfig returns the mint configuration of the mint denom, made of the top-level params
+func (p Params) PrimaryMintConfig() MintConfig {
+	return NewMintConfig(
+		p.MintDenom,
+		p.InflationRateChange,
+		p.InflationMax,
+		p.InflationMin,
+		p.GoalBonded,
+		p.DistributionProportions,
+	)
+}
+
+// AllMintConfigs returns the mint configurations of all the minted denoms, the mint configuration
+// of the mint denom first. Params without mint configurations have a single-entry list.
+func (p Params) AllMintConfigs() []MintConfig {
+	return append([]MintConfig{p.PrimaryMintConfig()}, p.MintConfigs...)
+}
+
+// GetMintConfig returns the mint configuration of a denom minted in addition to the mint denom
+func (p Params) GetMintConfig(denom string) (MintConfig, bool) {
+	for _, config := range p.MintConfigs {
+		if config.MintDenom == denom {
+			return config, true
+		}
+	}
+	return MintConfig{}, false
+}
+
+// WithMintConfig returns the params with the mint denom, the inflation schedule and the
+// distribution proportions of the mint configuration, the phases are not applied to the denoms
+// of the mint configurations
+func (p Params) WithMintConfig(config MintConfig) Params {
+	p.MintDenom = config.MintDenom
+	p.InflationRateChange = config.InflationRateChange
+	p.InflationMax = config.InflationMax
+	p.InflationMin = config.InflationMin
+	p.GoalBonded = config.GoalBonded
+	p.DistributionProportions = config.DistributionProportions
+	p.Phases = nil
+	return p
+}
+
+// validateMintConfigFeatures checks the params with mint configurations don't enable a feature that
+// is not applied to the denoms of the mint configurations: the max supply and the min
+// distributable provision are amounts of the mint denom, and the share pauses and the dust policy
+// only apply to the distribution of the mint denom.
+func (p Params) validateMintConfigFeatures() error {
+	if len(p.MintConfigs) == 0 {
+		return nil
+	}
+	for _, feature := range []struct {
+		name    string
+		enabled bool
+	}{
+		{name: "max supply", enabled: !p.MaxSupply.IsNil() && p.MaxSupply.IsPositive()},
+		{name: "min distributable provision", enabled: !p.MinDistributableProvision.IsNil() && p.MinDistributableProvision.IsPositive()},
+		{name: "staking share pause", enabled: p.PauseStakingShare},
+		{name: "funded addresses share pause", enabled: p.PauseFundedShare},
+		{name: "community pool share pause", enabled: p.PauseCommunityShare},
+		{name: "dust policy " + p.DustPolicy.String(), enabled: p.DustPolicy != DUST_POLICY_COMMUNITY_POOL},
+	} {
+		if feature.enabled {
+			return fmt.Errorf("%s is not applied to the denoms of the mint configurations", feature.name)
+		}
+	}
+	return nil
+}
+
+// NormalizeMintConfigs accepts the params listing the mint denom in the mint configurations: if
+// the mint denom is not set, the first mint configuration becomes the mint configuration of the
+// mint denom. The params of the single mint denom layout are unchanged.
+func (p *Params) NormalizeMintConfigs() {
+	if p.MintDenom != "" || len(p.MintConfigs) == 0 {
+		return
+	}
+	primary := p.MintConfigs[0]
+	p.MintDenom = primary.MintDenom
+	p.InflationRateChange = primary.InflationRateChange
+	p.InflationMax = primary.InflationMax
+	p.InflationMin = primary.InflationMin
+	p.GoalBonded = primary.GoalBonded
+	p.DistributionProportions = primary.DistributionProportions
+	p.MintConfigs = p.MintConfigs[1:]
+	if p.MinAnnualCommunityFunding.Denom == "" {
+		p.MinAnnualCommunityFunding = sdk.NewCoin(p.MintDenom, sdkmath.ZeroInt())
+	}
+}
+
+// Validate checks the mint configuration has a valid denom, inflation schedule and distribution
+// proportions
+func (c MintConfig) Validate() error {
+	if err := validateMintDenom(c.MintDenom); err != nil {
+		return err
+	}
+	if err := validateInflationRateChange(c.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateDec(c.InflationMax); err != nil {
+		return err
+	}
+	if err := validateDec(c.InflationMin); err != nil {
+		return err
+	}
+	if c.InflationMax.LT(c.InflationMin) {
+		return fmt.Errorf(
+			"max inflation (%s) must be greater than or equal to min inflation (%s)",
+			c.InflationMax, c.InflationMin,
+		)
+	}
+	if err := ValidateGoalBonded(c.GoalBonded); err != nil {
+		return err
+	}
+	return validateDistributionProportions(c.DistributionProportions)
+}
+
+func validateMintConfigs(i interface{}) error {
+	v, ok := i.([]MintConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool, len(v))
+	for _, config := range v {
+		if err := config.Validate(); err != nil {
+			return fmt.Errorf("mint config %s: %w", config.MintDenom, err)
+		}
+		if denoms[config.MintDenom] {
+			return fmt.Errorf("duplicate mint denom %s", config.MintDenom)
+		}
+		denoms[config.MintDenom] = true
+	}
+
+	return nil
+}
+
+// NewDenomMinter returns the minter of the denom of a mint configuration starting at the min
+// inflation of the configuration
+func NewDenomMinter(config MintConfig) DenomMinter {
+	return DenomMinter{
+		Denom:            config.MintDenom,
+		Inflation:        config.InflationMin,
+		AnnualProvisions: sdk.ZeroDec(),
+		CarryBuffer:      sdk.ZeroDec(),
+		CumulativeMinted: sdkmath.ZeroInt(),
+	}
+}
+
+// GetDenomMinter returns the minter of the denom of a mint configuration, a new minter if the
+// denom has never been minted
+func (m Minter) GetDenomMinter(config MintConfig) DenomMinter {
+	for _, denomMinter := range m.DenomMinters {
+		if denomMinter.Denom == config.MintDenom {
+			return denomMinter
+		}
+	}
+	return NewDenomMinter(config)
+}
+
+// SetDenomMinter sets the minter of a denom, the minters are kept in the order their denom is
+// first minted
+func (m *Minter) SetDenomMinter(denomMinter DenomMinter) {
+	for i := range m.DenomMinters {
+		if m.DenomMinters[i].Denom == denomMinter.Denom {
+			m.DenomMinters[i] = denomMinter
+			return
+		}
+	}
+	m.DenomMinters = append(m.DenomMinters, denomMinter)
+}
+
+// Validate checks the minter of a denom has a valid denom and non-negative values
+func (dm DenomMinter) Validate() error {
+	if err := sdk.ValidateDenom(dm.Denom); err != nil {
+		return err
+	}
+	for _, value := range []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{name: "inflation", value: dm.Inflation},
+		{name: "annual provisions", value: dm.AnnualProvisions},
+		{name: "carry buffer", value: dm.CarryBuffer},
+	} {
+		if value.value.IsNil() || value.value.IsNegative() {
+			return fmt.Errorf("denom minter %s %s should be non-negative, is %s", dm.Denom, value.name, value.value)
+		}
+	}
+	if dm.CumulativeMinted.IsNil() || dm.CumulativeMinted.IsNegative() {
+		return fmt.Errorf("denom minter %s cumulative minted should be non-negative, is %s", dm.Denom, dm.CumulativeMinted)
+	}
+	return nil
+}
+
+func validateDenomMinters(denomMinters []DenomMinter) error {
+	denoms := make(map[string]bool, len(denomMinters))
+	for _, denomMinter := range denomMinters {
+		if err := denomMinter.Validate(); err != nil {
+			return err
+		}
+		if denoms[denomMinter.Denom] {
+			return fmt.Errorf("duplicate denom minter %s", denomMinter.Denom)
+		}
+		denoms[denomMinter.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func fooMintConfig() types.MintConfig {
+	params := types.DefaultParams()
+	config := params.PrimaryMintConfig()
+	config.MintDenom = "foo"
+	return config
+}
+
+func TestParamsMintConfigs(t *testing.T) {
+	t.Run("should list the mint configuration of the mint denom first", func(t *testing.T) {
+		params := types.DefaultParams()
+		require.Equal(t, []types.MintConfig{params.PrimaryMintConfig()}, params.AllMintConfigs())
+
+		params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		require.Equal(t, []types.MintConfig{params.PrimaryMintConfig(), fooMintConfig()}, params.AllMintConfigs())
+		config, found := params.GetMintConfig("foo")
+		require.True(t, found)
+		require.Equal(t, fooMintConfig(), config)
+		_, found = params.GetMintConfig(params.MintDenom)
+		require.False(t, found)
+	})
+
+	t.Run("should reject duplicate denoms", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MintConfigs = []types.MintConfig{fooMintConfig()}
+		require.NoError(t, params.Validate())
+
+		params.MintConfigs = []types.MintConfig{fooMintConfig(), fooMintConfig()}
+		require.ErrorContains(t, params.Validate(), "duplicate mint denom foo")
+
+		params.MintConfigs = []types.MintConfig{params.PrimaryMintConfig()}
+		require.ErrorContains(t, params.Validate(), "duplicate mint denom "+params.MintDenom)
+	})
+
+	t.Run("should reject an invalid mint configuration", func(t *testing.T) {
+		params := types.DefaultParams()
+		config := fooMintConfig()
+		config.InflationMin = config.InflationMax.Add(sdk.OneDec())
+		params.MintConfigs = []types.MintConfig{config}
+		require.Error(t, params.Validate())
+
+		config = fooMintConfig()
+		config.DistributionProportions.Staking = sdk.OneDec()
+		params.MintConfigs = []types.MintConfig{config}
+		require.Error(t, params.Validate())
+	})
+
+	t.Run("should reject the features not applied to the denoms of the mint configurations", func(t *testing.T) {
+		for _, tc := range []struct {
+			feature string
+			enable  func(*types.Params)
+		}{
+			{feature: "max supply", enable: func(p *types.Params) { p.MaxSupply = sdkmath.NewInt(1_000_000) }},
+			{feature: "min distributable provision", enable: func(p *types.Params) { p.MinDistributableProvision = sdkmath.OneInt() }},
+			{feature: "staking share pause", enable: func(p *types.Params) { p.PauseStakingShare = true }},
+			{feature: "funded addresses share pause", enable: func(p *types.Params) { p.PauseFundedShare = true }},
+			{feature: "community pool share pause", enable: func(p *types.Params) { p.PauseCommunityShare = true }},
+			{feature: "dust policy DUST_POLICY_CARRY_OVER", enable: func(p *types.Params) { p.DustPolicy = types.DUST_POLICY_CARRY_OVER }},
+		} {
+			params := types.DefaultParams()
+			tc.enable(&params)
+			require.NoError(t, params.Validate(), tc.feature)
+
+			params.MintConfigs = []types.MintConfig{fooMintConfig()}
+			require.ErrorContains(t, params.Validate(), tc.feature+" is not applied to the denoms of the mint configurations")
+		}
+	})
+
+	t.Run("should promote the first mint configuration without mint denom", func(t *testing.T) {
+		defaults := types.DefaultParams()
+		params := defaults
+		params.MintDenom = ""
+		params.MintConfigs = []types.MintConfig{defaults.PrimaryMintConfig(), fooMintConfig()}
+		params.NormalizeMintConfigs()
+		require.Equal(t, defaults.PrimaryMintConfig(), params.PrimaryMintConfig())
+		require.Equal(t, []types.MintConfig{fooMintConfig()}, params.MintConfigs)
+		require.NoError(t, params.Validate())
+
+		// the params of the single mint denom layout are unchanged
+		params = defaults
+		params.NormalizeMintConfigs()
+		require.Equal(t, defaults, params)
+	})
+}
+
+func TestMinterDenomMinters(t *testing.T) {
+	minter := types.DefaultInitialMinter()
+	denomMinter := minter.GetDenomMinter(fooMintConfig())
+	require.Equal(t, types.NewDenomMinter(fooMintConfig()), denomMinter)
+	require.Empty(t, minter.DenomMinters)
+
+	denomMinter.CumulativeMinted = sdk.NewInt(10)
+	minter.SetDenomMinter(denomMinter)
+	minter.SetDenomMinter(denomMinter)
+	require.Equal(t, []types.DenomMinter{denomMinter}, minter.DenomMinters)
+	require.NoError(t, minter.Validate())
+
+	minter.DenomMinters = append(minter.DenomMinters, denomMinter)
+	require.ErrorContains(t, minter.Validate(), "duplicate denom minter foo")
+
+	denomMinter.CarryBuffer = sdk.NewDec(-1)
+	minter.DenomMinters = []types.DenomMinter{denomMinter}
+	require.Error(t, minter.Validate())
+}
@@ -13,6 +13,16 @@ func NewMinter(inflation, annualProvisions sdk.Dec) Minter {
 	return Minter{
 		Inflation:        inflation,
 		AnnualProvisions: annualProvisions,
+		CarryBuffer:      sdk.ZeroDec(),
+		LastBlockInputs: BlockInputs{
+			BondedRatio:   sdk.ZeroDec(),
+			StakingSupply: sdkmath.ZeroInt(),
+		},
+		CumulativeMinted:         sdkmath.ZeroInt(),
+		CumulativeDistributed:    NewCategoryTotals(),
+		CommunityFunding:         NewCommunityFunding(0, sdkmath.ZeroInt()),
+		TargetCumulativeEmission: sdk.ZeroDec(),
+		CumulativeFunded:         sdkmath.ZeroInt(),
 	}
 }
 
@@ -38,6 +48,189 @@ func (m Minter) Validate() error {
 		return fmt.Errorf("mint parameter Inflation should be positive, is %s",
 			m.Inflation.String())
 	}
+	if !m.CarryBuffer.IsNil() && m.CarryBuffer.IsNegative() {
+		return fmt.Errorf("mint carry buffer should not be negative, is %s",
+			m.CarryBuffer.String())
+	}
+	if !m.CumulativeMinted.IsNil() && m.CumulativeMinted.IsNegative() {
+		return fmt.Errorf("mint cumulative minted should not be negative, is %s",
+			m.CumulativeMinted.String())
+	}
+	if !m.CumulativeFunded.IsNil() && m.CumulativeFunded.IsNegative() {
+		return fmt.Errorf("mint cumulative funded should not be negative, is %s",
+			m.CumulativeFunded.String())
+	}
+	if !m.TargetCumulativeEmission.IsNil() && m.TargetCumulativeEmission.IsNegative() {
+		return fmt.Errorf("mint target cumulative emission should not be negative, is %s",
+			m.TargetCumulativeEmission.String())
+	}
+	if err := m.CumulativeDistributed.Validate(); err != nil {
+		return err
+	}
+	if err := m.LastBlockInputs.Validate(); err != nil {
+		return err
+	}
+	if err := m.BufferedDust.Validate(); err != nil {
+		return fmt.Errorf("invalid buffered dust: %w", err)
+	}
+	if err := validatePendingPayouts(m.PendingPayouts); err != nil {
+		return err
+	}
+	if err := m.StrategicReserve.Validate(); err != nil {
+		return fmt.Errorf("invalid strategic reserve: %w", err)
+	}
+	if err := m.StrategicReserveReleased.Validate(); err != nil {
+		return fmt.Errorf("invalid strategic reserve released: %w", err)
+	}
+	if err := validateDenomMinters(m.DenomMinters); err != nil {
+		return err
+	}
+	if err := validateCommunityPoolFunding(m.CumulativeCommunityPoolFunding); err != nil {
+		return err
+	}
+	if cf := m.CommunityFunding; cf.BudgetYear < 0 ||
+		(!cf.YearStartCommunityPool.IsNil() && cf.YearStartCommunityPool.IsNegative()) {
+		return fmt.Errorf("mint community funding should not be negative, is year %d from %s",
+			cf.BudgetYear, cf.YearStartCommunityPool)
+	}
+	if m.GoalBondedTransition != nil {
+		if err := m.GoalBondedTransition.Validate(); err != nil {
+			return err
+		}
+	}
+	if m.BlocksPerYearTransition != nil {
+		if err := m.BlocksPerYearTransition.Validate(); err != nil {
+			return err
+		}
+	}
+	return m.PausedShares.Validate()
+}
+
+// EffectiveGoalBonded returns the goal bonded ratio used to compute the inflation rate at the
+// height. The goal of a pending transition is interpolated from its start to its end, the
+// transition is ignored if the goal bonded param has been changed since it started.
+func (m Minter) EffectiveGoalBonded(params Params, height int64) sdk.Dec {
+	transition := m.GoalBondedTransition
+	if transition == nil || !transition.To.Equal(params.GoalBonded) {
+		return params.GoalBonded
+	}
+	return transition.GoalBondedAt(height)
+}
+
+// ProvisionParams returns the params used to compute the block provision at the height, the
+// blocks per year of a pending transition are interpolated from its start to its end. The
+// transition is ignored if the blocks per year param has been changed since it started.
+func (m Minter) ProvisionParams(params Params, height int64) Params {
+	if transition := m.BlocksPerYearTransition; transition != nil && transition.To == params.BlocksPerYear {
+		params.BlocksPerYear = transition.BlocksPerYearAt(height)
+	}
+	return params
+}
+
+// SettleBlocksPerYearTransition removes the transition of the blocks per year once completed at
+// the height or once the blocks per year param has been changed
+func (m *Minter) SettleBlocksPerYearTransition(params Params, height int64) {
+	if transition := m.BlocksPerYearTransition; transition != nil &&
+		(height >= transition.EndHeight || transition.To != params.BlocksPerYear) {
+		m.BlocksPerYearTransition = nil
+	}
+}
+
+// ExpectedCumulativeMinted returns the cumulative minted amount derived from the totals
+// distributed to each category and the buffered paused shares, less the funded amount
+// distributed with the minted coins
+func (m Minter) ExpectedCumulativeMinted() sdkmath.Int {
+	expected := m.CumulativeDistributed.Total().Add(TotalAmount(m.PausedShares.Total()))
+	if m.CumulativeFunded.IsNil() {
+		return expected
+	}
+	return expected.Sub(m.CumulativeFunded)
+}
+
+// GoalBondedAt returns the goal bonded ratio of the transition at the height
+func (t GoalBondedTransition) GoalBondedAt(height int64) sdk.Dec {
+	switch {
+	case height <= t.StartHeight:
+		return t.From
+	case height >= t.EndHeight:
+		return t.To
+	}
+	progress := sdk.NewDec(height - t.StartHeight).QuoInt64(t.EndHeight - t.StartHeight)
+	return t.From.Add(t.To.Sub(t.From).Mul(progress))
+}
+
+// NewBlocksPerYearTransition returns the transition of the blocks per year used to compute the
+// block provision from the blocks per year before a change to the blocks per year after the
+// change, starting at the height. The transition lasts a day of blocks at the new blocks per year.
+func NewBlocksPerYearTransition(from, to uint64, height int64) *BlocksPerYearTransition {
+	blocks := to / 365
+	if blocks == 0 {
+		blocks = 1
+	}
+	return &BlocksPerYearTransition{
+		From:        from,
+		To:          to,
+		StartHeight: height,
+		EndHeight:   height + int64(blocks),
+	}
+}
+
+// BlocksPerYearAt returns the blocks per year of the transition at the height. The inverse of the
+// blocks per year is interpolated so the block provision moves linearly along the transition.
+func (t BlocksPerYearTransition) BlocksPerYearAt(height int64) uint64 {
+	switch {
+	case height <= t.StartHeight:
+		return t.From
+	case height >= t.EndHeight:
+		return t.To
+	}
+	// 1/bpy = 1/from + (1/to - 1/from) * progress / length
+	from, to := sdkmath.NewIntFromUint64(t.From), sdkmath.NewIntFromUint64(t.To)
+	progress, length := height-t.StartHeight, t.EndHeight-t.StartHeight
+	denominator := to.MulRaw(length).Add(from.Sub(to).MulRaw(progress))
+	return from.Mul(to).MulRaw(length).Quo(denominator).Uint64()
+}
+
+// Validate checks the blocks per year of the transition are positive and its end follows its start
+func (t BlocksPerYearTransition) Validate() error {
+	if t.From == 0 || t.To == 0 {
+		return fmt.Errorf("blocks per year transition from %d to %d should be positive", t.From, t.To)
+	}
+	if t.EndHeight <= t.StartHeight {
+		return fmt.Errorf("blocks per year transition end height %d should be after start height %d",
+			t.EndHeight, t.StartHeight)
+	}
+	return nil
+}
+
+// Validate checks the goals of the transition are valid and its end follows its start
+func (t GoalBondedTransition) Validate() error {
+	if err := ValidateGoalBonded(t.From); err != nil {
+		return err
+	}
+	if err := ValidateGoalBonded(t.To); err != nil {
+		return err
+	}
+	if t.EndHeight <= t.StartHeight {
+		return fmt.Errorf("goal bonded transition end height %d should be after start height %d",
+			t.EndHeight, t.StartHeight)
+	}
+	return nil
+}
+
+// Validate checks the block inputs are not negative
+func (bi BlockInputs) Validate() error {
+	if bi.Height < 0 {
+		return fmt.Errorf("block inputs height should not be negative, is %d", bi.Height)
+	}
+	if !bi.BondedRatio.IsNil() && bi.BondedRatio.IsNegative() {
+		return fmt.Errorf("block inputs bonded ratio should not be negative, is %s",
+			bi.BondedRatio.String())
+	}
+	if !bi.StakingSupply.IsNil() && bi.StakingSupply.IsNegative() {
+		return fmt.Errorf("block inputs staking supply should not be negative, is %s",
+			bi.StakingSupply.String())
+	}
 	return nil
 }
 
@@ -49,14 +242,7 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 	// defined to be 13% per year, however the annual inflation is capped as between
 	// 7% and 20%.
 
-	// (1 - bondedRatio/GoalBonded) * InflationRateChange
-	inflationRateChangePerYear := sdk.OneDec().
-		Sub(bondedRatio.Quo(params.GoalBonded)).
-		Mul(params.InflationRateChange)
-	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
-
-	// adjust the new annual inflation for this next cycle
-	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
+	inflation := m.unboundedNextInflationRate(params, bondedRatio)
 	if inflation.GT(params.InflationMax) {
 		inflation = params.InflationMax
 	}
@@ -67,15 +253,68 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 	return inflation
 }
 
+// unboundedNextInflationRate returns the new inflation rate for the next block before the
+// inflation bounds are applied, depending on the inflation calculation mode of the params.
+func (m Minter) unboundedNextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
+	var inflationRateChangePerYear sdk.Dec
+	switch params.InflationCalculationMode {
+	case INFLATION_CALCULATION_MODE_LINEAR:
+		// -InflationRateChange, the bonded ratio is ignored
+		inflationRateChangePerYear = params.InflationRateChange.Neg()
+	default:
+		// (1 - bondedRatio/GoalBonded) * InflationRateChange
+		inflationRateChangePerYear = sdk.OneDec().
+			Sub(bondedRatio.Quo(params.GoalBonded)).
+			Mul(params.InflationRateChange)
+	}
+	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
+
+	// adjust the new annual inflation for this next cycle
+	return m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
+}
+
 // NextAnnualProvisions returns the annual provisions based on current total
 // supply and inflation rate.
 func (m Minter) NextAnnualProvisions(_ Params, totalSupply sdkmath.Int) sdk.Dec {
 	return m.Inflation.MulInt(totalSupply)
 }
 
+// ExactBlockProvision returns the untruncated provisions for a block based on
+// the annual provisions rate.
+func (m Minter) ExactBlockProvision(params Params) sdk.Dec {
+	return m.AnnualProvisions.QuoInt(sdkmath.NewInt(int64(params.BlocksPerYear)))
+}
+
 // BlockProvision returns the provisions for a block based on the annual
 // provisions rate.
 func (m Minter) BlockProvision(params Params) sdk.Coin {
-	provisionAmt := m.AnnualProvisions.QuoInt(sdkmath.NewInt(int64(params.BlocksPerYear)))
-	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+	return sdk.NewCoin(params.MintDenom, m.ExactBlockProvision(params).TruncateInt())
+}
+
+// BufferedBlockProvision returns the provision to mint for a block when a
+// minimum distributable provision is set, along with the updated carry buffer.
+// The exact block provision is added to the carry buffer, if the integral part
+// of the buffer reaches the minimum it is minted and only the fractional
+// remainder is carried over, otherwise nothing is minted and the whole buffer is
+// carried over to the next block.
+func (m Minter) BufferedBlockProvision(params Params) (sdk.Coin, sdk.Dec) {
+	return m.BufferedProvision(params, m.ExactBlockProvision(params))
+}
+
+// BufferedProvision returns the provision to mint and the updated carry buffer
+// when the exact provision is added to the carry buffer, following the rules of
+// BufferedBlockProvision.
+func (m Minter) BufferedProvision(params Params, provision sdk.Dec) (sdk.Coin, sdk.Dec) {
+	carry := m.CarryBuffer
+	if carry.IsNil() {
+		carry = sdk.ZeroDec()
+	}
+	carry = carry.Add(provision)
+
+	provisionAmt := carry.TruncateInt()
+	if provisionAmt.IsZero() || (!params.MinDistributableProvision.IsNil() && provisionAmt.LT(params.MinDistributableProvision)) {
+		return sdk.NewCoin(params.MintDenom, sdkmath.ZeroInt()), carry
+	}
+
+	return sdk.NewCoin(params.MintDenom, provisionAmt), carry.Sub(sdk.NewDecFromInt(provisionAmt))
 }
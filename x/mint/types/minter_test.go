@@ -14,6 +14,19 @@ import (
 func TestValidateMinter(t *testing.T) {
 	invalid := types.DefaultInitialMinter()
 	invalid.Inflation = sdk.NewDec(-1)
+	negativeCarry := types.DefaultInitialMinter()
+	negativeCarry.CarryBuffer = sdk.NewDec(-1)
+	negativeBondedRatio := types.DefaultInitialMinter()
+	negativeBondedRatio.LastBlockInputs.BondedRatio = sdk.NewDec(-1)
+	invalidPausedShares := types.DefaultInitialMinter()
+	invalidPausedShares.PausedShares.Staking = sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdkmath.NewInt(-1)}}
+	invalidTransition := types.DefaultInitialMinter()
+	invalidTransition.GoalBondedTransition = &types.GoalBondedTransition{
+		From:        sdk.NewDecWithPrec(5, 1),
+		To:          sdk.NewDecWithPrec(7, 1),
+		StartHeight: 10,
+		EndHeight:   10,
+	}
 
 	tests := []struct {
 		name    string
@@ -30,6 +43,26 @@ func TestValidateMinter(t *testing.T) {
 			minter:  invalid,
 			isValid: false,
 		},
+		{
+			name:    "should prevent validate for minter with negative carry buffer",
+			minter:  negativeCarry,
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate for minter with negative last block bonded ratio",
+			minter:  negativeBondedRatio,
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate for minter with invalid paused shares",
+			minter:  invalidPausedShares,
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate for minter with invalid goal bonded transition",
+			minter:  invalidTransition,
+			isValid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -92,6 +125,66 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestNextInflationLinear(t *testing.T) {
+	minter := types.DefaultInitialMinter()
+	params := types.DefaultParams()
+	params.InflationCalculationMode = types.INFLATION_CALCULATION_MODE_LINEAR
+	decrease := params.InflationRateChange.QuoInt64(int64(params.BlocksPerYear))
+
+	// the inflation decreases by the rate change per year whatever the bonded ratio
+	for _, bondedRatio := range []sdk.Dec{sdk.ZeroDec(), params.GoalBonded, sdk.OneDec()} {
+		minter.Inflation = sdk.NewDecWithPrec(15, 2)
+		require.Equal(t, minter.Inflation.Sub(decrease), minter.NextInflationRate(params, bondedRatio))
+	}
+
+	// the inflation stops at the min inflation
+	minter.Inflation = params.InflationMin
+	require.Equal(t, params.InflationMin, minter.NextInflationRate(params, sdk.ZeroDec()))
+	minter.Inflation = params.InflationMax.Add(sdk.OneDec())
+	require.Equal(t, params.InflationMax, minter.NextInflationRate(params, sdk.ZeroDec()))
+}
+
+func TestInflationConvergence(t *testing.T) {
+	// with no bonded tokens, the inflation moves toward the max inflation by the rate change per
+	// year, the blocks to cross the range of the inflation bounds are pinned for each rate change
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+
+	for _, tc := range []struct {
+		rateChange string
+		blocks     int
+	}{
+		{rateChange: "0.13", blocks: 100},
+		{rateChange: "0.065", blocks: 200},
+		{rateChange: "0.05", blocks: 260},
+		{rateChange: "0.01", blocks: 1300},
+	} {
+		tc := tc
+		t.Run(tc.rateChange, func(t *testing.T) {
+			params := params
+			params.InflationRateChange = sdk.MustNewDecFromStr(tc.rateChange)
+			require.NoError(t, params.Validate())
+
+			minter := types.InitialMinter(params.InflationMin)
+			blocks := 0
+			for minter.Inflation.LT(params.InflationMax) {
+				minter.Inflation = minter.NextInflationRate(params, sdk.ZeroDec())
+				blocks++
+			}
+			require.Equal(t, tc.blocks, blocks)
+
+			// the inflation moves back to the min inflation at the same speed with a bonded ratio of
+			// twice the goal bonded ratio
+			blocks = 0
+			for minter.Inflation.GT(params.InflationMin) {
+				minter.Inflation = minter.NextInflationRate(params, params.GoalBonded.MulInt64(2))
+				blocks++
+			}
+			require.Equal(t, tc.blocks, blocks)
+		})
+	}
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := types.InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := types.DefaultParams()
@@ -120,6 +213,105 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestBlocksPerYearTransition(t *testing.T) {
+	minter := types.InitialMinter(sdk.NewDecWithPrec(1, 1))
+	minter.AnnualProvisions = sdk.NewDec(6_311_520_000)
+	params := types.DefaultParams()
+	newParams := params
+	newParams.BlocksPerYear = params.BlocksPerYear * 2
+
+	minter.BlocksPerYearTransition = types.NewBlocksPerYearTransition(params.BlocksPerYear, newParams.BlocksPerYear, 10)
+	require.NoError(t, minter.Validate())
+	require.Equal(t, int64(10+newParams.BlocksPerYear/365), minter.BlocksPerYearTransition.EndHeight)
+
+	// the block provision is continuous at the start of the transition and reaches the provision
+	// at the new blocks per year at its end
+	require.True(t, minter.ExactBlockProvision(minter.ProvisionParams(newParams, 10)).Equal(minter.ExactBlockProvision(params)))
+	previous := minter.ExactBlockProvision(params)
+	for height := int64(11); height <= minter.BlocksPerYearTransition.EndHeight; height += 1000 {
+		provision := minter.ExactBlockProvision(minter.ProvisionParams(newParams, height))
+		require.True(t, provision.LTE(previous), "height %d", height)
+		previous = provision
+	}
+	end := minter.BlocksPerYearTransition.EndHeight
+	require.True(t, minter.ExactBlockProvision(minter.ProvisionParams(newParams, end)).Equal(minter.ExactBlockProvision(newParams)))
+
+	// the transition is ignored and settled once the blocks per year param changes again
+	require.Equal(t, params, minter.ProvisionParams(params, 11))
+	settled := minter
+	settled.SettleBlocksPerYearTransition(newParams, 11)
+	require.NotNil(t, settled.BlocksPerYearTransition)
+	settled.SettleBlocksPerYearTransition(params, 11)
+	require.Nil(t, settled.BlocksPerYearTransition)
+	settled = minter
+	settled.SettleBlocksPerYearTransition(newParams, end)
+	require.Nil(t, settled.BlocksPerYearTransition)
+
+	minter.BlocksPerYearTransition.EndHeight = minter.BlocksPerYearTransition.StartHeight
+	require.Error(t, minter.Validate())
+}
+
+func TestBufferedBlockProvision(t *testing.T) {
+	params := types.DefaultParams()
+	params.BlocksPerYear = 4
+	params.MinDistributableProvision = sdkmath.NewInt(10)
+
+	tests := []struct {
+		name             string
+		annualProvisions sdk.Dec
+		carryBuffer      sdk.Dec
+		expProvision     int64
+		expCarryBuffer   sdk.Dec
+	}{
+		{
+			name:             "should buffer provision below the minimum",
+			annualProvisions: sdk.NewDec(30),
+			carryBuffer:      sdk.ZeroDec(),
+			expProvision:     0,
+			expCarryBuffer:   sdk.MustNewDecFromStr("7.5"),
+		},
+		{
+			name:             "should mint buffer crossing the minimum and carry the fractional remainder",
+			annualProvisions: sdk.NewDec(30),
+			carryBuffer:      sdk.MustNewDecFromStr("7.5"),
+			expProvision:     15,
+			expCarryBuffer:   sdk.ZeroDec(),
+		},
+		{
+			name:             "should mint provision equal to the minimum",
+			annualProvisions: sdk.NewDec(41),
+			carryBuffer:      sdk.ZeroDec(),
+			expProvision:     10,
+			expCarryBuffer:   sdk.MustNewDecFromStr("0.25"),
+		},
+		{
+			name:             "should handle nil carry buffer",
+			annualProvisions: sdk.NewDec(41),
+			carryBuffer:      sdk.Dec{},
+			expProvision:     10,
+			expCarryBuffer:   sdk.MustNewDecFromStr("0.25"),
+		},
+		{
+			name:             "should buffer zero provision",
+			annualProvisions: sdk.ZeroDec(),
+			carryBuffer:      sdk.MustNewDecFromStr("0.5"),
+			expProvision:     0,
+			expCarryBuffer:   sdk.MustNewDecFromStr("0.5"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			minter := types.InitialMinter(sdk.NewDecWithPrec(1, 1))
+			minter.AnnualProvisions = tc.annualProvisions
+			minter.CarryBuffer = tc.carryBuffer
+
+			provision, carryBuffer := minter.BufferedBlockProvision(params)
+			require.Equal(t, sdk.NewCoin(params.MintDenom, sdkmath.NewInt(tc.expProvision)), provision)
+			require.True(t, tc.expCarryBuffer.Equal(carryBuffer), "expected %s, got %s", tc.expCarryBuffer, carryBuffer)
+		})
+	}
+}
+
 // Benchmarking :)
 // previously using sdk.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
@@ -168,3 +360,45 @@ func BenchmarkNextAnnualProvisions(b *testing.B) {
 		minter.NextAnnualProvisions(params, totalSupply)
 	}
 }
+
+func TestGoalBondedTransition(t *testing.T) {
+	transition := types.GoalBondedTransition{
+		From:        sdk.NewDecWithPrec(5, 1),
+		To:          sdk.NewDecWithPrec(7, 1),
+		StartHeight: 10,
+		EndHeight:   20,
+	}
+	for _, tc := range []struct {
+		height   int64
+		expected sdk.Dec
+	}{
+		{height: 5, expected: sdk.NewDecWithPrec(5, 1)},
+		{height: 10, expected: sdk.NewDecWithPrec(5, 1)},
+		{height: 11, expected: sdk.NewDecWithPrec(52, 2)},
+		{height: 15, expected: sdk.NewDecWithPrec(6, 1)},
+		{height: 19, expected: sdk.NewDecWithPrec(68, 2)},
+		{height: 20, expected: sdk.NewDecWithPrec(7, 1)},
+		{height: 30, expected: sdk.NewDecWithPrec(7, 1)},
+	} {
+		require.Equal(t, tc.expected, transition.GoalBondedAt(tc.height), "height %d", tc.height)
+	}
+
+	params := types.DefaultParams()
+	params.GoalBonded = transition.To
+	minter := types.DefaultInitialMinter()
+	require.Equal(t, params.GoalBonded, minter.EffectiveGoalBonded(params, 15))
+	minter.GoalBondedTransition = &transition
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), minter.EffectiveGoalBonded(params, 15))
+
+	// the transition is ignored once the goal bonded param has changed
+	params.GoalBonded = sdk.NewDecWithPrec(8, 1)
+	require.Equal(t, params.GoalBonded, minter.EffectiveGoalBonded(params, 15))
+
+	require.NoError(t, transition.Validate())
+	invalid := transition
+	invalid.To = sdk.ZeroDec()
+	require.ErrorIs(t, invalid.Validate(), types.ErrInvalidGoalBonded)
+	invalid = transition
+	invalid.EndHeight = invalid.StartHeight
+	require.Error(t, invalid.Validate())
+}
@@ -0,0 +1,20 @@
+package types
+
+// IsMintingHeight returns true if the provisions are minted at the height. With a minting interval
+// larger than 1, the provisions are minted at the heights multiple of the interval and accrue in
+// the carry buffer of the minter in between.
+func (p Params) IsMintingHeight(height int64) bool {
+	if p.MintingInterval <= 1 {
+		return true
+	}
+	return height%int64(p.MintingInterval) == 0
+}
+
+// hasMintingHeight returns true if the provisions are minted at one of the blocks from the height
+func (p Params) hasMintingHeight(height int64, blocks uint64) bool {
+	if p.MintingInterval <= 1 {
+		return true
+	}
+	lastHeight := height + int64(blocks) - 1
+	return lastHeight-lastHeight%int64(p.MintingInterval) >= height
+}
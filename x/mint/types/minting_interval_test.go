@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsMintingHeight(t *testing.T) {
+	params := DefaultParams()
+	for _, height := range []int64{1, 2, 3, 10} {
+		require.True(t, params.IsMintingHeight(height), "height %d", height)
+	}
+
+	params.MintingInterval = 0
+	require.True(t, params.IsMintingHeight(7))
+
+	params.MintingInterval = 5
+	for height, minting := range map[int64]bool{1: false, 4: false, 5: true, 6: false, 10: true} {
+		require.Equal(t, minting, params.IsMintingHeight(height), "height %d", height)
+	}
+}
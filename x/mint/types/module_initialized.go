@@ -0,0 +1,45 @@
+package types
+
+// EnabledFeatures returns the proto names of the params of the optional features enabled, in the
+// order of the params
+func (p Params) EnabledFeatures() (features []string) {
+	for _, feature := range []struct {
+		name    string
+		enabled bool
+	}{
+		{"min_distributable_provision", !p.MinDistributableProvision.IsNil() && p.MinDistributableProvision.IsPositive()},
+		{"pause_minting", p.PauseMinting},
+		{"pause_staking_share", p.PauseStakingShare},
+		{"pause_funded_share", p.PauseFundedShare},
+		{"pause_community_share", p.PauseCommunityShare},
+		{"emit_mint_planned", p.EmitMintPlanned},
+		{"min_annual_community_funding", !p.MinAnnualCommunityFunding.Amount.IsNil() && p.MinAnnualCommunityFunding.IsPositive()},
+		{"drift_correction", p.DriftCorrection.Enabled()},
+		{"staking_rewards_recipient", p.StakingRewardsRecipient != ""},
+		{"phases", len(p.Phases) > 0},
+		{"max_supply", !p.MaxSupply.IsNil() && p.MaxSupply.IsPositive()},
+		{"mint_configs", len(p.MintConfigs) > 0},
+		{"bootstrap_override", p.BootstrapOverride.Enabled()},
+		{"min_bonded_ratio", !p.MinBondedRatio.IsNil() && p.MinBondedRatio.IsPositive()},
+		{"auto_pause_threshold", p.AutoPauseThreshold > 0},
+		{"minting_interval", p.MintingInterval > 1},
+	} {
+		if feature.enabled {
+			features = append(features, feature.name)
+		}
+	}
+	return features
+}
+
+// NewEventModuleInitialized returns the summary of the mint configuration loaded by the genesis
+// initialization, the height is the first height minted with the configuration
+func NewEventModuleInitialized(height int64, minter Minter, params Params) EventModuleInitialized {
+	return EventModuleInitialized{
+		Height:                  height,
+		MintDenom:               params.MintDenom,
+		Inflation:               minter.Inflation,
+		DistributionProportions: params.DistributionProportions,
+		FundedAddresses:         uint32(len(params.FundedAddresses)),
+		Features:                params.EnabledFeatures(),
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnabledFeatures(t *testing.T) {
+	params := DefaultParams()
+	require.Empty(t, params.EnabledFeatures())
+
+	params.MinDistributableProvision = sdkmath.OneInt()
+	params.DriftCorrection.MaxFactor = sdk.NewDecWithPrec(1, 1)
+	params.Phases = []Phase{{Name: "phase", StartHeight: 10}}
+	params.BootstrapOverride = BootstrapOverride{Recipient: "mint", EndHeight: 10}
+	params.AutoPauseThreshold = 3
+	params.MintingInterval = 1
+	require.Equal(t, []string{
+		"min_distributable_provision",
+		"drift_correction",
+		"phases",
+		"bootstrap_override",
+		"auto_pause_threshold",
+	}, params.EnabledFeatures())
+}
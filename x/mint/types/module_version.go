@@ -0,0 +1,51 @@
+package types
+
+import "fmt"
+
+// BehaviorRevisions are the behavior revisions of the consensus versions of the module, each
+// revision names the behavior added by its version
+var BehaviorRevisions = map[uint64]string{
+	1: "1-base",
+	2: "2-summary",
+	3: "3-dust-ledger",
+	4: "4-default-params",
+	5: "5-funding-windows",
+	6: "6-mint-configs",
+	7: "7-sdk-mint-import",
+}
+
+// BehaviorRevision returns the behavior revision of a consensus version of the module
+func BehaviorRevision(version uint64) string {
+	if revision, ok := BehaviorRevisions[version]; ok {
+		return revision
+	}
+	return fmt.Sprintf("%d-unknown", version)
+}
+
+// NewModuleVersion returns the module version of a consensus version without completed migration
+func NewModuleVersion(version uint64) ModuleVersion {
+	return ModuleVersion{
+		ConsensusVersion: version,
+		BehaviorRevision: BehaviorRevision(version),
+		Migrations:       []MigrationRecord{},
+	}
+}
+
+// AddMigration returns the module version after the migration from a version at a height. The
+// migration is recorded once, a migration from an already recorded version is ignored.
+func (v ModuleVersion) AddMigration(from uint64, height int64) ModuleVersion {
+	v.ConsensusVersion = from + 1
+	v.BehaviorRevision = BehaviorRevision(from + 1)
+	for _, migration := range v.Migrations {
+		if migration.FromVersion == from {
+			return v
+		}
+	}
+	v.Migrations = append(v.Migrations, MigrationRecord{
+		FromVersion:      from,
+		ToVersion:        from + 1,
+		Height:           height,
+		BehaviorRevision: v.BehaviorRevision,
+	})
+	return v
+}
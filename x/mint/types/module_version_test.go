@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestModuleVersionAddMigration(t *testing.T) {
+	moduleVersion := types.NewModuleVersion(1)
+	require.Equal(t, "1-base", moduleVersion.BehaviorRevision)
+	require.Empty(t, moduleVersion.Migrations)
+
+	moduleVersion = moduleVersion.AddMigration(1, 10)
+	moduleVersion = moduleVersion.AddMigration(2, 20)
+	require.EqualValues(t, 3, moduleVersion.ConsensusVersion)
+	require.Equal(t, "3-dust-ledger", moduleVersion.BehaviorRevision)
+	require.Equal(t, []types.MigrationRecord{
+		{FromVersion: 1, ToVersion: 2, Height: 10, BehaviorRevision: "2-summary"},
+		{FromVersion: 2, ToVersion: 3, Height: 20, BehaviorRevision: "3-dust-ledger"},
+	}, moduleVersion.Migrations)
+
+	// a migration already recorded is not appended again
+	require.Equal(t, moduleVersion.Migrations, moduleVersion.AddMigration(2, 30).Migrations)
+
+	require.Equal(t, "99-unknown", types.BehaviorRevision(99))
+}
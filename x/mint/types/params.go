@@ -3,24 +3,58 @@ package types
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	errorsignite "github.com/ignite/modules/pkg/errors"
 )
 
 // Parameter store keys
 var (
-	KeyMintDenom               = []byte("MintDenom")
-	KeyInflationRateChange     = []byte("InflationRateChange")
-	KeyInflationMax            = []byte("InflationMax")
-	KeyInflationMin            = []byte("InflationMin")
-	KeyGoalBonded              = []byte("GoalBonded")
-	KeyBlocksPerYear           = []byte("BlocksPerYear")
-	KeyDistributionProportions = []byte("DistributionProportions")
-	KeyFundedAddresses         = []byte("FundedAddresses")
+	KeyMintDenom                  = []byte("MintDenom")
+	KeyInflationRateChange        = []byte("InflationRateChange")
+	KeyInflationMax               = []byte("InflationMax")
+	KeyInflationMin               = []byte("InflationMin")
+	KeyGoalBonded                 = []byte("GoalBonded")
+	KeyBlocksPerYear              = []byte("BlocksPerYear")
+	KeyDistributionProportions    = []byte("DistributionProportions")
+	KeyFundedAddresses            = []byte("FundedAddresses")
+	KeyMinDistributableProvision  = []byte("MinDistributableProvision")
+	KeyPauseMinting               = []byte("PauseMinting")
+	KeyPauseStakingShare          = []byte("PauseStakingShare")
+	KeyPauseFundedShare           = []byte("PauseFundedShare")
+	KeyPauseCommunityShare        = []byte("PauseCommunityShare")
+	KeyPausedShareMode            = []byte("PausedShareMode")
+	KeyDustAssignment             = []byte("DustAssignment")
+	KeyEmitMintPlanned            = []byte("EmitMintPlanned")
+	KeySupplySourceMode           = []byte("SupplySourceMode")
+	KeyMinAnnualCommunityFunding  = []byte("MinAnnualCommunityFunding")
+	KeyCommunityFundingPriority   = []byte("CommunityFundingPriority")
+	KeyCommunityFundingWindow     = []byte("CommunityFundingWindow")
+	KeyDriftCorrection            = []byte("DriftCorrection")
+	KeyLargeChangeThreshold       = []byte("LargeChangeThreshold")
+	KeyStakingRewardsRecipient    = []byte("StakingRewardsRecipient")
+	KeyPhases                     = []byte("Phases")
+	KeyMaxSupply                  = []byte("MaxSupply")
+	KeyShortfallPolicy            = []byte("ShortfallPolicy")
+	KeyShortfallPriority          = []byte("ShortfallPriority")
+	KeyInflationSnapshotInterval  = []byte("InflationSnapshotInterval")
+	KeyInflationSnapshotRetention = []byte("InflationSnapshotRetention")
+	KeyMintConfigs                = []byte("MintConfigs")
+	KeyInflationCalculationMode   = []byte("InflationCalculationMode")
+	KeyBootstrapOverride          = []byte("BootstrapOverride")
+	KeyMinBondedRatio             = []byte("MinBondedRatio")
+	KeyAutoPauseThreshold         = []byte("AutoPauseThreshold")
+	KeyDustPolicy                 = []byte("DustPolicy")
+	KeyMaintenanceInterval        = []byte("MaintenanceInterval")
+	KeyMintingInterval            = []byte("MintingInterval")
+	KeyMinCommunityPoolShare      = []byte("MinCommunityPoolShare")
 
 	DefaultMintDenom               = sdk.DefaultBondDenom
 	DefaultInflationRateChange     = sdk.NewDecWithPrec(13, 2)
@@ -29,11 +63,48 @@ var (
 	DefaultGoalBonded              = sdk.NewDecWithPrec(67, 2)
 	DefaultBlocksPerYear           = uint64(60 * 60 * 8766 / 5) // assuming 5 seconds block times
 	DefaultDistributionProportions = DistributionProportions{
-		Staking:         sdk.NewDecWithPrec(3, 1), // 0.3
-		FundedAddresses: sdk.NewDecWithPrec(4, 1), // 0.4
-		CommunityPool:   sdk.NewDecWithPrec(3, 1), // 0.3
+		Staking:          sdk.NewDecWithPrec(3, 1), // 0.3
+		FundedAddresses:  sdk.NewDecWithPrec(4, 1), // 0.4
+		CommunityPool:    sdk.NewDecWithPrec(3, 1), // 0.3
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	DefaultFundedAddresses           []WeightedAddress
+	DefaultMinDistributableProvision = sdkmath.ZeroInt()
+	DefaultPauseMinting              = false
+	DefaultPauseStakingShare         = false
+	DefaultPauseFundedShare          = false
+	DefaultPauseCommunityShare       = false
+	DefaultPausedShareMode           = PAUSED_SHARE_MODE_COMMUNITY_POOL
+	DefaultDustAssignment            = DUST_ASSIGNMENT_MODULE_ACCOUNT
+	DefaultEmitMintPlanned           = false
+	DefaultSupplySourceMode          = SUPPLY_SOURCE_MODE_REPLACE
+	DefaultMinAnnualCommunityFunding = sdk.NewCoin(DefaultMintDenom, sdkmath.ZeroInt())
+	DefaultCommunityFundingPriority  = []CommunityFundingSource{
+		COMMUNITY_FUNDING_SOURCE_MINT,
+		COMMUNITY_FUNDING_SOURCE_STAKING,
 	}
-	DefaultFundedAddresses []WeightedAddress
+	DefaultCommunityFundingWindow = uint64(60 * 60 * 24 / 5) // one day with 5 seconds block times
+	DefaultDriftCorrection        = DriftCorrection{
+		MaxFactor: sdk.ZeroDec(),
+		Horizon:   uint64(60 * 60 * 24 * 30 / 5), // thirty days with 5 seconds block times
+	}
+	DefaultLargeChangeThreshold       = sdk.NewDecWithPrec(5, 2) // 5 percentage points
+	DefaultStakingRewardsRecipient    = ""
+	DefaultPhases                     []Phase
+	DefaultMaxSupply                  = sdkmath.ZeroInt()
+	DefaultShortfallPolicy            = SHORTFALL_POLICY_PRO_RATA
+	DefaultShortfallPriority          = []string{CategoryStaking, CategoryFundedAddresses, CategoryCommunityPool}
+	DefaultInflationSnapshotInterval  = uint64(1000)
+	DefaultInflationSnapshotRetention = DefaultBlocksPerYear
+	DefaultMintConfigs                []MintConfig
+	DefaultInflationCalculationMode   = INFLATION_CALCULATION_MODE_GOAL_BONDED
+	DefaultBootstrapOverride          = BootstrapOverride{}
+	DefaultMinBondedRatio             = sdk.ZeroDec()
+	DefaultAutoPauseThreshold         = uint64(0)
+	DefaultDustPolicy                 = DUST_POLICY_COMMUNITY_POOL
+	DefaultMaintenanceInterval        = uint64(100)
+	DefaultMintingInterval            = uint64(1)
+	DefaultMinCommunityPoolShare      = sdk.ZeroDec()
 )
 
 // ParamTable for minting module.
@@ -41,6 +112,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams creates a new Params instance, parameters not provided as
+// arguments are set to their default value.
 func NewParams(
 	mintDenom string,
 	inflationRateChange,
@@ -52,14 +125,44 @@ func NewParams(
 	fundedAddrs []WeightedAddress,
 ) Params {
 	return Params{
-		MintDenom:               mintDenom,
-		InflationRateChange:     inflationRateChange,
-		InflationMax:            inflationMax,
-		InflationMin:            inflationMin,
-		GoalBonded:              goalBonded,
-		BlocksPerYear:           blocksPerYear,
-		DistributionProportions: proportions,
-		FundedAddresses:         fundedAddrs,
+		MintDenom:                  mintDenom,
+		InflationRateChange:        inflationRateChange,
+		InflationMax:               inflationMax,
+		InflationMin:               inflationMin,
+		GoalBonded:                 goalBonded,
+		BlocksPerYear:              blocksPerYear,
+		DistributionProportions:    proportions,
+		FundedAddresses:            fundedAddrs,
+		MinDistributableProvision:  DefaultMinDistributableProvision,
+		PauseMinting:               DefaultPauseMinting,
+		PauseStakingShare:          DefaultPauseStakingShare,
+		PauseFundedShare:           DefaultPauseFundedShare,
+		PauseCommunityShare:        DefaultPauseCommunityShare,
+		PausedShareMode:            DefaultPausedShareMode,
+		DustAssignment:             DefaultDustAssignment,
+		EmitMintPlanned:            DefaultEmitMintPlanned,
+		SupplySourceMode:           DefaultSupplySourceMode,
+		MinAnnualCommunityFunding:  sdk.NewCoin(mintDenom, sdkmath.ZeroInt()),
+		CommunityFundingPriority:   DefaultCommunityFundingPriority,
+		CommunityFundingWindow:     DefaultCommunityFundingWindow,
+		DriftCorrection:            DefaultDriftCorrection,
+		LargeChangeThreshold:       DefaultLargeChangeThreshold,
+		StakingRewardsRecipient:    DefaultStakingRewardsRecipient,
+		Phases:                     DefaultPhases,
+		MaxSupply:                  DefaultMaxSupply,
+		ShortfallPolicy:            DefaultShortfallPolicy,
+		ShortfallPriority:          DefaultShortfallPriority,
+		InflationSnapshotInterval:  DefaultInflationSnapshotInterval,
+		InflationSnapshotRetention: DefaultInflationSnapshotRetention,
+		MintConfigs:                DefaultMintConfigs,
+		InflationCalculationMode:   DefaultInflationCalculationMode,
+		BootstrapOverride:          DefaultBootstrapOverride,
+		MinBondedRatio:             DefaultMinBondedRatio,
+		AutoPauseThreshold:         DefaultAutoPauseThreshold,
+		DustPolicy:                 DefaultDustPolicy,
+		MaintenanceInterval:        DefaultMaintenanceInterval,
+		MintingInterval:            DefaultMintingInterval,
+		MinCommunityPoolShare:      DefaultMinCommunityPoolShare,
 	}
 }
 
@@ -82,7 +185,7 @@ func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
 		return err
 	}
-	if err := validateDec(p.InflationRateChange); err != nil {
+	if err := validateInflationRateChange(p.InflationRateChange); err != nil {
 		return err
 	}
 	if err := validateDec(p.InflationMax); err != nil {
@@ -91,7 +194,7 @@ func (p Params) Validate() error {
 	if err := validateDec(p.InflationMin); err != nil {
 		return err
 	}
-	if err := validateDec(p.GoalBonded); err != nil {
+	if err := ValidateGoalBonded(p.GoalBonded); err != nil {
 		return err
 	}
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
@@ -103,10 +206,250 @@ func (p Params) Validate() error {
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if err := p.validateInflationRateChangeRange(); err != nil {
+		return err
+	}
 	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
 		return err
 	}
-	return validateWeightedAddresses(p.FundedAddresses)
+	if err := validateWeightedAddresses(p.FundedAddresses); err != nil {
+		return err
+	}
+	if err := validateMinDistributableProvision(p.MinDistributableProvision); err != nil {
+		return err
+	}
+	if err := validatePausedShareMode(p.PausedShareMode); err != nil {
+		return err
+	}
+	if err := validateDustAssignment(p.DustAssignment); err != nil {
+		return err
+	}
+	if err := validateSupplySourceMode(p.SupplySourceMode); err != nil {
+		return err
+	}
+	if err := validateMinAnnualCommunityFunding(p.MinAnnualCommunityFunding); err != nil {
+		return err
+	}
+	if err := validateCommunityFundingPriority(p.CommunityFundingPriority); err != nil {
+		return err
+	}
+	if err := validateCommunityFundingWindow(p.CommunityFundingWindow); err != nil {
+		return err
+	}
+	if err := validateDriftCorrection(p.DriftCorrection); err != nil {
+		return err
+	}
+	if err := validateLargeChangeThreshold(p.LargeChangeThreshold); err != nil {
+		return err
+	}
+	if err := validateStakingRewardsRecipient(p.StakingRewardsRecipient); err != nil {
+		return err
+	}
+	if err := validatePhases(p.Phases); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateShortfallPolicy(p.ShortfallPolicy); err != nil {
+		return err
+	}
+	if err := validateShortfallPriority(p.ShortfallPriority); err != nil {
+		return err
+	}
+	if err := validateInflationSnapshotInterval(p.InflationSnapshotInterval); err != nil {
+		return err
+	}
+	if err := validateInflationSnapshotRetention(p.InflationSnapshotRetention); err != nil {
+		return err
+	}
+	if p.InflationSnapshotRetention > 0 && p.InflationSnapshotRetention < p.InflationSnapshotInterval {
+		return fmt.Errorf(
+			"inflation snapshot retention %d must not be lower than the inflation snapshot interval %d",
+			p.InflationSnapshotRetention,
+			p.InflationSnapshotInterval,
+		)
+	}
+	for _, phase := range p.Phases {
+		if err := p.WithPhase(phase).validateInflationRateChangeRange(); err != nil {
+			return fmt.Errorf("phase %s: %w", phase.Name, err)
+		}
+	}
+	if err := validateMintConfigs(p.MintConfigs); err != nil {
+		return err
+	}
+	if err := validateInflationCalculationMode(p.InflationCalculationMode); err != nil {
+		return err
+	}
+	if err := validateBootstrapOverride(p.BootstrapOverride); err != nil {
+		return err
+	}
+	if err := validateMinBondedRatio(p.MinBondedRatio); err != nil {
+		return err
+	}
+	if err := validateAutoPauseThreshold(p.AutoPauseThreshold); err != nil {
+		return err
+	}
+	if err := validateDustPolicy(p.DustPolicy); err != nil {
+		return err
+	}
+	if err := validateMaintenanceInterval(p.MaintenanceInterval); err != nil {
+		return err
+	}
+	if err := validateMintingInterval(p.MintingInterval); err != nil {
+		return err
+	}
+	if err := validateMinCommunityPoolShare(p.MinCommunityPoolShare); err != nil {
+		return err
+	}
+	if err := p.validateCommunityPoolShare(); err != nil {
+		return err
+	}
+	if err := p.validateMintConfigFeatures(); err != nil {
+		return err
+	}
+	for _, config := range p.MintConfigs {
+		if config.MintDenom == p.MintDenom {
+			return fmt.Errorf("duplicate mint denom %s", config.MintDenom)
+		}
+		if err := p.WithMintConfig(config).validateInflationRateChangeRange(); err != nil {
+			return fmt.Errorf("mint config %s: %w", config.MintDenom, err)
+		}
+		if err := p.WithMintConfig(config).validateCommunityPoolShare(); err != nil {
+			return fmt.Errorf("mint config %s: %w", config.MintDenom, err)
+		}
+	}
+	return p.validateCommunityFunding()
+}
+
+// validateInflationRateChangeRange checks the inflation rate change doesn't exceed the range of
+// the inflation bounds, the rate change is not bounded for a fixed inflation rate
+func (p Params) validateInflationRateChangeRange() error {
+	inflationRange := p.InflationMax.Sub(p.InflationMin)
+	if inflationRange.IsPositive() && p.InflationRateChange.GT(inflationRange) {
+		return fmt.Errorf(
+			"inflation rate change (%s) must be lower than or equal to max inflation minus min inflation (%s)",
+			p.InflationRateChange, inflationRange,
+		)
+	}
+	return nil
+}
+
+// CommunityPoolShare returns the share of the minted coins sent to the community pool by the
+// distribution proportions, the funded addresses share is sent to the community pool when there
+// is no funded address
+func (p Params) CommunityPoolShare() sdk.Dec {
+	share := p.DistributionProportions.CommunityPool
+	if len(p.FundedAddresses) == 0 {
+		share = share.Add(p.DistributionProportions.FundedAddresses)
+	}
+	return share
+}
+
+// validateCommunityPoolShare checks the community pool share of the distribution proportions is
+// not lower than the min community pool share. A min community pool share is also incompatible
+// with the params withholding the share of the community pool whatever the proportions: the
+// bootstrap override, sending all the minted coins to its recipient, and the pause of the
+// community pool share, buffering the share in the module account.
+func (p Params) validateCommunityPoolShare() error {
+	if share := p.CommunityPoolShare(); share.LT(p.MinCommunityPoolShare) {
+		return errorsignite.Wrapf(
+			ErrInvalidProportions,
+			"community pool share %s is lower than the required minimum %s",
+			share, p.MinCommunityPoolShare,
+		)
+	}
+	if !p.MinCommunityPoolShare.IsPositive() {
+		return nil
+	}
+	if p.BootstrapOverride.Enabled() {
+		return errorsignite.Wrapf(
+			ErrInvalidProportions,
+			"bootstrap override sending all the minted coins to %s bypasses the required minimum community pool share %s",
+			p.BootstrapOverride.Recipient, p.MinCommunityPoolShare,
+		)
+	}
+	if p.PauseCommunityShare {
+		return errorsignite.Wrapf(
+			ErrInvalidProportions,
+			"paused community pool share bypasses the required minimum community pool share %s",
+			p.MinCommunityPoolShare,
+		)
+	}
+	return nil
+}
+
+// validateCommunityFunding checks the minimum annual community funding is in the mint denom
+func (p Params) validateCommunityFunding() error {
+	if p.MinAnnualCommunityFunding.IsPositive() && p.MinAnnualCommunityFunding.Denom != p.MintDenom {
+		return fmt.Errorf(
+			"min annual community funding denom (%s) must be the mint denom (%s)",
+			p.MinAnnualCommunityFunding.Denom, p.MintDenom,
+		)
+	}
+	return nil
+}
+
+// FieldErrors validates every param and returns the validation errors by param key, the
+// errors of the constraints between params are reported on the key of the first param
+func (p Params) FieldErrors() (fieldErrors []ParamsFieldError) {
+	for _, pair := range p.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.ValueOf(pair.Value).Elem().Interface()); err != nil {
+			fieldErrors = append(fieldErrors, ParamsFieldError{Field: string(pair.Key), Error: err.Error()})
+		}
+	}
+	if !p.InflationMax.IsNil() && !p.InflationMin.IsNil() && p.InflationMax.LT(p.InflationMin) {
+		fieldErrors = append(fieldErrors, ParamsFieldError{
+			Field: string(KeyInflationMax),
+			Error: fmt.Sprintf(
+				"max inflation (%s) must be greater than or equal to min inflation (%s)",
+				p.InflationMax, p.InflationMin,
+			),
+		})
+	}
+	if validateInflationRateChange(p.InflationRateChange) == nil &&
+		validateDec(p.InflationMax) == nil && validateDec(p.InflationMin) == nil {
+		if err := p.validateInflationRateChangeRange(); err != nil {
+			fieldErrors = append(fieldErrors, ParamsFieldError{
+				Field: string(KeyInflationRateChange),
+				Error: err.Error(),
+			})
+		}
+	}
+	if validateInflationRateChange(p.InflationRateChange) == nil && validatePhases(p.Phases) == nil {
+		for _, phase := range p.Phases {
+			if err := p.WithPhase(phase).validateInflationRateChangeRange(); err != nil {
+				fieldErrors = append(fieldErrors, ParamsFieldError{
+					Field: string(KeyPhases),
+					Error: fmt.Sprintf("phase %s: %s", phase.Name, err),
+				})
+			}
+		}
+	}
+	if validateDistributionProportions(p.DistributionProportions) == nil &&
+		validateMinCommunityPoolShare(p.MinCommunityPoolShare) == nil {
+		if err := p.validateCommunityPoolShare(); err != nil {
+			fieldErrors = append(fieldErrors, ParamsFieldError{
+				Field: string(KeyDistributionProportions),
+				Error: err.Error(),
+			})
+		}
+	}
+	if validateMinAnnualCommunityFunding(p.MinAnnualCommunityFunding) == nil {
+		if err := p.validateCommunityFunding(); err != nil {
+			fieldErrors = append(fieldErrors, ParamsFieldError{
+				Field: string(KeyMinAnnualCommunityFunding),
+				Error: err.Error(),
+			})
+		}
+	}
+	return fieldErrors
+}
+
+// StakingRewardsRecipientIsModuleAccount returns true if the staking rewards recipient is the name
+// of a module account rather than an address
+func (p Params) StakingRewardsRecipientIsModuleAccount() bool {
+	return isModuleName(p.StakingRewardsRecipient)
 }
 
 // String implements the Stringer interface.
@@ -119,13 +462,43 @@ func (p Params) String() string {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
-		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateDec),
+		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
 		paramtypes.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateDec),
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateDec),
-		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateDec),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
 		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyFundedAddresses, &p.FundedAddresses, validateWeightedAddresses),
+		paramtypes.NewParamSetPair(KeyMinDistributableProvision, &p.MinDistributableProvision, validateMinDistributableProvision),
+		paramtypes.NewParamSetPair(KeyPauseMinting, &p.PauseMinting, validateBool),
+		paramtypes.NewParamSetPair(KeyPauseStakingShare, &p.PauseStakingShare, validateBool),
+		paramtypes.NewParamSetPair(KeyPauseFundedShare, &p.PauseFundedShare, validateBool),
+		paramtypes.NewParamSetPair(KeyPauseCommunityShare, &p.PauseCommunityShare, validateBool),
+		paramtypes.NewParamSetPair(KeyPausedShareMode, &p.PausedShareMode, validatePausedShareMode),
+		paramtypes.NewParamSetPair(KeyDustAssignment, &p.DustAssignment, validateDustAssignment),
+		paramtypes.NewParamSetPair(KeyEmitMintPlanned, &p.EmitMintPlanned, validateBool),
+		paramtypes.NewParamSetPair(KeySupplySourceMode, &p.SupplySourceMode, validateSupplySourceMode),
+		paramtypes.NewParamSetPair(KeyMinAnnualCommunityFunding, &p.MinAnnualCommunityFunding, validateMinAnnualCommunityFunding),
+		paramtypes.NewParamSetPair(KeyCommunityFundingPriority, &p.CommunityFundingPriority, validateCommunityFundingPriority),
+		paramtypes.NewParamSetPair(KeyCommunityFundingWindow, &p.CommunityFundingWindow, validateCommunityFundingWindow),
+		paramtypes.NewParamSetPair(KeyDriftCorrection, &p.DriftCorrection, validateDriftCorrection),
+		paramtypes.NewParamSetPair(KeyLargeChangeThreshold, &p.LargeChangeThreshold, validateLargeChangeThreshold),
+		paramtypes.NewParamSetPair(KeyStakingRewardsRecipient, &p.StakingRewardsRecipient, validateStakingRewardsRecipient),
+		paramtypes.NewParamSetPair(KeyPhases, &p.Phases, validatePhases),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyShortfallPolicy, &p.ShortfallPolicy, validateShortfallPolicy),
+		paramtypes.NewParamSetPair(KeyShortfallPriority, &p.ShortfallPriority, validateShortfallPriority),
+		paramtypes.NewParamSetPair(KeyInflationSnapshotInterval, &p.InflationSnapshotInterval, validateInflationSnapshotInterval),
+		paramtypes.NewParamSetPair(KeyInflationSnapshotRetention, &p.InflationSnapshotRetention, validateInflationSnapshotRetention),
+		paramtypes.NewParamSetPair(KeyMintConfigs, &p.MintConfigs, validateMintConfigs),
+		paramtypes.NewParamSetPair(KeyInflationCalculationMode, &p.InflationCalculationMode, validateInflationCalculationMode),
+		paramtypes.NewParamSetPair(KeyBootstrapOverride, &p.BootstrapOverride, validateBootstrapOverride),
+		paramtypes.NewParamSetPair(KeyMinBondedRatio, &p.MinBondedRatio, validateMinBondedRatio),
+		paramtypes.NewParamSetPair(KeyAutoPauseThreshold, &p.AutoPauseThreshold, validateAutoPauseThreshold),
+		paramtypes.NewParamSetPair(KeyDustPolicy, &p.DustPolicy, validateDustPolicy),
+		paramtypes.NewParamSetPair(KeyMaintenanceInterval, &p.MaintenanceInterval, validateMaintenanceInterval),
+		paramtypes.NewParamSetPair(KeyMintingInterval, &p.MintingInterval, validateMintingInterval),
+		paramtypes.NewParamSetPair(KeyMinCommunityPoolShare, &p.MinCommunityPoolShare, validateMinCommunityPoolShare),
 	}
 }
 
@@ -148,6 +521,9 @@ func validateDec(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.IsNil() {
+		return errors.New("dec cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("cannot be negative: %s", v)
 	}
@@ -158,6 +534,16 @@ func validateDec(i interface{}) error {
 	return nil
 }
 
+func validateInflationRateChange(i interface{}) error {
+	if err := validateDec(i); err != nil {
+		return err
+	}
+	if v := i.(sdk.Dec); !v.IsPositive() {
+		return fmt.Errorf("inflation rate change must be positive: %s", v)
+	}
+	return nil
+}
+
 func validateBlocksPerYear(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -177,27 +563,44 @@ func validateDistributionProportions(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.Staking.IsNil() || v.FundedAddresses.IsNil() || v.CommunityPool.IsNil() {
+		return errorsignite.Wrap(ErrInvalidProportions, "distribution ratios cannot be nil")
+	}
+
 	if v.Staking.IsNegative() {
-		return errors.New("staking distribution ratio should not be negative")
+		return errorsignite.Wrap(ErrInvalidProportions, "staking distribution ratio should not be negative")
 	}
 
 	if v.FundedAddresses.IsNegative() {
-		return errors.New("funded addresses distribution ratio should not be negative")
+		return errorsignite.Wrap(ErrInvalidProportions, "funded addresses distribution ratio should not be negative")
 	}
 
 	if v.CommunityPool.IsNegative() {
-		return errors.New("community pool distribution ratio should not be negative")
+		return errorsignite.Wrap(ErrInvalidProportions, "community pool distribution ratio should not be negative")
+	}
+
+	if v.StrategicReserveRatio().IsNegative() {
+		return errorsignite.Wrap(ErrInvalidProportions, "strategic reserve distribution ratio should not be negative")
 	}
 
-	totalProportions := v.Staking.Add(v.FundedAddresses).Add(v.CommunityPool)
+	totalProportions := v.Staking.Add(v.FundedAddresses).Add(v.CommunityPool).Add(v.StrategicReserveRatio())
 
 	if !totalProportions.Equal(sdk.NewDec(1)) {
-		return errors.New("total distributions ratio should be 1")
+		return errorsignite.Wrap(ErrInvalidProportions, "total distributions ratio should be 1")
 	}
 
 	return nil
 }
 
+// StrategicReserveRatio returns the strategic reserve proportion, zero for the proportions set
+// before the strategic reserve
+func (p DistributionProportions) StrategicReserveRatio() sdk.Dec {
+	if p.StrategicReserve.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.StrategicReserve
+}
+
 func validateWeightedAddresses(i interface{}) error {
 	v, ok := i.([]WeightedAddress)
 	if !ok {
@@ -209,23 +612,465 @@ func validateWeightedAddresses(i interface{}) error {
 	}
 
 	weightSum := sdk.NewDec(0)
+	indexes := make(map[string]int, len(v))
+	stakeWeighted := 0
 	for i, w := range v {
-		_, err := sdk.AccAddressFromBech32(w.Address)
+		// the addresses are compared in their canonical form, a mixed-case address duplicates
+		// its lowercase form
+		addr, err := NormalizeAddress(w.Address)
 		if err != nil {
-			return fmt.Errorf("invalid address at index %d", i)
+			return errorsignite.Wrapf(ErrInvalidFundedAddress, "invalid address at index %d: %s", i, err)
 		}
-		if !w.Weight.IsPositive() {
+		if j, ok := indexes[addr]; ok {
+			return errorsignite.Wrapf(ErrInvalidFundedAddress, "duplicate address %s at index %d and %d", addr, j, i)
+		}
+		indexes[addr] = i
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
 			return fmt.Errorf("non-positive weight at index %d", i)
 		}
 		if w.Weight.GT(sdk.NewDec(1)) {
-			return fmt.Errorf("more than 1 weight at index %d", i)
+			return errorsignite.Wrapf(ErrInvalidWeightSum, "more than 1 weight at index %d", i)
+		}
+		if _, ok := PayoutMode_name[int32(w.PayoutMode)]; !ok {
+			return fmt.Errorf("invalid payout mode %d at index %d", w.PayoutMode, i)
+		}
+		if _, ok := WeightMode_name[int32(w.WeightMode)]; !ok {
+			return fmt.Errorf("invalid weight mode %d at index %d", w.WeightMode, i)
+		}
+		if w.IsStakeWeighted() {
+			stakeWeighted++
+		}
+		if w.StartHeight < 0 || w.EndHeight < 0 {
+			return fmt.Errorf("negative funding window height at index %d", i)
+		}
+		if w.StartHeight > 0 && w.EndHeight > 0 && w.EndHeight <= w.StartHeight {
+			return fmt.Errorf(
+				"funding window end height (%d) must be after start height (%d) at index %d",
+				w.EndHeight, w.StartHeight, i,
+			)
 		}
 		weightSum = weightSum.Add(w.Weight)
 	}
+	if stakeWeighted > MaxStakeWeightedAddresses {
+		return errorsignite.Wrapf(
+			ErrInvalidFundedAddress,
+			"%d stake weighted addresses, the maximum is %d",
+			stakeWeighted, MaxStakeWeightedAddresses,
+		)
+	}
 
 	if !weightSum.Equal(sdk.NewDec(1)) {
-		return fmt.Errorf("invalid weight sum: %s", weightSum.String())
+		return errorsignite.Wrapf(ErrInvalidWeightSum, "invalid weight sum: %s", weightSum.String())
+	}
+
+	return nil
+}
+
+func validateMinDistributableProvision(i interface{}) error {
+	v, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil value is equivalent to zero and disables the carry buffer
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("min distributable provision cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePausedShareMode(i interface{}) error {
+	v, ok := i.(PausedShareMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PausedShareMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid paused share mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateDustAssignment(i interface{}) error {
+	v, ok := i.(DustAssignment)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := DustAssignment_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid dust assignment: %d", v)
+	}
+
+	return nil
+}
+
+func validateDustPolicy(i interface{}) error {
+	v, ok := i.(DustPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := DustPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid dust policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateSupplySourceMode(i interface{}) error {
+	v, ok := i.(SupplySourceMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := SupplySourceMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid supply source mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateInflationCalculationMode(i interface{}) error {
+	v, ok := i.(InflationCalculationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationCalculationMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation calculation mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinAnnualCommunityFunding(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Amount.IsNil() {
+		return errors.New("min annual community funding amount cannot be nil")
+	}
+
+	return v.Validate()
+}
+
+func validateCommunityFundingPriority(i interface{}) error {
+	v, ok := i.([]CommunityFundingSource)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[CommunityFundingSource]bool)
+	for _, source := range v {
+		if _, ok := CommunityFundingSource_name[int32(source)]; !ok {
+			return fmt.Errorf("invalid community funding source: %d", source)
+		}
+		if seen[source] {
+			return fmt.Errorf("duplicated community funding source: %s", source)
+		}
+		seen[source] = true
+	}
+
+	return nil
+}
+
+func validateCommunityFundingWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("community funding window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateDriftCorrection(i interface{}) error {
+	v, ok := i.(DriftCorrection)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.MaxFactor.IsNil() {
+		return errors.New("drift correction max factor cannot be nil")
+	}
+	if v.MaxFactor.IsNegative() || v.MaxFactor.GTE(sdk.OneDec()) {
+		return fmt.Errorf("drift correction max factor must be in [0, 1): %s", v.MaxFactor)
+	}
+	if v.Horizon == 0 {
+		return fmt.Errorf("drift correction horizon must be positive: %d", v.Horizon)
+	}
+
+	return nil
+}
+
+func validateBootstrapOverride(i interface{}) error {
+	v, ok := i.(BootstrapOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Recipient == "" {
+		if v.EndHeight != 0 {
+			return fmt.Errorf("bootstrap override end height without recipient: %d", v.EndHeight)
+		}
+		return nil
+	}
+	if v.EndHeight <= 0 {
+		return fmt.Errorf("bootstrap override end height must be positive: %d", v.EndHeight)
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Recipient); err != nil && !isModuleName(v.Recipient) {
+		return fmt.Errorf("invalid bootstrap override recipient %q: %w", v.Recipient, err)
+	}
+
+	return nil
+}
+
+func validateMinBondedRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min bonded ratio cannot be nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min bonded ratio must be in [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateAutoPauseThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaintenanceInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("maintenance interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMintingInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v == 0 {
+		return fmt.Errorf("minting interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinCommunityPoolShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min community pool share cannot be nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min community pool share must be in [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateLargeChangeThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("large change threshold cannot be nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("large change threshold must be in [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateStakingRewardsRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil && !isModuleName(v) {
+		return fmt.Errorf("invalid staking rewards recipient %q: %w", v, err)
+	}
+
+	return nil
+}
+
+func validatePhases(i interface{}) error {
+	v, ok := i.([]Phase)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]bool, len(v))
+	for i, phase := range v {
+		if strings.TrimSpace(phase.Name) == "" {
+			return fmt.Errorf("phase %d name cannot be empty", i)
+		}
+		if names[phase.Name] {
+			return fmt.Errorf("duplicate phase %s", phase.Name)
+		}
+		names[phase.Name] = true
+		if phase.StartHeight <= 0 {
+			return fmt.Errorf("phase %s start height must be positive: %d", phase.Name, phase.StartHeight)
+		}
+		if i > 0 && phase.StartHeight <= v[i-1].StartHeight {
+			return fmt.Errorf(
+				"phase %s start height (%d) must be after phase %s start height (%d)",
+				phase.Name, phase.StartHeight, v[i-1].Name, v[i-1].StartHeight,
+			)
+		}
+		if err := validateDec(phase.InflationMin); err != nil {
+			return fmt.Errorf("phase %s min inflation: %w", phase.Name, err)
+		}
+		if err := validateDec(phase.InflationMax); err != nil {
+			return fmt.Errorf("phase %s max inflation: %w", phase.Name, err)
+		}
+		if phase.InflationMax.LT(phase.InflationMin) {
+			return fmt.Errorf(
+				"phase %s max inflation (%s) must be greater than or equal to min inflation (%s)",
+				phase.Name, phase.InflationMax, phase.InflationMin,
+			)
+		}
+		if err := ValidateGoalBonded(phase.GoalBonded); err != nil {
+			return fmt.Errorf("phase %s: %w", phase.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil value is equivalent to zero and leaves the supply unlimited
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateShortfallPolicy(i interface{}) error {
+	v, ok := i.(ShortfallPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ShortfallPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid shortfall policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateShortfallPriority(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, category := range v {
+		switch category {
+		case CategoryStaking, CategoryFundedAddresses, CategoryCommunityPool:
+		default:
+			return fmt.Errorf("invalid shortfall priority category: %s", category)
+		}
+		if seen[category] {
+			return fmt.Errorf("duplicated shortfall priority category: %s", category)
+		}
+		seen[category] = true
+	}
+	if len(seen) != len(DefaultShortfallPriority) {
+		return fmt.Errorf("shortfall priority must list every distribution category: %v", v)
+	}
+
+	return nil
+}
+
+func validateInflationSnapshotInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateInflationSnapshotRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateGoalBonded(v)
+}
+
+// ValidateGoalBonded checks the goal bonded ratio is in (0, 1]
+func ValidateGoalBonded(goalBonded sdk.Dec) error {
+	if goalBonded.IsNil() || !goalBonded.IsPositive() || goalBonded.GT(sdk.OneDec()) {
+		return errorsignite.Wrapf(ErrInvalidGoalBonded, "goal bonded should be in (0, 1], is %s", goalBonded)
+	}
 	return nil
 }
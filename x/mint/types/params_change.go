@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ParamsChangeSourceGenesis is the source of the params set from the genesis state
+	ParamsChangeSourceGenesis = "genesis"
+
+	// ParamsChangeSourceKeeper is the source of the params set directly through the keeper, by
+	// the upgrade handlers for instance
+	ParamsChangeSourceKeeper = "keeper"
+
+	// ParamsChangeSourceAutoPause is the source of the params pausing minting once the
+	// distribution of the minted coins failed for auto_pause_threshold consecutive blocks
+	ParamsChangeSourceAutoPause = "auto_pause"
+)
+
+// NewParamsChange returns a change of the params at the height of the context, the hash of the
+// transaction is set when the context holds the bytes of a transaction
+func NewParamsChange(ctx sdk.Context, authority, msgType string) ParamsChange {
+	change := ParamsChange{
+		Height:    ctx.BlockHeight(),
+		Authority: authority,
+		MsgType:   msgType,
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		change.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+	return change
+}
+
+// GenesisParamsChange returns the change of the params set from the genesis state
+func GenesisParamsChange() ParamsChange {
+	return ParamsChange{MsgType: ParamsChangeSourceGenesis}
+}
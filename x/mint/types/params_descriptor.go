@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// paramMetadata is the static description of a param
+type paramMetadata struct {
+	key    []byte
+	name   string
+	typ    string
+	bounds string
+}
+
+// paramsMetadata describes the params in the order of their proto fields, every param must be
+// described
+var paramsMetadata = []paramMetadata{
+	{KeyMintDenom, "mint_denom", "string", "valid coin denom"},
+	{KeyInflationRateChange, "inflation_rate_change", "cosmos.Dec", "(0, 1], at most inflation_max minus inflation_min"},
+	{KeyInflationMax, "inflation_max", "cosmos.Dec", "[0, 1], at least inflation_min"},
+	{KeyInflationMin, "inflation_min", "cosmos.Dec", "[0, 1], at most inflation_max"},
+	{KeyGoalBonded, "goal_bonded", "cosmos.Dec", "(0, 1]"},
+	{KeyBlocksPerYear, "blocks_per_year", "uint64", "positive"},
+	{KeyDistributionProportions, "distribution_proportions", "DistributionProportions", "non-negative ratios summing to 1"},
+	{KeyFundedAddresses, "funded_addresses", "repeated WeightedAddress", "empty, or valid addresses with weights in (0, 1] summing to 1, end heights after start heights and at most 10 stake weighted addresses"},
+	{KeyMinDistributableProvision, "min_distributable_provision", "cosmos.Int", "non-negative"},
+	{KeyPauseMinting, "pause_minting", "bool", "true or false"},
+	{KeyPauseStakingShare, "pause_staking_share", "bool", "true or false"},
+	{KeyPauseFundedShare, "pause_funded_share", "bool", "true or false"},
+	{KeyPauseCommunityShare, "pause_community_share", "bool", "true or false"},
+	{KeyPausedShareMode, "paused_share_mode", "PausedShareMode", enumBounds(PausedShareMode_name)},
+	{KeyDustAssignment, "dust_assignment", "DustAssignment", enumBounds(DustAssignment_name)},
+	{KeyEmitMintPlanned, "emit_mint_planned", "bool", "true or false"},
+	{KeySupplySourceMode, "supply_source_mode", "SupplySourceMode", enumBounds(SupplySourceMode_name)},
+	{KeyMinAnnualCommunityFunding, "min_annual_community_funding", "cosmos.base.v1beta1.Coin", "non-negative amount, in the mint denom if positive"},
+	{KeyCommunityFundingPriority, "community_funding_priority", "repeated CommunityFundingSource", "distinct values of " + enumBounds(CommunityFundingSource_name)},
+	{KeyCommunityFundingWindow, "community_funding_window", "uint64", "positive"},
+	{KeyDriftCorrection, "drift_correction", "DriftCorrection", "max_factor in [0, 1), positive horizon"},
+	{KeyLargeChangeThreshold, "large_change_threshold", "cosmos.Dec", "[0, 1]"},
+	{KeyStakingRewardsRecipient, "staking_rewards_recipient", "string", "empty, address or module account name"},
+	{KeyPhases, "phases", "repeated Phase", "empty, or named phases with increasing positive start heights, valid inflation bounds and goal bonded in (0, 1]"},
+	{KeyMaxSupply, "max_supply", "cosmos.Int", "non-negative, zero for an unlimited supply"},
+	{KeyShortfallPolicy, "shortfall_policy", "ShortfallPolicy", enumBounds(ShortfallPolicy_name)},
+	{KeyShortfallPriority, "shortfall_priority", "repeated string", "every distribution category once"},
+	{KeyInflationSnapshotInterval, "inflation_snapshot_interval", "uint64", "non-negative, zero to disable the snapshots"},
+	{KeyInflationSnapshotRetention, "inflation_snapshot_retention", "uint64", "zero to keep the snapshots forever, or at least inflation_snapshot_interval"},
+	{KeyMintConfigs, "mint_configs", "repeated MintConfig", "empty, or distinct denoms other than mint_denom with valid inflation schedules and distribution proportions"},
+	{KeyInflationCalculationMode, "inflation_calculation_mode", "InflationCalculationMode", enumBounds(InflationCalculationMode_name)},
+	{KeyBootstrapOverride, "bootstrap_override", "BootstrapOverride", "empty recipient and zero end_height, or address or module account name recipient and positive end_height"},
+	{KeyMinBondedRatio, "min_bonded_ratio", "cosmos.Dec", "[0, 1], zero to never skip the minting"},
+	{KeyAutoPauseThreshold, "auto_pause_threshold", "uint64", "consecutive failed distributions pausing minting, zero to never pause"},
+	{KeyDustPolicy, "dust_policy", "DustPolicy", enumBounds(DustPolicy_name)},
+	{KeyMaintenanceInterval, "maintenance_interval", "uint64", "positive"},
+	{KeyMintingInterval, "minting_interval", "uint64", "positive, 1 to mint every block"},
+	{KeyMinCommunityPoolShare, "min_community_pool_share", "cosmos.Dec", "[0, 1], zero for no minimum"},
+}
+
+// enumBounds lists the names of the values of an enum ordered by value
+func enumBounds(names map[int32]string) string {
+	values := make([]int32, 0, len(names))
+	for value := range names {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	list := make([]string, len(values))
+	for i, value := range values {
+		list[i] = names[value]
+	}
+	return "one of " + strings.Join(list, ", ")
+}
+
+// Describe returns the descriptors of the params in the order of their proto fields, with the
+// canonical JSON of their value and of their default value
+func (p Params) Describe() ([]ParamDescriptor, error) {
+	values, err := canonicalFields(p)
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := canonicalFields(DefaultParams())
+	if err != nil {
+		return nil, err
+	}
+
+	descriptors := make([]ParamDescriptor, len(paramsMetadata))
+	for i, metadata := range paramsMetadata {
+		value, ok := values[metadata.name]
+		if !ok {
+			return nil, fmt.Errorf("no param %s", metadata.name)
+		}
+		descriptors[i] = ParamDescriptor{
+			Name:    metadata.name,
+			Key:     string(metadata.key),
+			Type:    metadata.typ,
+			Value:   string(value),
+			Default: string(defaults[metadata.name]),
+			Bounds:  metadata.bounds,
+		}
+	}
+	return descriptors, nil
+}
+
+// canonicalFields returns the compact canonical JSON of the params fields by proto name
+func canonicalFields(p Params) (map[string]json.RawMessage, error) {
+	bz, err := p.MarshalCanonicalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	for name, field := range fields {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, field); err != nil {
+			return nil, err
+		}
+		fields[name] = compact.Bytes()
+	}
+	return fields, nil
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestParamsDescribe(t *testing.T) {
+	params := types.DefaultParams()
+	params.PauseMinting = true
+	params.GoalBonded = sdk.NewDecWithPrec(5, 1)
+	descriptors, err := params.Describe()
+	require.NoError(t, err)
+
+	t.Run("should describe every proto field of the params in order", func(t *testing.T) {
+		bz, err := codec.ProtoMarshalJSON(&types.Params{}, nil)
+		require.NoError(t, err)
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(bz, &fields))
+		require.Len(t, descriptors, len(fields))
+
+		var p types.Params
+		pairs := p.ParamSetPairs()
+		require.Len(t, descriptors, len(pairs))
+		for i, descriptor := range descriptors {
+			require.Contains(t, fields, descriptor.Name)
+			require.Equal(t, string(pairs[i].Key), descriptor.Key)
+			require.NotEmpty(t, descriptor.Type, descriptor.Name)
+			require.NotEmpty(t, descriptor.Bounds, descriptor.Name)
+		}
+	})
+
+	t.Run("should describe the current and default values", func(t *testing.T) {
+		values := make(map[string]json.RawMessage)
+		defaults := make(map[string]json.RawMessage)
+		for _, descriptor := range descriptors {
+			values[descriptor.Name] = json.RawMessage(descriptor.Value)
+			defaults[descriptor.Name] = json.RawMessage(descriptor.Default)
+		}
+		require.Equal(t, "true", string(values["pause_minting"]))
+		require.Equal(t, "false", string(defaults["pause_minting"]))
+		require.Equal(t, `"0.500000000000000000"`, string(values["goal_bonded"]))
+		require.Equal(t, `"0.670000000000000000"`, string(defaults["goal_bonded"]))
+
+		// the values and the defaults decode into the params
+		for _, tc := range []struct {
+			fields   map[string]json.RawMessage
+			expected types.Params
+		}{
+			{fields: values, expected: params},
+			{fields: defaults, expected: types.DefaultParams()},
+		} {
+			bz, err := json.Marshal(tc.fields)
+			require.NoError(t, err)
+			require.NoError(t, types.ValidateJSON(bz))
+			var decoded types.Params
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &decoded))
+			expected, err := tc.expected.MarshalCanonicalJSON()
+			require.NoError(t, err)
+			actual, err := decoded.MarshalCanonicalJSON()
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(actual))
+		}
+	})
+
+	t.Run("should list the values of the enums", func(t *testing.T) {
+		for _, descriptor := range descriptors {
+			if descriptor.Name == "paused_share_mode" {
+				require.Equal(t, "one of PAUSED_SHARE_MODE_COMMUNITY_POOL, PAUSED_SHARE_MODE_BUFFER", descriptor.Bounds)
+			}
+		}
+	})
+}
@@ -2,15 +2,46 @@ package types
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/ignite/modules/testutil/sample"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/stretchr/testify/require"
 )
 
 func TestParamsValidate(t *testing.T) {
+	communityFundingDenom := DefaultParams()
+	communityFundingDenom.MinAnnualCommunityFunding = sdk.NewInt64Coin("foo", 1000)
+	zeroRateChange := DefaultParams()
+	zeroRateChange.InflationRateChange = sdk.ZeroDec()
+	fastRateChange := DefaultParams()
+	fastRateChange.InflationRateChange = DefaultInflationMax.Sub(DefaultInflationMin).Add(sdk.SmallestDec())
+	fastestRateChange := DefaultParams()
+	fastestRateChange.InflationRateChange = DefaultInflationMax.Sub(DefaultInflationMin)
+	fixedInflation := DefaultParams()
+	fixedInflation.InflationMin = DefaultInflationMax
+	phases := DefaultParams()
+	phases.InflationRateChange = sdk.NewDecWithPrec(1, 2)
+	phases.Phases = []Phase{
+		newPhase("bootstrap", 5, sdk.NewDecWithPrec(15, 2), sdk.NewDecWithPrec(20, 2)),
+		newPhase("growth", 10, sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(10, 2)),
+	}
+	negativeMaxSupply := DefaultParams()
+	negativeMaxSupply.MaxSupply = sdkmath.NewInt(-1)
+	shortSnapshotRetention := DefaultParams()
+	shortSnapshotRetention.InflationSnapshotRetention = DefaultInflationSnapshotInterval - 1
+	noSnapshots := DefaultParams()
+	noSnapshots.InflationSnapshotInterval = 0
+	noSnapshots.InflationSnapshotRetention = 0
+	narrowPhase := phases
+	narrowPhase.Phases = []Phase{
+		newPhase("bootstrap", 5, sdk.NewDecWithPrec(15, 2), sdk.NewDecWithPrec(155, 3)),
+	}
+
 	tests := []struct {
 		name    string
 		params  Params
@@ -21,6 +52,16 @@ func TestParamsValidate(t *testing.T) {
 			params:  DefaultParams(),
 			isValid: true,
 		},
+		{
+			name:    "should validate params without inflation snapshots",
+			params:  noSnapshots,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate params with inflation snapshot retention lower than the interval",
+			params:  shortSnapshotRetention,
+			isValid: false,
+		},
 		{
 			name: "should prevent validate params with inflation max less than inflation min",
 			params: Params{
@@ -142,6 +183,46 @@ func TestParamsValidate(t *testing.T) {
 			},
 			isValid: false,
 		},
+		{
+			name:    "should prevent validate params with min annual community funding not in the mint denom",
+			params:  communityFundingDenom,
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate params with zero inflation rate change",
+			params:  zeroRateChange,
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate params with inflation rate change above the inflation range",
+			params:  fastRateChange,
+			isValid: false,
+		},
+		{
+			name:    "should validate params with inflation rate change equal to the inflation range",
+			params:  fastestRateChange,
+			isValid: true,
+		},
+		{
+			name:    "should validate params with any inflation rate change for a fixed inflation",
+			params:  fixedInflation,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate params with negative max supply",
+			params:  negativeMaxSupply,
+			isValid: false,
+		},
+		{
+			name:    "should validate params with phases",
+			params:  phases,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate params with inflation rate change above the inflation range of a phase",
+			params:  narrowPhase,
+			isValid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -210,6 +291,11 @@ func TestValidateDec(t *testing.T) {
 			value:   "string",
 			isValid: false,
 		},
+		{
+			name:    "should prevent validate nil dec",
+			value:   sdk.Dec{},
+			isValid: false,
+		},
 		{
 			name:    "should prevent validate dec with negative value",
 			value:   sdk.NewDec(-1),
@@ -233,6 +319,45 @@ func TestValidateDec(t *testing.T) {
 	}
 }
 
+func TestValidateInflationRateChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate valid inflation rate change",
+			value:   DefaultInflationRateChange,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate inflation rate change with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate zero inflation rate change",
+			value:   sdk.ZeroDec(),
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate inflation rate change too large a value",
+			value:   sdk.NewDec(2),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateInflationRateChange(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestValidateBlocksPerYear(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -319,6 +444,36 @@ func TestValidateDistributionProportions(t *testing.T) {
 			},
 			isValid: false,
 		},
+		{
+			name: "should validate distribution proportions with a strategic reserve ratio",
+			distrProportions: DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(3, 1), // 0.3
+				FundedAddresses:  sdk.NewDecWithPrec(4, 1), // 0.4
+				CommunityPool:    sdk.NewDecWithPrec(2, 1), // 0.2
+				StrategicReserve: sdk.NewDecWithPrec(1, 1), // 0.1
+			},
+			isValid: true,
+		},
+		{
+			name: "should prevent validate distribution proportions with negative strategic reserve ratio",
+			distrProportions: DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(3, 1),  // 0.3
+				FundedAddresses:  sdk.NewDecWithPrec(4, 1),  // 0.4
+				CommunityPool:    sdk.NewDecWithPrec(4, 1),  // 0.4
+				StrategicReserve: sdk.NewDecWithPrec(-1, 1), // -0.1
+			},
+			isValid: false,
+		},
+		{
+			name: "should prevent validate distribution proportions total ratio with strategic reserve not equal to 1",
+			distrProportions: DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(3, 1), // 0.3
+				FundedAddresses:  sdk.NewDecWithPrec(4, 1), // 0.4
+				CommunityPool:    sdk.NewDecWithPrec(3, 1), // 0.3
+				StrategicReserve: sdk.NewDecWithPrec(1, 1), // 0.1
+			},
+			isValid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -332,9 +487,25 @@ func TestValidateDistributionProportions(t *testing.T) {
 	}
 }
 
+// mixedCaseAddress returns the address with every other character in uppercase
+func mixedCaseAddress(address string) string {
+	var b strings.Builder
+	for i, c := range address {
+		if i%2 == 0 {
+			b.WriteString(strings.ToUpper(string(c)))
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
 func TestValidateWeightedAddresses(t *testing.T) {
 	s := rand.NewSource(1)
 	r := rand.New(s)
+	duplicatedAddr := sample.Address(r)
+	wrongPrefixAddr, err := bech32.ConvertAndEncode("osmo", sdk.MustAccAddressFromBech32(sample.Address(r)))
+	require.NoError(t, err)
 
 	tests := []struct {
 		name              string
@@ -355,6 +526,78 @@ func TestValidateWeightedAddresses(t *testing.T) {
 			},
 			isValid: true,
 		},
+		{
+			name: "should validate weighted addresses in pull payout mode",
+			weightedAddresses: []WeightedAddress{
+				{
+					Address:    sample.Address(r),
+					Weight:     sdk.OneDec(),
+					PayoutMode: PAYOUT_MODE_PULL,
+				},
+			},
+			isValid: true,
+		},
+		{
+			name: "should validate weighted addresses with funding windows",
+			weightedAddresses: []WeightedAddress{
+				{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(5, 1), StartHeight: 10, EndHeight: 20},
+				{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(3, 1), StartHeight: 10},
+				{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(2, 1), EndHeight: 20},
+			},
+			isValid: true,
+		},
+		{
+			name: "should prevent validate weighted address with end height at start height",
+			weightedAddresses: []WeightedAddress{
+				{Address: sample.Address(r), Weight: sdk.OneDec(), StartHeight: 10, EndHeight: 10},
+			},
+			isValid: false,
+		},
+		{
+			name: "should prevent validate weighted address with end height before start height",
+			weightedAddresses: []WeightedAddress{
+				{Address: sample.Address(r), Weight: sdk.OneDec(), StartHeight: 10, EndHeight: 5},
+			},
+			isValid: false,
+		},
+		{
+			name: "should prevent validate weighted address with negative start height",
+			weightedAddresses: []WeightedAddress{
+				{Address: sample.Address(r), Weight: sdk.OneDec(), StartHeight: -1},
+			},
+			isValid: false,
+		},
+		{
+			name: "should validate weighted addresses in uppercase and mixed-case",
+			weightedAddresses: []WeightedAddress{
+				{Address: strings.ToUpper(sample.Address(r)), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: mixedCaseAddress(sample.Address(r)), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			isValid: true,
+		},
+		{
+			name: "should prevent validate duplicated weighted addresses",
+			weightedAddresses: []WeightedAddress{
+				{Address: duplicatedAddr, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: duplicatedAddr, Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			isValid: false,
+		},
+		{
+			name: "should prevent validate weighted addresses duplicated after normalization",
+			weightedAddresses: []WeightedAddress{
+				{Address: duplicatedAddr, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: mixedCaseAddress(duplicatedAddr), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			isValid: false,
+		},
+		{
+			name: "should prevent validate weighted address with another prefix",
+			weightedAddresses: []WeightedAddress{
+				{Address: wrongPrefixAddr, Weight: sdk.OneDec()},
+			},
+			isValid: false,
+		},
 		{
 			name:              "should validate valid empty weighted addresses",
 			weightedAddresses: DefaultFundedAddresses,
@@ -385,6 +628,37 @@ func TestValidateWeightedAddresses(t *testing.T) {
 			},
 			isValid: false,
 		},
+		{
+			name: "should prevent validate weighed addresses with invalid payout mode",
+			weightedAddresses: []WeightedAddress{
+				{
+					Address:    sample.Address(r),
+					Weight:     sdk.OneDec(),
+					PayoutMode: PayoutMode(100),
+				},
+			},
+			isValid: false,
+		},
+		{
+			name: "should validate stake weighted addresses",
+			weightedAddresses: []WeightedAddress{
+				{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(3, 1), WeightMode: WEIGHT_MODE_STAKE_WEIGHTED},
+				{Address: sample.Address(r), Weight: sdk.NewDecWithPrec(2, 1), WeightMode: WEIGHT_MODE_STAKE_WEIGHTED},
+			},
+			isValid: true,
+		},
+		{
+			name: "should prevent validate weighed addresses with invalid weight mode",
+			weightedAddresses: []WeightedAddress{
+				{
+					Address:    sample.Address(r),
+					Weight:     sdk.OneDec(),
+					WeightMode: WeightMode(100),
+				},
+			},
+			isValid: false,
+		},
 		{
 			name: "should prevent validate weighed addresses with weight greater than 1",
 			weightedAddresses: []WeightedAddress{
@@ -421,3 +695,1219 @@ func TestValidateWeightedAddresses(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateWeightedAddressesErrors(t *testing.T) {
+	addr := sample.Address(sample.Rand())
+	wrongPrefixAddr, err := bech32.ConvertAndEncode("osmo", sdk.MustAccAddressFromBech32(addr))
+	require.NoError(t, err)
+
+	err = validateWeightedAddresses([]WeightedAddress{{Address: wrongPrefixAddr, Weight: sdk.OneDec()}})
+	require.ErrorIs(t, err, ErrInvalidFundedAddress)
+	require.ErrorContains(t, err, "invalid address at index 0: invalid Bech32 prefix; expected cosmos, got osmo")
+
+	err = validateWeightedAddresses([]WeightedAddress{
+		{Address: addr, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: strings.ToUpper(addr), Weight: sdk.NewDecWithPrec(5, 1)},
+	})
+	require.ErrorIs(t, err, ErrInvalidFundedAddress)
+	require.ErrorContains(t, err, "duplicate address "+addr+" at index 0 and 1")
+
+	r := sample.Rand()
+	stakeWeighted := make([]WeightedAddress, MaxStakeWeightedAddresses+1)
+	for i := range stakeWeighted {
+		stakeWeighted[i] = WeightedAddress{
+			Address:    sample.Address(r),
+			Weight:     sdk.NewDecWithPrec(9, 2),
+			WeightMode: WEIGHT_MODE_STAKE_WEIGHTED,
+		}
+	}
+	stakeWeighted[0].Weight = sdk.NewDecWithPrec(1, 1)
+	err = validateWeightedAddresses(stakeWeighted)
+	require.ErrorIs(t, err, ErrInvalidFundedAddress)
+	require.ErrorContains(t, err, "11 stake weighted addresses, the maximum is 10")
+
+	// the stake weighted addresses can be mixed with as many fixed addresses
+	stakeWeighted[0].WeightMode = WEIGHT_MODE_FIXED
+	require.NoError(t, validateWeightedAddresses(stakeWeighted))
+}
+
+func TestValidateMinDistributableProvision(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate valid min distributable provision",
+			value:   sdkmath.NewInt(100),
+			isValid: true,
+		},
+		{
+			name:    "should validate zero min distributable provision",
+			value:   DefaultMinDistributableProvision,
+			isValid: true,
+		},
+		{
+			name:    "should validate nil min distributable provision",
+			value:   sdkmath.Int{},
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate min distributable provision with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate negative min distributable provision",
+			value:   sdkmath.NewInt(-1),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMinDistributableProvision(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidatePausedShareMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate community pool paused share mode",
+			value:   PAUSED_SHARE_MODE_COMMUNITY_POOL,
+			isValid: true,
+		},
+		{
+			name:    "should validate buffer paused share mode",
+			value:   PAUSED_SHARE_MODE_BUFFER,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate paused share mode with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unknown paused share mode",
+			value:   PausedShareMode(100),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePausedShareMode(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDustAssignment(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate module account dust assignment",
+			value:   DUST_ASSIGNMENT_MODULE_ACCOUNT,
+			isValid: true,
+		},
+		{
+			name:    "should validate round robin dust assignment",
+			value:   DUST_ASSIGNMENT_ROUND_ROBIN,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate dust assignment with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unknown dust assignment",
+			value:   DustAssignment(100),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDustAssignment(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDustPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate community pool dust policy",
+			value:   DUST_POLICY_COMMUNITY_POOL,
+			isValid: true,
+		},
+		{
+			name:    "should validate first recipient dust policy",
+			value:   DUST_POLICY_FIRST_RECIPIENT,
+			isValid: true,
+		},
+		{
+			name:    "should validate carry over dust policy",
+			value:   DUST_POLICY_CARRY_OVER,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate dust policy with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unknown dust policy",
+			value:   DustPolicy(100),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDustPolicy(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMaintenanceInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate maintenance interval",
+			value:   uint64(100),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate maintenance interval with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate zero maintenance interval",
+			value:   uint64(0),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMaintenanceInterval(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMintingInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate minting interval",
+			value:   uint64(10),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate minting interval with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate zero minting interval",
+			value:   uint64(0),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMintingInterval(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateInflationCalculationMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate goal bonded inflation calculation mode",
+			value:   INFLATION_CALCULATION_MODE_GOAL_BONDED,
+			isValid: true,
+		},
+		{
+			name:    "should validate linear inflation calculation mode",
+			value:   INFLATION_CALCULATION_MODE_LINEAR,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate inflation calculation mode with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unknown inflation calculation mode",
+			value:   InflationCalculationMode(100),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateInflationCalculationMode(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// the inflation bounds and the goal bonded are validated in both modes
+	for _, mode := range []InflationCalculationMode{INFLATION_CALCULATION_MODE_GOAL_BONDED, INFLATION_CALCULATION_MODE_LINEAR} {
+		params := DefaultParams()
+		params.InflationCalculationMode = mode
+		require.NoError(t, params.Validate())
+
+		invalidBounds := params
+		invalidBounds.InflationMin = params.InflationMax.Add(sdk.SmallestDec())
+		require.Error(t, invalidBounds.Validate(), mode.String())
+
+		for _, goalBonded := range []sdk.Dec{sdk.ZeroDec(), sdk.NewDecWithPrec(11, 1)} {
+			invalidGoalBonded := params
+			invalidGoalBonded.GoalBonded = goalBonded
+			require.Error(t, invalidGoalBonded.Validate(), mode.String())
+		}
+	}
+}
+
+func TestValidateSupplySourceMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate replace supply source mode",
+			value:   SUPPLY_SOURCE_MODE_REPLACE,
+			isValid: true,
+		},
+		{
+			name:    "should validate max supply source mode",
+			value:   SUPPLY_SOURCE_MODE_MAX,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate supply source mode with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unknown supply source mode",
+			value:   SupplySourceMode(100),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSupplySourceMode(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMinAnnualCommunityFunding(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate zero min annual community funding",
+			value:   DefaultMinAnnualCommunityFunding,
+			isValid: true,
+		},
+		{
+			name:    "should validate positive min annual community funding",
+			value:   sdk.NewInt64Coin(DefaultMintDenom, 1000),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate min annual community funding with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate min annual community funding with nil amount",
+			value:   sdk.Coin{Denom: DefaultMintDenom},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate negative min annual community funding",
+			value:   sdk.Coin{Denom: DefaultMintDenom, Amount: sdkmath.NewInt(-1)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate min annual community funding with invalid denom",
+			value:   sdk.Coin{Denom: "", Amount: sdkmath.NewInt(1)},
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMinAnnualCommunityFunding(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateCommunityFundingPriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default community funding priority",
+			value:   DefaultCommunityFundingPriority,
+			isValid: true,
+		},
+		{
+			name:    "should validate empty community funding priority",
+			value:   []CommunityFundingSource{},
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate community funding priority with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unknown community funding source",
+			value:   []CommunityFundingSource{CommunityFundingSource(100)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate duplicated community funding source",
+			value:   []CommunityFundingSource{COMMUNITY_FUNDING_SOURCE_STAKING, COMMUNITY_FUNDING_SOURCE_STAKING},
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateCommunityFundingPriority(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateCommunityFundingWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default community funding window",
+			value:   DefaultCommunityFundingWindow,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate community funding window with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate zero community funding window",
+			value:   uint64(0),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateCommunityFundingWindow(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDriftCorrection(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default drift correction",
+			value:   DefaultDriftCorrection,
+			isValid: true,
+		},
+		{
+			name:    "should validate enabled drift correction",
+			value:   NewDriftCorrection(sdk.NewDecWithPrec(5, 2), 100),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate drift correction with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate drift correction with nil max factor",
+			value:   DriftCorrection{Horizon: 100},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate drift correction with negative max factor",
+			value:   NewDriftCorrection(sdk.NewDecWithPrec(-5, 2), 100),
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate drift correction with max factor of one",
+			value:   NewDriftCorrection(sdk.OneDec(), 100),
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate drift correction with zero horizon",
+			value:   NewDriftCorrection(sdk.ZeroDec(), 0),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDriftCorrection(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateLargeChangeThreshold(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default large change threshold",
+			value:   DefaultLargeChangeThreshold,
+			isValid: true,
+		},
+		{
+			name:    "should validate zero large change threshold",
+			value:   sdk.ZeroDec(),
+			isValid: true,
+		},
+		{
+			name:    "should validate large change threshold of one",
+			value:   sdk.OneDec(),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate large change threshold with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate nil large change threshold",
+			value:   sdk.Dec{},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate negative large change threshold",
+			value:   sdk.NewDecWithPrec(-5, 2),
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate large change threshold greater than one",
+			value:   sdk.MustNewDecFromStr("1.000000000000000001"),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateLargeChangeThreshold(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMinBondedRatio(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default min bonded ratio",
+			value:   DefaultMinBondedRatio,
+			isValid: true,
+		},
+		{
+			name:    "should validate min bonded ratio",
+			value:   sdk.NewDecWithPrec(5, 1),
+			isValid: true,
+		},
+		{
+			name:    "should validate min bonded ratio of one",
+			value:   sdk.OneDec(),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate min bonded ratio with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate nil min bonded ratio",
+			value:   sdk.Dec{},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate negative min bonded ratio",
+			value:   sdk.NewDecWithPrec(-1, 1),
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate min bonded ratio greater than one",
+			value:   sdk.MustNewDecFromStr("1.000000000000000001"),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMinBondedRatio(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	params := DefaultParams()
+	params.MinBondedRatio = sdk.NewDecWithPrec(15, 1)
+	require.ErrorContains(t, params.Validate(), "min bonded ratio must be in [0, 1]: 1.500000000000000000")
+}
+
+func TestParamsFieldErrors(t *testing.T) {
+	t.Run("should return no error for valid params", func(t *testing.T) {
+		require.Empty(t, DefaultParams().FieldErrors())
+	})
+
+	t.Run("should return the errors of every invalid param", func(t *testing.T) {
+		params := DefaultParams()
+		params.MintDenom = ""
+		params.BlocksPerYear = 0
+		params.InflationMin = sdk.NewDecWithPrec(3, 1)
+		params.InflationMax = sdk.NewDecWithPrec(2, 1)
+
+		fieldErrors := params.FieldErrors()
+		require.Len(t, fieldErrors, 3)
+		require.Equal(t, string(KeyMintDenom), fieldErrors[0].Field)
+		require.Equal(t, string(KeyBlocksPerYear), fieldErrors[1].Field)
+		require.Equal(t, string(KeyInflationMax), fieldErrors[2].Field)
+		for _, fieldError := range fieldErrors {
+			require.NotEmpty(t, fieldError.Error)
+		}
+	})
+
+	t.Run("should return the error of the inflation rate change above the inflation range", func(t *testing.T) {
+		params := DefaultParams()
+		params.InflationMax = sdk.NewDecWithPrec(1, 1)
+
+		fieldErrors := params.FieldErrors()
+		require.Len(t, fieldErrors, 1)
+		require.Equal(t, string(KeyInflationRateChange), fieldErrors[0].Field)
+	})
+}
+
+func newPhase(name string, startHeight int64, inflationMin, inflationMax sdk.Dec) Phase {
+	return Phase{
+		Name:         name,
+		StartHeight:  startHeight,
+		InflationMin: inflationMin,
+		InflationMax: inflationMax,
+		GoalBonded:   DefaultGoalBonded,
+	}
+}
+
+func TestValidatePhases(t *testing.T) {
+	low, high := sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(10, 2)
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate no phase",
+			value:   []Phase{},
+			isValid: true,
+		},
+		{
+			name:    "should validate ordered phases",
+			value:   []Phase{newPhase("a", 1, low, high), newPhase("b", 2, high, high)},
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate phases with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate phase with empty name",
+			value:   []Phase{newPhase(" ", 1, low, high)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate phases with duplicate names",
+			value:   []Phase{newPhase("a", 1, low, high), newPhase("a", 2, low, high)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate phase with zero start height",
+			value:   []Phase{newPhase("a", 0, low, high)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate overlapping phases",
+			value:   []Phase{newPhase("a", 2, low, high), newPhase("b", 2, low, high)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unordered phases",
+			value:   []Phase{newPhase("a", 3, low, high), newPhase("b", 2, low, high)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate phase with nil inflation bound",
+			value:   []Phase{newPhase("a", 1, sdk.Dec{}, high)},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate phase with max inflation less than min inflation",
+			value:   []Phase{newPhase("a", 1, high, low)},
+			isValid: false,
+		},
+		{
+			name: "should prevent validate phase with invalid goal bonded",
+			value: []Phase{{
+				Name:         "a",
+				StartHeight:  1,
+				InflationMin: low,
+				InflationMax: high,
+				GoalBonded:   sdk.ZeroDec(),
+			}},
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePhases(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMaxSupply(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default max supply",
+			value:   DefaultMaxSupply,
+			isValid: true,
+		},
+		{
+			name:    "should validate nil max supply",
+			value:   sdkmath.Int{},
+			isValid: true,
+		},
+		{
+			name:    "should validate positive max supply",
+			value:   sdkmath.NewInt(1_000_000),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate max supply with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate negative max supply",
+			value:   sdkmath.NewInt(-1),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMaxSupply(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateShortfallPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate pro rata policy",
+			value:   SHORTFALL_POLICY_PRO_RATA,
+			isValid: true,
+		},
+		{
+			name:    "should validate priority policy",
+			value:   SHORTFALL_POLICY_PRIORITY,
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate policy with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate unknown policy",
+			value:   ShortfallPolicy(2),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateShortfallPolicy(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateShortfallPriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default shortfall priority",
+			value:   DefaultShortfallPriority,
+			isValid: true,
+		},
+		{
+			name:    "should validate reordered shortfall priority",
+			value:   []string{CategoryCommunityPool, CategoryStaking, CategoryFundedAddresses},
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate shortfall priority with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate empty shortfall priority",
+			value:   []string{},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate shortfall priority with missing category",
+			value:   []string{CategoryStaking, CategoryCommunityPool},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate shortfall priority with duplicated category",
+			value:   []string{CategoryStaking, CategoryStaking, CategoryCommunityPool},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate shortfall priority with unknown category",
+			value:   []string{CategoryStaking, CategoryFundedAddresses, CategoryCommunityPool, CounterDust},
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateShortfallPriority(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateBootstrapOverride(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default bootstrap override",
+			value:   DefaultBootstrapOverride,
+			isValid: true,
+		},
+		{
+			name:    "should validate bootstrap override to an address",
+			value:   BootstrapOverride{Recipient: sample.Address(sample.Rand()), EndHeight: 100},
+			isValid: true,
+		},
+		{
+			name:    "should validate bootstrap override to a module account",
+			value:   BootstrapOverride{Recipient: "community_pool", EndHeight: 100},
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate bootstrap override with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate bootstrap override with end height and no recipient",
+			value:   BootstrapOverride{EndHeight: 100},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate bootstrap override with zero end height",
+			value:   BootstrapOverride{Recipient: sample.Address(sample.Rand())},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate bootstrap override with negative end height",
+			value:   BootstrapOverride{Recipient: sample.Address(sample.Rand()), EndHeight: -1},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate bootstrap override with invalid address",
+			value:   BootstrapOverride{Recipient: "cosmos1invalid", EndHeight: 100},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate bootstrap override with invalid recipient",
+			value:   BootstrapOverride{Recipient: "Invalid-Recipient", EndHeight: 100},
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateBootstrapOverride(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateStakingRewardsRecipient(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default staking rewards recipient",
+			value:   DefaultStakingRewardsRecipient,
+			isValid: true,
+		},
+		{
+			name:    "should validate staking rewards recipient address",
+			value:   sample.Address(sample.Rand()),
+			isValid: true,
+		},
+		{
+			name:    "should validate staking rewards recipient module account",
+			value:   "fee_abstraction",
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate staking rewards recipient with invalid interface",
+			value:   10,
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate staking rewards recipient with invalid address",
+			value:   "cosmos1invalid",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate staking rewards recipient with invalid recipient",
+			value:   "Invalid-Recipient",
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateStakingRewardsRecipient(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMinCommunityPoolShare(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		isValid bool
+	}{
+		{
+			name:    "should validate default min community pool share",
+			value:   DefaultMinCommunityPoolShare,
+			isValid: true,
+		},
+		{
+			name:    "should validate min community pool share of one",
+			value:   sdk.OneDec(),
+			isValid: true,
+		},
+		{
+			name:    "should prevent validate min community pool share with invalid interface",
+			value:   "string",
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate nil min community pool share",
+			value:   sdk.Dec{},
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate negative min community pool share",
+			value:   sdk.NewDecWithPrec(-5, 2),
+			isValid: false,
+		},
+		{
+			name:    "should prevent validate min community pool share greater than one",
+			value:   sdk.MustNewDecFromStr("1.000000000000000001"),
+			isValid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMinCommunityPoolShare(tc.value)
+			if !tc.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsValidateCommunityPoolShare(t *testing.T) {
+	fundedAddresses := []WeightedAddress{{Address: sample.Address(sample.Rand()), Weight: sdk.OneDec()}}
+	newParams := func(staking, funded, community string, minShare string) Params {
+		params := DefaultParams()
+		params.DistributionProportions = DistributionProportions{
+			Staking:          sdk.MustNewDecFromStr(staking),
+			FundedAddresses:  sdk.MustNewDecFromStr(funded),
+			CommunityPool:    sdk.MustNewDecFromStr(community),
+			StrategicReserve: sdk.ZeroDec(),
+		}
+		params.FundedAddresses = fundedAddresses
+		params.MinCommunityPoolShare = sdk.MustNewDecFromStr(minShare)
+		return params
+	}
+	withoutFundedAddresses := func(params Params) Params {
+		params.FundedAddresses = nil
+		return params
+	}
+	withMintConfig := func(params Params, community string) Params {
+		staking := sdk.OneDec().Sub(sdk.MustNewDecFromStr(community))
+		params.MintConfigs = []MintConfig{NewMintConfig(
+			"foo",
+			DefaultInflationRateChange,
+			DefaultInflationMax,
+			DefaultInflationMin,
+			DefaultGoalBonded,
+			DistributionProportions{
+				Staking:          staking,
+				FundedAddresses:  sdk.ZeroDec(),
+				CommunityPool:    sdk.MustNewDecFromStr(community),
+				StrategicReserve: sdk.ZeroDec(),
+			},
+		)}
+		return params
+	}
+
+	withBootstrapOverride := func(params Params) Params {
+		params.BootstrapOverride = BootstrapOverride{Recipient: sample.Address(sample.Rand()), EndHeight: 100}
+		return params
+	}
+	withPausedCommunityShare := func(params Params) Params {
+		params.PauseCommunityShare = true
+		return params
+	}
+
+	tests := []struct {
+		name   string
+		params Params
+		share  sdk.Dec
+		err    string
+	}{
+		{
+			name:   "should validate a community pool share above the minimum",
+			params: newParams("0.5", "0.4", "0.1", "0.05"),
+			share:  sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:   "should validate a community pool share equal to the minimum",
+			params: newParams("0.55", "0.4", "0.05", "0.05"),
+			share:  sdk.MustNewDecFromStr("0.05"),
+		},
+		{
+			name:   "should prevent validate a community pool share below the minimum",
+			params: newParams("0.551", "0.4", "0.049", "0.05"),
+			share:  sdk.MustNewDecFromStr("0.049"),
+			err:    "community pool share 0.049000000000000000 is lower than the required minimum 0.050000000000000000",
+		},
+		{
+			name:   "should validate all the minted coins sent to the community pool with a minimum of one",
+			params: newParams("0", "0", "1", "1"),
+			share:  sdk.OneDec(),
+		},
+		{
+			name:   "should validate an implied community pool share equal to the minimum",
+			params: withoutFundedAddresses(newParams("0.95", "0.05", "0", "0.05")),
+			share:  sdk.MustNewDecFromStr("0.05"),
+		},
+		{
+			name:   "should prevent validate an implied community pool share below the minimum",
+			params: withoutFundedAddresses(newParams("0.96", "0.03", "0.01", "0.05")),
+			share:  sdk.MustNewDecFromStr("0.04"),
+			err:    "community pool share 0.040000000000000000 is lower than the required minimum 0.050000000000000000",
+		},
+		{
+			name:   "should validate a mint configuration with a community pool share equal to the minimum",
+			params: withMintConfig(newParams("0.5", "0.4", "0.1", "0.05"), "0.05"),
+			share:  sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:   "should prevent validate a mint configuration with a community pool share below the minimum",
+			params: withMintConfig(newParams("0.5", "0.4", "0.1", "0.05"), "0.04"),
+			share:  sdk.MustNewDecFromStr("0.1"),
+			err:    "mint config foo: community pool share 0.040000000000000000 is lower than the required minimum 0.050000000000000000",
+		},
+		{
+			name:   "should validate a bootstrap override without minimum",
+			params: withBootstrapOverride(newParams("0.5", "0.4", "0.1", "0")),
+			share:  sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:   "should prevent validate a bootstrap override with a minimum",
+			params: withBootstrapOverride(newParams("0.5", "0.4", "0.1", "0.05")),
+			share:  sdk.MustNewDecFromStr("0.1"),
+			err:    "bypasses the required minimum community pool share 0.050000000000000000",
+		},
+		{
+			name:   "should validate a paused community pool share without minimum",
+			params: withPausedCommunityShare(newParams("0.5", "0.4", "0.1", "0")),
+			share:  sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:   "should prevent validate a paused community pool share with a minimum",
+			params: withPausedCommunityShare(newParams("0.5", "0.4", "0.1", "0.05")),
+			share:  sdk.MustNewDecFromStr("0.1"),
+			err:    "paused community pool share bypasses the required minimum community pool share 0.050000000000000000",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.share.Equal(tc.params.CommunityPoolShare()), tc.params.CommunityPoolShare().String())
+			err := tc.params.Validate()
+			if tc.err != "" {
+				require.ErrorIs(t, err, ErrInvalidProportions)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("should return the community pool share error on the distribution proportions", func(t *testing.T) {
+		fieldErrors := newParams("0.551", "0.4", "0.049", "0.05").FieldErrors()
+		require.Len(t, fieldErrors, 1)
+		require.Equal(t, string(KeyDistributionProportions), fieldErrors[0].Field)
+	})
+}
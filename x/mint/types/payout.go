@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BookPayout adds the share of a funded address in pull payout mode to its pending payout
+func (m *Minter) BookPayout(address string, height int64, coins sdk.Coins) {
+	if coins.IsZero() {
+		return
+	}
+	for i, payout := range m.PendingPayouts {
+		if payout.Address == address {
+			m.PendingPayouts[i].Amount = payout.Amount.Add(coins...)
+			m.PendingPayouts[i].ToHeight = height
+			return
+		}
+	}
+	m.PendingPayouts = append(m.PendingPayouts, PendingPayout{
+		Address:    address,
+		Amount:     coins,
+		FromHeight: height,
+		ToHeight:   height,
+	})
+}
+
+// GetPendingPayout returns the pending payout of a funded address
+func (m Minter) GetPendingPayout(address string) (PendingPayout, bool) {
+	for _, payout := range m.PendingPayouts {
+		if payout.Address == address {
+			return payout, true
+		}
+	}
+	return PendingPayout{}, false
+}
+
+// ClaimPayout removes the pending payout of a funded address and returns it
+func (m *Minter) ClaimPayout(address string) (PendingPayout, bool) {
+	for i, payout := range m.PendingPayouts {
+		if payout.Address == address {
+			m.PendingPayouts = append(m.PendingPayouts[:i:i], m.PendingPayouts[i+1:]...)
+			return payout, true
+		}
+	}
+	return PendingPayout{}, false
+}
+
+// TotalPendingPayouts returns the sum of the pending payouts
+func (m Minter) TotalPendingPayouts() sdk.Coins {
+	var total sdk.Coins
+	for _, payout := range m.PendingPayouts {
+		total = total.Add(payout.Amount...)
+	}
+	return total
+}
+
+// Validate checks the address and the amount of the pending payout and its height range
+func (p PendingPayout) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid pending payout address %s: %w", p.Address, err)
+	}
+	if err := p.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid pending payout amount of %s: %w", p.Address, err)
+	}
+	if p.FromHeight < 0 || p.ToHeight < p.FromHeight {
+		return fmt.Errorf("invalid pending payout height range of %s: %d to %d", p.Address, p.FromHeight, p.ToHeight)
+	}
+	return nil
+}
+
+func validatePendingPayouts(payouts []PendingPayout) error {
+	addresses := make(map[string]struct{}, len(payouts))
+	for _, payout := range payouts {
+		if err := payout.Validate(); err != nil {
+			return err
+		}
+		if _, ok := addresses[payout.Address]; ok {
+			return fmt.Errorf("duplicated pending payout of %s", payout.Address)
+		}
+		addresses[payout.Address] = struct{}{}
+	}
+	return nil
+}
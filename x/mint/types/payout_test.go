@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMinterPendingPayouts(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	r := sample.Rand()
+	addr1, addr2 := sample.Address(r), sample.Address(r)
+	minter := types.DefaultInitialMinter()
+
+	minter.BookPayout(addr1, 10, stake(5))
+	minter.BookPayout(addr2, 11, stake(3))
+	minter.BookPayout(addr1, 12, stake(5))
+	minter.BookPayout(addr1, 13, nil)
+	require.Equal(t, stake(13), minter.TotalPendingPayouts())
+	require.NoError(t, minter.Validate())
+
+	payout, found := minter.GetPendingPayout(addr1)
+	require.True(t, found)
+	require.Equal(t, types.PendingPayout{Address: addr1, Amount: stake(10), FromHeight: 10, ToHeight: 12}, payout)
+
+	claimed, found := minter.ClaimPayout(addr1)
+	require.True(t, found)
+	require.Equal(t, payout, claimed)
+	_, found = minter.ClaimPayout(addr1)
+	require.False(t, found)
+	require.Equal(t, []types.PendingPayout{
+		{Address: addr2, Amount: stake(3), FromHeight: 11, ToHeight: 11},
+	}, minter.PendingPayouts)
+
+	// a new payout starts from the height of the first share after the claim
+	minter.BookPayout(addr1, 20, stake(1))
+	payout, _ = minter.GetPendingPayout(addr1)
+	require.EqualValues(t, 20, payout.FromHeight)
+}
+
+func TestPendingPayoutValidate(t *testing.T) {
+	addr := sample.Address(sample.Rand())
+	tests := []struct {
+		name    string
+		payouts []types.PendingPayout
+		err     string
+	}{
+		{
+			name: "should validate pending payouts",
+			payouts: []types.PendingPayout{
+				{Address: addr, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), FromHeight: 1, ToHeight: 2},
+			},
+		},
+		{
+			name:    "should prevent validate an invalid address",
+			payouts: []types.PendingPayout{{Address: "invalid"}},
+			err:     "invalid pending payout address",
+		},
+		{
+			name: "should prevent validate an invalid amount",
+			payouts: []types.PendingPayout{
+				{Address: addr, Amount: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}},
+			},
+			err: "invalid pending payout amount",
+		},
+		{
+			name:    "should prevent validate an invalid height range",
+			payouts: []types.PendingPayout{{Address: addr, FromHeight: 2, ToHeight: 1}},
+			err:     "invalid pending payout height range",
+		},
+		{
+			name:    "should prevent validate duplicated pending payouts",
+			payouts: []types.PendingPayout{{Address: addr}, {Address: addr}},
+			err:     "duplicated pending payout",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			minter := types.DefaultInitialMinter()
+			minter.PendingPayouts = tc.payouts
+			err := minter.Validate()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+// ActivePhase returns the phase of the schedule active at the height, the last phase started at
+// or before the height. No phase is active before the start of the first phase.
+func (p Params) ActivePhase(height int64) (Phase, bool) {
+	for i := len(p.Phases) - 1; i >= 0; i-- {
+		if p.Phases[i].StartHeight <= height {
+			return p.Phases[i], true
+		}
+	}
+	return Phase{}, false
+}
+
+// NextPhaseStart returns the start height of the first phase of the schedule starting after the
+// height, false if no phase starts after the height
+func (p Params) NextPhaseStart(height int64) (int64, bool) {
+	next, found := int64(0), false
+	for _, phase := range p.Phases {
+		if phase.StartHeight > height && (!found || phase.StartHeight < next) {
+			next, found = phase.StartHeight, true
+		}
+	}
+	return next, found
+}
+
+// WithPhase returns the params with the inflation bounds and the goal bonded of the phase
+func (p Params) WithPhase(phase Phase) Params {
+	p.InflationMin = phase.InflationMin
+	p.InflationMax = phase.InflationMax
+	p.GoalBonded = phase.GoalBonded
+	return p
+}
+
+// AtHeight returns the params applied at the height: the params with the inflation bounds and the
+// goal bonded of the phase active at the height, or the params if no phase is active
+func (p Params) AtHeight(height int64) Params {
+	if phase, ok := p.ActivePhase(height); ok {
+		return p.WithPhase(phase)
+	}
+	return p
+}
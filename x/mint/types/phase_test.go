@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsAtHeight(t *testing.T) {
+	params := DefaultParams()
+	params.Phases = []Phase{
+		newPhase("bootstrap", 5, sdk.NewDecWithPrec(15, 2), sdk.NewDecWithPrec(20, 2)),
+		newPhase("growth", 10, sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(10, 2)),
+	}
+	params.Phases[1].GoalBonded = sdk.NewDecWithPrec(50, 2)
+
+	t.Run("should return the params before the first phase", func(t *testing.T) {
+		_, active := params.ActivePhase(4)
+		require.False(t, active)
+		require.Equal(t, params, params.AtHeight(4))
+	})
+
+	t.Run("should apply the phase from its start height", func(t *testing.T) {
+		for height, phase := range map[int64]Phase{5: params.Phases[0], 9: params.Phases[0], 10: params.Phases[1], 100: params.Phases[1]} {
+			active, ok := params.ActivePhase(height)
+			require.True(t, ok)
+			require.Equal(t, phase, active)
+
+			atHeight := params.AtHeight(height)
+			require.Equal(t, phase.InflationMin, atHeight.InflationMin)
+			require.Equal(t, phase.InflationMax, atHeight.InflationMax)
+			require.Equal(t, phase.GoalBonded, atHeight.GoalBonded)
+			require.Equal(t, params.InflationRateChange, atHeight.InflationRateChange)
+			require.Equal(t, params.Phases, atHeight.Phases)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+const (
+	// ProfileMainnet is the profile of the module defaults
+	ProfileMainnet = "mainnet"
+	// ProfileTestnet is the profile of incentivized testnets, the planned emissions are announced
+	// and a lower share of the supply is expected to be bonded
+	ProfileTestnet = "testnet"
+	// ProfileDevnet is the profile of development networks with 1 second block times and an
+	// inflation rate adjusting within a few blocks
+	ProfileDevnet = "devnet"
+)
+
+var (
+	profilesMu sync.RWMutex
+	profiles   = map[string]GenesisState{}
+)
+
+func init() {
+	mainnet := *DefaultGenesis()
+
+	testnet := *DefaultGenesis()
+	testnet.Params.GoalBonded = sdk.NewDecWithPrec(50, 2)
+	testnet.Params.EmitMintPlanned = true
+
+	devnet := *DefaultGenesis()
+	// the inflation moves across its bounds in a year at most
+	devnet.Params.InflationRateChange = devnet.Params.InflationMax.Sub(devnet.Params.InflationMin)
+	devnet.Params.BlocksPerYear = uint64(60 * 60 * 8766) // assuming 1 second block times
+	devnet.Params.EmitMintPlanned = true
+	devnet.Params.CommunityFundingWindow = uint64(60 * 60)
+	devnet.Params.DriftCorrection.Horizon = uint64(60 * 60 * 24)
+
+	for name, genesis := range map[string]GenesisState{
+		ProfileMainnet: mainnet,
+		ProfileTestnet: testnet,
+		ProfileDevnet:  devnet,
+	} {
+		if err := RegisterProfile(name, genesis.Params, genesis.Minter); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterProfile registers a named profile of default params and initial minter. The profile
+// must not be registered yet and its params and minter must be valid.
+func RegisterProfile(name string, params Params, minter Minter) error {
+	if name == "" {
+		return errors.Wrap(ErrInvalidProfile, "empty profile name")
+	}
+	genesis := GenesisState{Params: params, Minter: minter}
+	if err := genesis.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidProfile, "profile %s: %s", name, err)
+	}
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+	if _, ok := profiles[name]; ok {
+		return errors.Wrapf(ErrInvalidProfile, "profile %s already registered", name)
+	}
+	profiles[name] = genesis
+	return nil
+}
+
+// Profiles returns the names of the registered profiles, sorted
+func Profiles() []string {
+	profilesMu.RLock()
+	defer profilesMu.RUnlock()
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultParamsForProfile returns the default params of a registered profile
+func DefaultParamsForProfile(profile string) (Params, error) {
+	genesis, err := DefaultGenesisForProfile(profile)
+	if err != nil {
+		return Params{}, err
+	}
+	return genesis.Params, nil
+}
+
+// DefaultInitialMinterForProfile returns the initial minter of a registered profile
+func DefaultInitialMinterForProfile(profile string) (Minter, error) {
+	genesis, err := DefaultGenesisForProfile(profile)
+	if err != nil {
+		return Minter{}, err
+	}
+	return genesis.Minter, nil
+}
+
+// DefaultGenesisForProfile returns the default genesis state of a registered profile
+func DefaultGenesisForProfile(profile string) (*GenesisState, error) {
+	profilesMu.RLock()
+	genesis, ok := profiles[profile]
+	profilesMu.RUnlock()
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownProfile, "%s, expected one of %v", profile, Profiles())
+	}
+
+	// the slices of the params are copied so the registered profile can't be altered
+	genesis.Params.FundedAddresses = append([]WeightedAddress(nil), genesis.Params.FundedAddresses...)
+	genesis.Params.CommunityFundingPriority = append([]CommunityFundingSource(nil), genesis.Params.CommunityFundingPriority...)
+	return &genesis, nil
+}
+
+// MustDefaultGenesisForProfile returns the default genesis state of a registered profile, it
+// panics if the profile is unknown
+func MustDefaultGenesisForProfile(profile string) *GenesisState {
+	genesis, err := DefaultGenesisForProfile(profile)
+	if err != nil {
+		panic(fmt.Sprintf("mint profile: %s", err))
+	}
+	return genesis
+}
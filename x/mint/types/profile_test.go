@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestBuiltInProfiles(t *testing.T) {
+	for _, profile := range []string{types.ProfileMainnet, types.ProfileTestnet, types.ProfileDevnet} {
+		t.Run(profile, func(t *testing.T) {
+			require.Contains(t, types.Profiles(), profile)
+
+			params, err := types.DefaultParamsForProfile(profile)
+			require.NoError(t, err)
+			require.NoError(t, params.Validate())
+
+			minter, err := types.DefaultInitialMinterForProfile(profile)
+			require.NoError(t, err)
+			require.NoError(t, minter.Validate())
+		})
+	}
+
+	params, err := types.DefaultParamsForProfile(types.ProfileMainnet)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+	minter, err := types.DefaultInitialMinterForProfile(types.ProfileMainnet)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultInitialMinter(), minter)
+}
+
+func TestDefaultParamsForProfile(t *testing.T) {
+	t.Run("should fail for an unknown profile", func(t *testing.T) {
+		_, err := types.DefaultParamsForProfile("foo")
+		require.ErrorIs(t, err, types.ErrUnknownProfile)
+		require.ErrorContains(t, err, "expected one of")
+
+		_, err = types.DefaultInitialMinterForProfile("foo")
+		require.ErrorIs(t, err, types.ErrUnknownProfile)
+		require.Panics(t, func() {
+			types.MustDefaultGenesisForProfile("foo")
+		})
+	})
+
+	t.Run("should not alter the registered profile", func(t *testing.T) {
+		params, err := types.DefaultParamsForProfile(types.ProfileDevnet)
+		require.NoError(t, err)
+		params.CommunityFundingPriority[0] = types.COMMUNITY_FUNDING_SOURCE_STAKING
+
+		params, err = types.DefaultParamsForProfile(types.ProfileDevnet)
+		require.NoError(t, err)
+		require.Equal(t, types.DefaultCommunityFundingPriority, params.CommunityFundingPriority)
+	})
+}
+
+func TestRegisterProfile(t *testing.T) {
+	params := types.DefaultParams()
+	params.MintDenom = "foo"
+	params.MinAnnualCommunityFunding = sdk.NewInt64Coin("foo", 0)
+	minter := types.InitialMinter(sdk.NewDecWithPrec(1, 1))
+
+	require.NoError(t, types.RegisterProfile("register-test", params, minter))
+	registered, err := types.DefaultParamsForProfile("register-test")
+	require.NoError(t, err)
+	require.Equal(t, params, registered)
+	registeredMinter, err := types.DefaultInitialMinterForProfile("register-test")
+	require.NoError(t, err)
+	require.Equal(t, minter, registeredMinter)
+
+	require.ErrorIs(t, types.RegisterProfile("register-test", params, minter), types.ErrInvalidProfile)
+	require.ErrorIs(t, types.RegisterProfile(types.ProfileMainnet, params, minter), types.ErrInvalidProfile)
+	require.ErrorIs(t, types.RegisterProfile("", params, minter), types.ErrInvalidProfile)
+
+	params.BlocksPerYear = 0
+	require.ErrorIs(t, types.RegisterProfile("register-test-invalid", params, minter), types.ErrInvalidProfile)
+	_, err = types.DefaultParamsForProfile("register-test-invalid")
+	require.ErrorIs(t, err, types.ErrUnknownProfile)
+}
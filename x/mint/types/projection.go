@@ -0,0 +1,99 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxProjectionSteps bounds the number of steps simulated to project the emissions of a year
+const MaxProjectionSteps = 1000
+
+// ProjectEmissions projects the emissions of the year of blocks from the height with the mint
+// schedule of the minter and the params, with a constant bonded ratio. The year is simulated in
+// at most MaxProjectionSteps steps of consecutive blocks, plus a step for each phase starting in
+// the year, each step minting the provision of all its blocks at once.
+func ProjectEmissions(
+	minter Minter,
+	params Params,
+	height int64,
+	bondedRatio sdk.Dec,
+	stakingSupply,
+	supply sdkmath.Int,
+) EmissionProjection {
+	steps := params.BlocksPerYear
+	if steps > MaxProjectionSteps {
+		steps = MaxProjectionSteps
+	}
+	blocksPerStep := (params.BlocksPerYear + steps - 1) / steps
+	endHeight := height + int64(params.BlocksPerYear)
+
+	schedule := NewMintSchedule(minter, params, bondedRatio, stakingSupply, supply)
+	total := sdkmath.ZeroInt()
+	for stepHeight := height; stepHeight < endHeight; {
+		// a step doesn't cross the end of the year nor the start of a phase
+		stepEnd := stepHeight + int64(blocksPerStep)
+		if stepEnd > endHeight {
+			stepEnd = endHeight
+		}
+		if phaseStart, ok := params.NextPhaseStart(stepHeight); ok && phaseStart < stepEnd {
+			stepEnd = phaseStart
+		}
+		minted, _ := schedule.Step(stepHeight, uint64(stepEnd-stepHeight))
+		total = total.Add(minted)
+		stepHeight = stepEnd
+	}
+
+	return EmissionProjection{
+		Total:      total,
+		Categories: ProjectShares(params, total),
+		Inflation:  schedule.Minter.Inflation,
+	}
+}
+
+// ProjectShares returns the amounts distributed to each category from an amount of minted coins.
+// The shares of the paused categories are redirected to the community pool or held in the minter
+// depending on the paused share mode, the share of the funded addresses is sent to the community
+// pool when there is no funded address.
+func ProjectShares(params Params, amount sdkmath.Int) CategoryTotals {
+	proportions := params.DistributionProportions
+	shares := NewCategoryTotals()
+	shares.Staking = sdk.NewDecFromInt(amount).Mul(proportions.Staking).TruncateInt()
+	shares.FundedAddresses = sdk.NewDecFromInt(amount).Mul(proportions.FundedAddresses).TruncateInt()
+	shares.StrategicReserve = sdk.NewDecFromInt(amount).Mul(proportions.StrategicReserveRatio()).TruncateInt()
+	shares.CommunityPool = amount.Sub(shares.Staking).Sub(shares.FundedAddresses).Sub(shares.StrategicReserve)
+	return ProjectCategoryShares(params, shares)
+}
+
+// ProjectCategoryShares returns the amounts distributed to each category from the shares of the
+// categories of an amount of minted coins, like ProjectShares. The strategic reserve share is
+// never paused nor redirected.
+func ProjectCategoryShares(params Params, shares CategoryTotals) CategoryTotals {
+	shares.Normalize()
+	staking, funded, community := shares.Staking, shares.FundedAddresses, shares.CommunityPool
+
+	redirect := func(share *sdkmath.Int, paused bool) {
+		if !paused {
+			return
+		}
+		if params.PausedShareMode == PAUSED_SHARE_MODE_COMMUNITY_POOL {
+			community = community.Add(*share)
+		}
+		*share = sdkmath.ZeroInt()
+	}
+	redirect(&staking, params.PauseStakingShare)
+	redirect(&funded, params.PauseFundedShare)
+	if len(params.FundedAddresses) == 0 {
+		community = community.Add(funded)
+		funded = sdkmath.ZeroInt()
+	}
+	if params.PauseCommunityShare {
+		community = sdkmath.ZeroInt()
+	}
+
+	totals := NewCategoryTotals()
+	totals.Staking = staking
+	totals.FundedAddresses = funded
+	totals.CommunityPool = community
+	totals.StrategicReserve = shares.StrategicReserve
+	return totals
+}
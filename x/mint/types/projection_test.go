@@ -0,0 +1,254 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestProjectEmissions(t *testing.T) {
+	supply := sdkmath.NewInt(1_000_000_000)
+	minter := types.InitialMinter(sdk.NewDecWithPrec(10, 2))
+
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	params.InflationMin = sdk.ZeroDec()
+	params.InflationMax = sdk.OneDec()
+
+	t.Run("should compound a constant inflation at the goal bonded ratio", func(t *testing.T) {
+		projection := types.ProjectEmissions(minter, params, 1, params.GoalBonded, supply, supply)
+
+		// 100 steps of 0.1% compounded
+		expected := sdk.NewDecFromInt(supply).Mul(sdk.NewDecWithPrec(1001, 3).Power(100).Sub(sdk.OneDec()))
+		require.True(t, expected.TruncateInt().Sub(projection.Total).Abs().LTE(sdkmath.NewInt(100)))
+		require.Equal(t, minter.Inflation, projection.Inflation)
+	})
+
+	t.Run("should increase the inflation below the goal bonded ratio", func(t *testing.T) {
+		atGoal := types.ProjectEmissions(minter, params, 1, params.GoalBonded, supply, supply)
+		belowGoal := types.ProjectEmissions(minter, params, 1, sdk.ZeroDec(), supply, supply)
+		require.True(t, belowGoal.Total.GT(atGoal.Total))
+		require.True(t, belowGoal.Inflation.Equal(minter.Inflation.Add(params.InflationRateChange)))
+	})
+
+	t.Run("should cap the inflation", func(t *testing.T) {
+		capped := params
+		capped.InflationMax = minter.Inflation
+		projection := types.ProjectEmissions(minter, capped, 1, sdk.ZeroDec(), supply, supply)
+		require.Equal(t, minter.Inflation, projection.Inflation)
+	})
+
+	t.Run("should bound the simulated steps", func(t *testing.T) {
+		long := params
+		long.BlocksPerYear = types.DefaultBlocksPerYear
+		projection := types.ProjectEmissions(minter, long, 1, long.GoalBonded, supply, supply)
+		require.True(t, projection.Total.IsPositive())
+	})
+
+	t.Run("should project no emission when minting is paused", func(t *testing.T) {
+		paused := params
+		paused.PauseMinting = true
+		projection := types.ProjectEmissions(minter, paused, 1, params.GoalBonded, supply, supply)
+		require.True(t, projection.Total.IsZero())
+		require.True(t, projection.Categories.Total().IsZero())
+	})
+
+	t.Run("should project no emission below the min bonded ratio", func(t *testing.T) {
+		floored := params
+		floored.MinBondedRatio = sdk.NewDecWithPrec(5, 1)
+		below := types.ProjectEmissions(minter, floored, 1, sdk.NewDecWithPrec(4, 1), supply, supply)
+		require.True(t, below.Total.IsZero())
+		require.True(t, below.Categories.Total().IsZero())
+		// the minter keeps tracking the inflation
+		require.True(t, below.Inflation.GT(minter.Inflation))
+
+		atFloor := types.ProjectEmissions(minter, floored, 1, floored.MinBondedRatio, supply, supply)
+		require.True(t, atFloor.Total.IsPositive())
+	})
+
+	t.Run("should decrease the inflation in the linear mode", func(t *testing.T) {
+		linear := params
+		linear.InflationCalculationMode = types.INFLATION_CALCULATION_MODE_LINEAR
+		linear.InflationRateChange = sdk.NewDecWithPrec(5, 2)
+		projection := types.ProjectEmissions(minter, linear, 1, sdk.ZeroDec(), supply, supply)
+		require.True(t, projection.Inflation.Equal(sdk.NewDecWithPrec(5, 2)))
+	})
+
+	t.Run("should apply the inflation bounds of the phase active at each step", func(t *testing.T) {
+		phased := params
+		phased.Phases = []types.Phase{{
+			Name:         "capped",
+			StartHeight:  51,
+			InflationMin: sdk.ZeroDec(),
+			InflationMax: minter.Inflation,
+			GoalBonded:   params.GoalBonded,
+		}}
+		unphased := types.ProjectEmissions(minter, params, 1, sdk.ZeroDec(), supply, supply)
+		projection := types.ProjectEmissions(minter, phased, 1, sdk.ZeroDec(), supply, supply)
+		require.True(t, projection.Inflation.Equal(minter.Inflation))
+		require.True(t, projection.Total.LT(unphased.Total))
+	})
+
+	t.Run("should cap the emissions at the max supply", func(t *testing.T) {
+		capped := params
+		capped.MaxSupply = supply.AddRaw(1_500_000)
+		projection := types.ProjectEmissions(minter, capped, 1, params.GoalBonded, supply, supply)
+		require.Equal(t, sdkmath.NewInt(1_500_000), projection.Total)
+	})
+
+	t.Run("should project the provisions minted at the end of the epochs", func(t *testing.T) {
+		epochs := params
+		epochs.MintingInterval = 30
+		every := types.ProjectEmissions(minter, params, 1, params.GoalBonded, supply, supply)
+		projection := types.ProjectEmissions(minter, epochs, 1, params.GoalBonded, supply, supply)
+		// the provisions of the blocks after the last epoch of the year are carried over
+		require.True(t, projection.Total.IsPositive())
+		require.True(t, projection.Total.LT(every.Total))
+	})
+
+	t.Run("should step the blocks of a long year at once", func(t *testing.T) {
+		long := params
+		long.BlocksPerYear = 100_000
+		long.Phases = []types.Phase{{
+			Name:         "capped",
+			StartHeight:  50_001,
+			InflationMin: sdk.ZeroDec(),
+			InflationMax: minter.Inflation,
+			GoalBonded:   params.GoalBonded,
+		}}
+		projection := types.ProjectEmissions(minter, long, 1, sdk.ZeroDec(), supply, supply)
+		require.True(t, projection.Inflation.Equal(minter.Inflation))
+		require.True(t, projection.Total.IsPositive())
+	})
+}
+
+func TestProjectShares(t *testing.T) {
+	amount := sdkmath.NewInt(100)
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:         sdk.NewDecWithPrec(3, 1),
+		FundedAddresses: sdk.NewDecWithPrec(4, 1),
+		CommunityPool:   sdk.NewDecWithPrec(3, 1),
+	}
+	params.FundedAddresses = []types.WeightedAddress{{Address: sample.Address(sample.Rand()), Weight: sdk.OneDec()}}
+	totals := func(staking, funded, community int64) types.CategoryTotals {
+		ct := types.NewCategoryTotals()
+		ct.Staking = sdkmath.NewInt(staking)
+		ct.FundedAddresses = sdkmath.NewInt(funded)
+		ct.CommunityPool = sdkmath.NewInt(community)
+		return ct
+	}
+
+	tests := []struct {
+		name     string
+		params   func(types.Params) types.Params
+		expected types.CategoryTotals
+	}{
+		{
+			name:     "should distribute by proportions",
+			params:   func(p types.Params) types.Params { return p },
+			expected: totals(30, 40, 30),
+		},
+		{
+			name: "should send the funded share to the community pool without funded addresses",
+			params: func(p types.Params) types.Params {
+				p.FundedAddresses = nil
+				return p
+			},
+			expected: totals(30, 0, 70),
+		},
+		{
+			name: "should redirect the paused shares to the community pool",
+			params: func(p types.Params) types.Params {
+				p.PauseStakingShare = true
+				return p
+			},
+			expected: totals(0, 40, 60),
+		},
+		{
+			name: "should hold the paused shares in buffer mode",
+			params: func(p types.Params) types.Params {
+				p.PauseStakingShare = true
+				p.PauseCommunityShare = true
+				p.PausedShareMode = types.PAUSED_SHARE_MODE_BUFFER
+				return p
+			},
+			expected: totals(0, 40, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.ProjectShares(tt.params(params), amount))
+		})
+	}
+}
+
+func TestUpcomingProvisions(t *testing.T) {
+	supply := sdkmath.NewInt(1_000_000_000)
+	minter := types.InitialMinter(sdk.NewDecWithPrec(10, 2))
+
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	params.InflationMin = sdk.ZeroDec()
+	params.InflationMax = sdk.OneDec()
+
+	t.Run("should mint the block provision of a constant inflation at the goal bonded ratio", func(t *testing.T) {
+		provisions, firstClampedHeight := types.UpcomingProvisions(minter, params, 10, 3, params.GoalBonded, supply, supply)
+		require.Equal(t, []sdkmath.Int{
+			sdkmath.NewInt(1_000_000),
+			sdkmath.NewInt(1_001_000),
+			sdkmath.NewInt(1_002_001),
+		}, provisions)
+		require.Zero(t, firstClampedHeight)
+	})
+
+	t.Run("should return the first height where the inflation bounds bind", func(t *testing.T) {
+		bounded := params
+		bounded.InflationMax = minter.Inflation.Add(params.InflationRateChange.QuoInt64(50))
+		_, firstClampedHeight := types.UpcomingProvisions(minter, bounded, 10, 5, sdk.ZeroDec(), supply, supply)
+		require.EqualValues(t, 12, firstClampedHeight)
+	})
+
+	t.Run("should return the first height where the max supply binds", func(t *testing.T) {
+		capped := params
+		capped.MaxSupply = supply.AddRaw(1_500_000)
+		provisions, firstClampedHeight := types.UpcomingProvisions(minter, capped, 10, 3, params.GoalBonded, supply, supply)
+		require.Equal(t, []sdkmath.Int{sdkmath.NewInt(1_000_000), sdkmath.NewInt(500_000), sdkmath.ZeroInt()}, provisions)
+		require.EqualValues(t, 11, firstClampedHeight)
+	})
+
+	t.Run("should mint nothing while minting is paused", func(t *testing.T) {
+		paused := params
+		paused.PauseMinting = true
+		provisions, _ := types.UpcomingProvisions(minter, paused, 10, 2, params.GoalBonded, supply, supply)
+		require.Equal(t, []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt()}, provisions)
+	})
+
+	t.Run("should mint nothing while the bonded ratio is below the min bonded ratio", func(t *testing.T) {
+		floored := params
+		floored.MinBondedRatio = sdk.NewDecWithPrec(5, 1)
+		provisions, _ := types.UpcomingProvisions(minter, floored, 10, 2, sdk.NewDecWithPrec(4, 1), supply, supply)
+		require.Equal(t, []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt()}, provisions)
+
+		provisions, _ = types.UpcomingProvisions(minter, floored, 10, 2, floored.MinBondedRatio, supply, supply)
+		require.True(t, provisions[0].IsPositive())
+		require.True(t, provisions[1].IsPositive())
+	})
+
+	t.Run("should mint the provisions accrued in the epoch at its end", func(t *testing.T) {
+		epochs := params
+		epochs.MintingInterval = 3
+		provisions, _ := types.UpcomingProvisions(minter, epochs, 10, 4, params.GoalBonded, supply, supply)
+		require.Equal(t, []sdkmath.Int{
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			sdkmath.NewInt(3_000_000),
+			sdkmath.ZeroInt(),
+		}, provisions)
+	})
+}
@@ -6,24 +6,31 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +40,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
+	// verbose adds the values derived from the params at the current height to
+	// the response.
+	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
 }
 
 func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
@@ -68,10 +78,25 @@ func (m *QueryParamsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
+func (m *QueryParamsRequest) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
 // QueryParamsResponse is the response type for the Query/Params RPC method.
 type QueryParamsResponse struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// last_change is the last change of the params, empty if unknown.
+	LastChange *ParamsChange `protobuf:"bytes,2,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+	// active_phase is the phase of the schedule active at the current height,
+	// empty if no phase is active.
+	ActivePhase *Phase `protobuf:"bytes,3,opt,name=active_phase,json=activePhase,proto3" json:"active_phase,omitempty"`
+	// derived are the values derived from the params at the current height, only
+	// set with verbose.
+	Derived *DerivedParams `protobuf:"bytes,4,opt,name=derived,proto3" json:"derived,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -114,6 +139,27 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChange() *ParamsChange {
+	if m != nil {
+		return m.LastChange
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) GetActivePhase() *Phase {
+	if m != nil {
+		return m.ActivePhase
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) GetDerived() *DerivedParams {
+	if m != nil {
+		return m.Derived
+	}
+	return nil
+}
+
 // QueryInflationRequest is the request type for the Query/Inflation RPC method.
 type QueryInflationRequest struct {
 }
@@ -269,455 +315,16410 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "modules.mint.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "modules.mint.QueryParamsResponse")
-	proto.RegisterType((*QueryInflationRequest)(nil), "modules.mint.QueryInflationRequest")
-	proto.RegisterType((*QueryInflationResponse)(nil), "modules.mint.QueryInflationResponse")
-	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "modules.mint.QueryAnnualProvisionsRequest")
-	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "modules.mint.QueryAnnualProvisionsResponse")
+// QueryMinterRequest is the request type for the Query/Minter RPC method.
+type QueryMinterRequest struct {
 }
 
-func init() { proto.RegisterFile("modules/mint/query.proto", fileDescriptor_bb1e9d68eff6fd9a) }
+func (m *QueryMinterRequest) Reset()         { *m = QueryMinterRequest{} }
+func (m *QueryMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterRequest) ProtoMessage()    {}
+func (*QueryMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{6}
+}
+func (m *QueryMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterRequest.Merge(m, src)
+}
+func (m *QueryMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_bb1e9d68eff6fd9a = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xb5, 0xf9, 0x11, 0xa9, 0x47, 0x87, 0x72, 0x04, 0x5a, 0x4c, 0x7b, 0x0d, 0x2e, 0x8a, 0x2a,
-	0x50, 0x7d, 0x6a, 0x58, 0x19, 0x20, 0xea, 0xd2, 0xad, 0x64, 0xec, 0x82, 0x2e, 0xee, 0x61, 0x4e,
-	0xd8, 0x77, 0xae, 0xef, 0x5c, 0xd1, 0x85, 0x81, 0x91, 0x09, 0x89, 0x09, 0xf1, 0x6f, 0xf0, 0x47,
-	0x64, 0x8c, 0x60, 0x41, 0x0c, 0x11, 0x4a, 0xf8, 0x43, 0x90, 0xef, 0xce, 0x21, 0x71, 0x9c, 0x88,
-	0xa1, 0x4b, 0x62, 0x7f, 0xef, 0xf9, 0x7b, 0xef, 0xfb, 0xde, 0x1d, 0xd8, 0x4a, 0xc4, 0x59, 0x1e,
-	0x53, 0x89, 0x13, 0xc6, 0x15, 0x3e, 0xcf, 0x69, 0x76, 0x19, 0xa4, 0x99, 0x50, 0x02, 0xae, 0x5b,
-	0x24, 0x28, 0x10, 0xaf, 0x19, 0x89, 0x48, 0x68, 0x00, 0x17, 0x4f, 0x86, 0xe3, 0x6d, 0x47, 0x42,
-	0x44, 0x31, 0xc5, 0x24, 0x65, 0x98, 0x70, 0x2e, 0x14, 0x51, 0x4c, 0x70, 0x69, 0xd1, 0xfb, 0xa1,
-	0x90, 0x89, 0x90, 0xaf, 0xcc, 0x67, 0xe6, 0xc5, 0x42, 0x9b, 0x73, 0xb2, 0xc5, 0x8f, 0x01, 0xfc,
-	0x26, 0x80, 0x2f, 0x0b, 0x13, 0x27, 0x24, 0x23, 0x89, 0xec, 0xd1, 0xf3, 0x9c, 0x4a, 0xe5, 0x1f,
-	0x83, 0x3b, 0x73, 0x55, 0x99, 0x0a, 0x2e, 0x29, 0xec, 0x80, 0x46, 0xaa, 0x2b, 0x5b, 0x6e, 0xcb,
-	0xdd, 0xbf, 0xd5, 0x69, 0x06, 0xb3, 0x9e, 0x03, 0xc3, 0xee, 0xde, 0x18, 0x8c, 0x76, 0x9d, 0x9e,
-	0x65, 0xfa, 0x9b, 0xe0, 0xae, 0x6e, 0x75, 0xcc, 0x5f, 0xc7, 0xda, 0x6d, 0xa9, 0xa1, 0xc0, 0xbd,
-	0x2a, 0x60, 0x65, 0x4e, 0xc1, 0x1a, 0x2b, 0x8b, 0x5a, 0x69, 0xbd, 0xfb, 0xac, 0xe8, 0xf9, 0x6b,
-	0xb4, 0xdb, 0x8e, 0x98, 0x7a, 0x93, 0xf7, 0x83, 0x50, 0x24, 0x76, 0x40, 0xfb, 0x77, 0x20, 0xcf,
-	0xde, 0x62, 0x75, 0x99, 0x52, 0x19, 0x1c, 0xd1, 0xf0, 0xfb, 0xb7, 0x03, 0x60, 0xe7, 0x3f, 0xa2,
-	0x61, 0xef, 0x5f, 0x3b, 0x1f, 0x81, 0x6d, 0xad, 0xfa, 0x82, 0xf3, 0x9c, 0xc4, 0x27, 0x99, 0xb8,
-	0x60, 0xb2, 0x58, 0x61, 0xe9, 0xea, 0xa3, 0x0b, 0x76, 0x96, 0x10, 0xac, 0x3b, 0x06, 0x6e, 0x13,
-	0x8d, 0x15, 0x7b, 0xb6, 0xe0, 0x95, 0xb8, 0xdc, 0x20, 0x15, 0xc9, 0xce, 0x97, 0xeb, 0xe0, 0xa6,
-	0x36, 0x03, 0x33, 0xd0, 0x30, 0xdb, 0x85, 0xad, 0xf9, 0x9d, 0x2f, 0x86, 0xe7, 0x3d, 0x5c, 0xc1,
-	0x30, 0x33, 0xf8, 0x7b, 0x1f, 0x7e, 0xfc, 0xf9, 0x7c, 0x6d, 0x07, 0x3e, 0x28, 0xed, 0xe9, 0x63,
-	0x71, 0x71, 0xd8, 0xa7, 0x8a, 0x1c, 0x62, 0x93, 0x1c, 0x7c, 0x0f, 0xd6, 0xa6, 0xd9, 0xc0, 0xbd,
-	0x9a, 0xa6, 0xd5, 0x48, 0xbd, 0x47, 0xab, 0x49, 0x56, 0xbc, 0xad, 0xc5, 0x5b, 0x10, 0xd5, 0x8a,
-	0x4f, 0xa3, 0x82, 0x5f, 0x5d, 0xb0, 0x51, 0x4d, 0x01, 0x3e, 0xae, 0x91, 0x58, 0x92, 0xa5, 0xf7,
-	0xe4, 0xbf, 0xb8, 0xd6, 0x55, 0xa0, 0x5d, 0xed, 0xc3, 0x76, 0xad, 0xab, 0x85, 0xc4, 0xbb, 0xcf,
-	0x07, 0x63, 0xe4, 0x0e, 0xc7, 0xc8, 0xfd, 0x3d, 0x46, 0xee, 0xa7, 0x09, 0x72, 0x86, 0x13, 0xe4,
-	0xfc, 0x9c, 0x20, 0xe7, 0x74, 0x36, 0x7d, 0x16, 0x71, 0xa6, 0x28, 0x2e, 0x6f, 0xdf, 0x3b, 0xd3,
-	0x55, 0x9f, 0x80, 0x7e, 0x43, 0xdf, 0xc0, 0xa7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x44, 0x80,
-	0x14, 0xb9, 0x13, 0x04, 0x00, 0x00,
+var xxx_messageInfo_QueryMinterRequest proto.InternalMessageInfo
+
+// QueryMinterResponse is the response type for the Query/Minter RPC method.
+type QueryMinterResponse struct {
+	// minter is the current minter state.
+	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryMinterResponse) Reset()         { *m = QueryMinterResponse{} }
+func (m *QueryMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterResponse) ProtoMessage()    {}
+func (*QueryMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{7}
+}
+func (m *QueryMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterResponse.Merge(m, src)
+}
+func (m *QueryMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryMinterResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
-	// AnnualProvisions current minting annual provisions value.
-	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+func (m *QueryMinterResponse) GetMinter() Minter {
+	if m != nil {
+		return m.Minter
+	}
+	return Minter{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryAdminCapabilitiesRequest is the request type for the
+// Query/AdminCapabilities RPC method.
+type QueryAdminCapabilitiesRequest struct {
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAdminCapabilitiesRequest) Reset()         { *m = QueryAdminCapabilitiesRequest{} }
+func (m *QueryAdminCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminCapabilitiesRequest) ProtoMessage()    {}
+func (*QueryAdminCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{8}
+}
+func (m *QueryAdminCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminCapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminCapabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminCapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminCapabilitiesRequest.Merge(m, src)
+}
+func (m *QueryAdminCapabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminCapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminCapabilitiesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/modules.mint.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAdminCapabilitiesRequest proto.InternalMessageInfo
+
+// QueryAdminCapabilitiesResponse is the response type for the
+// Query/AdminCapabilities RPC method.
+type QueryAdminCapabilitiesResponse struct {
+	// authorities are the addresses allowed to execute the admin messages.
+	Authorities []string `protobuf:"bytes,1,rep,name=authorities,proto3" json:"authorities,omitempty"`
+	// capabilities are the admin messages of the module.
+	Capabilities []AdminCapability `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities"`
+	// pause_state is the current pause state of the module.
+	PauseState PauseState `protobuf:"bytes,3,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+	// param_descriptors describe the params updatable with MsgUpdateParams.
+	ParamDescriptors []ParamDescriptor `protobuf:"bytes,4,rep,name=param_descriptors,json=paramDescriptors,proto3" json:"param_descriptors"`
+}
+
+func (m *QueryAdminCapabilitiesResponse) Reset()         { *m = QueryAdminCapabilitiesResponse{} }
+func (m *QueryAdminCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminCapabilitiesResponse) ProtoMessage()    {}
+func (*QueryAdminCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{9}
+}
+func (m *QueryAdminCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminCapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAdminCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminCapabilitiesResponse.Merge(m, src)
+}
+func (m *QueryAdminCapabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminCapabilitiesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
-	out := new(QueryInflationResponse)
-	err := c.cc.Invoke(ctx, "/modules.mint.Query/Inflation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAdminCapabilitiesResponse proto.InternalMessageInfo
+
+func (m *QueryAdminCapabilitiesResponse) GetAuthorities() []string {
+	if m != nil {
+		return m.Authorities
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
-	out := new(QueryAnnualProvisionsResponse)
-	err := c.cc.Invoke(ctx, "/modules.mint.Query/AnnualProvisions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAdminCapabilitiesResponse) GetCapabilities() []AdminCapability {
+	if m != nil {
+		return m.Capabilities
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
-	// AnnualProvisions current minting annual provisions value.
-	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+func (m *QueryAdminCapabilitiesResponse) GetPauseState() PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return PauseState{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAdminCapabilitiesResponse) GetParamDescriptors() []ParamDescriptor {
+	if m != nil {
+		return m.ParamDescriptors
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// AdminCapability describes a message executable by the module authority.
+type AdminCapability struct {
+	// type_url is the type URL of the message.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// authority is the address allowed to execute the message.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// available is true if the message can currently be executed.
+	Available bool `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	// unavailable_reason explains why the message can't currently be executed.
+	UnavailableReason string `protobuf:"bytes,4,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
 }
-func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+
+func (m *AdminCapability) Reset()         { *m = AdminCapability{} }
+func (m *AdminCapability) String() string { return proto.CompactTextString(m) }
+func (*AdminCapability) ProtoMessage()    {}
+func (*AdminCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{10}
 }
-func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+func (m *AdminCapability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *AdminCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminCapability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminCapability.Merge(m, src)
+}
+func (m *AdminCapability) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminCapability.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_AdminCapability proto.InternalMessageInfo
+
+func (m *AdminCapability) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return ""
+}
+
+func (m *AdminCapability) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/modules.mint.Query/Params",
+	return ""
+}
+
+func (m *AdminCapability) GetAvailable() bool {
+	if m != nil {
+		return m.Available
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return false
+}
+
+func (m *AdminCapability) GetUnavailableReason() string {
+	if m != nil {
+		return m.UnavailableReason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInflationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// PauseState describes what is currently paused in the module.
+type PauseState struct {
+	Minting         bool            `protobuf:"varint,1,opt,name=minting,proto3" json:"minting,omitempty"`
+	StakingShare    bool            `protobuf:"varint,2,opt,name=staking_share,json=stakingShare,proto3" json:"staking_share,omitempty"`
+	FundedShare     bool            `protobuf:"varint,3,opt,name=funded_share,json=fundedShare,proto3" json:"funded_share,omitempty"`
+	CommunityShare  bool            `protobuf:"varint,4,opt,name=community_share,json=communityShare,proto3" json:"community_share,omitempty"`
+	PausedShareMode PausedShareMode `protobuf:"varint,5,opt,name=paused_share_mode,json=pausedShareMode,proto3,enum=modules.mint.PausedShareMode" json:"paused_share_mode,omitempty"`
+}
+
+func (m *PauseState) Reset()         { *m = PauseState{} }
+func (m *PauseState) String() string { return proto.CompactTextString(m) }
+func (*PauseState) ProtoMessage()    {}
+func (*PauseState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{11}
+}
+func (m *PauseState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Inflation(ctx, in)
+}
+func (m *PauseState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseState.Merge(m, src)
+}
+func (m *PauseState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseState proto.InternalMessageInfo
+
+func (m *PauseState) GetMinting() bool {
+	if m != nil {
+		return m.Minting
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/modules.mint.Query/Inflation",
+	return false
+}
+
+func (m *PauseState) GetStakingShare() bool {
+	if m != nil {
+		return m.StakingShare
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	return false
+}
+
+func (m *PauseState) GetFundedShare() bool {
+	if m != nil {
+		return m.FundedShare
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAnnualProvisionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PauseState) GetCommunityShare() bool {
+	if m != nil {
+		return m.CommunityShare
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	return false
+}
+
+func (m *PauseState) GetPausedShareMode() PausedShareMode {
+	if m != nil {
+		return m.PausedShareMode
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/modules.mint.Query/AnnualProvisions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	return PAUSED_SHARE_MODE_COMMUNITY_POOL
+}
+
+// QueryFundedAddressHistoryRequest is the request type for the
+// Query/FundedAddressHistory RPC method.
+type QueryFundedAddressHistoryRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundedAddressHistoryRequest) Reset()         { *m = QueryFundedAddressHistoryRequest{} }
+func (m *QueryFundedAddressHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundedAddressHistoryRequest) ProtoMessage()    {}
+func (*QueryFundedAddressHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{12}
+}
+func (m *QueryFundedAddressHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundedAddressHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundedAddressHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFundedAddressHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundedAddressHistoryRequest.Merge(m, src)
+}
+func (m *QueryFundedAddressHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundedAddressHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundedAddressHistoryRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "modules.mint.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Inflation",
-			Handler:    _Query_Inflation_Handler,
-		},
-		{
-			MethodName: "AnnualProvisions",
-			Handler:    _Query_AnnualProvisions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "modules/mint/query.proto",
+var xxx_messageInfo_QueryFundedAddressHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFundedAddressHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFundedAddressHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFundedAddressHistoryResponse is the response type for the
+// Query/FundedAddressHistory RPC method.
+type QueryFundedAddressHistoryResponse struct {
+	// changes are the weight changes of the funded address from the oldest.
+	Changes    []FundedAddressWeightChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	Pagination *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFundedAddressHistoryResponse) Reset()         { *m = QueryFundedAddressHistoryResponse{} }
+func (m *QueryFundedAddressHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundedAddressHistoryResponse) ProtoMessage()    {}
+func (*QueryFundedAddressHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{13}
+}
+func (m *QueryFundedAddressHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundedAddressHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundedAddressHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundedAddressHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundedAddressHistoryResponse.Merge(m, src)
+}
+func (m *QueryFundedAddressHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundedAddressHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundedAddressHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFundedAddressHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFundedAddressHistoryResponse) GetChanges() []FundedAddressWeightChange {
+	if m != nil {
+		return m.Changes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFundedAddressHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryParamsImpactRequest is the request type for the Query/ParamsImpact RPC
+// method.
+type QueryParamsImpactRequest struct {
+	// proposed_params are the params of the proposal, all the params must be
+	// provided
+	ProposedParams Params `protobuf:"bytes,1,opt,name=proposed_params,json=proposedParams,proto3" json:"proposed_params"`
+}
+
+func (m *QueryParamsImpactRequest) Reset()         { *m = QueryParamsImpactRequest{} }
+func (m *QueryParamsImpactRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsImpactRequest) ProtoMessage()    {}
+func (*QueryParamsImpactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{14}
+}
+func (m *QueryParamsImpactRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsImpactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsImpactRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsImpactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsImpactRequest.Merge(m, src)
+}
+func (m *QueryParamsImpactRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsImpactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsImpactRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsImpactRequest proto.InternalMessageInfo
+
+func (m *QueryParamsImpactRequest) GetProposedParams() Params {
+	if m != nil {
+		return m.ProposedParams
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsImpactResponse is the response type for the Query/ParamsImpact
+// RPC method.
+type QueryParamsImpactResponse struct {
+	// current is the projection of the emissions under the current params
+	Current EmissionProjection `protobuf:"bytes,1,opt,name=current,proto3" json:"current"`
+	// proposed is the projection of the emissions under the proposed params
+	Proposed EmissionProjection `protobuf:"bytes,2,opt,name=proposed,proto3" json:"proposed"`
+	// delta is the difference of the proposed emissions with the current ones
+	Delta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delta"`
+	// category_deltas are the differences by distribution category, they can be
+	// negative
+	CategoryDeltas CategoryTotals `protobuf:"bytes,4,opt,name=category_deltas,json=categoryDeltas,proto3" json:"category_deltas"`
 }
 
-func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsImpactResponse) Reset()         { *m = QueryParamsImpactResponse{} }
+func (m *QueryParamsImpactResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsImpactResponse) ProtoMessage()    {}
+func (*QueryParamsImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{15}
+}
+func (m *QueryParamsImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsImpactResponse.Merge(m, src)
+}
+func (m *QueryParamsImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsImpactResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsImpactResponse proto.InternalMessageInfo
+
+func (m *QueryParamsImpactResponse) GetCurrent() EmissionProjection {
+	if m != nil {
+		return m.Current
 	}
-	return dAtA[:n], nil
+	return EmissionProjection{}
 }
 
-func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsImpactResponse) GetProposed() EmissionProjection {
+	if m != nil {
+		return m.Proposed
+	}
+	return EmissionProjection{}
 }
 
-func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsImpactResponse) GetCategoryDeltas() CategoryTotals {
+	if m != nil {
+		return m.CategoryDeltas
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return CategoryTotals{}
 }
 
-func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryStatusRequest is the request type for the Query/Status RPC method.
+type QueryStatusRequest struct {
+}
+
+func (m *QueryStatusRequest) Reset()         { *m = QueryStatusRequest{} }
+func (m *QueryStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatusRequest) ProtoMessage()    {}
+func (*QueryStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{16}
+}
+func (m *QueryStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatusRequest.Merge(m, src)
+}
+func (m *QueryStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryStatusRequest proto.InternalMessageInfo
+
+// QueryStatusResponse is the response type for the Query/Status RPC method.
+type QueryStatusResponse struct {
+	// pause_state is the current pause state of the module.
+	PauseState PauseState `protobuf:"bytes,1,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+	// denom_consistency is the consistency of the supplies of the mint denom,
+	// minting is skipped while they mismatch.
+	DenomConsistency DenomConsistency `protobuf:"bytes,2,opt,name=denom_consistency,json=denomConsistency,proto3" json:"denom_consistency"`
+	// last_params_change is the last change of the params, empty if unknown.
+	LastParamsChange *ParamsChange `protobuf:"bytes,3,opt,name=last_params_change,json=lastParamsChange,proto3" json:"last_params_change,omitempty"`
+	// active_phase is the phase of the schedule active at the current height,
+	// empty if no phase is active.
+	ActivePhase *Phase `protobuf:"bytes,4,opt,name=active_phase,json=activePhase,proto3" json:"active_phase,omitempty"`
+	// initialized_at_height is the first height minted with the genesis state of
+	// the module, zero if unknown.
+	InitializedAtHeight int64 `protobuf:"varint,5,opt,name=initialized_at_height,json=initializedAtHeight,proto3" json:"initialized_at_height,omitempty"`
 }
 
-func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryStatusResponse) Reset()         { *m = QueryStatusResponse{} }
+func (m *QueryStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatusResponse) ProtoMessage()    {}
+func (*QueryStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{17}
+}
+func (m *QueryStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatusResponse.Merge(m, src)
+}
+func (m *QueryStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStatusResponse proto.InternalMessageInfo
+
+func (m *QueryStatusResponse) GetPauseState() PauseState {
+	if m != nil {
+		return m.PauseState
 	}
-	return dAtA[:n], nil
+	return PauseState{}
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStatusResponse) GetDenomConsistency() DenomConsistency {
+	if m != nil {
+		return m.DenomConsistency
+	}
+	return DenomConsistency{}
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *QueryStatusResponse) GetLastParamsChange() *ParamsChange {
+	if m != nil {
+		return m.LastParamsChange
+	}
+	return nil
+}
+
+func (m *QueryStatusResponse) GetActivePhase() *Phase {
+	if m != nil {
+		return m.ActivePhase
+	}
+	return nil
+}
+
+func (m *QueryStatusResponse) GetInitializedAtHeight() int64 {
+	if m != nil {
+		return m.InitializedAtHeight
+	}
+	return 0
+}
+
+// QueryValidateParamsRequest is the request type for the Query/ValidateParams
+// RPC method.
+type QueryValidateParamsRequest struct {
+	// params are the proposed params of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryValidateParamsRequest) Reset()         { *m = QueryValidateParamsRequest{} }
+func (m *QueryValidateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateParamsRequest) ProtoMessage()    {}
+func (*QueryValidateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{18}
+}
+func (m *QueryValidateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateParamsRequest.Merge(m, src)
+}
+func (m *QueryValidateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateParamsRequest proto.InternalMessageInfo
+
+func (m *QueryValidateParamsRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryValidateParamsResponse is the response type for the
+// Query/ValidateParams RPC method.
+type QueryValidateParamsResponse struct {
+	// valid is true if the proposed params are valid.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// errors are the validation errors of the proposed params by field.
+	Errors []ParamsFieldError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors"`
+	// effective are the values derived from the proposed params, only set if
+	// the params are valid.
+	Effective *EffectiveParams `protobuf:"bytes,3,opt,name=effective,proto3" json:"effective,omitempty"`
+}
+
+func (m *QueryValidateParamsResponse) Reset()         { *m = QueryValidateParamsResponse{} }
+func (m *QueryValidateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateParamsResponse) ProtoMessage()    {}
+func (*QueryValidateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{19}
+}
+func (m *QueryValidateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateParamsResponse.Merge(m, src)
+}
+func (m *QueryValidateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateParamsResponse proto.InternalMessageInfo
+
+func (m *QueryValidateParamsResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryValidateParamsResponse) GetErrors() []ParamsFieldError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *QueryValidateParamsResponse) GetEffective() *EffectiveParams {
+	if m != nil {
+		return m.Effective
+	}
+	return nil
+}
+
+// ParamsFieldError is the validation error of a param.
+type ParamsFieldError struct {
+	// field is the key of the param.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// error is the validation error.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ParamsFieldError) Reset()         { *m = ParamsFieldError{} }
+func (m *ParamsFieldError) String() string { return proto.CompactTextString(m) }
+func (*ParamsFieldError) ProtoMessage()    {}
+func (*ParamsFieldError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{20}
+}
+func (m *ParamsFieldError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsFieldError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsFieldError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsFieldError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsFieldError.Merge(m, src)
+}
+func (m *ParamsFieldError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsFieldError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsFieldError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsFieldError proto.InternalMessageInfo
+
+func (m *ParamsFieldError) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ParamsFieldError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EffectiveParams are the values derived from params once applied to the
+// current minter.
+type EffectiveParams struct {
+	// params are the validated params.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// normalized_funded_addresses are the funded addresses with weights divided
+	// by their sum.
+	NormalizedFundedAddresses []WeightedAddress `protobuf:"bytes,2,rep,name=normalized_funded_addresses,json=normalizedFundedAddresses,proto3" json:"normalized_funded_addresses"`
+	// proportions are the proportions of the minted coins distributed to each
+	// category once the paused shares are redirected or buffered and the funded
+	// addresses share is sent to the community pool if there is no funded
+	// address.
+	Proportions DistributionProportions `protobuf:"bytes,3,opt,name=proportions,proto3" json:"proportions"`
+	// buffered_proportion is the proportion of the minted coins held in the
+	// minter by the paused categories.
+	BufferedProportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=buffered_proportion,json=bufferedProportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"buffered_proportion"`
+	// next_inflation is the inflation rate of the next block at the current
+	// bonded ratio.
+	NextInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=next_inflation,json=nextInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"next_inflation"`
+	// inflation_clamp is the key of the inflation bound binding the inflation
+	// rate of the next block, empty if no bound binds.
+	InflationClamp string `protobuf:"bytes,6,opt,name=inflation_clamp,json=inflationClamp,proto3" json:"inflation_clamp,omitempty"`
+}
+
+func (m *EffectiveParams) Reset()         { *m = EffectiveParams{} }
+func (m *EffectiveParams) String() string { return proto.CompactTextString(m) }
+func (*EffectiveParams) ProtoMessage()    {}
+func (*EffectiveParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{21}
+}
+func (m *EffectiveParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveParams.Merge(m, src)
+}
+func (m *EffectiveParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveParams proto.InternalMessageInfo
+
+func (m *EffectiveParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *EffectiveParams) GetNormalizedFundedAddresses() []WeightedAddress {
+	if m != nil {
+		return m.NormalizedFundedAddresses
+	}
+	return nil
+}
+
+func (m *EffectiveParams) GetProportions() DistributionProportions {
+	if m != nil {
+		return m.Proportions
+	}
+	return DistributionProportions{}
+}
+
+func (m *EffectiveParams) GetInflationClamp() string {
+	if m != nil {
+		return m.InflationClamp
+	}
+	return ""
+}
+
+// DerivedParams are the values derived from the params at a height, once the
+// phases, the goal bonded transition, the bootstrap override, the funding
+// windows, the max supply and the pause flags are applied.
+type DerivedParams struct {
+	// height is the height the values are derived at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// effective_proportions are the proportions of the minted coins distributed
+	// to each category at the height. While the bootstrap override is active, all
+	// the minted coins are distributed to its recipient as a funded address.
+	EffectiveProportions DistributionProportions `protobuf:"bytes,2,opt,name=effective_proportions,json=effectiveProportions,proto3" json:"effective_proportions"`
+	// buffered_proportion is the proportion of the minted coins held in the
+	// minter by the paused categories.
+	BufferedProportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=buffered_proportion,json=bufferedProportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"buffered_proportion"`
+	// inflation_min is the minimum inflation rate applied at the height.
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min"`
+	// inflation_max is the maximum inflation rate applied at the height.
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max"`
+	// goal_bonded is the goal bonded ratio applied at the height.
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
+	// active_phase is the phase of the schedule active at the height, empty if no
+	// phase is active.
+	ActivePhase *Phase `protobuf:"bytes,7,opt,name=active_phase,json=activePhase,proto3" json:"active_phase,omitempty"`
+	// next_phase is the next phase of the schedule, empty if no phase starts
+	// after the height.
+	NextPhase *Phase `protobuf:"bytes,8,opt,name=next_phase,json=nextPhase,proto3" json:"next_phase,omitempty"`
+	// goal_bonded_transition is the pending transition of the goal bonded ratio,
+	// empty if no transition is pending.
+	GoalBondedTransition *GoalBondedTransition `protobuf:"bytes,9,opt,name=goal_bonded_transition,json=goalBondedTransition,proto3" json:"goal_bonded_transition,omitempty"`
+	// bootstrap_override_active is true if the minted coins are sent to the
+	// recipient of the bootstrap override at the height.
+	BootstrapOverrideActive bool `protobuf:"varint,10,opt,name=bootstrap_override_active,json=bootstrapOverrideActive,proto3" json:"bootstrap_override_active,omitempty"`
+	// funded_addresses_out_of_window are the funded addresses outside of their
+	// funding window at the height, their share is sent to the community pool.
+	FundedAddressesOutOfWindow []string `protobuf:"bytes,11,rep,name=funded_addresses_out_of_window,json=fundedAddressesOutOfWindow,proto3" json:"funded_addresses_out_of_window,omitempty"`
+	// max_supply_capped is true if the supply of the mint denom is capped by the
+	// max supply.
+	MaxSupplyCapped bool `protobuf:"varint,12,opt,name=max_supply_capped,json=maxSupplyCapped,proto3" json:"max_supply_capped,omitempty"`
+	// max_supply_utilization is the supply of the mint denom divided by the max
+	// supply, zero if the supply is not capped.
+	MaxSupplyUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_supply_utilization,json=maxSupplyUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_supply_utilization"`
+	// max_supply_headroom is the amount of the mint denom that can still be
+	// minted below the max supply, zero if the supply is not capped.
+	MaxSupplyHeadroom github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=max_supply_headroom,json=maxSupplyHeadroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply_headroom"`
+	// pause_state is the pause state of the module.
+	PauseState PauseState `protobuf:"bytes,15,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+	// auto_paused is true if minting has been paused by the auto pause.
+	AutoPaused bool `protobuf:"varint,16,opt,name=auto_paused,json=autoPaused,proto3" json:"auto_paused,omitempty"`
+	// minting_skipped is true if the bonded ratio is below the min bonded ratio,
+	// the minting of the mint denom is skipped.
+	MintingSkipped bool `protobuf:"varint,17,opt,name=minting_skipped,json=mintingSkipped,proto3" json:"minting_skipped,omitempty"`
+	// inflation_min_bps is the min inflation at the height in basis points, unset
+	// if it is not a whole number of basis points.
+	InflationMinBps *BasisPoints `protobuf:"bytes,18,opt,name=inflation_min_bps,json=inflationMinBps,proto3" json:"inflation_min_bps,omitempty"`
+	// inflation_max_bps is the max inflation at the height in basis points, unset
+	// if it is not a whole number of basis points.
+	InflationMaxBps *BasisPoints `protobuf:"bytes,19,opt,name=inflation_max_bps,json=inflationMaxBps,proto3" json:"inflation_max_bps,omitempty"`
+}
+
+func (m *DerivedParams) Reset()         { *m = DerivedParams{} }
+func (m *DerivedParams) String() string { return proto.CompactTextString(m) }
+func (*DerivedParams) ProtoMessage()    {}
+func (*DerivedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{22}
+}
+func (m *DerivedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedParams.Merge(m, src)
+}
+func (m *DerivedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedParams proto.InternalMessageInfo
+
+func (m *DerivedParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DerivedParams) GetEffectiveProportions() DistributionProportions {
+	if m != nil {
+		return m.EffectiveProportions
+	}
+	return DistributionProportions{}
+}
+
+func (m *DerivedParams) GetActivePhase() *Phase {
+	if m != nil {
+		return m.ActivePhase
+	}
+	return nil
+}
+
+func (m *DerivedParams) GetNextPhase() *Phase {
+	if m != nil {
+		return m.NextPhase
+	}
+	return nil
+}
+
+func (m *DerivedParams) GetGoalBondedTransition() *GoalBondedTransition {
+	if m != nil {
+		return m.GoalBondedTransition
+	}
+	return nil
+}
+
+func (m *DerivedParams) GetBootstrapOverrideActive() bool {
+	if m != nil {
+		return m.BootstrapOverrideActive
+	}
+	return false
+}
+
+func (m *DerivedParams) GetFundedAddressesOutOfWindow() []string {
+	if m != nil {
+		return m.FundedAddressesOutOfWindow
+	}
+	return nil
+}
+
+func (m *DerivedParams) GetMaxSupplyCapped() bool {
+	if m != nil {
+		return m.MaxSupplyCapped
+	}
+	return false
+}
+
+func (m *DerivedParams) GetPauseState() PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return PauseState{}
+}
+
+func (m *DerivedParams) GetAutoPaused() bool {
+	if m != nil {
+		return m.AutoPaused
+	}
+	return false
+}
+
+func (m *DerivedParams) GetMintingSkipped() bool {
+	if m != nil {
+		return m.MintingSkipped
+	}
+	return false
+}
+
+func (m *DerivedParams) GetInflationMinBps() *BasisPoints {
+	if m != nil {
+		return m.InflationMinBps
+	}
+	return nil
+}
+
+func (m *DerivedParams) GetInflationMaxBps() *BasisPoints {
+	if m != nil {
+		return m.InflationMaxBps
+	}
+	return nil
+}
+
+// QueryDelegatorAPRRequest is the request type for the Query/DelegatorAPR RPC
+// method.
+type QueryDelegatorAPRRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAPRRequest) Reset()         { *m = QueryDelegatorAPRRequest{} }
+func (m *QueryDelegatorAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAPRRequest) ProtoMessage()    {}
+func (*QueryDelegatorAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{23}
+}
+func (m *QueryDelegatorAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAPRRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAPRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAPRRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorAPRRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryDelegatorAPRResponse is the response type for the Query/DelegatorAPR RPC
+// method.
+type QueryDelegatorAPRResponse struct {
+	// staking_apr is the annual rate of the staking share of the annual
+	// provisions over the bonded tokens.
+	StakingApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking_apr,json=stakingApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking_apr"`
+	// community_tax is the community tax of the distribution module.
+	CommunityTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax"`
+	// commission_rate is the commission rate of the validator.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// delegator_apr is the staking APR net of the community tax and the
+	// commission rate.
+	DelegatorApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=delegator_apr,json=delegatorApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_apr"`
+	// bonded_tokens is the total amount of bonded tokens.
+	BondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens"`
+}
+
+func (m *QueryDelegatorAPRResponse) Reset()         { *m = QueryDelegatorAPRResponse{} }
+func (m *QueryDelegatorAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAPRResponse) ProtoMessage()    {}
+func (*QueryDelegatorAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{24}
+}
+func (m *QueryDelegatorAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAPRResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAPRResponse proto.InternalMessageInfo
+
+// QueryModuleAccountRequest is the request type for the Query/ModuleAccount RPC
+// method.
+type QueryModuleAccountRequest struct {
+}
+
+func (m *QueryModuleAccountRequest) Reset()         { *m = QueryModuleAccountRequest{} }
+func (m *QueryModuleAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountRequest) ProtoMessage()    {}
+func (*QueryModuleAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{25}
+}
+func (m *QueryModuleAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountRequest.Merge(m, src)
+}
+func (m *QueryModuleAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountRequest proto.InternalMessageInfo
+
+// QueryModuleAccountResponse is the response type for the Query/ModuleAccount
+// RPC method.
+type QueryModuleAccountResponse struct {
+	// address is the address of the module account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the balance of the module account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// entries are the sub-balances of the coins buffered in the module account.
+	Entries []LedgerEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+	// total_buffered is the sum of the ledger entries, equal to the balance
+	// unless the module-account-balance invariant is broken.
+	TotalBuffered github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_buffered,json=totalBuffered,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_buffered"`
+}
+
+func (m *QueryModuleAccountResponse) Reset()         { *m = QueryModuleAccountResponse{} }
+func (m *QueryModuleAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountResponse) ProtoMessage()    {}
+func (*QueryModuleAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{26}
+}
+func (m *QueryModuleAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountResponse.Merge(m, src)
+}
+func (m *QueryModuleAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryModuleAccountResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *QueryModuleAccountResponse) GetEntries() []LedgerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryModuleAccountResponse) GetTotalBuffered() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBuffered
+	}
+	return nil
+}
+
+// QueryEmissionDriftRequest is the request type for the Query/EmissionDrift
+// RPC method.
+type QueryEmissionDriftRequest struct {
+}
+
+func (m *QueryEmissionDriftRequest) Reset()         { *m = QueryEmissionDriftRequest{} }
+func (m *QueryEmissionDriftRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionDriftRequest) ProtoMessage()    {}
+func (*QueryEmissionDriftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{27}
+}
+func (m *QueryEmissionDriftRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionDriftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionDriftRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionDriftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionDriftRequest.Merge(m, src)
+}
+func (m *QueryEmissionDriftRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionDriftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionDriftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionDriftRequest proto.InternalMessageInfo
+
+// QueryEmissionDriftResponse is the response type for the Query/EmissionDrift
+// RPC method.
+type QueryEmissionDriftResponse struct {
+	// target_emission is the integral of the block provisions of the configured
+	// schedule.
+	TargetEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=target_emission,json=targetEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_emission"`
+	// realized_emission is the cumulative minted amount.
+	RealizedEmission github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=realized_emission,json=realizedEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"realized_emission"`
+	// drift is the realized emission minus the target emission.
+	Drift github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=drift,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"drift"`
+	// relative_drift is the drift over the target emission.
+	RelativeDrift github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=relative_drift,json=relativeDrift,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"relative_drift"`
+	// carry_buffer is the part of the drift that is minted once the carry
+	// buffer reaches the minimum distributable provision.
+	CarryBuffer github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=carry_buffer,json=carryBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"carry_buffer"`
+}
+
+func (m *QueryEmissionDriftResponse) Reset()         { *m = QueryEmissionDriftResponse{} }
+func (m *QueryEmissionDriftResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionDriftResponse) ProtoMessage()    {}
+func (*QueryEmissionDriftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{28}
+}
+func (m *QueryEmissionDriftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionDriftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionDriftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionDriftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionDriftResponse.Merge(m, src)
+}
+func (m *QueryEmissionDriftResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionDriftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionDriftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionDriftResponse proto.InternalMessageInfo
+
+// QueryDistributionHistoryRequest is the request type for the
+// Query/DistributionHistory RPC method.
+type QueryDistributionHistoryRequest struct {
+	// from_height is the lowest height of the returned allocations
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the highest height of the returned allocations, no upper
+	// bound if zero
+	ToHeight   int64              `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDistributionHistoryRequest) Reset()         { *m = QueryDistributionHistoryRequest{} }
+func (m *QueryDistributionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionHistoryRequest) ProtoMessage()    {}
+func (*QueryDistributionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{29}
+}
+func (m *QueryDistributionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionHistoryRequest.Merge(m, src)
+}
+func (m *QueryDistributionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryDistributionHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryDistributionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDistributionHistoryResponse is the response type for the
+// Query/DistributionHistory RPC method.
+type QueryDistributionHistoryResponse struct {
+	Distributions []BlockDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDistributionHistoryResponse) Reset()         { *m = QueryDistributionHistoryResponse{} }
+func (m *QueryDistributionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionHistoryResponse) ProtoMessage()    {}
+func (*QueryDistributionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{30}
+}
+func (m *QueryDistributionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionHistoryResponse.Merge(m, src)
+}
+func (m *QueryDistributionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionHistoryResponse) GetDistributions() []BlockDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+func (m *QueryDistributionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAverageInflationRequest is the request type for the
+// Query/AverageInflation RPC method.
+type QueryAverageInflationRequest struct {
+	// from_time is the start of the period, included
+	FromTime time.Time `protobuf:"bytes,1,opt,name=from_time,json=fromTime,proto3,stdtime" json:"from_time"`
+	// to_time is the end of the period, excluded
+	ToTime time.Time `protobuf:"bytes,2,opt,name=to_time,json=toTime,proto3,stdtime" json:"to_time"`
+}
+
+func (m *QueryAverageInflationRequest) Reset()         { *m = QueryAverageInflationRequest{} }
+func (m *QueryAverageInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAverageInflationRequest) ProtoMessage()    {}
+func (*QueryAverageInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{31}
+}
+func (m *QueryAverageInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAverageInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAverageInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAverageInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAverageInflationRequest.Merge(m, src)
+}
+func (m *QueryAverageInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAverageInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAverageInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAverageInflationRequest proto.InternalMessageInfo
+
+func (m *QueryAverageInflationRequest) GetFromTime() time.Time {
+	if m != nil {
+		return m.FromTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryAverageInflationRequest) GetToTime() time.Time {
+	if m != nil {
+		return m.ToTime
+	}
+	return time.Time{}
+}
+
+// QueryAverageInflationResponse is the response type for the
+// Query/AverageInflation RPC method.
+type QueryAverageInflationResponse struct {
+	// average_inflation is the mean of the inflation rates weighted by the time
+	// each rate applied over the covered part of the period
+	AverageInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=average_inflation,json=averageInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_inflation"`
+	// covered_fraction is the fraction of the period covered by the records, the
+	// pruned and missing records leave the rest of the period uncovered
+	CoveredFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=covered_fraction,json=coveredFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"covered_fraction"`
+}
+
+func (m *QueryAverageInflationResponse) Reset()         { *m = QueryAverageInflationResponse{} }
+func (m *QueryAverageInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAverageInflationResponse) ProtoMessage()    {}
+func (*QueryAverageInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{32}
+}
+func (m *QueryAverageInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAverageInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAverageInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAverageInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAverageInflationResponse.Merge(m, src)
+}
+func (m *QueryAverageInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAverageInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAverageInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAverageInflationResponse proto.InternalMessageInfo
+
+// QueryEmissionReportRequest is the request type for the
+// Query/EmissionReport RPC method.
+type QueryEmissionReportRequest struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height of the report, at most the current height
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryEmissionReportRequest) Reset()         { *m = QueryEmissionReportRequest{} }
+func (m *QueryEmissionReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionReportRequest) ProtoMessage()    {}
+func (*QueryEmissionReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{33}
+}
+func (m *QueryEmissionReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionReportRequest.Merge(m, src)
+}
+func (m *QueryEmissionReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionReportRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionReportRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryEmissionReportRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryEmissionReportResponse is the response type for the
+// Query/EmissionReport RPC method.
+type QueryEmissionReportResponse struct {
+	Report EmissionReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report"`
+	// hash is the SHA-256 hash of the canonical encoding of the report
+	Hash         []byte                     `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	ProofContext EmissionReportProofContext `protobuf:"bytes,3,opt,name=proof_context,json=proofContext,proto3" json:"proof_context"`
+}
+
+func (m *QueryEmissionReportResponse) Reset()         { *m = QueryEmissionReportResponse{} }
+func (m *QueryEmissionReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionReportResponse) ProtoMessage()    {}
+func (*QueryEmissionReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{34}
+}
+func (m *QueryEmissionReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionReportResponse.Merge(m, src)
+}
+func (m *QueryEmissionReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionReportResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionReportResponse) GetReport() EmissionReport {
+	if m != nil {
+		return m.Report
+	}
+	return EmissionReport{}
+}
+
+func (m *QueryEmissionReportResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *QueryEmissionReportResponse) GetProofContext() EmissionReportProofContext {
+	if m != nil {
+		return m.ProofContext
+	}
+	return EmissionReportProofContext{}
+}
+
+// EmissionReportProofContext locates the records of an emission report in the
+// state to verify them with store proofs: each record is queried with a proof
+// at the height from the store at the key prefix followed by the big endian
+// height of the record, and the proof is verified against the app hash of the
+// header of the next block.
+type EmissionReportProofContext struct {
+	// height is the height of the state the report was assembled from
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// store_name is the name of the store of the records
+	StoreName string `protobuf:"bytes,2,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// key_prefix is the prefix of the keys of the records
+	KeyPrefix []byte `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+}
+
+func (m *EmissionReportProofContext) Reset()         { *m = EmissionReportProofContext{} }
+func (m *EmissionReportProofContext) String() string { return proto.CompactTextString(m) }
+func (*EmissionReportProofContext) ProtoMessage()    {}
+func (*EmissionReportProofContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{35}
+}
+func (m *EmissionReportProofContext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionReportProofContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionReportProofContext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionReportProofContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionReportProofContext.Merge(m, src)
+}
+func (m *EmissionReportProofContext) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionReportProofContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionReportProofContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionReportProofContext proto.InternalMessageInfo
+
+func (m *EmissionReportProofContext) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EmissionReportProofContext) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *EmissionReportProofContext) GetKeyPrefix() []byte {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return nil
+}
+
+// QueryFeeAdvisoryRequest is the request type for the Query/FeeAdvisory RPC
+// method.
+type QueryFeeAdvisoryRequest struct {
+	// target_fee_coverage_ratio is the ratio of the staking share of the block
+	// provision the fees of a block should cover, in (0, 1]
+	TargetFeeCoverageRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=target_fee_coverage_ratio,json=targetFeeCoverageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_fee_coverage_ratio"`
+	// average_block_gas is the average gas used by a block, the module doesn't
+	// track the gas of the blocks
+	AverageBlockGas uint64 `protobuf:"varint,2,opt,name=average_block_gas,json=averageBlockGas,proto3" json:"average_block_gas,omitempty"`
+}
+
+func (m *QueryFeeAdvisoryRequest) Reset()         { *m = QueryFeeAdvisoryRequest{} }
+func (m *QueryFeeAdvisoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAdvisoryRequest) ProtoMessage()    {}
+func (*QueryFeeAdvisoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{36}
+}
+func (m *QueryFeeAdvisoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAdvisoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAdvisoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeAdvisoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAdvisoryRequest.Merge(m, src)
+}
+func (m *QueryFeeAdvisoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAdvisoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAdvisoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeAdvisoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeAdvisoryRequest) GetAverageBlockGas() uint64 {
+	if m != nil {
+		return m.AverageBlockGas
+	}
+	return 0
+}
+
+// QueryFeeAdvisoryResponse is the response type for the Query/FeeAdvisory RPC
+// method. The values are advisory and not used by consensus.
+type QueryFeeAdvisoryResponse struct {
+	// advisory_min_gas_price is the price per gas unit in the mint denom for the
+	// fees of a block of the average gas to cover the target ratio
+	AdvisoryMinGasPrice types.DecCoin `protobuf:"bytes,1,opt,name=advisory_min_gas_price,json=advisoryMinGasPrice,proto3" json:"advisory_min_gas_price"`
+	// staking_block_provision is the staking share of the block provision
+	StakingBlockProvision github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=staking_block_provision,json=stakingBlockProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking_block_provision"`
+	// target_block_fees are the fees of a block covering the target ratio
+	TargetBlockFees github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_block_fees,json=targetBlockFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_block_fees"`
+}
+
+func (m *QueryFeeAdvisoryResponse) Reset()         { *m = QueryFeeAdvisoryResponse{} }
+func (m *QueryFeeAdvisoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAdvisoryResponse) ProtoMessage()    {}
+func (*QueryFeeAdvisoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{37}
+}
+func (m *QueryFeeAdvisoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAdvisoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAdvisoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeAdvisoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAdvisoryResponse.Merge(m, src)
+}
+func (m *QueryFeeAdvisoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAdvisoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAdvisoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeAdvisoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeAdvisoryResponse) GetAdvisoryMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.AdvisoryMinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+// QueryEstimatedDistributionRequest is the request type for the
+// Query/EstimatedDistribution RPC method.
+type QueryEstimatedDistributionRequest struct {
+}
+
+func (m *QueryEstimatedDistributionRequest) Reset()         { *m = QueryEstimatedDistributionRequest{} }
+func (m *QueryEstimatedDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedDistributionRequest) ProtoMessage()    {}
+func (*QueryEstimatedDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{38}
+}
+func (m *QueryEstimatedDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimatedDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedDistributionRequest.Merge(m, src)
+}
+func (m *QueryEstimatedDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedDistributionRequest proto.InternalMessageInfo
+
+// QueryEstimatedDistributionResponse is the response type for the
+// Query/EstimatedDistribution RPC method. The amounts are the amounts the
+// next block mints and distributes, they are zero when the next block doesn't
+// distribute minted coins.
+type QueryEstimatedDistributionResponse struct {
+	// block_provision is the amount minted and distributed by the next block,
+	// including the released carry buffer, the drift correction and the
+	// community funding top-up
+	BlockProvision types.Coin `protobuf:"bytes,1,opt,name=block_provision,json=blockProvision,proto3" json:"block_provision"`
+	// staking is the staking share sent to the fee collector
+	Staking types.Coin `protobuf:"bytes,2,opt,name=staking,proto3" json:"staking"`
+	// community_pool is the amount sent to the community pool, including the
+	// truncation remainder and the funded addresses share when there is no
+	// funded address
+	CommunityPool types.Coin `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool"`
+	// funded_addresses are the shares of the funded addresses
+	FundedAddresses []FundedAddressAllocation `protobuf:"bytes,4,rep,name=funded_addresses,json=fundedAddresses,proto3" json:"funded_addresses"`
+	// truncation_remainder is the part of the community pool amount that is the
+	// remainder of the truncation of the staking and funded addresses shares
+	TruncationRemainder types.Coin `protobuf:"bytes,5,opt,name=truncation_remainder,json=truncationRemainder,proto3" json:"truncation_remainder"`
+	// funded_addresses_dust is the remainder of the truncation of the shares of
+	// the funded addresses, kept in the module account or assigned in round
+	// robin depending on the dust assignment
+	FundedAddressesDust types.Coin `protobuf:"bytes,6,opt,name=funded_addresses_dust,json=fundedAddressesDust,proto3" json:"funded_addresses_dust"`
+}
+
+func (m *QueryEstimatedDistributionResponse) Reset()         { *m = QueryEstimatedDistributionResponse{} }
+func (m *QueryEstimatedDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedDistributionResponse) ProtoMessage()    {}
+func (*QueryEstimatedDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{39}
+}
+func (m *QueryEstimatedDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimatedDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedDistributionResponse.Merge(m, src)
+}
+func (m *QueryEstimatedDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryEstimatedDistributionResponse) GetBlockProvision() types.Coin {
+	if m != nil {
+		return m.BlockProvision
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimatedDistributionResponse) GetStaking() types.Coin {
+	if m != nil {
+		return m.Staking
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimatedDistributionResponse) GetCommunityPool() types.Coin {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimatedDistributionResponse) GetFundedAddresses() []FundedAddressAllocation {
+	if m != nil {
+		return m.FundedAddresses
+	}
+	return nil
+}
+
+func (m *QueryEstimatedDistributionResponse) GetTruncationRemainder() types.Coin {
+	if m != nil {
+		return m.TruncationRemainder
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimatedDistributionResponse) GetFundedAddressesDust() types.Coin {
+	if m != nil {
+		return m.FundedAddressesDust
+	}
+	return types.Coin{}
+}
+
+// FundedAddressAllocation is the share of the block provision of a funded
+// address
+type FundedAddressAllocation struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *FundedAddressAllocation) Reset()         { *m = FundedAddressAllocation{} }
+func (m *FundedAddressAllocation) String() string { return proto.CompactTextString(m) }
+func (*FundedAddressAllocation) ProtoMessage()    {}
+func (*FundedAddressAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{40}
+}
+func (m *FundedAddressAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundedAddressAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundedAddressAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundedAddressAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundedAddressAllocation.Merge(m, src)
+}
+func (m *FundedAddressAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundedAddressAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundedAddressAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundedAddressAllocation proto.InternalMessageInfo
+
+func (m *FundedAddressAllocation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FundedAddressAllocation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// QueryAddressMintIncomeRequest is the request type for the
+// Query/AddressMintIncome RPC method.
+type QueryAddressMintIncomeRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	FromHeight int64  `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height of the range, at most the current height
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryAddressMintIncomeRequest) Reset()         { *m = QueryAddressMintIncomeRequest{} }
+func (m *QueryAddressMintIncomeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressMintIncomeRequest) ProtoMessage()    {}
+func (*QueryAddressMintIncomeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{41}
+}
+func (m *QueryAddressMintIncomeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressMintIncomeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressMintIncomeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressMintIncomeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressMintIncomeRequest.Merge(m, src)
+}
+func (m *QueryAddressMintIncomeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressMintIncomeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressMintIncomeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressMintIncomeRequest proto.InternalMessageInfo
+
+func (m *QueryAddressMintIncomeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAddressMintIncomeRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryAddressMintIncomeRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryAddressMintIncomeResponse is the response type for the
+// Query/AddressMintIncome RPC method.
+type QueryAddressMintIncomeResponse struct {
+	// amount is the total of the shares of the address in the range
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// recorded_blocks is the number of blocks of the range with a recorded
+	// allocation
+	RecordedBlocks uint64 `protobuf:"varint,2,opt,name=recorded_blocks,json=recordedBlocks,proto3" json:"recorded_blocks,omitempty"`
+	// lifetime is the income of the address since it is tracked
+	Lifetime FundedAddressIncome `protobuf:"bytes,3,opt,name=lifetime,proto3" json:"lifetime"`
+}
+
+func (m *QueryAddressMintIncomeResponse) Reset()         { *m = QueryAddressMintIncomeResponse{} }
+func (m *QueryAddressMintIncomeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressMintIncomeResponse) ProtoMessage()    {}
+func (*QueryAddressMintIncomeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{42}
+}
+func (m *QueryAddressMintIncomeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressMintIncomeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressMintIncomeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressMintIncomeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressMintIncomeResponse.Merge(m, src)
+}
+func (m *QueryAddressMintIncomeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressMintIncomeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressMintIncomeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressMintIncomeResponse proto.InternalMessageInfo
+
+func (m *QueryAddressMintIncomeResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *QueryAddressMintIncomeResponse) GetRecordedBlocks() uint64 {
+	if m != nil {
+		return m.RecordedBlocks
+	}
+	return 0
+}
+
+func (m *QueryAddressMintIncomeResponse) GetLifetime() FundedAddressIncome {
+	if m != nil {
+		return m.Lifetime
+	}
+	return FundedAddressIncome{}
+}
+
+// QueryTotalBurnedRequest is the request type for the Query/TotalBurned RPC
+// method.
+type QueryTotalBurnedRequest struct {
+}
+
+func (m *QueryTotalBurnedRequest) Reset()         { *m = QueryTotalBurnedRequest{} }
+func (m *QueryTotalBurnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedRequest) ProtoMessage()    {}
+func (*QueryTotalBurnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{43}
+}
+func (m *QueryTotalBurnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedRequest.Merge(m, src)
+}
+func (m *QueryTotalBurnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedRequest proto.InternalMessageInfo
+
+// QueryTotalBurnedResponse is the response type for the Query/TotalBurned RPC
+// method.
+type QueryTotalBurnedResponse struct {
+	// total_burned is the total amount of each denom burned with MsgBurn
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+}
+
+func (m *QueryTotalBurnedResponse) Reset()         { *m = QueryTotalBurnedResponse{} }
+func (m *QueryTotalBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedResponse) ProtoMessage()    {}
+func (*QueryTotalBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{44}
+}
+func (m *QueryTotalBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedResponse.Merge(m, src)
+}
+func (m *QueryTotalBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedResponse proto.InternalMessageInfo
+
+func (m *QueryTotalBurnedResponse) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
+// QueryTotalMintedRequest is the request type for the Query/TotalMinted RPC
+// method.
+type QueryTotalMintedRequest struct {
+}
+
+func (m *QueryTotalMintedRequest) Reset()         { *m = QueryTotalMintedRequest{} }
+func (m *QueryTotalMintedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalMintedRequest) ProtoMessage()    {}
+func (*QueryTotalMintedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{45}
+}
+func (m *QueryTotalMintedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalMintedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalMintedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalMintedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalMintedRequest.Merge(m, src)
+}
+func (m *QueryTotalMintedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalMintedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalMintedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalMintedRequest proto.InternalMessageInfo
+
+// QueryTotalMintedResponse is the response type for the Query/TotalMinted RPC
+// method.
+type QueryTotalMintedResponse struct {
+	// total_minted is the total amount of each denom minted by the module
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_minted,json=totalMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_minted"`
+}
+
+func (m *QueryTotalMintedResponse) Reset()         { *m = QueryTotalMintedResponse{} }
+func (m *QueryTotalMintedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalMintedResponse) ProtoMessage()    {}
+func (*QueryTotalMintedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{46}
+}
+func (m *QueryTotalMintedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalMintedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalMintedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalMintedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalMintedResponse.Merge(m, src)
+}
+func (m *QueryTotalMintedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalMintedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalMintedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalMintedResponse proto.InternalMessageInfo
+
+func (m *QueryTotalMintedResponse) GetTotalMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return nil
+}
+
+// QueryInflationHistoryRequest is the request type for the
+// Query/InflationHistory RPC method.
+type QueryInflationHistoryRequest struct {
+	// from_height is the lowest height of the returned snapshots
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the highest height of the returned snapshots, no upper bound
+	// if zero
+	ToHeight   int64              `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInflationHistoryRequest) Reset()         { *m = QueryInflationHistoryRequest{} }
+func (m *QueryInflationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationHistoryRequest) ProtoMessage()    {}
+func (*QueryInflationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{47}
+}
+func (m *QueryInflationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationHistoryRequest.Merge(m, src)
+}
+func (m *QueryInflationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryInflationHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryInflationHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryInflationHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInflationHistoryResponse is the response type for the
+// Query/InflationHistory RPC method.
+type QueryInflationHistoryResponse struct {
+	Snapshots  []InflationSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInflationHistoryResponse) Reset()         { *m = QueryInflationHistoryResponse{} }
+func (m *QueryInflationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationHistoryResponse) ProtoMessage()    {}
+func (*QueryInflationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{48}
+}
+func (m *QueryInflationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationHistoryResponse.Merge(m, src)
+}
+func (m *QueryInflationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryInflationHistoryResponse) GetSnapshots() []InflationSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QueryInflationHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStrategicReserveRequest is the request type for the
+// Query/StrategicReserve RPC method.
+type QueryStrategicReserveRequest struct {
+}
+
+func (m *QueryStrategicReserveRequest) Reset()         { *m = QueryStrategicReserveRequest{} }
+func (m *QueryStrategicReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStrategicReserveRequest) ProtoMessage()    {}
+func (*QueryStrategicReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{49}
+}
+func (m *QueryStrategicReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrategicReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrategicReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStrategicReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrategicReserveRequest.Merge(m, src)
+}
+func (m *QueryStrategicReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrategicReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrategicReserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStrategicReserveRequest proto.InternalMessageInfo
+
+// QueryStrategicReserveResponse is the response type for the
+// Query/StrategicReserve RPC method.
+type QueryStrategicReserveResponse struct {
+	// balance is the balance of the strategic reserve locked in the module
+	// account
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// accrued is the cumulative share of the minted coins accrued in the
+	// strategic reserve
+	Accrued github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=accrued,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"accrued"`
+	// released is the cumulative amount released from the strategic reserve
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+}
+
+func (m *QueryStrategicReserveResponse) Reset()         { *m = QueryStrategicReserveResponse{} }
+func (m *QueryStrategicReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStrategicReserveResponse) ProtoMessage()    {}
+func (*QueryStrategicReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{50}
+}
+func (m *QueryStrategicReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStrategicReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStrategicReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStrategicReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStrategicReserveResponse.Merge(m, src)
+}
+func (m *QueryStrategicReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStrategicReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStrategicReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStrategicReserveResponse proto.InternalMessageInfo
+
+func (m *QueryStrategicReserveResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *QueryStrategicReserveResponse) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+// QueryUpcomingProvisionsRequest is the request type for the
+// Query/UpcomingProvisions RPC method.
+type QueryUpcomingProvisionsRequest struct {
+	// count is the number of blocks of the provisions, at most 10000
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryUpcomingProvisionsRequest) Reset()         { *m = QueryUpcomingProvisionsRequest{} }
+func (m *QueryUpcomingProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingProvisionsRequest) ProtoMessage()    {}
+func (*QueryUpcomingProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{51}
+}
+func (m *QueryUpcomingProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingProvisionsRequest.Merge(m, src)
+}
+func (m *QueryUpcomingProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingProvisionsRequest proto.InternalMessageInfo
+
+func (m *QueryUpcomingProvisionsRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryUpcomingProvisionsResponse is the response type for the
+// Query/UpcomingProvisions RPC method.
+type QueryUpcomingProvisionsResponse struct {
+	// start_height is the height of the block of the first provision
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// denom is the denom of the provisions
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// provisions are the amounts minted at each block from start_height
+	Provisions []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,rep,name=provisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"provisions"`
+	// first_clamped_height is the height of the first block where the inflation
+	// bounds or the max supply bind, zero if they don't bind in the blocks of the
+	// provisions
+	FirstClampedHeight int64 `protobuf:"varint,4,opt,name=first_clamped_height,json=firstClampedHeight,proto3" json:"first_clamped_height,omitempty"`
+}
+
+func (m *QueryUpcomingProvisionsResponse) Reset()         { *m = QueryUpcomingProvisionsResponse{} }
+func (m *QueryUpcomingProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingProvisionsResponse) ProtoMessage()    {}
+func (*QueryUpcomingProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{52}
+}
+func (m *QueryUpcomingProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingProvisionsResponse.Merge(m, src)
+}
+func (m *QueryUpcomingProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingProvisionsResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingProvisionsResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryUpcomingProvisionsResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryUpcomingProvisionsResponse) GetFirstClampedHeight() int64 {
+	if m != nil {
+		return m.FirstClampedHeight
+	}
+	return 0
+}
+
+// QueryAnnualFundedProvisionsRequest is the request type for the
+// Query/AnnualFundedProvisions RPC method.
+type QueryAnnualFundedProvisionsRequest struct {
+	// address is the funded address of the projection, all the funded addresses
+	// if empty
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAnnualFundedProvisionsRequest) Reset()         { *m = QueryAnnualFundedProvisionsRequest{} }
+func (m *QueryAnnualFundedProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualFundedProvisionsRequest) ProtoMessage()    {}
+func (*QueryAnnualFundedProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{53}
+}
+func (m *QueryAnnualFundedProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualFundedProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualFundedProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualFundedProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualFundedProvisionsRequest.Merge(m, src)
+}
+func (m *QueryAnnualFundedProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualFundedProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualFundedProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualFundedProvisionsRequest proto.InternalMessageInfo
+
+func (m *QueryAnnualFundedProvisionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAnnualFundedProvisionsResponse is the response type for the
+// Query/AnnualFundedProvisions RPC method.
+type QueryAnnualFundedProvisionsResponse struct {
+	// annual_provisions are the coins projected to be distributed to each
+	// funded address over a year, in the order of the params
+	AnnualProvisions []FundedAddressAllocation `protobuf:"bytes,1,rep,name=annual_provisions,json=annualProvisions,proto3" json:"annual_provisions"`
+}
+
+func (m *QueryAnnualFundedProvisionsResponse) Reset()         { *m = QueryAnnualFundedProvisionsResponse{} }
+func (m *QueryAnnualFundedProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualFundedProvisionsResponse) ProtoMessage()    {}
+func (*QueryAnnualFundedProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{54}
+}
+func (m *QueryAnnualFundedProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualFundedProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualFundedProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualFundedProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualFundedProvisionsResponse.Merge(m, src)
+}
+func (m *QueryAnnualFundedProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualFundedProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualFundedProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualFundedProvisionsResponse proto.InternalMessageInfo
+
+func (m *QueryAnnualFundedProvisionsResponse) GetAnnualProvisions() []FundedAddressAllocation {
+	if m != nil {
+		return m.AnnualProvisions
+	}
+	return nil
+}
+
+// QueryModuleVersionRequest is the request type for the Query/ModuleVersion RPC
+// method.
+type QueryModuleVersionRequest struct {
+}
+
+func (m *QueryModuleVersionRequest) Reset()         { *m = QueryModuleVersionRequest{} }
+func (m *QueryModuleVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionRequest) ProtoMessage()    {}
+func (*QueryModuleVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{55}
+}
+func (m *QueryModuleVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionRequest proto.InternalMessageInfo
+
+// QueryModuleVersionResponse is the response type for the Query/ModuleVersion
+// RPC method.
+type QueryModuleVersionResponse struct {
+	ModuleVersion ModuleVersion `protobuf:"bytes,1,opt,name=module_version,json=moduleVersion,proto3" json:"module_version"`
+}
+
+func (m *QueryModuleVersionResponse) Reset()         { *m = QueryModuleVersionResponse{} }
+func (m *QueryModuleVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionResponse) ProtoMessage()    {}
+func (*QueryModuleVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{56}
+}
+func (m *QueryModuleVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionResponse) GetModuleVersion() ModuleVersion {
+	if m != nil {
+		return m.ModuleVersion
+	}
+	return ModuleVersion{}
+}
+
+// QueryStateProofRequest is the request type for the Query/StateProof RPC
+// method.
+type QueryStateProofRequest struct {
+	// key_name is the name of the part of the mint state to prove: minter,
+	// summary, params, schema_version, module_version, params_change or
+	// fee_collector_name
+	KeyName string `protobuf:"bytes,1,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	// height is the height of the proven state, the current height if zero
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryStateProofRequest) Reset()         { *m = QueryStateProofRequest{} }
+func (m *QueryStateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStateProofRequest) ProtoMessage()    {}
+func (*QueryStateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{57}
+}
+func (m *QueryStateProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateProofRequest.Merge(m, src)
+}
+func (m *QueryStateProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStateProofRequest proto.InternalMessageInfo
+
+func (m *QueryStateProofRequest) GetKeyName() string {
+	if m != nil {
+		return m.KeyName
+	}
+	return ""
+}
+
+func (m *QueryStateProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryStateProofResponse is the response type for the Query/StateProof RPC
+// method.
+type QueryStateProofResponse struct {
+	// proofs are the proofs of the store keys of the part of the state, one per
+	// param for the params
+	Proofs []StateProof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs"`
+}
+
+func (m *QueryStateProofResponse) Reset()         { *m = QueryStateProofResponse{} }
+func (m *QueryStateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateProofResponse) ProtoMessage()    {}
+func (*QueryStateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{58}
+}
+func (m *QueryStateProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateProofResponse.Merge(m, src)
+}
+func (m *QueryStateProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStateProofResponse proto.InternalMessageInfo
+
+func (m *QueryStateProofResponse) GetProofs() []StateProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+// StateProof is the value of a store key at a height with the commitment proof
+// of the value, or of its absence if the value is empty, against the app hash
+// of the height. The app hash of a height is committed in the header of the
+// next block.
+type StateProof struct {
+	// store_name is the name of the store holding the key
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Key       []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of the key, empty if the key is absent
+	Value  []byte           `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Height int64            `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Proof  *crypto.ProofOps `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{59}
+}
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *StateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StateProof) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryMintDenomRequest is the request type for the Query/MintDenom RPC method.
+type QueryMintDenomRequest struct {
+}
+
+func (m *QueryMintDenomRequest) Reset()         { *m = QueryMintDenomRequest{} }
+func (m *QueryMintDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintDenomRequest) ProtoMessage()    {}
+func (*QueryMintDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{60}
+}
+func (m *QueryMintDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintDenomRequest.Merge(m, src)
+}
+func (m *QueryMintDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintDenomRequest proto.InternalMessageInfo
+
+// QueryMintDenomResponse is the response type for the Query/MintDenom RPC
+// method.
+type QueryMintDenomResponse struct {
+	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+}
+
+func (m *QueryMintDenomResponse) Reset()         { *m = QueryMintDenomResponse{} }
+func (m *QueryMintDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintDenomResponse) ProtoMessage()    {}
+func (*QueryMintDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{61}
+}
+func (m *QueryMintDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintDenomResponse.Merge(m, src)
+}
+func (m *QueryMintDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintDenomResponse proto.InternalMessageInfo
+
+func (m *QueryMintDenomResponse) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+// QueryDistributionProportionsRequest is the request type for the
+// Query/DistributionProportions RPC method.
+type QueryDistributionProportionsRequest struct {
+}
+
+func (m *QueryDistributionProportionsRequest) Reset()         { *m = QueryDistributionProportionsRequest{} }
+func (m *QueryDistributionProportionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionProportionsRequest) ProtoMessage()    {}
+func (*QueryDistributionProportionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{62}
+}
+func (m *QueryDistributionProportionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionProportionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionProportionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionProportionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionProportionsRequest.Merge(m, src)
+}
+func (m *QueryDistributionProportionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionProportionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionProportionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionProportionsRequest proto.InternalMessageInfo
+
+// QueryDistributionProportionsResponse is the response type for the
+// Query/DistributionProportions RPC method.
+type QueryDistributionProportionsResponse struct {
+	DistributionProportions DistributionProportions `protobuf:"bytes,1,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
+}
+
+func (m *QueryDistributionProportionsResponse) Reset()         { *m = QueryDistributionProportionsResponse{} }
+func (m *QueryDistributionProportionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionProportionsResponse) ProtoMessage()    {}
+func (*QueryDistributionProportionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{63}
+}
+func (m *QueryDistributionProportionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionProportionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionProportionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionProportionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionProportionsResponse.Merge(m, src)
+}
+func (m *QueryDistributionProportionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionProportionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionProportionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionProportionsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionProportionsResponse) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+// QueryFundedAddressesRequest is the request type for the Query/FundedAddresses
+// RPC method.
+type QueryFundedAddressesRequest struct {
+	// pagination is the page of the funded addresses, the key of a page is the
+	// address of its first funded address.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundedAddressesRequest) Reset()         { *m = QueryFundedAddressesRequest{} }
+func (m *QueryFundedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundedAddressesRequest) ProtoMessage()    {}
+func (*QueryFundedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{64}
+}
+func (m *QueryFundedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundedAddressesRequest.Merge(m, src)
+}
+func (m *QueryFundedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundedAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryFundedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFundedAddressesResponse is the response type for the
+// Query/FundedAddresses RPC method.
+type QueryFundedAddressesResponse struct {
+	FundedAddresses []WeightedAddress   `protobuf:"bytes,1,rep,name=funded_addresses,json=fundedAddresses,proto3" json:"funded_addresses"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundedAddressesResponse) Reset()         { *m = QueryFundedAddressesResponse{} }
+func (m *QueryFundedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundedAddressesResponse) ProtoMessage()    {}
+func (*QueryFundedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb1e9d68eff6fd9a, []int{65}
+}
+func (m *QueryFundedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundedAddressesResponse.Merge(m, src)
+}
+func (m *QueryFundedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryFundedAddressesResponse) GetFundedAddresses() []WeightedAddress {
+	if m != nil {
+		return m.FundedAddresses
+	}
+	return nil
+}
+
+func (m *QueryFundedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "modules.mint.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "modules.mint.QueryParamsResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "modules.mint.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "modules.mint.QueryInflationResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "modules.mint.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "modules.mint.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryMinterRequest)(nil), "modules.mint.QueryMinterRequest")
+	proto.RegisterType((*QueryMinterResponse)(nil), "modules.mint.QueryMinterResponse")
+	proto.RegisterType((*QueryAdminCapabilitiesRequest)(nil), "modules.mint.QueryAdminCapabilitiesRequest")
+	proto.RegisterType((*QueryAdminCapabilitiesResponse)(nil), "modules.mint.QueryAdminCapabilitiesResponse")
+	proto.RegisterType((*AdminCapability)(nil), "modules.mint.AdminCapability")
+	proto.RegisterType((*PauseState)(nil), "modules.mint.PauseState")
+	proto.RegisterType((*QueryFundedAddressHistoryRequest)(nil), "modules.mint.QueryFundedAddressHistoryRequest")
+	proto.RegisterType((*QueryFundedAddressHistoryResponse)(nil), "modules.mint.QueryFundedAddressHistoryResponse")
+	proto.RegisterType((*QueryParamsImpactRequest)(nil), "modules.mint.QueryParamsImpactRequest")
+	proto.RegisterType((*QueryParamsImpactResponse)(nil), "modules.mint.QueryParamsImpactResponse")
+	proto.RegisterType((*QueryStatusRequest)(nil), "modules.mint.QueryStatusRequest")
+	proto.RegisterType((*QueryStatusResponse)(nil), "modules.mint.QueryStatusResponse")
+	proto.RegisterType((*QueryValidateParamsRequest)(nil), "modules.mint.QueryValidateParamsRequest")
+	proto.RegisterType((*QueryValidateParamsResponse)(nil), "modules.mint.QueryValidateParamsResponse")
+	proto.RegisterType((*ParamsFieldError)(nil), "modules.mint.ParamsFieldError")
+	proto.RegisterType((*EffectiveParams)(nil), "modules.mint.EffectiveParams")
+	proto.RegisterType((*DerivedParams)(nil), "modules.mint.DerivedParams")
+	proto.RegisterType((*QueryDelegatorAPRRequest)(nil), "modules.mint.QueryDelegatorAPRRequest")
+	proto.RegisterType((*QueryDelegatorAPRResponse)(nil), "modules.mint.QueryDelegatorAPRResponse")
+	proto.RegisterType((*QueryModuleAccountRequest)(nil), "modules.mint.QueryModuleAccountRequest")
+	proto.RegisterType((*QueryModuleAccountResponse)(nil), "modules.mint.QueryModuleAccountResponse")
+	proto.RegisterType((*QueryEmissionDriftRequest)(nil), "modules.mint.QueryEmissionDriftRequest")
+	proto.RegisterType((*QueryEmissionDriftResponse)(nil), "modules.mint.QueryEmissionDriftResponse")
+	proto.RegisterType((*QueryDistributionHistoryRequest)(nil), "modules.mint.QueryDistributionHistoryRequest")
+	proto.RegisterType((*QueryDistributionHistoryResponse)(nil), "modules.mint.QueryDistributionHistoryResponse")
+	proto.RegisterType((*QueryAverageInflationRequest)(nil), "modules.mint.QueryAverageInflationRequest")
+	proto.RegisterType((*QueryAverageInflationResponse)(nil), "modules.mint.QueryAverageInflationResponse")
+	proto.RegisterType((*QueryEmissionReportRequest)(nil), "modules.mint.QueryEmissionReportRequest")
+	proto.RegisterType((*QueryEmissionReportResponse)(nil), "modules.mint.QueryEmissionReportResponse")
+	proto.RegisterType((*EmissionReportProofContext)(nil), "modules.mint.EmissionReportProofContext")
+	proto.RegisterType((*QueryFeeAdvisoryRequest)(nil), "modules.mint.QueryFeeAdvisoryRequest")
+	proto.RegisterType((*QueryFeeAdvisoryResponse)(nil), "modules.mint.QueryFeeAdvisoryResponse")
+	proto.RegisterType((*QueryEstimatedDistributionRequest)(nil), "modules.mint.QueryEstimatedDistributionRequest")
+	proto.RegisterType((*QueryEstimatedDistributionResponse)(nil), "modules.mint.QueryEstimatedDistributionResponse")
+	proto.RegisterType((*FundedAddressAllocation)(nil), "modules.mint.FundedAddressAllocation")
+	proto.RegisterType((*QueryAddressMintIncomeRequest)(nil), "modules.mint.QueryAddressMintIncomeRequest")
+	proto.RegisterType((*QueryAddressMintIncomeResponse)(nil), "modules.mint.QueryAddressMintIncomeResponse")
+	proto.RegisterType((*QueryTotalBurnedRequest)(nil), "modules.mint.QueryTotalBurnedRequest")
+	proto.RegisterType((*QueryTotalBurnedResponse)(nil), "modules.mint.QueryTotalBurnedResponse")
+	proto.RegisterType((*QueryTotalMintedRequest)(nil), "modules.mint.QueryTotalMintedRequest")
+	proto.RegisterType((*QueryTotalMintedResponse)(nil), "modules.mint.QueryTotalMintedResponse")
+	proto.RegisterType((*QueryInflationHistoryRequest)(nil), "modules.mint.QueryInflationHistoryRequest")
+	proto.RegisterType((*QueryInflationHistoryResponse)(nil), "modules.mint.QueryInflationHistoryResponse")
+	proto.RegisterType((*QueryStrategicReserveRequest)(nil), "modules.mint.QueryStrategicReserveRequest")
+	proto.RegisterType((*QueryStrategicReserveResponse)(nil), "modules.mint.QueryStrategicReserveResponse")
+	proto.RegisterType((*QueryUpcomingProvisionsRequest)(nil), "modules.mint.QueryUpcomingProvisionsRequest")
+	proto.RegisterType((*QueryUpcomingProvisionsResponse)(nil), "modules.mint.QueryUpcomingProvisionsResponse")
+	proto.RegisterType((*QueryAnnualFundedProvisionsRequest)(nil), "modules.mint.QueryAnnualFundedProvisionsRequest")
+	proto.RegisterType((*QueryAnnualFundedProvisionsResponse)(nil), "modules.mint.QueryAnnualFundedProvisionsResponse")
+	proto.RegisterType((*QueryModuleVersionRequest)(nil), "modules.mint.QueryModuleVersionRequest")
+	proto.RegisterType((*QueryModuleVersionResponse)(nil), "modules.mint.QueryModuleVersionResponse")
+	proto.RegisterType((*QueryStateProofRequest)(nil), "modules.mint.QueryStateProofRequest")
+	proto.RegisterType((*QueryStateProofResponse)(nil), "modules.mint.QueryStateProofResponse")
+	proto.RegisterType((*StateProof)(nil), "modules.mint.StateProof")
+	proto.RegisterType((*QueryMintDenomRequest)(nil), "modules.mint.QueryMintDenomRequest")
+	proto.RegisterType((*QueryMintDenomResponse)(nil), "modules.mint.QueryMintDenomResponse")
+	proto.RegisterType((*QueryDistributionProportionsRequest)(nil), "modules.mint.QueryDistributionProportionsRequest")
+	proto.RegisterType((*QueryDistributionProportionsResponse)(nil), "modules.mint.QueryDistributionProportionsResponse")
+	proto.RegisterType((*QueryFundedAddressesRequest)(nil), "modules.mint.QueryFundedAddressesRequest")
+	proto.RegisterType((*QueryFundedAddressesResponse)(nil), "modules.mint.QueryFundedAddressesResponse")
+}
+
+func init() { proto.RegisterFile("modules/mint/query.proto", fileDescriptor_bb1e9d68eff6fd9a) }
+
+var fileDescriptor_bb1e9d68eff6fd9a = []byte{
+	// 4234 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0x6e, 0x92, 0xe2, 0xe7, 0xf1, 0x5f, 0xa4, 0xa4, 0x61, 0xcb, 0x22, 0xc5, 0xd6, 0x8f, 0x92,
+	0xcc, 0xa1, 0xa4, 0x8d, 0xed, 0xd8, 0xfb, 0xc9, 0x92, 0xd4, 0x17, 0x8e, 0x6c, 0x7a, 0x24, 0xcb,
+	0x86, 0x91, 0x45, 0xa7, 0xd8, 0x53, 0x33, 0x6c, 0x6b, 0xa6, 0xbb, 0xb7, 0xba, 0x86, 0x26, 0xd7,
+	0x70, 0x16, 0xc8, 0x21, 0x09, 0x7c, 0x70, 0x36, 0x58, 0x20, 0x39, 0x04, 0xd8, 0x04, 0x48, 0x80,
+	0x05, 0x16, 0x48, 0x72, 0x71, 0xb2, 0x39, 0xe5, 0xb0, 0x97, 0xec, 0x71, 0xe1, 0xbd, 0x04, 0x39,
+	0xd8, 0x81, 0x1d, 0xe4, 0x90, 0x4b, 0x80, 0x18, 0xc9, 0x39, 0xa8, 0xaa, 0x57, 0x3d, 0xdd, 0x3d,
+	0x3d, 0xc3, 0xa1, 0x34, 0x06, 0x72, 0x91, 0xa6, 0x5f, 0xbd, 0x5f, 0x55, 0xbd, 0x7a, 0xf5, 0x3e,
+	0x45, 0x28, 0x35, 0xc3, 0x6a, 0xab, 0xc1, 0xe2, 0x8d, 0xa6, 0x1f, 0x88, 0x8d, 0xef, 0xb7, 0x18,
+	0x3f, 0x2c, 0x47, 0x3c, 0x14, 0x21, 0x99, 0xc2, 0x91, 0xb2, 0x1c, 0xb1, 0xaf, 0x7a, 0x61, 0xdc,
+	0x0c, 0xe3, 0x8d, 0x5d, 0x1a, 0x33, 0x8d, 0xb6, 0xb1, 0x7f, 0x63, 0x97, 0x09, 0x7a, 0x63, 0x23,
+	0xa2, 0x75, 0x3f, 0xa0, 0xc2, 0x0f, 0x03, 0x4d, 0x69, 0x2f, 0xa7, 0x71, 0x0d, 0x96, 0x17, 0xfa,
+	0x66, 0x7c, 0xb1, 0x1e, 0xd6, 0x43, 0xf5, 0x73, 0x43, 0xfe, 0x42, 0xe8, 0xf3, 0xf5, 0x30, 0xac,
+	0x37, 0xd8, 0x06, 0x8d, 0xfc, 0x0d, 0x1a, 0x04, 0xa1, 0x50, 0x2c, 0x63, 0x1c, 0x5d, 0xc1, 0x51,
+	0xf5, 0xb5, 0xdb, 0xaa, 0x6d, 0x08, 0xbf, 0xc9, 0x62, 0x41, 0x9b, 0x11, 0x22, 0x2c, 0x69, 0xa1,
+	0xae, 0xe6, 0xab, 0x3f, 0x70, 0xe8, 0xac, 0x60, 0x41, 0x95, 0x71, 0x35, 0x43, 0x8f, 0x1f, 0x46,
+	0x22, 0x94, 0x6c, 0xc2, 0x1a, 0x0e, 0x9f, 0xce, 0x2c, 0x81, 0xfc, 0x47, 0x0f, 0x38, 0x65, 0x20,
+	0x6f, 0xca, 0x99, 0xee, 0x50, 0x4e, 0x9b, 0x71, 0x85, 0x7d, 0xbf, 0xc5, 0x62, 0x41, 0x4a, 0x30,
+	0xb6, 0xcf, 0xf8, 0x6e, 0x18, 0xb3, 0x92, 0x75, 0xce, 0x5a, 0x1b, 0xaf, 0x98, 0x4f, 0xe7, 0x7f,
+	0x2c, 0x58, 0xc8, 0x10, 0xc4, 0x51, 0x18, 0xc4, 0x8c, 0xdc, 0x84, 0xd1, 0x48, 0x41, 0x14, 0xc1,
+	0xe4, 0xcd, 0xc5, 0x72, 0x7a, 0x69, 0xcb, 0x1a, 0x7b, 0x6b, 0xe4, 0x97, 0x9f, 0xad, 0x3c, 0x57,
+	0x41, 0x4c, 0xf2, 0x4d, 0x98, 0x6c, 0xd0, 0x58, 0xb8, 0xde, 0x1e, 0x0d, 0xea, 0xac, 0x34, 0xa4,
+	0x08, 0xed, 0x22, 0xc2, 0x6d, 0x85, 0x51, 0x01, 0x89, 0xae, 0x7f, 0x93, 0x97, 0x60, 0x8a, 0x7a,
+	0xc2, 0xdf, 0x67, 0x6e, 0xb4, 0x47, 0x63, 0x56, 0x1a, 0x56, 0xd4, 0x0b, 0x39, 0x6a, 0x39, 0x54,
+	0x99, 0xd4, 0x88, 0xea, 0x83, 0xbc, 0x08, 0x63, 0x55, 0xc6, 0xfd, 0x7d, 0x56, 0x2d, 0x8d, 0x28,
+	0x92, 0x33, 0x59, 0x92, 0x5b, 0x7a, 0x10, 0xa7, 0x67, 0x70, 0x9d, 0xd3, 0x70, 0x52, 0x4d, 0xfb,
+	0x7e, 0x50, 0x6b, 0xa8, 0x4d, 0xc3, 0xa5, 0x72, 0x04, 0x9c, 0xca, 0x0f, 0xe0, 0x92, 0xbc, 0x0b,
+	0x13, 0xbe, 0x01, 0xaa, 0x55, 0x99, 0xda, 0xfa, 0x96, 0x9c, 0xff, 0xbf, 0x7e, 0xb6, 0x72, 0xa9,
+	0xee, 0x8b, 0xbd, 0xd6, 0x6e, 0xd9, 0x0b, 0x9b, 0xb8, 0x8d, 0xf8, 0xdf, 0x7a, 0x5c, 0x7d, 0xb2,
+	0x21, 0x0e, 0x23, 0x16, 0x97, 0x6f, 0x31, 0xef, 0xd3, 0x4f, 0xd6, 0x01, 0x77, 0xf9, 0x16, 0xf3,
+	0x2a, 0x6d, 0x76, 0xce, 0x32, 0x3c, 0xaf, 0xa4, 0x6e, 0x06, 0x41, 0x8b, 0x36, 0x76, 0x78, 0xb8,
+	0xef, 0xc7, 0xd2, 0x92, 0x8c, 0x56, 0x1f, 0x59, 0x70, 0xb6, 0x0b, 0x02, 0x6a, 0xe7, 0xc3, 0x3c,
+	0x55, 0x63, 0x6e, 0x94, 0x0c, 0x0e, 0x44, 0xcb, 0x39, 0x9a, 0x13, 0xe9, 0x2c, 0xa2, 0x8d, 0x3d,
+	0xf0, 0x03, 0xc1, 0xb8, 0x51, 0xf1, 0x3e, 0x2c, 0x64, 0xa0, 0x6d, 0x43, 0x6a, 0x2a, 0x48, 0xb1,
+	0x21, 0x69, 0x6c, 0x63, 0x48, 0x1a, 0xd3, 0x59, 0x31, 0x93, 0xad, 0x36, 0xfd, 0x60, 0x9b, 0x46,
+	0x74, 0xd7, 0x6f, 0xf8, 0xc2, 0x67, 0xc9, 0x72, 0xfc, 0x64, 0x08, 0x96, 0xbb, 0x61, 0xa0, 0xdc,
+	0x73, 0x30, 0x49, 0x5b, 0x62, 0x2f, 0xe4, 0x0a, 0x5c, 0xb2, 0xce, 0x0d, 0xaf, 0x4d, 0x54, 0xd2,
+	0x20, 0x72, 0x17, 0xa6, 0xbc, 0x14, 0x65, 0x69, 0xe8, 0xdc, 0xf0, 0xda, 0xe4, 0xcd, 0xb3, 0x59,
+	0xfd, 0xb2, 0x02, 0x0e, 0x51, 0xd1, 0x0c, 0x21, 0xf9, 0x2d, 0x98, 0x8c, 0x68, 0x2b, 0x66, 0x6e,
+	0x2c, 0xa8, 0x30, 0x96, 0x5b, 0xca, 0xdb, 0x7d, 0x2b, 0x66, 0x0f, 0xe5, 0x38, 0xb2, 0x80, 0x28,
+	0x81, 0x90, 0x1d, 0x98, 0x57, 0x47, 0xc8, 0xad, 0xb2, 0xd8, 0xe3, 0x7e, 0x24, 0x42, 0x1e, 0x97,
+	0x46, 0x8a, 0xd4, 0x51, 0x66, 0x7c, 0x2b, 0xc1, 0x42, 0x5e, 0x73, 0x51, 0x16, 0x1c, 0x3b, 0x7f,
+	0x66, 0xc1, 0x6c, 0x4e, 0x75, 0xb2, 0x04, 0xe3, 0x72, 0x8f, 0xdd, 0x16, 0x6f, 0xa8, 0xbd, 0x98,
+	0xa8, 0x8c, 0xc9, 0xef, 0xb7, 0x78, 0x83, 0x3c, 0x0f, 0x13, 0x66, 0x65, 0x0e, 0xd5, 0xb9, 0x9d,
+	0xa8, 0xb4, 0x01, 0x6a, 0x74, 0x9f, 0xfa, 0x0d, 0xba, 0xdb, 0xd0, 0xb3, 0x1b, 0xaf, 0xb4, 0x01,
+	0x64, 0x1d, 0x48, 0x2b, 0x48, 0x3e, 0x5d, 0xce, 0x68, 0x1c, 0x06, 0xea, 0x2c, 0x4e, 0x54, 0xe6,
+	0x53, 0x23, 0x15, 0x35, 0xe0, 0x7c, 0x61, 0x01, 0xb4, 0x17, 0x43, 0x7a, 0x26, 0x39, 0x31, 0x3f,
+	0xa8, 0x1b, 0xcf, 0x84, 0x9f, 0xe4, 0x3c, 0x4c, 0xc7, 0x82, 0x3e, 0xf1, 0x83, 0xba, 0x1b, 0xef,
+	0x51, 0xae, 0xfd, 0xc9, 0x78, 0x65, 0x0a, 0x81, 0x0f, 0x25, 0x8c, 0xac, 0xc2, 0x54, 0xad, 0x15,
+	0x54, 0x59, 0x15, 0x71, 0xb4, 0x76, 0x93, 0x1a, 0xa6, 0x51, 0x2e, 0xc3, 0xac, 0x17, 0x36, 0x9b,
+	0xad, 0xc0, 0x17, 0x87, 0x88, 0x35, 0xa2, 0xb0, 0x66, 0x12, 0xb0, 0x46, 0xbc, 0x2f, 0x77, 0xa1,
+	0x15, 0x1b, 0x5e, 0x6e, 0x33, 0xac, 0xb2, 0xd2, 0x89, 0x73, 0xd6, 0xda, 0x4c, 0xe7, 0x2e, 0x48,
+	0x34, 0x45, 0xf5, 0x20, 0xac, 0xb2, 0xca, 0x6c, 0x94, 0x05, 0x38, 0x3f, 0xb1, 0xe0, 0x9c, 0xb2,
+	0xcf, 0x3b, 0x4a, 0x91, 0xcd, 0x6a, 0x95, 0xb3, 0x38, 0xbe, 0xe7, 0xc7, 0x22, 0xe4, 0x87, 0xc6,
+	0x29, 0xdf, 0x84, 0x31, 0xaa, 0x07, 0xf4, 0x76, 0x6c, 0x95, 0x3e, 0xfd, 0x64, 0x7d, 0x11, 0x4f,
+	0x1e, 0x92, 0x3c, 0x14, 0xdc, 0x0f, 0xea, 0x15, 0x83, 0x48, 0xee, 0x00, 0xb4, 0xaf, 0x2e, 0xf4,
+	0xb0, 0x97, 0xca, 0x48, 0x23, 0xef, 0xae, 0xb2, 0xbe, 0x0e, 0xf1, 0x06, 0x2b, 0xef, 0xd0, 0x3a,
+	0x43, 0x79, 0x95, 0x14, 0xa5, 0xf3, 0xf7, 0x16, 0xac, 0xf6, 0x50, 0x10, 0xcf, 0xd0, 0x5d, 0x18,
+	0xd3, 0xbe, 0x5c, 0x9f, 0x9f, 0xc9, 0x9b, 0x97, 0xb3, 0xeb, 0x90, 0x21, 0x7e, 0x9b, 0xf9, 0xf5,
+	0x3d, 0xf4, 0xe6, 0x68, 0x97, 0x86, 0x9a, 0xdc, 0x2d, 0x50, 0xfb, 0xf2, 0x91, 0x6a, 0x6b, 0x2d,
+	0x32, 0x7a, 0xbb, 0x50, 0x4a, 0xdd, 0x56, 0xf7, 0x9b, 0x11, 0xf5, 0x84, 0x59, 0xcf, 0x6d, 0x98,
+	0x8d, 0x78, 0x18, 0x85, 0x72, 0x07, 0xfb, 0xbe, 0xbb, 0x66, 0x0c, 0x89, 0x86, 0x3a, 0xbf, 0x18,
+	0x82, 0xa5, 0x02, 0x09, 0xb8, 0x20, 0xdf, 0x85, 0x31, 0xaf, 0xc5, 0x39, 0x0b, 0x04, 0xb2, 0x3e,
+	0x97, 0x65, 0x7d, 0xbb, 0xe9, 0xc7, 0xb1, 0x1f, 0x06, 0x3b, 0x3c, 0x7c, 0x8f, 0x79, 0x52, 0xe3,
+	0x64, 0x25, 0x34, 0x19, 0xd9, 0x82, 0x71, 0x23, 0xb1, 0x34, 0x74, 0x2c, 0x16, 0x09, 0x1d, 0xa9,
+	0xc0, 0x89, 0x2a, 0x6b, 0x08, 0xaa, 0xac, 0x7d, 0xe2, 0x58, 0xee, 0xfd, 0x7e, 0x20, 0x52, 0xee,
+	0xfd, 0x7e, 0x20, 0x2a, 0x9a, 0x15, 0x79, 0x0d, 0x66, 0x3d, 0x2a, 0x58, 0x3d, 0xe4, 0x87, 0xae,
+	0x82, 0xc4, 0x78, 0x9d, 0x3e, 0x9f, 0x55, 0x6f, 0x1b, 0x91, 0x1e, 0x85, 0x82, 0x36, 0x92, 0x45,
+	0x34, 0xa4, 0xb7, 0x14, 0x65, 0x72, 0x41, 0xc8, 0x23, 0xde, 0x4a, 0x9c, 0xf6, 0xe7, 0x43, 0xb0,
+	0x90, 0x01, 0xe3, 0xa2, 0xe6, 0xdc, 0xa7, 0x75, 0x6c, 0xf7, 0xf9, 0x26, 0xcc, 0x57, 0x59, 0x10,
+	0x36, 0x5d, 0x2f, 0x0c, 0x62, 0x3f, 0x16, 0x2c, 0xf0, 0x0e, 0x71, 0x71, 0x97, 0xf3, 0xc1, 0x40,
+	0x10, 0x36, 0xb7, 0xdb, 0x58, 0xc6, 0x7f, 0x56, 0x73, 0x70, 0x72, 0x0f, 0x88, 0x0a, 0x65, 0xb4,
+	0x1d, 0x99, 0x88, 0x66, 0xf8, 0xc8, 0x88, 0x66, 0x4e, 0x52, 0xa5, 0x21, 0x1d, 0x71, 0xcd, 0x48,
+	0x9f, 0x71, 0xcd, 0x4d, 0x38, 0xe9, 0x07, 0xbe, 0xf0, 0x69, 0xc3, 0xff, 0x01, 0xab, 0xba, 0x54,
+	0xb8, 0x7b, 0xea, 0x80, 0x29, 0x8f, 0x34, 0x5c, 0x59, 0x48, 0x0d, 0x6e, 0x8a, 0x7b, 0x6a, 0xc8,
+	0xd9, 0x01, 0x5b, 0x2d, 0xf0, 0x63, 0xda, 0xf0, 0xab, 0x54, 0xb0, 0x6c, 0x10, 0xf8, 0x14, 0x21,
+	0x9d, 0xf3, 0xb7, 0x16, 0x9c, 0x29, 0x64, 0x89, 0x7b, 0xb7, 0x08, 0x27, 0xf6, 0xe5, 0x08, 0x3a,
+	0x6f, 0xfd, 0x41, 0xbe, 0x05, 0xa3, 0x8c, 0xf3, 0x90, 0x9b, 0x3b, 0x75, 0xb9, 0x48, 0xd2, 0x1d,
+	0x9f, 0x35, 0xaa, 0xb7, 0x25, 0x9a, 0x91, 0xa9, 0x69, 0xc8, 0x37, 0x61, 0x82, 0xd5, 0x6a, 0x4c,
+	0xad, 0x05, 0x2e, 0x79, 0xce, 0xff, 0xde, 0x36, 0xc3, 0xa8, 0x4d, 0x1b, 0xdf, 0xf9, 0x0e, 0xcc,
+	0xe5, 0xd9, 0x4b, 0x25, 0x6b, 0xf2, 0x0b, 0x6f, 0x3d, 0xfd, 0x21, 0xa1, 0x4a, 0x20, 0xde, 0x77,
+	0xfa, 0xc3, 0xf9, 0xdf, 0x61, 0x98, 0xcd, 0xb1, 0x7f, 0xaa, 0x58, 0xd8, 0x83, 0x33, 0x41, 0xc8,
+	0x9b, 0xb8, 0x7b, 0x78, 0x47, 0xa1, 0x17, 0xef, 0x16, 0x6b, 0x68, 0x0f, 0x9a, 0x38, 0x54, 0xe4,
+	0xb8, 0xd4, 0xe6, 0x93, 0xf1, 0xb7, 0x2c, 0x26, 0x0f, 0x60, 0x52, 0x39, 0x05, 0xae, 0xb2, 0x0e,
+	0x5c, 0xab, 0x8b, 0x39, 0x93, 0xf7, 0x63, 0xc1, 0xfd, 0xdd, 0x96, 0xd0, 0x3e, 0xc5, 0x20, 0x23,
+	0xf3, 0x34, 0x3d, 0x69, 0xc2, 0xc2, 0x6e, 0xab, 0x56, 0x63, 0x5c, 0x3a, 0xd0, 0x04, 0x5e, 0x1a,
+	0x39, 0xb6, 0x97, 0xe9, 0x0c, 0x22, 0x89, 0x61, 0xdc, 0x56, 0x81, 0x78, 0x30, 0x13, 0xb0, 0x03,
+	0xe1, 0xb6, 0x83, 0xea, 0x13, 0x03, 0x90, 0x34, 0x2d, 0x79, 0x26, 0xc1, 0xbb, 0xbc, 0xfd, 0x13,
+	0xfe, 0xae, 0xd7, 0xa0, 0xcd, 0xa8, 0x34, 0xaa, 0xf6, 0x7b, 0x26, 0x01, 0x6f, 0x4b, 0xa8, 0xf3,
+	0xd1, 0x24, 0x4c, 0x67, 0x72, 0x05, 0x72, 0x0a, 0x46, 0xf1, 0xc8, 0x59, 0xea, 0xc8, 0xe1, 0x17,
+	0xf9, 0x5d, 0x38, 0x99, 0xd8, 0x9b, 0x9b, 0x5e, 0xff, 0xa1, 0xe3, 0xaf, 0xff, 0x62, 0xc2, 0x69,
+	0xe7, 0xe8, 0x8d, 0x18, 0xfe, 0x9a, 0x36, 0x82, 0xc2, 0x74, 0x7b, 0x8d, 0x9a, 0xfe, 0x60, 0x76,
+	0x7c, 0x2a, 0x61, 0xf9, 0xc0, 0xcf, 0x8b, 0xa0, 0x07, 0xa5, 0x13, 0x83, 0x15, 0x41, 0x0f, 0xc8,
+	0xf7, 0x60, 0xb2, 0x1e, 0xd2, 0x86, 0xbb, 0x1b, 0xca, 0x43, 0x52, 0x1a, 0x1d, 0x80, 0x00, 0x90,
+	0x0c, 0xb7, 0x14, 0xbf, 0x0e, 0x3f, 0x3e, 0xd6, 0xb7, 0x1f, 0x07, 0x65, 0xe5, 0x9a, 0x6a, 0xbc,
+	0x3b, 0xd5, 0x84, 0x44, 0xd3, 0x34, 0xef, 0xc0, 0xa9, 0xd4, 0x54, 0x5c, 0xc1, 0x69, 0x10, 0xfb,
+	0xca, 0x04, 0x26, 0x14, 0xbd, 0x93, 0xa5, 0xbf, 0x9b, 0x68, 0xf9, 0x28, 0xc1, 0xac, 0x2c, 0xd6,
+	0x0b, 0xa0, 0xe4, 0x55, 0x58, 0xda, 0x0d, 0x43, 0x11, 0x0b, 0x4e, 0x23, 0x37, 0xdc, 0x67, 0x9c,
+	0xfb, 0x55, 0xe6, 0x6a, 0x7d, 0x4b, 0xa0, 0x7c, 0xf8, 0xe9, 0x04, 0xe1, 0x0d, 0x1c, 0xdf, 0x54,
+	0xc3, 0x64, 0x0b, 0x96, 0xf3, 0x7e, 0xcc, 0x0d, 0x5b, 0xc2, 0x0d, 0x6b, 0xee, 0xfb, 0x7e, 0x50,
+	0x0d, 0xdf, 0x2f, 0x4d, 0xaa, 0x24, 0xcb, 0xae, 0x65, 0xdd, 0xd4, 0x1b, 0x2d, 0xf1, 0x46, 0xed,
+	0x6d, 0x85, 0x41, 0xae, 0xc2, 0x7c, 0x93, 0x1e, 0xb8, 0x71, 0x2b, 0x8a, 0x1a, 0x87, 0xae, 0x47,
+	0xa3, 0x88, 0x55, 0x4b, 0x53, 0x4a, 0xee, 0x6c, 0x93, 0x1e, 0x3c, 0x54, 0xf0, 0x6d, 0x05, 0x26,
+	0x1c, 0x4e, 0xa5, 0x70, 0x5b, 0xc2, 0x6f, 0xf8, 0x3f, 0xd0, 0x7e, 0x62, 0x7a, 0x00, 0x7b, 0xbb,
+	0x98, 0x88, 0x7b, 0xab, 0xcd, 0x99, 0x34, 0x60, 0x21, 0x25, 0x73, 0x8f, 0xd1, 0x2a, 0x0f, 0xc3,
+	0x66, 0x69, 0x66, 0x00, 0x81, 0xd6, 0x7c, 0x22, 0xf0, 0x1e, 0xb2, 0xcd, 0x47, 0x3e, 0xb3, 0xc7,
+	0x8e, 0x7c, 0x56, 0x54, 0x92, 0x1b, 0xba, 0x0a, 0x54, 0x2d, 0xcd, 0xa9, 0x85, 0x04, 0x09, 0x52,
+	0x64, 0x55, 0xe9, 0xfe, 0x30, 0x9f, 0x72, 0xe3, 0x27, 0xbe, 0x5a, 0xed, 0x79, 0x9d, 0xfc, 0x20,
+	0xf8, 0xa1, 0x86, 0x92, 0xdb, 0x30, 0x9f, 0xf1, 0x01, 0xee, 0x6e, 0x14, 0x97, 0x88, 0x52, 0x68,
+	0x29, 0xab, 0xd0, 0x16, 0x8d, 0xfd, 0x78, 0x27, 0xf4, 0x03, 0x11, 0x57, 0x66, 0xd3, 0x87, 0x7c,
+	0x2b, 0x8a, 0x73, 0x6c, 0xe8, 0x81, 0x62, 0xb3, 0x70, 0x0c, 0x36, 0xf4, 0x60, 0x2b, 0x8a, 0x9d,
+	0xf7, 0x30, 0xcc, 0xbf, 0xc5, 0x1a, 0xac, 0x4e, 0x45, 0xc8, 0x37, 0x77, 0x2a, 0x26, 0x8c, 0x79,
+	0x1d, 0xe6, 0xf7, 0x75, 0x30, 0x12, 0x72, 0x37, 0x9b, 0x40, 0xad, 0x7e, 0xfa, 0xc9, 0xfa, 0x59,
+	0x5c, 0xf2, 0xc7, 0x06, 0x27, 0x9b, 0x49, 0xcd, 0xed, 0xe7, 0xe0, 0xce, 0x47, 0x23, 0xb0, 0x54,
+	0x20, 0x0c, 0x03, 0x9c, 0xef, 0xc1, 0xa4, 0xc9, 0x42, 0x69, 0xc4, 0x4b, 0xd6, 0xb1, 0x0d, 0xa1,
+	0xc0, 0xab, 0x20, 0xc3, 0xcd, 0x88, 0x4b, 0xbf, 0xd8, 0x4e, 0x4e, 0x05, 0x3d, 0x28, 0x0d, 0x0d,
+	0x40, 0xc0, 0x54, 0xc2, 0xf2, 0x11, 0x3d, 0x20, 0x4c, 0xe7, 0xbf, 0x3a, 0xab, 0x70, 0xb9, 0xa9,
+	0x50, 0x3c, 0xab, 0x90, 0x99, 0x36, 0xd3, 0x8a, 0x34, 0x45, 0x0a, 0xd3, 0x55, 0xb3, 0x80, 0x6a,
+	0xa9, 0x06, 0x72, 0x89, 0x24, 0x2c, 0x71, 0xb1, 0x8c, 0x47, 0x0c, 0x9f, 0xb0, 0x20, 0x2e, 0x9d,
+	0x18, 0xc0, 0xb1, 0x9c, 0xd2, 0x2c, 0x1f, 0x29, 0x8e, 0xce, 0x19, 0xb4, 0x85, 0x07, 0xca, 0x54,
+	0x37, 0x3d, 0x2f, 0x6c, 0x05, 0x26, 0xc1, 0x74, 0xfe, 0x63, 0x08, 0xec, 0xa2, 0xd1, 0xa4, 0xd2,
+	0x75, 0xfc, 0x7c, 0x9e, 0xc1, 0xd8, 0x2e, 0x6d, 0xd0, 0xc0, 0x63, 0x18, 0x12, 0x2e, 0x65, 0xb2,
+	0x62, 0x93, 0x0f, 0x6f, 0x87, 0x7e, 0xb0, 0x75, 0x5d, 0xce, 0xf3, 0x67, 0x9f, 0xaf, 0xac, 0xf5,
+	0x31, 0x4f, 0x49, 0x10, 0x57, 0x0c, 0x6f, 0xf2, 0x0a, 0x8c, 0xb1, 0x40, 0x70, 0x59, 0xe5, 0x1a,
+	0x3e, 0x37, 0xdc, 0x79, 0x18, 0x7f, 0x9b, 0x55, 0xeb, 0x8c, 0xdf, 0x0e, 0x04, 0x37, 0x29, 0x91,
+	0xc1, 0x27, 0x1c, 0x66, 0x84, 0xcc, 0xf5, 0x5c, 0x13, 0x38, 0x94, 0x46, 0x06, 0xaf, 0xe8, 0xb4,
+	0x12, 0xb1, 0x85, 0x12, 0x92, 0x5d, 0x30, 0xb9, 0xf0, 0x2d, 0xee, 0xd7, 0x92, 0x5d, 0xf8, 0xa3,
+	0x11, 0xb0, 0x8b, 0x46, 0x71, 0x17, 0x18, 0xcc, 0x0a, 0xca, 0xeb, 0x4c, 0xb8, 0x0c, 0xc7, 0x07,
+	0x72, 0x68, 0x67, 0x34, 0x53, 0x23, 0x53, 0x96, 0x5b, 0x39, 0xc3, 0xe8, 0x3e, 0x11, 0x34, 0x34,
+	0x00, 0x7b, 0x9c, 0x33, 0x6c, 0x13, 0x51, 0x32, 0xdd, 0x97, 0x53, 0x1c, 0xc8, 0xb1, 0xd5, 0xac,
+	0x64, 0xec, 0xcd, 0x99, 0x74, 0xb9, 0xfb, 0xcc, 0xd5, 0xcc, 0x07, 0x71, 0x5c, 0xa7, 0x0d, 0x4f,
+	0xb5, 0x25, 0xc4, 0x95, 0x05, 0x56, 0xce, 0x0f, 0xd1, 0x74, 0x06, 0x12, 0xf3, 0x4d, 0x2a, 0x8e,
+	0xda, 0x52, 0x9c, 0x9f, 0x5a, 0xb0, 0xa2, 0x5d, 0x77, 0x2a, 0xc8, 0xce, 0x55, 0xd9, 0x56, 0x60,
+	0xb2, 0xc6, 0xc3, 0xa6, 0x9b, 0x09, 0xe5, 0x41, 0x82, 0x74, 0xd2, 0x4c, 0xce, 0xc0, 0x84, 0x08,
+	0xcd, 0xf0, 0x90, 0x1a, 0x1e, 0x17, 0x21, 0x0e, 0x66, 0xeb, 0x6d, 0xc3, 0x4f, 0x5d, 0x6f, 0xfb,
+	0x47, 0x53, 0x10, 0x2c, 0xd4, 0x14, 0x4d, 0xf7, 0x35, 0x98, 0xae, 0xa6, 0x86, 0x4d, 0xd1, 0x6d,
+	0x25, 0x77, 0x71, 0x36, 0x42, 0xef, 0x49, 0x9a, 0x0d, 0x9e, 0xd8, 0x2c, 0xed, 0xe0, 0x4a, 0x6e,
+	0x7f, 0x69, 0x99, 0xde, 0xc4, 0x3e, 0xe3, 0xb4, 0xce, 0xf2, 0x1d, 0x13, 0xb2, 0x09, 0x13, 0x6a,
+	0x85, 0x85, 0xdf, 0x34, 0xd5, 0x1b, 0xbb, 0xac, 0x5b, 0x5f, 0x65, 0xd3, 0xfa, 0x2a, 0x3f, 0x32,
+	0xad, 0xaf, 0xad, 0x71, 0xa9, 0xed, 0x8f, 0x3e, 0x5f, 0xb1, 0x2a, 0xe3, 0x92, 0x4c, 0x0e, 0x90,
+	0x6f, 0xc3, 0x98, 0x08, 0x35, 0x83, 0xa1, 0x63, 0x30, 0x18, 0x15, 0xa1, 0x04, 0x3b, 0x5f, 0x25,
+	0xdd, 0x91, 0x0e, 0x15, 0x53, 0xdd, 0x11, 0x3d, 0xe6, 0x66, 0x7b, 0x38, 0x13, 0xcf, 0xdc, 0x1d,
+	0xc9, 0x89, 0x24, 0x75, 0x98, 0xf3, 0x64, 0x64, 0x2d, 0xd3, 0x7e, 0x4e, 0x3d, 0xf1, 0x74, 0x8e,
+	0xa1, 0x53, 0xd2, 0x2c, 0x72, 0xbd, 0x83, 0x4c, 0x9d, 0x77, 0x73, 0x7e, 0xb0, 0xc2, 0x64, 0x42,
+	0x37, 0x10, 0xbb, 0x77, 0x7e, 0x61, 0xea, 0x3e, 0x79, 0xe6, 0xb8, 0x9e, 0xaf, 0xc2, 0x28, 0x57,
+	0x90, 0x92, 0x55, 0x54, 0x25, 0xcc, 0x52, 0x99, 0xd2, 0x88, 0xa6, 0x20, 0x04, 0x46, 0xf6, 0x68,
+	0xbc, 0xa7, 0x64, 0x4e, 0x55, 0xd4, 0x6f, 0xf2, 0x10, 0xa6, 0x55, 0x7b, 0x53, 0x96, 0xf0, 0x04,
+	0x3b, 0x10, 0x78, 0xd4, 0xd6, 0x7a, 0xb1, 0xdd, 0x91, 0x04, 0xdb, 0x1a, 0xdf, 0xf4, 0x65, 0xa2,
+	0x14, 0xcc, 0xe1, 0x60, 0x77, 0xa7, 0xe8, 0x9a, 0xde, 0x9f, 0x05, 0x90, 0xc7, 0x92, 0xb9, 0x01,
+	0x45, 0x73, 0x9c, 0xa8, 0x4c, 0x28, 0xc8, 0xeb, 0xb4, 0xc9, 0xe4, 0xf0, 0x13, 0x76, 0xe8, 0x46,
+	0x9c, 0xd5, 0xfc, 0x03, 0xa5, 0xe6, 0x54, 0x65, 0xe2, 0x09, 0x3b, 0xdc, 0x51, 0x00, 0xe7, 0x9f,
+	0x2c, 0x38, 0xad, 0x0b, 0xeb, 0x8c, 0x6d, 0x56, 0xf7, 0xfd, 0x38, 0xe5, 0x8a, 0xde, 0x87, 0x25,
+	0xbc, 0x9a, 0x6a, 0x8c, 0xb9, 0x5e, 0x88, 0x06, 0xc9, 0xa5, 0xdd, 0x0c, 0xc4, 0x18, 0x4f, 0x69,
+	0xf6, 0x77, 0x18, 0xdb, 0x46, 0xe6, 0x15, 0xc9, 0x5b, 0x66, 0x5d, 0xc6, 0xfa, 0x77, 0xa5, 0xf7,
+	0x70, 0xeb, 0x54, 0x57, 0x2b, 0x46, 0x2a, 0xb3, 0x38, 0xa0, 0xbc, 0xca, 0x5d, 0x1a, 0x3b, 0xbf,
+	0x1e, 0x82, 0x52, 0xe7, 0x04, 0x70, 0xdb, 0xdf, 0x86, 0x53, 0x14, 0x61, 0x2a, 0x49, 0xa8, 0x53,
+	0xd9, 0xbc, 0xf6, 0x3d, 0x96, 0x98, 0x41, 0x51, 0x50, 0x70, 0x8b, 0x79, 0x2a, 0x2e, 0xd0, 0x7b,
+	0xb4, 0x60, 0x38, 0x3c, 0xf0, 0x83, 0xbb, 0x34, 0xde, 0x91, 0xe4, 0x44, 0xc0, 0x69, 0x13, 0x66,
+	0x6b, 0x0d, 0x93, 0x26, 0xe6, 0x40, 0xce, 0xce, 0x49, 0x64, 0xae, 0x66, 0x99, 0x74, 0x32, 0xc9,
+	0x1e, 0xcc, 0xe3, 0x86, 0x68, 0xa1, 0x35, 0xc6, 0xe2, 0x81, 0xdc, 0xb2, 0x18, 0x82, 0x28, 0x71,
+	0x77, 0x18, 0x8b, 0x9d, 0xf3, 0xd8, 0x6e, 0xb9, 0x1d, 0x0b, 0xbf, 0x49, 0x05, 0xab, 0xa6, 0x1d,
+	0xb8, 0x89, 0x6c, 0xfe, 0x7b, 0x18, 0x9c, 0x5e, 0x58, 0xb8, 0x09, 0xf7, 0x60, 0x36, 0xbf, 0x46,
+	0x16, 0x66, 0x58, 0x5d, 0x43, 0x32, 0xac, 0xd3, 0xef, 0x66, 0xe7, 0xff, 0x0a, 0x8c, 0xe1, 0xc2,
+	0x94, 0x86, 0xfa, 0xe3, 0x60, 0xf0, 0xc9, 0x1d, 0x68, 0xb7, 0xcf, 0xdc, 0x28, 0x0c, 0x1b, 0xa5,
+	0xe1, 0xfe, 0x38, 0xb4, 0xf3, 0x9d, 0x9d, 0x30, 0x6c, 0x90, 0xc7, 0x30, 0xd7, 0x51, 0x1c, 0xd5,
+	0x01, 0xe6, 0xc5, 0x1e, 0xbd, 0xa6, 0xcd, 0x46, 0x23, 0xf4, 0x68, 0xea, 0xf2, 0x9b, 0xcd, 0xd5,
+	0x1c, 0x48, 0x05, 0x16, 0x05, 0x6f, 0x05, 0x1a, 0xc9, 0xe5, 0xac, 0x49, 0xfd, 0xa0, 0x8a, 0x31,
+	0x48, 0x1f, 0x5a, 0x2e, 0xb4, 0x89, 0x2b, 0x86, 0x96, 0x3c, 0x84, 0x93, 0x1d, 0x05, 0x90, 0x6a,
+	0x2b, 0x16, 0xa5, 0xd1, 0x3e, 0x99, 0xe6, 0x94, 0xbc, 0xd5, 0x8a, 0x85, 0xf3, 0x07, 0x16, 0x9c,
+	0xee, 0x32, 0xb7, 0xa7, 0xca, 0x28, 0x5e, 0x86, 0x51, 0xda, 0x0c, 0x5b, 0x81, 0xe8, 0x77, 0x4b,
+	0x11, 0xdd, 0xf9, 0x93, 0xe4, 0x12, 0xd5, 0x9c, 0x64, 0x67, 0xfe, 0x7e, 0xe0, 0x85, 0x4d, 0xf6,
+	0x2c, 0x0d, 0xcb, 0xdc, 0x35, 0x34, 0xd4, 0xfb, 0x1a, 0x1a, 0xce, 0x5d, 0x43, 0x5f, 0x59, 0x49,
+	0x9f, 0xbf, 0x43, 0x27, 0x3c, 0x0d, 0x5e, 0x32, 0x5f, 0x6b, 0xf0, 0x79, 0x09, 0xb2, 0x96, 0x65,
+	0x14, 0xce, 0xbc, 0x90, 0xcb, 0xbd, 0x57, 0x67, 0xc8, 0xb8, 0xcf, 0x19, 0x03, 0x56, 0x47, 0x3d,
+	0x26, 0xdb, 0x30, 0xde, 0xf0, 0x6b, 0x4c, 0x45, 0x32, 0xfa, 0x40, 0xac, 0xf6, 0x30, 0x63, 0x3d,
+	0x15, 0xd3, 0xdf, 0x33, 0x84, 0xce, 0x12, 0x5e, 0x21, 0x8f, 0x74, 0x52, 0xc4, 0x03, 0x56, 0x4d,
+	0xbd, 0x03, 0x29, 0x75, 0x8e, 0xe1, 0x52, 0x04, 0x30, 0x65, 0x52, 0x35, 0x09, 0xff, 0x3a, 0x16,
+	0x64, 0x52, 0xb4, 0xe5, 0x66, 0xf5, 0x94, 0x5b, 0xd3, 0x4d, 0x4f, 0x33, 0x96, 0xd7, 0xb3, 0xa9,
+	0xe0, 0x5f, 0x9f, 0x9e, 0x5a, 0xae, 0xf3, 0xd7, 0x26, 0x82, 0x4d, 0x82, 0xb4, 0xff, 0x97, 0x39,
+	0xc2, 0xdf, 0x98, 0x03, 0xd8, 0xa9, 0x26, 0x2e, 0xdc, 0x36, 0x4c, 0xc4, 0x01, 0x8d, 0xe2, 0xbd,
+	0x50, 0x74, 0x49, 0x0e, 0x12, 0xd2, 0x87, 0x88, 0x87, 0xc6, 0xd5, 0xa6, 0x1b, 0x5c, 0x62, 0x60,
+	0xde, 0x2c, 0x3d, 0x14, 0x5c, 0xb6, 0x7f, 0x7d, 0xaf, 0xc2, 0x62, 0xc6, 0xf7, 0xcd, 0xdc, 0x9c,
+	0x7f, 0x1e, 0x82, 0xb3, 0x5d, 0x10, 0x92, 0x5c, 0x3d, 0xa9, 0x7e, 0x58, 0x5f, 0x63, 0xf5, 0xe3,
+	0x31, 0x8c, 0x51, 0xcf, 0xe3, 0x2d, 0x6c, 0xb9, 0x3f, 0x6b, 0x86, 0x6e, 0x98, 0x91, 0x3a, 0x8c,
+	0x73, 0xd6, 0x60, 0x54, 0x96, 0x5e, 0x87, 0x07, 0xaf, 0x7f, 0xc2, 0xdc, 0x79, 0x09, 0xbd, 0xe0,
+	0x5b, 0x91, 0x17, 0x36, 0xfd, 0xa0, 0xde, 0xf1, 0x3e, 0x4c, 0x36, 0x33, 0x3d, 0x74, 0x82, 0xd2,
+	0x2d, 0xe9, 0x0f, 0xe7, 0x3f, 0x4d, 0x7e, 0x5c, 0x44, 0x88, 0x7b, 0xb0, 0x0a, 0xf2, 0x45, 0x0d,
+	0x17, 0x59, 0xe3, 0x9f, 0x54, 0x30, 0x34, 0xf0, 0x45, 0xf9, 0xde, 0x20, 0x08, 0x9b, 0xa6, 0x53,
+	0xaa, 0x3e, 0xc8, 0xef, 0x00, 0xa4, 0x5e, 0x9a, 0xc9, 0xf9, 0x3f, 0xeb, 0xc2, 0xa6, 0xf8, 0x91,
+	0xeb, 0xb0, 0x58, 0xf3, 0xb9, 0x7c, 0x4c, 0x28, 0xbb, 0x73, 0xac, 0x6a, 0xd4, 0x1b, 0x51, 0xea,
+	0x11, 0x35, 0xb6, 0xad, 0x87, 0xf0, 0xae, 0x78, 0x07, 0x9c, 0xd4, 0x0b, 0x39, 0xed, 0x67, 0x3b,
+	0x17, 0xea, 0x29, 0xee, 0x30, 0xe7, 0x87, 0x70, 0xbe, 0x27, 0x67, 0x5c, 0xc9, 0x77, 0x8a, 0x5f,
+	0xe0, 0x1d, 0x3b, 0x96, 0xe9, 0x7c, 0x70, 0x97, 0xad, 0x4a, 0x3e, 0x66, 0x3c, 0x4e, 0x45, 0x8d,
+	0x35, 0xb0, 0x8b, 0x06, 0x93, 0x60, 0x71, 0x46, 0x8b, 0x76, 0xf7, 0xf5, 0x48, 0xc9, 0x2a, 0x7a,
+	0x25, 0x99, 0x21, 0x36, 0x91, 0x5a, 0x33, 0x0d, 0x74, 0x5e, 0xc3, 0x87, 0x91, 0xaa, 0xf3, 0xa0,
+	0x32, 0x29, 0xb3, 0xa6, 0x4b, 0x30, 0x2e, 0x53, 0x22, 0x95, 0x2f, 0xe1, 0xc3, 0xb2, 0x27, 0xec,
+	0x50, 0x65, 0x4b, 0xed, 0x24, 0x6b, 0x28, 0x9d, 0x64, 0x39, 0x6f, 0xc2, 0xe9, 0x0e, 0x66, 0xa8,
+	0xf1, 0x4b, 0x30, 0xaa, 0xb2, 0x38, 0xb3, 0x76, 0xb9, 0x7e, 0x48, 0x9b, 0x22, 0xe9, 0xb8, 0x2b,
+	0x6c, 0xf9, 0xe6, 0x0a, 0xda, 0x83, 0xb9, 0x34, 0xce, 0xca, 0xa7, 0x71, 0x73, 0x30, 0xfc, 0x84,
+	0x1d, 0x62, 0x0e, 0x2a, 0x7f, 0xe2, 0x53, 0x86, 0x16, 0xc3, 0x9c, 0x4e, 0x7f, 0xa4, 0x26, 0x30,
+	0x92, 0xc9, 0x12, 0x6f, 0xc0, 0x09, 0x25, 0x17, 0x03, 0xca, 0x33, 0xe5, 0xf6, 0x7b, 0xdd, 0xb2,
+	0x7e, 0xaf, 0x5b, 0x56, 0x7a, 0xbc, 0x11, 0xc5, 0x15, 0x8d, 0x99, 0x3c, 0x39, 0x95, 0xb7, 0x92,
+	0x7a, 0x88, 0x62, 0x76, 0xf0, 0x65, 0x38, 0x95, 0x1f, 0xc0, 0xb5, 0x38, 0x0b, 0x20, 0x39, 0xba,
+	0xfa, 0xf8, 0xe1, 0x24, 0x9a, 0x06, 0xcd, 0xb9, 0x88, 0x86, 0xd9, 0xa5, 0xc7, 0x6c, 0xf8, 0x7f,
+	0x6c, 0xc1, 0x85, 0xde, 0x78, 0x28, 0xae, 0x06, 0xa5, 0x74, 0x11, 0x29, 0xd3, 0xdc, 0xb6, 0x8e,
+	0xdf, 0xdc, 0x3e, 0x5d, 0x2d, 0x1e, 0x76, 0x18, 0x16, 0x17, 0x72, 0xef, 0x19, 0x8c, 0x3d, 0x65,
+	0x2f, 0x54, 0xeb, 0xa9, 0x2f, 0xd4, 0x9f, 0x9b, 0x7b, 0xbf, 0x43, 0x0e, 0xce, 0xf7, 0xf5, 0x82,
+	0xe4, 0xc3, 0xea, 0xff, 0x65, 0x46, 0x47, 0xd2, 0x31, 0xa8, 0xab, 0xf5, 0xe6, 0x57, 0x0e, 0x9c,
+	0x50, 0x9a, 0x13, 0x0e, 0xa3, 0xf8, 0x1c, 0x21, 0xf7, 0x4e, 0xac, 0xf3, 0x95, 0xb7, 0xbd, 0xda,
+	0x03, 0x43, 0x0b, 0x71, 0xce, 0xff, 0xfe, 0xaf, 0xff, 0xfd, 0xc7, 0x43, 0x67, 0xc9, 0x19, 0xe3,
+	0x96, 0x25, 0x66, 0xea, 0x55, 0xbc, 0x92, 0xf4, 0x7b, 0x30, 0xd1, 0x2e, 0x67, 0x9d, 0x2f, 0x60,
+	0x9a, 0x2f, 0x01, 0xda, 0x17, 0x7a, 0x23, 0xa1, 0xf0, 0x4b, 0x4a, 0xf8, 0x39, 0xb2, 0x5c, 0x28,
+	0x3c, 0xa9, 0xcb, 0x91, 0x3f, 0xb7, 0x60, 0x2e, 0xff, 0xce, 0x99, 0x5c, 0x2d, 0x10, 0xd1, 0xe5,
+	0xb5, 0xb4, 0x7d, 0xad, 0x2f, 0x5c, 0xd4, 0xaa, 0xac, 0xb4, 0x5a, 0x23, 0x97, 0x0a, 0xb5, 0xea,
+	0xf0, 0xe8, 0x72, 0x47, 0xf4, 0xa3, 0xe5, 0xc2, 0x1d, 0xc9, 0xbc, 0x89, 0xb6, 0x57, 0x7b, 0x60,
+	0xf4, 0xb5, 0x23, 0x4d, 0x2d, 0xe9, 0x2f, 0x2c, 0x98, 0xef, 0x78, 0xea, 0x4c, 0x0a, 0xa7, 0xd9,
+	0xe5, 0xc9, 0xb4, 0xfd, 0x42, 0x7f, 0xc8, 0xa8, 0xd5, 0x86, 0xd2, 0xea, 0x0a, 0xb9, 0x5c, 0xbc,
+	0x28, 0x92, 0xce, 0xcd, 0xbc, 0x81, 0xfe, 0x07, 0x0b, 0x16, 0x8b, 0xde, 0x92, 0x92, 0x72, 0x81,
+	0xdc, 0x1e, 0xaf, 0x62, 0xed, 0x8d, 0xbe, 0xf1, 0x51, 0xd5, 0x6f, 0x2b, 0x55, 0x5f, 0x26, 0x2f,
+	0x16, 0xaa, 0x9a, 0x3d, 0xdf, 0xee, 0x9e, 0x26, 0xde, 0xf8, 0x00, 0x01, 0x1f, 0x92, 0x8f, 0x2d,
+	0x98, 0x4a, 0xbf, 0xf5, 0x24, 0x97, 0xba, 0x9e, 0xa2, 0xcc, 0x73, 0x53, 0xfb, 0xf2, 0x91, 0x78,
+	0xa8, 0xe0, 0xba, 0x52, 0xf0, 0xf2, 0xab, 0xd6, 0x55, 0xc7, 0xe9, 0x71, 0xec, 0x5c, 0x5f, 0xcb,
+	0xe7, 0x30, 0xaa, 0x1f, 0x48, 0x16, 0xda, 0x57, 0xe6, 0x49, 0xa5, 0xbd, 0xda, 0x03, 0xa3, 0x2f,
+	0xfb, 0x8a, 0xb5, 0xa4, 0x3f, 0xb5, 0x60, 0x26, 0xfb, 0xc2, 0x8f, 0xac, 0x15, 0xb0, 0x2e, 0x7c,
+	0x57, 0x68, 0x5f, 0xe9, 0x03, 0x33, 0x6b, 0x56, 0x72, 0x29, 0x2e, 0x14, 0xea, 0x83, 0xdd, 0x79,
+	0x86, 0x0f, 0x2f, 0xa5, 0xe1, 0x4f, 0xa5, 0xfb, 0xf2, 0x85, 0xbb, 0x53, 0xf0, 0x4a, 0xc0, 0xbe,
+	0x7c, 0x24, 0x1e, 0xaa, 0xf4, 0x1d, 0xa5, 0xd2, 0x6f, 0x92, 0x97, 0x0a, 0xf5, 0xc9, 0xb4, 0xb4,
+	0x37, 0x3e, 0xe8, 0x78, 0x78, 0xf0, 0x21, 0xf9, 0x63, 0x0b, 0xa6, 0x33, 0xfd, 0x60, 0x52, 0x24,
+	0xba, 0xa8, 0x9f, 0x6c, 0xaf, 0x1d, 0x8d, 0x88, 0x4a, 0x5e, 0x53, 0x4a, 0x5e, 0x24, 0xe7, 0x8b,
+	0x9d, 0x84, 0x0e, 0xf0, 0x28, 0xca, 0x97, 0x1a, 0x65, 0x7a, 0xa3, 0x85, 0x1a, 0x15, 0xf5, 0x56,
+	0xed, 0xb5, 0xa3, 0x11, 0xfb, 0xd2, 0xc8, 0x74, 0x44, 0x75, 0x6f, 0x91, 0xfc, 0xa1, 0x05, 0x93,
+	0xa9, 0x72, 0x32, 0xb9, 0x58, 0x74, 0xc6, 0x3b, 0xea, 0xe5, 0xf6, 0xa5, 0xa3, 0xd0, 0x50, 0x97,
+	0x2b, 0x4a, 0x97, 0xf3, 0x64, 0xb5, 0xd8, 0x03, 0x30, 0xe6, 0x9a, 0x92, 0x33, 0xf9, 0xa9, 0x05,
+	0x0b, 0x05, 0x2d, 0x38, 0xb2, 0x5e, 0x64, 0x2e, 0x5d, 0x9b, 0x8a, 0x76, 0xb9, 0x5f, 0x74, 0xd4,
+	0xf0, 0x86, 0xd2, 0xf0, 0x1a, 0xb9, 0x52, 0x6c, 0x64, 0xe9, 0x98, 0x0b, 0x3d, 0x94, 0xbe, 0x04,
+	0xf3, 0xbd, 0xa5, 0xc2, 0x4b, 0xb0, 0xb8, 0x2d, 0x67, 0x5f, 0xeb, 0x0b, 0xb7, 0xbf, 0x4b, 0x30,
+	0xdf, 0x3a, 0x23, 0x3f, 0xb6, 0x60, 0x26, 0xdb, 0x5b, 0x21, 0xbd, 0x6c, 0x27, 0xd3, 0x9a, 0xb2,
+	0xaf, 0xf4, 0x81, 0x89, 0x7a, 0xbd, 0xa0, 0xf4, 0xba, 0x44, 0x2e, 0xf4, 0x36, 0x33, 0xec, 0x2c,
+	0xfd, 0x9d, 0x05, 0x27, 0x0b, 0x6b, 0xe7, 0xa4, 0xe8, 0x56, 0xe9, 0x55, 0x8b, 0xb7, 0xaf, 0xf7,
+	0x4f, 0x80, 0xaa, 0x7e, 0x43, 0xa9, 0xba, 0x4e, 0xae, 0x15, 0xab, 0x6a, 0x68, 0xdd, 0xf4, 0x6e,
+	0x93, 0x9f, 0xa9, 0x8b, 0x3d, 0x57, 0xdb, 0xec, 0x72, 0xb1, 0x17, 0x57, 0x65, 0xed, 0x17, 0xfa,
+	0x43, 0x46, 0x2d, 0x5f, 0x55, 0x5a, 0xfe, 0x06, 0xb9, 0xd9, 0xe5, 0x62, 0xd7, 0xd7, 0xa4, 0x04,
+	0xba, 0xbe, 0xa2, 0x4c, 0x5d, 0x95, 0xf2, 0x18, 0xa7, 0xea, 0x8e, 0x85, 0xc7, 0xb8, 0xb3, 0x66,
+	0x69, 0x5f, 0x3a, 0x0a, 0xad, 0xaf, 0x63, 0x9c, 0xae, 0x6c, 0xb6, 0x35, 0xd1, 0x15, 0xbe, 0xee,
+	0x9a, 0x64, 0xaa, 0x92, 0xf6, 0xa5, 0xa3, 0xd0, 0x8e, 0xa1, 0x89, 0xae, 0x5d, 0xaa, 0x63, 0x9a,
+	0xaf, 0xd7, 0x15, 0x1e, 0xd3, 0x2e, 0xb5, 0x47, 0xfb, 0x5a, 0x5f, 0xb8, 0x7d, 0x1d, 0xd3, 0xf6,
+	0xcb, 0xbb, 0xb4, 0x13, 0xc9, 0x57, 0xdf, 0x0a, 0xb5, 0xeb, 0x52, 0xc3, 0xb3, 0xaf, 0xf5, 0x85,
+	0xdb, 0x97, 0x76, 0xb1, 0x21, 0x73, 0x39, 0x2a, 0xf2, 0x57, 0x16, 0x90, 0xce, 0xca, 0x14, 0x29,
+	0x32, 0xe8, 0xae, 0x95, 0x2f, 0x7b, 0xbd, 0x4f, 0x6c, 0xd4, 0xf1, 0xba, 0xd2, 0xf1, 0x2a, 0x59,
+	0x2b, 0xd4, 0xb1, 0x85, 0x84, 0xe9, 0x78, 0xff, 0xbf, 0x2c, 0x38, 0x55, 0x5c, 0xf9, 0x21, 0xd7,
+	0xbb, 0xe6, 0x19, 0x5d, 0xca, 0x4f, 0xf6, 0x8d, 0x63, 0x50, 0xa0, 0xc6, 0x91, 0xd2, 0xf8, 0xbd,
+	0x77, 0x5f, 0x21, 0x2f, 0xf7, 0xca, 0x50, 0x30, 0xd0, 0x6d, 0x2b, 0x9e, 0x3a, 0xb8, 0xeb, 0xc7,
+	0x22, 0x4c, 0x85, 0x34, 0x58, 0xfb, 0xe9, 0x11, 0xd2, 0x64, 0x8b, 0x51, 0xf6, 0xda, 0xd1, 0x88,
+	0xc7, 0x09, 0x69, 0xb0, 0x66, 0x45, 0x3e, 0xce, 0xd6, 0x76, 0x2e, 0x74, 0x09, 0x7b, 0x33, 0x65,
+	0x29, 0xfb, 0xe2, 0x11, 0x58, 0x7d, 0xf9, 0x6d, 0xf5, 0x32, 0xd7, 0x55, 0x05, 0x9c, 0x8d, 0x0f,
+	0x4c, 0x95, 0xeb, 0x43, 0xf2, 0x43, 0x98, 0x48, 0xaa, 0x35, 0x85, 0x29, 0x72, 0xbe, 0xc8, 0x63,
+	0x5f, 0xe8, 0x8d, 0x84, 0xca, 0x5c, 0x56, 0xca, 0xac, 0x92, 0x95, 0xae, 0xd9, 0xa0, 0xae, 0x05,
+	0x91, 0x9f, 0x5b, 0x70, 0xba, 0x4b, 0xf5, 0x85, 0xdc, 0x38, 0x22, 0x3a, 0xe9, 0x2c, 0x11, 0xd9,
+	0x37, 0x8f, 0x43, 0x82, 0xba, 0xbe, 0xa8, 0x74, 0xdd, 0xe8, 0x62, 0x5d, 0xdd, 0x0a, 0x49, 0x44,
+	0xfe, 0x69, 0x6a, 0xfe, 0x0f, 0x59, 0xae, 0x1c, 0x95, 0xf4, 0xb5, 0xf3, 0xd8, 0xab, 0xfd, 0xa0,
+	0x66, 0x33, 0x2f, 0x72, 0xb1, 0x8f, 0xd4, 0x90, 0xc5, 0x5b, 0xdf, 0xfd, 0xe5, 0x17, 0xcb, 0xd6,
+	0xaf, 0xbe, 0x58, 0xb6, 0xfe, 0xed, 0x8b, 0x65, 0xeb, 0x47, 0x5f, 0x2e, 0x3f, 0xf7, 0xab, 0x2f,
+	0x97, 0x9f, 0xfb, 0x97, 0x2f, 0x97, 0x9f, 0x7b, 0x37, 0x5d, 0xcf, 0xf6, 0xeb, 0x81, 0x2f, 0x18,
+	0x5a, 0x68, 0xbc, 0x71, 0xa0, 0x99, 0xaa, 0x9a, 0xf6, 0xee, 0xa8, 0x7a, 0xae, 0xf4, 0x8d, 0xff,
+	0x1b, 0x00, 0xba, 0x0b, 0xc5, 0x51, 0xa2, 0x40, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// AnnualProvisions current minting annual provisions value.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// Minter returns the current minter state.
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// AdminCapabilities returns the messages executable by the module authority
+	// and whether they are currently available.
+	AdminCapabilities(ctx context.Context, in *QueryAdminCapabilitiesRequest, opts ...grpc.CallOption) (*QueryAdminCapabilitiesResponse, error)
+	// FundedAddressHistory returns the weight changes of a funded address.
+	FundedAddressHistory(ctx context.Context, in *QueryFundedAddressHistoryRequest, opts ...grpc.CallOption) (*QueryFundedAddressHistoryResponse, error)
+	// ParamsImpact returns the emissions of the first year under the current
+	// params and under the proposed params.
+	ParamsImpact(ctx context.Context, in *QueryParamsImpactRequest, opts ...grpc.CallOption) (*QueryParamsImpactResponse, error)
+	// Status returns the pause state of the module and the consistency of the
+	// supplies the provisions are computed against.
+	Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
+	// ValidateParams validates the params proposed to replace the params of the
+	// module and returns the values derived from them.
+	ValidateParams(ctx context.Context, in *QueryValidateParamsRequest, opts ...grpc.CallOption) (*QueryValidateParamsResponse, error)
+	// DelegatorAPR returns the estimated annual rate of the staking rewards
+	// minted for the delegators of a validator, net of the community tax and the
+	// commission of the validator.
+	DelegatorAPR(ctx context.Context, in *QueryDelegatorAPRRequest, opts ...grpc.CallOption) (*QueryDelegatorAPRResponse, error)
+	// ModuleAccount returns the balance of the module account broken down by
+	// ledger entry.
+	ModuleAccount(ctx context.Context, in *QueryModuleAccountRequest, opts ...grpc.CallOption) (*QueryModuleAccountResponse, error)
+	// EmissionDrift returns the drift of the realized emissions from the target
+	// emissions of the configured schedule.
+	EmissionDrift(ctx context.Context, in *QueryEmissionDriftRequest, opts ...grpc.CallOption) (*QueryEmissionDriftResponse, error)
+	// FeeAdvisory returns an advisory minimum gas price for the fees to cover a
+	// target ratio of the staking share of the block provision. The price is not
+	// used by consensus.
+	FeeAdvisory(ctx context.Context, in *QueryFeeAdvisoryRequest, opts ...grpc.CallOption) (*QueryFeeAdvisoryResponse, error)
+	// DistributionHistory returns the recorded allocations of the coins minted in
+	// each block from the oldest.
+	DistributionHistory(ctx context.Context, in *QueryDistributionHistoryRequest, opts ...grpc.CallOption) (*QueryDistributionHistoryResponse, error)
+	// AverageInflation returns the time-weighted mean of the inflation rates of
+	// the recorded blocks over a period and the fraction of the period covered by
+	// the records.
+	AverageInflation(ctx context.Context, in *QueryAverageInflationRequest, opts ...grpc.CallOption) (*QueryAverageInflationResponse, error)
+	// EmissionReport returns the emissions of a range of heights assembled from
+	// the recorded allocations, the hash of its canonical encoding and the
+	// context to verify the records with store proofs.
+	EmissionReport(ctx context.Context, in *QueryEmissionReportRequest, opts ...grpc.CallOption) (*QueryEmissionReportResponse, error)
+	// EstimatedDistribution returns the amounts the block provision of the
+	// minter is distributed in: the staking share sent to the fee collector, the
+	// community pool share and the share of each funded address.
+	EstimatedDistribution(ctx context.Context, in *QueryEstimatedDistributionRequest, opts ...grpc.CallOption) (*QueryEstimatedDistributionResponse, error)
+	// AddressMintIncome returns the shares of the minted coins distributed to a
+	// funded address in a range of heights from the recorded allocations, and
+	// the income of the address since it is tracked.
+	AddressMintIncome(ctx context.Context, in *QueryAddressMintIncomeRequest, opts ...grpc.CallOption) (*QueryAddressMintIncomeResponse, error)
+	// TotalBurned returns the total amount of each denom burned with MsgBurn.
+	TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error)
+	// TotalMinted returns the total amount of each denom minted by the module.
+	TotalMinted(ctx context.Context, in *QueryTotalMintedRequest, opts ...grpc.CallOption) (*QueryTotalMintedResponse, error)
+	// InflationHistory returns the snapshots of the inflation recorded every
+	// inflation_snapshot_interval blocks from the oldest.
+	InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error)
+	// StrategicReserve returns the balance of the strategic reserve, the share
+	// accrued in it and the amount released from it.
+	StrategicReserve(ctx context.Context, in *QueryStrategicReserveRequest, opts ...grpc.CallOption) (*QueryStrategicReserveResponse, error)
+	// UpcomingProvisions returns the provisions of the next blocks simulated
+	// from the current state and the schedule of the params, at most 10000
+	// blocks.
+	UpcomingProvisions(ctx context.Context, in *QueryUpcomingProvisionsRequest, opts ...grpc.CallOption) (*QueryUpcomingProvisionsResponse, error)
+	// AnnualFundedProvisions returns the coins projected to be distributed to
+	// the funded addresses over a year at the current annual provisions and
+	// params, for a single funded address or all of them.
+	AnnualFundedProvisions(ctx context.Context, in *QueryAnnualFundedProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualFundedProvisionsResponse, error)
+	// ModuleVersion returns the consensus version of the module, the behavior
+	// revision of the version and the migrations completed on the chain.
+	ModuleVersion(ctx context.Context, in *QueryModuleVersionRequest, opts ...grpc.CallOption) (*QueryModuleVersionResponse, error)
+	// StateProof returns the values of the store keys holding a part of the mint
+	// state at a height, with the commitment proofs of the values, or of their
+	// absence, against the app hash of the height.
+	StateProof(ctx context.Context, in *QueryStateProofRequest, opts ...grpc.CallOption) (*QueryStateProofResponse, error)
+	// MintDenom returns the mint_denom param.
+	MintDenom(ctx context.Context, in *QueryMintDenomRequest, opts ...grpc.CallOption) (*QueryMintDenomResponse, error)
+	// DistributionProportions returns the distribution_proportions param.
+	DistributionProportions(ctx context.Context, in *QueryDistributionProportionsRequest, opts ...grpc.CallOption) (*QueryDistributionProportionsResponse, error)
+	// FundedAddresses returns the funded_addresses param in the order of the
+	// params, paginated by address.
+	FundedAddresses(ctx context.Context, in *QueryFundedAddressesRequest, opts ...grpc.CallOption) (*QueryFundedAddressesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/Minter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminCapabilities(ctx context.Context, in *QueryAdminCapabilitiesRequest, opts ...grpc.CallOption) (*QueryAdminCapabilitiesResponse, error) {
+	out := new(QueryAdminCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/AdminCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundedAddressHistory(ctx context.Context, in *QueryFundedAddressHistoryRequest, opts ...grpc.CallOption) (*QueryFundedAddressHistoryResponse, error) {
+	out := new(QueryFundedAddressHistoryResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/FundedAddressHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsImpact(ctx context.Context, in *QueryParamsImpactRequest, opts ...grpc.CallOption) (*QueryParamsImpactResponse, error) {
+	out := new(QueryParamsImpactResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/ParamsImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
+	out := new(QueryStatusResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateParams(ctx context.Context, in *QueryValidateParamsRequest, opts ...grpc.CallOption) (*QueryValidateParamsResponse, error) {
+	out := new(QueryValidateParamsResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/ValidateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorAPR(ctx context.Context, in *QueryDelegatorAPRRequest, opts ...grpc.CallOption) (*QueryDelegatorAPRResponse, error) {
+	out := new(QueryDelegatorAPRResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/DelegatorAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccount(ctx context.Context, in *QueryModuleAccountRequest, opts ...grpc.CallOption) (*QueryModuleAccountResponse, error) {
+	out := new(QueryModuleAccountResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/ModuleAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionDrift(ctx context.Context, in *QueryEmissionDriftRequest, opts ...grpc.CallOption) (*QueryEmissionDriftResponse, error) {
+	out := new(QueryEmissionDriftResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/EmissionDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAdvisory(ctx context.Context, in *QueryFeeAdvisoryRequest, opts ...grpc.CallOption) (*QueryFeeAdvisoryResponse, error) {
+	out := new(QueryFeeAdvisoryResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/FeeAdvisory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionHistory(ctx context.Context, in *QueryDistributionHistoryRequest, opts ...grpc.CallOption) (*QueryDistributionHistoryResponse, error) {
+	out := new(QueryDistributionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/DistributionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AverageInflation(ctx context.Context, in *QueryAverageInflationRequest, opts ...grpc.CallOption) (*QueryAverageInflationResponse, error) {
+	out := new(QueryAverageInflationResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/AverageInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionReport(ctx context.Context, in *QueryEmissionReportRequest, opts ...grpc.CallOption) (*QueryEmissionReportResponse, error) {
+	out := new(QueryEmissionReportResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/EmissionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimatedDistribution(ctx context.Context, in *QueryEstimatedDistributionRequest, opts ...grpc.CallOption) (*QueryEstimatedDistributionResponse, error) {
+	out := new(QueryEstimatedDistributionResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/EstimatedDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressMintIncome(ctx context.Context, in *QueryAddressMintIncomeRequest, opts ...grpc.CallOption) (*QueryAddressMintIncomeResponse, error) {
+	out := new(QueryAddressMintIncomeResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/AddressMintIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error) {
+	out := new(QueryTotalBurnedResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/TotalBurned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalMinted(ctx context.Context, in *QueryTotalMintedRequest, opts ...grpc.CallOption) (*QueryTotalMintedResponse, error) {
+	out := new(QueryTotalMintedResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/TotalMinted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error) {
+	out := new(QueryInflationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/InflationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StrategicReserve(ctx context.Context, in *QueryStrategicReserveRequest, opts ...grpc.CallOption) (*QueryStrategicReserveResponse, error) {
+	out := new(QueryStrategicReserveResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/StrategicReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpcomingProvisions(ctx context.Context, in *QueryUpcomingProvisionsRequest, opts ...grpc.CallOption) (*QueryUpcomingProvisionsResponse, error) {
+	out := new(QueryUpcomingProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/UpcomingProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualFundedProvisions(ctx context.Context, in *QueryAnnualFundedProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualFundedProvisionsResponse, error) {
+	out := new(QueryAnnualFundedProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/AnnualFundedProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleVersion(ctx context.Context, in *QueryModuleVersionRequest, opts ...grpc.CallOption) (*QueryModuleVersionResponse, error) {
+	out := new(QueryModuleVersionResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/ModuleVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StateProof(ctx context.Context, in *QueryStateProofRequest, opts ...grpc.CallOption) (*QueryStateProofResponse, error) {
+	out := new(QueryStateProofResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/StateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintDenom(ctx context.Context, in *QueryMintDenomRequest, opts ...grpc.CallOption) (*QueryMintDenomResponse, error) {
+	out := new(QueryMintDenomResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/MintDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionProportions(ctx context.Context, in *QueryDistributionProportionsRequest, opts ...grpc.CallOption) (*QueryDistributionProportionsResponse, error) {
+	out := new(QueryDistributionProportionsResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/DistributionProportions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundedAddresses(ctx context.Context, in *QueryFundedAddressesRequest, opts ...grpc.CallOption) (*QueryFundedAddressesResponse, error) {
+	out := new(QueryFundedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/modules.mint.Query/FundedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// AnnualProvisions current minting annual provisions value.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// Minter returns the current minter state.
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// AdminCapabilities returns the messages executable by the module authority
+	// and whether they are currently available.
+	AdminCapabilities(context.Context, *QueryAdminCapabilitiesRequest) (*QueryAdminCapabilitiesResponse, error)
+	// FundedAddressHistory returns the weight changes of a funded address.
+	FundedAddressHistory(context.Context, *QueryFundedAddressHistoryRequest) (*QueryFundedAddressHistoryResponse, error)
+	// ParamsImpact returns the emissions of the first year under the current
+	// params and under the proposed params.
+	ParamsImpact(context.Context, *QueryParamsImpactRequest) (*QueryParamsImpactResponse, error)
+	// Status returns the pause state of the module and the consistency of the
+	// supplies the provisions are computed against.
+	Status(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
+	// ValidateParams validates the params proposed to replace the params of the
+	// module and returns the values derived from them.
+	ValidateParams(context.Context, *QueryValidateParamsRequest) (*QueryValidateParamsResponse, error)
+	// DelegatorAPR returns the estimated annual rate of the staking rewards
+	// minted for the delegators of a validator, net of the community tax and the
+	// commission of the validator.
+	DelegatorAPR(context.Context, *QueryDelegatorAPRRequest) (*QueryDelegatorAPRResponse, error)
+	// ModuleAccount returns the balance of the module account broken down by
+	// ledger entry.
+	ModuleAccount(context.Context, *QueryModuleAccountRequest) (*QueryModuleAccountResponse, error)
+	// EmissionDrift returns the drift of the realized emissions from the target
+	// emissions of the configured schedule.
+	EmissionDrift(context.Context, *QueryEmissionDriftRequest) (*QueryEmissionDriftResponse, error)
+	// FeeAdvisory returns an advisory minimum gas price for the fees to cover a
+	// target ratio of the staking share of the block provision. The price is not
+	// used by consensus.
+	FeeAdvisory(context.Context, *QueryFeeAdvisoryRequest) (*QueryFeeAdvisoryResponse, error)
+	// DistributionHistory returns the recorded allocations of the coins minted in
+	// each block from the oldest.
+	DistributionHistory(context.Context, *QueryDistributionHistoryRequest) (*QueryDistributionHistoryResponse, error)
+	// AverageInflation returns the time-weighted mean of the inflation rates of
+	// the recorded blocks over a period and the fraction of the period covered by
+	// the records.
+	AverageInflation(context.Context, *QueryAverageInflationRequest) (*QueryAverageInflationResponse, error)
+	// EmissionReport returns the emissions of a range of heights assembled from
+	// the recorded allocations, the hash of its canonical encoding and the
+	// context to verify the records with store proofs.
+	EmissionReport(context.Context, *QueryEmissionReportRequest) (*QueryEmissionReportResponse, error)
+	// EstimatedDistribution returns the amounts the block provision of the
+	// minter is distributed in: the staking share sent to the fee collector, the
+	// community pool share and the share of each funded address.
+	EstimatedDistribution(context.Context, *QueryEstimatedDistributionRequest) (*QueryEstimatedDistributionResponse, error)
+	// AddressMintIncome returns the shares of the minted coins distributed to a
+	// funded address in a range of heights from the recorded allocations, and
+	// the income of the address since it is tracked.
+	AddressMintIncome(context.Context, *QueryAddressMintIncomeRequest) (*QueryAddressMintIncomeResponse, error)
+	// TotalBurned returns the total amount of each denom burned with MsgBurn.
+	TotalBurned(context.Context, *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error)
+	// TotalMinted returns the total amount of each denom minted by the module.
+	TotalMinted(context.Context, *QueryTotalMintedRequest) (*QueryTotalMintedResponse, error)
+	// InflationHistory returns the snapshots of the inflation recorded every
+	// inflation_snapshot_interval blocks from the oldest.
+	InflationHistory(context.Context, *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error)
+	// StrategicReserve returns the balance of the strategic reserve, the share
+	// accrued in it and the amount released from it.
+	StrategicReserve(context.Context, *QueryStrategicReserveRequest) (*QueryStrategicReserveResponse, error)
+	// UpcomingProvisions returns the provisions of the next blocks simulated
+	// from the current state and the schedule of the params, at most 10000
+	// blocks.
+	UpcomingProvisions(context.Context, *QueryUpcomingProvisionsRequest) (*QueryUpcomingProvisionsResponse, error)
+	// AnnualFundedProvisions returns the coins projected to be distributed to
+	// the funded addresses over a year at the current annual provisions and
+	// params, for a single funded address or all of them.
+	AnnualFundedProvisions(context.Context, *QueryAnnualFundedProvisionsRequest) (*QueryAnnualFundedProvisionsResponse, error)
+	// ModuleVersion returns the consensus version of the module, the behavior
+	// revision of the version and the migrations completed on the chain.
+	ModuleVersion(context.Context, *QueryModuleVersionRequest) (*QueryModuleVersionResponse, error)
+	// StateProof returns the values of the store keys holding a part of the mint
+	// state at a height, with the commitment proofs of the values, or of their
+	// absence, against the app hash of the height.
+	StateProof(context.Context, *QueryStateProofRequest) (*QueryStateProofResponse, error)
+	// MintDenom returns the mint_denom param.
+	MintDenom(context.Context, *QueryMintDenomRequest) (*QueryMintDenomResponse, error)
+	// DistributionProportions returns the distribution_proportions param.
+	DistributionProportions(context.Context, *QueryDistributionProportionsRequest) (*QueryDistributionProportionsResponse, error)
+	// FundedAddresses returns the funded_addresses param in the order of the
+	// params, paginated by address.
+	FundedAddresses(context.Context, *QueryFundedAddressesRequest) (*QueryFundedAddressesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) Minter(ctx context.Context, req *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (*UnimplementedQueryServer) AdminCapabilities(ctx context.Context, req *QueryAdminCapabilitiesRequest) (*QueryAdminCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCapabilities not implemented")
+}
+func (*UnimplementedQueryServer) FundedAddressHistory(ctx context.Context, req *QueryFundedAddressHistoryRequest) (*QueryFundedAddressHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundedAddressHistory not implemented")
+}
+func (*UnimplementedQueryServer) ParamsImpact(ctx context.Context, req *QueryParamsImpactRequest) (*QueryParamsImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsImpact not implemented")
+}
+func (*UnimplementedQueryServer) Status(ctx context.Context, req *QueryStatusRequest) (*QueryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedQueryServer) ValidateParams(ctx context.Context, req *QueryValidateParamsRequest) (*QueryValidateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateParams not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorAPR(ctx context.Context, req *QueryDelegatorAPRRequest) (*QueryDelegatorAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAPR not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccount(ctx context.Context, req *QueryModuleAccountRequest) (*QueryModuleAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccount not implemented")
+}
+func (*UnimplementedQueryServer) EmissionDrift(ctx context.Context, req *QueryEmissionDriftRequest) (*QueryEmissionDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionDrift not implemented")
+}
+func (*UnimplementedQueryServer) FeeAdvisory(ctx context.Context, req *QueryFeeAdvisoryRequest) (*QueryFeeAdvisoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAdvisory not implemented")
+}
+func (*UnimplementedQueryServer) DistributionHistory(ctx context.Context, req *QueryDistributionHistoryRequest) (*QueryDistributionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionHistory not implemented")
+}
+func (*UnimplementedQueryServer) AverageInflation(ctx context.Context, req *QueryAverageInflationRequest) (*QueryAverageInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AverageInflation not implemented")
+}
+func (*UnimplementedQueryServer) EmissionReport(ctx context.Context, req *QueryEmissionReportRequest) (*QueryEmissionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionReport not implemented")
+}
+func (*UnimplementedQueryServer) EstimatedDistribution(ctx context.Context, req *QueryEstimatedDistributionRequest) (*QueryEstimatedDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatedDistribution not implemented")
+}
+func (*UnimplementedQueryServer) AddressMintIncome(ctx context.Context, req *QueryAddressMintIncomeRequest) (*QueryAddressMintIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressMintIncome not implemented")
+}
+func (*UnimplementedQueryServer) TotalBurned(ctx context.Context, req *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBurned not implemented")
+}
+func (*UnimplementedQueryServer) TotalMinted(ctx context.Context, req *QueryTotalMintedRequest) (*QueryTotalMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalMinted not implemented")
+}
+func (*UnimplementedQueryServer) InflationHistory(ctx context.Context, req *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationHistory not implemented")
+}
+func (*UnimplementedQueryServer) StrategicReserve(ctx context.Context, req *QueryStrategicReserveRequest) (*QueryStrategicReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StrategicReserve not implemented")
+}
+func (*UnimplementedQueryServer) UpcomingProvisions(ctx context.Context, req *QueryUpcomingProvisionsRequest) (*QueryUpcomingProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingProvisions not implemented")
+}
+func (*UnimplementedQueryServer) AnnualFundedProvisions(ctx context.Context, req *QueryAnnualFundedProvisionsRequest) (*QueryAnnualFundedProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualFundedProvisions not implemented")
+}
+func (*UnimplementedQueryServer) ModuleVersion(ctx context.Context, req *QueryModuleVersionRequest) (*QueryModuleVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersion not implemented")
+}
+func (*UnimplementedQueryServer) StateProof(ctx context.Context, req *QueryStateProofRequest) (*QueryStateProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateProof not implemented")
+}
+func (*UnimplementedQueryServer) MintDenom(ctx context.Context, req *QueryMintDenomRequest) (*QueryMintDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintDenom not implemented")
+}
+func (*UnimplementedQueryServer) DistributionProportions(ctx context.Context, req *QueryDistributionProportionsRequest) (*QueryDistributionProportionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionProportions not implemented")
+}
+func (*UnimplementedQueryServer) FundedAddresses(ctx context.Context, req *QueryFundedAddressesRequest) (*QueryFundedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundedAddresses not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/Minter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/AdminCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminCapabilities(ctx, req.(*QueryAdminCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundedAddressHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundedAddressHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundedAddressHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/FundedAddressHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundedAddressHistory(ctx, req.(*QueryFundedAddressHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/ParamsImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsImpact(ctx, req.(*QueryParamsImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Status(ctx, req.(*QueryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/ValidateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateParams(ctx, req.(*QueryValidateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/DelegatorAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAPR(ctx, req.(*QueryDelegatorAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/ModuleAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccount(ctx, req.(*QueryModuleAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/EmissionDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionDrift(ctx, req.(*QueryEmissionDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAdvisory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeAdvisoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAdvisory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/FeeAdvisory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAdvisory(ctx, req.(*QueryFeeAdvisoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/DistributionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionHistory(ctx, req.(*QueryDistributionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AverageInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAverageInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AverageInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/AverageInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AverageInflation(ctx, req.(*QueryAverageInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/EmissionReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionReport(ctx, req.(*QueryEmissionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimatedDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimatedDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimatedDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/EstimatedDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimatedDistribution(ctx, req.(*QueryEstimatedDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressMintIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressMintIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressMintIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/AddressMintIncome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressMintIncome(ctx, req.(*QueryAddressMintIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/TotalBurned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBurned(ctx, req.(*QueryTotalBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalMinted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/TotalMinted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalMinted(ctx, req.(*QueryTotalMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/InflationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationHistory(ctx, req.(*QueryInflationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StrategicReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStrategicReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StrategicReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/StrategicReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StrategicReserve(ctx, req.(*QueryStrategicReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpcomingProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/UpcomingProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingProvisions(ctx, req.(*QueryUpcomingProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualFundedProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualFundedProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualFundedProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/AnnualFundedProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualFundedProvisions(ctx, req.(*QueryAnnualFundedProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/ModuleVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleVersion(ctx, req.(*QueryModuleVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/StateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StateProof(ctx, req.(*QueryStateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/MintDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintDenom(ctx, req.(*QueryMintDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionProportions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionProportionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionProportions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/DistributionProportions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionProportions(ctx, req.(*QueryDistributionProportionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modules.mint.Query/FundedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundedAddresses(ctx, req.(*QueryFundedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "modules.mint.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "AdminCapabilities",
+			Handler:    _Query_AdminCapabilities_Handler,
+		},
+		{
+			MethodName: "FundedAddressHistory",
+			Handler:    _Query_FundedAddressHistory_Handler,
+		},
+		{
+			MethodName: "ParamsImpact",
+			Handler:    _Query_ParamsImpact_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Query_Status_Handler,
+		},
+		{
+			MethodName: "ValidateParams",
+			Handler:    _Query_ValidateParams_Handler,
+		},
+		{
+			MethodName: "DelegatorAPR",
+			Handler:    _Query_DelegatorAPR_Handler,
+		},
+		{
+			MethodName: "ModuleAccount",
+			Handler:    _Query_ModuleAccount_Handler,
+		},
+		{
+			MethodName: "EmissionDrift",
+			Handler:    _Query_EmissionDrift_Handler,
+		},
+		{
+			MethodName: "FeeAdvisory",
+			Handler:    _Query_FeeAdvisory_Handler,
+		},
+		{
+			MethodName: "DistributionHistory",
+			Handler:    _Query_DistributionHistory_Handler,
+		},
+		{
+			MethodName: "AverageInflation",
+			Handler:    _Query_AverageInflation_Handler,
+		},
+		{
+			MethodName: "EmissionReport",
+			Handler:    _Query_EmissionReport_Handler,
+		},
+		{
+			MethodName: "EstimatedDistribution",
+			Handler:    _Query_EstimatedDistribution_Handler,
+		},
+		{
+			MethodName: "AddressMintIncome",
+			Handler:    _Query_AddressMintIncome_Handler,
+		},
+		{
+			MethodName: "TotalBurned",
+			Handler:    _Query_TotalBurned_Handler,
+		},
+		{
+			MethodName: "TotalMinted",
+			Handler:    _Query_TotalMinted_Handler,
+		},
+		{
+			MethodName: "InflationHistory",
+			Handler:    _Query_InflationHistory_Handler,
+		},
+		{
+			MethodName: "StrategicReserve",
+			Handler:    _Query_StrategicReserve_Handler,
+		},
+		{
+			MethodName: "UpcomingProvisions",
+			Handler:    _Query_UpcomingProvisions_Handler,
+		},
+		{
+			MethodName: "AnnualFundedProvisions",
+			Handler:    _Query_AnnualFundedProvisions_Handler,
+		},
+		{
+			MethodName: "ModuleVersion",
+			Handler:    _Query_ModuleVersion_Handler,
+		},
+		{
+			MethodName: "StateProof",
+			Handler:    _Query_StateProof_Handler,
+		},
+		{
+			MethodName: "MintDenom",
+			Handler:    _Query_MintDenom_Handler,
+		},
+		{
+			MethodName: "DistributionProportions",
+			Handler:    _Query_DistributionProportions_Handler,
+		},
+		{
+			MethodName: "FundedAddresses",
+			Handler:    _Query_FundedAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "modules/mint/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verbose {
+		i--
+		if m.Verbose {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Derived != nil {
+		{
+			size, err := m.Derived.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivePhase != nil {
+		{
+			size, err := m.ActivePhase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastChange != nil {
+		{
+			size, err := m.LastChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminCapabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminCapabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminCapabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminCapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminCapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminCapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParamDescriptors) > 0 {
+		for iNdEx := len(m.ParamDescriptors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamDescriptors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authorities) > 0 {
+		for iNdEx := len(m.Authorities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Authorities[iNdEx])
+			copy(dAtA[i:], m.Authorities[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Authorities[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminCapability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminCapability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminCapability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnavailableReason) > 0 {
+		i -= len(m.UnavailableReason)
+		copy(dAtA[i:], m.UnavailableReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnavailableReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausedShareMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PausedShareMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommunityShare {
+		i--
+		if m.CommunityShare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FundedShare {
+		i--
+		if m.FundedShare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StakingShare {
+		i--
+		if m.StakingShare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Minting {
+		i--
+		if m.Minting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundedAddressHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundedAddressHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundedAddressHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundedAddressHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundedAddressHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundedAddressHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProposedParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CategoryDeltas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Proposed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InitializedAtHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InitializedAtHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivePhase != nil {
+		{
+			size, err := m.ActivePhase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastParamsChange != nil {
+		{
+			size, err := m.LastParamsChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.DenomConsistency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Effective != nil {
+		{
+			size, err := m.Effective.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsFieldError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsFieldError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsFieldError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InflationClamp) > 0 {
+		i -= len(m.InflationClamp)
+		copy(dAtA[i:], m.InflationClamp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InflationClamp)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.NextInflation.Size()
+		i -= size
+		if _, err := m.NextInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BufferedProportion.Size()
+		i -= size
+		if _, err := m.BufferedProportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Proportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NormalizedFundedAddresses) > 0 {
+		for iNdEx := len(m.NormalizedFundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizedFundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InflationMaxBps != nil {
+		{
+			size, err := m.InflationMaxBps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.InflationMinBps != nil {
+		{
+			size, err := m.InflationMinBps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MintingSkipped {
+		i--
+		if m.MintingSkipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AutoPaused {
+		i--
+		if m.AutoPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.MaxSupplyHeadroom.Size()
+		i -= size
+		if _, err := m.MaxSupplyHeadroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxSupplyUtilization.Size()
+		i -= size
+		if _, err := m.MaxSupplyUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxSupplyCapped {
+		i--
+		if m.MaxSupplyCapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FundedAddressesOutOfWindow) > 0 {
+		for iNdEx := len(m.FundedAddressesOutOfWindow) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FundedAddressesOutOfWindow[iNdEx])
+			copy(dAtA[i:], m.FundedAddressesOutOfWindow[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FundedAddressesOutOfWindow[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.BootstrapOverrideActive {
+		i--
+		if m.BootstrapOverrideActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GoalBondedTransition != nil {
+		{
+			size, err := m.GoalBondedTransition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.NextPhase != nil {
+		{
+			size, err := m.NextPhase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ActivePhase != nil {
+		{
+			size, err := m.ActivePhase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BufferedProportion.Size()
+		i -= size
+		if _, err := m.BufferedProportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.EffectiveProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DelegatorApr.Size()
+		i -= size
+		if _, err := m.DelegatorApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakingApr.Size()
+		i -= size
+		if _, err := m.StakingApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalBuffered) > 0 {
+		for iNdEx := len(m.TotalBuffered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBuffered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionDriftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionDriftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionDriftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionDriftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionDriftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionDriftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CarryBuffer.Size()
+		i -= size
+		if _, err := m.CarryBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RelativeDrift.Size()
+		i -= size
+		if _, err := m.RelativeDrift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Drift.Size()
+		i -= size
+		if _, err := m.Drift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RealizedEmission.Size()
+		i -= size
+		if _, err := m.RealizedEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TargetEmission.Size()
+		i -= size
+		if _, err := m.TargetEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAverageInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAverageInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAverageInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ToTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintQuery(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x12
+	n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FromTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FromTime):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintQuery(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAverageInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAverageInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAverageInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoveredFraction.Size()
+		i -= size
+		if _, err := m.CoveredFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.AverageInflation.Size()
+		i -= size
+		if _, err := m.AverageInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofContext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionReportProofContext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionReportProofContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionReportProofContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyPrefix) > 0 {
+		i -= len(m.KeyPrefix)
+		copy(dAtA[i:], m.KeyPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeAdvisoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeAdvisoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeAdvisoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AverageBlockGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TargetFeeCoverageRatio.Size()
+		i -= size
+		if _, err := m.TargetFeeCoverageRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeAdvisoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeAdvisoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeAdvisoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetBlockFees.Size()
+		i -= size
+		if _, err := m.TargetBlockFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakingBlockProvision.Size()
+		i -= size
+		if _, err := m.StakingBlockProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AdvisoryMinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FundedAddressesDust.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TruncationRemainder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FundedAddresses) > 0 {
+		for iNdEx := len(m.FundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.CommunityPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Staking.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BlockProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FundedAddressAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundedAddressAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundedAddressAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressMintIncomeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressMintIncomeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressMintIncomeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressMintIncomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressMintIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressMintIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lifetime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RecordedBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalMintedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMintedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalMintedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMintedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalMinted) > 0 {
+		for iNdEx := len(m.TotalMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrategicReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrategicReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrategicReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStrategicReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStrategicReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStrategicReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Accrued.Size()
+		i -= size
+		if _, err := m.Accrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FirstClampedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FirstClampedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provisions) > 0 {
+		for iNdEx := len(m.Provisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Provisions[iNdEx].Size()
+				i -= size
+				if _, err := m.Provisions[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualFundedProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualFundedProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualFundedProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualFundedProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualFundedProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualFundedProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnualProvisions) > 0 {
+		for iNdEx := len(m.AnnualProvisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualProvisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ModuleVersion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.KeyName) > 0 {
+		i -= len(m.KeyName)
+		copy(dAtA[i:], m.KeyName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionProportionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionProportionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionProportionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionProportionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionProportionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionProportionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FundedAddresses) > 0 {
+		for iNdEx := len(m.FundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verbose {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChange != nil {
+		l = m.LastChange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActivePhase != nil {
+		l = m.ActivePhase.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Derived != nil {
+		l = m.Derived.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAdminCapabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAdminCapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorities) > 0 {
+		for _, s := range m.Authorities {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Capabilities) > 0 {
+		for _, e := range m.Capabilities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PauseState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ParamDescriptors) > 0 {
+		for _, e := range m.ParamDescriptors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminCapability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Available {
+		n += 2
+	}
+	l = len(m.UnavailableReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Minting {
+		n += 2
+	}
+	if m.StakingShare {
+		n += 2
+	}
+	if m.FundedShare {
+		n += 2
+	}
+	if m.CommunityShare {
+		n += 2
+	}
+	if m.PausedShareMode != 0 {
+		n += 1 + sovQuery(uint64(m.PausedShareMode))
+	}
+	return n
+}
+
+func (m *QueryFundedAddressHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundedAddressHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsImpactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProposedParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Proposed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CategoryDeltas.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PauseState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DenomConsistency.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastParamsChange != nil {
+		l = m.LastParamsChange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActivePhase != nil {
+		l = m.ActivePhase.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InitializedAtHeight != 0 {
+		n += 1 + sovQuery(uint64(m.InitializedAtHeight))
+	}
+	return n
+}
+
+func (m *QueryValidateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Effective != nil {
+		l = m.Effective.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsFieldError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EffectiveParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.NormalizedFundedAddresses) > 0 {
+		for _, e := range m.NormalizedFundedAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Proportions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BufferedProportion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NextInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.InflationClamp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DerivedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.EffectiveProportions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BufferedProportion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ActivePhase != nil {
+		l = m.ActivePhase.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextPhase != nil {
+		l = m.NextPhase.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GoalBondedTransition != nil {
+		l = m.GoalBondedTransition.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BootstrapOverrideActive {
+		n += 2
+	}
+	if len(m.FundedAddressesOutOfWindow) > 0 {
+		for _, s := range m.FundedAddressesOutOfWindow {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxSupplyCapped {
+		n += 2
+	}
+	l = m.MaxSupplyUtilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxSupplyHeadroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PauseState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AutoPaused {
+		n += 3
+	}
+	if m.MintingSkipped {
+		n += 3
+	}
+	if m.InflationMinBps != nil {
+		l = m.InflationMinBps.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.InflationMaxBps != nil {
+		l = m.InflationMaxBps.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegatorApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalBuffered) > 0 {
+		for _, e := range m.TotalBuffered {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEmissionDriftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionDriftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RealizedEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Drift.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RelativeDrift.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CarryBuffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDistributionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAverageInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FromTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAverageInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AverageInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CoveredFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryEmissionReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Report.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofContext.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EmissionReportProofContext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeAdvisoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetFeeCoverageRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AverageBlockGas != 0 {
+		n += 1 + sovQuery(uint64(m.AverageBlockGas))
+	}
+	return n
+}
+
+func (m *QueryFeeAdvisoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AdvisoryMinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StakingBlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TargetBlockFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimatedDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEstimatedDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Staking.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.FundedAddresses) > 0 {
+		for _, e := range m.FundedAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TruncationRemainder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FundedAddressesDust.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *FundedAddressAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAddressMintIncomeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryAddressMintIncomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RecordedBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RecordedBlocks))
+	}
+	l = m.Lifetime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalMintedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalMintedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalMinted) > 0 {
+		for _, e := range m.TotalMinted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInflationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStrategicReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStrategicReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Accrued.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpcomingProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryUpcomingProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Provisions) > 0 {
+		for _, e := range m.Provisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FirstClampedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FirstClampedHeight))
+	}
+	return n
+}
+
+func (m *QueryAnnualFundedProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAnnualFundedProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AnnualProvisions) > 0 {
+		for _, e := range m.AnnualProvisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ModuleVersion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStateProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryStateProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionProportionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributionProportionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundedAddresses) > 0 {
+		for _, e := range m.FundedAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verbose = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastChange == nil {
+				m.LastChange = &ParamsChange{}
+			}
+			if err := m.LastChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivePhase == nil {
+				m.ActivePhase = &Phase{}
+			}
+			if err := m.ActivePhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derived", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Derived == nil {
+				m.Derived = &DerivedParams{}
+			}
+			if err := m.Derived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminCapabilitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminCapabilitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminCapabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminCapabilitiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminCapabilitiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorities = append(m.Authorities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, AdminCapability{})
+			if err := m.Capabilities[len(m.Capabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamDescriptors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamDescriptors = append(m.ParamDescriptors, ParamDescriptor{})
+			if err := m.ParamDescriptors[len(m.ParamDescriptors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminCapability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminCapability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminCapability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Available = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnavailableReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Minting = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingShare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakingShare = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedShare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FundedShare = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityShare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommunityShare = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedShareMode", wireType)
+			}
+			m.PausedShareMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedShareMode |= PausedShareMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundedAddressHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundedAddressHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundedAddressHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundedAddressHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundedAddressHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundedAddressHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, FundedAddressWeightChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsImpactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsImpactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsImpactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsImpactResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryDeltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CategoryDeltas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomConsistency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomConsistency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastParamsChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastParamsChange == nil {
+				m.LastParamsChange = &ParamsChange{}
+			}
+			if err := m.LastParamsChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivePhase == nil {
+				m.ActivePhase = &Phase{}
+			}
+			if err := m.ActivePhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitializedAtHeight", wireType)
+			}
+			m.InitializedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitializedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, ParamsFieldError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Effective == nil {
+				m.Effective = &EffectiveParams{}
+			}
+			if err := m.Effective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsFieldError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsFieldError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsFieldError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedFundedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedFundedAddresses = append(m.NormalizedFundedAddresses, WeightedAddress{})
+			if err := m.NormalizedFundedAddresses[len(m.NormalizedFundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedProportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BufferedProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationClamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationClamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedProportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BufferedProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivePhase == nil {
+				m.ActivePhase = &Phase{}
+			}
+			if err := m.ActivePhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextPhase == nil {
+				m.NextPhase = &Phase{}
+			}
+			if err := m.NextPhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBondedTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoalBondedTransition == nil {
+				m.GoalBondedTransition = &GoalBondedTransition{}
+			}
+			if err := m.GoalBondedTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapOverrideActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BootstrapOverrideActive = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddressesOutOfWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundedAddressesOutOfWindow = append(m.FundedAddressesOutOfWindow, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyCapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSupplyCapped = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupplyUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyHeadroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupplyHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoPaused = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingSkipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintingSkipped = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMinBps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InflationMinBps == nil {
+				m.InflationMinBps = &BasisPoints{}
+			}
+			if err := m.InflationMinBps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMaxBps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InflationMaxBps == nil {
+				m.InflationMaxBps = &BasisPoints{}
+			}
+			if err := m.InflationMaxBps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, LedgerEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBuffered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBuffered = append(m.TotalBuffered, types.Coin{})
+			if err := m.TotalBuffered[len(m.TotalBuffered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionDriftRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionDriftRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionDriftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionDriftResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionDriftResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionDriftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Drift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeDrift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelativeDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarryBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, BlockDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAverageInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAverageInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAverageInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAverageInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAverageInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAverageInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoveredFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionReportProofContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionReportProofContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionReportProofContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = append(m.KeyPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyPrefix == nil {
+				m.KeyPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeAdvisoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeAdvisoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeAdvisoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFeeCoverageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetFeeCoverageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockGas", wireType)
+			}
+			m.AverageBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeAdvisoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeAdvisoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeAdvisoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvisoryMinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdvisoryMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingBlockProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingBlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundedAddresses = append(m.FundedAddresses, FundedAddressAllocation{})
+			if err := m.FundedAddresses[len(m.FundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncationRemainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TruncationRemainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddressesDust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundedAddressesDust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundedAddressAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundedAddressAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundedAddressAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressMintIncomeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressMintIncomeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressMintIncomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressMintIncomeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressMintIncomeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressMintIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedBlocks", wireType)
+			}
+			m.RecordedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lifetime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurnedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurnedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMintedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMintedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMintedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMintedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalMinted = append(m.TotalMinted, types.Coin{})
+			if err := m.TotalMinted[len(m.TotalMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, InflationSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStrategicReserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStrategicReserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStrategicReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStrategicReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStrategicReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStrategicReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Provisions = append(m.Provisions, v)
+			if err := m.Provisions[len(m.Provisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstClampedHeight", wireType)
+			}
+			m.FirstClampedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstClampedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualFundedProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualFundedProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualFundedProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualFundedProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualFundedProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualFundedProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualProvisions = append(m.AnnualProvisions, FundedAddressAllocation{})
+			if err := m.AnnualProvisions[len(m.AnnualProvisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, StateProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAnnualProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -740,10 +16741,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -767,7 +16768,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -790,17 +16791,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -810,24 +16811,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -850,7 +16850,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionProportionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -873,10 +16873,10 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionProportionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionProportionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -900,7 +16900,7 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionProportionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -923,17 +16923,17 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionProportionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionProportionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -943,22 +16943,22 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -983,7 +16983,7 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFundedAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,12 +17006,48 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundedAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1033,7 +17069,7 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFundedAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1056,17 +17092,17 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundedAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedAddresses", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1076,22 +17112,59 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FundedAddresses = append(m.FundedAddresses, WeightedAddress{})
+			if err := m.FundedAddresses[len(m.FundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
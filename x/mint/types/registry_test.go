@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// mintProtoPackage is the proto package of the mint module, its type URLs are part of the public
+// API of the module
+const mintProtoPackage = "modules.mint"
+
+// TestProtoRegistry compares the proto API of the mint module with the golden file: the messages
+// registered as sdk.Msg in the interface registry, the methods of the services and the messages,
+// typed events and params included, of the proto files of the module. Any addition, removal or
+// rename shows as a diff of the golden file, rewritten when the tests are run with -update.
+func TestProtoRegistry(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "# sdk.Msg implementations")
+	msgs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	sort.Strings(msgs)
+	for _, typeURL := range msgs {
+		_, err := registry.Resolve(typeURL)
+		require.NoError(t, err, typeURL)
+		fmt.Fprintln(&b, typeURL)
+	}
+
+	var files []protoreflect.FileDescriptor
+	gogoproto.HybridResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if fd.Package() == mintProtoPackage {
+			files = append(files, fd)
+		}
+		return true
+	})
+	require.NotEmpty(t, files)
+	sort.Slice(files, func(i, j int) bool { return files[i].Path() < files[j].Path() })
+
+	fmt.Fprintln(&b, "\n# files")
+	for _, fd := range files {
+		fmt.Fprintf(&b, "%s go_package=%s\n", fd.Path(), fd.Options().(*descriptorpb.FileOptions).GetGoPackage())
+	}
+
+	var methods, messages, enums []string
+	for _, fd := range files {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := service.Methods().Get(j)
+				methods = append(methods, fmt.Sprintf("/%s/%s (%s) returns (%s)",
+					service.FullName(),
+					method.Name(),
+					method.Input().FullName(),
+					method.Output().FullName(),
+				))
+			}
+		}
+		messages = append(messages, messageNames(fd.Messages())...)
+		for i := 0; i < fd.Enums().Len(); i++ {
+			enums = append(enums, string(fd.Enums().Get(i).FullName()))
+		}
+	}
+	for _, message := range messages {
+		// the typed events are parsed from the type registered in the gogoproto registry
+		require.NotNil(t, gogoproto.MessageType(message), message)
+	}
+	for _, section := range []struct {
+		name  string
+		names []string
+	}{
+		{name: "service methods", names: methods},
+		{name: "messages", names: messages},
+		{name: "enums", names: enums},
+	} {
+		sort.Strings(section.names)
+		fmt.Fprintf(&b, "\n# %s\n", section.name)
+		for _, name := range section.names {
+			fmt.Fprintln(&b, name)
+		}
+	}
+
+	requireGolden(t, "registry.golden", []byte(b.String()))
+}
+
+// messageNames returns the full names of the messages and their nested messages, the map entries
+// excluded
+func messageNames(messages protoreflect.MessageDescriptors) []string {
+	var names []string
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		names = append(names, string(message.FullName()))
+		names = append(names, messageNames(message.Messages())...)
+	}
+	return names
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// RepairedRecordSchemaVersion is the repaired record of the schema version
+	RepairedRecordSchemaVersion = "schema_version"
+
+	// RepairedRecordMinter is the repaired record of the minter
+	RepairedRecordMinter = "minter"
+
+	// RepairedRecordLedger is the repaired record of the ledger of the buffered coins
+	RepairedRecordLedger = "ledger"
+
+	// RepairedRecordSummary is the repaired record of the summary
+	RepairedRecordSummary = "summary"
+)
+
+// StoreRepair is a record of the store rewritten by the store repair
+type StoreRepair struct {
+	Record string
+	Detail string
+}
+
+// RepairReport lists the records of the store rewritten by the store repair, the report of a
+// consistent store is empty
+type RepairReport struct {
+	Repairs []StoreRepair
+}
+
+// Add adds a repaired record to the report
+func (r *RepairReport) Add(record, detail string) {
+	r.Repairs = append(r.Repairs, StoreRepair{Record: record, Detail: detail})
+}
+
+// String implements the Stringer interface.
+func (r RepairReport) String() string {
+	if len(r.Repairs) == 0 {
+		return "the store is consistent, no record repaired\n"
+	}
+	var b strings.Builder
+	for _, repair := range r.Repairs {
+		fmt.Fprintf(&b, "%s: %s\n", repair.Record, repair.Detail)
+	}
+	return b.String()
+}
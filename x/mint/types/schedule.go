@@ -0,0 +1,147 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintStep is the outcome of the steps of the begin blocker for consecutive blocks of the mint
+// denom, computed by NextMintStep
+type MintStep struct {
+	// Clamped is true if the inflation bounds bind the inflation rate
+	Clamped bool
+	// Skipped is true if the minting is skipped because the bonded ratio is below the min bonded
+	// ratio
+	Skipped bool
+	// Minting is true if coins are minted: the minting is neither skipped nor paused and the
+	// blocks include a minting height
+	Minting bool
+	// DriftCorrection is the correction added to the provision of the blocks
+	DriftCorrection sdk.Dec
+	// Minted is the amount to mint before the max supply
+	Minted sdkmath.Int
+}
+
+// NextMintStep updates the minter with the steps of the begin blocker for the blocks from the
+// height, with the params applied at the height: the inflation rate and the annual provisions,
+// the goal bonded and the blocks per year transitions, the target emissions, the drift
+// correction and the carry buffer. The begin blocker steps a single block, a projection steps
+// several blocks at once with the rate change and the provision of all the blocks. The max
+// supply and the community funding top-up are left to the caller.
+func (m *Minter) NextMintStep(
+	params Params,
+	height int64,
+	blocks uint64,
+	bondedRatio sdk.Dec,
+	stakingSupply sdkmath.Int,
+) MintStep {
+	step := MintStep{DriftCorrection: sdk.ZeroDec(), Minted: sdkmath.ZeroInt()}
+	lastHeight := height + int64(blocks) - 1
+
+	// the goal bonded ratio of a pending transition is interpolated, the transition is settled
+	// once completed or once the goal bonded param has been changed
+	inflationParams := params
+	inflationParams.GoalBonded = m.EffectiveGoalBonded(params, height)
+	inflationParams.InflationRateChange = params.InflationRateChange.MulInt64(int64(blocks))
+	if transition := m.GoalBondedTransition; transition != nil &&
+		(lastHeight >= transition.EndHeight || !transition.To.Equal(params.GoalBonded)) {
+		m.GoalBondedTransition = nil
+	}
+	unbounded := m.unboundedNextInflationRate(inflationParams, bondedRatio)
+	m.Inflation = m.NextInflationRate(inflationParams, bondedRatio)
+	step.Clamped = !unbounded.Equal(m.Inflation)
+	m.AnnualProvisions = m.NextAnnualProvisions(params, stakingSupply)
+
+	// the minting is skipped while the bonded ratio is below the min bonded ratio. The minter keeps
+	// tracking the inflation but the provision is not added to the target emissions, so the drift
+	// correction doesn't catch up the skipped blocks once the bonded ratio recovers.
+	if params.MinBondedRatio.IsPositive() && bondedRatio.LT(params.MinBondedRatio) {
+		step.Skipped = true
+		return step
+	}
+
+	// the provisions are corrected from the drift of the realized emissions before the provision
+	// is added to the target emissions, paused blocks included. The blocks per year of a pending
+	// transition are interpolated so the block provision doesn't jump on a change of the blocks
+	// per year.
+	provisionParams := m.ProvisionParams(params, height)
+	m.SettleBlocksPerYearTransition(params, lastHeight)
+	step.DriftCorrection = m.driftCorrection(provisionParams, stakingSupply, blocks)
+	provision := m.ExactBlockProvision(provisionParams).MulInt64(int64(blocks))
+	m.TargetCumulativeEmission = m.TargetCumulativeEmission.Add(provision)
+	provision = provision.Add(step.DriftCorrection)
+
+	// the minter keeps tracking the inflation while minting is paused
+	if params.PauseMinting {
+		return step
+	}
+
+	// with a minting interval, the provisions accrue in the carry buffer and are minted at once at
+	// the end of each epoch, the blocks at a multiple of the interval
+	if !params.hasMintingHeight(height, blocks) {
+		m.CarryBuffer = m.CarryBuffer.Add(provision)
+		return step
+	}
+
+	// provisions below the minimum distributable provision are accumulated in the carry buffer
+	// until they can be minted
+	step.Minting = true
+	step.Minted = provision.TruncateInt()
+	switch {
+	case params.MinDistributableProvision.IsPositive(), params.MintingInterval > 1:
+		var mintedCoin sdk.Coin
+		mintedCoin, m.CarryBuffer = m.BufferedProvision(params, provision)
+		step.Minted = mintedCoin.Amount
+	case m.CarryBuffer.IsPositive():
+		// the minimum has been disabled, flush the remaining carry buffer
+		step.Minted = step.Minted.Add(m.CarryBuffer.TruncateInt())
+		m.CarryBuffer = sdk.ZeroDec()
+	}
+	return step
+}
+
+// MintSchedule simulates the minting of the mint denom over consecutive blocks with the steps of
+// the begin blocker and the schedule of the params, the phases included, with a constant bonded
+// ratio. The staking supply and the supply of the mint denom grow with the minted coins, which
+// are capped by the max supply. The community funding top-up is not simulated.
+type MintSchedule struct {
+	Minter        Minter
+	Params        Params
+	BondedRatio   sdk.Dec
+	StakingSupply sdkmath.Int
+	Supply        sdkmath.Int
+}
+
+// NewMintSchedule returns the schedule of the minting from the minter and the params
+func NewMintSchedule(minter Minter, params Params, bondedRatio sdk.Dec, stakingSupply, supply sdkmath.Int) *MintSchedule {
+	return &MintSchedule{
+		Minter:        minter,
+		Params:        params,
+		BondedRatio:   bondedRatio,
+		StakingSupply: stakingSupply,
+		Supply:        supply,
+	}
+}
+
+// Step simulates the minting of the blocks from the height and returns the minted amount. Clamped
+// is true if the inflation bounds or the max supply bind.
+func (s *MintSchedule) Step(height int64, blocks uint64) (minted sdkmath.Int, clamped bool) {
+	params := s.Params.AtHeight(height)
+	step := s.Minter.NextMintStep(params, height, blocks, s.BondedRatio, s.StakingSupply)
+	if !step.Minting {
+		return sdkmath.ZeroInt(), step.Clamped
+	}
+
+	// the drift is reset when the max supply cuts the minted coins
+	minted = step.Minted
+	if headroom, capped := params.MaxSupplyHeadroom(s.Supply); capped && headroom.LT(minted) {
+		minted = headroom
+		s.Minter.TargetCumulativeEmission = sdk.NewDecFromInt(s.Minter.CumulativeMinted.Add(minted)).Add(s.Minter.CarryBuffer)
+		step.Clamped = true
+	}
+
+	s.Minter.CumulativeMinted = s.Minter.CumulativeMinted.Add(minted)
+	s.StakingSupply = s.StakingSupply.Add(minted)
+	s.Supply = s.Supply.Add(minted)
+	return minted, step.Clamped
+}
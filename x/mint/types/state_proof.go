@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// StateProver returns the value of a key of a store of the app at a height with the commitment
+// proof of the value, or of its absence, against the app hash of the height
+type StateProver interface {
+	ProveState(storeName string, key []byte, height int64) (StateProof, error)
+}
+
+// StateKey is a key of a store of the app holding a part of the mint state
+type StateKey struct {
+	StoreName string
+	Key       []byte
+}
+
+// stateKeys are the store keys of the parts of the mint state held under a single key of the
+// module store
+var stateKeys = map[string][]byte{
+	"minter":             MinterKey,
+	"summary":            SummaryKey,
+	"params_change":      ParamsChangeKey,
+	"schema_version":     SchemaVersionKey,
+	"fee_collector_name": FeeCollectorNameKey,
+	"module_version":     ModuleVersionKey,
+	"dust_accumulator":   DustAccumulatorKey,
+}
+
+// StateKeyNameParams is the name of the params in the state proofs, they are held in the x/params
+// store with one key per param
+const StateKeyNameParams = "params"
+
+// StateKeyNames returns the names of the parts of the mint state that can be proven
+func StateKeyNames() []string {
+	names := []string{StateKeyNameParams}
+	for name := range stateKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StateKeys returns the store keys holding the part of the mint state with the name, the params
+// are held in the subspace of the module in the x/params store
+func StateKeys(name string) ([]StateKey, error) {
+	if name == StateKeyNameParams {
+		var params Params
+		pairs := params.ParamSetPairs()
+		keys := make([]StateKey, 0, len(pairs))
+		for _, pair := range pairs {
+			keys = append(keys, StateKey{
+				StoreName: paramstypes.StoreKey,
+				Key:       append([]byte(ModuleName+"/"), pair.Key...),
+			})
+		}
+		return keys, nil
+	}
+	key, ok := stateKeys[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown state key name %q, expected one of %v", name, StateKeyNames())
+	}
+	return []StateKey{{StoreName: StoreKey, Key: key}}, nil
+}
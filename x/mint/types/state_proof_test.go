@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestStateKeys(t *testing.T) {
+	t.Run("should map the names to the keys of the module store", func(t *testing.T) {
+		for name, key := range map[string][]byte{
+			"minter":             types.MinterKey,
+			"summary":            types.SummaryKey,
+			"params_change":      types.ParamsChangeKey,
+			"schema_version":     types.SchemaVersionKey,
+			"fee_collector_name": types.FeeCollectorNameKey,
+			"module_version":     types.ModuleVersionKey,
+			"dust_accumulator":   types.DustAccumulatorKey,
+		} {
+			keys, err := types.StateKeys(name)
+			require.NoError(t, err)
+			require.Equal(t, []types.StateKey{{StoreName: types.StoreKey, Key: key}}, keys)
+		}
+	})
+
+	t.Run("should map the params to the keys of the subspace of the module", func(t *testing.T) {
+		keys, err := types.StateKeys(types.StateKeyNameParams)
+		require.NoError(t, err)
+		var params types.Params
+		pairs := params.ParamSetPairs()
+		require.Len(t, keys, len(pairs))
+		for i, key := range keys {
+			require.Equal(t, paramstypes.StoreKey, key.StoreName)
+			require.Equal(t, "mint/"+string(pairs[i].Key), string(key.Key))
+		}
+	})
+
+	t.Run("should reject an unknown name", func(t *testing.T) {
+		_, err := types.StateKeys("distribution_history")
+		require.ErrorContains(t, err, "unknown state key name")
+	})
+
+	t.Run("should list the names", func(t *testing.T) {
+		require.Equal(t, []string{
+			"dust_accumulator", "fee_collector_name", "minter", "module_version", "params",
+			"params_change", "schema_version", "summary",
+		}, types.StateKeyNames())
+	})
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// ReleaseStrategicReserve debits the released amount from the strategic reserve and counts it in
+// the released amount. The release fails with ErrInsufficientReserve if it exceeds the reserve.
+func (m *Minter) ReleaseStrategicReserve(amount sdk.Coins) error {
+	remaining, hasNeg := m.StrategicReserve.SafeSub(amount...)
+	if hasNeg {
+		return errors.Wrapf(ErrInsufficientReserve, "release of %s exceeds the strategic reserve of %s", amount, m.StrategicReserve)
+	}
+	m.StrategicReserve = remaining
+	m.StrategicReserveReleased = m.StrategicReserveReleased.Add(amount...)
+	return nil
+}
+
+// ExcludedSupply returns the supply of a denom excluded from the staking supply the annual
+// provisions are computed against, the strategic reserve locked in the module account
+func (m Minter) ExcludedSupply(denom string) sdkmath.Int {
+	return m.StrategicReserve.AmountOf(denom)
+}
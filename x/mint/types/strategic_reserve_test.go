@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestMinterReleaseStrategicReserve(t *testing.T) {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	minter := types.DefaultInitialMinter()
+	minter.Book(types.LedgerEntryStrategicReserve, stake(100))
+	require.Equal(t, sdk.NewInt(100), minter.ExcludedSupply(sdk.DefaultBondDenom))
+	require.True(t, minter.ExcludedSupply("foo").IsZero())
+
+	require.NoError(t, minter.ReleaseStrategicReserve(stake(30)))
+	require.Equal(t, stake(70), minter.StrategicReserve)
+	require.Equal(t, stake(30), minter.StrategicReserveReleased)
+
+	err := minter.ReleaseStrategicReserve(stake(71))
+	require.ErrorIs(t, err, types.ErrInsufficientReserve)
+	err = minter.ReleaseStrategicReserve(sdk.NewCoins(sdk.NewInt64Coin("foo", 1)))
+	require.ErrorIs(t, err, types.ErrInsufficientReserve)
+	require.Equal(t, stake(70), minter.StrategicReserve)
+	require.Equal(t, stake(30), minter.StrategicReserveReleased)
+
+	require.NoError(t, minter.ReleaseStrategicReserve(stake(70)))
+	require.True(t, minter.StrategicReserve.IsZero())
+	require.Equal(t, stake(100), minter.StrategicReserveReleased)
+}
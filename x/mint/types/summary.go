@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewSummary returns the summary of the mint state derived from the minter and the params
+func NewSummary(minter Minter, params Params) Summary {
+	cumulativeMinted := minter.CumulativeMinted
+	if cumulativeMinted.IsNil() {
+		cumulativeMinted = sdkmath.ZeroInt()
+	}
+	return Summary{
+		MintDenom:        params.MintDenom,
+		Inflation:        minter.Inflation,
+		AnnualProvisions: minter.AnnualProvisions,
+		CumulativeMinted: cumulativeMinted,
+	}
+}
+
+// NewPauseState returns the pause state of the module defined by the params
+func NewPauseState(params Params) PauseState {
+	return PauseState{
+		Minting:         params.PauseMinting,
+		StakingShare:    params.PauseStakingShare,
+		FundedShare:     params.PauseFundedShare,
+		CommunityShare:  params.PauseCommunityShare,
+		PausedShareMode: params.PausedShareMode,
+	}
+}
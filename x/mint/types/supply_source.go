@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// SupplySourceStakingKeeper is the name of the staking keeper as source of the staking supply
+	SupplySourceStakingKeeper = "staking_keeper"
+
+	// SupplySourceExternal is the name of the supply source of the keeper as source of the staking supply
+	SupplySourceExternal = "supply_source"
+)
+
+// SelectStakingSupply returns the staking supply used to compute the annual provisions from the
+// staking keeper supply and the supply of the supply source, and the name of the selected source
+func SelectStakingSupply(mode SupplySourceMode, stakingSupply, sourceSupply sdkmath.Int) (sdkmath.Int, string) {
+	if mode == SUPPLY_SOURCE_MODE_MAX && stakingSupply.GT(sourceSupply) {
+		return stakingSupply, SupplySourceStakingKeeper
+	}
+	return sourceSupply, SupplySourceExternal
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestSelectStakingSupply(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           types.SupplySourceMode
+		stakingSupply  int64
+		sourceSupply   int64
+		expected       int64
+		expectedSource string
+	}{
+		{
+			name:           "should replace the staking supply",
+			mode:           types.SUPPLY_SOURCE_MODE_REPLACE,
+			stakingSupply:  1000,
+			sourceSupply:   800,
+			expected:       800,
+			expectedSource: types.SupplySourceExternal,
+		},
+		{
+			name:           "should select the larger supply source supply",
+			mode:           types.SUPPLY_SOURCE_MODE_MAX,
+			stakingSupply:  1000,
+			sourceSupply:   1500,
+			expected:       1500,
+			expectedSource: types.SupplySourceExternal,
+		},
+		{
+			name:           "should select the larger staking supply",
+			mode:           types.SUPPLY_SOURCE_MODE_MAX,
+			stakingSupply:  1000,
+			sourceSupply:   800,
+			expected:       1000,
+			expectedSource: types.SupplySourceStakingKeeper,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			supply, source := types.SelectStakingSupply(tc.mode, sdkmath.NewInt(tc.stakingSupply), sdkmath.NewInt(tc.sourceSupply))
+			require.Equal(t, sdkmath.NewInt(tc.expected), supply)
+			require.Equal(t, tc.expectedSource, source)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+)
+
+// TokenomicsYear is the duration of a year used to convert the durations of a tokenomics spec
+// into blocks, the year of DefaultBlocksPerYear
+const TokenomicsYear = 8766 * time.Hour
+
+// TokenomicsSpec is a high-level description of the tokenomics of a chain. The rates and the
+// shares are percentages, the durations are Go durations like "5s" or "72h", or a number of days
+// or years like "30d" or "4y".
+type TokenomicsSpec struct {
+	MintDenom string `json:"mint_denom"`
+	// inflation rate of the first block
+	InitialInflation sdk.Dec `json:"initial_inflation"`
+	// cap and floor of the inflation rate, the initial inflation if not set
+	MaxInflation sdk.Dec `json:"max_inflation"`
+	MinInflation sdk.Dec `json:"min_inflation"`
+	// maximum change of the inflation rate per year, the default bounded by the range of the
+	// inflation rate if not set
+	InflationRateChange sdk.Dec `json:"inflation_rate_change"`
+	// bonded ratio targeted by the inflation rate, the default if not set
+	GoalBonded sdk.Dec `json:"goal_bonded"`
+	// assumed block time
+	BlockTime string `json:"block_time"`
+	// period of the halvings of the inflation, no halving if empty
+	HalvingPeriod string `json:"halving_period"`
+	// shares of the minted coins
+	StakingShare         sdk.Dec           `json:"staking_share"`
+	FundedAddressesShare sdk.Dec           `json:"funded_addresses_share"`
+	CommunityPoolShare   sdk.Dec           `json:"community_pool_share"`
+	FundedAddresses      []WeightedAddress `json:"funded_addresses"`
+}
+
+// ParamsFromTokenomics converts the tokenomics spec into validated params, the params not
+// described by the spec have their default value. The returned warnings describe the lossy
+// conversions of the spec.
+func ParamsFromTokenomics(spec TokenomicsSpec) (params Params, warnings []string, err error) {
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	ratio := func(name string, percent sdk.Dec) (sdk.Dec, error) {
+		if percent.IsNil() {
+			return sdk.Dec{}, fmt.Errorf("%s is not set", name)
+		}
+		r := percent.QuoInt64(100)
+		if !r.MulInt64(100).Equal(percent) {
+			warn("%s %s%% is truncated to %s%%", name, percent, r.MulInt64(100))
+		}
+		return r, nil
+	}
+	optionalRatio := func(name string, percent, fallback sdk.Dec) (sdk.Dec, error) {
+		if percent.IsNil() {
+			return fallback, nil
+		}
+		return ratio(name, percent)
+	}
+	invalid := func(err error) (Params, []string, error) {
+		return Params{}, warnings, errors.Wrap(ErrInvalidTokenomics, err.Error())
+	}
+
+	initialInflation, err := ratio("initial inflation", spec.InitialInflation)
+	if err != nil {
+		return invalid(err)
+	}
+	inflationMax, err := optionalRatio("max inflation", spec.MaxInflation, initialInflation)
+	if err != nil {
+		return invalid(err)
+	}
+	inflationMin, err := optionalRatio("min inflation", spec.MinInflation, initialInflation)
+	if err != nil {
+		return invalid(err)
+	}
+	if initialInflation.GT(inflationMax) || initialInflation.LT(inflationMin) {
+		warn(
+			"initial inflation %s%% is bounded to [%s%%, %s%%] from the first block",
+			spec.InitialInflation, inflationMin.MulInt64(100), inflationMax.MulInt64(100),
+		)
+	}
+	inflationRateChange := DefaultInflationRateChange
+	if inflationRange := inflationMax.Sub(inflationMin); inflationRange.IsPositive() && inflationRange.LT(inflationRateChange) {
+		inflationRateChange = inflationRange
+	}
+	inflationRateChange, err = optionalRatio("inflation rate change", spec.InflationRateChange, inflationRateChange)
+	if err != nil {
+		return invalid(err)
+	}
+	goalBonded, err := optionalRatio("goal bonded", spec.GoalBonded, DefaultGoalBonded)
+	if err != nil {
+		return invalid(err)
+	}
+
+	blockTime, err := ParseTokenomicsDuration(spec.BlockTime)
+	if err != nil {
+		return invalid(fmt.Errorf("block time: %w", err))
+	}
+	if blockTime <= 0 || blockTime > TokenomicsYear {
+		return invalid(fmt.Errorf("block time must be in (0, 1y]: %s", blockTime))
+	}
+	blocksPerYear := uint64((TokenomicsYear + blockTime/2) / blockTime)
+	if TokenomicsYear%blockTime != 0 {
+		warn("a year of %s blocks is rounded to %d blocks", blockTime, blocksPerYear)
+	}
+	if spec.HalvingPeriod != "" {
+		if _, err := ParseTokenomicsDuration(spec.HalvingPeriod); err != nil {
+			return invalid(fmt.Errorf("halving period: %w", err))
+		}
+		warn("halving every %s is not supported by the params, the inflation bounds must be halved by upgrades", spec.HalvingPeriod)
+	}
+
+	var proportions DistributionProportions
+	if proportions.Staking, err = ratio("staking share", spec.StakingShare); err != nil {
+		return invalid(err)
+	}
+	if proportions.FundedAddresses, err = ratio("funded addresses share", spec.FundedAddressesShare); err != nil {
+		return invalid(err)
+	}
+	if proportions.CommunityPool, err = ratio("community pool share", spec.CommunityPoolShare); err != nil {
+		return invalid(err)
+	}
+
+	params = NewParams(
+		spec.MintDenom,
+		inflationRateChange,
+		inflationMax,
+		inflationMin,
+		goalBonded,
+		blocksPerYear,
+		proportions,
+		spec.FundedAddresses,
+	)
+	if err := params.Validate(); err != nil {
+		return Params{}, warnings, InvalidParamsError(err)
+	}
+	return params, warnings, nil
+}
+
+// InitialMinter returns the initial minter of the tokenomics spec, with the initial inflation
+func (spec TokenomicsSpec) InitialMinter() Minter {
+	if spec.InitialInflation.IsNil() {
+		return DefaultInitialMinter()
+	}
+	return InitialMinter(spec.InitialInflation.QuoInt64(100))
+}
+
+// ParseTokenomicsDuration parses a Go duration, or a number of days or years with the d or y
+// unit, a year being TokenomicsYear
+func ParseTokenomicsDuration(s string) (time.Duration, error) {
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "y"):
+		unit = TokenomicsYear
+	default:
+		return time.ParseDuration(s)
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(s[:len(s)-1]), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return time.Duration(n * float64(unit)), nil
+}
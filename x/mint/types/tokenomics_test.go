@@ -0,0 +1,187 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// tokenomicsSpec returns a spec of a fixed inflation of 10% with 6s blocks
+func tokenomicsSpec() types.TokenomicsSpec {
+	return types.TokenomicsSpec{
+		MintDenom:            sdk.DefaultBondDenom,
+		InitialInflation:     sdk.NewDec(10),
+		BlockTime:            "6s",
+		StakingShare:         sdk.NewDec(70),
+		FundedAddressesShare: sdk.ZeroDec(),
+		CommunityPoolShare:   sdk.NewDec(30),
+	}
+}
+
+func TestParamsFromTokenomics(t *testing.T) {
+	t.Run("should convert the spec into params", func(t *testing.T) {
+		spec := tokenomicsSpec()
+		spec.MaxInflation = sdk.NewDec(15)
+		spec.MinInflation = sdk.NewDec(5)
+		spec.GoalBonded = sdk.NewDec(60)
+
+		params, warnings, err := types.ParamsFromTokenomics(spec)
+		require.NoError(t, err)
+		require.Empty(t, warnings)
+
+		expected := types.DefaultParams()
+		expected.InflationMax = sdk.NewDecWithPrec(15, 2)
+		expected.InflationMin = sdk.NewDecWithPrec(5, 2)
+		expected.InflationRateChange = sdk.NewDecWithPrec(10, 2)
+		expected.GoalBonded = sdk.NewDecWithPrec(60, 2)
+		expected.BlocksPerYear = 5259600
+		expected.DistributionProportions = types.DistributionProportions{
+			Staking:         sdk.NewDecWithPrec(70, 2),
+			FundedAddresses: sdk.ZeroDec(),
+			CommunityPool:   sdk.NewDecWithPrec(30, 2),
+		}
+		require.Equal(t, expected, params)
+		require.Equal(t, types.InitialMinter(sdk.NewDecWithPrec(10, 2)), spec.InitialMinter())
+	})
+
+	t.Run("should fix the inflation without bounds", func(t *testing.T) {
+		params, warnings, err := types.ParamsFromTokenomics(tokenomicsSpec())
+		require.NoError(t, err)
+		require.Empty(t, warnings)
+		require.Equal(t, sdk.NewDecWithPrec(10, 2), params.InflationMax)
+		require.Equal(t, sdk.NewDecWithPrec(10, 2), params.InflationMin)
+		require.Equal(t, types.DefaultInflationRateChange, params.InflationRateChange)
+	})
+
+	t.Run("should bound the inflation rate change to the inflation range", func(t *testing.T) {
+		spec := tokenomicsSpec()
+		spec.MaxInflation = sdk.NewDec(12)
+		spec.MinInflation = sdk.NewDec(8)
+
+		params, _, err := types.ParamsFromTokenomics(spec)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecWithPrec(4, 2), params.InflationRateChange)
+	})
+
+	tests := []struct {
+		name     string
+		update   func(*types.TokenomicsSpec)
+		warnings []string
+	}{
+		{
+			name:     "should warn of rounded blocks per year",
+			update:   func(spec *types.TokenomicsSpec) { spec.BlockTime = "7s" },
+			warnings: []string{"a year of 7s blocks is rounded to 4508229 blocks"},
+		},
+		{
+			name: "should warn of truncated percentages",
+			update: func(spec *types.TokenomicsSpec) {
+				spec.InitialInflation = sdk.MustNewDecFromStr("10.000000000000000001")
+			},
+			warnings: []string{
+				"initial inflation 10.000000000000000001% is truncated to 10.000000000000000000%",
+			},
+		},
+		{
+			name: "should warn of an initial inflation out of bounds",
+			update: func(spec *types.TokenomicsSpec) {
+				spec.MaxInflation = sdk.NewDec(8)
+				spec.MinInflation = sdk.NewDec(5)
+			},
+			warnings: []string{
+				"initial inflation 10.000000000000000000% is bounded to [5.000000000000000000%, 8.000000000000000000%] from the first block",
+			},
+		},
+		{
+			name:   "should warn of an unsupported halving",
+			update: func(spec *types.TokenomicsSpec) { spec.HalvingPeriod = "4y" },
+			warnings: []string{
+				"halving every 4y is not supported by the params, the inflation bounds must be halved by upgrades",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := tokenomicsSpec()
+			tc.update(&spec)
+
+			_, warnings, err := types.ParamsFromTokenomics(spec)
+			require.NoError(t, err)
+			require.Equal(t, tc.warnings, warnings)
+		})
+	}
+}
+
+func TestParamsFromTokenomicsInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(*types.TokenomicsSpec)
+		err    error
+	}{
+		{
+			name:   "should fail without initial inflation",
+			update: func(spec *types.TokenomicsSpec) { spec.InitialInflation = sdk.Dec{} },
+			err:    types.ErrInvalidTokenomics,
+		},
+		{
+			name:   "should fail without share",
+			update: func(spec *types.TokenomicsSpec) { spec.CommunityPoolShare = sdk.Dec{} },
+			err:    types.ErrInvalidTokenomics,
+		},
+		{
+			name:   "should fail with an invalid block time",
+			update: func(spec *types.TokenomicsSpec) { spec.BlockTime = "6 seconds" },
+			err:    types.ErrInvalidTokenomics,
+		},
+		{
+			name:   "should fail with a block time longer than a year",
+			update: func(spec *types.TokenomicsSpec) { spec.BlockTime = "2y" },
+			err:    types.ErrInvalidTokenomics,
+		},
+		{
+			name:   "should fail with an invalid halving period",
+			update: func(spec *types.TokenomicsSpec) { spec.HalvingPeriod = "4 years" },
+			err:    types.ErrInvalidTokenomics,
+		},
+		{
+			name:   "should fail with shares not summing to 100%",
+			update: func(spec *types.TokenomicsSpec) { spec.StakingShare = sdk.NewDec(60) },
+			err:    types.ErrInvalidProportions,
+		},
+		{
+			name:   "should fail with an inflation above 100%",
+			update: func(spec *types.TokenomicsSpec) { spec.MaxInflation = sdk.NewDec(200) },
+			err:    types.ErrInvalidParams,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := tokenomicsSpec()
+			tc.update(&spec)
+
+			_, _, err := types.ParamsFromTokenomics(spec)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestParseTokenomicsDuration(t *testing.T) {
+	for s, expected := range map[string]time.Duration{
+		"6s":    6 * time.Second,
+		"1h30m": 90 * time.Minute,
+		"30d":   30 * 24 * time.Hour,
+		"1.5d":  36 * time.Hour,
+		"4y":    4 * types.TokenomicsYear,
+	} {
+		d, err := types.ParseTokenomicsDuration(s)
+		require.NoError(t, err, s)
+		require.Equal(t, expected, d, s)
+	}
+
+	_, err := types.ParseTokenomicsDuration("4 years")
+	require.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxUpcomingProvisions bounds the number of blocks of the simulated upcoming provisions
+const MaxUpcomingProvisions = 10_000
+
+// UpcomingProvisions simulates the provisions minted at the count blocks from the height with the
+// mint schedule of the minter and the params, block by block. The height of the first block where
+// the inflation bounds or the max supply bind is returned, zero if they don't bind.
+func UpcomingProvisions(
+	minter Minter,
+	params Params,
+	height int64,
+	count uint64,
+	bondedRatio sdk.Dec,
+	stakingSupply,
+	supply sdkmath.Int,
+) (provisions []sdkmath.Int, firstClampedHeight int64) {
+	schedule := NewMintSchedule(minter, params, bondedRatio, stakingSupply, supply)
+	provisions = make([]sdkmath.Int, 0, count)
+	for i := uint64(0); i < count; i++ {
+		blockHeight := height + int64(i)
+		minted, clamped := schedule.Step(blockHeight, 1)
+		if clamped && firstClampedHeight == 0 {
+			firstClampedHeight = blockHeight
+		}
+		provisions = append(provisions, minted)
+	}
+	return provisions, firstClampedHeight
+}
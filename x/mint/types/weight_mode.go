@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxStakeWeightedAddresses is the maximum number of stake weighted funded addresses, the bonded
+// delegations of each of them are read at each distribution
+const MaxStakeWeightedAddresses = 10
+
+// IsStakeWeighted returns true if the weight of the address is split by bonded delegations
+func (w WeightedAddress) IsStakeWeighted() bool {
+	return w.WeightMode == WEIGHT_MODE_STAKE_WEIGHTED
+}
+
+// ApplyStakeWeights returns the funded addresses with the pooled weight of the stake weighted
+// addresses split between them proportionally to their bonded amounts, indexed like the funded
+// addresses. The pooled weight is split equally when none of them has a bonded amount. The weights
+// are truncated so their sum never exceeds the pooled weight, the remainder is part of the dust of
+// the funded addresses share. The funded addresses are returned unchanged without a stake weighted
+// address.
+func ApplyStakeWeights(fundedAddresses []WeightedAddress, bonded []sdkmath.Int) []WeightedAddress {
+	pooled := sdk.ZeroDec()
+	totalBonded := sdkmath.ZeroInt()
+	count := int64(0)
+	for i, w := range fundedAddresses {
+		if !w.IsStakeWeighted() {
+			continue
+		}
+		pooled = pooled.Add(w.Weight)
+		totalBonded = totalBonded.Add(bonded[i])
+		count++
+	}
+	if count == 0 {
+		return fundedAddresses
+	}
+
+	weighted := make([]WeightedAddress, len(fundedAddresses))
+	for i, w := range fundedAddresses {
+		weighted[i] = w
+		if !w.IsStakeWeighted() {
+			continue
+		}
+		if totalBonded.IsPositive() {
+			weighted[i].Weight = pooled.MulInt(bonded[i]).QuoInt(totalBonded)
+		} else {
+			weighted[i].Weight = pooled.QuoInt64(count)
+		}
+	}
+	return weighted
+}
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/x/mint/types"
+)
+
+func TestApplyStakeWeights(t *testing.T) {
+	fixed := types.WeightedAddress{Address: "fixed", Weight: sdk.NewDecWithPrec(4, 1)}
+	stakeWeighted := func(address string, weight int64) types.WeightedAddress {
+		return types.WeightedAddress{
+			Address:    address,
+			Weight:     sdk.NewDecWithPrec(weight, 1),
+			WeightMode: types.WEIGHT_MODE_STAKE_WEIGHTED,
+		}
+	}
+	weights := func(fundedAddresses []types.WeightedAddress) []string {
+		w := make([]string, len(fundedAddresses))
+		for i, fa := range fundedAddresses {
+			w[i] = fa.Weight.String()
+		}
+		return w
+	}
+	ints := func(amounts ...int64) []sdkmath.Int {
+		bonded := make([]sdkmath.Int, len(amounts))
+		for i, amount := range amounts {
+			bonded[i] = sdkmath.NewInt(amount)
+		}
+		return bonded
+	}
+
+	tests := []struct {
+		name            string
+		fundedAddresses []types.WeightedAddress
+		bonded          []sdkmath.Int
+		expected        []string
+	}{
+		{
+			name:            "should keep the fixed weights",
+			fundedAddresses: []types.WeightedAddress{fixed, {Address: "other", Weight: sdk.NewDecWithPrec(6, 1)}},
+			bonded:          ints(100, 200),
+			expected:        []string{"0.400000000000000000", "0.600000000000000000"},
+		},
+		{
+			name:            "should split the pooled weight by bonded amount",
+			fundedAddresses: []types.WeightedAddress{fixed, stakeWeighted("a", 2), stakeWeighted("b", 4)},
+			bonded:          ints(1000, 100, 200),
+			expected:        []string{"0.400000000000000000", "0.200000000000000000", "0.400000000000000000"},
+		},
+		{
+			name:            "should give no weight to an address without bonded amount",
+			fundedAddresses: []types.WeightedAddress{fixed, stakeWeighted("a", 3), stakeWeighted("b", 3)},
+			bonded:          ints(0, 0, 50),
+			expected:        []string{"0.400000000000000000", "0.000000000000000000", "0.600000000000000000"},
+		},
+		{
+			name:            "should split the pooled weight equally without bonded amount",
+			fundedAddresses: []types.WeightedAddress{fixed, stakeWeighted("a", 1), stakeWeighted("b", 5)},
+			bonded:          ints(100, 0, 0),
+			expected:        []string{"0.400000000000000000", "0.300000000000000000", "0.300000000000000000"},
+		},
+		{
+			name:            "should truncate the weights below the pooled weight",
+			fundedAddresses: []types.WeightedAddress{fixed, stakeWeighted("a", 3), stakeWeighted("b", 3)},
+			bonded:          ints(0, 1, 2),
+			expected:        []string{"0.400000000000000000", "0.200000000000000000", "0.400000000000000000"},
+		},
+		{
+			name:            "should truncate the weights of three addresses",
+			fundedAddresses: []types.WeightedAddress{stakeWeighted("a", 5), stakeWeighted("b", 5), stakeWeighted("c", 0)},
+			bonded:          ints(1, 1, 1),
+			expected:        []string{"0.333333333333333333", "0.333333333333333333", "0.333333333333333333"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			weighted := types.ApplyStakeWeights(tc.fundedAddresses, tc.bonded)
+			require.Equal(t, tc.expected, weights(weighted))
+			for i, w := range weighted {
+				require.Equal(t, tc.fundedAddresses[i].Address, w.Address)
+				require.Equal(t, tc.fundedAddresses[i].WeightMode, w.WeightMode)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NormalizeAddress returns the canonical lowercase bech32 form of an account address, the address
+// can be uppercase or mixed-case and must have the account address prefix of the chain
+func NormalizeAddress(address string) (string, error) {
+	accAddr, err := sdk.AccAddressFromBech32(strings.ToLower(address))
+	if err != nil {
+		return "", err
+	}
+	return accAddr.String(), nil
+}
+
+// NormalizeWeightedAddresses returns a copy of the weighted addresses with their address in the
+// canonical form, the invalid addresses are kept unchanged for the validation to reject them
+func NormalizeWeightedAddresses(addresses []WeightedAddress) []WeightedAddress {
+	if addresses == nil {
+		return nil
+	}
+	normalized := make([]WeightedAddress, len(addresses))
+	for i, wa := range addresses {
+		if addr, err := NormalizeAddress(wa.Address); err == nil {
+			wa.Address = addr
+		}
+		normalized[i] = wa
+	}
+	return normalized
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// mixedCase returns the address with every other character in uppercase
+func mixedCase(address string) string {
+	var b strings.Builder
+	for i, c := range address {
+		if i%2 == 0 {
+			b.WriteString(strings.ToUpper(string(c)))
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	addr := sample.Address(sample.Rand())
+	wrongPrefix, err := bech32.ConvertAndEncode("osmo", sdk.MustAccAddressFromBech32(addr))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		address string
+		err     string
+	}{
+		{
+			name:    "should keep a canonical address",
+			address: addr,
+		},
+		{
+			name:    "should normalize an uppercase address",
+			address: strings.ToUpper(addr),
+		},
+		{
+			name:    "should normalize a mixed-case address",
+			address: mixedCase(addr),
+		},
+		{
+			name:    "should prevent normalizing an address with another prefix",
+			address: wrongPrefix,
+			err:     "expected cosmos, got osmo",
+		},
+		{
+			name:    "should prevent normalizing an invalid address",
+			address: "invalid",
+			err:     "decoding bech32 failed",
+		},
+		{
+			name:    "should prevent normalizing an empty address",
+			address: "",
+			err:     "empty address string is not allowed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := types.NormalizeAddress(tt.address)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, addr, normalized)
+		})
+	}
+}
+
+func TestNormalizeWeightedAddresses(t *testing.T) {
+	addr := sample.Address(sample.Rand())
+	addresses := []types.WeightedAddress{
+		{Address: strings.ToUpper(addr), Weight: sdk.NewDecWithPrec(5, 1), PayoutMode: types.PAYOUT_MODE_PULL},
+		{Address: "invalid", Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+
+	normalized := types.NormalizeWeightedAddresses(addresses)
+	require.Equal(t, []types.WeightedAddress{
+		{Address: addr, Weight: sdk.NewDecWithPrec(5, 1), PayoutMode: types.PAYOUT_MODE_PULL},
+		{Address: "invalid", Weight: sdk.NewDecWithPrec(5, 1)},
+	}, normalized)
+
+	// the input is not modified
+	require.Equal(t, strings.ToUpper(addr), addresses[0].Address)
+	require.Nil(t, types.NormalizeWeightedAddresses(nil))
+}
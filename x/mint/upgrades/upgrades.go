@@ -0,0 +1,380 @@
+// Package upgrades provides composable helpers applying common transitions of the mint params
+// in the upgrade handlers of a chain.
+//
+// The helpers validate the resulting params, set them through the keeper, so the summary and
+// the history of the funded addresses are updated, and emit an EventParamsUpdated listing the
+// updated params. The returned errors can be returned as is from an upgrade handler.
+//
+// SetFeeCollectorName updates the fee collector receiving the staking share in the same upgrade
+// as the renaming of the fee collector module.
+package upgrades
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/modules/pkg/errors"
+	"github.com/ignite/modules/x/mint/types"
+)
+
+// MintKeeper defines the mint keeper methods used by the helpers
+type MintKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// FeeCollectorKeeper defines the mint keeper methods used by the fee collector helper
+type FeeCollectorKeeper interface {
+	GetFeeCollectorName(ctx sdk.Context) string
+	SetFeeCollectorName(ctx sdk.Context, name string) error
+}
+
+// ParamPatch is a partial set of mint params, only the non-nil fields are applied
+type ParamPatch struct {
+	MintDenom                  *string
+	InflationRateChange        *sdk.Dec
+	InflationMax               *sdk.Dec
+	InflationMin               *sdk.Dec
+	GoalBonded                 *sdk.Dec
+	BlocksPerYear              *uint64
+	DistributionProportions    *types.DistributionProportions
+	FundedAddresses            *[]types.WeightedAddress
+	MinDistributableProvision  *sdk.Int
+	PauseMinting               *bool
+	PauseStakingShare          *bool
+	PauseFundedShare           *bool
+	PauseCommunityShare        *bool
+	PausedShareMode            *types.PausedShareMode
+	DustAssignment             *types.DustAssignment
+	EmitMintPlanned            *bool
+	SupplySourceMode           *types.SupplySourceMode
+	MinAnnualCommunityFunding  *sdk.Coin
+	CommunityFundingPriority   *[]types.CommunityFundingSource
+	CommunityFundingWindow     *uint64
+	DriftCorrection            *types.DriftCorrection
+	LargeChangeThreshold       *sdk.Dec
+	StakingRewardsRecipient    *string
+	Phases                     *[]types.Phase
+	MaxSupply                  *sdk.Int
+	ShortfallPolicy            *types.ShortfallPolicy
+	ShortfallPriority          *[]string
+	InflationSnapshotInterval  *uint64
+	InflationSnapshotRetention *uint64
+	MintConfigs                *[]types.MintConfig
+	InflationCalculationMode   *types.InflationCalculationMode
+	BootstrapOverride          *types.BootstrapOverride
+	MinBondedRatio             *sdk.Dec
+	AutoPauseThreshold         *uint64
+	DustPolicy                 *types.DustPolicy
+	MaintenanceInterval        *uint64
+	MintingInterval            *uint64
+	MinCommunityPoolShare      *sdk.Dec
+}
+
+// ApplyParamPatch applies the non-nil fields of the patch to the params. The params are not
+// updated if the patched params are invalid, or if the patch sets a bootstrap override the chain
+// has passed the end height of.
+func ApplyParamPatch(ctx sdk.Context, k MintKeeper, patch ParamPatch) error {
+	params := k.GetParams(ctx)
+	override := params.BootstrapOverride
+	fields := patch.apply(&params)
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(types.InvalidParamsError(err), "patched params")
+	}
+	if params.BootstrapOverride != override {
+		if err := params.BootstrapOverride.CheckEndHeight(ctx.BlockHeight()); err != nil {
+			return err
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	k.SetParams(ctx, params)
+	return ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{Fields: fields})
+}
+
+// SetProportions sets the distribution proportions of the minted coins
+func SetProportions(ctx sdk.Context, k MintKeeper, proportions types.DistributionProportions) error {
+	return ApplyParamPatch(ctx, k, ParamPatch{DistributionProportions: &proportions})
+}
+
+// SetFundedAddresses replaces the funded addresses and their weights
+func SetFundedAddresses(ctx sdk.Context, k MintKeeper, fundedAddresses []types.WeightedAddress) error {
+	return ApplyParamPatch(ctx, k, ParamPatch{FundedAddresses: &fundedAddresses})
+}
+
+// ClearFundedAddresses removes all the funded addresses, their share of the minted coins is
+// sent to the community pool
+func ClearFundedAddresses(ctx sdk.Context, k MintKeeper) error {
+	return SetFundedAddresses(ctx, k, []types.WeightedAddress{})
+}
+
+// SetInflationBounds sets the minimum and maximum inflation rates
+func SetInflationBounds(ctx sdk.Context, k MintKeeper, min, max sdk.Dec) error {
+	return ApplyParamPatch(ctx, k, ParamPatch{InflationMin: &min, InflationMax: &max})
+}
+
+// SetFeeCollectorName sets the name of the module account receiving the staking share, the
+// module account must exist
+func SetFeeCollectorName(ctx sdk.Context, k FeeCollectorKeeper, name string) error {
+	if k.GetFeeCollectorName(ctx) == name {
+		return nil
+	}
+	return k.SetFeeCollectorName(ctx, name)
+}
+
+// apply applies the patch to the params and returns the names of the updated params
+func (p ParamPatch) apply(params *types.Params) (fields []string) {
+	update := func(name string, changed bool) {
+		if changed {
+			fields = append(fields, name)
+		}
+	}
+	if p.MintDenom != nil {
+		update("mint_denom", params.MintDenom != *p.MintDenom)
+		params.MintDenom = *p.MintDenom
+	}
+	if p.InflationRateChange != nil {
+		update("inflation_rate_change", !decEqual(params.InflationRateChange, *p.InflationRateChange))
+		params.InflationRateChange = *p.InflationRateChange
+	}
+	if p.InflationMax != nil {
+		update("inflation_max", !decEqual(params.InflationMax, *p.InflationMax))
+		params.InflationMax = *p.InflationMax
+	}
+	if p.InflationMin != nil {
+		update("inflation_min", !decEqual(params.InflationMin, *p.InflationMin))
+		params.InflationMin = *p.InflationMin
+	}
+	if p.GoalBonded != nil {
+		update("goal_bonded", !decEqual(params.GoalBonded, *p.GoalBonded))
+		params.GoalBonded = *p.GoalBonded
+	}
+	if p.BlocksPerYear != nil {
+		update("blocks_per_year", params.BlocksPerYear != *p.BlocksPerYear)
+		params.BlocksPerYear = *p.BlocksPerYear
+	}
+	if p.DistributionProportions != nil {
+		update("distribution_proportions", !proportionsEqual(params.DistributionProportions, *p.DistributionProportions))
+		params.DistributionProportions = *p.DistributionProportions
+	}
+	if p.FundedAddresses != nil {
+		update("funded_addresses", !fundedAddressesEqual(params.FundedAddresses, *p.FundedAddresses))
+		params.FundedAddresses = *p.FundedAddresses
+	}
+	if p.MinDistributableProvision != nil {
+		current, patched := params.MinDistributableProvision, *p.MinDistributableProvision
+		update("min_distributable_provision", current.IsNil() || patched.IsNil() || !current.Equal(patched))
+		params.MinDistributableProvision = patched
+	}
+	if p.PauseMinting != nil {
+		update("pause_minting", params.PauseMinting != *p.PauseMinting)
+		params.PauseMinting = *p.PauseMinting
+	}
+	if p.PauseStakingShare != nil {
+		update("pause_staking_share", params.PauseStakingShare != *p.PauseStakingShare)
+		params.PauseStakingShare = *p.PauseStakingShare
+	}
+	if p.PauseFundedShare != nil {
+		update("pause_funded_share", params.PauseFundedShare != *p.PauseFundedShare)
+		params.PauseFundedShare = *p.PauseFundedShare
+	}
+	if p.PauseCommunityShare != nil {
+		update("pause_community_share", params.PauseCommunityShare != *p.PauseCommunityShare)
+		params.PauseCommunityShare = *p.PauseCommunityShare
+	}
+	if p.PausedShareMode != nil {
+		update("paused_share_mode", params.PausedShareMode != *p.PausedShareMode)
+		params.PausedShareMode = *p.PausedShareMode
+	}
+	if p.DustAssignment != nil {
+		update("dust_assignment", params.DustAssignment != *p.DustAssignment)
+		params.DustAssignment = *p.DustAssignment
+	}
+	if p.EmitMintPlanned != nil {
+		update("emit_mint_planned", params.EmitMintPlanned != *p.EmitMintPlanned)
+		params.EmitMintPlanned = *p.EmitMintPlanned
+	}
+	if p.SupplySourceMode != nil {
+		update("supply_source_mode", params.SupplySourceMode != *p.SupplySourceMode)
+		params.SupplySourceMode = *p.SupplySourceMode
+	}
+	if p.MinAnnualCommunityFunding != nil {
+		update("min_annual_community_funding", !coinEqual(params.MinAnnualCommunityFunding, *p.MinAnnualCommunityFunding))
+		params.MinAnnualCommunityFunding = *p.MinAnnualCommunityFunding
+	}
+	if p.CommunityFundingPriority != nil {
+		update("community_funding_priority", !communityFundingPriorityEqual(params.CommunityFundingPriority, *p.CommunityFundingPriority))
+		params.CommunityFundingPriority = *p.CommunityFundingPriority
+	}
+	if p.CommunityFundingWindow != nil {
+		update("community_funding_window", params.CommunityFundingWindow != *p.CommunityFundingWindow)
+		params.CommunityFundingWindow = *p.CommunityFundingWindow
+	}
+	if p.DriftCorrection != nil {
+		current, patched := params.DriftCorrection, *p.DriftCorrection
+		update("drift_correction", !decEqual(current.MaxFactor, patched.MaxFactor) || current.Horizon != patched.Horizon)
+		params.DriftCorrection = patched
+	}
+	if p.LargeChangeThreshold != nil {
+		update("large_change_threshold", !decEqual(params.LargeChangeThreshold, *p.LargeChangeThreshold))
+		params.LargeChangeThreshold = *p.LargeChangeThreshold
+	}
+	if p.StakingRewardsRecipient != nil {
+		update("staking_rewards_recipient", params.StakingRewardsRecipient != *p.StakingRewardsRecipient)
+		params.StakingRewardsRecipient = *p.StakingRewardsRecipient
+	}
+	if p.Phases != nil {
+		update("phases", !phasesEqual(params.Phases, *p.Phases))
+		params.Phases = *p.Phases
+	}
+	if p.MaxSupply != nil {
+		current, patched := params.MaxSupply, *p.MaxSupply
+		update("max_supply", current.IsNil() || patched.IsNil() || !current.Equal(patched))
+		params.MaxSupply = patched
+	}
+	if p.ShortfallPolicy != nil {
+		update("shortfall_policy", params.ShortfallPolicy != *p.ShortfallPolicy)
+		params.ShortfallPolicy = *p.ShortfallPolicy
+	}
+	if p.ShortfallPriority != nil {
+		update("shortfall_priority", !stringsEqual(params.ShortfallPriority, *p.ShortfallPriority))
+		params.ShortfallPriority = *p.ShortfallPriority
+	}
+	if p.InflationSnapshotInterval != nil {
+		update("inflation_snapshot_interval", params.InflationSnapshotInterval != *p.InflationSnapshotInterval)
+		params.InflationSnapshotInterval = *p.InflationSnapshotInterval
+	}
+	if p.InflationSnapshotRetention != nil {
+		update("inflation_snapshot_retention", params.InflationSnapshotRetention != *p.InflationSnapshotRetention)
+		params.InflationSnapshotRetention = *p.InflationSnapshotRetention
+	}
+	if p.MintConfigs != nil {
+		update("mint_configs", !mintConfigsEqual(params.MintConfigs, *p.MintConfigs))
+		params.MintConfigs = *p.MintConfigs
+	}
+	if p.InflationCalculationMode != nil {
+		update("inflation_calculation_mode", params.InflationCalculationMode != *p.InflationCalculationMode)
+		params.InflationCalculationMode = *p.InflationCalculationMode
+	}
+	if p.BootstrapOverride != nil {
+		update("bootstrap_override", params.BootstrapOverride != *p.BootstrapOverride)
+		params.BootstrapOverride = *p.BootstrapOverride
+	}
+	if p.MinBondedRatio != nil {
+		update("min_bonded_ratio", !decEqual(params.MinBondedRatio, *p.MinBondedRatio))
+		params.MinBondedRatio = *p.MinBondedRatio
+	}
+	if p.AutoPauseThreshold != nil {
+		update("auto_pause_threshold", params.AutoPauseThreshold != *p.AutoPauseThreshold)
+		params.AutoPauseThreshold = *p.AutoPauseThreshold
+	}
+	if p.DustPolicy != nil {
+		update("dust_policy", params.DustPolicy != *p.DustPolicy)
+		params.DustPolicy = *p.DustPolicy
+	}
+	if p.MaintenanceInterval != nil {
+		update("maintenance_interval", params.MaintenanceInterval != *p.MaintenanceInterval)
+		params.MaintenanceInterval = *p.MaintenanceInterval
+	}
+	if p.MintingInterval != nil {
+		update("minting_interval", params.MintingInterval != *p.MintingInterval)
+		params.MintingInterval = *p.MintingInterval
+	}
+	if p.MinCommunityPoolShare != nil {
+		update("min_community_pool_share", !decEqual(params.MinCommunityPoolShare, *p.MinCommunityPoolShare))
+		params.MinCommunityPoolShare = *p.MinCommunityPoolShare
+	}
+	return fields
+}
+
+func decEqual(a, b sdk.Dec) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() && b.IsNil()
+	}
+	return a.Equal(b)
+}
+
+func proportionsEqual(a, b types.DistributionProportions) bool {
+	return decEqual(a.Staking, b.Staking) &&
+		decEqual(a.FundedAddresses, b.FundedAddresses) &&
+		decEqual(a.CommunityPool, b.CommunityPool) &&
+		decEqual(a.StrategicReserveRatio(), b.StrategicReserveRatio())
+}
+
+func mintConfigsEqual(a, b []types.MintConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].MintDenom != b[i].MintDenom ||
+			!decEqual(a[i].InflationRateChange, b[i].InflationRateChange) ||
+			!decEqual(a[i].InflationMax, b[i].InflationMax) ||
+			!decEqual(a[i].InflationMin, b[i].InflationMin) ||
+			!decEqual(a[i].GoalBonded, b[i].GoalBonded) ||
+			!proportionsEqual(a[i].DistributionProportions, b[i].DistributionProportions) {
+			return false
+		}
+	}
+	return true
+}
+
+func fundedAddressesEqual(a, b []types.WeightedAddress) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Address != b[i].Address || !decEqual(a[i].Weight, b[i].Weight) || a[i].PayoutMode != b[i].PayoutMode ||
+			a[i].StartHeight != b[i].StartHeight || a[i].EndHeight != b[i].EndHeight || a[i].WeightMode != b[i].WeightMode {
+			return false
+		}
+	}
+	return true
+}
+
+func communityFundingPriorityEqual(a, b []types.CommunityFundingSource) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func phasesEqual(a, b []types.Phase) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name ||
+			a[i].StartHeight != b[i].StartHeight ||
+			!decEqual(a[i].InflationMin, b[i].InflationMin) ||
+			!decEqual(a[i].InflationMax, b[i].InflationMax) ||
+			!decEqual(a[i].GoalBonded, b[i].GoalBonded) {
+			return false
+		}
+	}
+	return true
+}
+
+func coinEqual(a, b sdk.Coin) bool {
+	if a.Amount.IsNil() || b.Amount.IsNil() {
+		return a.Denom == b.Denom && a.Amount.IsNil() && b.Amount.IsNil()
+	}
+	return a.Denom == b.Denom && a.Amount.Equal(b.Amount)
+}
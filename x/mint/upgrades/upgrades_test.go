@@ -0,0 +1,197 @@
+package upgrades_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	testapp "github.com/ignite/modules/app"
+	"github.com/ignite/modules/testutil"
+	"github.com/ignite/modules/testutil/sample"
+	"github.com/ignite/modules/x/mint/types"
+	"github.com/ignite/modules/x/mint/upgrades"
+)
+
+func setup(t *testing.T) (*testapp.App, sdk.Context) {
+	chainID := "simapp-chain-id"
+	app, genesisState := testutil.GenApp(chainID, true, 5)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+			ChainId:         chainID,
+		},
+	)
+	return app, app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+}
+
+// applyUpgrade runs the handler in a simulated upgrade of the app and returns the error of the handler
+func applyUpgrade(app *testapp.App, ctx sdk.Context, handler func(sdk.Context) error) (err error) {
+	app.UpgradeKeeper.SetUpgradeHandler("v2", func(
+		ctx sdk.Context,
+		_ upgradetypes.Plan,
+		fromVM module.VersionMap,
+	) (module.VersionMap, error) {
+		err = handler(ctx)
+		return fromVM, err
+	})
+
+	// the upgrade keeper panics when the handler fails
+	defer func() {
+		if r := recover(); r != nil && err == nil {
+			panic(r)
+		}
+	}()
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v2", Height: ctx.BlockHeight()})
+	return err
+}
+
+func updatedFields(t *testing.T, ctx sdk.Context) [][]string {
+	var fields [][]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "modules.mint.EventParamsUpdated" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		fields = append(fields, parsed.(*types.EventParamsUpdated).Fields)
+	}
+	return fields
+}
+
+func TestUpgradeHelpers(t *testing.T) {
+	r := sample.Rand()
+	fundedAddr := sample.Address(r)
+	proportions := types.DistributionProportions{
+		Staking:          sdk.NewDecWithPrec(5, 1),
+		FundedAddresses:  sdk.NewDecWithPrec(2, 1),
+		CommunityPool:    sdk.NewDecWithPrec(3, 1),
+		StrategicReserve: sdk.ZeroDec(),
+	}
+	inflationMax := sdk.NewDecWithPrec(25, 2)
+	blocksPerYear := uint64(1000)
+	pauseMinting := true
+
+	tests := []struct {
+		name     string
+		handler  func(sdk.Context, upgrades.MintKeeper) error
+		expected func(types.Params) types.Params
+		fields   [][]string
+		err      error
+	}{
+		{
+			name: "should set the distribution proportions",
+			handler: func(ctx sdk.Context, k upgrades.MintKeeper) error {
+				return upgrades.SetProportions(ctx, k, proportions)
+			},
+			expected: func(p types.Params) types.Params {
+				p.DistributionProportions = proportions
+				return p
+			},
+			fields: [][]string{{"distribution_proportions"}},
+		},
+		{
+			name: "should compose several helpers",
+			handler: func(ctx sdk.Context, k upgrades.MintKeeper) error {
+				if err := upgrades.SetFundedAddresses(ctx, k, []types.WeightedAddress{
+					{Address: fundedAddr, Weight: sdk.OneDec()},
+				}); err != nil {
+					return err
+				}
+				return upgrades.ClearFundedAddresses(ctx, k)
+			},
+			expected: func(p types.Params) types.Params {
+				p.FundedAddresses = nil
+				return p
+			},
+			fields: [][]string{{"funded_addresses"}, {"funded_addresses"}},
+		},
+		{
+			name: "should apply a partial params patch",
+			handler: func(ctx sdk.Context, k upgrades.MintKeeper) error {
+				return upgrades.ApplyParamPatch(ctx, k, upgrades.ParamPatch{
+					InflationMax:  &inflationMax,
+					BlocksPerYear: &blocksPerYear,
+					PauseMinting:  &pauseMinting,
+				})
+			},
+			expected: func(p types.Params) types.Params {
+				p.InflationMax = inflationMax
+				p.BlocksPerYear = blocksPerYear
+				p.PauseMinting = pauseMinting
+				return p
+			},
+			fields: [][]string{{"inflation_max", "blocks_per_year", "pause_minting"}},
+		},
+		{
+			name: "should not emit an event for an unchanged patch",
+			handler: func(ctx sdk.Context, k upgrades.MintKeeper) error {
+				params := k.GetParams(ctx)
+				return upgrades.ApplyParamPatch(ctx, k, upgrades.ParamPatch{MintDenom: &params.MintDenom})
+			},
+			expected: func(p types.Params) types.Params {
+				return p
+			},
+		},
+		{
+			name: "should prevent applying invalid params",
+			handler: func(ctx sdk.Context, k upgrades.MintKeeper) error {
+				return upgrades.SetInflationBounds(ctx, k, sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1))
+			},
+			err: types.ErrInvalidParams,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := setup(t)
+			initialParams := app.MintKeeper.GetParams(ctx)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := applyUpgrade(app, ctx, func(ctx sdk.Context) error {
+				return tc.handler(ctx, app.MintKeeper)
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, initialParams, app.MintKeeper.GetParams(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected(initialParams), app.MintKeeper.GetParams(ctx))
+			require.Equal(t, tc.fields, updatedFields(t, ctx))
+		})
+	}
+}
+
+func TestSetFeeCollectorName(t *testing.T) {
+	app, ctx := setup(t)
+	require.Equal(t, authtypes.FeeCollectorName, app.MintKeeper.GetFeeCollectorName(ctx))
+
+	t.Run("should prevent setting a fee collector without module account", func(t *testing.T) {
+		err := applyUpgrade(app, ctx, func(ctx sdk.Context) error {
+			return upgrades.SetFeeCollectorName(ctx, app.MintKeeper, "renamed_fee_collector")
+		})
+		require.ErrorIs(t, err, types.ErrFeeCollectorNotFound)
+		require.Equal(t, authtypes.FeeCollectorName, app.MintKeeper.GetFeeCollectorName(ctx))
+	})
+
+	t.Run("should set the fee collector", func(t *testing.T) {
+		err := applyUpgrade(app, ctx, func(ctx sdk.Context) error {
+			return upgrades.SetFeeCollectorName(ctx, app.MintKeeper, distrtypes.ModuleName)
+		})
+		require.NoError(t, err)
+		require.Equal(t, distrtypes.ModuleName, app.MintKeeper.GetFeeCollectorName(ctx))
+	})
+}